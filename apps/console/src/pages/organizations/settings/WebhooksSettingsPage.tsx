@@ -147,6 +147,29 @@ const EVENT_TYPES = [
   { value: "VENDOR_CREATED", label: "vendor:created" },
   { value: "VENDOR_UPDATED", label: "vendor:updated" },
   { value: "VENDOR_DELETED", label: "vendor:deleted" },
+  { value: "RISK_CREATED", label: "risk:created" },
+  { value: "RISK_UPDATED", label: "risk:updated" },
+  { value: "RISK_DELETED", label: "risk:deleted" },
+  { value: "MEASURE_CREATED", label: "measure:created" },
+  { value: "MEASURE_UPDATED", label: "measure:updated" },
+  { value: "MEASURE_DELETED", label: "measure:deleted" },
+  { value: "CONTROL_CREATED", label: "control:created" },
+  { value: "CONTROL_UPDATED", label: "control:updated" },
+  { value: "CONTROL_DELETED", label: "control:deleted" },
+  { value: "TASK_CREATED", label: "task:created" },
+  { value: "TASK_UPDATED", label: "task:updated" },
+  { value: "TASK_DELETED", label: "task:deleted" },
+  { value: "NONCONFORMITY_CREATED", label: "nonconformity:created" },
+  { value: "NONCONFORMITY_UPDATED", label: "nonconformity:updated" },
+  { value: "NONCONFORMITY_DELETED", label: "nonconformity:deleted" },
+  { value: "AUDIT_CREATED", label: "audit:created" },
+  { value: "AUDIT_UPDATED", label: "audit:updated" },
+  { value: "AUDIT_DELETED", label: "audit:deleted" },
+  { value: "EVIDENCE_CREATED", label: "evidence:created" },
+  { value: "EVIDENCE_DELETED", label: "evidence:deleted" },
+  { value: "DOCUMENT_VERSION_PUBLISHED", label: "document:version_published" },
+  { value: "DOCUMENT_SIGNATURE_SIGNED", label: "document:signature_signed" },
+  { value: "TRUST_CENTER_ACCESS_REQUESTED", label: "trust_center_access:requested" },
 ] as const;
 
 type WebhookEventType = (typeof EVENT_TYPES)[number]["value"];
@@ -295,6 +318,9 @@ function EventStatusBadge({ status }: { status: string }) {
   if (status === "PENDING") {
     return <Badge variant="info" size="sm">{__("Pending")}</Badge>;
   }
+  if (status === "FAILED") {
+    return <Badge variant="warning" size="sm">{__("Retrying")}</Badge>;
+  }
   return <Badge variant="danger" size="sm">{__("Failed")}</Badge>;
 }
 
@@ -164,6 +164,12 @@ $(echo "${SAML_PRIVATE_KEY:-}" | sed 's/^/        /')
       email: "${ACME_EMAIL:-admin@getprobo.com}"
       key-type: "${ACME_KEY_TYPE:-EC256}"
       root-ca: "${ACME_ROOT_CA:-}"
+
+  evidence-collector:
+    interval: ${EVIDENCE_COLLECTOR_INTERVAL:-86400}
+    poll-interval: ${EVIDENCE_COLLECTOR_POLL_INTERVAL:-60}
+    timeout: ${EVIDENCE_COLLECTOR_TIMEOUT:-300}
+    aws-endpoint: "${EVIDENCE_COLLECTOR_AWS_ENDPOINT:-}"
 EOF
 
   # Add connectors if configured
@@ -82,6 +82,20 @@ type (
 		RecipientFullName string
 	}
 
+	// WeeklyDigestItem is a line of a section of the weekly digest.
+	WeeklyDigestItem struct {
+		Title  string
+		Detail string
+	}
+
+	WeeklyDigest struct {
+		OpenTasks              []WeeklyDigestItem
+		OverdueNonconformities []WeeklyDigestItem
+		PendingSignatures      []WeeklyDigestItem
+		UpcomingAudits         []WeeklyDigestItem
+		RisingRisks            []WeeklyDigestItem
+	}
+
 	Presenter struct {
 		fm                *filemanager.Service
 		config            PresenterConfig
@@ -214,9 +228,13 @@ const (
 	subjectDocumentSigning                   = "Action Required – Please review and sign %s compliance documents"
 	subjectDocumentExport                    = "Your document export is ready"
 	subjectFrameworkExport                   = "Your framework export is ready"
+	subjectAuditLogExport                    = "Your audit log export is ready"
 	subjectTrustCenterAccess                 = "Compliance Page Access Invitation - %s"
 	subjectTrustCenterDocumentAccessRejected = "Compliance Page Document Access Rejected - %s"
 	subjectMagicLink                         = "Connect to %s"
+	subjectDeadlineReminder                  = "Reminder – %s %s"
+	subjectWeeklyDigest                      = "Your weekly compliance digest for %s"
+	subjectDocumentApproval                  = "Action Required – Please review and approve %s"
 )
 
 var (
@@ -232,12 +250,20 @@ var (
 	documentExportTextTemplate                    = texttemplate.Must(texttemplate.ParseFS(Templates, "dist/document-export.txt.tmpl"))
 	frameworkExportHTMLTemplate                   = htmltemplate.Must(htmltemplate.ParseFS(Templates, "dist/framework-export.html.tmpl"))
 	frameworkExportTextTemplate                   = texttemplate.Must(texttemplate.ParseFS(Templates, "dist/framework-export.txt.tmpl"))
+	auditLogExportHTMLTemplate                    = htmltemplate.Must(htmltemplate.ParseFS(Templates, "dist/audit-log-export.html.tmpl"))
+	auditLogExportTextTemplate                    = texttemplate.Must(texttemplate.ParseFS(Templates, "dist/audit-log-export.txt.tmpl"))
 	trustCenterAccessHTMLTemplate                 = htmltemplate.Must(htmltemplate.ParseFS(Templates, "dist/trust-center-access.html.tmpl"))
 	trustCenterAccessTextTemplate                 = texttemplate.Must(texttemplate.ParseFS(Templates, "dist/trust-center-access.txt.tmpl"))
 	trustCenterDocumentAccessRejectedHTMLTemplate = htmltemplate.Must(htmltemplate.ParseFS(Templates, "dist/trust-center-document-access-rejected.html.tmpl"))
 	trustCenterDocumentAccessRejectedTextTemplate = texttemplate.Must(texttemplate.ParseFS(Templates, "dist/trust-center-document-access-rejected.txt.tmpl"))
 	magicLinkHTMLTemplate                         = htmltemplate.Must(htmltemplate.ParseFS(Templates, "dist/magic-link.html.tmpl"))
 	magicLinkTextTemplate                         = texttemplate.Must(texttemplate.ParseFS(Templates, "dist/magic-link.txt.tmpl"))
+	deadlineReminderHTMLTemplate                  = htmltemplate.Must(htmltemplate.ParseFS(Templates, "dist/deadline-reminder.html.tmpl"))
+	deadlineReminderTextTemplate                  = texttemplate.Must(texttemplate.ParseFS(Templates, "dist/deadline-reminder.txt.tmpl"))
+	weeklyDigestHTMLTemplate                      = htmltemplate.Must(htmltemplate.ParseFS(Templates, "dist/weekly-digest.html.tmpl"))
+	weeklyDigestTextTemplate                      = texttemplate.Must(texttemplate.ParseFS(Templates, "dist/weekly-digest.txt.tmpl"))
+	documentApprovalHTMLTemplate                  = htmltemplate.Must(htmltemplate.ParseFS(Templates, "dist/document-approval.html.tmpl"))
+	documentApprovalTextTemplate                  = texttemplate.Must(texttemplate.ParseFS(Templates, "dist/document-approval.txt.tmpl"))
 )
 
 func (p *Presenter) getCommonVariables(ctx context.Context) (*CommonVariables, error) {
@@ -397,6 +423,24 @@ func (p *Presenter) RenderFrameworkExport(ctx context.Context, downloadUrl strin
 	return subjectFrameworkExport, textBody, htmlBody, err
 }
 
+func (p *Presenter) RenderAuditLogExport(ctx context.Context, downloadUrl string) (subject string, textBody string, htmlBody *string, err error) {
+	vars, err := p.getCommonVariables(ctx)
+	if err != nil {
+		return "", "", nil, fmt.Errorf("cannot get common variables: %w", err)
+	}
+
+	data := struct {
+		*CommonVariables
+		DownloadUrl string
+	}{
+		CommonVariables: vars,
+		DownloadUrl:     downloadUrl,
+	}
+
+	textBody, htmlBody, err = renderEmail(auditLogExportTextTemplate, auditLogExportHTMLTemplate, data)
+	return subjectAuditLogExport, textBody, htmlBody, err
+}
+
 func (p *Presenter) RenderTrustCenterAccess(ctx context.Context, organizationName string) (subject string, textBody string, htmlBody *string, err error) {
 	vars, err := p.getCommonVariables(ctx)
 	if err != nil {
@@ -461,6 +505,99 @@ func (p *Presenter) RenderMagicLink(ctx context.Context, magicLinkUrlPath string
 	return fmt.Sprintf(subjectMagicLink, organizationName), textBody, htmlBody, err
 }
 
+func (p *Presenter) RenderDeadlineReminder(
+	ctx context.Context,
+	itemURL string,
+	itemType string,
+	itemTitle string,
+	deadline time.Time,
+	status string,
+	escalated bool,
+	organizationName string,
+) (subject string, textBody string, htmlBody *string, err error) {
+	vars, err := p.getCommonVariables(ctx)
+	if err != nil {
+		return "", "", nil, fmt.Errorf("cannot get common variables: %w", err)
+	}
+
+	data := struct {
+		*CommonVariables
+		ItemUrl          string
+		ItemType         string
+		ItemTitle        string
+		Deadline         string
+		Status           string
+		Escalated        bool
+		OrganizationName string
+	}{
+		CommonVariables:  vars,
+		ItemUrl:          itemURL,
+		ItemType:         itemType,
+		ItemTitle:        itemTitle,
+		Deadline:         deadline.Format("January 2, 2006"),
+		Status:           status,
+		Escalated:        escalated,
+		OrganizationName: organizationName,
+	}
+
+	textBody, htmlBody, err = renderEmail(deadlineReminderTextTemplate, deadlineReminderHTMLTemplate, data)
+	return fmt.Sprintf(subjectDeadlineReminder, itemTitle, status), textBody, htmlBody, err
+}
+
+func (p *Presenter) RenderWeeklyDigest(
+	ctx context.Context,
+	organizationURL string,
+	organizationName string,
+	digest WeeklyDigest,
+) (subject string, textBody string, htmlBody *string, err error) {
+	vars, err := p.getCommonVariables(ctx)
+	if err != nil {
+		return "", "", nil, fmt.Errorf("cannot get common variables: %w", err)
+	}
+
+	data := struct {
+		*CommonVariables
+		WeeklyDigest
+		OrganizationUrl  string
+		OrganizationName string
+	}{
+		CommonVariables:  vars,
+		WeeklyDigest:     digest,
+		OrganizationUrl:  organizationURL,
+		OrganizationName: organizationName,
+	}
+
+	textBody, htmlBody, err = renderEmail(weeklyDigestTextTemplate, weeklyDigestHTMLTemplate, data)
+	return fmt.Sprintf(subjectWeeklyDigest, organizationName), textBody, htmlBody, err
+}
+
+func (p *Presenter) RenderDocumentApproval(
+	ctx context.Context,
+	documentURL string,
+	documentTitle string,
+	organizationName string,
+) (subject string, textBody string, htmlBody *string, err error) {
+	vars, err := p.getCommonVariables(ctx)
+	if err != nil {
+		return "", "", nil, fmt.Errorf("cannot get common variables: %w", err)
+	}
+
+	data := struct {
+		*CommonVariables
+		DocumentUrl      string
+		DocumentTitle    string
+		OrganizationName string
+	}{
+		CommonVariables:  vars,
+		DocumentUrl:      documentURL,
+		DocumentTitle:    documentTitle,
+		OrganizationName: organizationName,
+	}
+
+	textBody, htmlBody, err = renderEmail(documentApprovalTextTemplate, documentApprovalHTMLTemplate, data)
+	return fmt.Sprintf(subjectDocumentApproval, documentTitle), textBody, htmlBody, err
+}
+
 func renderEmail(textTemplate *texttemplate.Template, htmlTemplate *htmltemplate.Template, data any) (textBody string, htmlBody *string, err error) {
 	var textBuf bytes.Buffer
 	if err := textTemplate.Execute(&textBuf, data); err != nil {
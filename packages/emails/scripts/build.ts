@@ -4,7 +4,10 @@ import { dirname, join } from "node:path";
 import { fileURLToPath } from "node:url";
 import * as React from "react";
 
+import AuditLogExport from "../src/AuditLogExport";
 import ConfirmEmail from "../src/ConfirmEmail";
+import DeadlineReminder from "../src/DeadlineReminder";
+import DocumentApproval from "../src/DocumentApproval";
 import DocumentExport from "../src/DocumentExport";
 import DocumentSigning from "../src/DocumentSigning";
 import FrameworkExport from "../src/FrameworkExport";
@@ -12,6 +15,7 @@ import Invitation from "../src/Invitation";
 import PasswordReset from "../src/PasswordReset";
 import TrustCenterAccess from "../src/TrustCenterAccess";
 import TrustCenterDocumentAccessRejected from "../src/TrustCenterDocumentAccessRejected";
+import WeeklyDigest from "../src/WeeklyDigest";
 import MagicLink from "../src/MagicLink";
 
 const __filename = fileURLToPath(import.meta.url);
@@ -47,6 +51,10 @@ const templates: TemplateConfig[] = [
     name: "framework-export",
     render: () => FrameworkExport(),
   },
+  {
+    name: "audit-log-export",
+    render: () => AuditLogExport(),
+  },
   {
     name: "trust-center-access",
     render: () => TrustCenterAccess(),
@@ -59,6 +67,18 @@ const templates: TemplateConfig[] = [
     name: "magic-link",
     render: () => MagicLink(),
   },
+  {
+    name: "deadline-reminder",
+    render: () => DeadlineReminder(),
+  },
+  {
+    name: "weekly-digest",
+    render: () => WeeklyDigest(),
+  },
+  {
+    name: "document-approval",
+    render: () => DocumentApproval(),
+  },
 ];
 
 async function build() {
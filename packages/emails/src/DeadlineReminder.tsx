@@ -0,0 +1,36 @@
+import { Button, Section, Text } from '@react-email/components';
+import * as React from 'react';
+import EmailLayout, { bodyText, button, buttonContainer, footerText } from './components/EmailLayout';
+
+export const DeadlineReminder = () => {
+  return (
+    <EmailLayout subject={'Reminder – {{.ItemTitle}} {{.Status}}'}>
+      {'{{if .Escalated}}'}
+      <Text style={bodyText}>
+        You're receiving this message as an administrator of <strong>{'{{.OrganizationName}}'}</strong> because the following item is still open after its deadline:
+      </Text>
+      {'{{else}}'}
+      <Text style={bodyText}>
+        The following item of <strong>{'{{.OrganizationName}}'}</strong> {'{{.Status}}'}:
+      </Text>
+      {'{{end}}'}
+
+      <Text style={bodyText}>
+        <strong>{'{{.ItemType}}'}:</strong> {'{{.ItemTitle}}'}<br/>
+        <strong>Deadline:</strong> {'{{.Deadline}}'}
+      </Text>
+
+      <Section style={buttonContainer}>
+        <Button style={button} href={'{{.ItemUrl}}'}>
+          View in Probo
+        </Button>
+      </Section>
+
+      <Text style={footerText}>
+        You can change when these reminders are sent in the organization settings.
+      </Text>
+    </EmailLayout>
+  );
+};
+
+export default DeadlineReminder;
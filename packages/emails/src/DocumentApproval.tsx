@@ -0,0 +1,28 @@
+import { Button, Section, Text } from '@react-email/components';
+import * as React from 'react';
+import EmailLayout, { bodyText, button, buttonContainer, footerText } from './components/EmailLayout';
+
+export const DocumentApproval = () => {
+  return (
+    <EmailLayout subject={'Action Required – Please review and approve {{.DocumentTitle}}'}>
+      <Text style={bodyText}>
+        A new version of <strong>{'{{.DocumentTitle}}'}</strong> in <strong>{'{{.OrganizationName}}'}</strong> is waiting for your approval before it can be published.
+      </Text>
+      <Text style={bodyText}>
+        Please review the draft and approve or reject it by clicking the button below:
+      </Text>
+
+      <Section style={buttonContainer}>
+        <Button style={button} href={'{{.DocumentUrl}}'}>
+          Review Document
+        </Button>
+      </Section>
+
+      <Text style={footerText}>
+        You're receiving this message because you are an approver of this document.
+      </Text>
+    </EmailLayout>
+  );
+};
+
+export default DocumentApproval;
@@ -0,0 +1,44 @@
+import { Button, Section, Text } from '@react-email/components';
+import * as React from 'react';
+import EmailLayout, { bodyText, button, buttonContainer, footerText } from './components/EmailLayout';
+
+const DigestSection = ({ field, title }: { field: string; title: string }) => (
+  <>
+    {`{{if .${field}}}`}
+    <Text style={bodyText}>
+      <strong>{title}</strong><br/>
+      {`{{range .${field}}}`}
+        • {'{{.Title}}'}{'{{if .Detail}}'} ({'{{.Detail}}'}){'{{end}}'}<br/>
+      {'{{end}}'}
+    </Text>
+    {'{{end}}'}
+  </>
+);
+
+export const WeeklyDigest = () => {
+  return (
+    <EmailLayout subject={'Your weekly compliance digest for {{.OrganizationName}}'}>
+      <Text style={bodyText}>
+        Here is what needs your attention this week in <strong>{'{{.OrganizationName}}'}</strong>:
+      </Text>
+
+      <DigestSection field="OpenTasks" title="Open tasks" />
+      <DigestSection field="OverdueNonconformities" title="Overdue nonconformities" />
+      <DigestSection field="PendingSignatures" title="Documents awaiting your signature" />
+      <DigestSection field="UpcomingAudits" title="Upcoming audits" />
+      <DigestSection field="RisingRisks" title="Your risks whose residual score went up" />
+
+      <Section style={buttonContainer}>
+        <Button style={button} href={'{{.OrganizationUrl}}'}>
+          Open Probo
+        </Button>
+      </Section>
+
+      <Text style={footerText}>
+        You're receiving this digest because you turned it on in your profile. You can turn it off at any time from the same place.
+      </Text>
+    </EmailLayout>
+  );
+};
+
+export default WeeklyDigest;
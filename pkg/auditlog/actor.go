@@ -0,0 +1,64 @@
+// Copyright (c) 2025 Probo Inc <hello@getprobo.com>.
+//
+// Permission to use, copy, modify, and/or distribute this software for any
+// purpose with or without fee is hereby granted, provided that the above
+// copyright notice and this permission notice appear in all copies.
+//
+// THE SOFTWARE IS PROVIDED "AS IS" AND THE AUTHOR DISCLAIMS ALL WARRANTIES WITH
+// REGARD TO THIS SOFTWARE INCLUDING ALL IMPLIED WARRANTIES OF MERCHANTABILITY
+// AND FITNESS. IN NO EVENT SHALL THE AUTHOR BE LIABLE FOR ANY SPECIAL, DIRECT,
+// INDIRECT, OR CONSEQUENTIAL DAMAGES OR ANY DAMAGES WHATSOEVER RESULTING FROM
+// LOSS OF USE, DATA OR PROFITS, WHETHER IN AN ACTION OF CONTRACT, NEGLIGENCE OR
+// OTHER TORTIOUS ACTION, ARISING OUT OF OR IN CONNECTION WITH THE USE OR
+// PERFORMANCE OF THIS SOFTWARE.
+
+package auditlog
+
+import (
+	"context"
+
+	"go.probo.inc/probo/pkg/coredata"
+	"go.probo.inc/probo/pkg/gid"
+)
+
+type (
+	Actor struct {
+		Type       coredata.AuditLogActorType
+		IdentityID *gid.GID
+		APIKeyID   *gid.GID
+	}
+
+	ctxKey struct{ name string }
+)
+
+var actorContextKey = &ctxKey{name: "audit_log_actor"}
+
+func NewUserActor(identityID gid.GID) Actor {
+	return Actor{
+		Type:       coredata.AuditLogActorTypeUser,
+		IdentityID: &identityID,
+	}
+}
+
+func NewAPIKeyActor(identityID gid.GID, apiKeyID gid.GID) Actor {
+	return Actor{
+		Type:       coredata.AuditLogActorTypeAPIKey,
+		IdentityID: &identityID,
+		APIKeyID:   &apiKeyID,
+	}
+}
+
+func ContextWithActor(ctx context.Context, actor Actor) context.Context {
+	return context.WithValue(ctx, actorContextKey, actor)
+}
+
+// ActorFromContext returns the actor performing the current request.
+// Mutations issued outside of an authenticated request (workers, SCIM
+// bridges, ...) are attributed to the system.
+func ActorFromContext(ctx context.Context) Actor {
+	if actor, ok := ctx.Value(actorContextKey).(Actor); ok {
+		return actor
+	}
+
+	return Actor{Type: coredata.AuditLogActorTypeSystem}
+}
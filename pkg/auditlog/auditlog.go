@@ -0,0 +1,73 @@
+// Copyright (c) 2025 Probo Inc <hello@getprobo.com>.
+//
+// Permission to use, copy, modify, and/or distribute this software for any
+// purpose with or without fee is hereby granted, provided that the above
+// copyright notice and this permission notice appear in all copies.
+//
+// THE SOFTWARE IS PROVIDED "AS IS" AND THE AUTHOR DISCLAIMS ALL WARRANTIES WITH
+// REGARD TO THIS SOFTWARE INCLUDING ALL IMPLIED WARRANTIES OF MERCHANTABILITY
+// AND FITNESS. IN NO EVENT SHALL THE AUTHOR BE LIABLE FOR ANY SPECIAL, DIRECT,
+// INDIRECT, OR CONSEQUENTIAL DAMAGES OR ANY DAMAGES WHATSOEVER RESULTING FROM
+// LOSS OF USE, DATA OR PROFITS, WHETHER IN AN ACTION OF CONTRACT, NEGLIGENCE OR
+// OTHER TORTIOUS ACTION, ARISING OUT OF OR IN CONNECTION WITH THE USE OR
+// PERFORMANCE OF THIS SOFTWARE.
+
+package auditlog
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"go.gearno.de/kit/pg"
+	"go.probo.inc/probo/pkg/coredata"
+	"go.probo.inc/probo/pkg/gid"
+)
+
+// Record appends an entry to the organization audit log. It must be
+// called with the connection of the transaction performing the mutation
+// so the entry is only persisted if the mutation is.
+//
+// before and after are the states of the resource around the mutation;
+// pass nil as before for creations and nil as after for deletions.
+func Record(
+	ctx context.Context,
+	conn pg.Conn,
+	scope coredata.Scoper,
+	organizationID gid.GID,
+	action string,
+	resourceID gid.GID,
+	before any,
+	after any,
+) error {
+	changes, err := computeChanges(before, after)
+	if err != nil {
+		return fmt.Errorf("cannot compute audit log changes: %w", err)
+	}
+
+	rawChanges, err := json.Marshal(changes)
+	if err != nil {
+		return fmt.Errorf("cannot marshal audit log changes: %w", err)
+	}
+
+	actor := ActorFromContext(ctx)
+
+	entry := &coredata.AuditLogEntry{
+		ID:             gid.New(scope.GetTenantID(), coredata.AuditLogEntryEntityType),
+		OrganizationID: organizationID,
+		ActorType:      actor.Type,
+		ActorID:        actor.IdentityID,
+		APIKeyID:       actor.APIKeyID,
+		Action:         action,
+		ResourceID:     resourceID,
+		Changes:        rawChanges,
+		CreatedAt:      time.Now(),
+	}
+
+	if err := entry.Insert(ctx, conn, scope); err != nil {
+		return fmt.Errorf("cannot insert audit log entry: %w", err)
+	}
+
+	return nil
+}
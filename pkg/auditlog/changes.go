@@ -0,0 +1,103 @@
+// Copyright (c) 2025 Probo Inc <hello@getprobo.com>.
+//
+// Permission to use, copy, modify, and/or distribute this software for any
+// purpose with or without fee is hereby granted, provided that the above
+// copyright notice and this permission notice appear in all copies.
+//
+// THE SOFTWARE IS PROVIDED "AS IS" AND THE AUTHOR DISCLAIMS ALL WARRANTIES WITH
+// REGARD TO THIS SOFTWARE INCLUDING ALL IMPLIED WARRANTIES OF MERCHANTABILITY
+// AND FITNESS. IN NO EVENT SHALL THE AUTHOR BE LIABLE FOR ANY SPECIAL, DIRECT,
+// INDIRECT, OR CONSEQUENTIAL DAMAGES OR ANY DAMAGES WHATSOEVER RESULTING FROM
+// LOSS OF USE, DATA OR PROFITS, WHETHER IN AN ACTION OF CONTRACT, NEGLIGENCE OR
+// OTHER TORTIOUS ACTION, ARISING OUT OF OR IN CONNECTION WITH THE USE OR
+// PERFORMANCE OF THIS SOFTWARE.
+
+package auditlog
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"maps"
+	"slices"
+)
+
+type Change struct {
+	Before json.RawMessage `json:"before"`
+	After  json.RawMessage `json:"after"`
+}
+
+// ignoredFields are bumped by every write and would only add noise to
+// the recorded changes.
+var ignoredFields = []string{"updatedAt"}
+
+// computeChanges returns the top-level fields that differ between the
+// JSON representations of before and after. A nil before (creation) or
+// after (deletion) reports every non-null field of the other side.
+func computeChanges(before, after any) (map[string]Change, error) {
+	beforeFields, err := toFields(before)
+	if err != nil {
+		return nil, fmt.Errorf("cannot encode before state: %w", err)
+	}
+
+	afterFields, err := toFields(after)
+	if err != nil {
+		return nil, fmt.Errorf("cannot encode after state: %w", err)
+	}
+
+	keys := slices.Collect(maps.Keys(beforeFields))
+	for key := range afterFields {
+		if _, ok := beforeFields[key]; !ok {
+			keys = append(keys, key)
+		}
+	}
+
+	changes := make(map[string]Change)
+	for _, key := range keys {
+		if slices.Contains(ignoredFields, key) {
+			continue
+		}
+
+		beforeValue := orNull(beforeFields[key])
+		afterValue := orNull(afterFields[key])
+
+		if bytes.Equal(beforeValue, afterValue) {
+			continue
+		}
+
+		changes[key] = Change{Before: beforeValue, After: afterValue}
+	}
+
+	return changes, nil
+}
+
+func toFields(v any) (map[string]json.RawMessage, error) {
+	fields := make(map[string]json.RawMessage)
+
+	if v == nil {
+		return fields, nil
+	}
+
+	data, err := json.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+
+	if bytes.Equal(data, []byte("null")) {
+		return fields, nil
+	}
+
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return nil, fmt.Errorf("state must encode to a JSON object: %w", err)
+	}
+
+	return fields, nil
+}
+
+func orNull(v json.RawMessage) json.RawMessage {
+	if v == nil {
+		return json.RawMessage("null")
+	}
+
+	return v
+}
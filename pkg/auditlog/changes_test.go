@@ -0,0 +1,87 @@
+// Copyright (c) 2025 Probo Inc <hello@getprobo.com>.
+//
+// Permission to use, copy, modify, and/or distribute this software for any
+// purpose with or without fee is hereby granted, provided that the above
+// copyright notice and this permission notice appear in all copies.
+//
+// THE SOFTWARE IS PROVIDED "AS IS" AND THE AUTHOR DISCLAIMS ALL WARRANTIES WITH
+// REGARD TO THIS SOFTWARE INCLUDING ALL IMPLIED WARRANTIES OF MERCHANTABILITY
+// AND FITNESS. IN NO EVENT SHALL THE AUTHOR BE LIABLE FOR ANY SPECIAL, DIRECT,
+// INDIRECT, OR CONSEQUENTIAL DAMAGES OR ANY DAMAGES WHATSOEVER RESULTING FROM
+// LOSS OF USE, DATA OR PROFITS, WHETHER IN AN ACTION OF CONTRACT, NEGLIGENCE OR
+// OTHER TORTIOUS ACTION, ARISING OUT OF OR IN CONNECTION WITH THE USE OR
+// PERFORMANCE OF THIS SOFTWARE.
+
+package auditlog
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type testResource struct {
+	Name        string  `json:"name"`
+	Description *string `json:"description"`
+	Score       int     `json:"score"`
+	UpdatedAt   string  `json:"updatedAt"`
+}
+
+func TestComputeChanges(t *testing.T) {
+	description := "a description"
+
+	tests := []struct {
+		name   string
+		before any
+		after  any
+		want   map[string]Change
+	}{
+		{
+			name:   "creation reports every non-null field",
+			before: nil,
+			after:  &testResource{Name: "risk", Score: 4, UpdatedAt: "now"},
+			want: map[string]Change{
+				"name":  {Before: json.RawMessage(`null`), After: json.RawMessage(`"risk"`)},
+				"score": {Before: json.RawMessage(`null`), After: json.RawMessage(`4`)},
+			},
+		},
+		{
+			name:   "update only reports modified fields",
+			before: &testResource{Name: "risk", Score: 4, UpdatedAt: "before"},
+			after:  &testResource{Name: "risk", Description: &description, Score: 6, UpdatedAt: "after"},
+			want: map[string]Change{
+				"description": {Before: json.RawMessage(`null`), After: json.RawMessage(`"a description"`)},
+				"score":       {Before: json.RawMessage(`4`), After: json.RawMessage(`6`)},
+			},
+		},
+		{
+			name:   "deletion reports every non-null field",
+			before: map[string]any{"name": "risk"},
+			after:  nil,
+			want: map[string]Change{
+				"name": {Before: json.RawMessage(`"risk"`), After: json.RawMessage(`null`)},
+			},
+		},
+		{
+			name:   "identical states report nothing",
+			before: &testResource{Name: "risk", Score: 4},
+			after:  &testResource{Name: "risk", Score: 4},
+			want:   map[string]Change{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := computeChanges(tt.before, tt.after)
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestComputeChangesRejectsNonObjects(t *testing.T) {
+	_, err := computeChanges(nil, []string{"not", "an", "object"})
+	assert.Error(t, err)
+}
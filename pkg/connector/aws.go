@@ -0,0 +1,73 @@
+// Copyright (c) 2025 Probo Inc <hello@getprobo.com>.
+//
+// Permission to use, copy, modify, and/or distribute this software for any
+// purpose with or without fee is hereby granted, provided that the above
+// copyright notice and this permission notice appear in all copies.
+//
+// THE SOFTWARE IS PROVIDED "AS IS" AND THE AUTHOR DISCLAIMS ALL WARRANTIES WITH
+// REGARD TO THIS SOFTWARE INCLUDING ALL IMPLIED WARRANTIES OF MERCHANTABILITY
+// AND FITNESS. IN NO EVENT SHALL THE AUTHOR BE LIABLE FOR ANY SPECIAL, DIRECT,
+// INDIRECT, OR CONSEQUENTIAL DAMAGES OR ANY DAMAGES WHATSOEVER RESULTING FROM
+// LOSS OF USE, DATA OR PROFITS, WHETHER IN AN ACTION OF CONTRACT, NEGLIGENCE OR
+// OTHER TORTIOUS ACTION, ARISING OUT OF OR IN CONNECTION WITH THE USE OR
+// PERFORMANCE OF THIS SOFTWARE.
+
+package connector
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+
+	"go.gearno.de/kit/httpclient"
+)
+
+type (
+	// AWSConnection holds the credentials used to read the configuration
+	// of an AWS account. Either a static access key or a role ARN must be
+	// set. When both are set, the access key is used to assume the role.
+	AWSConnection struct {
+		Region          string `json:"region"`
+		AccessKeyID     string `json:"access_key_id,omitempty"`
+		SecretAccessKey string `json:"secret_access_key,omitempty"`
+		RoleARN         string `json:"role_arn,omitempty"`
+		ExternalID      string `json:"external_id,omitempty"`
+	}
+)
+
+const (
+	AWSProvider = "AWS"
+)
+
+var _ Connection = (*AWSConnection)(nil)
+
+func (c *AWSConnection) Type() ProtocolType {
+	return ProtocolAWSCredentials
+}
+
+// Client returns a plain HTTP client; AWS requests are signed by the SDK
+// using the connection credentials.
+func (c *AWSConnection) Client(ctx context.Context) (*http.Client, error) {
+	return &http.Client{Transport: httpclient.DefaultPooledTransport()}, nil
+}
+
+func (c AWSConnection) MarshalJSON() ([]byte, error) {
+	type Alias AWSConnection
+	return json.Marshal(&struct {
+		Type string `json:"type"`
+		Alias
+	}{
+		Type:  string(ProtocolAWSCredentials),
+		Alias: Alias(c),
+	})
+}
+
+func (c *AWSConnection) UnmarshalJSON(data []byte) error {
+	type Alias AWSConnection
+	aux := &struct {
+		*Alias
+	}{
+		Alias: (*Alias)(c),
+	}
+	return json.Unmarshal(data, &aux)
+}
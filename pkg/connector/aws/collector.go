@@ -0,0 +1,617 @@
+// Copyright (c) 2025 Probo Inc <hello@getprobo.com>.
+//
+// Permission to use, copy, modify, and/or distribute this software for any
+// purpose with or without fee is hereby granted, provided that the above
+// copyright notice and this permission notice appear in all copies.
+//
+// THE SOFTWARE IS PROVIDED "AS IS" AND THE AUTHOR DISCLAIMS ALL WARRANTIES WITH
+// REGARD TO THIS SOFTWARE INCLUDING ALL IMPLIED WARRANTIES OF MERCHANTABILITY
+// AND FITNESS. IN NO EVENT SHALL THE AUTHOR BE LIABLE FOR ANY SPECIAL, DIRECT,
+// INDIRECT, OR CONSEQUENTIAL DAMAGES OR ANY DAMAGES WHATSOEVER RESULTING FROM
+// LOSS OF USE, DATA OR PROFITS, WHETHER IN AN ACTION OF CONTRACT, NEGLIGENCE OR
+// OTHER TORTIOUS ACTION, ARISING OUT OF OR IN CONNECTION WITH THE USE OR
+// PERFORMANCE OF THIS SOFTWARE.
+
+// Package aws collects the security posture of the AWS account reachable
+// through an AWS connector.
+package aws
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"slices"
+	"strings"
+	"time"
+
+	awssdk "github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/credentials/stscreds"
+	"github.com/aws/aws-sdk-go-v2/service/cloudtrail"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	"github.com/aws/aws-sdk-go-v2/service/iam"
+	iamtypes "github.com/aws/aws-sdk-go-v2/service/iam/types"
+	"github.com/aws/aws-sdk-go-v2/service/kms"
+	kmstypes "github.com/aws/aws-sdk-go-v2/service/kms/types"
+	"github.com/aws/aws-sdk-go-v2/service/rds"
+	"github.com/aws/aws-sdk-go-v2/service/sts"
+	"go.gearno.de/kit/log"
+	"go.probo.inc/probo/pkg/awsconfig"
+	"go.probo.inc/probo/pkg/connector"
+)
+
+const (
+	EvidenceKeyPasswordPolicy = "aws.iam.password-policy"
+	EvidenceKeyRootMFA        = "aws.iam.root-mfa"
+	EvidenceKeyCloudTrail     = "aws.cloudtrail.trails"
+	EvidenceKeyKMSKeyRotation = "aws.kms.key-rotation"
+	EvidenceKeyRDSEncryption  = "aws.rds.encryption"
+	EvidenceKeySecurityGroups = "aws.ec2.security-groups"
+)
+
+const (
+	minimumPasswordLength = 14
+	assumeRoleSessionName = "probo-evidence-collector"
+	worldIPv4CIDR         = "0.0.0.0/0"
+	worldIPv6CIDR         = "::/0"
+)
+
+type (
+	Collector struct {
+		logger          *log.Logger
+		endpoint        string
+		accessKeyID     string
+		secretAccessKey string
+	}
+
+	// CollectorOptions configures the AWS principal of Probo itself.
+	CollectorOptions struct {
+		// Endpoint overrides the AWS endpoint of every service so
+		// collection can run against a local AWS emulator.
+		Endpoint string
+
+		// AccessKeyID and SecretAccessKey are the static credentials used
+		// to assume the role of connectors without their own access key.
+		// Such connectors fail to collect when they are empty.
+		AccessKeyID     string
+		SecretAccessKey string
+	}
+
+	passwordPolicyReport struct {
+		CollectedAt                time.Time `json:"collectedAt"`
+		Configured                 bool      `json:"configured"`
+		MinimumPasswordLength      int32     `json:"minimumPasswordLength"`
+		RequireSymbols             bool      `json:"requireSymbols"`
+		RequireNumbers             bool      `json:"requireNumbers"`
+		RequireUppercaseCharacters bool      `json:"requireUppercaseCharacters"`
+		RequireLowercaseCharacters bool      `json:"requireLowercaseCharacters"`
+		AllowUsersToChangePassword bool      `json:"allowUsersToChangePassword"`
+		ExpirePasswords            bool      `json:"expirePasswords"`
+		MaxPasswordAge             int32     `json:"maxPasswordAge"`
+		PasswordReusePrevention    int32     `json:"passwordReusePrevention"`
+		HardExpiry                 bool      `json:"hardExpiry"`
+	}
+
+	rootMFAReport struct {
+		CollectedAt              time.Time `json:"collectedAt"`
+		AccountMFAEnabled        bool      `json:"accountMfaEnabled"`
+		AccountAccessKeysPresent bool      `json:"accountAccessKeysPresent"`
+	}
+
+	report[T any] struct {
+		CollectedAt time.Time `json:"collectedAt"`
+		Region      string    `json:"region"`
+		Items       []T       `json:"items"`
+	}
+
+	trailReport struct {
+		Name                     string `json:"name"`
+		ARN                      string `json:"arn"`
+		HomeRegion               string `json:"homeRegion"`
+		IsLogging                bool   `json:"isLogging"`
+		IsMultiRegionTrail       bool   `json:"isMultiRegionTrail"`
+		IsOrganizationTrail      bool   `json:"isOrganizationTrail"`
+		LogFileValidationEnabled bool   `json:"logFileValidationEnabled"`
+		KMSKeyID                 string `json:"kmsKeyId,omitempty"`
+		S3BucketName             string `json:"s3BucketName,omitempty"`
+	}
+
+	keyRotationReport struct {
+		KeyID           string `json:"keyId"`
+		ARN             string `json:"arn"`
+		Description     string `json:"description,omitempty"`
+		RotationEnabled bool   `json:"rotationEnabled"`
+	}
+
+	dbInstanceReport struct {
+		Identifier         string `json:"identifier"`
+		Engine             string `json:"engine"`
+		Status             string `json:"status"`
+		StorageEncrypted   bool   `json:"storageEncrypted"`
+		KMSKeyID           string `json:"kmsKeyId,omitempty"`
+		PubliclyAccessible bool   `json:"publiclyAccessible"`
+	}
+
+	securityGroupReport struct {
+		GroupID     string              `json:"groupId"`
+		GroupName   string              `json:"groupName"`
+		VPCID       string              `json:"vpcId,omitempty"`
+		OpenToWorld []exposedRuleReport `json:"openToWorld"`
+	}
+
+	exposedRuleReport struct {
+		Protocol string `json:"protocol"`
+		FromPort int32  `json:"fromPort"`
+		ToPort   int32  `json:"toPort"`
+		CIDR     string `json:"cidr"`
+	}
+)
+
+var _ connector.Collector = (*Collector)(nil)
+
+// NewCollector returns a collector calling the AWS APIs of the connected
+// account. The ambient credentials of the host are never used: a connector
+// is reached either with its own access key or by assuming its role with
+// the credentials of the options.
+func NewCollector(logger *log.Logger, opts CollectorOptions) *Collector {
+	return &Collector{
+		logger:          logger,
+		endpoint:        opts.Endpoint,
+		accessKeyID:     opts.AccessKeyID,
+		secretAccessKey: opts.SecretAccessKey,
+	}
+}
+
+// Collect snapshots the account configuration. Every evidence is collected
+// independently: a failing API call only fails the evidence relying on it.
+func (c *Collector) Collect(
+	ctx context.Context,
+	httpClient *http.Client,
+	conn connector.Connection,
+) ([]connector.CollectedEvidence, error) {
+	awsConn, ok := conn.(*connector.AWSConnection)
+	if !ok {
+		return nil, fmt.Errorf("unsupported connection type %T", conn)
+	}
+
+	cfg, err := c.config(httpClient, awsConn)
+	if err != nil {
+		return nil, fmt.Errorf("cannot configure aws client: %w", err)
+	}
+
+	now := time.Now()
+
+	return []connector.CollectedEvidence{
+		c.collectPasswordPolicy(ctx, iam.NewFromConfig(cfg), now),
+		c.collectRootMFA(ctx, iam.NewFromConfig(cfg), now),
+		c.collectCloudTrail(ctx, cloudtrail.NewFromConfig(cfg), cfg.Region, now),
+		c.collectKMSKeyRotation(ctx, kms.NewFromConfig(cfg), cfg.Region, now),
+		c.collectRDSEncryption(ctx, rds.NewFromConfig(cfg), cfg.Region, now),
+		c.collectSecurityGroups(ctx, ec2.NewFromConfig(cfg), cfg.Region, now),
+	}, nil
+}
+
+func (c *Collector) config(httpClient *http.Client, conn *connector.AWSConnection) (awssdk.Config, error) {
+	accessKeyID, secretAccessKey := conn.AccessKeyID, conn.SecretAccessKey
+
+	if conn.RoleARN != "" {
+		if conn.ExternalID == "" {
+			return awssdk.Config{}, errors.New("cannot assume role without external id")
+		}
+
+		if accessKeyID == "" {
+			accessKeyID, secretAccessKey = c.accessKeyID, c.secretAccessKey
+		}
+	}
+
+	// awsconfig falls back to the ECS and EC2 credentials of the host when
+	// no access key is given, which must never happen for a connector.
+	if accessKeyID == "" || secretAccessKey == "" {
+		return awssdk.Config{}, errors.New("no credentials configured for connection")
+	}
+
+	cfg := awsconfig.NewConfig(
+		c.logger,
+		httpClient,
+		awsconfig.Options{
+			Region:          conn.Region,
+			AccessKeyID:     accessKeyID,
+			SecretAccessKey: secretAccessKey,
+			Endpoint:        c.endpoint,
+		},
+	)
+
+	if conn.RoleARN != "" {
+		cfg.Credentials = awssdk.NewCredentialsCache(
+			stscreds.NewAssumeRoleProvider(
+				sts.NewFromConfig(cfg),
+				conn.RoleARN,
+				func(o *stscreds.AssumeRoleOptions) {
+					o.RoleSessionName = assumeRoleSessionName
+					o.ExternalID = awssdk.String(conn.ExternalID)
+				},
+			),
+		)
+	}
+
+	return cfg, nil
+}
+
+func (c *Collector) collectPasswordPolicy(ctx context.Context, client *iam.Client, now time.Time) connector.CollectedEvidence {
+	evidence := connector.CollectedEvidence{
+		Key:         EvidenceKeyPasswordPolicy,
+		Filename:    "aws-iam-password-policy.json",
+		Description: "AWS IAM account password policy",
+	}
+
+	policyReport := passwordPolicyReport{CollectedAt: now}
+
+	out, err := client.GetAccountPasswordPolicy(ctx, &iam.GetAccountPasswordPolicyInput{})
+	if err != nil {
+		var noSuchEntity *iamtypes.NoSuchEntityException
+		if !errors.As(err, &noSuchEntity) {
+			evidence.Err = fmt.Errorf("cannot get account password policy: %w", err)
+			return evidence
+		}
+	}
+
+	if out != nil && out.PasswordPolicy != nil {
+		policy := out.PasswordPolicy
+		policyReport.Configured = true
+		policyReport.MinimumPasswordLength = awssdk.ToInt32(policy.MinimumPasswordLength)
+		policyReport.RequireSymbols = policy.RequireSymbols
+		policyReport.RequireNumbers = policy.RequireNumbers
+		policyReport.RequireUppercaseCharacters = policy.RequireUppercaseCharacters
+		policyReport.RequireLowercaseCharacters = policy.RequireLowercaseCharacters
+		policyReport.AllowUsersToChangePassword = policy.AllowUsersToChangePassword
+		policyReport.ExpirePasswords = policy.ExpirePasswords
+		policyReport.MaxPasswordAge = awssdk.ToInt32(policy.MaxPasswordAge)
+		policyReport.PasswordReusePrevention = awssdk.ToInt32(policy.PasswordReusePrevention)
+		policyReport.HardExpiry = awssdk.ToBool(policy.HardExpiry)
+	}
+
+	switch {
+	case !policyReport.Configured:
+		evidence.Findings = append(
+			evidence.Findings,
+			connector.Finding{
+				Key:         "password-policy",
+				Title:       "AWS account has no IAM password policy",
+				Description: "No IAM password policy is configured, IAM users can set passwords of any length and complexity.",
+			},
+		)
+	case policyReport.MinimumPasswordLength < minimumPasswordLength:
+		evidence.Findings = append(
+			evidence.Findings,
+			connector.Finding{
+				Key:         "password-policy",
+				Title:       "AWS IAM password policy allows short passwords",
+				Description: fmt.Sprintf("The IAM password policy requires %d characters, at least %d are expected.", policyReport.MinimumPasswordLength, minimumPasswordLength),
+			},
+		)
+	}
+
+	evidence.Data = policyReport
+	return evidence
+}
+
+func (c *Collector) collectRootMFA(ctx context.Context, client *iam.Client, now time.Time) connector.CollectedEvidence {
+	evidence := connector.CollectedEvidence{
+		Key:         EvidenceKeyRootMFA,
+		Filename:    "aws-iam-root-mfa.json",
+		Description: "AWS root account multi-factor authentication",
+	}
+
+	out, err := client.GetAccountSummary(ctx, &iam.GetAccountSummaryInput{})
+	if err != nil {
+		evidence.Err = fmt.Errorf("cannot get account summary: %w", err)
+		return evidence
+	}
+
+	mfaReport := rootMFAReport{
+		CollectedAt:              now,
+		AccountMFAEnabled:        out.SummaryMap["AccountMFAEnabled"] > 0,
+		AccountAccessKeysPresent: out.SummaryMap["AccountAccessKeysPresent"] > 0,
+	}
+
+	if !mfaReport.AccountMFAEnabled {
+		evidence.Findings = append(
+			evidence.Findings,
+			connector.Finding{
+				Key:         "root-mfa",
+				Title:       "AWS root account has no MFA device",
+				Description: "The root user of the AWS account can sign in without multi-factor authentication.",
+			},
+		)
+	}
+
+	if mfaReport.AccountAccessKeysPresent {
+		evidence.Findings = append(
+			evidence.Findings,
+			connector.Finding{
+				Key:         "root-access-keys",
+				Title:       "AWS root account has access keys",
+				Description: "The root user of the AWS account has active access keys, they should be deleted.",
+			},
+		)
+	}
+
+	evidence.Data = mfaReport
+	return evidence
+}
+
+func (c *Collector) collectCloudTrail(ctx context.Context, client *cloudtrail.Client, region string, now time.Time) connector.CollectedEvidence {
+	evidence := connector.CollectedEvidence{
+		Key:         EvidenceKeyCloudTrail,
+		Filename:    "aws-cloudtrail-trails.json",
+		Description: "AWS CloudTrail trails",
+	}
+
+	out, err := client.DescribeTrails(ctx, &cloudtrail.DescribeTrailsInput{IncludeShadowTrails: awssdk.Bool(true)})
+	if err != nil {
+		evidence.Err = fmt.Errorf("cannot describe trails: %w", err)
+		return evidence
+	}
+
+	trails := report[trailReport]{CollectedAt: now, Region: region, Items: []trailReport{}}
+	multiRegionLogging := false
+
+	for _, trail := range out.TrailList {
+		status, err := client.GetTrailStatus(ctx, &cloudtrail.GetTrailStatusInput{Name: trail.TrailARN})
+		if err != nil {
+			evidence.Err = fmt.Errorf("cannot get status of trail %q: %w", awssdk.ToString(trail.Name), err)
+			return evidence
+		}
+
+		item := trailReport{
+			Name:                     awssdk.ToString(trail.Name),
+			ARN:                      awssdk.ToString(trail.TrailARN),
+			HomeRegion:               awssdk.ToString(trail.HomeRegion),
+			IsLogging:                awssdk.ToBool(status.IsLogging),
+			IsMultiRegionTrail:       awssdk.ToBool(trail.IsMultiRegionTrail),
+			IsOrganizationTrail:      awssdk.ToBool(trail.IsOrganizationTrail),
+			LogFileValidationEnabled: awssdk.ToBool(trail.LogFileValidationEnabled),
+			KMSKeyID:                 awssdk.ToString(trail.KmsKeyId),
+			S3BucketName:             awssdk.ToString(trail.S3BucketName),
+		}
+		trails.Items = append(trails.Items, item)
+
+		if item.IsLogging && item.IsMultiRegionTrail {
+			multiRegionLogging = true
+		}
+
+		if item.IsLogging && !item.LogFileValidationEnabled {
+			evidence.Findings = append(
+				evidence.Findings,
+				connector.Finding{
+					Key:         "cloudtrail-log-validation:" + item.ARN,
+					Title:       fmt.Sprintf("CloudTrail trail %s has no log file validation", item.Name),
+					Description: fmt.Sprintf("Log file integrity validation is disabled on trail %s, tampering with its logs cannot be detected.", item.ARN),
+				},
+			)
+		}
+	}
+
+	if !multiRegionLogging {
+		evidence.Findings = append(
+			evidence.Findings,
+			connector.Finding{
+				Key:         "cloudtrail-multi-region",
+				Title:       "AWS account has no multi-region CloudTrail trail",
+				Description: "No logging multi-region CloudTrail trail exists, API activity in some regions is not recorded.",
+			},
+		)
+	}
+
+	evidence.Data = trails
+	return evidence
+}
+
+func (c *Collector) collectKMSKeyRotation(ctx context.Context, client *kms.Client, region string, now time.Time) connector.CollectedEvidence {
+	evidence := connector.CollectedEvidence{
+		Key:         EvidenceKeyKMSKeyRotation,
+		Filename:    "aws-kms-key-rotation.json",
+		Description: "AWS KMS customer managed key rotation",
+	}
+
+	keys := report[keyRotationReport]{CollectedAt: now, Region: region, Items: []keyRotationReport{}}
+
+	paginator := kms.NewListKeysPaginator(client, &kms.ListKeysInput{})
+	for paginator.HasMorePages() {
+		page, err := paginator.NextPage(ctx)
+		if err != nil {
+			evidence.Err = fmt.Errorf("cannot list keys: %w", err)
+			return evidence
+		}
+
+		for _, key := range page.Keys {
+			described, err := client.DescribeKey(ctx, &kms.DescribeKeyInput{KeyId: key.KeyId})
+			if err != nil {
+				evidence.Err = fmt.Errorf("cannot describe key %q: %w", awssdk.ToString(key.KeyId), err)
+				return evidence
+			}
+
+			// Only enabled symmetric customer managed keys support
+			// automatic rotation.
+			metadata := described.KeyMetadata
+			if metadata == nil ||
+				metadata.KeyManager != kmstypes.KeyManagerTypeCustomer ||
+				metadata.KeyState != kmstypes.KeyStateEnabled ||
+				metadata.KeySpec != kmstypes.KeySpecSymmetricDefault ||
+				metadata.Origin != kmstypes.OriginTypeAwsKms {
+				continue
+			}
+
+			rotation, err := client.GetKeyRotationStatus(ctx, &kms.GetKeyRotationStatusInput{KeyId: key.KeyId})
+			if err != nil {
+				evidence.Err = fmt.Errorf("cannot get rotation status of key %q: %w", awssdk.ToString(key.KeyId), err)
+				return evidence
+			}
+
+			item := keyRotationReport{
+				KeyID:           awssdk.ToString(metadata.KeyId),
+				ARN:             awssdk.ToString(metadata.Arn),
+				Description:     awssdk.ToString(metadata.Description),
+				RotationEnabled: rotation.KeyRotationEnabled,
+			}
+			keys.Items = append(keys.Items, item)
+
+			if !item.RotationEnabled {
+				evidence.Findings = append(
+					evidence.Findings,
+					connector.Finding{
+						Key:         "kms-rotation:" + item.ARN,
+						Title:       fmt.Sprintf("KMS key %s has no automatic rotation", item.KeyID),
+						Description: fmt.Sprintf("Automatic rotation is disabled on customer managed key %s.", item.ARN),
+					},
+				)
+			}
+		}
+	}
+
+	evidence.Data = keys
+	return evidence
+}
+
+func (c *Collector) collectRDSEncryption(ctx context.Context, client *rds.Client, region string, now time.Time) connector.CollectedEvidence {
+	evidence := connector.CollectedEvidence{
+		Key:         EvidenceKeyRDSEncryption,
+		Filename:    "aws-rds-encryption.json",
+		Description: "AWS RDS storage encryption",
+	}
+
+	instances := report[dbInstanceReport]{CollectedAt: now, Region: region, Items: []dbInstanceReport{}}
+
+	paginator := rds.NewDescribeDBInstancesPaginator(client, &rds.DescribeDBInstancesInput{})
+	for paginator.HasMorePages() {
+		page, err := paginator.NextPage(ctx)
+		if err != nil {
+			evidence.Err = fmt.Errorf("cannot describe db instances: %w", err)
+			return evidence
+		}
+
+		for _, instance := range page.DBInstances {
+			item := dbInstanceReport{
+				Identifier:         awssdk.ToString(instance.DBInstanceIdentifier),
+				Engine:             awssdk.ToString(instance.Engine),
+				Status:             awssdk.ToString(instance.DBInstanceStatus),
+				StorageEncrypted:   awssdk.ToBool(instance.StorageEncrypted),
+				KMSKeyID:           awssdk.ToString(instance.KmsKeyId),
+				PubliclyAccessible: awssdk.ToBool(instance.PubliclyAccessible),
+			}
+			instances.Items = append(instances.Items, item)
+
+			if !item.StorageEncrypted {
+				evidence.Findings = append(
+					evidence.Findings,
+					connector.Finding{
+						Key:         "rds-encryption:" + item.Identifier,
+						Title:       fmt.Sprintf("RDS instance %s is not encrypted", item.Identifier),
+						Description: fmt.Sprintf("Storage encryption at rest is disabled on %s instance %s.", item.Engine, item.Identifier),
+					},
+				)
+			}
+		}
+	}
+
+	evidence.Data = instances
+	return evidence
+}
+
+func (c *Collector) collectSecurityGroups(ctx context.Context, client *ec2.Client, region string, now time.Time) connector.CollectedEvidence {
+	evidence := connector.CollectedEvidence{
+		Key:         EvidenceKeySecurityGroups,
+		Filename:    "aws-ec2-security-groups.json",
+		Description: "AWS EC2 security groups open to the internet",
+	}
+
+	groups := report[securityGroupReport]{CollectedAt: now, Region: region, Items: []securityGroupReport{}}
+
+	paginator := ec2.NewDescribeSecurityGroupsPaginator(client, &ec2.DescribeSecurityGroupsInput{})
+	for paginator.HasMorePages() {
+		page, err := paginator.NextPage(ctx)
+		if err != nil {
+			evidence.Err = fmt.Errorf("cannot describe security groups: %w", err)
+			return evidence
+		}
+
+		for _, group := range page.SecurityGroups {
+			item := securityGroupReport{
+				GroupID:     awssdk.ToString(group.GroupId),
+				GroupName:   awssdk.ToString(group.GroupName),
+				VPCID:       awssdk.ToString(group.VpcId),
+				OpenToWorld: []exposedRuleReport{},
+			}
+
+			for _, permission := range group.IpPermissions {
+				var cidrs []string
+				for _, r := range permission.IpRanges {
+					cidrs = append(cidrs, awssdk.ToString(r.CidrIp))
+				}
+				for _, r := range permission.Ipv6Ranges {
+					cidrs = append(cidrs, awssdk.ToString(r.CidrIpv6))
+				}
+
+				for _, cidr := range cidrs {
+					if cidr != worldIPv4CIDR && cidr != worldIPv6CIDR {
+						continue
+					}
+
+					item.OpenToWorld = append(
+						item.OpenToWorld,
+						exposedRuleReport{
+							Protocol: awssdk.ToString(permission.IpProtocol),
+							FromPort: awssdk.ToInt32(permission.FromPort),
+							ToPort:   awssdk.ToInt32(permission.ToPort),
+							CIDR:     cidr,
+						},
+					)
+				}
+			}
+
+			groups.Items = append(groups.Items, item)
+
+			if exposed := sensitiveExposures(item.OpenToWorld); len(exposed) > 0 {
+				evidence.Findings = append(
+					evidence.Findings,
+					connector.Finding{
+						Key:         "security-group-exposure:" + item.GroupID,
+						Title:       fmt.Sprintf("Security group %s is open to the internet", item.GroupName),
+						Description: fmt.Sprintf("Security group %s (%s) allows inbound traffic from anywhere on %s.", item.GroupName, item.GroupID, strings.Join(exposed, ", ")),
+					},
+				)
+			}
+		}
+	}
+
+	evidence.Data = groups
+	return evidence
+}
+
+// sensitiveExposures returns the world open rules other than HTTP and HTTPS,
+// which are expected on public facing load balancers.
+func sensitiveExposures(rules []exposedRuleReport) []string {
+	var exposed []string
+
+	for _, rule := range rules {
+		if rule.Protocol == "tcp" && rule.FromPort == rule.ToPort && (rule.FromPort == 80 || rule.FromPort == 443) {
+			continue
+		}
+
+		var description string
+		switch {
+		case rule.Protocol == "-1":
+			description = "all traffic"
+		case rule.FromPort == rule.ToPort:
+			description = fmt.Sprintf("%s/%d", rule.Protocol, rule.FromPort)
+		default:
+			description = fmt.Sprintf("%s/%d-%d", rule.Protocol, rule.FromPort, rule.ToPort)
+		}
+
+		if !slices.Contains(exposed, description) {
+			exposed = append(exposed, description)
+		}
+	}
+
+	return exposed
+}
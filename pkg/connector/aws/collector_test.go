@@ -0,0 +1,161 @@
+// Copyright (c) 2025 Probo Inc <hello@getprobo.com>.
+//
+// Permission to use, copy, modify, and/or distribute this software for any
+// purpose with or without fee is hereby granted, provided that the above
+// copyright notice and this permission notice appear in all copies.
+//
+// THE SOFTWARE IS PROVIDED "AS IS" AND THE AUTHOR DISCLAIMS ALL WARRANTIES WITH
+// REGARD TO THIS SOFTWARE INCLUDING ALL IMPLIED WARRANTIES OF MERCHANTABILITY
+// AND FITNESS. IN NO EVENT SHALL THE AUTHOR BE LIABLE FOR ANY SPECIAL, DIRECT,
+// INDIRECT, OR CONSEQUENTIAL DAMAGES OR ANY DAMAGES WHATSOEVER RESULTING FROM
+// LOSS OF USE, DATA OR PROFITS, WHETHER IN AN ACTION OF CONTRACT, NEGLIGENCE OR
+// OTHER TORTIOUS ACTION, ARISING OUT OF OR IN CONNECTION WITH THE USE OR
+// PERFORMANCE OF THIS SOFTWARE.
+
+package aws
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.gearno.de/kit/log"
+	"go.probo.inc/probo/pkg/connector"
+)
+
+func TestCollect(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_ = r.ParseForm()
+
+		w.Header().Set("Content-Type", "text/xml")
+
+		switch r.Form.Get("Action") {
+		case "GetAccountSummary":
+			_, _ = w.Write([]byte(`<GetAccountSummaryResponse><GetAccountSummaryResult><SummaryMap>
+				<entry><key>AccountMFAEnabled</key><value>0</value></entry>
+				<entry><key>AccountAccessKeysPresent</key><value>0</value></entry>
+			</SummaryMap></GetAccountSummaryResult></GetAccountSummaryResponse>`))
+		case "GetAccountPasswordPolicy":
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte(`<ErrorResponse><Error><Type>Sender</Type><Code>NoSuchEntity</Code><Message>no policy</Message></Error></ErrorResponse>`))
+		default:
+			w.WriteHeader(http.StatusForbidden)
+			_, _ = w.Write([]byte(`<ErrorResponse><Error><Type>Sender</Type><Code>AccessDenied</Code><Message>denied</Message></Error></ErrorResponse>`))
+		}
+	}))
+	defer server.Close()
+
+	collector := NewCollector(log.NewLogger(log.WithOutput(io.Discard)), CollectorOptions{Endpoint: server.URL})
+
+	evidences, err := collector.Collect(
+		context.Background(),
+		server.Client(),
+		&connector.AWSConnection{
+			Region:          "us-east-1",
+			AccessKeyID:     "AKIAEXAMPLE",
+			SecretAccessKey: "secret",
+		},
+	)
+	require.NoError(t, err)
+
+	byKey := map[string]connector.CollectedEvidence{}
+	for _, evidence := range evidences {
+		byKey[evidence.Key] = evidence
+	}
+
+	passwordPolicy := byKey[EvidenceKeyPasswordPolicy]
+	require.NoError(t, passwordPolicy.Err)
+	assert.False(t, passwordPolicy.Data.(passwordPolicyReport).Configured)
+	require.Len(t, passwordPolicy.Findings, 1)
+	assert.Equal(t, "password-policy", passwordPolicy.Findings[0].Key)
+
+	rootMFA := byKey[EvidenceKeyRootMFA]
+	require.NoError(t, rootMFA.Err)
+	require.Len(t, rootMFA.Findings, 1)
+	assert.Equal(t, "root-mfa", rootMFA.Findings[0].Key)
+
+	for _, key := range []string{EvidenceKeyCloudTrail, EvidenceKeyKMSKeyRotation, EvidenceKeyRDSEncryption, EvidenceKeySecurityGroups} {
+		assert.Error(t, byKey[key].Err, key)
+		assert.Nil(t, byKey[key].Data, key)
+	}
+}
+
+func TestSensitiveExposures(t *testing.T) {
+	t.Parallel()
+
+	exposed := sensitiveExposures(
+		[]exposedRuleReport{
+			{Protocol: "tcp", FromPort: 443, ToPort: 443, CIDR: worldIPv4CIDR},
+			{Protocol: "tcp", FromPort: 80, ToPort: 80, CIDR: worldIPv6CIDR},
+			{Protocol: "tcp", FromPort: 22, ToPort: 22, CIDR: worldIPv4CIDR},
+			{Protocol: "tcp", FromPort: 22, ToPort: 22, CIDR: worldIPv6CIDR},
+			{Protocol: "udp", FromPort: 1000, ToPort: 2000, CIDR: worldIPv4CIDR},
+			{Protocol: "-1", CIDR: worldIPv4CIDR},
+		},
+	)
+
+	assert.Equal(t, []string{"tcp/22", "udp/1000-2000", "all traffic"}, exposed)
+}
+
+func TestConfig(t *testing.T) {
+	t.Parallel()
+
+	logger := log.NewLogger(log.WithOutput(io.Discard))
+
+	tests := []struct {
+		name      string
+		collector *Collector
+		conn      connector.AWSConnection
+		wantErr   bool
+	}{
+		{
+			name:      "access key",
+			collector: NewCollector(logger, CollectorOptions{}),
+			conn:      connector.AWSConnection{Region: "us-east-1", AccessKeyID: "AKIAEXAMPLE", SecretAccessKey: "secret"},
+		},
+		{
+			name:      "no credentials never falls back to ambient credentials",
+			collector: NewCollector(logger, CollectorOptions{}),
+			conn:      connector.AWSConnection{Region: "us-east-1"},
+			wantErr:   true,
+		},
+		{
+			name:      "role without external id",
+			collector: NewCollector(logger, CollectorOptions{AccessKeyID: "AKIAPROBO", SecretAccessKey: "secret"}),
+			conn:      connector.AWSConnection{Region: "us-east-1", RoleARN: "arn:aws:iam::123456789012:role/probo"},
+			wantErr:   true,
+		},
+		{
+			name:      "role without collector credentials",
+			collector: NewCollector(logger, CollectorOptions{}),
+			conn:      connector.AWSConnection{Region: "us-east-1", RoleARN: "arn:aws:iam::123456789012:role/probo", ExternalID: "external"},
+			wantErr:   true,
+		},
+		{
+			name:      "role with collector credentials",
+			collector: NewCollector(logger, CollectorOptions{AccessKeyID: "AKIAPROBO", SecretAccessKey: "secret"}),
+			conn:      connector.AWSConnection{Region: "us-east-1", RoleARN: "arn:aws:iam::123456789012:role/probo", ExternalID: "external"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			cfg, err := tt.collector.config(http.DefaultClient, &tt.conn)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.NotNil(t, cfg.Credentials)
+		})
+	}
+}
@@ -0,0 +1,54 @@
+// Copyright (c) 2025 Probo Inc <hello@getprobo.com>.
+//
+// Permission to use, copy, modify, and/or distribute this software for any
+// purpose with or without fee is hereby granted, provided that the above
+// copyright notice and this permission notice appear in all copies.
+//
+// THE SOFTWARE IS PROVIDED "AS IS" AND THE AUTHOR DISCLAIMS ALL WARRANTIES WITH
+// REGARD TO THIS SOFTWARE INCLUDING ALL IMPLIED WARRANTIES OF MERCHANTABILITY
+// AND FITNESS. IN NO EVENT SHALL THE AUTHOR BE LIABLE FOR ANY SPECIAL, DIRECT,
+// INDIRECT, OR CONSEQUENTIAL DAMAGES OR ANY DAMAGES WHATSOEVER RESULTING FROM
+// LOSS OF USE, DATA OR PROFITS, WHETHER IN AN ACTION OF CONTRACT, NEGLIGENCE OR
+// OTHER TORTIOUS ACTION, ARISING OUT OF OR IN CONNECTION WITH THE USE OR
+// PERFORMANCE OF THIS SOFTWARE.
+
+package connector
+
+import (
+	"context"
+	"net/http"
+)
+
+type (
+	// Collector pulls configuration state from a connected system so it
+	// can be stored as evidence. Collectors are registered per provider
+	// alongside the Connector used to establish the connection.
+	Collector interface {
+		// Collect returns the current state of the connected system. The
+		// given HTTP client is already authenticated for OAuth2
+		// connections; other protocols read their credentials from conn.
+		Collect(ctx context.Context, httpClient *http.Client, conn Connection) ([]CollectedEvidence, error)
+	}
+
+	// CollectedEvidence is a single piece of configuration state returned
+	// by a Collector. Key identifies the evidence and is what measures are
+	// mapped against (e.g. "aws.iam.password-policy"). Err is set instead
+	// of Data when this piece of state could not be read.
+	CollectedEvidence struct {
+		Key         string
+		Filename    string
+		Description string
+		Data        any
+		Findings    []Finding
+		Err         error
+	}
+
+	// Finding is a deviation from the expected configuration spotted while
+	// collecting evidence. Key must be stable across collections so the
+	// same finding is not reported twice.
+	Finding struct {
+		Key         string
+		Title       string
+		Description string
+	}
+)
@@ -41,7 +41,9 @@ type (
 )
 
 const (
-	ProtocolOAuth2 ProtocolType = "OAUTH2"
+	ProtocolOAuth2         ProtocolType = "OAUTH2"
+	ProtocolAWSCredentials ProtocolType = "AWS_CREDENTIALS"
+	ProtocolAPIToken       ProtocolType = "API_TOKEN"
 )
 
 func UnmarshalConnection(protocol string, provider string, data []byte) (Connection, error) {
@@ -62,6 +64,25 @@ func UnmarshalConnection(protocol string, provider string, data []byte) (Connect
 			}
 			return &conn, nil
 		}
+
+	case string(ProtocolAWSCredentials):
+		var conn AWSConnection
+		if err := json.Unmarshal(data, &conn); err != nil {
+			return nil, fmt.Errorf("cannot unmarshal aws connection: %w", err)
+		}
+		return &conn, nil
+
+	case string(ProtocolAPIToken):
+		switch provider {
+		case OktaProvider:
+			var conn OktaConnection
+			if err := json.Unmarshal(data, &conn); err != nil {
+				return nil, fmt.Errorf("cannot unmarshal okta connection: %w", err)
+			}
+			return &conn, nil
+		}
+
+		return nil, fmt.Errorf("unsupported provider for api token connection: %s", provider)
 	}
 
 	return nil, fmt.Errorf("unknown connection protocol: %s", protocol)
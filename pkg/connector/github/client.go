@@ -0,0 +1,284 @@
+// Copyright (c) 2025 Probo Inc <hello@getprobo.com>.
+//
+// Permission to use, copy, modify, and/or distribute this software for any
+// purpose with or without fee is hereby granted, provided that the above
+// copyright notice and this permission notice appear in all copies.
+//
+// THE SOFTWARE IS PROVIDED "AS IS" AND THE AUTHOR DISCLAIMS ALL WARRANTIES WITH
+// REGARD TO THIS SOFTWARE INCLUDING ALL IMPLIED WARRANTIES OF MERCHANTABILITY
+// AND FITNESS. IN NO EVENT SHALL THE AUTHOR BE LIABLE FOR ANY SPECIAL, DIRECT,
+// INDIRECT, OR CONSEQUENTIAL DAMAGES OR ANY DAMAGES WHATSOEVER RESULTING FROM
+// LOSS OF USE, DATA OR PROFITS, WHETHER IN AN ACTION OF CONTRACT, NEGLIGENCE OR
+// OTHER TORTIOUS ACTION, ARISING OUT OF OR IN CONNECTION WITH THE USE OR
+// PERFORMANCE OF THIS SOFTWARE.
+
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"regexp"
+	"strings"
+)
+
+type (
+	client struct {
+		httpClient *http.Client
+		apiURL     string
+	}
+
+	// APIError is returned when the GitHub API answers with a non 2xx
+	// status code.
+	APIError struct {
+		StatusCode int
+		Message    string
+	}
+
+	repository struct {
+		Name          string `json:"name"`
+		FullName      string `json:"full_name"`
+		Private       bool   `json:"private"`
+		Visibility    string `json:"visibility"`
+		Archived      bool   `json:"archived"`
+		DefaultBranch string `json:"default_branch"`
+		HTMLURL       string `json:"html_url"`
+		Owner         struct {
+			Login string `json:"login"`
+			Type  string `json:"type"`
+		} `json:"owner"`
+		SecurityAndAnalysis *struct {
+			SecretScanning *struct {
+				Status string `json:"status"`
+			} `json:"secret_scanning"`
+			SecretScanningPushProtection *struct {
+				Status string `json:"status"`
+			} `json:"secret_scanning_push_protection"`
+		} `json:"security_and_analysis"`
+	}
+
+	enabledSetting struct {
+		Enabled bool `json:"enabled"`
+	}
+
+	branchProtection struct {
+		RequiredPullRequestReviews *struct {
+			RequiredApprovingReviewCount int  `json:"required_approving_review_count"`
+			DismissStaleReviews          bool `json:"dismiss_stale_reviews"`
+			RequireCodeOwnerReviews      bool `json:"require_code_owner_reviews"`
+		} `json:"required_pull_request_reviews"`
+		RequiredStatusChecks *struct {
+			Strict   bool     `json:"strict"`
+			Contexts []string `json:"contexts"`
+		} `json:"required_status_checks"`
+		EnforceAdmins         *enabledSetting `json:"enforce_admins"`
+		AllowForcePushes      *enabledSetting `json:"allow_force_pushes"`
+		AllowDeletions        *enabledSetting `json:"allow_deletions"`
+		RequiredSignatures    *enabledSetting `json:"required_signatures"`
+		RequiredLinearHistory *enabledSetting `json:"required_linear_history"`
+	}
+
+	dependabotAlert struct {
+		Number           int    `json:"number"`
+		State            string `json:"state"`
+		HTMLURL          string `json:"html_url"`
+		SecurityAdvisory struct {
+			GHSAID   string `json:"ghsa_id"`
+			Severity string `json:"severity"`
+			Summary  string `json:"summary"`
+		} `json:"security_advisory"`
+		Dependency struct {
+			Package struct {
+				Ecosystem string `json:"ecosystem"`
+				Name      string `json:"name"`
+			} `json:"package"`
+		} `json:"dependency"`
+	}
+
+	member struct {
+		Login string `json:"login"`
+		ID    int64  `json:"id"`
+		Type  string `json:"type"`
+	}
+)
+
+var nextLinkRegexp = regexp.MustCompile(`<([^>]+)>;\s*rel="next"`)
+
+func (e *APIError) Error() string {
+	return fmt.Sprintf("github api returned status %d: %s", e.StatusCode, e.Message)
+}
+
+func isStatus(err error, statusCode int) bool {
+	var apiErr *APIError
+	return errors.As(err, &apiErr) && apiErr.StatusCode == statusCode
+}
+
+func (c *client) listRepositories(ctx context.Context) ([]repository, error) {
+	var repositories []repository
+
+	err := c.getAll(
+		ctx,
+		"/user/repos?affiliation=owner,organization_member&per_page=100",
+		func(data []byte) error {
+			var page []repository
+			if err := json.Unmarshal(data, &page); err != nil {
+				return err
+			}
+			repositories = append(repositories, page...)
+			return nil
+		},
+	)
+	if err != nil {
+		return nil, fmt.Errorf("cannot list repositories: %w", err)
+	}
+
+	return repositories, nil
+}
+
+// getBranchProtection returns nil without error when the branch is not
+// protected.
+func (c *client) getBranchProtection(ctx context.Context, repo repository) (*branchProtection, error) {
+	path := fmt.Sprintf(
+		"/repos/%s/%s/branches/%s/protection",
+		url.PathEscape(repo.Owner.Login),
+		url.PathEscape(repo.Name),
+		url.PathEscape(repo.DefaultBranch),
+	)
+
+	var protection branchProtection
+	if err := c.get(ctx, path, &protection); err != nil {
+		if isStatus(err, http.StatusNotFound) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("cannot get branch protection: %w", err)
+	}
+
+	return &protection, nil
+}
+
+func (c *client) listOpenDependabotAlerts(ctx context.Context, repo repository) ([]dependabotAlert, error) {
+	path := fmt.Sprintf(
+		"/repos/%s/%s/dependabot/alerts?state=open&per_page=100",
+		url.PathEscape(repo.Owner.Login),
+		url.PathEscape(repo.Name),
+	)
+
+	var alerts []dependabotAlert
+
+	err := c.getAll(
+		ctx,
+		path,
+		func(data []byte) error {
+			var page []dependabotAlert
+			if err := json.Unmarshal(data, &page); err != nil {
+				return err
+			}
+			alerts = append(alerts, page...)
+			return nil
+		},
+	)
+	if err != nil {
+		return nil, fmt.Errorf("cannot list dependabot alerts: %w", err)
+	}
+
+	return alerts, nil
+}
+
+func (c *client) listOrganizationAdmins(ctx context.Context, organization string) ([]member, error) {
+	path := fmt.Sprintf("/orgs/%s/members?role=admin&per_page=100", url.PathEscape(organization))
+
+	var members []member
+
+	err := c.getAll(
+		ctx,
+		path,
+		func(data []byte) error {
+			var page []member
+			if err := json.Unmarshal(data, &page); err != nil {
+				return err
+			}
+			members = append(members, page...)
+			return nil
+		},
+	)
+	if err != nil {
+		return nil, fmt.Errorf("cannot list organization admins: %w", err)
+	}
+
+	return members, nil
+}
+
+func (c *client) get(ctx context.Context, path string, v any) error {
+	data, _, err := c.do(ctx, c.apiURL+path)
+	if err != nil {
+		return err
+	}
+
+	if err := json.Unmarshal(data, v); err != nil {
+		return fmt.Errorf("cannot decode response: %w", err)
+	}
+
+	return nil
+}
+
+func (c *client) getAll(ctx context.Context, path string, fn func(data []byte) error) error {
+	next := c.apiURL + path
+
+	for next != "" {
+		data, header, err := c.do(ctx, next)
+		if err != nil {
+			return err
+		}
+
+		if err := fn(data); err != nil {
+			return fmt.Errorf("cannot decode response: %w", err)
+		}
+
+		next = ""
+		if m := nextLinkRegexp.FindStringSubmatch(header.Get("Link")); m != nil {
+			next = m[1]
+		}
+	}
+
+	return nil
+}
+
+func (c *client) do(ctx context.Context, rawURL string) ([]byte, http.Header, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, rawURL, nil)
+	if err != nil {
+		return nil, nil, fmt.Errorf("cannot create request: %w", err)
+	}
+
+	req.Header.Set("Accept", "application/vnd.github+json")
+	req.Header.Set("X-GitHub-Api-Version", "2022-11-28")
+	req.Header.Set("User-Agent", "Probo Connector")
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, nil, fmt.Errorf("cannot execute request: %w", err)
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	data, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, nil, fmt.Errorf("cannot read response body: %w", err)
+	}
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		var body struct {
+			Message string `json:"message"`
+		}
+		_ = json.Unmarshal(data, &body)
+
+		return nil, nil, &APIError{
+			StatusCode: resp.StatusCode,
+			Message:    strings.TrimSpace(body.Message),
+		}
+	}
+
+	return data, resp.Header, nil
+}
@@ -0,0 +1,411 @@
+// Copyright (c) 2025 Probo Inc <hello@getprobo.com>.
+//
+// Permission to use, copy, modify, and/or distribute this software for any
+// purpose with or without fee is hereby granted, provided that the above
+// copyright notice and this permission notice appear in all copies.
+//
+// THE SOFTWARE IS PROVIDED "AS IS" AND THE AUTHOR DISCLAIMS ALL WARRANTIES WITH
+// REGARD TO THIS SOFTWARE INCLUDING ALL IMPLIED WARRANTIES OF MERCHANTABILITY
+// AND FITNESS. IN NO EVENT SHALL THE AUTHOR BE LIABLE FOR ANY SPECIAL, DIRECT,
+// INDIRECT, OR CONSEQUENTIAL DAMAGES OR ANY DAMAGES WHATSOEVER RESULTING FROM
+// LOSS OF USE, DATA OR PROFITS, WHETHER IN AN ACTION OF CONTRACT, NEGLIGENCE OR
+// OTHER TORTIOUS ACTION, ARISING OUT OF OR IN CONNECTION WITH THE USE OR
+// PERFORMANCE OF THIS SOFTWARE.
+
+// Package github collects the security posture of the GitHub repositories
+// reachable through a GitHub connector.
+package github
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"slices"
+	"strings"
+	"time"
+
+	"go.probo.inc/probo/pkg/connector"
+)
+
+const (
+	DefaultAPIURL = "https://api.github.com"
+
+	EvidenceKeyRepositories     = "github.repositories"
+	EvidenceKeyBranchProtection = "github.branch-protection"
+	EvidenceKeyRequiredReviews  = "github.required-reviews"
+	EvidenceKeySecretScanning   = "github.secret-scanning"
+	EvidenceKeyDependabotAlerts = "github.dependabot-alerts"
+	EvidenceKeyAdminMembers     = "github.admin-members"
+)
+
+type (
+	Collector struct {
+		apiURL string
+	}
+
+	report[T any] struct {
+		CollectedAt time.Time `json:"collectedAt"`
+		Items       []T       `json:"items"`
+	}
+
+	repositoryReport struct {
+		Repository    string `json:"repository"`
+		Visibility    string `json:"visibility"`
+		DefaultBranch string `json:"defaultBranch"`
+		URL           string `json:"url"`
+	}
+
+	branchProtectionReport struct {
+		Repository            string   `json:"repository"`
+		Branch                string   `json:"branch"`
+		Protected             bool     `json:"protected"`
+		EnforceAdmins         bool     `json:"enforceAdmins"`
+		RequiredStatusChecks  []string `json:"requiredStatusChecks"`
+		StrictStatusChecks    bool     `json:"strictStatusChecks"`
+		AllowForcePushes      bool     `json:"allowForcePushes"`
+		AllowDeletions        bool     `json:"allowDeletions"`
+		RequiredSignatures    bool     `json:"requiredSignatures"`
+		RequiredLinearHistory bool     `json:"requiredLinearHistory"`
+		Error                 string   `json:"error,omitempty"`
+	}
+
+	requiredReviewsReport struct {
+		Repository                   string `json:"repository"`
+		Branch                       string `json:"branch"`
+		RequiredApprovingReviewCount int    `json:"requiredApprovingReviewCount"`
+		DismissStaleReviews          bool   `json:"dismissStaleReviews"`
+		RequireCodeOwnerReviews      bool   `json:"requireCodeOwnerReviews"`
+		Error                        string `json:"error,omitempty"`
+	}
+
+	secretScanningReport struct {
+		Repository     string `json:"repository"`
+		SecretScanning string `json:"secretScanning"`
+		PushProtection string `json:"pushProtection"`
+	}
+
+	dependabotAlertsReport struct {
+		Repository string         `json:"repository"`
+		Enabled    bool           `json:"enabled"`
+		Open       int            `json:"open"`
+		BySeverity map[string]int `json:"bySeverity"`
+		Alerts     []string       `json:"alerts"`
+		Error      string         `json:"error,omitempty"`
+	}
+
+	adminMembersReport struct {
+		Organization string   `json:"organization"`
+		Admins       []string `json:"admins"`
+		Error        string   `json:"error,omitempty"`
+	}
+)
+
+var _ connector.Collector = (*Collector)(nil)
+
+// NewCollector returns a collector for the GitHub API at apiURL. An empty
+// apiURL uses the public GitHub API; GitHub Enterprise Server installations
+// use https://<host>/api/v3.
+func NewCollector(apiURL string) *Collector {
+	if apiURL == "" {
+		apiURL = DefaultAPIURL
+	}
+
+	return &Collector{apiURL: strings.TrimSuffix(apiURL, "/")}
+}
+
+func (c *Collector) Collect(
+	ctx context.Context,
+	httpClient *http.Client,
+	_ connector.Connection,
+) ([]connector.CollectedEvidence, error) {
+	cl := &client{httpClient: httpClient, apiURL: c.apiURL}
+	now := time.Now()
+
+	repositories, err := cl.listRepositories(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	repositories = slices.DeleteFunc(
+		repositories,
+		func(r repository) bool { return r.Archived },
+	)
+
+	var (
+		repos          = report[repositoryReport]{CollectedAt: now}
+		protections    = report[branchProtectionReport]{CollectedAt: now}
+		reviews        = report[requiredReviewsReport]{CollectedAt: now}
+		secretScanning = report[secretScanningReport]{CollectedAt: now}
+		dependabot     = report[dependabotAlertsReport]{CollectedAt: now}
+		admins         = report[adminMembersReport]{CollectedAt: now}
+
+		protectionFindings     []connector.Finding
+		reviewFindings         []connector.Finding
+		secretScanningFindings []connector.Finding
+		dependabotFindings     []connector.Finding
+
+		organizations []string
+	)
+
+	for _, repo := range repositories {
+		repos.Items = append(
+			repos.Items,
+			repositoryReport{
+				Repository:    repo.FullName,
+				Visibility:    repositoryVisibility(repo),
+				DefaultBranch: repo.DefaultBranch,
+				URL:           repo.HTMLURL,
+			},
+		)
+
+		if repo.Owner.Type == "Organization" && !slices.Contains(organizations, repo.Owner.Login) {
+			organizations = append(organizations, repo.Owner.Login)
+		}
+
+		protection, protectionReport, reviewsReport := c.collectBranchProtection(ctx, cl, repo)
+		protections.Items = append(protections.Items, protectionReport)
+		reviews.Items = append(reviews.Items, reviewsReport)
+
+		if protectionReport.Error == "" && protection == nil {
+			protectionFindings = append(
+				protectionFindings,
+				connector.Finding{
+					Key:         "branch-protection:" + repo.FullName,
+					Title:       fmt.Sprintf("Default branch of %s is not protected", repo.FullName),
+					Description: fmt.Sprintf("The default branch %q of %s has no branch protection rule.", repo.DefaultBranch, repo.FullName),
+				},
+			)
+		}
+
+		if reviewsReport.Error == "" && reviewsReport.RequiredApprovingReviewCount < 1 {
+			reviewFindings = append(
+				reviewFindings,
+				connector.Finding{
+					Key:         "required-reviews:" + repo.FullName,
+					Title:       fmt.Sprintf("Pull requests to %s do not require review", repo.FullName),
+					Description: fmt.Sprintf("Changes to the default branch %q of %s can be merged without an approving review.", repo.DefaultBranch, repo.FullName),
+				},
+			)
+		}
+
+		scanningReport := secretScanningReport{
+			Repository:     repo.FullName,
+			SecretScanning: "unknown",
+			PushProtection: "unknown",
+		}
+		if sa := repo.SecurityAndAnalysis; sa != nil {
+			if sa.SecretScanning != nil {
+				scanningReport.SecretScanning = sa.SecretScanning.Status
+			}
+			if sa.SecretScanningPushProtection != nil {
+				scanningReport.PushProtection = sa.SecretScanningPushProtection.Status
+			}
+		}
+		secretScanning.Items = append(secretScanning.Items, scanningReport)
+
+		if scanningReport.SecretScanning == "disabled" {
+			secretScanningFindings = append(
+				secretScanningFindings,
+				connector.Finding{
+					Key:         "secret-scanning:" + repo.FullName,
+					Title:       fmt.Sprintf("Secret scanning is disabled on %s", repo.FullName),
+					Description: fmt.Sprintf("Secret scanning is not enabled for %s, leaked credentials will not be detected.", repo.FullName),
+				},
+			)
+		}
+
+		alertsReport := c.collectDependabotAlerts(ctx, cl, repo)
+		dependabot.Items = append(dependabot.Items, alertsReport)
+
+		switch {
+		case alertsReport.Error == "" && !alertsReport.Enabled:
+			dependabotFindings = append(
+				dependabotFindings,
+				connector.Finding{
+					Key:         "dependabot-disabled:" + repo.FullName,
+					Title:       fmt.Sprintf("Dependabot alerts are disabled on %s", repo.FullName),
+					Description: fmt.Sprintf("Vulnerable dependencies of %s are not reported because Dependabot alerts are disabled.", repo.FullName),
+				},
+			)
+		case alertsReport.BySeverity["critical"]+alertsReport.BySeverity["high"] > 0:
+			dependabotFindings = append(
+				dependabotFindings,
+				connector.Finding{
+					Key:   "dependabot-alerts:" + repo.FullName,
+					Title: fmt.Sprintf("%s has open critical or high Dependabot alerts", repo.FullName),
+					Description: fmt.Sprintf(
+						"%s has %d critical and %d high severity open Dependabot alerts.",
+						repo.FullName,
+						alertsReport.BySeverity["critical"],
+						alertsReport.BySeverity["high"],
+					),
+				},
+			)
+		}
+	}
+
+	for _, organization := range organizations {
+		adminsReport := adminMembersReport{Organization: organization, Admins: []string{}}
+
+		members, err := cl.listOrganizationAdmins(ctx, organization)
+		if err != nil {
+			adminsReport.Error = err.Error()
+		}
+		for _, m := range members {
+			adminsReport.Admins = append(adminsReport.Admins, m.Login)
+		}
+
+		admins.Items = append(admins.Items, adminsReport)
+	}
+
+	return []connector.CollectedEvidence{
+		{
+			Key:         EvidenceKeyRepositories,
+			Filename:    "github-repositories.json",
+			Description: "GitHub repositories inventory",
+			Data:        repos,
+		},
+		{
+			Key:         EvidenceKeyBranchProtection,
+			Filename:    "github-branch-protection.json",
+			Description: "GitHub default branch protection rules",
+			Data:        protections,
+			Findings:    protectionFindings,
+		},
+		{
+			Key:         EvidenceKeyRequiredReviews,
+			Filename:    "github-required-reviews.json",
+			Description: "GitHub required pull request reviews",
+			Data:        reviews,
+			Findings:    reviewFindings,
+		},
+		{
+			Key:         EvidenceKeySecretScanning,
+			Filename:    "github-secret-scanning.json",
+			Description: "GitHub secret scanning status",
+			Data:        secretScanning,
+			Findings:    secretScanningFindings,
+		},
+		{
+			Key:         EvidenceKeyDependabotAlerts,
+			Filename:    "github-dependabot-alerts.json",
+			Description: "GitHub open Dependabot alerts",
+			Data:        dependabot,
+			Findings:    dependabotFindings,
+		},
+		{
+			Key:         EvidenceKeyAdminMembers,
+			Filename:    "github-admin-members.json",
+			Description: "GitHub organization administrators",
+			Data:        admins,
+		},
+	}, nil
+}
+
+func (c *Collector) collectBranchProtection(
+	ctx context.Context,
+	cl *client,
+	repo repository,
+) (*branchProtection, branchProtectionReport, requiredReviewsReport) {
+	protectionReport := branchProtectionReport{
+		Repository:           repo.FullName,
+		Branch:               repo.DefaultBranch,
+		RequiredStatusChecks: []string{},
+	}
+	reviewsReport := requiredReviewsReport{
+		Repository: repo.FullName,
+		Branch:     repo.DefaultBranch,
+	}
+
+	protection, err := cl.getBranchProtection(ctx, repo)
+	if err != nil {
+		protectionReport.Error = err.Error()
+		reviewsReport.Error = err.Error()
+		return nil, protectionReport, reviewsReport
+	}
+
+	if protection == nil {
+		return nil, protectionReport, reviewsReport
+	}
+
+	protectionReport.Protected = true
+	protectionReport.EnforceAdmins = isEnabled(protection.EnforceAdmins)
+	protectionReport.AllowForcePushes = isEnabled(protection.AllowForcePushes)
+	protectionReport.AllowDeletions = isEnabled(protection.AllowDeletions)
+	protectionReport.RequiredSignatures = isEnabled(protection.RequiredSignatures)
+	protectionReport.RequiredLinearHistory = isEnabled(protection.RequiredLinearHistory)
+	if checks := protection.RequiredStatusChecks; checks != nil {
+		protectionReport.StrictStatusChecks = checks.Strict
+		if checks.Contexts != nil {
+			protectionReport.RequiredStatusChecks = checks.Contexts
+		}
+	}
+
+	if prReviews := protection.RequiredPullRequestReviews; prReviews != nil {
+		reviewsReport.RequiredApprovingReviewCount = prReviews.RequiredApprovingReviewCount
+		reviewsReport.DismissStaleReviews = prReviews.DismissStaleReviews
+		reviewsReport.RequireCodeOwnerReviews = prReviews.RequireCodeOwnerReviews
+	}
+
+	return protection, protectionReport, reviewsReport
+}
+
+func (c *Collector) collectDependabotAlerts(
+	ctx context.Context,
+	cl *client,
+	repo repository,
+) dependabotAlertsReport {
+	alertsReport := dependabotAlertsReport{
+		Repository: repo.FullName,
+		Enabled:    true,
+		BySeverity: map[string]int{},
+		Alerts:     []string{},
+	}
+
+	alerts, err := cl.listOpenDependabotAlerts(ctx, repo)
+	if err != nil {
+		// GitHub answers 403 when Dependabot alerts are disabled for the
+		// repository.
+		if isStatus(err, http.StatusForbidden) && strings.Contains(strings.ToLower(err.Error()), "disabled") {
+			alertsReport.Enabled = false
+			return alertsReport
+		}
+
+		alertsReport.Error = err.Error()
+		return alertsReport
+	}
+
+	for _, alert := range alerts {
+		alertsReport.Open++
+		alertsReport.BySeverity[alert.SecurityAdvisory.Severity]++
+		alertsReport.Alerts = append(
+			alertsReport.Alerts,
+			fmt.Sprintf(
+				"%s %s (%s) in %s/%s",
+				alert.SecurityAdvisory.GHSAID,
+				alert.SecurityAdvisory.Severity,
+				alert.SecurityAdvisory.Summary,
+				alert.Dependency.Package.Ecosystem,
+				alert.Dependency.Package.Name,
+			),
+		)
+	}
+
+	return alertsReport
+}
+
+func repositoryVisibility(repo repository) string {
+	if repo.Visibility != "" {
+		return repo.Visibility
+	}
+
+	if repo.Private {
+		return "private"
+	}
+
+	return "public"
+}
+
+func isEnabled(s *enabledSetting) bool {
+	return s != nil && s.Enabled
+}
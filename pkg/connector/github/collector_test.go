@@ -0,0 +1,102 @@
+// Copyright (c) 2025 Probo Inc <hello@getprobo.com>.
+//
+// Permission to use, copy, modify, and/or distribute this software for any
+// purpose with or without fee is hereby granted, provided that the above
+// copyright notice and this permission notice appear in all copies.
+//
+// THE SOFTWARE IS PROVIDED "AS IS" AND THE AUTHOR DISCLAIMS ALL WARRANTIES WITH
+// REGARD TO THIS SOFTWARE INCLUDING ALL IMPLIED WARRANTIES OF MERCHANTABILITY
+// AND FITNESS. IN NO EVENT SHALL THE AUTHOR BE LIABLE FOR ANY SPECIAL, DIRECT,
+// INDIRECT, OR CONSEQUENTIAL DAMAGES OR ANY DAMAGES WHATSOEVER RESULTING FROM
+// LOSS OF USE, DATA OR PROFITS, WHETHER IN AN ACTION OF CONTRACT, NEGLIGENCE OR
+// OTHER TORTIOUS ACTION, ARISING OUT OF OR IN CONNECTION WITH THE USE OR
+// PERFORMANCE OF THIS SOFTWARE.
+
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.probo.inc/probo/pkg/connector"
+)
+
+func TestCollect(t *testing.T) {
+	t.Parallel()
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /user/repos", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`[
+			{"name": "api", "full_name": "acme/api", "visibility": "private", "default_branch": "main",
+			 "owner": {"login": "acme", "type": "Organization"},
+			 "security_and_analysis": {"secret_scanning": {"status": "enabled"}}},
+			{"name": "web", "full_name": "acme/web", "visibility": "public", "default_branch": "main",
+			 "owner": {"login": "acme", "type": "Organization"},
+			 "security_and_analysis": {"secret_scanning": {"status": "disabled"}}},
+			{"name": "old", "full_name": "acme/old", "archived": true, "default_branch": "main",
+			 "owner": {"login": "acme", "type": "Organization"}}
+		]`))
+	})
+	mux.HandleFunc("GET /repos/acme/api/branches/main/protection", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{
+			"required_pull_request_reviews": {"required_approving_review_count": 2},
+			"enforce_admins": {"enabled": true}
+		}`))
+	})
+	mux.HandleFunc("GET /repos/acme/web/branches/main/protection", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+		_, _ = w.Write([]byte(`{"message": "Branch not protected"}`))
+	})
+	mux.HandleFunc("GET /repos/acme/api/dependabot/alerts", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`[
+			{"number": 1, "state": "open", "security_advisory": {"ghsa_id": "GHSA-1", "severity": "critical"}},
+			{"number": 2, "state": "open", "security_advisory": {"ghsa_id": "GHSA-2", "severity": "low"}}
+		]`))
+	})
+	mux.HandleFunc("GET /repos/acme/web/dependabot/alerts", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusForbidden)
+		_, _ = w.Write([]byte(`{"message": "Dependabot alerts are disabled for this repository."}`))
+	})
+	mux.HandleFunc("GET /orgs/acme/members", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "admin", r.URL.Query().Get("role"))
+		_, _ = w.Write([]byte(`[{"login": "alice"}, {"login": "bob"}]`))
+	})
+
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	evidences, err := NewCollector(server.URL).Collect(context.Background(), server.Client(), nil)
+	require.NoError(t, err)
+
+	byKey := map[string]connector.CollectedEvidence{}
+	for _, e := range evidences {
+		byKey[e.Key] = e
+	}
+
+	findingKeys := func(key string) []string {
+		var keys []string
+		for _, f := range byKey[key].Findings {
+			keys = append(keys, f.Key)
+		}
+		return keys
+	}
+
+	assert.Len(t, byKey[EvidenceKeyRepositories].Data.(report[repositoryReport]).Items, 2)
+	assert.Equal(t, []string{"branch-protection:acme/web"}, findingKeys(EvidenceKeyBranchProtection))
+	assert.Equal(t, []string{"required-reviews:acme/web"}, findingKeys(EvidenceKeyRequiredReviews))
+	assert.Equal(t, []string{"secret-scanning:acme/web"}, findingKeys(EvidenceKeySecretScanning))
+	assert.ElementsMatch(
+		t,
+		[]string{"dependabot-alerts:acme/api", "dependabot-disabled:acme/web"},
+		findingKeys(EvidenceKeyDependabotAlerts),
+	)
+
+	admins, err := json.Marshal(byKey[EvidenceKeyAdminMembers].Data)
+	require.NoError(t, err)
+	assert.Contains(t, string(admins), `"admins":["alice","bob"]`)
+}
@@ -0,0 +1,78 @@
+// Copyright (c) 2025 Probo Inc <hello@getprobo.com>.
+//
+// Permission to use, copy, modify, and/or distribute this software for any
+// purpose with or without fee is hereby granted, provided that the above
+// copyright notice and this permission notice appear in all copies.
+//
+// THE SOFTWARE IS PROVIDED "AS IS" AND THE AUTHOR DISCLAIMS ALL WARRANTIES WITH
+// REGARD TO THIS SOFTWARE INCLUDING ALL IMPLIED WARRANTIES OF MERCHANTABILITY
+// AND FITNESS. IN NO EVENT SHALL THE AUTHOR BE LIABLE FOR ANY SPECIAL, DIRECT,
+// INDIRECT, OR CONSEQUENTIAL DAMAGES OR ANY DAMAGES WHATSOEVER RESULTING FROM
+// LOSS OF USE, DATA OR PROFITS, WHETHER IN AN ACTION OF CONTRACT, NEGLIGENCE OR
+// OTHER TORTIOUS ACTION, ARISING OUT OF OR IN CONNECTION WITH THE USE OR
+// PERFORMANCE OF THIS SOFTWARE.
+
+package connector
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+
+	"go.gearno.de/kit/httpclient"
+)
+
+type (
+	// OktaConnection holds an Okta API token and the Okta organization
+	// domain (e.g. acme.okta.com) it was issued for.
+	OktaConnection struct {
+		Domain   string `json:"domain"`
+		APIToken string `json:"api_token"`
+	}
+)
+
+const (
+	OktaProvider = "OKTA"
+)
+
+var _ Connection = (*OktaConnection)(nil)
+
+func (c *OktaConnection) Type() ProtocolType {
+	return ProtocolAPIToken
+}
+
+func (c *OktaConnection) Client(ctx context.Context) (*http.Client, error) {
+	return c.ClientWithOptions(ctx)
+}
+
+// ClientWithOptions returns an HTTP client authenticating every request
+// with the Okta SSWS API token.
+func (c *OktaConnection) ClientWithOptions(ctx context.Context, opts ...httpclient.Option) (*http.Client, error) {
+	transport := &oauth2Transport{
+		token:      c.APIToken,
+		tokenType:  "SSWS",
+		underlying: httpclient.DefaultPooledTransport(opts...),
+	}
+	return &http.Client{Transport: transport}, nil
+}
+
+func (c OktaConnection) MarshalJSON() ([]byte, error) {
+	type Alias OktaConnection
+	return json.Marshal(&struct {
+		Type string `json:"type"`
+		Alias
+	}{
+		Type:  string(ProtocolAPIToken),
+		Alias: Alias(c),
+	})
+}
+
+func (c *OktaConnection) UnmarshalJSON(data []byte) error {
+	type Alias OktaConnection
+	aux := &struct {
+		*Alias
+	}{
+		Alias: (*Alias)(c),
+	}
+	return json.Unmarshal(data, &aux)
+}
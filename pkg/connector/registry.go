@@ -27,12 +27,14 @@ type (
 	ConnectorRegistry struct {
 		sync.RWMutex
 		connectors map[string]Connector
+		collectors map[string]Collector
 	}
 )
 
 func NewConnectorRegistry() *ConnectorRegistry {
 	return &ConnectorRegistry{
 		connectors: make(map[string]Connector),
+		collectors: make(map[string]Collector),
 	}
 }
 
@@ -56,6 +58,40 @@ func (cr *ConnectorRegistry) Get(provider string) (Connector, error) {
 	return connector, nil
 }
 
+// RegisterCollector registers the evidence collector for a provider.
+func (cr *ConnectorRegistry) RegisterCollector(provider string, collector Collector) error {
+	cr.Lock()
+	defer cr.Unlock()
+	if _, ok := cr.collectors[provider]; ok {
+		return fmt.Errorf("collector %q already registered", provider)
+	}
+	cr.collectors[provider] = collector
+	return nil
+}
+
+// GetCollector returns the evidence collector registered for a provider.
+func (cr *ConnectorRegistry) GetCollector(provider string) (Collector, error) {
+	cr.RLock()
+	defer cr.RUnlock()
+	collector, ok := cr.collectors[provider]
+	if !ok {
+		return nil, fmt.Errorf("collector %q not found", provider)
+	}
+	return collector, nil
+}
+
+// CollectorProviders returns the providers that have an evidence collector
+// registered.
+func (cr *ConnectorRegistry) CollectorProviders() []string {
+	cr.RLock()
+	defer cr.RUnlock()
+	providers := make([]string, 0, len(cr.collectors))
+	for provider := range cr.collectors {
+		providers = append(providers, provider)
+	}
+	return providers
+}
+
 func (cr *ConnectorRegistry) Initiate(ctx context.Context, provider string, organizationID gid.GID, r *http.Request) (string, error) {
 	connector, err := cr.Get(provider)
 	if err != nil {
@@ -0,0 +1,349 @@
+// Copyright (c) 2025 Probo Inc <hello@getprobo.com>.
+//
+// Permission to use, copy, modify, and/or distribute this software for any
+// purpose with or without fee is hereby granted, provided that the above
+// copyright notice and this permission notice appear in all copies.
+//
+// THE SOFTWARE IS PROVIDED "AS IS" AND THE AUTHOR DISCLAIMS ALL WARRANTIES WITH
+// REGARD TO THIS SOFTWARE INCLUDING ALL IMPLIED WARRANTIES OF MERCHANTABILITY
+// AND FITNESS. IN NO EVENT SHALL THE AUTHOR BE LIABLE FOR ANY SPECIAL, DIRECT,
+// INDIRECT, OR CONSEQUENTIAL DAMAGES OR ANY DAMAGES WHATSOEVER RESULTING FROM
+// LOSS OF USE, DATA OR PROFITS, WHETHER IN AN ACTION OF CONTRACT, NEGLIGENCE OR
+// OTHER TORTIOUS ACTION, ARISING OUT OF OR IN CONNECTION WITH THE USE OR
+// PERFORMANCE OF THIS SOFTWARE.
+
+package coredata
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"maps"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"go.gearno.de/kit/pg"
+	"go.probo.inc/probo/pkg/gid"
+)
+
+type (
+	// AcknowledgementCampaign periodically asks members to sign the current
+	// published version of a document again. RecipientProfileIDs restricts
+	// the campaign to some members, every active member is asked when it is
+	// empty.
+	AcknowledgementCampaign struct {
+		ID                  gid.GID    `db:"id"`
+		OrganizationID      gid.GID    `db:"organization_id"`
+		DocumentID          gid.GID    `db:"document_id"`
+		RecipientProfileIDs []gid.GID  `db:"recipient_profile_ids"`
+		IntervalDays        int        `db:"interval_days"`
+		Enabled             bool       `db:"enabled"`
+		NextRunAt           time.Time  `db:"next_run_at"`
+		LastRunAt           *time.Time `db:"last_run_at"`
+		LastError           *string    `db:"last_error"`
+		CreatedAt           time.Time  `db:"created_at"`
+		UpdatedAt           time.Time  `db:"updated_at"`
+	}
+
+	AcknowledgementCampaigns []*AcknowledgementCampaign
+)
+
+var ErrNoAcknowledgementCampaignDue = errors.New("no acknowledgement campaign due")
+
+// AuthorizationAttributes returns the authorization attributes for policy evaluation.
+func (c *AcknowledgementCampaign) AuthorizationAttributes(ctx context.Context, conn pg.Conn) (map[string]string, error) {
+	q := `SELECT organization_id FROM acknowledgement_campaigns WHERE id = $1 LIMIT 1;`
+
+	var organizationID gid.GID
+	if err := conn.QueryRow(ctx, q, c.ID).Scan(&organizationID); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrResourceNotFound
+		}
+		return nil, fmt.Errorf("cannot query acknowledgement campaign authorization attributes: %w", err)
+	}
+
+	return map[string]string{"organization_id": organizationID.String()}, nil
+}
+
+func (c *AcknowledgementCampaign) LoadByID(
+	ctx context.Context,
+	conn pg.Conn,
+	scope Scoper,
+	campaignID gid.GID,
+) error {
+	q := `
+SELECT
+    id,
+    organization_id,
+    document_id,
+    recipient_profile_ids,
+    interval_days,
+    enabled,
+    next_run_at,
+    last_run_at,
+    last_error,
+    created_at,
+    updated_at
+FROM
+    acknowledgement_campaigns
+WHERE
+    %s
+    AND id = @id
+LIMIT 1;
+`
+
+	q = fmt.Sprintf(q, scope.SQLFragment())
+
+	args := pgx.StrictNamedArgs{"id": campaignID}
+	maps.Copy(args, scope.SQLArguments())
+
+	rows, err := conn.Query(ctx, q, args)
+	if err != nil {
+		return fmt.Errorf("cannot query acknowledgement campaign: %w", err)
+	}
+
+	campaign, err := pgx.CollectExactlyOneRow(rows, pgx.RowToStructByName[AcknowledgementCampaign])
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return ErrResourceNotFound
+		}
+		return fmt.Errorf("cannot collect acknowledgement campaign: %w", err)
+	}
+
+	*c = campaign
+
+	return nil
+}
+
+func (cs *AcknowledgementCampaigns) LoadByDocumentID(
+	ctx context.Context,
+	conn pg.Conn,
+	scope Scoper,
+	documentID gid.GID,
+) error {
+	q := `
+SELECT
+    id,
+    organization_id,
+    document_id,
+    recipient_profile_ids,
+    interval_days,
+    enabled,
+    next_run_at,
+    last_run_at,
+    last_error,
+    created_at,
+    updated_at
+FROM
+    acknowledgement_campaigns
+WHERE
+    %s
+    AND document_id = @document_id
+ORDER BY
+    created_at ASC,
+    id ASC
+`
+
+	q = fmt.Sprintf(q, scope.SQLFragment())
+
+	args := pgx.StrictNamedArgs{"document_id": documentID}
+	maps.Copy(args, scope.SQLArguments())
+
+	rows, err := conn.Query(ctx, q, args)
+	if err != nil {
+		return fmt.Errorf("cannot query acknowledgement campaigns: %w", err)
+	}
+
+	campaigns, err := pgx.CollectRows(rows, pgx.RowToAddrOfStructByName[AcknowledgementCampaign])
+	if err != nil {
+		return fmt.Errorf("cannot collect acknowledgement campaigns: %w", err)
+	}
+
+	*cs = campaigns
+
+	return nil
+}
+
+// LoadNextDueForUpdateSkipLocked locks the enabled campaign whose next run
+// is the most overdue, skipping campaigns locked by other workers. It
+// returns ErrNoAcknowledgementCampaignDue when no campaign is due.
+func (c *AcknowledgementCampaign) LoadNextDueForUpdateSkipLocked(
+	ctx context.Context,
+	conn pg.Conn,
+	now time.Time,
+) error {
+	q := `
+SELECT
+    id,
+    organization_id,
+    document_id,
+    recipient_profile_ids,
+    interval_days,
+    enabled,
+    next_run_at,
+    last_run_at,
+    last_error,
+    created_at,
+    updated_at
+FROM
+    acknowledgement_campaigns
+WHERE
+    enabled
+    AND next_run_at <= @now
+ORDER BY
+    next_run_at ASC
+LIMIT 1
+FOR UPDATE SKIP LOCKED
+`
+
+	args := pgx.StrictNamedArgs{"now": now}
+
+	rows, err := conn.Query(ctx, q, args)
+	if err != nil {
+		return fmt.Errorf("cannot query acknowledgement campaigns: %w", err)
+	}
+
+	campaign, err := pgx.CollectExactlyOneRow(rows, pgx.RowToStructByName[AcknowledgementCampaign])
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return ErrNoAcknowledgementCampaignDue
+		}
+		return fmt.Errorf("cannot collect acknowledgement campaign: %w", err)
+	}
+
+	*c = campaign
+
+	return nil
+}
+
+func (c *AcknowledgementCampaign) Insert(
+	ctx context.Context,
+	conn pg.Conn,
+	scope Scoper,
+) error {
+	q := `
+INSERT INTO acknowledgement_campaigns (
+    id,
+    tenant_id,
+    organization_id,
+    document_id,
+    recipient_profile_ids,
+    interval_days,
+    enabled,
+    next_run_at,
+    last_run_at,
+    last_error,
+    created_at,
+    updated_at
+) VALUES (
+    @id,
+    @tenant_id,
+    @organization_id,
+    @document_id,
+    @recipient_profile_ids,
+    @interval_days,
+    @enabled,
+    @next_run_at,
+    @last_run_at,
+    @last_error,
+    @created_at,
+    @updated_at
+)
+`
+
+	args := pgx.StrictNamedArgs{
+		"id":                    c.ID,
+		"tenant_id":             scope.GetTenantID(),
+		"organization_id":       c.OrganizationID,
+		"document_id":           c.DocumentID,
+		"recipient_profile_ids": c.RecipientProfileIDs,
+		"interval_days":         c.IntervalDays,
+		"enabled":               c.Enabled,
+		"next_run_at":           c.NextRunAt,
+		"last_run_at":           c.LastRunAt,
+		"last_error":            c.LastError,
+		"created_at":            c.CreatedAt,
+		"updated_at":            c.UpdatedAt,
+	}
+
+	if _, err := conn.Exec(ctx, q, args); err != nil {
+		return fmt.Errorf("cannot insert acknowledgement campaign: %w", err)
+	}
+
+	return nil
+}
+
+func (c *AcknowledgementCampaign) Update(
+	ctx context.Context,
+	conn pg.Conn,
+	scope Scoper,
+) error {
+	q := `
+UPDATE acknowledgement_campaigns
+SET
+    recipient_profile_ids = @recipient_profile_ids,
+    interval_days = @interval_days,
+    enabled = @enabled,
+    next_run_at = @next_run_at,
+    last_run_at = @last_run_at,
+    last_error = @last_error,
+    updated_at = @updated_at
+WHERE
+    %s
+    AND id = @id
+`
+
+	q = fmt.Sprintf(q, scope.SQLFragment())
+
+	args := pgx.StrictNamedArgs{
+		"id":                    c.ID,
+		"recipient_profile_ids": c.RecipientProfileIDs,
+		"interval_days":         c.IntervalDays,
+		"enabled":               c.Enabled,
+		"next_run_at":           c.NextRunAt,
+		"last_run_at":           c.LastRunAt,
+		"last_error":            c.LastError,
+		"updated_at":            c.UpdatedAt,
+	}
+	maps.Copy(args, scope.SQLArguments())
+
+	result, err := conn.Exec(ctx, q, args)
+	if err != nil {
+		return fmt.Errorf("cannot update acknowledgement campaign: %w", err)
+	}
+
+	if result.RowsAffected() == 0 {
+		return ErrResourceNotFound
+	}
+
+	return nil
+}
+
+func (c *AcknowledgementCampaign) Delete(
+	ctx context.Context,
+	conn pg.Conn,
+	scope Scoper,
+) error {
+	q := `
+DELETE FROM acknowledgement_campaigns
+WHERE
+    %s
+    AND id = @id
+`
+
+	q = fmt.Sprintf(q, scope.SQLFragment())
+
+	args := pgx.StrictNamedArgs{"id": c.ID}
+	maps.Copy(args, scope.SQLArguments())
+
+	result, err := conn.Exec(ctx, q, args)
+	if err != nil {
+		return fmt.Errorf("cannot delete acknowledgement campaign: %w", err)
+	}
+
+	if result.RowsAffected() == 0 {
+		return ErrResourceNotFound
+	}
+
+	return nil
+}
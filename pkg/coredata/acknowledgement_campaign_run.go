@@ -0,0 +1,238 @@
+// Copyright (c) 2025 Probo Inc <hello@getprobo.com>.
+//
+// Permission to use, copy, modify, and/or distribute this software for any
+// purpose with or without fee is hereby granted, provided that the above
+// copyright notice and this permission notice appear in all copies.
+//
+// THE SOFTWARE IS PROVIDED "AS IS" AND THE AUTHOR DISCLAIMS ALL WARRANTIES WITH
+// REGARD TO THIS SOFTWARE INCLUDING ALL IMPLIED WARRANTIES OF MERCHANTABILITY
+// AND FITNESS. IN NO EVENT SHALL THE AUTHOR BE LIABLE FOR ANY SPECIAL, DIRECT,
+// INDIRECT, OR CONSEQUENTIAL DAMAGES OR ANY DAMAGES WHATSOEVER RESULTING FROM
+// LOSS OF USE, DATA OR PROFITS, WHETHER IN AN ACTION OF CONTRACT, NEGLIGENCE OR
+// OTHER TORTIOUS ACTION, ARISING OUT OF OR IN CONNECTION WITH THE USE OR
+// PERFORMANCE OF THIS SOFTWARE.
+
+package coredata
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"maps"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"go.gearno.de/kit/pg"
+	"go.probo.inc/probo/pkg/gid"
+)
+
+type (
+	// AcknowledgementCampaignRun is one round of an acknowledgement
+	// campaign, along with the signatures it requested.
+	AcknowledgementCampaignRun struct {
+		ID                gid.GID   `db:"id"`
+		OrganizationID    gid.GID   `db:"organization_id"`
+		CampaignID        gid.GID   `db:"campaign_id"`
+		DocumentVersionID gid.GID   `db:"document_version_id"`
+		StartedAt         time.Time `db:"started_at"`
+		CreatedAt         time.Time `db:"created_at"`
+	}
+
+	AcknowledgementCampaignRunCompletion struct {
+		TotalCount  int `db:"total_count"`
+		SignedCount int `db:"signed_count"`
+	}
+)
+
+// AuthorizationAttributes returns the authorization attributes for policy evaluation.
+func (r *AcknowledgementCampaignRun) AuthorizationAttributes(ctx context.Context, conn pg.Conn) (map[string]string, error) {
+	q := `SELECT organization_id FROM acknowledgement_campaign_runs WHERE id = $1 LIMIT 1;`
+
+	var organizationID gid.GID
+	if err := conn.QueryRow(ctx, q, r.ID).Scan(&organizationID); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrResourceNotFound
+		}
+		return nil, fmt.Errorf("cannot query acknowledgement campaign run authorization attributes: %w", err)
+	}
+
+	return map[string]string{"organization_id": organizationID.String()}, nil
+}
+
+func (r *AcknowledgementCampaignRun) LoadLatestByCampaignID(
+	ctx context.Context,
+	conn pg.Conn,
+	scope Scoper,
+	campaignID gid.GID,
+) error {
+	q := `
+SELECT
+    id,
+    organization_id,
+    campaign_id,
+    document_version_id,
+    started_at,
+    created_at
+FROM
+    acknowledgement_campaign_runs
+WHERE
+    %s
+    AND campaign_id = @campaign_id
+ORDER BY
+    started_at DESC
+LIMIT 1;
+`
+
+	q = fmt.Sprintf(q, scope.SQLFragment())
+
+	args := pgx.StrictNamedArgs{"campaign_id": campaignID}
+	maps.Copy(args, scope.SQLArguments())
+
+	rows, err := conn.Query(ctx, q, args)
+	if err != nil {
+		return fmt.Errorf("cannot query acknowledgement campaign run: %w", err)
+	}
+
+	run, err := pgx.CollectExactlyOneRow(rows, pgx.RowToStructByName[AcknowledgementCampaignRun])
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return ErrResourceNotFound
+		}
+		return fmt.Errorf("cannot collect acknowledgement campaign run: %w", err)
+	}
+
+	*r = run
+
+	return nil
+}
+
+func (r *AcknowledgementCampaignRun) Insert(
+	ctx context.Context,
+	conn pg.Conn,
+	scope Scoper,
+) error {
+	q := `
+INSERT INTO acknowledgement_campaign_runs (
+    id,
+    tenant_id,
+    organization_id,
+    campaign_id,
+    document_version_id,
+    started_at,
+    created_at
+) VALUES (
+    @id,
+    @tenant_id,
+    @organization_id,
+    @campaign_id,
+    @document_version_id,
+    @started_at,
+    @created_at
+)
+`
+
+	args := pgx.StrictNamedArgs{
+		"id":                  r.ID,
+		"tenant_id":           scope.GetTenantID(),
+		"organization_id":     r.OrganizationID,
+		"campaign_id":         r.CampaignID,
+		"document_version_id": r.DocumentVersionID,
+		"started_at":          r.StartedAt,
+		"created_at":          r.CreatedAt,
+	}
+
+	if _, err := conn.Exec(ctx, q, args); err != nil {
+		return fmt.Errorf("cannot insert acknowledgement campaign run: %w", err)
+	}
+
+	return nil
+}
+
+// InsertSignatures links the signatures requested by the run to it.
+func (r *AcknowledgementCampaignRun) InsertSignatures(
+	ctx context.Context,
+	conn pg.Conn,
+	scope Scoper,
+	signatureIDs []gid.GID,
+) error {
+	q := `
+INSERT INTO acknowledgement_campaign_run_signatures (
+    run_id,
+    document_version_signature_id,
+    tenant_id,
+    created_at
+)
+SELECT
+    @run_id,
+    signature_id,
+    @tenant_id,
+    @created_at
+FROM
+    unnest(@signature_ids::text[]) AS signature_id
+ON CONFLICT DO NOTHING
+`
+
+	args := pgx.StrictNamedArgs{
+		"run_id":        r.ID,
+		"tenant_id":     scope.GetTenantID(),
+		"signature_ids": signatureIDs,
+		"created_at":    r.CreatedAt,
+	}
+
+	if _, err := conn.Exec(ctx, q, args); err != nil {
+		return fmt.Errorf("cannot insert acknowledgement campaign run signatures: %w", err)
+	}
+
+	return nil
+}
+
+// LoadByRunID counts the signatures requested by the run and the ones
+// already signed.
+func (c *AcknowledgementCampaignRunCompletion) LoadByRunID(
+	ctx context.Context,
+	conn pg.Conn,
+	scope Scoper,
+	runID gid.GID,
+) error {
+	q := `
+SELECT
+    COUNT(dvs.id) AS total_count,
+    COUNT(dvs.id) FILTER (WHERE dvs.state = 'SIGNED') AS signed_count
+FROM
+    acknowledgement_campaign_run_signatures rs
+INNER JOIN
+    document_version_signatures dvs ON dvs.id = rs.document_version_signature_id
+WHERE
+    rs.%s
+    AND rs.run_id = @run_id
+`
+
+	q = fmt.Sprintf(q, scope.SQLFragment())
+
+	args := pgx.StrictNamedArgs{"run_id": runID}
+	maps.Copy(args, scope.SQLArguments())
+
+	rows, err := conn.Query(ctx, q, args)
+	if err != nil {
+		return fmt.Errorf("cannot query acknowledgement campaign run completion: %w", err)
+	}
+
+	completion, err := pgx.CollectExactlyOneRow(rows, pgx.RowToStructByName[AcknowledgementCampaignRunCompletion])
+	if err != nil {
+		return fmt.Errorf("cannot collect acknowledgement campaign run completion: %w", err)
+	}
+
+	*c = completion
+
+	return nil
+}
+
+// Percentage returns the share of the requested signatures already signed,
+// between 0 and 100. A run that requested no signature is complete.
+func (c AcknowledgementCampaignRunCompletion) Percentage() float64 {
+	if c.TotalCount == 0 {
+		return 100
+	}
+
+	return float64(c.SignedCount) * 100 / float64(c.TotalCount)
+}
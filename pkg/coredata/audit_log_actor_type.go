@@ -0,0 +1,69 @@
+// Copyright (c) 2025 Probo Inc <hello@getprobo.com>.
+//
+// Permission to use, copy, modify, and/or distribute this software for any
+// purpose with or without fee is hereby granted, provided that the above
+// copyright notice and this permission notice appear in all copies.
+//
+// THE SOFTWARE IS PROVIDED "AS IS" AND THE AUTHOR DISCLAIMS ALL WARRANTIES WITH
+// REGARD TO THIS SOFTWARE INCLUDING ALL IMPLIED WARRANTIES OF MERCHANTABILITY
+// AND FITNESS. IN NO EVENT SHALL THE AUTHOR BE LIABLE FOR ANY SPECIAL, DIRECT,
+// INDIRECT, OR CONSEQUENTIAL DAMAGES OR ANY DAMAGES WHATSOEVER RESULTING FROM
+// LOSS OF USE, DATA OR PROFITS, WHETHER IN AN ACTION OF CONTRACT, NEGLIGENCE OR
+// OTHER TORTIOUS ACTION, ARISING OUT OF OR IN CONNECTION WITH THE USE OR
+// PERFORMANCE OF THIS SOFTWARE.
+
+package coredata
+
+import (
+	"database/sql/driver"
+	"fmt"
+)
+
+type AuditLogActorType string
+
+const (
+	AuditLogActorTypeUser   AuditLogActorType = "USER"
+	AuditLogActorTypeAPIKey AuditLogActorType = "API_KEY"
+	AuditLogActorTypeSystem AuditLogActorType = "SYSTEM"
+)
+
+func (t AuditLogActorType) String() string {
+	return string(t)
+}
+
+func (t AuditLogActorType) IsValid() bool {
+	switch t {
+	case AuditLogActorTypeUser,
+		AuditLogActorTypeAPIKey,
+		AuditLogActorTypeSystem:
+		return true
+	}
+	return false
+}
+
+func (t AuditLogActorType) MarshalText() ([]byte, error) {
+	return []byte(t.String()), nil
+}
+
+func (t *AuditLogActorType) UnmarshalText(text []byte) error {
+	*t = AuditLogActorType(text)
+	if !t.IsValid() {
+		return fmt.Errorf("%s is not a valid AuditLogActorType", string(text))
+	}
+	return nil
+}
+
+func (t *AuditLogActorType) Scan(value any) error {
+	switch v := value.(type) {
+	case string:
+		return t.UnmarshalText([]byte(v))
+	case []byte:
+		return t.UnmarshalText(v)
+	default:
+		return fmt.Errorf("unsupported type for AuditLogActorType: %T", value)
+	}
+}
+
+func (t AuditLogActorType) Value() (driver.Value, error) {
+	return t.String(), nil
+}
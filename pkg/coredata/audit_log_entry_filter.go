@@ -0,0 +1,78 @@
+// Copyright (c) 2025 Probo Inc <hello@getprobo.com>.
+//
+// Permission to use, copy, modify, and/or distribute this software for any
+// purpose with or without fee is hereby granted, provided that the above
+// copyright notice and this permission notice appear in all copies.
+//
+// THE SOFTWARE IS PROVIDED "AS IS" AND THE AUTHOR DISCLAIMS ALL WARRANTIES WITH
+// REGARD TO THIS SOFTWARE INCLUDING ALL IMPLIED WARRANTIES OF MERCHANTABILITY
+// AND FITNESS. IN NO EVENT SHALL THE AUTHOR BE LIABLE FOR ANY SPECIAL, DIRECT,
+// INDIRECT, OR CONSEQUENTIAL DAMAGES OR ANY DAMAGES WHATSOEVER RESULTING FROM
+// LOSS OF USE, DATA OR PROFITS, WHETHER IN AN ACTION OF CONTRACT, NEGLIGENCE OR
+// OTHER TORTIOUS ACTION, ARISING OUT OF OR IN CONNECTION WITH THE USE OR
+// PERFORMANCE OF THIS SOFTWARE.
+
+package coredata
+
+import (
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"go.probo.inc/probo/pkg/gid"
+)
+
+type (
+	AuditLogEntryFilter struct {
+		action     *string
+		actorID    *gid.GID
+		resourceID *gid.GID
+		from       *time.Time
+		to         *time.Time
+	}
+)
+
+func NewAuditLogEntryFilter() *AuditLogEntryFilter {
+	return &AuditLogEntryFilter{}
+}
+
+func (f *AuditLogEntryFilter) WithAction(action *string) *AuditLogEntryFilter {
+	f.action = action
+	return f
+}
+
+func (f *AuditLogEntryFilter) WithActorID(actorID *gid.GID) *AuditLogEntryFilter {
+	f.actorID = actorID
+	return f
+}
+
+func (f *AuditLogEntryFilter) WithResourceID(resourceID *gid.GID) *AuditLogEntryFilter {
+	f.resourceID = resourceID
+	return f
+}
+
+func (f *AuditLogEntryFilter) WithCreatedBetween(from *time.Time, to *time.Time) *AuditLogEntryFilter {
+	f.from = from
+	f.to = to
+	return f
+}
+
+func (f *AuditLogEntryFilter) SQLArguments() pgx.NamedArgs {
+	return pgx.NamedArgs{
+		"filter_action":      f.action,
+		"filter_actor_id":    f.actorID,
+		"filter_resource_id": f.resourceID,
+		"filter_from":        f.from,
+		"filter_to":          f.to,
+	}
+}
+
+func (f *AuditLogEntryFilter) SQLFragment() string {
+	return `
+(
+	(@filter_action::text IS NULL OR action = @filter_action::text)
+	AND (@filter_actor_id::text IS NULL OR actor_id = @filter_actor_id::text)
+	AND (@filter_resource_id::text IS NULL OR resource_id = @filter_resource_id::text)
+	AND (@filter_from::timestamptz IS NULL OR created_at >= @filter_from::timestamptz)
+	AND (@filter_to::timestamptz IS NULL OR created_at < @filter_to::timestamptz)
+)`
+}
@@ -46,6 +46,8 @@ type (
 	Connectors []*Connector
 )
 
+var ErrNoConnectorAvailable = errors.New("no connector available for evidence collection")
+
 func (c *Connector) CursorKey(orderBy ConnectorOrderField) page.CursorKey {
 	switch orderBy {
 	case ConnectorOrderFieldCreatedAt:
@@ -228,6 +230,60 @@ LIMIT 1;
 	return nil
 }
 
+// LoadNextForEvidenceCollectionSkipLocked locks the next connector having at
+// least one evidence mapping due for collection. Only connectors whose
+// provider is listed in providers are considered.
+func (c *Connector) LoadNextForEvidenceCollectionSkipLocked(
+	ctx context.Context,
+	conn pg.Conn,
+	providers []string,
+) error {
+	q := `
+SELECT
+    c.id,
+    c.organization_id,
+    c.provider,
+    c.protocol,
+    c.settings,
+    c.encrypted_connection,
+    c.created_at,
+    c.updated_at
+FROM
+    connectors c
+WHERE
+    c.provider::text = ANY(@providers::text[])
+    AND EXISTS (
+        SELECT 1
+        FROM connector_evidence_mappings m
+        WHERE m.connector_id = c.id
+            AND (m.next_collection_at IS NULL OR m.next_collection_at <= NOW())
+    )
+ORDER BY
+    c.created_at ASC
+LIMIT 1
+FOR UPDATE OF c SKIP LOCKED
+`
+
+	args := pgx.StrictNamedArgs{"providers": providers}
+
+	rows, err := conn.Query(ctx, q, args)
+	if err != nil {
+		return fmt.Errorf("cannot query connectors: %w", err)
+	}
+
+	loadedConnector, err := pgx.CollectExactlyOneRow(rows, pgx.RowToStructByName[Connector])
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return ErrNoConnectorAvailable
+		}
+		return fmt.Errorf("cannot collect connector row: %w", err)
+	}
+
+	*c = loadedConnector
+
+	return nil
+}
+
 func (c *Connector) Delete(
 	ctx context.Context,
 	conn pg.Conn,
@@ -0,0 +1,157 @@
+// Copyright (c) 2025 Probo Inc <hello@getprobo.com>.
+//
+// Permission to use, copy, modify, and/or distribute this software for any
+// purpose with or without fee is hereby granted, provided that the above
+// copyright notice and this permission notice appear in all copies.
+//
+// THE SOFTWARE IS PROVIDED "AS IS" AND THE AUTHOR DISCLAIMS ALL WARRANTIES WITH
+// REGARD TO THIS SOFTWARE INCLUDING ALL IMPLIED WARRANTIES OF MERCHANTABILITY
+// AND FITNESS. IN NO EVENT SHALL THE AUTHOR BE LIABLE FOR ANY SPECIAL, DIRECT,
+// INDIRECT, OR CONSEQUENTIAL DAMAGES OR ANY DAMAGES WHATSOEVER RESULTING FROM
+// LOSS OF USE, DATA OR PROFITS, WHETHER IN AN ACTION OF CONTRACT, NEGLIGENCE OR
+// OTHER TORTIOUS ACTION, ARISING OUT OF OR IN CONNECTION WITH THE USE OR
+// PERFORMANCE OF THIS SOFTWARE.
+
+package coredata
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"go.gearno.de/kit/pg"
+	"go.probo.inc/probo/pkg/gid"
+)
+
+type (
+	// ConnectorEvidenceFinding records that a finding reported by a
+	// collector for a mapping has already opened a task or a
+	// nonconformity, so later collections do not open it again.
+	ConnectorEvidenceFinding struct {
+		MappingID       gid.GID   `db:"mapping_id"`
+		FindingKey      string    `db:"finding_key"`
+		TaskID          *gid.GID  `db:"task_id"`
+		NonconformityID *gid.GID  `db:"nonconformity_id"`
+		CreatedAt       time.Time `db:"created_at"`
+	}
+
+	ConnectorEvidenceFindings []*ConnectorEvidenceFinding
+)
+
+func (f *ConnectorEvidenceFindings) LoadAllByMappingID(
+	ctx context.Context,
+	conn pg.Conn,
+	scope Scoper,
+	mappingID gid.GID,
+) error {
+	q := `
+SELECT
+    mapping_id,
+    finding_key,
+    task_id,
+    nonconformity_id,
+    created_at
+FROM
+    connector_evidence_findings
+WHERE
+    %s
+    AND mapping_id = @mapping_id
+`
+
+	q = fmt.Sprintf(q, scope.SQLFragment())
+
+	args := pgx.StrictNamedArgs{"mapping_id": mappingID}
+	maps.Copy(args, scope.SQLArguments())
+
+	rows, err := conn.Query(ctx, q, args)
+	if err != nil {
+		return fmt.Errorf("cannot query connector evidence findings: %w", err)
+	}
+
+	findings, err := pgx.CollectRows(rows, pgx.RowToAddrOfStructByName[ConnectorEvidenceFinding])
+	if err != nil {
+		return fmt.Errorf("cannot collect connector evidence findings: %w", err)
+	}
+
+	*f = findings
+
+	return nil
+}
+
+func (f *ConnectorEvidenceFinding) Insert(
+	ctx context.Context,
+	conn pg.Conn,
+	scope Scoper,
+) error {
+	q := `
+INSERT INTO connector_evidence_findings (
+    tenant_id,
+    mapping_id,
+    finding_key,
+    task_id,
+    nonconformity_id,
+    created_at
+) VALUES (
+    @tenant_id,
+    @mapping_id,
+    @finding_key,
+    @task_id,
+    @nonconformity_id,
+    @created_at
+)
+ON CONFLICT (mapping_id, finding_key) DO NOTHING
+`
+
+	args := pgx.StrictNamedArgs{
+		"tenant_id":        scope.GetTenantID(),
+		"mapping_id":       f.MappingID,
+		"finding_key":      f.FindingKey,
+		"task_id":          f.TaskID,
+		"nonconformity_id": f.NonconformityID,
+		"created_at":       f.CreatedAt,
+	}
+
+	if _, err := conn.Exec(ctx, q, args); err != nil {
+		return fmt.Errorf("cannot insert connector evidence finding: %w", err)
+	}
+
+	return nil
+}
+
+// DeleteResolvedByMappingID deletes the findings of a mapping that are not
+// part of the given keys anymore, so they open again if they reappear.
+func (f *ConnectorEvidenceFindings) DeleteResolvedByMappingID(
+	ctx context.Context,
+	conn pg.Conn,
+	scope Scoper,
+	mappingID gid.GID,
+	currentKeys []string,
+) error {
+	q := `
+DELETE FROM connector_evidence_findings
+WHERE
+    %s
+    AND mapping_id = @mapping_id
+    AND NOT (finding_key = ANY(@current_keys::text[]))
+`
+
+	q = fmt.Sprintf(q, scope.SQLFragment())
+
+	if currentKeys == nil {
+		currentKeys = []string{}
+	}
+
+	args := pgx.StrictNamedArgs{
+		"mapping_id":   mappingID,
+		"current_keys": currentKeys,
+	}
+	maps.Copy(args, scope.SQLArguments())
+
+	if _, err := conn.Exec(ctx, q, args); err != nil {
+		return fmt.Errorf("cannot delete resolved connector evidence findings: %w", err)
+	}
+
+	return nil
+}
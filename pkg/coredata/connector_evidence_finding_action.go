@@ -0,0 +1,79 @@
+// Copyright (c) 2025 Probo Inc <hello@getprobo.com>.
+//
+// Permission to use, copy, modify, and/or distribute this software for any
+// purpose with or without fee is hereby granted, provided that the above
+// copyright notice and this permission notice appear in all copies.
+//
+// THE SOFTWARE IS PROVIDED "AS IS" AND THE AUTHOR DISCLAIMS ALL WARRANTIES WITH
+// REGARD TO THIS SOFTWARE INCLUDING ALL IMPLIED WARRANTIES OF MERCHANTABILITY
+// AND FITNESS. IN NO EVENT SHALL THE AUTHOR BE LIABLE FOR ANY SPECIAL, DIRECT,
+// INDIRECT, OR CONSEQUENTIAL DAMAGES OR ANY DAMAGES WHATSOEVER RESULTING FROM
+// LOSS OF USE, DATA OR PROFITS, WHETHER IN AN ACTION OF CONTRACT, NEGLIGENCE OR
+// OTHER TORTIOUS ACTION, ARISING OUT OF OR IN CONNECTION WITH THE USE OR
+// PERFORMANCE OF THIS SOFTWARE.
+
+package coredata
+
+import (
+	"database/sql/driver"
+	"fmt"
+)
+
+// ConnectorEvidenceFindingAction is what happens when a collector reports a
+// finding for a mapped piece of evidence.
+type ConnectorEvidenceFindingAction string
+
+const (
+	ConnectorEvidenceFindingActionNone          ConnectorEvidenceFindingAction = "NONE"
+	ConnectorEvidenceFindingActionTask          ConnectorEvidenceFindingAction = "TASK"
+	ConnectorEvidenceFindingActionNonconformity ConnectorEvidenceFindingAction = "NONCONFORMITY"
+)
+
+func ConnectorEvidenceFindingActions() []ConnectorEvidenceFindingAction {
+	return []ConnectorEvidenceFindingAction{
+		ConnectorEvidenceFindingActionNone,
+		ConnectorEvidenceFindingActionTask,
+		ConnectorEvidenceFindingActionNonconformity,
+	}
+}
+
+func (a ConnectorEvidenceFindingAction) String() string {
+	return string(a)
+}
+
+func (a ConnectorEvidenceFindingAction) IsValid() bool {
+	switch a {
+	case ConnectorEvidenceFindingActionNone,
+		ConnectorEvidenceFindingActionTask,
+		ConnectorEvidenceFindingActionNonconformity:
+		return true
+	}
+	return false
+}
+
+func (a ConnectorEvidenceFindingAction) MarshalText() ([]byte, error) {
+	return []byte(a.String()), nil
+}
+
+func (a *ConnectorEvidenceFindingAction) UnmarshalText(text []byte) error {
+	*a = ConnectorEvidenceFindingAction(text)
+	if !a.IsValid() {
+		return fmt.Errorf("%s is not a valid ConnectorEvidenceFindingAction", string(text))
+	}
+	return nil
+}
+
+func (a *ConnectorEvidenceFindingAction) Scan(value any) error {
+	switch v := value.(type) {
+	case string:
+		return a.UnmarshalText([]byte(v))
+	case []byte:
+		return a.UnmarshalText(v)
+	default:
+		return fmt.Errorf("unsupported type for ConnectorEvidenceFindingAction: %T", value)
+	}
+}
+
+func (a ConnectorEvidenceFindingAction) Value() (driver.Value, error) {
+	return a.String(), nil
+}
@@ -0,0 +1,424 @@
+// Copyright (c) 2025 Probo Inc <hello@getprobo.com>.
+//
+// Permission to use, copy, modify, and/or distribute this software for any
+// purpose with or without fee is hereby granted, provided that the above
+// copyright notice and this permission notice appear in all copies.
+//
+// THE SOFTWARE IS PROVIDED "AS IS" AND THE AUTHOR DISCLAIMS ALL WARRANTIES WITH
+// REGARD TO THIS SOFTWARE INCLUDING ALL IMPLIED WARRANTIES OF MERCHANTABILITY
+// AND FITNESS. IN NO EVENT SHALL THE AUTHOR BE LIABLE FOR ANY SPECIAL, DIRECT,
+// INDIRECT, OR CONSEQUENTIAL DAMAGES OR ANY DAMAGES WHATSOEVER RESULTING FROM
+// LOSS OF USE, DATA OR PROFITS, WHETHER IN AN ACTION OF CONTRACT, NEGLIGENCE OR
+// OTHER TORTIOUS ACTION, ARISING OUT OF OR IN CONNECTION WITH THE USE OR
+// PERFORMANCE OF THIS SOFTWARE.
+
+package coredata
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"maps"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"go.gearno.de/kit/pg"
+	"go.probo.inc/probo/pkg/gid"
+	"go.probo.inc/probo/pkg/page"
+)
+
+type (
+	// ConnectorEvidenceMapping maps a piece of evidence collected through
+	// a connector to the measure it should be attached to.
+	ConnectorEvidenceMapping struct {
+		ID               gid.GID                        `db:"id"`
+		OrganizationID   gid.GID                        `db:"organization_id"`
+		ConnectorID      gid.GID                        `db:"connector_id"`
+		MeasureID        gid.GID                        `db:"measure_id"`
+		EvidenceKey      string                         `db:"evidence_key"`
+		FindingAction    ConnectorEvidenceFindingAction `db:"finding_action"`
+		FindingOwnerID   *gid.GID                       `db:"finding_owner_profile_id"`
+		LastCollectedAt  *time.Time                     `db:"last_collected_at"`
+		NextCollectionAt *time.Time                     `db:"next_collection_at"`
+		CollectionError  *string                        `db:"collection_error"`
+		CreatedAt        time.Time                      `db:"created_at"`
+		UpdatedAt        time.Time                      `db:"updated_at"`
+	}
+
+	ConnectorEvidenceMappings []*ConnectorEvidenceMapping
+)
+
+func (m *ConnectorEvidenceMapping) CursorKey(orderBy ConnectorEvidenceMappingOrderField) page.CursorKey {
+	switch orderBy {
+	case ConnectorEvidenceMappingOrderFieldCreatedAt:
+		return page.NewCursorKey(m.ID, m.CreatedAt)
+	}
+
+	panic(fmt.Sprintf("unsupported order by: %s", orderBy))
+}
+
+// AuthorizationAttributes returns the authorization attributes for policy evaluation.
+func (m *ConnectorEvidenceMapping) AuthorizationAttributes(ctx context.Context, conn pg.Conn) (map[string]string, error) {
+	q := `SELECT organization_id FROM connector_evidence_mappings WHERE id = $1 LIMIT 1;`
+
+	var organizationID gid.GID
+	if err := conn.QueryRow(ctx, q, m.ID).Scan(&organizationID); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrResourceNotFound
+		}
+		return nil, fmt.Errorf("cannot query connector evidence mapping authorization attributes: %w", err)
+	}
+
+	return map[string]string{"organization_id": organizationID.String()}, nil
+}
+
+func (m *ConnectorEvidenceMapping) LoadByID(
+	ctx context.Context,
+	conn pg.Conn,
+	scope Scoper,
+	mappingID gid.GID,
+) error {
+	q := `
+SELECT
+    id,
+    organization_id,
+    connector_id,
+    measure_id,
+    evidence_key,
+    finding_action,
+    finding_owner_profile_id,
+    last_collected_at,
+    next_collection_at,
+    collection_error,
+    created_at,
+    updated_at
+FROM
+    connector_evidence_mappings
+WHERE
+    %s
+    AND id = @id
+LIMIT 1;
+`
+
+	q = fmt.Sprintf(q, scope.SQLFragment())
+
+	args := pgx.StrictNamedArgs{"id": mappingID}
+	maps.Copy(args, scope.SQLArguments())
+
+	rows, err := conn.Query(ctx, q, args)
+	if err != nil {
+		return fmt.Errorf("cannot query connector evidence mapping: %w", err)
+	}
+
+	mapping, err := pgx.CollectExactlyOneRow(rows, pgx.RowToStructByName[ConnectorEvidenceMapping])
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return ErrResourceNotFound
+		}
+		return fmt.Errorf("cannot collect connector evidence mapping: %w", err)
+	}
+
+	*m = mapping
+
+	return nil
+}
+
+func (m *ConnectorEvidenceMappings) CountByMeasureID(
+	ctx context.Context,
+	conn pg.Conn,
+	scope Scoper,
+	measureID gid.GID,
+) (int, error) {
+	q := `
+SELECT
+    COUNT(id)
+FROM
+    connector_evidence_mappings
+WHERE
+    %s
+    AND measure_id = @measure_id
+`
+
+	q = fmt.Sprintf(q, scope.SQLFragment())
+
+	args := pgx.StrictNamedArgs{"measure_id": measureID}
+	maps.Copy(args, scope.SQLArguments())
+
+	var count int
+	if err := conn.QueryRow(ctx, q, args).Scan(&count); err != nil {
+		return 0, fmt.Errorf("cannot count connector evidence mappings: %w", err)
+	}
+
+	return count, nil
+}
+
+func (m *ConnectorEvidenceMappings) LoadByMeasureID(
+	ctx context.Context,
+	conn pg.Conn,
+	scope Scoper,
+	measureID gid.GID,
+	cursor *page.Cursor[ConnectorEvidenceMappingOrderField],
+) error {
+	q := `
+SELECT
+    id,
+    organization_id,
+    connector_id,
+    measure_id,
+    evidence_key,
+    finding_action,
+    finding_owner_profile_id,
+    last_collected_at,
+    next_collection_at,
+    collection_error,
+    created_at,
+    updated_at
+FROM
+    connector_evidence_mappings
+WHERE
+    %s
+    AND measure_id = @measure_id
+    AND %s
+`
+
+	q = fmt.Sprintf(q, scope.SQLFragment(), cursor.SQLFragment())
+
+	args := pgx.StrictNamedArgs{"measure_id": measureID}
+	maps.Copy(args, scope.SQLArguments())
+	maps.Copy(args, cursor.SQLArguments())
+
+	rows, err := conn.Query(ctx, q, args)
+	if err != nil {
+		return fmt.Errorf("cannot query connector evidence mappings: %w", err)
+	}
+
+	mappings, err := pgx.CollectRows(rows, pgx.RowToAddrOfStructByName[ConnectorEvidenceMapping])
+	if err != nil {
+		return fmt.Errorf("cannot collect connector evidence mappings: %w", err)
+	}
+
+	*m = mappings
+
+	return nil
+}
+
+func (m *ConnectorEvidenceMappings) LoadAllByConnectorID(
+	ctx context.Context,
+	conn pg.Conn,
+	scope Scoper,
+	connectorID gid.GID,
+) error {
+	q := `
+SELECT
+    id,
+    organization_id,
+    connector_id,
+    measure_id,
+    evidence_key,
+    finding_action,
+    finding_owner_profile_id,
+    last_collected_at,
+    next_collection_at,
+    collection_error,
+    created_at,
+    updated_at
+FROM
+    connector_evidence_mappings
+WHERE
+    %s
+    AND connector_id = @connector_id
+ORDER BY
+    created_at ASC
+`
+
+	q = fmt.Sprintf(q, scope.SQLFragment())
+
+	args := pgx.StrictNamedArgs{"connector_id": connectorID}
+	maps.Copy(args, scope.SQLArguments())
+
+	rows, err := conn.Query(ctx, q, args)
+	if err != nil {
+		return fmt.Errorf("cannot query connector evidence mappings: %w", err)
+	}
+
+	mappings, err := pgx.CollectRows(rows, pgx.RowToAddrOfStructByName[ConnectorEvidenceMapping])
+	if err != nil {
+		return fmt.Errorf("cannot collect connector evidence mappings: %w", err)
+	}
+
+	*m = mappings
+
+	return nil
+}
+
+func (m *ConnectorEvidenceMapping) Insert(
+	ctx context.Context,
+	conn pg.Conn,
+	scope Scoper,
+) error {
+	q := `
+INSERT INTO connector_evidence_mappings (
+    id,
+    tenant_id,
+    organization_id,
+    connector_id,
+    measure_id,
+    evidence_key,
+    finding_action,
+    finding_owner_profile_id,
+    last_collected_at,
+    next_collection_at,
+    collection_error,
+    created_at,
+    updated_at
+) VALUES (
+    @id,
+    @tenant_id,
+    @organization_id,
+    @connector_id,
+    @measure_id,
+    @evidence_key,
+    @finding_action,
+    @finding_owner_profile_id,
+    @last_collected_at,
+    @next_collection_at,
+    @collection_error,
+    @created_at,
+    @updated_at
+)
+`
+
+	args := pgx.StrictNamedArgs{
+		"id":                       m.ID,
+		"tenant_id":                scope.GetTenantID(),
+		"organization_id":          m.OrganizationID,
+		"connector_id":             m.ConnectorID,
+		"measure_id":               m.MeasureID,
+		"evidence_key":             m.EvidenceKey,
+		"finding_action":           m.FindingAction,
+		"finding_owner_profile_id": m.FindingOwnerID,
+		"last_collected_at":        m.LastCollectedAt,
+		"next_collection_at":       m.NextCollectionAt,
+		"collection_error":         m.CollectionError,
+		"created_at":               m.CreatedAt,
+		"updated_at":               m.UpdatedAt,
+	}
+
+	_, err := conn.Exec(ctx, q, args)
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) {
+			if pgErr.Code == "23505" && pgErr.ConstraintName == "connector_evidence_mappings_unique" {
+				return ErrResourceAlreadyExists
+			}
+		}
+		return fmt.Errorf("cannot insert connector evidence mapping: %w", err)
+	}
+
+	return nil
+}
+
+func (m *ConnectorEvidenceMapping) Update(
+	ctx context.Context,
+	conn pg.Conn,
+	scope Scoper,
+) error {
+	q := `
+UPDATE connector_evidence_mappings
+SET
+    last_collected_at = @last_collected_at,
+    next_collection_at = @next_collection_at,
+    collection_error = @collection_error,
+    updated_at = @updated_at
+WHERE
+    %s
+    AND id = @id
+`
+
+	q = fmt.Sprintf(q, scope.SQLFragment())
+
+	args := pgx.StrictNamedArgs{
+		"id":                 m.ID,
+		"last_collected_at":  m.LastCollectedAt,
+		"next_collection_at": m.NextCollectionAt,
+		"collection_error":   m.CollectionError,
+		"updated_at":         m.UpdatedAt,
+	}
+	maps.Copy(args, scope.SQLArguments())
+
+	result, err := conn.Exec(ctx, q, args)
+	if err != nil {
+		return fmt.Errorf("cannot update connector evidence mapping: %w", err)
+	}
+
+	if result.RowsAffected() == 0 {
+		return ErrResourceNotFound
+	}
+
+	return nil
+}
+
+// ScheduleByConnectorID sets the next collection time of every mapping of
+// the given connector.
+func (m *ConnectorEvidenceMappings) ScheduleByConnectorID(
+	ctx context.Context,
+	conn pg.Conn,
+	scope Scoper,
+	connectorID gid.GID,
+	nextCollectionAt time.Time,
+) error {
+	q := `
+UPDATE connector_evidence_mappings
+SET
+    next_collection_at = @next_collection_at,
+    updated_at = @updated_at
+WHERE
+    %s
+    AND connector_id = @connector_id
+`
+
+	q = fmt.Sprintf(q, scope.SQLFragment())
+
+	args := pgx.StrictNamedArgs{
+		"connector_id":       connectorID,
+		"next_collection_at": nextCollectionAt,
+		"updated_at":         time.Now(),
+	}
+	maps.Copy(args, scope.SQLArguments())
+
+	if _, err := conn.Exec(ctx, q, args); err != nil {
+		return fmt.Errorf("cannot schedule connector evidence mappings: %w", err)
+	}
+
+	return nil
+}
+
+func (m *ConnectorEvidenceMapping) Delete(
+	ctx context.Context,
+	conn pg.Conn,
+	scope Scoper,
+) error {
+	q := `
+DELETE FROM connector_evidence_mappings
+WHERE
+    %s
+    AND id = @id
+`
+
+	q = fmt.Sprintf(q, scope.SQLFragment())
+
+	args := pgx.StrictNamedArgs{"id": m.ID}
+	maps.Copy(args, scope.SQLArguments())
+
+	result, err := conn.Exec(ctx, q, args)
+	if err != nil {
+		return fmt.Errorf("cannot delete connector evidence mapping: %w", err)
+	}
+
+	if result.RowsAffected() == 0 {
+		return ErrResourceNotFound
+	}
+
+	return nil
+}
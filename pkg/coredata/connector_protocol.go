@@ -22,12 +22,16 @@ import (
 type ConnectorProtocol string
 
 const (
-	ConnectorProtocolOAuth2 ConnectorProtocol = "OAUTH2"
+	ConnectorProtocolOAuth2         ConnectorProtocol = "OAUTH2"
+	ConnectorProtocolAWSCredentials ConnectorProtocol = "AWS_CREDENTIALS"
+	ConnectorProtocolAPIToken       ConnectorProtocol = "API_TOKEN"
 )
 
 func ConnectorProtocols() []ConnectorProtocol {
 	return []ConnectorProtocol{
 		ConnectorProtocolOAuth2,
+		ConnectorProtocolAWSCredentials,
+		ConnectorProtocolAPIToken,
 	}
 }
 
@@ -49,6 +53,10 @@ func (cp *ConnectorProtocol) Scan(value any) error {
 	switch s {
 	case "OAUTH2":
 		*cp = ConnectorProtocolOAuth2
+	case "AWS_CREDENTIALS":
+		*cp = ConnectorProtocolAWSCredentials
+	case "API_TOKEN":
+		*cp = ConnectorProtocolAPIToken
 	default:
 		return fmt.Errorf("invalid ConnectorProtocol value: %q", s)
 	}
@@ -22,14 +22,22 @@ import (
 type ConnectorProvider string
 
 const (
-	ConnectorProviderSlack           ConnectorProvider = "SLACK"
-	ConnectorProviderGoogleWorkspace ConnectorProvider = "GOOGLE_WORKSPACE"
+	ConnectorProviderSlack            ConnectorProvider = "SLACK"
+	ConnectorProviderGoogleWorkspace  ConnectorProvider = "GOOGLE_WORKSPACE"
+	ConnectorProviderGitHub           ConnectorProvider = "GITHUB"
+	ConnectorProviderAWS              ConnectorProvider = "AWS"
+	ConnectorProviderMicrosoftEntraID ConnectorProvider = "MICROSOFT_ENTRA_ID"
+	ConnectorProviderOkta             ConnectorProvider = "OKTA"
 )
 
 func ConnectorProviders() []ConnectorProvider {
 	return []ConnectorProvider{
 		ConnectorProviderSlack,
 		ConnectorProviderGoogleWorkspace,
+		ConnectorProviderGitHub,
+		ConnectorProviderAWS,
+		ConnectorProviderMicrosoftEntraID,
+		ConnectorProviderOkta,
 	}
 }
 
@@ -53,6 +61,14 @@ func (cp *ConnectorProvider) Scan(value any) error {
 		*cp = ConnectorProviderSlack
 	case "GOOGLE_WORKSPACE":
 		*cp = ConnectorProviderGoogleWorkspace
+	case "GITHUB":
+		*cp = ConnectorProviderGitHub
+	case "AWS":
+		*cp = ConnectorProviderAWS
+	case "MICROSOFT_ENTRA_ID":
+		*cp = ConnectorProviderMicrosoftEntraID
+	case "OKTA":
+		*cp = ConnectorProviderOkta
 	default:
 		return fmt.Errorf("invalid ConnectorProvider value: %q", s)
 	}
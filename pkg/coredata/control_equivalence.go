@@ -0,0 +1,265 @@
+// Copyright (c) 2025 Probo Inc <hello@getprobo.com>.
+//
+// Permission to use, copy, modify, and/or distribute this software for any
+// purpose with or without fee is hereby granted, provided that the above
+// copyright notice and this permission notice appear in all copies.
+//
+// THE SOFTWARE IS PROVIDED "AS IS" AND THE AUTHOR DISCLAIMS ALL WARRANTIES WITH
+// REGARD TO THIS SOFTWARE INCLUDING ALL IMPLIED WARRANTIES OF MERCHANTABILITY
+// AND FITNESS. IN NO EVENT SHALL THE AUTHOR BE LIABLE FOR ANY SPECIAL, DIRECT,
+// INDIRECT, OR CONSEQUENTIAL DAMAGES OR ANY DAMAGES WHATSOEVER RESULTING FROM
+// LOSS OF USE, DATA OR PROFITS, WHETHER IN AN ACTION OF CONTRACT, NEGLIGENCE OR
+// OTHER TORTIOUS ACTION, ARISING OUT OF OR IN CONNECTION WITH THE USE OR
+// PERFORMANCE OF THIS SOFTWARE.
+
+package coredata
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"go.gearno.de/kit/pg"
+	"go.probo.inc/probo/pkg/gid"
+)
+
+type (
+	// ControlEquivalence states that two controls, usually of different
+	// frameworks, are satisfied by the same measures. The relation is
+	// symmetric and stored once, with the smallest control ID first.
+	ControlEquivalence struct {
+		ControlID           gid.GID      `db:"control_id"`
+		EquivalentControlID gid.GID      `db:"equivalent_control_id"`
+		OrganizationID      gid.GID      `db:"organization_id"`
+		TenantID            gid.TenantID `db:"tenant_id"`
+		CreatedAt           time.Time    `db:"created_at"`
+	}
+
+	// ControlEquivalenceCoverage is a measure of a source framework control
+	// that covers an equivalent control of a target framework.
+	ControlEquivalenceCoverage struct {
+		ControlID           gid.GID `db:"control_id"`
+		EquivalentControlID gid.GID `db:"equivalent_control_id"`
+		MeasureID           gid.GID `db:"measure_id"`
+	}
+
+	ControlEquivalenceCoverages []*ControlEquivalenceCoverage
+)
+
+// NewControlEquivalence returns the equivalence between two controls in
+// its stored order.
+func NewControlEquivalence(
+	organizationID gid.GID,
+	controlID gid.GID,
+	equivalentControlID gid.GID,
+	now time.Time,
+) *ControlEquivalence {
+	if equivalentControlID.String() < controlID.String() {
+		controlID, equivalentControlID = equivalentControlID, controlID
+	}
+
+	return &ControlEquivalence{
+		ControlID:           controlID,
+		EquivalentControlID: equivalentControlID,
+		OrganizationID:      organizationID,
+		TenantID:            organizationID.TenantID(),
+		CreatedAt:           now,
+	}
+}
+
+// Upsert inserts the equivalence and reports whether it did not exist yet.
+func (ce ControlEquivalence) Upsert(
+	ctx context.Context,
+	conn pg.Conn,
+	scope Scoper,
+) (bool, error) {
+	q := `
+INSERT INTO
+    controls_equivalences (
+        control_id,
+        equivalent_control_id,
+        organization_id,
+        tenant_id,
+        created_at
+    )
+VALUES (
+    @control_id,
+    @equivalent_control_id,
+    @organization_id,
+    @tenant_id,
+    @created_at
+)
+ON CONFLICT (control_id, equivalent_control_id) DO NOTHING;
+`
+
+	args := pgx.StrictNamedArgs{
+		"control_id":            ce.ControlID,
+		"equivalent_control_id": ce.EquivalentControlID,
+		"organization_id":       ce.OrganizationID,
+		"tenant_id":             scope.GetTenantID(),
+		"created_at":            ce.CreatedAt,
+	}
+
+	result, err := conn.Exec(ctx, q, args)
+	if err != nil {
+		return false, fmt.Errorf("cannot insert control equivalence: %w", err)
+	}
+
+	return result.RowsAffected() == 1, nil
+}
+
+func (ce ControlEquivalence) Delete(
+	ctx context.Context,
+	conn pg.Conn,
+	scope Scoper,
+) error {
+	q := `
+DELETE
+FROM
+    controls_equivalences
+WHERE
+    %s
+    AND control_id = @control_id
+    AND equivalent_control_id = @equivalent_control_id;
+`
+
+	args := pgx.StrictNamedArgs{
+		"control_id":            ce.ControlID,
+		"equivalent_control_id": ce.EquivalentControlID,
+	}
+	maps.Copy(args, scope.SQLArguments())
+	q = fmt.Sprintf(q, scope.SQLFragment())
+
+	result, err := conn.Exec(ctx, q, args)
+	if err != nil {
+		return fmt.Errorf("cannot delete control equivalence: %w", err)
+	}
+
+	if result.RowsAffected() == 0 {
+		return ErrResourceNotFound
+	}
+
+	return nil
+}
+
+// LoadEquivalentsByControlID loads the controls equivalent to the given
+// control, in either direction of the relation.
+func (c *Controls) LoadEquivalentsByControlID(
+	ctx context.Context,
+	conn pg.Conn,
+	scope Scoper,
+	controlID gid.GID,
+) error {
+	q := `
+SELECT
+    c.id,
+    c.section_title,
+    c.framework_id,
+    c.organization_id,
+    c.name,
+    c.description,
+    c.best_practice,
+    c.created_at,
+    c.updated_at
+FROM
+    controls_equivalences ce
+INNER JOIN controls c
+    ON c.id = CASE WHEN ce.control_id = @control_id THEN ce.equivalent_control_id ELSE ce.control_id END
+WHERE
+    ce.%s
+    AND (ce.control_id = @control_id OR ce.equivalent_control_id = @control_id)
+ORDER BY
+    c.framework_id ASC,
+    c.section_title ASC
+`
+	q = fmt.Sprintf(q, scope.SQLFragment())
+
+	args := pgx.StrictNamedArgs{"control_id": controlID}
+	maps.Copy(args, scope.SQLArguments())
+
+	rows, err := conn.Query(ctx, q, args)
+	if err != nil {
+		return fmt.Errorf("cannot query equivalent controls: %w", err)
+	}
+
+	controls, err := pgx.CollectRows(rows, pgx.RowToAddrOfStructByName[Control])
+	if err != nil {
+		return fmt.Errorf("cannot collect equivalent controls: %w", err)
+	}
+
+	*c = controls
+
+	return nil
+}
+
+// LoadByFrameworkIDs loads, for each control of the target framework, the
+// measures mapped to its equivalent controls of the source framework.
+func (cecs *ControlEquivalenceCoverages) LoadByFrameworkIDs(
+	ctx context.Context,
+	conn pg.Conn,
+	scope Scoper,
+	targetFrameworkID gid.GID,
+	sourceFrameworkID gid.GID,
+) error {
+	q := `
+WITH equivalences AS (
+    SELECT
+        control_id,
+        equivalent_control_id
+    FROM
+        controls_equivalences
+    WHERE
+        %s
+    UNION ALL
+    SELECT
+        equivalent_control_id,
+        control_id
+    FROM
+        controls_equivalences
+    WHERE
+        %[1]s
+)
+SELECT DISTINCT
+    target.id AS control_id,
+    source.id AS equivalent_control_id,
+    cm.measure_id
+FROM
+    equivalences e
+INNER JOIN controls target
+    ON target.id = e.control_id
+INNER JOIN controls source
+    ON source.id = e.equivalent_control_id
+INNER JOIN controls_measures cm
+    ON cm.control_id = source.id
+WHERE
+    target.framework_id = @target_framework_id
+    AND source.framework_id = @source_framework_id
+ORDER BY
+    control_id,
+    equivalent_control_id,
+    cm.measure_id
+`
+	q = fmt.Sprintf(q, scope.SQLFragment())
+
+	args := pgx.StrictNamedArgs{
+		"target_framework_id": targetFrameworkID,
+		"source_framework_id": sourceFrameworkID,
+	}
+	maps.Copy(args, scope.SQLArguments())
+
+	rows, err := conn.Query(ctx, q, args)
+	if err != nil {
+		return fmt.Errorf("cannot query control equivalence coverage: %w", err)
+	}
+
+	coverages, err := pgx.CollectRows(rows, pgx.RowToAddrOfStructByName[ControlEquivalenceCoverage])
+	if err != nil {
+		return fmt.Errorf("cannot collect control equivalence coverage: %w", err)
+	}
+
+	*cecs = coverages
+
+	return nil
+}
@@ -0,0 +1,147 @@
+// Copyright (c) 2025 Probo Inc <hello@getprobo.com>.
+//
+// Permission to use, copy, modify, and/or distribute this software for any
+// purpose with or without fee is hereby granted, provided that the above
+// copyright notice and this permission notice appear in all copies.
+//
+// THE SOFTWARE IS PROVIDED "AS IS" AND THE AUTHOR DISCLAIMS ALL WARRANTIES WITH
+// REGARD TO THIS SOFTWARE INCLUDING ALL IMPLIED WARRANTIES OF MERCHANTABILITY
+// AND FITNESS. IN NO EVENT SHALL THE AUTHOR BE LIABLE FOR ANY SPECIAL, DIRECT,
+// INDIRECT, OR CONSEQUENTIAL DAMAGES OR ANY DAMAGES WHATSOEVER RESULTING FROM
+// LOSS OF USE, DATA OR PROFITS, WHETHER IN AN ACTION OF CONTRACT, NEGLIGENCE OR
+// OTHER TORTIOUS ACTION, ARISING OUT OF OR IN CONNECTION WITH THE USE OR
+// PERFORMANCE OF THIS SOFTWARE.
+
+package coredata
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"go.gearno.de/kit/pg"
+	"go.probo.inc/probo/pkg/gid"
+)
+
+// MoveMappings moves the measure, document and obligation mappings of the
+// control onto each of the target controls. Mappings the target controls
+// already have are kept as is.
+func (c *Control) MoveMappings(
+	ctx context.Context,
+	conn pg.Conn,
+	scope Scoper,
+	targetControlIDs []gid.GID,
+	now time.Time,
+) error {
+	queries := []struct {
+		table string
+		query string
+	}{
+		{
+			table: "controls_measures",
+			query: `
+INSERT INTO
+    controls_measures (
+        control_id,
+        measure_id,
+        organization_id,
+        tenant_id,
+        created_at
+    )
+SELECT
+    t.id,
+    cm.measure_id,
+    cm.organization_id,
+    cm.tenant_id,
+    @created_at
+FROM
+    controls_measures cm
+CROSS JOIN unnest(@target_control_ids::text[]) AS t(id)
+WHERE
+    cm.%s
+    AND cm.control_id = @control_id
+ON CONFLICT DO NOTHING;
+`,
+		},
+		{
+			table: "controls_documents",
+			query: `
+INSERT INTO
+    controls_documents (
+        control_id,
+        document_id,
+        organization_id,
+        tenant_id,
+        created_at
+    )
+SELECT
+    t.id,
+    cd.document_id,
+    cd.organization_id,
+    cd.tenant_id,
+    @created_at
+FROM
+    controls_documents cd
+CROSS JOIN unnest(@target_control_ids::text[]) AS t(id)
+WHERE
+    cd.%s
+    AND cd.control_id = @control_id
+ON CONFLICT DO NOTHING;
+`,
+		},
+		{
+			table: "controls_obligations",
+			query: `
+INSERT INTO
+    controls_obligations (
+        control_id,
+        obligation_id,
+        tenant_id,
+        created_at
+    )
+SELECT
+    t.id,
+    co.obligation_id,
+    co.tenant_id,
+    @created_at
+FROM
+    controls_obligations co
+CROSS JOIN unnest(@target_control_ids::text[]) AS t(id)
+WHERE
+    co.%s
+    AND co.control_id = @control_id
+ON CONFLICT DO NOTHING;
+`,
+		},
+	}
+
+	for _, q := range queries {
+		args := pgx.StrictNamedArgs{
+			"control_id":         c.ID,
+			"target_control_ids": targetControlIDs,
+			"created_at":         now,
+		}
+		maps.Copy(args, scope.SQLArguments())
+
+		if _, err := conn.Exec(ctx, fmt.Sprintf(q.query, scope.SQLFragment()), args); err != nil {
+			return fmt.Errorf("cannot copy %s: %w", q.table, err)
+		}
+
+		deleteQuery := fmt.Sprintf(
+			"DELETE FROM %s WHERE %s AND control_id = @control_id;",
+			q.table,
+			scope.SQLFragment(),
+		)
+
+		deleteArgs := pgx.StrictNamedArgs{"control_id": c.ID}
+		maps.Copy(deleteArgs, scope.SQLArguments())
+
+		if _, err := conn.Exec(ctx, deleteQuery, deleteArgs); err != nil {
+			return fmt.Errorf("cannot delete %s: %w", q.table, err)
+		}
+	}
+
+	return nil
+}
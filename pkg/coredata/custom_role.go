@@ -0,0 +1,424 @@
+// Copyright (c) 2025 Probo Inc <hello@getprobo.com>.
+//
+// Permission to use, copy, modify, and/or distribute this software for any
+// purpose with or without fee is hereby granted, provided that the above
+// copyright notice and this permission notice appear in all copies.
+//
+// THE SOFTWARE IS PROVIDED "AS IS" AND THE AUTHOR DISCLAIMS ALL WARRANTIES WITH
+// REGARD TO THIS SOFTWARE INCLUDING ALL IMPLIED WARRANTIES OF MERCHANTABILITY
+// AND FITNESS. IN NO EVENT SHALL THE AUTHOR BE LIABLE FOR ANY SPECIAL, DIRECT,
+// INDIRECT, OR CONSEQUENTIAL DAMAGES OR ANY DAMAGES WHATSOEVER RESULTING FROM
+// LOSS OF USE, DATA OR PROFITS, WHETHER IN AN ACTION OF CONTRACT, NEGLIGENCE OR
+// OTHER TORTIOUS ACTION, ARISING OUT OF OR IN CONNECTION WITH THE USE OR
+// PERFORMANCE OF THIS SOFTWARE.
+
+package coredata
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"maps"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"go.gearno.de/kit/pg"
+	"go.probo.inc/probo/pkg/gid"
+	"go.probo.inc/probo/pkg/page"
+)
+
+type (
+	// CustomRole is an organization-defined role whose permissions are
+	// described by a JSON policy document.
+	CustomRole struct {
+		ID             gid.GID         `db:"id"`
+		OrganizationID gid.GID         `db:"organization_id"`
+		Name           string          `db:"name"`
+		Description    *string         `db:"description"`
+		Policy         json.RawMessage `db:"policy"`
+		CreatedAt      time.Time       `db:"created_at"`
+		UpdatedAt      time.Time       `db:"updated_at"`
+	}
+
+	CustomRoles []*CustomRole
+)
+
+func (r *CustomRole) CursorKey(orderBy CustomRoleOrderField) page.CursorKey {
+	switch orderBy {
+	case CustomRoleOrderFieldCreatedAt:
+		return page.NewCursorKey(r.ID, r.CreatedAt)
+	case CustomRoleOrderFieldName:
+		return page.NewCursorKey(r.ID, r.Name)
+	}
+
+	panic(fmt.Sprintf("unsupported order by: %s", orderBy))
+}
+
+// AuthorizationAttributes returns the authorization attributes for policy evaluation.
+func (r *CustomRole) AuthorizationAttributes(ctx context.Context, conn pg.Conn) (map[string]string, error) {
+	q := `SELECT organization_id FROM iam_custom_roles WHERE id = $1 LIMIT 1;`
+
+	var organizationID gid.GID
+	if err := conn.QueryRow(ctx, q, r.ID).Scan(&organizationID); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrResourceNotFound
+		}
+		return nil, fmt.Errorf("cannot query custom role authorization attributes: %w", err)
+	}
+
+	return map[string]string{"organization_id": organizationID.String()}, nil
+}
+
+func (r *CustomRole) LoadByID(
+	ctx context.Context,
+	conn pg.Conn,
+	scope Scoper,
+	customRoleID gid.GID,
+) error {
+	q := `
+SELECT
+    id,
+    organization_id,
+    name,
+    description,
+    policy,
+    created_at,
+    updated_at
+FROM
+    iam_custom_roles
+WHERE
+    %s
+    AND id = @id
+LIMIT 1;
+`
+
+	q = fmt.Sprintf(q, scope.SQLFragment())
+
+	args := pgx.StrictNamedArgs{"id": customRoleID}
+	maps.Copy(args, scope.SQLArguments())
+
+	rows, err := conn.Query(ctx, q, args)
+	if err != nil {
+		return fmt.Errorf("cannot query custom role: %w", err)
+	}
+
+	role, err := pgx.CollectExactlyOneRow(rows, pgx.RowToStructByName[CustomRole])
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return ErrResourceNotFound
+		}
+		return fmt.Errorf("cannot collect custom role: %w", err)
+	}
+
+	*r = role
+
+	return nil
+}
+
+// LoadByMembershipID loads the custom role assigned to the given
+// membership.
+func (r *CustomRole) LoadByMembershipID(
+	ctx context.Context,
+	conn pg.Conn,
+	scope Scoper,
+	membershipID gid.GID,
+) error {
+	q := `
+SELECT
+    cr.id,
+    cr.organization_id,
+    cr.name,
+    cr.description,
+    cr.policy,
+    cr.created_at,
+    cr.updated_at
+FROM
+    iam_custom_roles cr
+INNER JOIN
+    iam_membership_custom_roles mcr ON mcr.custom_role_id = cr.id
+WHERE
+    cr.%s
+    AND mcr.membership_id = @membership_id
+LIMIT 1;
+`
+
+	q = fmt.Sprintf(q, scope.SQLFragment())
+
+	args := pgx.StrictNamedArgs{"membership_id": membershipID}
+	maps.Copy(args, scope.SQLArguments())
+
+	rows, err := conn.Query(ctx, q, args)
+	if err != nil {
+		return fmt.Errorf("cannot query custom role: %w", err)
+	}
+
+	role, err := pgx.CollectExactlyOneRow(rows, pgx.RowToStructByName[CustomRole])
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return ErrResourceNotFound
+		}
+		return fmt.Errorf("cannot collect custom role: %w", err)
+	}
+
+	*r = role
+
+	return nil
+}
+
+// LoadByPersonalAPIKeyIDAndOrganizationID loads the custom role
+// restricting the given personal API key in the given organization.
+func (r *CustomRole) LoadByPersonalAPIKeyIDAndOrganizationID(
+	ctx context.Context,
+	conn pg.Conn,
+	scope Scoper,
+	personalAPIKeyID gid.GID,
+	organizationID gid.GID,
+) error {
+	q := `
+SELECT
+    cr.id,
+    cr.organization_id,
+    cr.name,
+    cr.description,
+    cr.policy,
+    cr.created_at,
+    cr.updated_at
+FROM
+    iam_custom_roles cr
+INNER JOIN
+    iam_personal_api_key_custom_roles akcr ON akcr.custom_role_id = cr.id
+WHERE
+    cr.%s
+    AND akcr.personal_api_key_id = @personal_api_key_id
+    AND akcr.organization_id = @organization_id
+LIMIT 1;
+`
+
+	q = fmt.Sprintf(q, scope.SQLFragment())
+
+	args := pgx.StrictNamedArgs{
+		"personal_api_key_id": personalAPIKeyID,
+		"organization_id":     organizationID,
+	}
+	maps.Copy(args, scope.SQLArguments())
+
+	rows, err := conn.Query(ctx, q, args)
+	if err != nil {
+		return fmt.Errorf("cannot query custom role: %w", err)
+	}
+
+	role, err := pgx.CollectExactlyOneRow(rows, pgx.RowToStructByName[CustomRole])
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return ErrResourceNotFound
+		}
+		return fmt.Errorf("cannot collect custom role: %w", err)
+	}
+
+	*r = role
+
+	return nil
+}
+
+func (r *CustomRoles) CountByOrganizationID(
+	ctx context.Context,
+	conn pg.Conn,
+	scope Scoper,
+	organizationID gid.GID,
+) (int, error) {
+	q := `
+SELECT
+    COUNT(id)
+FROM
+    iam_custom_roles
+WHERE
+    %s
+    AND organization_id = @organization_id
+`
+
+	q = fmt.Sprintf(q, scope.SQLFragment())
+
+	args := pgx.StrictNamedArgs{"organization_id": organizationID}
+	maps.Copy(args, scope.SQLArguments())
+
+	var count int
+	if err := conn.QueryRow(ctx, q, args).Scan(&count); err != nil {
+		return 0, fmt.Errorf("cannot count custom roles: %w", err)
+	}
+
+	return count, nil
+}
+
+func (r *CustomRoles) LoadByOrganizationID(
+	ctx context.Context,
+	conn pg.Conn,
+	scope Scoper,
+	organizationID gid.GID,
+	cursor *page.Cursor[CustomRoleOrderField],
+) error {
+	q := `
+SELECT
+    id,
+    organization_id,
+    name,
+    description,
+    policy,
+    created_at,
+    updated_at
+FROM
+    iam_custom_roles
+WHERE
+    %s
+    AND organization_id = @organization_id
+    AND %s
+`
+
+	q = fmt.Sprintf(q, scope.SQLFragment(), cursor.SQLFragment())
+
+	args := pgx.StrictNamedArgs{"organization_id": organizationID}
+	maps.Copy(args, scope.SQLArguments())
+	maps.Copy(args, cursor.SQLArguments())
+
+	rows, err := conn.Query(ctx, q, args)
+	if err != nil {
+		return fmt.Errorf("cannot query custom roles: %w", err)
+	}
+
+	roles, err := pgx.CollectRows(rows, pgx.RowToAddrOfStructByName[CustomRole])
+	if err != nil {
+		return fmt.Errorf("cannot collect custom roles: %w", err)
+	}
+
+	*r = roles
+
+	return nil
+}
+
+func (r *CustomRole) Insert(
+	ctx context.Context,
+	conn pg.Conn,
+	scope Scoper,
+) error {
+	q := `
+INSERT INTO iam_custom_roles (
+    id,
+    tenant_id,
+    organization_id,
+    name,
+    description,
+    policy,
+    created_at,
+    updated_at
+) VALUES (
+    @id,
+    @tenant_id,
+    @organization_id,
+    @name,
+    @description,
+    @policy,
+    @created_at,
+    @updated_at
+)
+`
+
+	args := pgx.StrictNamedArgs{
+		"id":              r.ID,
+		"tenant_id":       scope.GetTenantID(),
+		"organization_id": r.OrganizationID,
+		"name":            r.Name,
+		"description":     r.Description,
+		"policy":          r.Policy,
+		"created_at":      r.CreatedAt,
+		"updated_at":      r.UpdatedAt,
+	}
+
+	_, err := conn.Exec(ctx, q, args)
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) {
+			if pgErr.Code == "23505" && pgErr.ConstraintName == "iam_custom_roles_organization_id_name_key" {
+				return ErrResourceAlreadyExists
+			}
+		}
+		return fmt.Errorf("cannot insert custom role: %w", err)
+	}
+
+	return nil
+}
+
+func (r *CustomRole) Update(
+	ctx context.Context,
+	conn pg.Conn,
+	scope Scoper,
+) error {
+	q := `
+UPDATE iam_custom_roles
+SET
+    name = @name,
+    description = @description,
+    policy = @policy,
+    updated_at = @updated_at
+WHERE
+    %s
+    AND id = @id
+`
+
+	q = fmt.Sprintf(q, scope.SQLFragment())
+
+	args := pgx.StrictNamedArgs{
+		"id":          r.ID,
+		"name":        r.Name,
+		"description": r.Description,
+		"policy":      r.Policy,
+		"updated_at":  r.UpdatedAt,
+	}
+	maps.Copy(args, scope.SQLArguments())
+
+	result, err := conn.Exec(ctx, q, args)
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) {
+			if pgErr.Code == "23505" && pgErr.ConstraintName == "iam_custom_roles_organization_id_name_key" {
+				return ErrResourceAlreadyExists
+			}
+		}
+		return fmt.Errorf("cannot update custom role: %w", err)
+	}
+
+	if result.RowsAffected() == 0 {
+		return ErrResourceNotFound
+	}
+
+	return nil
+}
+
+func (r *CustomRole) Delete(
+	ctx context.Context,
+	conn pg.Conn,
+	scope Scoper,
+) error {
+	q := `
+DELETE FROM iam_custom_roles
+WHERE
+    %s
+    AND id = @id
+`
+
+	q = fmt.Sprintf(q, scope.SQLFragment())
+
+	args := pgx.StrictNamedArgs{"id": r.ID}
+	maps.Copy(args, scope.SQLArguments())
+
+	result, err := conn.Exec(ctx, q, args)
+	if err != nil {
+		return fmt.Errorf("cannot delete custom role: %w", err)
+	}
+
+	if result.RowsAffected() == 0 {
+		return ErrResourceNotFound
+	}
+
+	return nil
+}
@@ -0,0 +1,259 @@
+// Copyright (c) 2025 Probo Inc <hello@getprobo.com>.
+//
+// Permission to use, copy, modify, and/or distribute this software for any
+// purpose with or without fee is hereby granted, provided that the above
+// copyright notice and this permission notice appear in all copies.
+//
+// THE SOFTWARE IS PROVIDED "AS IS" AND THE AUTHOR DISCLAIMS ALL WARRANTIES WITH
+// REGARD TO THIS SOFTWARE INCLUDING ALL IMPLIED WARRANTIES OF MERCHANTABILITY
+// AND FITNESS. IN NO EVENT SHALL THE AUTHOR BE LIABLE FOR ANY SPECIAL, DIRECT,
+// INDIRECT, OR CONSEQUENTIAL DAMAGES OR ANY DAMAGES WHATSOEVER RESULTING FROM
+// LOSS OF USE, DATA OR PROFITS, WHETHER IN AN ACTION OF CONTRACT, NEGLIGENCE OR
+// OTHER TORTIOUS ACTION, ARISING OUT OF OR IN CONNECTION WITH THE USE OR
+// PERFORMANCE OF THIS SOFTWARE.
+
+package coredata
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"go.gearno.de/kit/pg"
+	"go.probo.inc/probo/pkg/gid"
+)
+
+type (
+	DeadlineItemType string
+
+	// DeadlineItem is an open item of an organization that carries a
+	// deadline, regardless of the table it comes from.
+	DeadlineItem struct {
+		EntityID       gid.GID          `db:"entity_id"`
+		Type           DeadlineItemType `db:"type"`
+		Title          string           `db:"title"`
+		Deadline       time.Time        `db:"deadline"`
+		OwnerProfileID *gid.GID         `db:"owner_profile_id"`
+		Path           string           `db:"path"`
+	}
+
+	DeadlineItems []*DeadlineItem
+)
+
+const (
+	DeadlineItemTypeTask                 DeadlineItemType = "TASK"
+	DeadlineItemTypeNonconformity        DeadlineItemType = "NONCONFORMITY"
+	DeadlineItemTypeObligation           DeadlineItemType = "OBLIGATION"
+	DeadlineItemTypeContinualImprovement DeadlineItemType = "CONTINUAL_IMPROVEMENT"
+	DeadlineItemTypeRightsRequest        DeadlineItemType = "RIGHTS_REQUEST"
+	DeadlineItemTypeProcessingActivity   DeadlineItemType = "PROCESSING_ACTIVITY"
+	DeadlineItemTypeVendorRiskAssessment DeadlineItemType = "VENDOR_RISK_ASSESSMENT"
+	DeadlineItemTypeDocumentReview       DeadlineItemType = "DOCUMENT_REVIEW"
+)
+
+func (t DeadlineItemType) String() string {
+	return string(t)
+}
+
+// LoadOpenByOrganizationID loads the open items of the organization whose
+// deadline falls between since and until, both inclusive. Path is the
+// console path of the item relative to the organization.
+func (items *DeadlineItems) LoadOpenByOrganizationID(
+	ctx context.Context,
+	conn pg.Conn,
+	scope Scoper,
+	organizationID gid.GID,
+	since time.Time,
+	until time.Time,
+) error {
+	q := `
+WITH items AS (
+    SELECT
+        id AS entity_id,
+        'TASK' AS type,
+        name AS title,
+        deadline::date AS deadline,
+        assigned_to_profile_id AS owner_profile_id,
+        'tasks' AS path
+    FROM
+        tasks
+    WHERE
+        %[1]s
+        AND organization_id = @organization_id
+        AND state <> 'DONE'
+        AND deadline IS NOT NULL
+
+    UNION ALL
+
+    SELECT
+        id,
+        'NONCONFORMITY',
+        reference_id,
+        due_date::date,
+        owner_profile_id,
+        'nonconformities/' || id
+    FROM
+        nonconformities
+    WHERE
+        %[1]s
+        AND organization_id = @organization_id
+        AND snapshot_id IS NULL
+        AND status <> 'CLOSED'
+        AND due_date IS NOT NULL
+
+    UNION ALL
+
+    SELECT
+        id,
+        'OBLIGATION',
+        COALESCE(requirement, area, 'Obligation'),
+        due_date::date,
+        owner_profile_id,
+        'obligations/' || id
+    FROM
+        obligations
+    WHERE
+        %[1]s
+        AND organization_id = @organization_id
+        AND snapshot_id IS NULL
+        AND status <> 'COMPLIANT'
+        AND due_date IS NOT NULL
+
+    UNION ALL
+
+    SELECT
+        id,
+        'CONTINUAL_IMPROVEMENT',
+        reference_id,
+        target_date::date,
+        owner_profile_id,
+        'continual-improvements/' || id
+    FROM
+        continual_improvements
+    WHERE
+        %[1]s
+        AND organization_id = @organization_id
+        AND snapshot_id IS NULL
+        AND status <> 'CLOSED'
+        AND target_date IS NOT NULL
+
+    UNION ALL
+
+    SELECT
+        id,
+        'RIGHTS_REQUEST',
+        COALESCE(data_subject, request_type::text),
+        deadline::date,
+        NULL,
+        'rights-requests/' || id
+    FROM
+        rights_requests
+    WHERE
+        %[1]s
+        AND organization_id = @organization_id
+        AND request_state <> 'DONE'
+        AND deadline IS NOT NULL
+
+    UNION ALL
+
+    SELECT
+        id,
+        'PROCESSING_ACTIVITY',
+        name,
+        next_review_date::date,
+        dpo_profile_id,
+        'processing-activities/' || id
+    FROM
+        processing_activities
+    WHERE
+        %[1]s
+        AND organization_id = @organization_id
+        AND snapshot_id IS NULL
+        AND next_review_date IS NOT NULL
+
+    UNION ALL
+
+    SELECT
+        vra.id,
+        'VENDOR_RISK_ASSESSMENT',
+        v.name,
+        vra.expires_at::date,
+        v.business_owner_profile_id,
+        'vendors/' || v.id || '/risks'
+    FROM
+        (
+            SELECT DISTINCT ON (vendor_id)
+                id,
+                vendor_id,
+                expires_at
+            FROM
+                vendor_risk_assessments
+            WHERE
+                %[1]s
+                AND organization_id = @organization_id
+                AND snapshot_id IS NULL
+            ORDER BY
+                vendor_id,
+                expires_at DESC
+        ) vra
+    INNER JOIN
+        vendors v ON v.id = vra.vendor_id
+
+    UNION ALL
+
+    SELECT
+        id,
+        'DOCUMENT_REVIEW',
+        title,
+        next_review_date,
+        owner_profile_id,
+        'documents/' || id
+    FROM
+        documents
+    WHERE
+        %[1]s
+        AND organization_id = @organization_id
+        AND deleted_at IS NULL
+        AND next_review_date IS NOT NULL
+)
+SELECT
+    entity_id,
+    type,
+    title,
+    deadline,
+    owner_profile_id,
+    path
+FROM
+    items
+WHERE
+    deadline BETWEEN @since::date AND @until::date
+ORDER BY
+    deadline ASC,
+    entity_id ASC
+`
+
+	q = fmt.Sprintf(q, scope.SQLFragment())
+
+	args := pgx.StrictNamedArgs{
+		"organization_id": organizationID,
+		"since":           since,
+		"until":           until,
+	}
+	maps.Copy(args, scope.SQLArguments())
+
+	rows, err := conn.Query(ctx, q, args)
+	if err != nil {
+		return fmt.Errorf("cannot query deadline items: %w", err)
+	}
+
+	result, err := pgx.CollectRows(rows, pgx.RowToAddrOfStructByName[DeadlineItem])
+	if err != nil {
+		return fmt.Errorf("cannot collect deadline items: %w", err)
+	}
+
+	*items = result
+
+	return nil
+}
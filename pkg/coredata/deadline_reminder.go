@@ -0,0 +1,104 @@
+// Copyright (c) 2025 Probo Inc <hello@getprobo.com>.
+//
+// Permission to use, copy, modify, and/or distribute this software for any
+// purpose with or without fee is hereby granted, provided that the above
+// copyright notice and this permission notice appear in all copies.
+//
+// THE SOFTWARE IS PROVIDED "AS IS" AND THE AUTHOR DISCLAIMS ALL WARRANTIES WITH
+// REGARD TO THIS SOFTWARE INCLUDING ALL IMPLIED WARRANTIES OF MERCHANTABILITY
+// AND FITNESS. IN NO EVENT SHALL THE AUTHOR BE LIABLE FOR ANY SPECIAL, DIRECT,
+// INDIRECT, OR CONSEQUENTIAL DAMAGES OR ANY DAMAGES WHATSOEVER RESULTING FROM
+// LOSS OF USE, DATA OR PROFITS, WHETHER IN AN ACTION OF CONTRACT, NEGLIGENCE OR
+// OTHER TORTIOUS ACTION, ARISING OUT OF OR IN CONNECTION WITH THE USE OR
+// PERFORMANCE OF THIS SOFTWARE.
+
+package coredata
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"go.gearno.de/kit/pg"
+	"go.probo.inc/probo/pkg/gid"
+)
+
+type (
+	// DeadlineReminder records a reminder sent for a deadline so that it
+	// is sent only once. A moved deadline starts a new series.
+	DeadlineReminder struct {
+		ID             gid.GID              `db:"id"`
+		OrganizationID gid.GID              `db:"organization_id"`
+		EntityID       gid.GID              `db:"entity_id"`
+		Deadline       time.Time            `db:"deadline"`
+		Kind           DeadlineReminderKind `db:"kind"`
+		OffsetDays     int                  `db:"offset_days"`
+		CreatedAt      time.Time            `db:"created_at"`
+	}
+)
+
+// AuthorizationAttributes returns the authorization attributes for policy evaluation.
+func (r *DeadlineReminder) AuthorizationAttributes(ctx context.Context, conn pg.Conn) (map[string]string, error) {
+	q := `SELECT organization_id FROM deadline_reminders WHERE id = $1 LIMIT 1;`
+
+	var organizationID gid.GID
+	if err := conn.QueryRow(ctx, q, r.ID).Scan(&organizationID); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrResourceNotFound
+		}
+		return nil, fmt.Errorf("cannot query deadline reminder authorization attributes: %w", err)
+	}
+
+	return map[string]string{"organization_id": organizationID.String()}, nil
+}
+
+// InsertIfNotSent records the reminder and reports whether it was new.
+// It returns false when the same reminder was already sent.
+func (r *DeadlineReminder) InsertIfNotSent(
+	ctx context.Context,
+	conn pg.Conn,
+	scope Scoper,
+) (bool, error) {
+	q := `
+INSERT INTO deadline_reminders (
+    id,
+    tenant_id,
+    organization_id,
+    entity_id,
+    deadline,
+    kind,
+    offset_days,
+    created_at
+) VALUES (
+    @id,
+    @tenant_id,
+    @organization_id,
+    @entity_id,
+    @deadline,
+    @kind,
+    @offset_days,
+    @created_at
+)
+ON CONFLICT (entity_id, deadline, kind, offset_days) DO NOTHING
+`
+
+	args := pgx.StrictNamedArgs{
+		"id":              r.ID,
+		"tenant_id":       scope.GetTenantID(),
+		"organization_id": r.OrganizationID,
+		"entity_id":       r.EntityID,
+		"deadline":        r.Deadline,
+		"kind":            r.Kind,
+		"offset_days":     r.OffsetDays,
+		"created_at":      r.CreatedAt,
+	}
+
+	result, err := conn.Exec(ctx, q, args)
+	if err != nil {
+		return false, fmt.Errorf("cannot insert deadline reminder: %w", err)
+	}
+
+	return result.RowsAffected() == 1, nil
+}
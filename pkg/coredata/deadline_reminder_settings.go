@@ -0,0 +1,308 @@
+// Copyright (c) 2025 Probo Inc <hello@getprobo.com>.
+//
+// Permission to use, copy, modify, and/or distribute this software for any
+// purpose with or without fee is hereby granted, provided that the above
+// copyright notice and this permission notice appear in all copies.
+//
+// THE SOFTWARE IS PROVIDED "AS IS" AND THE AUTHOR DISCLAIMS ALL WARRANTIES WITH
+// REGARD TO THIS SOFTWARE INCLUDING ALL IMPLIED WARRANTIES OF MERCHANTABILITY
+// AND FITNESS. IN NO EVENT SHALL THE AUTHOR BE LIABLE FOR ANY SPECIAL, DIRECT,
+// INDIRECT, OR CONSEQUENTIAL DAMAGES OR ANY DAMAGES WHATSOEVER RESULTING FROM
+// LOSS OF USE, DATA OR PROFITS, WHETHER IN AN ACTION OF CONTRACT, NEGLIGENCE OR
+// OTHER TORTIOUS ACTION, ARISING OUT OF OR IN CONNECTION WITH THE USE OR
+// PERFORMANCE OF THIS SOFTWARE.
+
+package coredata
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"maps"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"go.gearno.de/kit/pg"
+	"go.probo.inc/probo/pkg/gid"
+)
+
+type (
+	// DeadlineReminderSettings configures when reminders are sent for the
+	// deadlines of an organization and when they escalate to admins.
+	DeadlineReminderSettings struct {
+		ID                  gid.GID    `db:"id"`
+		OrganizationID      gid.GID    `db:"organization_id"`
+		Enabled             bool       `db:"enabled"`
+		DaysBefore          []int      `db:"days_before"`
+		RemindOnDueDate     bool       `db:"remind_on_due_date"`
+		OverdueIntervalDays int        `db:"overdue_interval_days"`
+		EscalationGraceDays *int       `db:"escalation_grace_days"`
+		SlackEnabled        bool       `db:"slack_enabled"`
+		NextRunAt           time.Time  `db:"next_run_at"`
+		LastRunAt           *time.Time `db:"last_run_at"`
+		LastError           *string    `db:"last_error"`
+		CreatedAt           time.Time  `db:"created_at"`
+		UpdatedAt           time.Time  `db:"updated_at"`
+	}
+)
+
+var ErrNoDeadlineReminderSettingsDue = errors.New("no deadline reminder settings due")
+
+// NewDeadlineReminderSettings returns the default reminder settings of a
+// new organization.
+func NewDeadlineReminderSettings(
+	tenantID gid.TenantID,
+	organizationID gid.GID,
+	now time.Time,
+) *DeadlineReminderSettings {
+	escalationGraceDays := 3
+
+	return &DeadlineReminderSettings{
+		ID:                  gid.New(tenantID, DeadlineReminderSettingsEntityType),
+		OrganizationID:      organizationID,
+		Enabled:             true,
+		DaysBefore:          []int{7, 1},
+		RemindOnDueDate:     true,
+		OverdueIntervalDays: 7,
+		EscalationGraceDays: &escalationGraceDays,
+		SlackEnabled:        true,
+		NextRunAt:           now,
+		CreatedAt:           now,
+		UpdatedAt:           now,
+	}
+}
+
+// AuthorizationAttributes returns the authorization attributes for policy evaluation.
+func (s *DeadlineReminderSettings) AuthorizationAttributes(ctx context.Context, conn pg.Conn) (map[string]string, error) {
+	q := `SELECT organization_id FROM deadline_reminder_settings WHERE id = $1 LIMIT 1;`
+
+	var organizationID gid.GID
+	if err := conn.QueryRow(ctx, q, s.ID).Scan(&organizationID); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrResourceNotFound
+		}
+		return nil, fmt.Errorf("cannot query deadline reminder settings authorization attributes: %w", err)
+	}
+
+	return map[string]string{"organization_id": organizationID.String()}, nil
+}
+
+func (s *DeadlineReminderSettings) LoadByOrganizationID(
+	ctx context.Context,
+	conn pg.Conn,
+	scope Scoper,
+	organizationID gid.GID,
+) error {
+	q := `
+SELECT
+    id,
+    organization_id,
+    enabled,
+    days_before,
+    remind_on_due_date,
+    overdue_interval_days,
+    escalation_grace_days,
+    slack_enabled,
+    next_run_at,
+    last_run_at,
+    last_error,
+    created_at,
+    updated_at
+FROM
+    deadline_reminder_settings
+WHERE
+    %s
+    AND organization_id = @organization_id
+LIMIT 1;
+`
+
+	q = fmt.Sprintf(q, scope.SQLFragment())
+
+	args := pgx.StrictNamedArgs{"organization_id": organizationID}
+	maps.Copy(args, scope.SQLArguments())
+
+	rows, err := conn.Query(ctx, q, args)
+	if err != nil {
+		return fmt.Errorf("cannot query deadline reminder settings: %w", err)
+	}
+
+	settings, err := pgx.CollectExactlyOneRow(rows, pgx.RowToStructByName[DeadlineReminderSettings])
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return ErrResourceNotFound
+		}
+		return fmt.Errorf("cannot collect deadline reminder settings: %w", err)
+	}
+
+	*s = settings
+
+	return nil
+}
+
+// LoadNextDueForUpdateSkipLocked locks the settings whose next run is the
+// most overdue, skipping settings locked by other workers. It returns
+// ErrNoDeadlineReminderSettingsDue when no settings are due.
+func (s *DeadlineReminderSettings) LoadNextDueForUpdateSkipLocked(
+	ctx context.Context,
+	conn pg.Conn,
+	now time.Time,
+) error {
+	q := `
+SELECT
+    id,
+    organization_id,
+    enabled,
+    days_before,
+    remind_on_due_date,
+    overdue_interval_days,
+    escalation_grace_days,
+    slack_enabled,
+    next_run_at,
+    last_run_at,
+    last_error,
+    created_at,
+    updated_at
+FROM
+    deadline_reminder_settings
+WHERE
+    next_run_at <= @now
+ORDER BY
+    next_run_at ASC
+LIMIT 1
+FOR UPDATE SKIP LOCKED
+`
+
+	args := pgx.StrictNamedArgs{"now": now}
+
+	rows, err := conn.Query(ctx, q, args)
+	if err != nil {
+		return fmt.Errorf("cannot query deadline reminder settings: %w", err)
+	}
+
+	settings, err := pgx.CollectExactlyOneRow(rows, pgx.RowToStructByName[DeadlineReminderSettings])
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return ErrNoDeadlineReminderSettingsDue
+		}
+		return fmt.Errorf("cannot collect deadline reminder settings: %w", err)
+	}
+
+	*s = settings
+
+	return nil
+}
+
+func (s *DeadlineReminderSettings) Insert(
+	ctx context.Context,
+	conn pg.Conn,
+	scope Scoper,
+) error {
+	q := `
+INSERT INTO deadline_reminder_settings (
+    id,
+    tenant_id,
+    organization_id,
+    enabled,
+    days_before,
+    remind_on_due_date,
+    overdue_interval_days,
+    escalation_grace_days,
+    slack_enabled,
+    next_run_at,
+    last_run_at,
+    last_error,
+    created_at,
+    updated_at
+) VALUES (
+    @id,
+    @tenant_id,
+    @organization_id,
+    @enabled,
+    @days_before,
+    @remind_on_due_date,
+    @overdue_interval_days,
+    @escalation_grace_days,
+    @slack_enabled,
+    @next_run_at,
+    @last_run_at,
+    @last_error,
+    @created_at,
+    @updated_at
+)
+`
+
+	args := pgx.StrictNamedArgs{
+		"id":                    s.ID,
+		"tenant_id":             scope.GetTenantID(),
+		"organization_id":       s.OrganizationID,
+		"enabled":               s.Enabled,
+		"days_before":           s.DaysBefore,
+		"remind_on_due_date":    s.RemindOnDueDate,
+		"overdue_interval_days": s.OverdueIntervalDays,
+		"escalation_grace_days": s.EscalationGraceDays,
+		"slack_enabled":         s.SlackEnabled,
+		"next_run_at":           s.NextRunAt,
+		"last_run_at":           s.LastRunAt,
+		"last_error":            s.LastError,
+		"created_at":            s.CreatedAt,
+		"updated_at":            s.UpdatedAt,
+	}
+
+	if _, err := conn.Exec(ctx, q, args); err != nil {
+		return fmt.Errorf("cannot insert deadline reminder settings: %w", err)
+	}
+
+	return nil
+}
+
+func (s *DeadlineReminderSettings) Update(
+	ctx context.Context,
+	conn pg.Conn,
+	scope Scoper,
+) error {
+	q := `
+UPDATE deadline_reminder_settings
+SET
+    enabled = @enabled,
+    days_before = @days_before,
+    remind_on_due_date = @remind_on_due_date,
+    overdue_interval_days = @overdue_interval_days,
+    escalation_grace_days = @escalation_grace_days,
+    slack_enabled = @slack_enabled,
+    next_run_at = @next_run_at,
+    last_run_at = @last_run_at,
+    last_error = @last_error,
+    updated_at = @updated_at
+WHERE
+    %s
+    AND id = @id
+`
+
+	q = fmt.Sprintf(q, scope.SQLFragment())
+
+	args := pgx.StrictNamedArgs{
+		"id":                    s.ID,
+		"enabled":               s.Enabled,
+		"days_before":           s.DaysBefore,
+		"remind_on_due_date":    s.RemindOnDueDate,
+		"overdue_interval_days": s.OverdueIntervalDays,
+		"escalation_grace_days": s.EscalationGraceDays,
+		"slack_enabled":         s.SlackEnabled,
+		"next_run_at":           s.NextRunAt,
+		"last_run_at":           s.LastRunAt,
+		"last_error":            s.LastError,
+		"updated_at":            s.UpdatedAt,
+	}
+	maps.Copy(args, scope.SQLArguments())
+
+	result, err := conn.Exec(ctx, q, args)
+	if err != nil {
+		return fmt.Errorf("cannot update deadline reminder settings: %w", err)
+	}
+
+	if result.RowsAffected() == 0 {
+		return ErrResourceNotFound
+	}
+
+	return nil
+}
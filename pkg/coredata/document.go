@@ -37,6 +37,10 @@ type (
 		Classification          DocumentClassification `db:"classification"`
 		CurrentPublishedVersion *int                   `db:"current_published_version"`
 		TrustCenterVisibility   TrustCenterVisibility  `db:"trust_center_visibility"`
+		OwnerProfileID          *gid.GID               `db:"owner_profile_id"`
+		ReviewIntervalDays      *int                   `db:"review_interval_days"`
+		NextReviewDate          *time.Time             `db:"next_review_date"`
+		LastReviewedAt          *time.Time             `db:"last_reviewed_at"`
 		CreatedAt               time.Time              `db:"created_at"`
 		UpdatedAt               time.Time              `db:"updated_at"`
 	}
@@ -87,6 +91,10 @@ SELECT
     classification,
     current_published_version,
     trust_center_visibility,
+    owner_profile_id,
+    review_interval_days,
+    next_review_date,
+    last_reviewed_at,
     created_at,
     updated_at
 FROM
@@ -138,6 +146,10 @@ SELECT
     classification,
     current_published_version,
     trust_center_visibility,
+    owner_profile_id,
+    review_interval_days,
+    next_review_date,
+    last_reviewed_at,
     created_at,
     updated_at
 FROM
@@ -190,6 +202,10 @@ SELECT
     classification,
     current_published_version,
     trust_center_visibility,
+    owner_profile_id,
+    review_interval_days,
+    next_review_date,
+    last_reviewed_at,
     created_at,
     updated_at
 FROM
@@ -271,6 +287,10 @@ SELECT
     classification,
     current_published_version,
     trust_center_visibility,
+    owner_profile_id,
+    review_interval_days,
+    next_review_date,
+    last_reviewed_at,
     created_at,
     updated_at
 FROM
@@ -321,6 +341,10 @@ SELECT
     classification,
     current_published_version,
     trust_center_visibility,
+    owner_profile_id,
+    review_interval_days,
+    next_review_date,
+    last_reviewed_at,
     created_at,
     updated_at
 FROM
@@ -370,6 +394,10 @@ INSERT INTO
 		classification,
 		current_published_version,
 		trust_center_visibility,
+		owner_profile_id,
+		review_interval_days,
+		next_review_date,
+		last_reviewed_at,
 		created_at,
 		updated_at
     )
@@ -382,6 +410,10 @@ VALUES (
     @classification,
     @current_published_version,
     @trust_center_visibility,
+    @owner_profile_id,
+    @review_interval_days,
+    @next_review_date,
+    @last_reviewed_at,
     @created_at,
     @updated_at
 );
@@ -396,6 +428,10 @@ VALUES (
 		"classification":            p.Classification,
 		"current_published_version": p.CurrentPublishedVersion,
 		"trust_center_visibility":   p.TrustCenterVisibility,
+		"owner_profile_id":          p.OwnerProfileID,
+		"review_interval_days":      p.ReviewIntervalDays,
+		"next_review_date":          p.NextReviewDate,
+		"last_reviewed_at":          p.LastReviewedAt,
 		"created_at":                p.CreatedAt,
 		"updated_at":                p.UpdatedAt,
 	}
@@ -454,6 +490,10 @@ SET
 	document_type = @document_type,
 	classification = @classification,
 	trust_center_visibility = @trust_center_visibility,
+	owner_profile_id = @owner_profile_id,
+	review_interval_days = @review_interval_days,
+	next_review_date = @next_review_date,
+	last_reviewed_at = @last_reviewed_at,
 	updated_at = @updated_at
 WHERE
 	%s
@@ -470,6 +510,10 @@ WHERE
 		"document_type":             p.DocumentType,
 		"classification":            p.Classification,
 		"trust_center_visibility":   p.TrustCenterVisibility,
+		"owner_profile_id":          p.OwnerProfileID,
+		"review_interval_days":      p.ReviewIntervalDays,
+		"next_review_date":          p.NextReviewDate,
+		"last_reviewed_at":          p.LastReviewedAt,
 	}
 	maps.Copy(args, scope.SQLArguments())
 
@@ -542,6 +586,10 @@ SELECT
 	scoped_documents.classification,
 	scoped_documents.current_published_version,
 	scoped_documents.trust_center_visibility,
+	scoped_documents.owner_profile_id,
+	scoped_documents.review_interval_days,
+	scoped_documents.next_review_date,
+	scoped_documents.last_reviewed_at,
 	scoped_documents.created_at,
 	scoped_documents.updated_at
 FROM scoped_documents
@@ -631,6 +679,10 @@ SELECT
 	scoped_documents.classification,
 	scoped_documents.current_published_version,
 	scoped_documents.trust_center_visibility,
+	scoped_documents.owner_profile_id,
+	scoped_documents.review_interval_days,
+	scoped_documents.next_review_date,
+	scoped_documents.last_reviewed_at,
 	scoped_documents.created_at,
 	scoped_documents.updated_at
 FROM scoped_documents
@@ -714,12 +766,10 @@ WITH last_signable_version AS (
 				AND i2.email_address = @user_email::CITEXT
 		)
 )
-SELECT EXISTS (
-	SELECT 1
-	FROM last_signable_version
-	WHERE %s
-		AND state = 'SIGNED'
-) AS signed
+SELECT
+	COALESCE(bool_and(state = 'SIGNED'), FALSE) AS signed
+FROM last_signable_version
+WHERE %s
 `
 
 	q = fmt.Sprintf(q, scope.SQLFragment())
@@ -29,6 +29,7 @@ type (
 	DocumentApprover struct {
 		DocumentID        gid.GID      `db:"document_id"`
 		ApproverProfileID gid.GID      `db:"approver_profile_id"`
+		Stage             int          `db:"stage"`
 		OrganizationID    gid.GID      `db:"organization_id"`
 		TenantID          gid.TenantID `db:"tenant_id"`
 		CreatedAt         time.Time    `db:"created_at"`
@@ -47,6 +48,7 @@ INSERT INTO
     document_approvers (
         document_id,
         approver_profile_id,
+        stage,
         organization_id,
         tenant_id,
         created_at
@@ -54,6 +56,7 @@ INSERT INTO
 VALUES (
     @document_id,
     @approver_profile_id,
+    @stage,
     @organization_id,
     @tenant_id,
     @created_at
@@ -64,6 +67,7 @@ ON CONFLICT (document_id, approver_profile_id) DO NOTHING;
 	args := pgx.StrictNamedArgs{
 		"document_id":        da.DocumentID,
 		"approver_profile_id": da.ApproverProfileID,
+		"stage":              da.Stage,
 		"organization_id":    da.OrganizationID,
 		"tenant_id":          scope.GetTenantID(),
 		"created_at":         da.CreatedAt,
@@ -87,6 +91,7 @@ func (da *DocumentApprovers) LoadByDocumentID(
 SELECT
     document_id,
     approver_profile_id,
+    stage,
     organization_id,
     tenant_id,
     created_at
@@ -95,7 +100,7 @@ FROM
 WHERE
     %s
     AND document_id = @document_id
-ORDER BY created_at ASC
+ORDER BY stage ASC, created_at ASC
 `
 
 	q = fmt.Sprintf(q, scope.SQLFragment())
@@ -25,6 +25,7 @@ type (
 		trustCenterVisibilities []TrustCenterVisibility
 		published               *bool
 		userEmail               *mail.Addr
+		reviewOverdue           *bool
 	}
 )
 
@@ -53,6 +54,13 @@ func (f *DocumentFilter) WithUserEmail(userEmail *mail.Addr) *DocumentFilter {
 	return f
 }
 
+// WithReviewOverdue keeps the documents whose next review date is past,
+// or the ones whose review is not overdue when reviewOverdue is false.
+func (f *DocumentFilter) WithReviewOverdue(reviewOverdue *bool) *DocumentFilter {
+	f.reviewOverdue = reviewOverdue
+	return f
+}
+
 func (f *DocumentFilter) SQLArguments() pgx.NamedArgs {
 	var visibilities []string
 	if f.trustCenterVisibilities != nil {
@@ -66,6 +74,7 @@ func (f *DocumentFilter) SQLArguments() pgx.NamedArgs {
 		"trust_center_visibilities": visibilities,
 		"published":                 f.published,
 		"user_email":                f.userEmail,
+		"review_overdue":            f.reviewOverdue,
 	}
 }
 
@@ -107,5 +116,11 @@ func (f *DocumentFilter) SQLFragment() string {
 				AND dvs.state IN ('REQUESTED', 'SIGNED')
 		)
 	END
+	AND
+	CASE
+		WHEN @review_overdue::boolean IS NULL THEN TRUE
+		WHEN @review_overdue::boolean IS TRUE THEN next_review_date < CURRENT_DATE
+		WHEN @review_overdue::boolean IS FALSE THEN next_review_date IS NULL OR next_review_date >= CURRENT_DATE
+	END
 )`
 }
@@ -175,6 +175,63 @@ LIMIT 1;
 	return nil
 }
 
+// LoadByIDForUpdate loads the document version and locks its row until the
+// end of the transaction, serializing concurrent changes to the version and
+// its approvals.
+func (dv *DocumentVersion) LoadByIDForUpdate(
+	ctx context.Context,
+	conn pg.Conn,
+	scope Scoper,
+	documentVersionID gid.GID,
+) error {
+	q := `
+SELECT
+	id,
+	organization_id,
+	document_id,
+	title,
+	version_number,
+	classification,
+	content,
+	changelog,
+	status,
+	published_at,
+	created_at,
+	updated_at
+FROM
+	document_versions
+WHERE
+	%s
+	AND id = @document_version_id
+FOR UPDATE;
+`
+
+	q = fmt.Sprintf(q, scope.SQLFragment())
+
+	args := pgx.StrictNamedArgs{
+		"document_version_id": documentVersionID,
+	}
+	maps.Copy(args, scope.SQLArguments())
+
+	rows, err := conn.Query(ctx, q, args)
+	if err != nil {
+		return fmt.Errorf("cannot query document versions: %w", err)
+	}
+
+	documentVersion, err := pgx.CollectExactlyOneRow(rows, pgx.RowToStructByName[DocumentVersion])
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return ErrResourceNotFound
+		}
+
+		return fmt.Errorf("cannot collect document version: %w", err)
+	}
+
+	*dv = documentVersion
+
+	return nil
+}
+
 func (dv DocumentVersion) Insert(
 	ctx context.Context,
 	conn pg.Conn,
@@ -16,6 +16,7 @@ package coredata
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"maps"
 	"time"
@@ -26,12 +27,20 @@ import (
 )
 
 type (
+	// DocumentVersionApprover is the decision of one approver on a
+	// document version. Approvers of the same stage decide in parallel,
+	// stages are decided in ascending order.
 	DocumentVersionApprover struct {
-		DocumentVersionID gid.GID      `db:"document_version_id"`
-		ApproverProfileID gid.GID      `db:"approver_profile_id"`
-		OrganizationID    gid.GID      `db:"organization_id"`
-		TenantID          gid.TenantID `db:"tenant_id"`
-		CreatedAt         time.Time    `db:"created_at"`
+		DocumentVersionID gid.GID                      `db:"document_version_id"`
+		ApproverProfileID gid.GID                      `db:"approver_profile_id"`
+		Stage             int                          `db:"stage"`
+		State             DocumentVersionApprovalState `db:"state"`
+		Comment           *string                      `db:"comment"`
+		DecidedAt         *time.Time                   `db:"decided_at"`
+		OrganizationID    gid.GID                      `db:"organization_id"`
+		TenantID          gid.TenantID                 `db:"tenant_id"`
+		CreatedAt         time.Time                    `db:"created_at"`
+		UpdatedAt         time.Time                    `db:"updated_at"`
 	}
 
 	DocumentVersionApprovers []*DocumentVersionApprover
@@ -47,16 +56,26 @@ INSERT INTO
     document_version_approvers (
         document_version_id,
         approver_profile_id,
+        stage,
+        state,
+        comment,
+        decided_at,
         organization_id,
         tenant_id,
-        created_at
+        created_at,
+        updated_at
     )
 VALUES (
     @document_version_id,
     @approver_profile_id,
+    @stage,
+    @state,
+    @comment,
+    @decided_at,
     @organization_id,
     @tenant_id,
-    @created_at
+    @created_at,
+    @updated_at
 )
 ON CONFLICT (document_version_id, approver_profile_id) DO NOTHING;
 `
@@ -64,9 +83,14 @@ ON CONFLICT (document_version_id, approver_profile_id) DO NOTHING;
 	args := pgx.StrictNamedArgs{
 		"document_version_id": dva.DocumentVersionID,
 		"approver_profile_id": dva.ApproverProfileID,
+		"stage":               dva.Stage,
+		"state":               dva.State,
+		"comment":             dva.Comment,
+		"decided_at":          dva.DecidedAt,
 		"organization_id":     dva.OrganizationID,
 		"tenant_id":           scope.GetTenantID(),
 		"created_at":          dva.CreatedAt,
+		"updated_at":          dva.UpdatedAt,
 	}
 	_, err := conn.Exec(ctx, q, args)
 
@@ -77,6 +101,99 @@ ON CONFLICT (document_version_id, approver_profile_id) DO NOTHING;
 	return nil
 }
 
+func (dva *DocumentVersionApprover) LoadByDocumentVersionIDAndApproverProfileIDForUpdate(
+	ctx context.Context,
+	conn pg.Conn,
+	scope Scoper,
+	documentVersionID gid.GID,
+	approverProfileID gid.GID,
+) error {
+	q := `
+SELECT
+    document_version_id,
+    approver_profile_id,
+    stage,
+    state,
+    comment,
+    decided_at,
+    organization_id,
+    tenant_id,
+    created_at,
+    updated_at
+FROM
+    document_version_approvers
+WHERE
+    %s
+    AND document_version_id = @document_version_id
+    AND approver_profile_id = @approver_profile_id
+FOR UPDATE
+`
+
+	q = fmt.Sprintf(q, scope.SQLFragment())
+
+	args := pgx.StrictNamedArgs{
+		"document_version_id": documentVersionID,
+		"approver_profile_id": approverProfileID,
+	}
+	maps.Copy(args, scope.SQLArguments())
+
+	rows, err := conn.Query(ctx, q, args)
+	if err != nil {
+		return fmt.Errorf("cannot query document version approver: %w", err)
+	}
+
+	approver, err := pgx.CollectExactlyOneRow(rows, pgx.RowToStructByName[DocumentVersionApprover])
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return ErrResourceNotFound
+		}
+
+		return fmt.Errorf("cannot collect document version approver: %w", err)
+	}
+
+	*dva = approver
+
+	return nil
+}
+
+func (dva *DocumentVersionApprover) Update(
+	ctx context.Context,
+	conn pg.Conn,
+	scope Scoper,
+) error {
+	q := `
+UPDATE document_version_approvers
+SET
+    state = @state,
+    comment = @comment,
+    decided_at = @decided_at,
+    updated_at = @updated_at
+WHERE
+    %s
+    AND document_version_id = @document_version_id
+    AND approver_profile_id = @approver_profile_id
+`
+
+	q = fmt.Sprintf(q, scope.SQLFragment())
+
+	args := pgx.StrictNamedArgs{
+		"document_version_id": dva.DocumentVersionID,
+		"approver_profile_id": dva.ApproverProfileID,
+		"state":               dva.State,
+		"comment":             dva.Comment,
+		"decided_at":          dva.DecidedAt,
+		"updated_at":          dva.UpdatedAt,
+	}
+	maps.Copy(args, scope.SQLArguments())
+
+	_, err := conn.Exec(ctx, q, args)
+	if err != nil {
+		return fmt.Errorf("cannot update document version approver: %w", err)
+	}
+
+	return nil
+}
+
 func (dva *DocumentVersionApprovers) LoadByDocumentVersionID(
 	ctx context.Context,
 	conn pg.Conn,
@@ -87,15 +204,20 @@ func (dva *DocumentVersionApprovers) LoadByDocumentVersionID(
 SELECT
     document_version_id,
     approver_profile_id,
+    stage,
+    state,
+    comment,
+    decided_at,
     organization_id,
     tenant_id,
-    created_at
+    created_at,
+    updated_at
 FROM
     document_version_approvers
 WHERE
     %s
     AND document_version_id = @document_version_id
-ORDER BY created_at ASC
+ORDER BY stage ASC, created_at ASC
 `
 
 	q = fmt.Sprintf(q, scope.SQLFragment())
@@ -118,6 +240,43 @@ ORDER BY created_at ASC
 	return nil
 }
 
+// ResetByDocumentVersionID clears the decisions of every approver of the
+// document version to start a new approval round.
+func (dva *DocumentVersionApprovers) ResetByDocumentVersionID(
+	ctx context.Context,
+	conn pg.Conn,
+	scope Scoper,
+	documentVersionID gid.GID,
+	updatedAt time.Time,
+) error {
+	q := `
+UPDATE document_version_approvers
+SET
+    state = 'PENDING',
+    comment = NULL,
+    decided_at = NULL,
+    updated_at = @updated_at
+WHERE
+    %s
+    AND document_version_id = @document_version_id
+`
+
+	q = fmt.Sprintf(q, scope.SQLFragment())
+
+	args := pgx.StrictNamedArgs{
+		"document_version_id": documentVersionID,
+		"updated_at":          updatedAt,
+	}
+	maps.Copy(args, scope.SQLArguments())
+
+	_, err := conn.Exec(ctx, q, args)
+	if err != nil {
+		return fmt.Errorf("cannot reset document version approvers: %w", err)
+	}
+
+	return nil
+}
+
 func (dva *DocumentVersionApprovers) DeleteByDocumentVersionID(
 	ctx context.Context,
 	conn pg.Conn,
@@ -152,3 +311,48 @@ func (dva *DocumentVersionApprovers) ApproverProfileIDs() []gid.GID {
 	}
 	return ids
 }
+
+// Approved reports whether every approver of the document version
+// approved it. A document version without approvers is approved.
+func (dva *DocumentVersionApprovers) Approved() bool {
+	for _, a := range *dva {
+		if a.State != DocumentVersionApprovalStateApproved {
+			return false
+		}
+	}
+	return true
+}
+
+// Rejected reports whether an approver rejected the document version.
+func (dva *DocumentVersionApprovers) Rejected() bool {
+	for _, a := range *dva {
+		if a.State == DocumentVersionApprovalStateRejected {
+			return true
+		}
+	}
+	return false
+}
+
+// PendingStage returns the lowest stage with an approver yet to decide, or
+// 0 when every approver decided.
+func (dva *DocumentVersionApprovers) PendingStage() int {
+	stage := 0
+	for _, a := range *dva {
+		if a.State == DocumentVersionApprovalStatePending && (stage == 0 || a.Stage < stage) {
+			stage = a.Stage
+		}
+	}
+	return stage
+}
+
+// PendingApproverProfileIDs returns the approvers of the given stage yet to
+// decide.
+func (dva *DocumentVersionApprovers) PendingApproverProfileIDs(stage int) []gid.GID {
+	var ids []gid.GID
+	for _, a := range *dva {
+		if a.Stage == stage && a.State == DocumentVersionApprovalStatePending {
+			ids = append(ids, a.ApproverProfileID)
+		}
+	}
+	return ids
+}
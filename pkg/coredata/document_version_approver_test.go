@@ -0,0 +1,116 @@
+// Copyright (c) 2025 Probo Inc <hello@getprobo.com>.
+//
+// Permission to use, copy, modify, and/or distribute this software for any
+// purpose with or without fee is hereby granted, provided that the above
+// copyright notice and this permission notice appear in all copies.
+//
+// THE SOFTWARE IS PROVIDED "AS IS" AND THE AUTHOR DISCLAIMS ALL WARRANTIES WITH
+// REGARD TO THIS SOFTWARE INCLUDING ALL IMPLIED WARRANTIES OF MERCHANTABILITY
+// AND FITNESS. IN NO EVENT SHALL THE AUTHOR BE LIABLE FOR ANY SPECIAL, DIRECT,
+// INDIRECT, OR CONSEQUENTIAL DAMAGES OR ANY DAMAGES WHATSOEVER RESULTING FROM
+// LOSS OF USE, DATA OR PROFITS, WHETHER IN AN ACTION OF CONTRACT, NEGLIGENCE OR
+// OTHER TORTIOUS ACTION, ARISING OUT OF OR IN CONNECTION WITH THE USE OR
+// PERFORMANCE OF THIS SOFTWARE.
+
+package coredata
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.probo.inc/probo/pkg/gid"
+)
+
+func TestDocumentVersionApprovers(t *testing.T) {
+	approver := func(stage int, state DocumentVersionApprovalState) *DocumentVersionApprover {
+		return &DocumentVersionApprover{
+			ApproverProfileID: gid.New(gid.NewTenantID(), MembershipProfileEntityType),
+			Stage:             stage,
+			State:             state,
+		}
+	}
+
+	tests := []struct {
+		name         string
+		approvers    DocumentVersionApprovers
+		pendingStage int
+		approved     bool
+		rejected     bool
+	}{
+		{
+			name:     "no approver",
+			approved: true,
+		},
+		{
+			name: "single stage pending",
+			approvers: DocumentVersionApprovers{
+				approver(1, DocumentVersionApprovalStateApproved),
+				approver(1, DocumentVersionApprovalStatePending),
+			},
+			pendingStage: 1,
+		},
+		{
+			name: "single stage approved",
+			approvers: DocumentVersionApprovers{
+				approver(1, DocumentVersionApprovalStateApproved),
+				approver(1, DocumentVersionApprovalStateApproved),
+			},
+			approved: true,
+		},
+		{
+			name: "first stage decided, second pending",
+			approvers: DocumentVersionApprovers{
+				approver(2, DocumentVersionApprovalStatePending),
+				approver(1, DocumentVersionApprovalStateApproved),
+				approver(3, DocumentVersionApprovalStatePending),
+			},
+			pendingStage: 2,
+		},
+		{
+			name: "lowest pending stage wins whatever the order",
+			approvers: DocumentVersionApprovers{
+				approver(3, DocumentVersionApprovalStatePending),
+				approver(2, DocumentVersionApprovalStatePending),
+			},
+			pendingStage: 2,
+		},
+		{
+			name: "rejected",
+			approvers: DocumentVersionApprovers{
+				approver(1, DocumentVersionApprovalStateRejected),
+				approver(1, DocumentVersionApprovalStatePending),
+				approver(2, DocumentVersionApprovalStatePending),
+			},
+			pendingStage: 1,
+			rejected:     true,
+		},
+		{
+			name: "rejected in last stage",
+			approvers: DocumentVersionApprovers{
+				approver(1, DocumentVersionApprovalStateApproved),
+				approver(2, DocumentVersionApprovalStateRejected),
+			},
+			rejected: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.pendingStage, tt.approvers.PendingStage())
+			assert.Equal(t, tt.approved, tt.approvers.Approved())
+			assert.Equal(t, tt.rejected, tt.approvers.Rejected())
+		})
+	}
+}
+
+func TestDocumentVersionApproversPendingApproverProfileIDs(t *testing.T) {
+	approvers := DocumentVersionApprovers{
+		{ApproverProfileID: gid.New(gid.NewTenantID(), MembershipProfileEntityType), Stage: 1, State: DocumentVersionApprovalStateApproved},
+		{ApproverProfileID: gid.New(gid.NewTenantID(), MembershipProfileEntityType), Stage: 1, State: DocumentVersionApprovalStatePending},
+		{ApproverProfileID: gid.New(gid.NewTenantID(), MembershipProfileEntityType), Stage: 2, State: DocumentVersionApprovalStatePending},
+	}
+
+	assert.Equal(t, []gid.GID{approvers[1].ApproverProfileID}, approvers.PendingApproverProfileIDs(1))
+	assert.Equal(t, []gid.GID{approvers[2].ApproverProfileID}, approvers.PendingApproverProfileIDs(2))
+	assert.Empty(t, approvers.PendingApproverProfileIDs(3))
+}
@@ -78,6 +78,10 @@ func (dvs *DocumentVersionSignature) AuthorizationAttributes(ctx context.Context
 	return map[string]string{"organization_id": organizationID.String()}, nil
 }
 
+// LoadByDocumentVersionIDAndSignatory loads the signature of the signatory
+// on the document version. A signatory asked to acknowledge a version again
+// has several signatures on it, the pending one is loaded first, then the
+// most recently requested one.
 func (pvs *DocumentVersionSignature) LoadByDocumentVersionIDAndSignatory(
 	ctx context.Context,
 	conn pg.Conn,
@@ -102,6 +106,9 @@ WHERE
 	%s
 	AND document_version_id = @document_version_id
 	AND signed_by_profile_id = @signatory
+ORDER BY
+	state = 'REQUESTED' DESC,
+	requested_at DESC
 LIMIT 1
 `
 
@@ -411,6 +418,8 @@ WHERE
 	return nil
 }
 
+// IsSignedByUserEmail reports whether the user signed the document version
+// and has no pending request to sign it again.
 func (pvs *DocumentVersionSignature) IsSignedByUserEmail(
 	ctx context.Context,
 	conn pg.Conn,
@@ -419,16 +428,14 @@ func (pvs *DocumentVersionSignature) IsSignedByUserEmail(
 	userEmail mail.Addr,
 ) (bool, error) {
 	q := `
-SELECT EXISTS (
-	SELECT 1
-	FROM document_version_signatures dvs
-	INNER JOIN iam_membership_profiles p ON dvs.signed_by_profile_id = p.id
-	INNER JOIN identities i ON p.identity_id = i.id
-	WHERE dvs.document_version_id = @document_version_id
-		AND i.email_address = @user_email::CITEXT
-		AND dvs.state = 'SIGNED'
-		AND dvs.tenant_id = @tenant_id
-) AS signed
+SELECT
+	COALESCE(bool_and(dvs.state = 'SIGNED'), FALSE) AS signed
+FROM document_version_signatures dvs
+INNER JOIN iam_membership_profiles p ON dvs.signed_by_profile_id = p.id
+INNER JOIN identities i ON p.identity_id = i.id
+WHERE dvs.document_version_id = @document_version_id
+	AND i.email_address = @user_email::CITEXT
+	AND dvs.tenant_id = @tenant_id
 `
 
 	args := pgx.StrictNamedArgs{
@@ -0,0 +1,228 @@
+// Copyright (c) 2025 Probo Inc <hello@getprobo.com>.
+//
+// Permission to use, copy, modify, and/or distribute this software for any
+// purpose with or without fee is hereby granted, provided that the above
+// copyright notice and this permission notice appear in all copies.
+//
+// THE SOFTWARE IS PROVIDED "AS IS" AND THE AUTHOR DISCLAIMS ALL WARRANTIES WITH
+// REGARD TO THIS SOFTWARE INCLUDING ALL IMPLIED WARRANTIES OF MERCHANTABILITY
+// AND FITNESS. IN NO EVENT SHALL THE AUTHOR BE LIABLE FOR ANY SPECIAL, DIRECT,
+// INDIRECT, OR CONSEQUENTIAL DAMAGES OR ANY DAMAGES WHATSOEVER RESULTING FROM
+// LOSS OF USE, DATA OR PROFITS, WHETHER IN AN ACTION OF CONTRACT, NEGLIGENCE OR
+// OTHER TORTIOUS ACTION, ARISING OUT OF OR IN CONNECTION WITH THE USE OR
+// PERFORMANCE OF THIS SOFTWARE.
+
+package coredata
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"maps"
+	"net"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"go.gearno.de/kit/pg"
+	"go.probo.inc/probo/pkg/gid"
+	"go.probo.inc/probo/pkg/mail"
+)
+
+type (
+	// DocumentVersionSignatureProof binds a signature to the content the
+	// signer was shown and to the signer session. Proofs of a document
+	// version form a hash chain ordered by sequence.
+	DocumentVersionSignatureProof struct {
+		DocumentVersionSignatureID gid.GID   `db:"document_version_signature_id"`
+		DocumentVersionID          gid.GID   `db:"document_version_id"`
+		OrganizationID             gid.GID   `db:"organization_id"`
+		Sequence                   int       `db:"sequence"`
+		ContentHash                string    `db:"content_hash"`
+		SignerFullName             string    `db:"signer_full_name"`
+		SignerEmailAddress         mail.Addr `db:"signer_email_address"`
+		SignerIPAddress            net.IP    `db:"signer_ip_address"`
+		SignerUserAgent            string    `db:"signer_user_agent"`
+		SignerAuthMethod           string    `db:"signer_auth_method"`
+		SignedAt                   time.Time `db:"signed_at"`
+		PreviousHash               string    `db:"previous_hash"`
+		Hash                       string    `db:"hash"`
+		CreatedAt                  time.Time `db:"created_at"`
+	}
+
+	DocumentVersionSignatureProofs []*DocumentVersionSignatureProof
+)
+
+func (p DocumentVersionSignatureProof) Insert(
+	ctx context.Context,
+	conn pg.Conn,
+	scope Scoper,
+) error {
+	q := `
+INSERT INTO document_version_signature_proofs (
+	document_version_signature_id,
+	document_version_id,
+	organization_id,
+	tenant_id,
+	sequence,
+	content_hash,
+	signer_full_name,
+	signer_email_address,
+	signer_ip_address,
+	signer_user_agent,
+	signer_auth_method,
+	signed_at,
+	previous_hash,
+	hash,
+	created_at
+) VALUES (
+	@document_version_signature_id,
+	@document_version_id,
+	@organization_id,
+	@tenant_id,
+	@sequence,
+	@content_hash,
+	@signer_full_name,
+	@signer_email_address,
+	@signer_ip_address,
+	@signer_user_agent,
+	@signer_auth_method,
+	@signed_at,
+	@previous_hash,
+	@hash,
+	@created_at
+)
+`
+
+	args := pgx.StrictNamedArgs{
+		"document_version_signature_id": p.DocumentVersionSignatureID,
+		"document_version_id":           p.DocumentVersionID,
+		"organization_id":               p.OrganizationID,
+		"tenant_id":                     scope.GetTenantID(),
+		"sequence":                      p.Sequence,
+		"content_hash":                  p.ContentHash,
+		"signer_full_name":              p.SignerFullName,
+		"signer_email_address":          p.SignerEmailAddress,
+		"signer_ip_address":             p.SignerIPAddress,
+		"signer_user_agent":             p.SignerUserAgent,
+		"signer_auth_method":            p.SignerAuthMethod,
+		"signed_at":                     p.SignedAt,
+		"previous_hash":                 p.PreviousHash,
+		"hash":                          p.Hash,
+		"created_at":                    p.CreatedAt,
+	}
+
+	if _, err := conn.Exec(ctx, q, args); err != nil {
+		return fmt.Errorf("cannot insert document version signature proof: %w", err)
+	}
+
+	return nil
+}
+
+// LoadLastByDocumentVersionID loads the proof with the highest sequence
+// of the document version.
+func (p *DocumentVersionSignatureProof) LoadLastByDocumentVersionID(
+	ctx context.Context,
+	conn pg.Conn,
+	scope Scoper,
+	documentVersionID gid.GID,
+) error {
+	q := `
+SELECT
+	document_version_signature_id,
+	document_version_id,
+	organization_id,
+	sequence,
+	content_hash,
+	signer_full_name,
+	signer_email_address,
+	signer_ip_address,
+	signer_user_agent,
+	signer_auth_method,
+	signed_at,
+	previous_hash,
+	hash,
+	created_at
+FROM
+	document_version_signature_proofs
+WHERE
+	%s
+	AND document_version_id = @document_version_id
+ORDER BY
+	sequence DESC
+LIMIT 1
+`
+
+	q = fmt.Sprintf(q, scope.SQLFragment())
+
+	args := pgx.StrictNamedArgs{"document_version_id": documentVersionID}
+	maps.Copy(args, scope.SQLArguments())
+
+	rows, err := conn.Query(ctx, q, args)
+	if err != nil {
+		return fmt.Errorf("cannot query document version signature proof: %w", err)
+	}
+
+	proof, err := pgx.CollectExactlyOneRow(rows, pgx.RowToStructByName[DocumentVersionSignatureProof])
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return ErrResourceNotFound
+		}
+
+		return fmt.Errorf("cannot collect document version signature proof: %w", err)
+	}
+
+	*p = proof
+
+	return nil
+}
+
+func (ps *DocumentVersionSignatureProofs) LoadByDocumentVersionID(
+	ctx context.Context,
+	conn pg.Conn,
+	scope Scoper,
+	documentVersionID gid.GID,
+) error {
+	q := `
+SELECT
+	document_version_signature_id,
+	document_version_id,
+	organization_id,
+	sequence,
+	content_hash,
+	signer_full_name,
+	signer_email_address,
+	signer_ip_address,
+	signer_user_agent,
+	signer_auth_method,
+	signed_at,
+	previous_hash,
+	hash,
+	created_at
+FROM
+	document_version_signature_proofs
+WHERE
+	%s
+	AND document_version_id = @document_version_id
+ORDER BY
+	sequence ASC
+`
+
+	q = fmt.Sprintf(q, scope.SQLFragment())
+
+	args := pgx.StrictNamedArgs{"document_version_id": documentVersionID}
+	maps.Copy(args, scope.SQLArguments())
+
+	rows, err := conn.Query(ctx, q, args)
+	if err != nil {
+		return fmt.Errorf("cannot query document version signature proofs: %w", err)
+	}
+
+	proofs, err := pgx.CollectRows(rows, pgx.RowToAddrOfStructByName[DocumentVersionSignatureProof])
+	if err != nil {
+		return fmt.Errorf("cannot collect document version signature proofs: %w", err)
+	}
+
+	*ps = proofs
+
+	return nil
+}
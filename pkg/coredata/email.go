@@ -18,26 +18,36 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"maps"
 	"time"
 
 	"github.com/jackc/pgx/v5"
 	"go.gearno.de/kit/pg"
 	"go.probo.inc/probo/pkg/gid"
 	"go.probo.inc/probo/pkg/mail"
+	"go.probo.inc/probo/pkg/page"
 )
 
 type (
 	Email struct {
-		ID             gid.GID    `db:"id"`
-		RecipientEmail string     `db:"recipient_email"`
-		RecipientName  string     `db:"recipient_name"`
-		Subject        string     `db:"subject"`
-		TextBody       string     `db:"text_body"`
-		HtmlBody       *string    `db:"html_body"`
-		CreatedAt      time.Time  `db:"created_at"`
-		UpdatedAt      time.Time  `db:"updated_at"`
-		SentAt         *time.Time `db:"sent_at"`
+		ID             gid.GID     `db:"id"`
+		OrganizationID *gid.GID    `db:"organization_id"`
+		RecipientEmail string      `db:"recipient_email"`
+		RecipientName  string      `db:"recipient_name"`
+		Subject        string      `db:"subject"`
+		TextBody       string      `db:"text_body"`
+		HtmlBody       *string     `db:"html_body"`
+		Status         EmailStatus `db:"status"`
+		AttemptCount   int         `db:"attempt_count"`
+		NextAttemptAt  *time.Time  `db:"next_attempt_at"`
+		LastError      *string     `db:"last_error"`
+		CreatedAt      time.Time   `db:"created_at"`
+		UpdatedAt      time.Time   `db:"updated_at"`
+		SentAt         *time.Time  `db:"sent_at"`
+		FailedAt       *time.Time  `db:"failed_at"`
 	}
+
+	Emails []*Email
 )
 
 var (
@@ -65,27 +75,40 @@ func NewEmail(
 		Subject:        subject,
 		TextBody:       textBody,
 		HtmlBody:       htmlBody,
+		Status:         EmailStatusPending,
 		CreatedAt:      now,
 		UpdatedAt:      now,
 	}
 }
 
+func (e Email) CursorKey(orderBy EmailOrderField) page.CursorKey {
+	switch orderBy {
+	case EmailOrderFieldCreatedAt:
+		return page.NewCursorKey(e.ID, e.CreatedAt)
+	}
+
+	panic(fmt.Sprintf("unsupported order by: %s", orderBy))
+}
+
 func (e *Email) Insert(
 	ctx context.Context,
 	conn pg.Conn,
 ) error {
 	q := `
-INSERT INTO emails (id, recipient_email, recipient_name, subject, text_body, html_body, created_at, updated_at)
-VALUES (@id, @recipient_email, @recipient_name, @subject, @text_body, @html_body, @created_at, @updated_at)
+INSERT INTO emails (id, organization_id, recipient_email, recipient_name, subject, text_body, html_body, status, attempt_count, created_at, updated_at)
+VALUES (@id, @organization_id, @recipient_email, @recipient_name, @subject, @text_body, @html_body, @status, @attempt_count, @created_at, @updated_at)
 	`
 
 	args := pgx.StrictNamedArgs{
 		"id":              e.ID,
+		"organization_id": e.OrganizationID,
 		"recipient_email": e.RecipientEmail,
 		"recipient_name":  e.RecipientName,
 		"subject":         e.Subject,
 		"text_body":       e.TextBody,
 		"html_body":       e.HtmlBody,
+		"status":          e.Status,
+		"attempt_count":   e.AttemptCount,
 		"created_at":      e.CreatedAt,
 		"updated_at":      e.UpdatedAt,
 	}
@@ -94,17 +117,38 @@ VALUES (@id, @recipient_email, @recipient_name, @subject, @text_body, @html_body
 	return err
 }
 
+// LoadNextUnsentForUpdate locks the oldest pending email whose retry
+// backoff has elapsed. Rows locked by another sender are skipped.
 func (e *Email) LoadNextUnsentForUpdate(
 	ctx context.Context,
 	conn pg.Conn,
 ) error {
 	q := `
-SELECT id, recipient_email, recipient_name, subject, text_body, html_body, created_at, updated_at, sent_at
-FROM emails
-WHERE sent_at IS NULL
-ORDER BY created_at ASC
+SELECT
+    id,
+    organization_id,
+    recipient_email,
+    recipient_name,
+    subject,
+    text_body,
+    html_body,
+    status,
+    attempt_count,
+    next_attempt_at,
+    last_error,
+    created_at,
+    updated_at,
+    sent_at,
+    failed_at
+FROM
+    emails
+WHERE
+    status = 'PENDING'
+    AND (next_attempt_at IS NULL OR next_attempt_at <= NOW())
+ORDER BY
+    created_at ASC
 LIMIT 1
-FOR UPDATE
+FOR UPDATE SKIP LOCKED
 	`
 
 	rows, err := conn.Query(ctx, q)
@@ -132,16 +176,112 @@ func (e *Email) Update(
 ) error {
 	q := `
 UPDATE emails
-SET sent_at = @sent_at, updated_at = @updated_at
+SET
+    status = @status,
+    attempt_count = @attempt_count,
+    next_attempt_at = @next_attempt_at,
+    last_error = @last_error,
+    sent_at = @sent_at,
+    failed_at = @failed_at,
+    updated_at = @updated_at
 WHERE id = @id
 	`
 
 	args := pgx.StrictNamedArgs{
-		"id":         e.ID,
-		"sent_at":    e.SentAt,
-		"updated_at": e.UpdatedAt,
+		"id":              e.ID,
+		"status":          e.Status,
+		"attempt_count":   e.AttemptCount,
+		"next_attempt_at": e.NextAttemptAt,
+		"last_error":      e.LastError,
+		"sent_at":         e.SentAt,
+		"failed_at":       e.FailedAt,
+		"updated_at":      e.UpdatedAt,
 	}
 
 	_, err := conn.Exec(ctx, q, args)
 	return err
 }
+
+// LoadByOrganizationID loads the emails sent on behalf of an organization.
+// Emails are not tenant scoped: the caller must have loaded the
+// organization within its scope beforehand.
+func (e *Emails) LoadByOrganizationID(
+	ctx context.Context,
+	conn pg.Conn,
+	organizationID gid.GID,
+	cursor *page.Cursor[EmailOrderField],
+	filter *EmailFilter,
+) error {
+	q := `
+SELECT
+    id,
+    organization_id,
+    recipient_email,
+    recipient_name,
+    subject,
+    text_body,
+    html_body,
+    status,
+    attempt_count,
+    next_attempt_at,
+    last_error,
+    created_at,
+    updated_at,
+    sent_at,
+    failed_at
+FROM
+    emails
+WHERE
+    organization_id = @organization_id
+    AND %s
+    AND %s
+`
+
+	q = fmt.Sprintf(q, filter.SQLFragment(), cursor.SQLFragment())
+
+	args := pgx.StrictNamedArgs{"organization_id": organizationID}
+	maps.Copy(args, filter.SQLArguments())
+	maps.Copy(args, cursor.SQLArguments())
+
+	rows, err := conn.Query(ctx, q, args)
+	if err != nil {
+		return fmt.Errorf("cannot query emails: %w", err)
+	}
+
+	emails, err := pgx.CollectRows(rows, pgx.RowToAddrOfStructByName[Email])
+	if err != nil {
+		return fmt.Errorf("cannot collect emails: %w", err)
+	}
+
+	*e = emails
+	return nil
+}
+
+func (e *Emails) CountByOrganizationID(
+	ctx context.Context,
+	conn pg.Conn,
+	organizationID gid.GID,
+	filter *EmailFilter,
+) (int, error) {
+	q := `
+SELECT
+    COUNT(id)
+FROM
+    emails
+WHERE
+    organization_id = @organization_id
+    AND %s
+`
+
+	q = fmt.Sprintf(q, filter.SQLFragment())
+
+	args := pgx.StrictNamedArgs{"organization_id": organizationID}
+	maps.Copy(args, filter.SQLArguments())
+
+	var count int
+	if err := conn.QueryRow(ctx, q, args).Scan(&count); err != nil {
+		return 0, fmt.Errorf("cannot count emails: %w", err)
+	}
+
+	return count, nil
+}
@@ -82,6 +82,20 @@ const (
 	WebhookSubscriptionEntityType              uint16 = 56
 	WebhookDataEntityType                      uint16 = 57
 	WebhookEventEntityType                     uint16 = 58
+	AuditLogEntryEntityType                    uint16 = 59
+	ConnectorEvidenceMappingEntityType         uint16 = 60
+	CustomRoleEntityType                       uint16 = 61
+	SnapshotScheduleEntityType                 uint16 = 62
+	RecoveryCodeEntityType                     uint16 = 63
+	WebAuthnCredentialEntityType               uint16 = 64
+	OIDCConfigurationEntityType                uint16 = 65
+	SCIMGroupEntityType                        uint16 = 66
+	SCIMGroupRoleMappingEntityType             uint16 = 67
+	DeadlineReminderSettingsEntityType         uint16 = 68
+	DeadlineReminderEntityType                 uint16 = 69
+	AcknowledgementCampaignEntityType          uint16 = 70
+	AcknowledgementCampaignRunEntityType       uint16 = 71
+	ImportJobEntityType                        uint16 = 72
 )
 
 func NewEntityFromID(id gid.GID) (any, bool) {
@@ -200,6 +214,34 @@ func NewEntityFromID(id gid.GID) (any, bool) {
 		return &WebhookData{ID: id}, true
 	case WebhookEventEntityType:
 		return &WebhookEvent{ID: id}, true
+	case AuditLogEntryEntityType:
+		return &AuditLogEntry{ID: id}, true
+	case ConnectorEvidenceMappingEntityType:
+		return &ConnectorEvidenceMapping{ID: id}, true
+	case CustomRoleEntityType:
+		return &CustomRole{ID: id}, true
+	case SnapshotScheduleEntityType:
+		return &SnapshotSchedule{ID: id}, true
+	case RecoveryCodeEntityType:
+		return &RecoveryCode{ID: id}, true
+	case WebAuthnCredentialEntityType:
+		return &WebAuthnCredential{ID: id}, true
+	case OIDCConfigurationEntityType:
+		return &OIDCConfiguration{ID: id}, true
+	case SCIMGroupEntityType:
+		return &SCIMGroup{ID: id}, true
+	case SCIMGroupRoleMappingEntityType:
+		return &SCIMGroupRoleMapping{ID: id}, true
+	case DeadlineReminderSettingsEntityType:
+		return &DeadlineReminderSettings{ID: id}, true
+	case DeadlineReminderEntityType:
+		return &DeadlineReminder{ID: id}, true
+	case AcknowledgementCampaignEntityType:
+		return &AcknowledgementCampaign{ID: id}, true
+	case AcknowledgementCampaignRunEntityType:
+		return &AcknowledgementCampaignRun{ID: id}, true
+	case ImportJobEntityType:
+		return &ImportJob{ID: id}, true
 	default:
 		return nil, false
 	}
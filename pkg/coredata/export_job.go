@@ -40,7 +40,16 @@ type (
 	}
 
 	FrameworkExportArguments struct {
-		FrameworkID gid.GID `json:"framework_id"`
+		FrameworkID gid.GID               `json:"framework_id"`
+		Format      FrameworkExportFormat `json:"format"`
+	}
+
+	AuditLogExportArguments struct {
+		Action     *string    `json:"action"`
+		ActorID    *gid.GID   `json:"actor_id"`
+		ResourceID *gid.GID   `json:"resource_id"`
+		From       *time.Time `json:"from"`
+		To         *time.Time `json:"to"`
 	}
 )
 
@@ -254,6 +263,19 @@ func (ej *ExportJob) GetFrameworkExportArguments() (*FrameworkExportArguments, e
 	return &args, nil
 }
 
+func (ej *ExportJob) GetAuditLogExportArguments() (*AuditLogExportArguments, error) {
+	if ej.Type != ExportJobTypeAuditLog {
+		return nil, fmt.Errorf("export job is not an audit log export")
+	}
+
+	var args AuditLogExportArguments
+	if err := json.Unmarshal(ej.Arguments, &args); err != nil {
+		return nil, fmt.Errorf("cannot unmarshal audit log export arguments: %w", err)
+	}
+
+	return &args, nil
+}
+
 func (ej *ExportJob) GetDocumentIDs() ([]gid.GID, error) {
 	args, err := ej.GetDocumentExportArguments()
 	if err != nil {
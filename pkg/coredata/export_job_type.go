@@ -12,6 +12,7 @@ type (
 const (
 	ExportJobTypeFramework ExportJobType = "FRAMEWORK"
 	ExportJobTypeDocument  ExportJobType = "DOCUMENT"
+	ExportJobTypeAuditLog  ExportJobType = "AUDIT_LOG"
 )
 
 func (ejt ExportJobType) String() string {
@@ -34,6 +35,8 @@ func (ejt *ExportJobType) Scan(value any) error {
 		*ejt = ExportJobTypeFramework
 	case ExportJobTypeDocument.String():
 		*ejt = ExportJobTypeDocument
+	case ExportJobTypeAuditLog.String():
+		*ejt = ExportJobTypeAuditLog
 	default:
 		return fmt.Errorf("invalid ExportJobType value: %q", s)
 	}
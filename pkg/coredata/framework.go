@@ -145,10 +145,14 @@ WHERE
 	return nil
 }
 
-func (f *Framework) LoadByReferenceID(
+// LoadByOrganizationIDAndReferenceID loads the framework of the
+// organization with the reference ID. It fails rather than picking one when
+// several frameworks match.
+func (f *Framework) LoadByOrganizationIDAndReferenceID(
 	ctx context.Context,
 	conn pg.Conn,
 	scope Scoper,
+	organizationID gid.GID,
 	referenceID string,
 ) error {
 	q := `
@@ -166,13 +170,16 @@ FROM
     frameworks
 WHERE
     %s
-    AND reference_id = @reference_id
-LIMIT 1;
+    AND organization_id = @organization_id
+    AND reference_id = @reference_id;
 `
 
 	q = fmt.Sprintf(q, scope.SQLFragment())
 
-	args := pgx.StrictNamedArgs{"reference_id": referenceID}
+	args := pgx.StrictNamedArgs{
+		"organization_id": organizationID,
+		"reference_id":    referenceID,
+	}
 	maps.Copy(args, scope.SQLArguments())
 	rows, err := conn.Query(ctx, q, args)
 	if err != nil {
@@ -185,6 +192,10 @@ LIMIT 1;
 			return ErrResourceNotFound
 		}
 
+		if errors.Is(err, pgx.ErrTooManyRows) {
+			return fmt.Errorf("several frameworks have reference id %q", referenceID)
+		}
+
 		return fmt.Errorf("cannot collect framework: %w", err)
 	}
 
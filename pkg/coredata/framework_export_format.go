@@ -0,0 +1,58 @@
+// Copyright (c) 2025 Probo Inc <hello@getprobo.com>.
+//
+// Permission to use, copy, modify, and/or distribute this software for any
+// purpose with or without fee is hereby granted, provided that the above
+// copyright notice and this permission notice appear in all copies.
+//
+// THE SOFTWARE IS PROVIDED "AS IS" AND THE AUTHOR DISCLAIMS ALL WARRANTIES WITH
+// REGARD TO THIS SOFTWARE INCLUDING ALL IMPLIED WARRANTIES OF MERCHANTABILITY
+// AND FITNESS. IN NO EVENT SHALL THE AUTHOR BE LIABLE FOR ANY SPECIAL, DIRECT,
+// INDIRECT, OR CONSEQUENTIAL DAMAGES OR ANY DAMAGES WHATSOEVER RESULTING FROM
+// LOSS OF USE, DATA OR PROFITS, WHETHER IN AN ACTION OF CONTRACT, NEGLIGENCE OR
+// OTHER TORTIOUS ACTION, ARISING OUT OF OR IN CONNECTION WITH THE USE OR
+// PERFORMANCE OF THIS SOFTWARE.
+
+package coredata
+
+import (
+	"fmt"
+)
+
+type (
+	FrameworkExportFormat string
+)
+
+const (
+	FrameworkExportFormatZIP   FrameworkExportFormat = "ZIP"
+	FrameworkExportFormatOSCAL FrameworkExportFormat = "OSCAL"
+)
+
+func FrameworkExportFormats() []FrameworkExportFormat {
+	return []FrameworkExportFormat{
+		FrameworkExportFormatZIP,
+		FrameworkExportFormatOSCAL,
+	}
+}
+
+func (f FrameworkExportFormat) MarshalText() ([]byte, error) {
+	return []byte(f.String()), nil
+}
+
+func (f *FrameworkExportFormat) UnmarshalText(data []byte) error {
+	val := string(data)
+
+	switch val {
+	case FrameworkExportFormatZIP.String():
+		*f = FrameworkExportFormatZIP
+	case FrameworkExportFormatOSCAL.String():
+		*f = FrameworkExportFormatOSCAL
+	default:
+		return fmt.Errorf("invalid FrameworkExportFormat value: %q", val)
+	}
+
+	return nil
+}
+
+func (f FrameworkExportFormat) String() string {
+	return string(f)
+}
@@ -0,0 +1,217 @@
+// Copyright (c) 2025 Probo Inc <hello@getprobo.com>.
+//
+// Permission to use, copy, modify, and/or distribute this software for any
+// purpose with or without fee is hereby granted, provided that the above
+// copyright notice and this permission notice appear in all copies.
+//
+// THE SOFTWARE IS PROVIDED "AS IS" AND THE AUTHOR DISCLAIMS ALL WARRANTIES WITH
+// REGARD TO THIS SOFTWARE INCLUDING ALL IMPLIED WARRANTIES OF MERCHANTABILITY
+// AND FITNESS. IN NO EVENT SHALL THE AUTHOR BE LIABLE FOR ANY SPECIAL, DIRECT,
+// INDIRECT, OR CONSEQUENTIAL DAMAGES OR ANY DAMAGES WHATSOEVER RESULTING FROM
+// LOSS OF USE, DATA OR PROFITS, WHETHER IN AN ACTION OF CONTRACT, NEGLIGENCE OR
+// OTHER TORTIOUS ACTION, ARISING OUT OF OR IN CONNECTION WITH THE USE OR
+// PERFORMANCE OF THIS SOFTWARE.
+
+package coredata
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"go.gearno.de/kit/pg"
+	"go.probo.inc/probo/pkg/gid"
+)
+
+type (
+	// IdentityTOTPFactor is the time-based one-time password second factor
+	// of an identity. The factor only protects sign-in once confirmed.
+	IdentityTOTPFactor struct {
+		IdentityID      gid.GID    `db:"identity_id"`
+		EncryptedSecret []byte     `db:"encrypted_secret"`
+		LastUsedCounter *int64     `db:"last_used_counter"`
+		ConfirmedAt     *time.Time `db:"confirmed_at"`
+		CreatedAt       time.Time  `db:"created_at"`
+		UpdatedAt       time.Time  `db:"updated_at"`
+	}
+)
+
+func (f *IdentityTOTPFactor) IsConfirmed() bool {
+	return f.ConfirmedAt != nil
+}
+
+func (f *IdentityTOTPFactor) LoadByIdentityID(
+	ctx context.Context,
+	conn pg.Conn,
+	identityID gid.GID,
+) error {
+	q := `
+SELECT
+    identity_id,
+    encrypted_secret,
+    last_used_counter,
+    confirmed_at,
+    created_at,
+    updated_at
+FROM
+    iam_identity_totp_factors
+WHERE
+    identity_id = @identity_id
+LIMIT 1;
+`
+
+	return f.load(ctx, conn, q, identityID)
+}
+
+func (f *IdentityTOTPFactor) LoadByIdentityIDForUpdate(
+	ctx context.Context,
+	conn pg.Conn,
+	identityID gid.GID,
+) error {
+	q := `
+SELECT
+    identity_id,
+    encrypted_secret,
+    last_used_counter,
+    confirmed_at,
+    created_at,
+    updated_at
+FROM
+    iam_identity_totp_factors
+WHERE
+    identity_id = @identity_id
+LIMIT 1
+FOR UPDATE;
+`
+
+	return f.load(ctx, conn, q, identityID)
+}
+
+func (f *IdentityTOTPFactor) load(
+	ctx context.Context,
+	conn pg.Conn,
+	q string,
+	identityID gid.GID,
+) error {
+	args := pgx.StrictNamedArgs{"identity_id": identityID}
+
+	rows, err := conn.Query(ctx, q, args)
+	if err != nil {
+		return fmt.Errorf("cannot query iam_identity_totp_factors: %w", err)
+	}
+
+	factor, err := pgx.CollectExactlyOneRow(rows, pgx.RowToStructByName[IdentityTOTPFactor])
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return ErrResourceNotFound
+		}
+
+		return fmt.Errorf("cannot collect iam_identity_totp_factors: %w", err)
+	}
+
+	*f = factor
+
+	return nil
+}
+
+// Upsert stores the factor, replacing any pending enrollment for the same
+// identity.
+func (f *IdentityTOTPFactor) Upsert(
+	ctx context.Context,
+	conn pg.Conn,
+) error {
+	q := `
+INSERT INTO iam_identity_totp_factors (
+    identity_id,
+    encrypted_secret,
+    last_used_counter,
+    confirmed_at,
+    created_at,
+    updated_at
+) VALUES (
+    @identity_id,
+    @encrypted_secret,
+    @last_used_counter,
+    @confirmed_at,
+    @created_at,
+    @updated_at
+)
+ON CONFLICT (identity_id) DO UPDATE SET
+    encrypted_secret = EXCLUDED.encrypted_secret,
+    last_used_counter = EXCLUDED.last_used_counter,
+    confirmed_at = EXCLUDED.confirmed_at,
+    created_at = EXCLUDED.created_at,
+    updated_at = EXCLUDED.updated_at
+`
+
+	args := pgx.StrictNamedArgs{
+		"identity_id":       f.IdentityID,
+		"encrypted_secret":  f.EncryptedSecret,
+		"last_used_counter": f.LastUsedCounter,
+		"confirmed_at":      f.ConfirmedAt,
+		"created_at":        f.CreatedAt,
+		"updated_at":        f.UpdatedAt,
+	}
+
+	_, err := conn.Exec(ctx, q, args)
+	if err != nil {
+		return fmt.Errorf("cannot upsert iam_identity_totp_factors: %w", err)
+	}
+
+	return nil
+}
+
+func (f *IdentityTOTPFactor) Update(
+	ctx context.Context,
+	conn pg.Conn,
+) error {
+	q := `
+UPDATE iam_identity_totp_factors
+SET
+    last_used_counter = @last_used_counter,
+    confirmed_at = @confirmed_at,
+    updated_at = @updated_at
+WHERE
+    identity_id = @identity_id
+`
+
+	args := pgx.StrictNamedArgs{
+		"identity_id":       f.IdentityID,
+		"last_used_counter": f.LastUsedCounter,
+		"confirmed_at":      f.ConfirmedAt,
+		"updated_at":        f.UpdatedAt,
+	}
+
+	result, err := conn.Exec(ctx, q, args)
+	if err != nil {
+		return fmt.Errorf("cannot update iam_identity_totp_factors: %w", err)
+	}
+
+	if result.RowsAffected() == 0 {
+		return ErrResourceNotFound
+	}
+
+	return nil
+}
+
+func (f *IdentityTOTPFactor) Delete(
+	ctx context.Context,
+	conn pg.Conn,
+) error {
+	q := `
+DELETE FROM iam_identity_totp_factors
+WHERE
+    identity_id = @identity_id
+`
+
+	args := pgx.StrictNamedArgs{"identity_id": f.IdentityID}
+
+	_, err := conn.Exec(ctx, q, args)
+	if err != nil {
+		return fmt.Errorf("cannot delete iam_identity_totp_factors: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,301 @@
+// Copyright (c) 2025 Probo Inc <hello@getprobo.com>.
+//
+// Permission to use, copy, modify, and/or distribute this software for any
+// purpose with or without fee is hereby granted, provided that the above
+// copyright notice and this permission notice appear in all copies.
+//
+// THE SOFTWARE IS PROVIDED "AS IS" AND THE AUTHOR DISCLAIMS ALL WARRANTIES WITH
+// REGARD TO THIS SOFTWARE INCLUDING ALL IMPLIED WARRANTIES OF MERCHANTABILITY
+// AND FITNESS. IN NO EVENT SHALL THE AUTHOR BE LIABLE FOR ANY SPECIAL, DIRECT,
+// INDIRECT, OR CONSEQUENTIAL DAMAGES OR ANY DAMAGES WHATSOEVER RESULTING FROM
+// LOSS OF USE, DATA OR PROFITS, WHETHER IN AN ACTION OF CONTRACT, NEGLIGENCE OR
+// OTHER TORTIOUS ACTION, ARISING OUT OF OR IN CONNECTION WITH THE USE OR
+// PERFORMANCE OF THIS SOFTWARE.
+
+package coredata
+
+import (
+	"context"
+	"database/sql/driver"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"maps"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"go.gearno.de/kit/pg"
+	"go.probo.inc/probo/pkg/gid"
+)
+
+type (
+	ImportJob struct {
+		ID                gid.GID            `db:"id"`
+		OrganizationID    gid.GID            `db:"organization_id"`
+		Type              ImportJobType      `db:"type"`
+		Arguments         json.RawMessage    `db:"arguments"`
+		Status            ImportJobStatus    `db:"status"`
+		Error             *string            `db:"error"`
+		RowErrors         ImportJobRowErrors `db:"row_errors"`
+		ImportedCount     int                `db:"imported_count"`
+		CreatedBy         *gid.GID           `db:"created_by"`
+		CreatedByAPIKeyID *gid.GID           `db:"created_by_api_key_id"`
+		CreatedAt         time.Time          `db:"created_at"`
+		StartedAt         *time.Time         `db:"started_at"`
+		CompletedAt       *time.Time         `db:"completed_at"`
+	}
+
+	// ImportJobArguments holds the rows of the imported spreadsheet,
+	// the first one being the header naming the columns.
+	ImportJobArguments struct {
+		Rows []ImportJobRow `json:"rows"`
+	}
+
+	ImportJobRow struct {
+		Number int      `json:"number"`
+		Cells  []string `json:"cells"`
+	}
+
+	// ImportJobRowError is a problem with a row of an import. Column is
+	// empty when the problem is not tied to a single column.
+	ImportJobRowError struct {
+		Row     int    `json:"row"`
+		Column  string `json:"column,omitempty"`
+		Message string `json:"message"`
+	}
+
+	ImportJobRowErrors []ImportJobRowError
+)
+
+var (
+	ErrNoImportJobAvailable = errors.New("no import job available")
+)
+
+func (e *ImportJobRowErrors) Scan(value any) error {
+	var data []byte
+	switch v := value.(type) {
+	case string:
+		data = []byte(v)
+	case []byte:
+		data = v
+	default:
+		return fmt.Errorf("unsupported type for ImportJobRowErrors: %T", value)
+	}
+
+	return json.Unmarshal(data, e)
+}
+
+func (e ImportJobRowErrors) Value() (driver.Value, error) {
+	if e == nil {
+		return "[]", nil
+	}
+
+	data, err := json.Marshal(e)
+	if err != nil {
+		return nil, fmt.Errorf("cannot marshal import job row errors: %w", err)
+	}
+
+	return string(data), nil
+}
+
+// AuthorizationAttributes returns the authorization attributes for policy evaluation.
+func (ij *ImportJob) AuthorizationAttributes(ctx context.Context, conn pg.Conn) (map[string]string, error) {
+	q := `SELECT organization_id FROM import_jobs WHERE id = $1 LIMIT 1;`
+
+	var organizationID gid.GID
+	if err := conn.QueryRow(ctx, q, ij.ID).Scan(&organizationID); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrResourceNotFound
+		}
+		return nil, fmt.Errorf("cannot query import job authorization attributes: %w", err)
+	}
+
+	return map[string]string{"organization_id": organizationID.String()}, nil
+}
+
+func (ij *ImportJob) Insert(
+	ctx context.Context,
+	conn pg.Conn,
+	scope Scoper,
+) error {
+	q := `
+INSERT INTO import_jobs (
+	id,
+	tenant_id,
+	organization_id,
+	type,
+	arguments,
+	status,
+	row_errors,
+	imported_count,
+	created_by,
+	created_by_api_key_id,
+	created_at
+) VALUES (
+	@id,
+	@tenant_id,
+	@organization_id,
+	@type,
+	@arguments,
+	@status,
+	@row_errors,
+	@imported_count,
+	@created_by,
+	@created_by_api_key_id,
+	@created_at
+)`
+	args := pgx.StrictNamedArgs{
+		"id":                    ij.ID,
+		"tenant_id":             scope.GetTenantID(),
+		"organization_id":       ij.OrganizationID,
+		"type":                  ij.Type,
+		"arguments":             ij.Arguments,
+		"status":                ij.Status,
+		"row_errors":            ij.RowErrors,
+		"imported_count":        ij.ImportedCount,
+		"created_by":            ij.CreatedBy,
+		"created_by_api_key_id": ij.CreatedByAPIKeyID,
+		"created_at":            ij.CreatedAt,
+	}
+	_, err := conn.Exec(ctx, q, args)
+	return err
+}
+
+func (ij *ImportJob) Update(
+	ctx context.Context,
+	conn pg.Conn,
+	scope Scoper,
+) error {
+	q := `
+UPDATE
+	import_jobs
+SET
+	status = @status,
+	error = @error,
+	row_errors = @row_errors,
+	imported_count = @imported_count,
+	started_at = @started_at,
+	completed_at = @completed_at
+WHERE
+	%s
+	AND id = @id
+`
+	q = fmt.Sprintf(q, scope.SQLFragment())
+	args := pgx.StrictNamedArgs{
+		"status":         ij.Status,
+		"error":          ij.Error,
+		"row_errors":     ij.RowErrors,
+		"imported_count": ij.ImportedCount,
+		"started_at":     ij.StartedAt,
+		"completed_at":   ij.CompletedAt,
+		"id":             ij.ID,
+	}
+	maps.Copy(args, scope.SQLArguments())
+	_, err := conn.Exec(ctx, q, args)
+	return err
+}
+
+func (ij *ImportJob) LoadByID(
+	ctx context.Context,
+	conn pg.Conn,
+	scope Scoper,
+	id gid.GID,
+) error {
+	q := `
+SELECT
+	id,
+	organization_id,
+	type,
+	arguments,
+	status,
+	error,
+	row_errors,
+	imported_count,
+	created_by,
+	created_by_api_key_id,
+	created_at,
+	started_at,
+	completed_at
+FROM
+	import_jobs
+WHERE
+	%s
+	AND id = @id
+`
+	q = fmt.Sprintf(q, scope.SQLFragment())
+	args := pgx.StrictNamedArgs{"id": id}
+	maps.Copy(args, scope.SQLArguments())
+
+	rows, err := conn.Query(ctx, q, args)
+	if err != nil {
+		return err
+	}
+
+	ij2, err := pgx.CollectExactlyOneRow(rows, pgx.RowToStructByName[ImportJob])
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return ErrResourceNotFound
+		}
+		return fmt.Errorf("cannot collect import job: %w", err)
+	}
+
+	*ij = ij2
+	return nil
+}
+
+func (ij *ImportJob) LoadNextPendingForUpdateSkipLocked(
+	ctx context.Context,
+	conn pg.Conn,
+) error {
+	q := `
+SELECT
+	id,
+	organization_id,
+	type,
+	arguments,
+	status,
+	error,
+	row_errors,
+	imported_count,
+	created_by,
+	created_by_api_key_id,
+	created_at,
+	started_at,
+	completed_at
+FROM
+	import_jobs
+WHERE
+	status = @status
+ORDER BY
+	created_at ASC
+LIMIT 1
+FOR UPDATE SKIP LOCKED
+`
+	args := pgx.StrictNamedArgs{
+		"status": ImportJobStatusPending,
+	}
+	rows, err := conn.Query(ctx, q, args)
+	if err != nil {
+		return err
+	}
+
+	ij2, err := pgx.CollectExactlyOneRow(rows, pgx.RowToStructByName[ImportJob])
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return ErrNoImportJobAvailable
+		}
+		return fmt.Errorf("cannot collect import job: %w", err)
+	}
+
+	*ij = ij2
+	return nil
+}
+
+func (ij *ImportJob) GetArguments() (*ImportJobArguments, error) {
+	var args ImportJobArguments
+	if err := json.Unmarshal(ij.Arguments, &args); err != nil {
+		return nil, fmt.Errorf("cannot unmarshal import job arguments: %w", err)
+	}
+
+	return &args, nil
+}
@@ -0,0 +1,80 @@
+// Copyright (c) 2025 Probo Inc <hello@getprobo.com>.
+//
+// Permission to use, copy, modify, and/or distribute this software for any
+// purpose with or without fee is hereby granted, provided that the above
+// copyright notice and this permission notice appear in all copies.
+//
+// THE SOFTWARE IS PROVIDED "AS IS" AND THE AUTHOR DISCLAIMS ALL WARRANTIES WITH
+// REGARD TO THIS SOFTWARE INCLUDING ALL IMPLIED WARRANTIES OF MERCHANTABILITY
+// AND FITNESS. IN NO EVENT SHALL THE AUTHOR BE LIABLE FOR ANY SPECIAL, DIRECT,
+// INDIRECT, OR CONSEQUENTIAL DAMAGES OR ANY DAMAGES WHATSOEVER RESULTING FROM
+// LOSS OF USE, DATA OR PROFITS, WHETHER IN AN ACTION OF CONTRACT, NEGLIGENCE OR
+// OTHER TORTIOUS ACTION, ARISING OUT OF OR IN CONNECTION WITH THE USE OR
+// PERFORMANCE OF THIS SOFTWARE.
+
+package coredata
+
+import (
+	"database/sql/driver"
+	"fmt"
+)
+
+type (
+	ImportJobStatus string
+)
+
+const (
+	ImportJobStatusPending    ImportJobStatus = "PENDING"
+	ImportJobStatusProcessing ImportJobStatus = "PROCESSING"
+	ImportJobStatusCompleted  ImportJobStatus = "COMPLETED"
+	ImportJobStatusFailed     ImportJobStatus = "FAILED"
+)
+
+func ImportJobStatuss() []ImportJobStatus {
+	return []ImportJobStatus{
+		ImportJobStatusPending,
+		ImportJobStatusProcessing,
+		ImportJobStatusCompleted,
+		ImportJobStatusFailed,
+	}
+}
+
+func (s ImportJobStatus) MarshalText() ([]byte, error) {
+	return []byte(s.String()), nil
+}
+
+func (s *ImportJobStatus) UnmarshalText(data []byte) error {
+	val := string(data)
+
+	switch val {
+	case ImportJobStatusPending.String():
+		*s = ImportJobStatusPending
+	case ImportJobStatusProcessing.String():
+		*s = ImportJobStatusProcessing
+	case ImportJobStatusCompleted.String():
+		*s = ImportJobStatusCompleted
+	case ImportJobStatusFailed.String():
+		*s = ImportJobStatusFailed
+	default:
+		return fmt.Errorf("invalid ImportJobStatus value: %q", val)
+	}
+
+	return nil
+}
+
+func (s ImportJobStatus) String() string {
+	return string(s)
+}
+
+func (s *ImportJobStatus) Scan(value any) error {
+	val, ok := value.(string)
+	if !ok {
+		return fmt.Errorf("invalid scan source for ImportJobStatus, expected string got %T", value)
+	}
+
+	return s.UnmarshalText([]byte(val))
+}
+
+func (s ImportJobStatus) Value() (driver.Value, error) {
+	return s.String(), nil
+}
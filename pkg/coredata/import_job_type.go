@@ -0,0 +1,84 @@
+// Copyright (c) 2025 Probo Inc <hello@getprobo.com>.
+//
+// Permission to use, copy, modify, and/or distribute this software for any
+// purpose with or without fee is hereby granted, provided that the above
+// copyright notice and this permission notice appear in all copies.
+//
+// THE SOFTWARE IS PROVIDED "AS IS" AND THE AUTHOR DISCLAIMS ALL WARRANTIES WITH
+// REGARD TO THIS SOFTWARE INCLUDING ALL IMPLIED WARRANTIES OF MERCHANTABILITY
+// AND FITNESS. IN NO EVENT SHALL THE AUTHOR BE LIABLE FOR ANY SPECIAL, DIRECT,
+// INDIRECT, OR CONSEQUENTIAL DAMAGES OR ANY DAMAGES WHATSOEVER RESULTING FROM
+// LOSS OF USE, DATA OR PROFITS, WHETHER IN AN ACTION OF CONTRACT, NEGLIGENCE OR
+// OTHER TORTIOUS ACTION, ARISING OUT OF OR IN CONNECTION WITH THE USE OR
+// PERFORMANCE OF THIS SOFTWARE.
+
+package coredata
+
+import (
+	"database/sql/driver"
+	"fmt"
+)
+
+type (
+	ImportJobType string
+)
+
+const (
+	ImportJobTypeRisk               ImportJobType = "RISK"
+	ImportJobTypeAsset              ImportJobType = "ASSET"
+	ImportJobTypeVendor             ImportJobType = "VENDOR"
+	ImportJobTypeDatum              ImportJobType = "DATUM"
+	ImportJobTypeProcessingActivity ImportJobType = "PROCESSING_ACTIVITY"
+)
+
+func ImportJobTypes() []ImportJobType {
+	return []ImportJobType{
+		ImportJobTypeRisk,
+		ImportJobTypeAsset,
+		ImportJobTypeVendor,
+		ImportJobTypeDatum,
+		ImportJobTypeProcessingActivity,
+	}
+}
+
+func (t ImportJobType) MarshalText() ([]byte, error) {
+	return []byte(t.String()), nil
+}
+
+func (t *ImportJobType) UnmarshalText(data []byte) error {
+	val := string(data)
+
+	switch val {
+	case ImportJobTypeRisk.String():
+		*t = ImportJobTypeRisk
+	case ImportJobTypeAsset.String():
+		*t = ImportJobTypeAsset
+	case ImportJobTypeVendor.String():
+		*t = ImportJobTypeVendor
+	case ImportJobTypeDatum.String():
+		*t = ImportJobTypeDatum
+	case ImportJobTypeProcessingActivity.String():
+		*t = ImportJobTypeProcessingActivity
+	default:
+		return fmt.Errorf("invalid ImportJobType value: %q", val)
+	}
+
+	return nil
+}
+
+func (t ImportJobType) String() string {
+	return string(t)
+}
+
+func (t *ImportJobType) Scan(value any) error {
+	val, ok := value.(string)
+	if !ok {
+		return fmt.Errorf("invalid scan source for ImportJobType, expected string got %T", value)
+	}
+
+	return t.UnmarshalText([]byte(val))
+}
+
+func (t ImportJobType) Value() (driver.Value, error) {
+	return t.String(), nil
+}
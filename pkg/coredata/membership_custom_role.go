@@ -0,0 +1,101 @@
+// Copyright (c) 2025 Probo Inc <hello@getprobo.com>.
+//
+// Permission to use, copy, modify, and/or distribute this software for any
+// purpose with or without fee is hereby granted, provided that the above
+// copyright notice and this permission notice appear in all copies.
+//
+// THE SOFTWARE IS PROVIDED "AS IS" AND THE AUTHOR DISCLAIMS ALL WARRANTIES WITH
+// REGARD TO THIS SOFTWARE INCLUDING ALL IMPLIED WARRANTIES OF MERCHANTABILITY
+// AND FITNESS. IN NO EVENT SHALL THE AUTHOR BE LIABLE FOR ANY SPECIAL, DIRECT,
+// INDIRECT, OR CONSEQUENTIAL DAMAGES OR ANY DAMAGES WHATSOEVER RESULTING FROM
+// LOSS OF USE, DATA OR PROFITS, WHETHER IN AN ACTION OF CONTRACT, NEGLIGENCE OR
+// OTHER TORTIOUS ACTION, ARISING OUT OF OR IN CONNECTION WITH THE USE OR
+// PERFORMANCE OF THIS SOFTWARE.
+
+package coredata
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"go.gearno.de/kit/pg"
+	"go.probo.inc/probo/pkg/gid"
+)
+
+type (
+	// MembershipCustomRole assigns a custom role to a membership. The
+	// custom role permissions are granted on top of the membership role.
+	MembershipCustomRole struct {
+		MembershipID gid.GID   `db:"membership_id"`
+		CustomRoleID gid.GID   `db:"custom_role_id"`
+		CreatedAt    time.Time `db:"created_at"`
+	}
+)
+
+// Upsert assigns the custom role to the membership, replacing any
+// previously assigned custom role.
+func (m *MembershipCustomRole) Upsert(
+	ctx context.Context,
+	conn pg.Conn,
+	scope Scoper,
+) error {
+	q := `
+INSERT INTO iam_membership_custom_roles (
+    membership_id,
+    tenant_id,
+    custom_role_id,
+    created_at
+) VALUES (
+    @membership_id,
+    @tenant_id,
+    @custom_role_id,
+    @created_at
+)
+ON CONFLICT (membership_id) DO UPDATE SET
+    custom_role_id = EXCLUDED.custom_role_id,
+    created_at = EXCLUDED.created_at
+`
+
+	args := pgx.StrictNamedArgs{
+		"membership_id":  m.MembershipID,
+		"tenant_id":      scope.GetTenantID(),
+		"custom_role_id": m.CustomRoleID,
+		"created_at":     m.CreatedAt,
+	}
+
+	if _, err := conn.Exec(ctx, q, args); err != nil {
+		return fmt.Errorf("cannot upsert membership custom role: %w", err)
+	}
+
+	return nil
+}
+
+// DeleteByMembershipID removes the custom role assigned to the
+// membership, if any.
+func (m *MembershipCustomRole) DeleteByMembershipID(
+	ctx context.Context,
+	conn pg.Conn,
+	scope Scoper,
+	membershipID gid.GID,
+) error {
+	q := `
+DELETE FROM iam_membership_custom_roles
+WHERE
+    %s
+    AND membership_id = @membership_id
+`
+
+	q = fmt.Sprintf(q, scope.SQLFragment())
+
+	args := pgx.StrictNamedArgs{"membership_id": membershipID}
+	maps.Copy(args, scope.SQLArguments())
+
+	if _, err := conn.Exec(ctx, q, args); err != nil {
+		return fmt.Errorf("cannot delete membership custom role: %w", err)
+	}
+
+	return nil
+}
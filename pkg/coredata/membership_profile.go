@@ -19,6 +19,7 @@ import (
 	"errors"
 	"fmt"
 	"maps"
+	"strings"
 	"time"
 
 	"github.com/jackc/pgx/v5"
@@ -38,6 +39,8 @@ type (
 		FullName                 string                `db:"full_name"`
 		Kind                     MembershipProfileKind `db:"kind"`
 		AdditionalEmailAddresses mail.Addrs            `db:"additional_email_addresses"`
+		WeeklyDigestEnabled      bool                  `db:"weekly_digest_enabled"`
+		WeeklyDigestLastSentAt   *time.Time            `db:"weekly_digest_last_sent_at"`
 		Position                 *string               `db:"position"`
 		ContractStartDate        *time.Time            `db:"contract_start_date"`
 		ContractEndDate          *time.Time            `db:"contract_end_date"`
@@ -48,6 +51,8 @@ type (
 	MembershipProfiles []*MembershipProfile
 )
 
+var ErrNoWeeklyDigestDue = errors.New("no weekly digest due")
+
 func (p MembershipProfile) CursorKey(orderBy MembershipProfileOrderField) page.CursorKey {
 	switch orderBy {
 	case MembershipProfileOrderFieldCreatedAt:
@@ -95,6 +100,8 @@ SELECT
     p.full_name,
     p.kind,
     p.additional_email_addresses,
+    p.weekly_digest_enabled,
+    p.weekly_digest_last_sent_at,
     p.position,
     p.contract_start_date,
     p.contract_end_date,
@@ -150,6 +157,8 @@ SELECT
     p.full_name,
     p.kind,
     p.additional_email_addresses,
+    p.weekly_digest_enabled,
+    p.weekly_digest_last_sent_at,
     p.position,
     p.contract_start_date,
     p.contract_end_date,
@@ -206,6 +215,8 @@ SELECT
     p.full_name,
     p.kind,
     p.additional_email_addresses,
+    p.weekly_digest_enabled,
+    p.weekly_digest_last_sent_at,
     p.position,
     p.contract_start_date,
     p.contract_end_date,
@@ -265,6 +276,8 @@ SELECT
     p.full_name,
     p.kind,
     p.additional_email_addresses,
+    p.weekly_digest_enabled,
+    p.weekly_digest_last_sent_at,
     p.position,
     p.contract_start_date,
     p.contract_end_date,
@@ -317,6 +330,8 @@ WITH profiles AS (
         full_name,
         kind,
         additional_email_addresses,
+        weekly_digest_enabled,
+        weekly_digest_last_sent_at,
         position,
         contract_start_date,
         contract_end_date,
@@ -339,6 +354,8 @@ SELECT
     p.full_name,
     p.kind,
     p.additional_email_addresses,
+    p.weekly_digest_enabled,
+    p.weekly_digest_last_sent_at,
     p.position,
     p.contract_start_date,
     p.contract_end_date,
@@ -385,6 +402,8 @@ WITH profiles AS (
         mp.full_name,
         mp.kind,
         mp.additional_email_addresses,
+        mp.weekly_digest_enabled,
+        mp.weekly_digest_last_sent_at,
         mp.position,
         mp.contract_start_date,
         mp.contract_end_date,
@@ -410,6 +429,8 @@ SELECT
     p.full_name,
     p.kind,
     p.additional_email_addresses,
+    p.weekly_digest_enabled,
+    p.weekly_digest_last_sent_at,
     p.position,
     p.contract_start_date,
     p.contract_end_date,
@@ -488,6 +509,8 @@ WITH profiles AS (
         mp.full_name,
         mp.kind,
         mp.additional_email_addresses,
+        mp.weekly_digest_enabled,
+        mp.weekly_digest_last_sent_at,
         mp.position,
         mp.contract_start_date,
         mp.contract_end_date,
@@ -513,6 +536,8 @@ SELECT
     p.full_name,
     p.kind,
     p.additional_email_addresses,
+    p.weekly_digest_enabled,
+    p.weekly_digest_last_sent_at,
     p.position,
     p.contract_start_date,
     p.contract_end_date,
@@ -592,6 +617,8 @@ WITH attendees AS (
         p.full_name,
         p.kind,
         p.additional_email_addresses,
+        p.weekly_digest_enabled,
+        p.weekly_digest_last_sent_at,
         p.position,
         p.contract_start_date,
         p.contract_end_date,
@@ -616,6 +643,8 @@ SELECT
     email_address,
     full_name,
     additional_email_addresses,
+    weekly_digest_enabled,
+    weekly_digest_last_sent_at,
     position,
     contract_start_date,
     contract_end_date,
@@ -675,6 +704,8 @@ SELECT
     p.full_name,
     i.email_address,
     p.additional_email_addresses,
+    p.weekly_digest_enabled,
+    p.weekly_digest_last_sent_at,
     p.position,
     p.contract_start_date,
     p.contract_end_date,
@@ -704,6 +735,267 @@ INNER JOIN signatories ON p.id = signatories.signed_by_profile_id
 	return nil
 }
 
+// LoadActiveAdminsByOrganizationID loads the profiles of the active owners
+// and admins of the organization.
+// LoadActiveSignatoriesByOrganizationID loads the members of the
+// organization with an active membership that can sign documents, that is
+// every profile but service accounts. When profileIDs is not empty, only
+// these profiles are loaded.
+func (p *MembershipProfiles) LoadActiveSignatoriesByOrganizationID(
+	ctx context.Context,
+	conn pg.Conn,
+	scope Scoper,
+	organizationID gid.GID,
+	profileIDs []gid.GID,
+) error {
+	q := `
+SELECT
+    p.id,
+    p.identity_id,
+    p.organization_id,
+    p.membership_id,
+    i.email_address,
+    p.full_name,
+    p.kind,
+    p.additional_email_addresses,
+    p.weekly_digest_enabled,
+    p.weekly_digest_last_sent_at,
+    p.position,
+    p.contract_start_date,
+    p.contract_end_date,
+    p.created_at,
+    p.updated_at
+FROM
+    iam_membership_profiles p
+INNER JOIN identities i
+    ON i.id = p.identity_id
+INNER JOIN iam_memberships m
+    ON m.id = p.membership_id
+WHERE
+    p.%s
+    AND p.organization_id = @organization_id
+    AND p.kind <> 'SERVICE_ACCOUNT'
+    AND m.state = 'ACTIVE'
+    AND (
+        cardinality(@profile_ids::text[]) = 0
+        OR p.id = ANY(@profile_ids::text[])
+    )
+ORDER BY
+    p.full_name ASC
+`
+
+	q = fmt.Sprintf(q, scope.SQLFragment())
+
+	if profileIDs == nil {
+		profileIDs = []gid.GID{}
+	}
+
+	args := pgx.StrictNamedArgs{
+		"organization_id": organizationID,
+		"profile_ids":     profileIDs,
+	}
+	maps.Copy(args, scope.SQLArguments())
+
+	rows, err := conn.Query(ctx, q, args)
+	if err != nil {
+		return fmt.Errorf("cannot query profiles: %w", err)
+	}
+
+	profiles, err := pgx.CollectRows(rows, pgx.RowToAddrOfStructByName[MembershipProfile])
+	if err != nil {
+		return fmt.Errorf("cannot collect profiles: %w", err)
+	}
+
+	*p = profiles
+
+	return nil
+}
+
+// LoadByOrganizationIDAndEmailAddresses loads the profiles of an
+// organization whose identity has one of the given email addresses,
+// compared case insensitively.
+func (p *MembershipProfiles) LoadByOrganizationIDAndEmailAddresses(
+	ctx context.Context,
+	conn pg.Conn,
+	scope Scoper,
+	organizationID gid.GID,
+	emailAddresses []string,
+) error {
+	q := `
+SELECT
+    p.id,
+    p.identity_id,
+    p.organization_id,
+    p.membership_id,
+    i.email_address,
+    p.full_name,
+    p.kind,
+    p.additional_email_addresses,
+    p.weekly_digest_enabled,
+    p.weekly_digest_last_sent_at,
+    p.position,
+    p.contract_start_date,
+    p.contract_end_date,
+    p.created_at,
+    p.updated_at
+FROM
+    iam_membership_profiles p
+INNER JOIN identities i
+    ON i.id = p.identity_id
+WHERE
+    p.%s
+    AND p.organization_id = @organization_id
+    AND lower(i.email_address) = ANY(@email_addresses::text[])
+`
+
+	q = fmt.Sprintf(q, scope.SQLFragment())
+
+	lowered := make([]string, len(emailAddresses))
+	for i, emailAddress := range emailAddresses {
+		lowered[i] = strings.ToLower(emailAddress)
+	}
+
+	args := pgx.StrictNamedArgs{
+		"organization_id": organizationID,
+		"email_addresses": lowered,
+	}
+	maps.Copy(args, scope.SQLArguments())
+
+	rows, err := conn.Query(ctx, q, args)
+	if err != nil {
+		return fmt.Errorf("cannot query profiles: %w", err)
+	}
+
+	profiles, err := pgx.CollectRows(rows, pgx.RowToAddrOfStructByName[MembershipProfile])
+	if err != nil {
+		return fmt.Errorf("cannot collect profiles: %w", err)
+	}
+
+	*p = profiles
+
+	return nil
+}
+
+func (p *MembershipProfiles) LoadActiveAdminsByOrganizationID(
+	ctx context.Context,
+	conn pg.Conn,
+	scope Scoper,
+	organizationID gid.GID,
+) error {
+	q := `
+SELECT
+    p.id,
+    p.identity_id,
+    p.organization_id,
+    p.membership_id,
+    i.email_address,
+    p.full_name,
+    p.kind,
+    p.additional_email_addresses,
+    p.weekly_digest_enabled,
+    p.weekly_digest_last_sent_at,
+    p.position,
+    p.contract_start_date,
+    p.contract_end_date,
+    p.created_at,
+    p.updated_at
+FROM
+    iam_membership_profiles p
+INNER JOIN identities i
+    ON i.id = p.identity_id
+INNER JOIN iam_memberships m
+    ON m.id = p.membership_id
+WHERE
+    p.%s
+    AND p.organization_id = @organization_id
+    AND m.role IN ('OWNER', 'ADMIN')
+    AND m.state = 'ACTIVE'
+ORDER BY
+    p.full_name ASC
+`
+
+	q = fmt.Sprintf(q, scope.SQLFragment())
+
+	args := pgx.StrictNamedArgs{"organization_id": organizationID}
+	maps.Copy(args, scope.SQLArguments())
+
+	rows, err := conn.Query(ctx, q, args)
+	if err != nil {
+		return fmt.Errorf("cannot query profiles: %w", err)
+	}
+
+	profiles, err := pgx.CollectRows(rows, pgx.RowToAddrOfStructByName[MembershipProfile])
+	if err != nil {
+		return fmt.Errorf("cannot collect profiles: %w", err)
+	}
+
+	*p = profiles
+
+	return nil
+}
+
+// LoadNextWeeklyDigestDueForUpdateSkipLocked locks an active profile opted
+// in to the weekly digest that has not received it since weekStart,
+// skipping profiles locked by other workers. It returns
+// ErrNoWeeklyDigestDue when no digest is due.
+func (p *MembershipProfile) LoadNextWeeklyDigestDueForUpdateSkipLocked(
+	ctx context.Context,
+	conn pg.Conn,
+	weekStart time.Time,
+) error {
+	q := `
+SELECT
+    p.id,
+    p.identity_id,
+    p.organization_id,
+    p.membership_id,
+    i.email_address,
+    p.full_name,
+    p.kind,
+    p.additional_email_addresses,
+    p.weekly_digest_enabled,
+    p.weekly_digest_last_sent_at,
+    p.position,
+    p.contract_start_date,
+    p.contract_end_date,
+    p.created_at,
+    p.updated_at
+FROM
+    iam_membership_profiles p
+INNER JOIN identities i
+    ON i.id = p.identity_id
+INNER JOIN iam_memberships m
+    ON m.id = p.membership_id
+WHERE
+    p.weekly_digest_enabled
+    AND (p.weekly_digest_last_sent_at IS NULL OR p.weekly_digest_last_sent_at < @week_start)
+    AND m.state = 'ACTIVE'
+ORDER BY
+    p.weekly_digest_last_sent_at ASC NULLS FIRST
+LIMIT 1
+FOR UPDATE OF p SKIP LOCKED
+`
+
+	args := pgx.StrictNamedArgs{"week_start": weekStart}
+
+	rows, err := conn.Query(ctx, q, args)
+	if err != nil {
+		return fmt.Errorf("cannot query profiles: %w", err)
+	}
+
+	profile, err := pgx.CollectExactlyOneRow(rows, pgx.RowToStructByName[MembershipProfile])
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return ErrNoWeeklyDigestDue
+		}
+		return fmt.Errorf("cannot collect profile: %w", err)
+	}
+
+	*p = profile
+
+	return nil
+}
+
 func (p *MembershipProfiles) CountByOrganizationID(
 	ctx context.Context,
 	conn pg.Conn,
@@ -757,6 +1049,8 @@ INSERT INTO
         full_name,
         kind,
         additional_email_addresses,
+        weekly_digest_enabled,
+        weekly_digest_last_sent_at,
         position,
         contract_start_date,
         contract_end_date,
@@ -772,6 +1066,8 @@ VALUES (
     @full_name,
     @kind,
     COALESCE(@additional_email_addresses, '{}'::CITEXT[]),
+    @weekly_digest_enabled,
+    @weekly_digest_last_sent_at,
     @position,
     @contract_start_date,
     @contract_end_date,
@@ -789,6 +1085,8 @@ VALUES (
 		"full_name":                  p.FullName,
 		"kind":                       p.Kind,
 		"additional_email_addresses": p.AdditionalEmailAddresses,
+		"weekly_digest_enabled":      p.WeeklyDigestEnabled,
+		"weekly_digest_last_sent_at": p.WeeklyDigestLastSentAt,
 		"position":                   p.Position,
 		"contract_start_date":        p.ContractStartDate,
 		"contract_end_date":          p.ContractEndDate,
@@ -816,6 +1114,8 @@ SET
     full_name = @full_name,
     kind = @kind,
     additional_email_addresses = @additional_email_addresses,
+    weekly_digest_enabled = @weekly_digest_enabled,
+    weekly_digest_last_sent_at = @weekly_digest_last_sent_at,
     position = @position,
     contract_start_date = @contract_start_date,
     contract_end_date = @contract_end_date,
@@ -832,6 +1132,8 @@ WHERE
 		"full_name":                  p.FullName,
 		"kind":                       p.Kind,
 		"additional_email_addresses": p.AdditionalEmailAddresses,
+		"weekly_digest_enabled":      p.WeeklyDigestEnabled,
+		"weekly_digest_last_sent_at": p.WeeklyDigestLastSentAt,
 		"position":                   p.Position,
 		"contract_start_date":        p.ContractStartDate,
 		"contract_end_date":          p.ContractEndDate,
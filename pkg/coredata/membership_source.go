@@ -25,6 +25,7 @@ const (
 	MembershipSourceManual MembershipSource = "MANUAL"
 	MembershipSourceSAML   MembershipSource = "SAML"
 	MembershipSourceSCIM   MembershipSource = "SCIM"
+	MembershipSourceOIDC   MembershipSource = "OIDC"
 )
 
 func (s MembershipSource) String() string {
@@ -49,6 +50,8 @@ func (s *MembershipSource) Scan(value any) error {
 		*s = MembershipSourceSAML
 	case "SCIM":
 		*s = MembershipSourceSCIM
+	case "OIDC":
+		*s = MembershipSourceOIDC
 	default:
 		return fmt.Errorf("invalid MembershipSource value: %q", str)
 	}
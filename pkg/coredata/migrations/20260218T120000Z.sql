@@ -0,0 +1 @@
+ALTER TYPE webhook_event_status ADD VALUE 'DEAD_LETTER';
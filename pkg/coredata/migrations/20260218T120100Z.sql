@@ -0,0 +1,26 @@
+ALTER TABLE webhook_events
+    ADD COLUMN attempts INTEGER NOT NULL DEFAULT 0,
+    ADD COLUMN next_attempt_at TIMESTAMP WITH TIME ZONE,
+    ADD COLUMN last_attempted_at TIMESTAMP WITH TIME ZONE;
+
+-- Events delivered before retries existed were attempted exactly once.
+UPDATE webhook_events
+    SET attempts = 1,
+        last_attempted_at = created_at
+    WHERE status IN ('SUCCEEDED', 'FAILED');
+
+-- A failure used to be terminal, keep it that way.
+UPDATE webhook_events
+    SET status = 'DEAD_LETTER'
+    WHERE status = 'FAILED';
+
+UPDATE webhook_events
+    SET next_attempt_at = created_at
+    WHERE status = 'PENDING';
+
+ALTER TABLE webhook_events
+    ALTER COLUMN attempts DROP DEFAULT;
+
+CREATE INDEX webhook_events_next_attempt_at_idx
+    ON webhook_events (next_attempt_at)
+    WHERE status IN ('PENDING', 'FAILED');
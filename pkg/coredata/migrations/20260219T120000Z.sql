@@ -0,0 +1,23 @@
+ALTER TYPE webhook_event_type ADD VALUE 'risk:created';
+ALTER TYPE webhook_event_type ADD VALUE 'risk:updated';
+ALTER TYPE webhook_event_type ADD VALUE 'risk:deleted';
+ALTER TYPE webhook_event_type ADD VALUE 'measure:created';
+ALTER TYPE webhook_event_type ADD VALUE 'measure:updated';
+ALTER TYPE webhook_event_type ADD VALUE 'measure:deleted';
+ALTER TYPE webhook_event_type ADD VALUE 'control:created';
+ALTER TYPE webhook_event_type ADD VALUE 'control:updated';
+ALTER TYPE webhook_event_type ADD VALUE 'control:deleted';
+ALTER TYPE webhook_event_type ADD VALUE 'task:created';
+ALTER TYPE webhook_event_type ADD VALUE 'task:updated';
+ALTER TYPE webhook_event_type ADD VALUE 'task:deleted';
+ALTER TYPE webhook_event_type ADD VALUE 'nonconformity:created';
+ALTER TYPE webhook_event_type ADD VALUE 'nonconformity:updated';
+ALTER TYPE webhook_event_type ADD VALUE 'nonconformity:deleted';
+ALTER TYPE webhook_event_type ADD VALUE 'audit:created';
+ALTER TYPE webhook_event_type ADD VALUE 'audit:updated';
+ALTER TYPE webhook_event_type ADD VALUE 'audit:deleted';
+ALTER TYPE webhook_event_type ADD VALUE 'evidence:created';
+ALTER TYPE webhook_event_type ADD VALUE 'evidence:deleted';
+ALTER TYPE webhook_event_type ADD VALUE 'document:version_published';
+ALTER TYPE webhook_event_type ADD VALUE 'document:signature_signed';
+ALTER TYPE webhook_event_type ADD VALUE 'trust_center_access:requested';
@@ -0,0 +1,38 @@
+CREATE TYPE audit_log_actor_type AS ENUM (
+    'USER',
+    'API_KEY',
+    'SYSTEM'
+);
+
+CREATE TABLE audit_log_entries (
+    id TEXT PRIMARY KEY,
+    tenant_id TEXT NOT NULL,
+    organization_id TEXT NOT NULL,
+    actor_type audit_log_actor_type NOT NULL,
+    actor_id TEXT,
+    api_key_id TEXT,
+    action TEXT NOT NULL,
+    resource_id TEXT NOT NULL,
+    changes JSONB NOT NULL,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL
+);
+
+CREATE INDEX idx_audit_log_entries_organization_id_created_at
+    ON audit_log_entries (organization_id, created_at DESC);
+
+CREATE INDEX idx_audit_log_entries_resource_id
+    ON audit_log_entries (resource_id);
+
+CREATE FUNCTION prevent_audit_log_entry_modification()
+RETURNS TRIGGER AS $$
+BEGIN
+    RAISE EXCEPTION 'audit log entries are append-only';
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER audit_log_entries_append_only
+    BEFORE UPDATE OR DELETE ON audit_log_entries
+    FOR EACH ROW
+    EXECUTE FUNCTION prevent_audit_log_entry_modification();
+
+ALTER TYPE export_jobs_type ADD VALUE 'AUDIT_LOG';
@@ -0,0 +1,20 @@
+CREATE TABLE connector_evidence_mappings (
+    id TEXT PRIMARY KEY,
+    tenant_id TEXT NOT NULL,
+    organization_id TEXT NOT NULL REFERENCES organizations(id) ON DELETE CASCADE,
+    connector_id TEXT NOT NULL REFERENCES connectors(id) ON DELETE CASCADE,
+    measure_id TEXT NOT NULL REFERENCES measures(id) ON DELETE CASCADE,
+    evidence_key TEXT NOT NULL,
+    last_collected_at TIMESTAMP WITH TIME ZONE,
+    next_collection_at TIMESTAMP WITH TIME ZONE,
+    collection_error TEXT,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    CONSTRAINT connector_evidence_mappings_unique UNIQUE (connector_id, measure_id, evidence_key)
+);
+
+CREATE INDEX idx_connector_evidence_mappings_measure_id
+    ON connector_evidence_mappings (measure_id);
+
+CREATE INDEX idx_connector_evidence_mappings_next_collection_at
+    ON connector_evidence_mappings (next_collection_at NULLS FIRST);
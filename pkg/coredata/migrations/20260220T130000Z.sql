@@ -0,0 +1,21 @@
+ALTER TYPE connector_provider ADD VALUE 'GITHUB';
+
+CREATE TYPE connector_evidence_finding_action AS ENUM (
+    'NONE',
+    'TASK',
+    'NONCONFORMITY'
+);
+
+ALTER TABLE connector_evidence_mappings
+    ADD COLUMN finding_action connector_evidence_finding_action NOT NULL DEFAULT 'NONE',
+    ADD COLUMN finding_owner_profile_id TEXT REFERENCES iam_membership_profiles(id) ON UPDATE CASCADE ON DELETE SET NULL;
+
+CREATE TABLE connector_evidence_findings (
+    tenant_id TEXT NOT NULL,
+    mapping_id TEXT NOT NULL REFERENCES connector_evidence_mappings(id) ON DELETE CASCADE,
+    finding_key TEXT NOT NULL,
+    task_id TEXT REFERENCES tasks(id) ON DELETE SET NULL,
+    nonconformity_id TEXT REFERENCES nonconformities(id) ON DELETE SET NULL,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    PRIMARY KEY (mapping_id, finding_key)
+);
@@ -0,0 +1,2 @@
+ALTER TYPE connector_provider ADD VALUE 'AWS';
+ALTER TYPE connector_protocol ADD VALUE 'AWS_CREDENTIALS';
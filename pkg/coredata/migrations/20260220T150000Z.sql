@@ -0,0 +1,33 @@
+CREATE TABLE iam_custom_roles (
+    id TEXT PRIMARY KEY,
+    tenant_id TEXT NOT NULL,
+    organization_id TEXT NOT NULL REFERENCES organizations(id) ON DELETE CASCADE,
+    name TEXT NOT NULL,
+    description TEXT,
+    policy JSONB NOT NULL,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    UNIQUE (organization_id, name)
+);
+
+CREATE TABLE iam_membership_custom_roles (
+    membership_id TEXT PRIMARY KEY REFERENCES iam_memberships(id) ON DELETE CASCADE,
+    tenant_id TEXT NOT NULL,
+    custom_role_id TEXT NOT NULL REFERENCES iam_custom_roles(id) ON DELETE CASCADE,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL
+);
+
+CREATE INDEX iam_membership_custom_roles_custom_role_id_idx
+    ON iam_membership_custom_roles (custom_role_id);
+
+CREATE TABLE iam_personal_api_key_custom_roles (
+    personal_api_key_id TEXT NOT NULL REFERENCES iam_personal_api_keys(id) ON DELETE CASCADE,
+    tenant_id TEXT NOT NULL,
+    organization_id TEXT NOT NULL REFERENCES organizations(id) ON DELETE CASCADE,
+    custom_role_id TEXT NOT NULL REFERENCES iam_custom_roles(id) ON DELETE CASCADE,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    PRIMARY KEY (personal_api_key_id, organization_id)
+);
+
+CREATE INDEX iam_personal_api_key_custom_roles_custom_role_id_idx
+    ON iam_personal_api_key_custom_roles (custom_role_id);
@@ -0,0 +1,21 @@
+CREATE TABLE snapshot_schedules (
+    id TEXT PRIMARY KEY,
+    tenant_id TEXT NOT NULL,
+    organization_id TEXT NOT NULL REFERENCES organizations(id) ON DELETE CASCADE,
+    name TEXT NOT NULL,
+    description TEXT,
+    type snapshots_type NOT NULL,
+    cron_expression TEXT NOT NULL,
+    next_run_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    last_run_at TIMESTAMP WITH TIME ZONE,
+    last_snapshot_id TEXT REFERENCES snapshots(id) ON DELETE SET NULL,
+    last_error TEXT,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL
+);
+
+CREATE INDEX idx_snapshot_schedules_organization_id
+    ON snapshot_schedules (organization_id);
+
+CREATE INDEX idx_snapshot_schedules_next_run_at
+    ON snapshot_schedules (next_run_at);
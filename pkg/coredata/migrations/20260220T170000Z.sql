@@ -0,0 +1,38 @@
+CREATE TABLE iam_identity_totp_factors (
+    identity_id TEXT PRIMARY KEY REFERENCES identities(id) ON DELETE CASCADE,
+    encrypted_secret BYTEA NOT NULL,
+    last_used_counter BIGINT,
+    confirmed_at TIMESTAMP WITH TIME ZONE,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL
+);
+
+CREATE TABLE iam_identity_recovery_codes (
+    id TEXT PRIMARY KEY,
+    identity_id TEXT NOT NULL REFERENCES identities(id) ON DELETE CASCADE,
+    hashed_code BYTEA NOT NULL,
+    used_at TIMESTAMP WITH TIME ZONE,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL
+);
+
+CREATE INDEX idx_iam_identity_recovery_codes_identity_id
+    ON iam_identity_recovery_codes (identity_id);
+
+CREATE TABLE iam_webauthn_credentials (
+    id TEXT PRIMARY KEY,
+    identity_id TEXT NOT NULL REFERENCES identities(id) ON DELETE CASCADE,
+    name TEXT NOT NULL,
+    credential_id BYTEA NOT NULL,
+    credential JSONB NOT NULL,
+    last_used_at TIMESTAMP WITH TIME ZONE,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    CONSTRAINT iam_webauthn_credentials_credential_id_unique UNIQUE (credential_id)
+);
+
+CREATE INDEX idx_iam_webauthn_credentials_identity_id
+    ON iam_webauthn_credentials (identity_id);
+
+ALTER TABLE iam_sessions ADD COLUMN mfa_verified_at TIMESTAMP WITH TIME ZONE;
+
+ALTER TABLE organizations ADD COLUMN mfa_required BOOLEAN NOT NULL DEFAULT FALSE;
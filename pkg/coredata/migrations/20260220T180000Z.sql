@@ -0,0 +1,3 @@
+ALTER TYPE session_auth_method
+ADD
+    VALUE 'OIDC';
@@ -0,0 +1,37 @@
+CREATE TABLE iam_oidc_configurations (
+    id TEXT PRIMARY KEY,
+    tenant_id TEXT NOT NULL,
+    organization_id TEXT NOT NULL REFERENCES organizations(id) ON DELETE CASCADE,
+    email_domain TEXT NOT NULL,
+    enforcement_policy saml_enforcement_policy NOT NULL,
+    issuer_url TEXT NOT NULL,
+    client_id TEXT NOT NULL,
+    encrypted_client_secret BYTEA NOT NULL,
+    auto_signup_enabled BOOLEAN NOT NULL DEFAULT FALSE,
+    domain_verification_token TEXT,
+    domain_verified_at TIMESTAMP WITH TIME ZONE,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    CONSTRAINT iam_oidc_configurations_organization_id_email_domain_unique
+        UNIQUE (organization_id, email_domain)
+);
+
+CREATE INDEX idx_iam_oidc_configurations_tenant_id
+    ON iam_oidc_configurations (tenant_id);
+
+CREATE INDEX idx_iam_oidc_configurations_email_domain
+    ON iam_oidc_configurations (email_domain)
+    WHERE domain_verified_at IS NOT NULL;
+
+CREATE TABLE iam_oidc_authorization_requests (
+    id TEXT PRIMARY KEY,
+    oidc_configuration_id TEXT NOT NULL REFERENCES iam_oidc_configurations(id) ON DELETE CASCADE,
+    nonce TEXT NOT NULL,
+    code_verifier TEXT NOT NULL,
+    continue_url TEXT NOT NULL,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    expires_at TIMESTAMP WITH TIME ZONE NOT NULL
+);
+
+CREATE INDEX idx_iam_oidc_authorization_requests_expires_at
+    ON iam_oidc_authorization_requests (expires_at);
@@ -0,0 +1,40 @@
+CREATE TABLE iam_scim_groups (
+    id TEXT PRIMARY KEY,
+    tenant_id TEXT NOT NULL,
+    organization_id TEXT NOT NULL REFERENCES organizations(id) ON DELETE CASCADE,
+    scim_configuration_id TEXT NOT NULL REFERENCES iam_scim_configurations(id) ON DELETE CASCADE,
+    display_name TEXT NOT NULL,
+    external_id TEXT,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    CONSTRAINT iam_scim_groups_scim_configuration_id_display_name_unique
+        UNIQUE (scim_configuration_id, display_name)
+);
+
+CREATE INDEX idx_iam_scim_groups_tenant_id ON iam_scim_groups(tenant_id);
+CREATE INDEX idx_iam_scim_groups_organization_id ON iam_scim_groups(organization_id);
+
+CREATE TABLE iam_scim_group_members (
+    scim_group_id TEXT NOT NULL REFERENCES iam_scim_groups(id) ON DELETE CASCADE,
+    membership_id TEXT NOT NULL REFERENCES iam_memberships(id) ON DELETE CASCADE,
+    tenant_id TEXT NOT NULL,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    PRIMARY KEY (scim_group_id, membership_id)
+);
+
+CREATE INDEX idx_iam_scim_group_members_tenant_id ON iam_scim_group_members(tenant_id);
+CREATE INDEX idx_iam_scim_group_members_membership_id ON iam_scim_group_members(membership_id);
+
+CREATE TABLE iam_scim_group_role_mappings (
+    id TEXT PRIMARY KEY,
+    tenant_id TEXT NOT NULL,
+    organization_id TEXT NOT NULL REFERENCES organizations(id) ON DELETE CASCADE,
+    group_display_name TEXT NOT NULL,
+    role authz_role NOT NULL,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    CONSTRAINT iam_scim_group_role_mappings_organization_id_group_display_name_unique
+        UNIQUE (organization_id, group_display_name)
+);
+
+CREATE INDEX idx_iam_scim_group_role_mappings_tenant_id ON iam_scim_group_role_mappings(tenant_id);
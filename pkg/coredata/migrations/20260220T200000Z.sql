@@ -0,0 +1,3 @@
+ALTER TYPE connector_provider ADD VALUE 'MICROSOFT_ENTRA_ID';
+ALTER TYPE connector_provider ADD VALUE 'OKTA';
+ALTER TYPE connector_protocol ADD VALUE 'API_TOKEN';
@@ -0,0 +1,21 @@
+CREATE TYPE email_status AS ENUM (
+    'PENDING',
+    'SENT',
+    'FAILED'
+);
+
+ALTER TABLE emails ADD COLUMN organization_id TEXT REFERENCES organizations(id) ON DELETE SET NULL;
+ALTER TABLE emails ADD COLUMN status email_status NOT NULL DEFAULT 'PENDING';
+ALTER TABLE emails ADD COLUMN attempt_count INTEGER NOT NULL DEFAULT 0;
+ALTER TABLE emails ADD COLUMN next_attempt_at TIMESTAMP WITH TIME ZONE;
+ALTER TABLE emails ADD COLUMN last_error TEXT;
+ALTER TABLE emails ADD COLUMN failed_at TIMESTAMP WITH TIME ZONE;
+
+UPDATE emails SET status = 'SENT' WHERE sent_at IS NOT NULL;
+
+ALTER TABLE emails ALTER COLUMN status DROP DEFAULT;
+ALTER TABLE emails ALTER COLUMN attempt_count DROP DEFAULT;
+
+DROP INDEX IF EXISTS emails_sent_at_idx;
+CREATE INDEX emails_pending_idx ON emails (created_at) WHERE status = 'PENDING';
+CREATE INDEX emails_organization_id_idx ON emails (organization_id, created_at) WHERE organization_id IS NOT NULL;
@@ -0,0 +1,72 @@
+CREATE TYPE deadline_reminder_kind AS ENUM (
+    'UPCOMING',
+    'DUE',
+    'OVERDUE',
+    'ESCALATION'
+);
+
+ALTER TYPE slack_message_type ADD VALUE 'DEADLINE_REMINDER';
+
+CREATE TABLE deadline_reminder_settings (
+    id TEXT PRIMARY KEY,
+    tenant_id TEXT NOT NULL,
+    organization_id TEXT NOT NULL REFERENCES organizations(id) ON DELETE CASCADE,
+    enabled BOOLEAN NOT NULL,
+    days_before INTEGER[] NOT NULL,
+    remind_on_due_date BOOLEAN NOT NULL,
+    overdue_interval_days INTEGER NOT NULL,
+    escalation_grace_days INTEGER,
+    slack_enabled BOOLEAN NOT NULL,
+    next_run_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    last_run_at TIMESTAMP WITH TIME ZONE,
+    last_error TEXT,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    CONSTRAINT deadline_reminder_settings_organization_id_key UNIQUE (organization_id)
+);
+
+CREATE INDEX deadline_reminder_settings_next_run_at_idx ON deadline_reminder_settings (next_run_at);
+
+INSERT INTO deadline_reminder_settings (
+    id,
+    tenant_id,
+    organization_id,
+    enabled,
+    days_before,
+    remind_on_due_date,
+    overdue_interval_days,
+    escalation_grace_days,
+    slack_enabled,
+    next_run_at,
+    created_at,
+    updated_at
+)
+SELECT
+    generate_gid(decode_base64_unpadded(o.tenant_id), 68),
+    o.tenant_id,
+    o.id,
+    FALSE,
+    ARRAY[7, 1],
+    TRUE,
+    7,
+    3,
+    FALSE,
+    NOW(),
+    NOW(),
+    NOW()
+FROM
+    organizations o;
+
+CREATE TABLE deadline_reminders (
+    id TEXT PRIMARY KEY,
+    tenant_id TEXT NOT NULL,
+    organization_id TEXT NOT NULL REFERENCES organizations(id) ON DELETE CASCADE,
+    entity_id TEXT NOT NULL,
+    deadline DATE NOT NULL,
+    kind deadline_reminder_kind NOT NULL,
+    offset_days INTEGER NOT NULL,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    CONSTRAINT deadline_reminders_entity_deadline_kind_offset_key UNIQUE (entity_id, deadline, kind, offset_days)
+);
+
+CREATE INDEX deadline_reminders_organization_id_idx ON deadline_reminders (organization_id);
@@ -0,0 +1,10 @@
+ALTER TABLE iam_membership_profiles
+    ADD COLUMN weekly_digest_enabled BOOLEAN NOT NULL DEFAULT FALSE,
+    ADD COLUMN weekly_digest_last_sent_at TIMESTAMP WITH TIME ZONE;
+
+ALTER TABLE iam_membership_profiles
+    ALTER COLUMN weekly_digest_enabled DROP DEFAULT;
+
+CREATE INDEX iam_membership_profiles_weekly_digest_idx
+    ON iam_membership_profiles (weekly_digest_last_sent_at)
+    WHERE weekly_digest_enabled;
@@ -0,0 +1,11 @@
+CREATE TABLE controls_equivalences (
+    control_id TEXT NOT NULL REFERENCES controls(id) ON DELETE CASCADE,
+    equivalent_control_id TEXT NOT NULL REFERENCES controls(id) ON DELETE CASCADE,
+    organization_id TEXT NOT NULL REFERENCES organizations(id) ON DELETE CASCADE,
+    tenant_id TEXT NOT NULL,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    PRIMARY KEY (control_id, equivalent_control_id),
+    CONSTRAINT controls_equivalences_ordered_check CHECK (control_id COLLATE "C" < equivalent_control_id COLLATE "C")
+);
+
+CREATE INDEX controls_equivalences_equivalent_control_id_idx ON controls_equivalences (equivalent_control_id);
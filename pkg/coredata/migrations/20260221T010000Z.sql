@@ -0,0 +1,18 @@
+CREATE TABLE document_version_signature_proofs (
+    document_version_signature_id TEXT PRIMARY KEY REFERENCES document_version_signatures(id) ON DELETE CASCADE,
+    document_version_id TEXT NOT NULL REFERENCES document_versions(id) ON DELETE CASCADE,
+    organization_id TEXT NOT NULL REFERENCES organizations(id) ON DELETE CASCADE,
+    tenant_id TEXT NOT NULL,
+    sequence INTEGER NOT NULL,
+    content_hash TEXT NOT NULL,
+    signer_full_name TEXT NOT NULL,
+    signer_email_address TEXT NOT NULL,
+    signer_ip_address INET,
+    signer_user_agent TEXT NOT NULL,
+    signer_auth_method TEXT NOT NULL,
+    signed_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    previous_hash TEXT NOT NULL,
+    hash TEXT NOT NULL,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    UNIQUE (document_version_id, sequence)
+);
@@ -0,0 +1,47 @@
+ALTER TABLE documents
+    ADD COLUMN owner_profile_id TEXT REFERENCES iam_membership_profiles(id) ON DELETE SET NULL,
+    ADD COLUMN review_interval_days INTEGER CHECK (review_interval_days > 0),
+    ADD COLUMN next_review_date DATE,
+    ADD COLUMN last_reviewed_at TIMESTAMP WITH TIME ZONE;
+
+CREATE INDEX documents_next_review_date_idx ON documents (next_review_date)
+    WHERE deleted_at IS NULL AND next_review_date IS NOT NULL;
+
+CREATE TABLE acknowledgement_campaigns (
+    id TEXT PRIMARY KEY,
+    tenant_id TEXT NOT NULL,
+    organization_id TEXT NOT NULL REFERENCES organizations(id) ON DELETE CASCADE,
+    document_id TEXT NOT NULL REFERENCES documents(id) ON DELETE CASCADE,
+    recipient_profile_ids TEXT[],
+    interval_days INTEGER NOT NULL CHECK (interval_days > 0),
+    enabled BOOLEAN NOT NULL,
+    next_run_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    last_run_at TIMESTAMP WITH TIME ZONE,
+    last_error TEXT,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL
+);
+
+CREATE INDEX acknowledgement_campaigns_document_id_idx ON acknowledgement_campaigns (document_id);
+CREATE INDEX acknowledgement_campaigns_next_run_at_idx ON acknowledgement_campaigns (next_run_at)
+    WHERE enabled;
+
+CREATE TABLE acknowledgement_campaign_runs (
+    id TEXT PRIMARY KEY,
+    tenant_id TEXT NOT NULL,
+    organization_id TEXT NOT NULL REFERENCES organizations(id) ON DELETE CASCADE,
+    campaign_id TEXT NOT NULL REFERENCES acknowledgement_campaigns(id) ON DELETE CASCADE,
+    document_version_id TEXT NOT NULL REFERENCES document_versions(id) ON DELETE CASCADE,
+    started_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL
+);
+
+CREATE INDEX acknowledgement_campaign_runs_campaign_id_idx ON acknowledgement_campaign_runs (campaign_id, started_at DESC);
+
+CREATE TABLE acknowledgement_campaign_run_signatures (
+    run_id TEXT NOT NULL REFERENCES acknowledgement_campaign_runs(id) ON DELETE CASCADE,
+    document_version_signature_id TEXT NOT NULL REFERENCES document_version_signatures(id) ON DELETE CASCADE,
+    tenant_id TEXT NOT NULL,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    PRIMARY KEY (run_id, document_version_signature_id)
+);
@@ -0,0 +1,25 @@
+CREATE TYPE document_version_approval_state AS ENUM (
+    'PENDING',
+    'APPROVED',
+    'REJECTED'
+);
+
+ALTER TABLE document_approvers
+    ADD COLUMN stage INTEGER NOT NULL DEFAULT 1 CHECK (stage > 0);
+
+ALTER TABLE document_version_approvers
+    ADD COLUMN stage INTEGER NOT NULL DEFAULT 1 CHECK (stage > 0),
+    ADD COLUMN state document_version_approval_state NOT NULL DEFAULT 'PENDING',
+    ADD COLUMN comment TEXT,
+    ADD COLUMN decided_at TIMESTAMP WITH TIME ZONE,
+    ADD COLUMN updated_at TIMESTAMP WITH TIME ZONE;
+
+UPDATE document_version_approvers SET updated_at = created_at;
+
+ALTER TABLE document_version_approvers
+    ALTER COLUMN updated_at SET NOT NULL,
+    ALTER COLUMN stage DROP DEFAULT,
+    ALTER COLUMN state DROP DEFAULT;
+
+ALTER TABLE document_approvers
+    ALTER COLUMN stage DROP DEFAULT;
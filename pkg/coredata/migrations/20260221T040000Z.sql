@@ -0,0 +1,32 @@
+CREATE TYPE import_job_type AS ENUM (
+    'RISK',
+    'ASSET',
+    'VENDOR',
+    'DATUM',
+    'PROCESSING_ACTIVITY'
+);
+
+CREATE TYPE import_job_status AS ENUM (
+    'PENDING',
+    'PROCESSING',
+    'COMPLETED',
+    'FAILED'
+);
+
+CREATE TABLE import_jobs (
+    id TEXT PRIMARY KEY,
+    tenant_id TEXT NOT NULL,
+    organization_id TEXT NOT NULL REFERENCES organizations(id) ON DELETE CASCADE,
+    type import_job_type NOT NULL,
+    arguments JSONB NOT NULL,
+    status import_job_status NOT NULL,
+    error TEXT,
+    row_errors JSONB NOT NULL DEFAULT '[]',
+    imported_count INTEGER NOT NULL DEFAULT 0,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    started_at TIMESTAMP WITH TIME ZONE,
+    completed_at TIMESTAMP WITH TIME ZONE
+);
+
+CREATE INDEX import_jobs_organization_id_idx ON import_jobs (organization_id);
+CREATE INDEX import_jobs_status_created_at_idx ON import_jobs (status, created_at);
@@ -0,0 +1 @@
+ALTER TABLE import_jobs ADD COLUMN created_by TEXT REFERENCES identities(id) ON DELETE SET NULL;
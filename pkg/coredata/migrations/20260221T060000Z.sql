@@ -0,0 +1 @@
+ALTER TABLE import_jobs ADD COLUMN created_by_api_key_id TEXT REFERENCES iam_personal_api_keys(id) ON DELETE SET NULL;
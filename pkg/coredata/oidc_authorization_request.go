@@ -0,0 +1,156 @@
+// Copyright (c) 2025 Probo Inc <hello@getprobo.com>.
+//
+// Permission to use, copy, modify, and/or distribute this software for any
+// purpose with or without fee is hereby granted, provided that the above
+// copyright notice and this permission notice appear in all copies.
+//
+// THE SOFTWARE IS PROVIDED "AS IS" AND THE AUTHOR DISCLAIMS ALL WARRANTIES WITH
+// REGARD TO THIS SOFTWARE INCLUDING ALL IMPLIED WARRANTIES OF MERCHANTABILITY
+// AND FITNESS. IN NO EVENT SHALL THE AUTHOR BE LIABLE FOR ANY SPECIAL, DIRECT,
+// INDIRECT, OR CONSEQUENTIAL DAMAGES OR ANY DAMAGES WHATSOEVER RESULTING FROM
+// LOSS OF USE, DATA OR PROFITS, WHETHER IN AN ACTION OF CONTRACT, NEGLIGENCE OR
+// OTHER TORTIOUS ACTION, ARISING OUT OF OR IN CONNECTION WITH THE USE OR
+// PERFORMANCE OF THIS SOFTWARE.
+
+package coredata
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"go.gearno.de/kit/pg"
+	"go.probo.inc/probo/pkg/gid"
+)
+
+type OIDCAuthorizationRequest struct {
+	ID                  string    `db:"id"`
+	OIDCConfigurationID gid.GID   `db:"oidc_configuration_id"`
+	Nonce               string    `db:"nonce"`
+	CodeVerifier        string    `db:"code_verifier"`
+	ContinueURL         string    `db:"continue_url"`
+	CreatedAt           time.Time `db:"created_at"`
+	ExpiresAt           time.Time `db:"expires_at"`
+}
+
+func (r *OIDCAuthorizationRequest) Insert(
+	ctx context.Context,
+	conn pg.Conn,
+) error {
+	q := `
+INSERT INTO iam_oidc_authorization_requests (
+    id,
+    oidc_configuration_id,
+    nonce,
+    code_verifier,
+    continue_url,
+    created_at,
+    expires_at
+) VALUES (
+    @id,
+    @oidc_configuration_id,
+    @nonce,
+    @code_verifier,
+    @continue_url,
+    @created_at,
+    @expires_at
+)
+`
+
+	args := pgx.StrictNamedArgs{
+		"id":                    r.ID,
+		"oidc_configuration_id": r.OIDCConfigurationID,
+		"nonce":                 r.Nonce,
+		"code_verifier":         r.CodeVerifier,
+		"continue_url":          r.ContinueURL,
+		"created_at":            r.CreatedAt,
+		"expires_at":            r.ExpiresAt,
+	}
+
+	_, err := conn.Exec(ctx, q, args)
+	if err != nil {
+		return fmt.Errorf("cannot insert oidc_authorization_request: %w", err)
+	}
+
+	return nil
+}
+
+// LoadByIDForUpdate locks the request so that concurrent callbacks
+// presenting the same state cannot both consume it.
+func (r *OIDCAuthorizationRequest) LoadByIDForUpdate(
+	ctx context.Context,
+	conn pg.Conn,
+	requestID string,
+) error {
+	q := `
+SELECT
+    id,
+    oidc_configuration_id,
+    nonce,
+    code_verifier,
+    continue_url,
+    created_at,
+    expires_at
+FROM
+    iam_oidc_authorization_requests
+WHERE
+    id = @id
+LIMIT 1
+FOR UPDATE;
+`
+
+	rows, err := conn.Query(ctx, q, pgx.StrictNamedArgs{"id": requestID})
+	if err != nil {
+		return fmt.Errorf("cannot query iam_oidc_authorization_requests: %w", err)
+	}
+
+	req, err := pgx.CollectExactlyOneRow(rows, pgx.RowToStructByName[OIDCAuthorizationRequest])
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return ErrResourceNotFound
+		}
+
+		return fmt.Errorf("cannot collect oidc_authorization_request: %w", err)
+	}
+
+	*r = req
+
+	return nil
+}
+
+func (r *OIDCAuthorizationRequest) IsExpired(now time.Time) bool {
+	return !now.Before(r.ExpiresAt)
+}
+
+func (r *OIDCAuthorizationRequest) Delete(
+	ctx context.Context,
+	conn pg.Conn,
+) error {
+	q := `
+DELETE FROM iam_oidc_authorization_requests
+WHERE id = @id
+`
+
+	_, err := conn.Exec(ctx, q, pgx.StrictNamedArgs{"id": r.ID})
+	if err != nil {
+		return fmt.Errorf("cannot delete oidc_authorization_request: %w", err)
+	}
+
+	return nil
+}
+
+func DeleteExpiredOIDCAuthorizationRequests(ctx context.Context, conn pg.Conn, now time.Time) (int64, error) {
+	q := `
+DELETE FROM iam_oidc_authorization_requests
+WHERE expires_at < @now
+`
+
+	result, err := conn.Exec(ctx, q, pgx.StrictNamedArgs{"now": now})
+	if err != nil {
+		return 0, fmt.Errorf("cannot delete expired oidc_authorization_requests: %w", err)
+	}
+
+	return result.RowsAffected(), nil
+}
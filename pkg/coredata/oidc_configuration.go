@@ -0,0 +1,546 @@
+// Copyright (c) 2025 Probo Inc <hello@getprobo.com>.
+//
+// Permission to use, copy, modify, and/or distribute this software for any
+// purpose with or without fee is hereby granted, provided that the above
+// copyright notice and this permission notice appear in all copies.
+//
+// THE SOFTWARE IS PROVIDED "AS IS" AND THE AUTHOR DISCLAIMS ALL WARRANTIES WITH
+// REGARD TO THIS SOFTWARE INCLUDING ALL IMPLIED WARRANTIES OF MERCHANTABILITY
+// AND FITNESS. IN NO EVENT SHALL THE AUTHOR BE LIABLE FOR ANY SPECIAL, DIRECT,
+// INDIRECT, OR CONSEQUENTIAL DAMAGES OR ANY DAMAGES WHATSOEVER RESULTING FROM
+// LOSS OF USE, DATA OR PROFITS, WHETHER IN AN ACTION OF CONTRACT, NEGLIGENCE OR
+// OTHER TORTIOUS ACTION, ARISING OUT OF OR IN CONNECTION WITH THE USE OR
+// PERFORMANCE OF THIS SOFTWARE.
+
+package coredata
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"maps"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"go.gearno.de/kit/pg"
+	"go.probo.inc/probo/pkg/gid"
+	"go.probo.inc/probo/pkg/page"
+)
+
+type (
+	OIDCConfiguration struct {
+		ID                      gid.GID               `db:"id"`
+		OrganizationID          gid.GID               `db:"organization_id"`
+		EmailDomain             string                `db:"email_domain"`
+		EnforcementPolicy       SAMLEnforcementPolicy `db:"enforcement_policy"`
+		IssuerURL               string                `db:"issuer_url"`
+		ClientID                string                `db:"client_id"`
+		EncryptedClientSecret   []byte                `db:"encrypted_client_secret"`
+		AutoSignupEnabled       bool                  `db:"auto_signup_enabled"`
+		DomainVerificationToken *string               `db:"domain_verification_token"`
+		DomainVerifiedAt        *time.Time            `db:"domain_verified_at"`
+		CreatedAt               time.Time             `db:"created_at"`
+		UpdatedAt               time.Time             `db:"updated_at"`
+	}
+
+	OIDCConfigurations []*OIDCConfiguration
+)
+
+func (c *OIDCConfiguration) CursorKey(orderBy OIDCConfigurationOrderField) page.CursorKey {
+	switch orderBy {
+	case OIDCConfigurationOrderFieldCreatedAt:
+		return page.NewCursorKey(c.ID, c.CreatedAt)
+	}
+
+	panic(fmt.Sprintf("unsupported order by: %s", orderBy))
+}
+
+func (c *OIDCConfiguration) AuthorizationAttributes(ctx context.Context, conn pg.Conn) (map[string]string, error) {
+	q := `SELECT organization_id FROM iam_oidc_configurations WHERE id = $1 LIMIT 1;`
+
+	var organizationID gid.GID
+	if err := conn.QueryRow(ctx, q, c.ID).Scan(&organizationID); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrResourceNotFound
+		}
+		return nil, fmt.Errorf("cannot query oidc configuration authorization attributes: %w", err)
+	}
+
+	return map[string]string{"organization_id": organizationID.String()}, nil
+}
+
+func (c *OIDCConfiguration) LoadByOrganizationIDAndEmailDomain(
+	ctx context.Context,
+	conn pg.Conn,
+	scope Scoper,
+	organizationID gid.GID,
+	emailDomain string,
+) error {
+	q := `
+SELECT
+    id,
+    organization_id,
+    email_domain,
+    enforcement_policy,
+    issuer_url,
+    client_id,
+    encrypted_client_secret,
+    auto_signup_enabled,
+    domain_verification_token,
+    domain_verified_at,
+    created_at,
+    updated_at
+FROM
+    iam_oidc_configurations
+WHERE
+    %s
+    AND organization_id = @organization_id
+    AND email_domain = @email_domain
+LIMIT 1;
+`
+
+	q = fmt.Sprintf(q, scope.SQLFragment())
+
+	args := pgx.StrictNamedArgs{
+		"organization_id": organizationID,
+		"email_domain":    emailDomain,
+	}
+	maps.Copy(args, scope.SQLArguments())
+
+	rows, err := conn.Query(ctx, q, args)
+	if err != nil {
+		return fmt.Errorf("cannot query iam_oidc_configurations: %w", err)
+	}
+
+	config, err := pgx.CollectExactlyOneRow(rows, pgx.RowToStructByName[OIDCConfiguration])
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return ErrResourceNotFound
+		}
+
+		return fmt.Errorf("cannot collect oidc_configuration: %w", err)
+	}
+
+	*c = config
+
+	return nil
+}
+
+func (c *OIDCConfiguration) LoadByID(
+	ctx context.Context,
+	conn pg.Conn,
+	scope Scoper,
+	configID gid.GID,
+) error {
+	q := `
+SELECT
+    id,
+    organization_id,
+    email_domain,
+    enforcement_policy,
+    issuer_url,
+    client_id,
+    encrypted_client_secret,
+    auto_signup_enabled,
+    domain_verification_token,
+    domain_verified_at,
+    created_at,
+    updated_at
+FROM
+    iam_oidc_configurations
+WHERE
+    %s
+    AND id = @id
+LIMIT 1;
+`
+
+	q = fmt.Sprintf(q, scope.SQLFragment())
+
+	args := pgx.StrictNamedArgs{"id": configID}
+	maps.Copy(args, scope.SQLArguments())
+
+	rows, err := conn.Query(ctx, q, args)
+	if err != nil {
+		return fmt.Errorf("cannot query iam_oidc_configurations: %w", err)
+	}
+
+	config, err := pgx.CollectExactlyOneRow(rows, pgx.RowToStructByName[OIDCConfiguration])
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return ErrResourceNotFound
+		}
+
+		return fmt.Errorf("cannot collect oidc_configuration: %w", err)
+	}
+
+	*c = config
+
+	return nil
+}
+
+func (c *OIDCConfiguration) LoadByIDForUpdateSkipLocked(
+	ctx context.Context,
+	conn pg.Conn,
+	configID gid.GID,
+) error {
+	q := `
+SELECT
+    id,
+    organization_id,
+    email_domain,
+    enforcement_policy,
+    issuer_url,
+    client_id,
+    encrypted_client_secret,
+    auto_signup_enabled,
+    domain_verification_token,
+    domain_verified_at,
+    created_at,
+    updated_at
+FROM
+    iam_oidc_configurations
+WHERE
+    id = @id
+FOR UPDATE SKIP LOCKED;
+`
+
+	args := pgx.StrictNamedArgs{"id": configID}
+
+	rows, err := conn.Query(ctx, q, args)
+	if err != nil {
+		return fmt.Errorf("cannot query iam_oidc_configurations: %w", err)
+	}
+
+	config, err := pgx.CollectExactlyOneRow(rows, pgx.RowToStructByName[OIDCConfiguration])
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return ErrResourceNotFound
+		}
+
+		return fmt.Errorf("cannot collect oidc_configuration: %w", err)
+	}
+
+	*c = config
+
+	return nil
+}
+
+func (c *OIDCConfiguration) Insert(
+	ctx context.Context,
+	conn pg.Conn,
+	scope Scoper,
+) error {
+	q := `
+INSERT INTO iam_oidc_configurations (
+    id,
+    tenant_id,
+    organization_id,
+    email_domain,
+    enforcement_policy,
+    issuer_url,
+    client_id,
+    encrypted_client_secret,
+    auto_signup_enabled,
+    domain_verification_token,
+    domain_verified_at,
+    created_at,
+    updated_at
+) VALUES (
+    @id,
+    @tenant_id,
+    @organization_id,
+    @email_domain,
+    @enforcement_policy,
+    @issuer_url,
+    @client_id,
+    @encrypted_client_secret,
+    @auto_signup_enabled,
+    @domain_verification_token,
+    @domain_verified_at,
+    @created_at,
+    @updated_at
+)
+`
+
+	args := pgx.StrictNamedArgs{
+		"id":                        c.ID,
+		"tenant_id":                 scope.GetTenantID(),
+		"organization_id":           c.OrganizationID,
+		"email_domain":              c.EmailDomain,
+		"enforcement_policy":        c.EnforcementPolicy,
+		"issuer_url":                c.IssuerURL,
+		"client_id":                 c.ClientID,
+		"encrypted_client_secret":   c.EncryptedClientSecret,
+		"auto_signup_enabled":       c.AutoSignupEnabled,
+		"domain_verification_token": c.DomainVerificationToken,
+		"domain_verified_at":        c.DomainVerifiedAt,
+		"created_at":                c.CreatedAt,
+		"updated_at":                c.UpdatedAt,
+	}
+
+	_, err := conn.Exec(ctx, q, args)
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) {
+			if pgErr.Code == "23505" && pgErr.ConstraintName == "iam_oidc_configurations_organization_id_email_domain_unique" {
+				return ErrResourceAlreadyExists
+			}
+		}
+
+		return fmt.Errorf("cannot insert oidc_configuration: %w", err)
+	}
+
+	return nil
+}
+
+func (c *OIDCConfiguration) Update(
+	ctx context.Context,
+	conn pg.Conn,
+	scope Scoper,
+) error {
+	q := `
+UPDATE iam_oidc_configurations
+SET
+    enforcement_policy = @enforcement_policy,
+    issuer_url = @issuer_url,
+    client_id = @client_id,
+    encrypted_client_secret = @encrypted_client_secret,
+    auto_signup_enabled = @auto_signup_enabled,
+    domain_verification_token = @domain_verification_token,
+    domain_verified_at = @domain_verified_at,
+    updated_at = @updated_at
+WHERE
+    %s
+    AND id = @id
+`
+
+	q = fmt.Sprintf(q, scope.SQLFragment())
+
+	args := pgx.StrictNamedArgs{
+		"id":                        c.ID,
+		"enforcement_policy":        c.EnforcementPolicy,
+		"issuer_url":                c.IssuerURL,
+		"client_id":                 c.ClientID,
+		"encrypted_client_secret":   c.EncryptedClientSecret,
+		"auto_signup_enabled":       c.AutoSignupEnabled,
+		"domain_verification_token": c.DomainVerificationToken,
+		"domain_verified_at":        c.DomainVerifiedAt,
+		"updated_at":                c.UpdatedAt,
+	}
+
+	maps.Copy(args, scope.SQLArguments())
+
+	_, err := conn.Exec(ctx, q, args)
+	if err != nil {
+		return fmt.Errorf("cannot update oidc_configuration: %w", err)
+	}
+
+	return nil
+}
+
+func (c *OIDCConfiguration) Delete(
+	ctx context.Context,
+	conn pg.Conn,
+	scope Scoper,
+) error {
+	q := `
+DELETE FROM iam_oidc_configurations
+WHERE
+    %s
+    AND id = @id
+`
+
+	q = fmt.Sprintf(q, scope.SQLFragment())
+
+	args := pgx.StrictNamedArgs{"id": c.ID}
+	maps.Copy(args, scope.SQLArguments())
+
+	_, err := conn.Exec(ctx, q, args)
+	if err != nil {
+		return fmt.Errorf("cannot delete oidc_configuration: %w", err)
+	}
+
+	return nil
+}
+
+func (c *OIDCConfigurations) LoadByOrganizationID(
+	ctx context.Context,
+	conn pg.Conn,
+	scope Scoper,
+	organizationID gid.GID,
+) error {
+	q := `
+SELECT
+    id,
+    organization_id,
+    email_domain,
+    enforcement_policy,
+    issuer_url,
+    client_id,
+    encrypted_client_secret,
+    auto_signup_enabled,
+    domain_verification_token,
+    domain_verified_at,
+    created_at,
+    updated_at
+FROM
+    iam_oidc_configurations
+WHERE
+    %s
+    AND organization_id = @organization_id
+ORDER BY email_domain ASC;
+`
+
+	q = fmt.Sprintf(q, scope.SQLFragment())
+
+	args := pgx.StrictNamedArgs{"organization_id": organizationID}
+	maps.Copy(args, scope.SQLArguments())
+
+	rows, err := conn.Query(ctx, q, args)
+	if err != nil {
+		return fmt.Errorf("cannot query iam_oidc_configurations: %w", err)
+	}
+
+	configs, err := pgx.CollectRows(rows, pgx.RowToAddrOfStructByName[OIDCConfiguration])
+	if err != nil {
+		return fmt.Errorf("cannot collect oidc_configurations: %w", err)
+	}
+
+	*c = configs
+
+	return nil
+}
+
+func (c *OIDCConfigurations) CountByOrganizationID(
+	ctx context.Context,
+	conn pg.Conn,
+	scope Scoper,
+	organizationID gid.GID,
+) (int, error) {
+	q := `
+SELECT
+    COUNT(*)
+FROM
+    iam_oidc_configurations
+WHERE
+    %s
+    AND organization_id = @organization_id
+`
+
+	q = fmt.Sprintf(q, scope.SQLFragment())
+
+	args := pgx.StrictNamedArgs{"organization_id": organizationID}
+	maps.Copy(args, scope.SQLArguments())
+
+	var count int
+	if err := conn.QueryRow(ctx, q, args).Scan(&count); err != nil {
+		return 0, fmt.Errorf("cannot count oidc configurations: %w", err)
+	}
+
+	return count, nil
+}
+
+func (c *OIDCConfigurations) LoadVerifiedByEmailDomain(ctx context.Context, conn pg.Conn, emailDomain string) error {
+	q := `
+SELECT
+    id,
+    organization_id,
+    email_domain,
+    enforcement_policy,
+    issuer_url,
+    client_id,
+    encrypted_client_secret,
+    auto_signup_enabled,
+    domain_verification_token,
+    domain_verified_at,
+    created_at,
+    updated_at
+FROM
+    iam_oidc_configurations
+WHERE
+    email_domain = @email_domain
+    AND domain_verified_at IS NOT NULL
+ORDER BY email_domain ASC;
+`
+
+	rows, err := conn.Query(ctx, q, pgx.StrictNamedArgs{"email_domain": emailDomain})
+	if err != nil {
+		return fmt.Errorf("cannot query iam_oidc_configurations: %w", err)
+	}
+
+	configs, err := pgx.CollectRows(rows, pgx.RowToAddrOfStructByName[OIDCConfiguration])
+	if err != nil {
+		return fmt.Errorf("cannot collect oidc_configurations: %w", err)
+	}
+
+	*c = configs
+
+	return nil
+}
+
+func (c *OIDCConfigurations) CountVerifiedByEmailDomain(
+	ctx context.Context,
+	conn pg.Conn,
+	emailDomain string,
+) (int, error) {
+	q := `
+SELECT
+    COUNT(*)
+FROM
+    iam_oidc_configurations
+WHERE
+    email_domain = @email_domain
+    AND domain_verified_at IS NOT NULL
+`
+
+	row := conn.QueryRow(ctx, q, pgx.StrictNamedArgs{"email_domain": emailDomain})
+	var count int
+	if err := row.Scan(&count); err != nil {
+		return 0, fmt.Errorf("cannot count oidc configurations: %w", err)
+	}
+
+	return count, nil
+}
+
+func (c *OIDCConfigurations) LoadUnverified(
+	ctx context.Context,
+	conn pg.Conn,
+) error {
+	q := `
+SELECT
+    id,
+    organization_id,
+    email_domain,
+    enforcement_policy,
+    issuer_url,
+    client_id,
+    encrypted_client_secret,
+    auto_signup_enabled,
+    domain_verification_token,
+    domain_verified_at,
+    created_at,
+    updated_at
+FROM
+    iam_oidc_configurations
+WHERE
+    domain_verified_at IS NULL
+    AND domain_verification_token IS NOT NULL
+ORDER BY created_at ASC
+LIMIT 100;
+`
+
+	rows, err := conn.Query(ctx, q)
+	if err != nil {
+		return fmt.Errorf("cannot query unverified iam_oidc_configurations: %w", err)
+	}
+
+	configs, err := pgx.CollectRows(rows, pgx.RowToAddrOfStructByName[OIDCConfiguration])
+	if err != nil {
+		return fmt.Errorf("cannot collect unverified oidc_configurations: %w", err)
+	}
+
+	*c = configs
+
+	return nil
+}
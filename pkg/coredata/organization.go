@@ -39,6 +39,7 @@ type (
 		Email                *string      `db:"email"`
 		HeadquarterAddress   *string      `db:"headquarter_address"`
 		CustomDomainID       *gid.GID     `db:"custom_domain_id"`
+		MFARequired          bool         `db:"mfa_required"`
 		CreatedAt            time.Time    `db:"created_at"`
 		UpdatedAt            time.Time    `db:"updated_at"`
 	}
@@ -91,6 +92,7 @@ SELECT
     email,
     headquarter_address,
     custom_domain_id,
+    mfa_required,
     created_at,
     updated_at
 FROM
@@ -152,6 +154,7 @@ SELECT
     email,
     headquarter_address,
     custom_domain_id,
+    mfa_required,
     created_at,
     updated_at
 FROM
@@ -206,6 +209,7 @@ SELECT
     email,
     headquarter_address,
     custom_domain_id,
+    mfa_required,
     logo_file_id,
     horizontal_logo_file_id,
     created_at,
@@ -260,6 +264,7 @@ SELECT
     email,
     headquarter_address,
     custom_domain_id,
+    mfa_required,
     logo_file_id,
     horizontal_logo_file_id,
     created_at,
@@ -317,6 +322,7 @@ SELECT
     email,
     headquarter_address,
     custom_domain_id,
+    mfa_required,
     logo_file_id,
     horizontal_logo_file_id,
     created_at,
@@ -362,9 +368,10 @@ INSERT INTO organizations (
     email,
     headquarter_address,
     custom_domain_id,
+    mfa_required,
     created_at,
     updated_at
-) VALUES (@tenant_id, @id, @name, @logo_file_id, @horizontal_logo_file_id, @description, @website_url, @email, @headquarter_address, @custom_domain_id, @created_at, @updated_at)
+) VALUES (@tenant_id, @id, @name, @logo_file_id, @horizontal_logo_file_id, @description, @website_url, @email, @headquarter_address, @custom_domain_id, @mfa_required, @created_at, @updated_at)
 `
 
 	args := pgx.StrictNamedArgs{
@@ -378,6 +385,7 @@ INSERT INTO organizations (
 		"email":                   o.Email,
 		"headquarter_address":     o.HeadquarterAddress,
 		"custom_domain_id":        o.CustomDomainID,
+		"mfa_required":            o.MFARequired,
 		"created_at":              o.CreatedAt,
 		"updated_at":              o.UpdatedAt,
 	}
@@ -406,6 +414,7 @@ SET
     email = @email,
     headquarter_address = @headquarter_address,
     custom_domain_id = @custom_domain_id,
+    mfa_required = @mfa_required,
     updated_at = @updated_at
 WHERE
     %s
@@ -424,6 +433,7 @@ WHERE
 		"email":                   o.Email,
 		"headquarter_address":     o.HeadquarterAddress,
 		"custom_domain_id":        o.CustomDomainID,
+		"mfa_required":            o.MFARequired,
 		"updated_at":              o.UpdatedAt,
 	}
 
@@ -475,6 +485,7 @@ SELECT
     email,
     headquarter_address,
     custom_domain_id,
+    mfa_required,
     created_at,
     updated_at
 FROM
@@ -527,6 +538,7 @@ SELECT
     email,
     headquarter_address,
     custom_domain_id,
+    mfa_required,
     created_at,
     updated_at
 FROM
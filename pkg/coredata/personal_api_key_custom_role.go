@@ -0,0 +1,110 @@
+// Copyright (c) 2025 Probo Inc <hello@getprobo.com>.
+//
+// Permission to use, copy, modify, and/or distribute this software for any
+// purpose with or without fee is hereby granted, provided that the above
+// copyright notice and this permission notice appear in all copies.
+//
+// THE SOFTWARE IS PROVIDED "AS IS" AND THE AUTHOR DISCLAIMS ALL WARRANTIES WITH
+// REGARD TO THIS SOFTWARE INCLUDING ALL IMPLIED WARRANTIES OF MERCHANTABILITY
+// AND FITNESS. IN NO EVENT SHALL THE AUTHOR BE LIABLE FOR ANY SPECIAL, DIRECT,
+// INDIRECT, OR CONSEQUENTIAL DAMAGES OR ANY DAMAGES WHATSOEVER RESULTING FROM
+// LOSS OF USE, DATA OR PROFITS, WHETHER IN AN ACTION OF CONTRACT, NEGLIGENCE OR
+// OTHER TORTIOUS ACTION, ARISING OUT OF OR IN CONNECTION WITH THE USE OR
+// PERFORMANCE OF THIS SOFTWARE.
+
+package coredata
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"go.gearno.de/kit/pg"
+	"go.probo.inc/probo/pkg/gid"
+)
+
+type (
+	// PersonalAPIKeyCustomRole restricts a personal API key to the
+	// permissions of a custom role within the custom role organization.
+	PersonalAPIKeyCustomRole struct {
+		PersonalAPIKeyID gid.GID   `db:"personal_api_key_id"`
+		OrganizationID   gid.GID   `db:"organization_id"`
+		CustomRoleID     gid.GID   `db:"custom_role_id"`
+		CreatedAt        time.Time `db:"created_at"`
+	}
+)
+
+// Upsert assigns the custom role to the personal API key, replacing any
+// custom role previously assigned in the same organization.
+func (k *PersonalAPIKeyCustomRole) Upsert(
+	ctx context.Context,
+	conn pg.Conn,
+	scope Scoper,
+) error {
+	q := `
+INSERT INTO iam_personal_api_key_custom_roles (
+    personal_api_key_id,
+    tenant_id,
+    organization_id,
+    custom_role_id,
+    created_at
+) VALUES (
+    @personal_api_key_id,
+    @tenant_id,
+    @organization_id,
+    @custom_role_id,
+    @created_at
+)
+ON CONFLICT (personal_api_key_id, organization_id) DO UPDATE SET
+    custom_role_id = EXCLUDED.custom_role_id,
+    created_at = EXCLUDED.created_at
+`
+
+	args := pgx.StrictNamedArgs{
+		"personal_api_key_id": k.PersonalAPIKeyID,
+		"tenant_id":           scope.GetTenantID(),
+		"organization_id":     k.OrganizationID,
+		"custom_role_id":      k.CustomRoleID,
+		"created_at":          k.CreatedAt,
+	}
+
+	if _, err := conn.Exec(ctx, q, args); err != nil {
+		return fmt.Errorf("cannot upsert personal api key custom role: %w", err)
+	}
+
+	return nil
+}
+
+// DeleteByPersonalAPIKeyIDAndOrganizationID lifts the custom role
+// restriction of the personal API key in the organization, if any.
+func (k *PersonalAPIKeyCustomRole) DeleteByPersonalAPIKeyIDAndOrganizationID(
+	ctx context.Context,
+	conn pg.Conn,
+	scope Scoper,
+	personalAPIKeyID gid.GID,
+	organizationID gid.GID,
+) error {
+	q := `
+DELETE FROM iam_personal_api_key_custom_roles
+WHERE
+    %s
+    AND personal_api_key_id = @personal_api_key_id
+    AND organization_id = @organization_id
+`
+
+	q = fmt.Sprintf(q, scope.SQLFragment())
+
+	args := pgx.StrictNamedArgs{
+		"personal_api_key_id": personalAPIKeyID,
+		"organization_id":     organizationID,
+	}
+	maps.Copy(args, scope.SQLArguments())
+
+	if _, err := conn.Exec(ctx, q, args); err != nil {
+		return fmt.Errorf("cannot delete personal api key custom role: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,200 @@
+// Copyright (c) 2025 Probo Inc <hello@getprobo.com>.
+//
+// Permission to use, copy, modify, and/or distribute this software for any
+// purpose with or without fee is hereby granted, provided that the above
+// copyright notice and this permission notice appear in all copies.
+//
+// THE SOFTWARE IS PROVIDED "AS IS" AND THE AUTHOR DISCLAIMS ALL WARRANTIES WITH
+// REGARD TO THIS SOFTWARE INCLUDING ALL IMPLIED WARRANTIES OF MERCHANTABILITY
+// AND FITNESS. IN NO EVENT SHALL THE AUTHOR BE LIABLE FOR ANY SPECIAL, DIRECT,
+// INDIRECT, OR CONSEQUENTIAL DAMAGES OR ANY DAMAGES WHATSOEVER RESULTING FROM
+// LOSS OF USE, DATA OR PROFITS, WHETHER IN AN ACTION OF CONTRACT, NEGLIGENCE OR
+// OTHER TORTIOUS ACTION, ARISING OUT OF OR IN CONNECTION WITH THE USE OR
+// PERFORMANCE OF THIS SOFTWARE.
+
+package coredata
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"go.gearno.de/kit/pg"
+	"go.probo.inc/probo/pkg/gid"
+)
+
+type (
+	// RecoveryCode is a single-use code an identity can present instead of
+	// its second factor. Only the hash of the code is stored.
+	RecoveryCode struct {
+		ID         gid.GID    `db:"id"`
+		IdentityID gid.GID    `db:"identity_id"`
+		HashedCode []byte     `db:"hashed_code"`
+		UsedAt     *time.Time `db:"used_at"`
+		CreatedAt  time.Time  `db:"created_at"`
+	}
+
+	RecoveryCodes []*RecoveryCode
+)
+
+func (c *RecoveryCode) LoadUnusedByIdentityIDAndHashedCodeForUpdate(
+	ctx context.Context,
+	conn pg.Conn,
+	identityID gid.GID,
+	hashedCode []byte,
+) error {
+	q := `
+SELECT
+    id,
+    identity_id,
+    hashed_code,
+    used_at,
+    created_at
+FROM
+    iam_identity_recovery_codes
+WHERE
+    identity_id = @identity_id
+    AND hashed_code = @hashed_code
+    AND used_at IS NULL
+LIMIT 1
+FOR UPDATE;
+`
+
+	args := pgx.StrictNamedArgs{
+		"identity_id": identityID,
+		"hashed_code": hashedCode,
+	}
+
+	rows, err := conn.Query(ctx, q, args)
+	if err != nil {
+		return fmt.Errorf("cannot query iam_identity_recovery_codes: %w", err)
+	}
+
+	code, err := pgx.CollectExactlyOneRow(rows, pgx.RowToStructByName[RecoveryCode])
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return ErrResourceNotFound
+		}
+
+		return fmt.Errorf("cannot collect iam_identity_recovery_codes: %w", err)
+	}
+
+	*c = code
+
+	return nil
+}
+
+func (c *RecoveryCode) Insert(
+	ctx context.Context,
+	conn pg.Conn,
+) error {
+	q := `
+INSERT INTO iam_identity_recovery_codes (
+    id,
+    identity_id,
+    hashed_code,
+    used_at,
+    created_at
+) VALUES (
+    @id,
+    @identity_id,
+    @hashed_code,
+    @used_at,
+    @created_at
+)
+`
+
+	args := pgx.StrictNamedArgs{
+		"id":          c.ID,
+		"identity_id": c.IdentityID,
+		"hashed_code": c.HashedCode,
+		"used_at":     c.UsedAt,
+		"created_at":  c.CreatedAt,
+	}
+
+	_, err := conn.Exec(ctx, q, args)
+	if err != nil {
+		return fmt.Errorf("cannot insert iam_identity_recovery_codes: %w", err)
+	}
+
+	return nil
+}
+
+func (c *RecoveryCode) Update(
+	ctx context.Context,
+	conn pg.Conn,
+) error {
+	q := `
+UPDATE iam_identity_recovery_codes
+SET
+    used_at = @used_at
+WHERE
+    id = @id
+`
+
+	args := pgx.StrictNamedArgs{
+		"id":      c.ID,
+		"used_at": c.UsedAt,
+	}
+
+	result, err := conn.Exec(ctx, q, args)
+	if err != nil {
+		return fmt.Errorf("cannot update iam_identity_recovery_codes: %w", err)
+	}
+
+	if result.RowsAffected() == 0 {
+		return ErrResourceNotFound
+	}
+
+	return nil
+}
+
+func (c *RecoveryCodes) CountUnusedByIdentityID(
+	ctx context.Context,
+	conn pg.Conn,
+	identityID gid.GID,
+) (int, error) {
+	q := `
+SELECT
+    COUNT(*)
+FROM
+    iam_identity_recovery_codes
+WHERE
+    identity_id = @identity_id
+    AND used_at IS NULL
+`
+
+	args := pgx.StrictNamedArgs{"identity_id": identityID}
+
+	row := conn.QueryRow(ctx, q, args)
+
+	var count int
+	if err := row.Scan(&count); err != nil {
+		return 0, fmt.Errorf("cannot scan count: %w", err)
+	}
+
+	return count, nil
+}
+
+func (c *RecoveryCodes) DeleteByIdentityID(
+	ctx context.Context,
+	conn pg.Conn,
+	identityID gid.GID,
+) error {
+	q := `
+DELETE FROM iam_identity_recovery_codes
+WHERE
+    identity_id = @identity_id
+`
+
+	args := pgx.StrictNamedArgs{"identity_id": identityID}
+
+	_, err := conn.Exec(ctx, q, args)
+	if err != nil {
+		return fmt.Errorf("cannot delete iam_identity_recovery_codes: %w", err)
+	}
+
+	return nil
+}
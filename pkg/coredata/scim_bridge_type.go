@@ -22,7 +22,9 @@ import (
 type SCIMBridgeType string
 
 const (
-	SCIMBridgeTypeGoogleWorkspace SCIMBridgeType = "GOOGLE_WORKSPACE"
+	SCIMBridgeTypeGoogleWorkspace  SCIMBridgeType = "GOOGLE_WORKSPACE"
+	SCIMBridgeTypeMicrosoftEntraID SCIMBridgeType = "MICROSOFT_ENTRA_ID"
+	SCIMBridgeTypeOkta             SCIMBridgeType = "OKTA"
 )
 
 func (t SCIMBridgeType) String() string {
@@ -43,6 +45,10 @@ func (t *SCIMBridgeType) Scan(value any) error {
 	switch str {
 	case "GOOGLE_WORKSPACE":
 		*t = SCIMBridgeTypeGoogleWorkspace
+	case "MICROSOFT_ENTRA_ID":
+		*t = SCIMBridgeTypeMicrosoftEntraID
+	case "OKTA":
+		*t = SCIMBridgeTypeOkta
 	default:
 		return fmt.Errorf("invalid SCIMBridgeType value: %q", str)
 	}
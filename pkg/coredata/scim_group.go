@@ -0,0 +1,312 @@
+// Copyright (c) 2025 Probo Inc <hello@getprobo.com>.
+//
+// Permission to use, copy, modify, and/or distribute this software for any
+// purpose with or without fee is hereby granted, provided that the above
+// copyright notice and this permission notice appear in all copies.
+//
+// THE SOFTWARE IS PROVIDED "AS IS" AND THE AUTHOR DISCLAIMS ALL WARRANTIES WITH
+// REGARD TO THIS SOFTWARE INCLUDING ALL IMPLIED WARRANTIES OF MERCHANTABILITY
+// AND FITNESS. IN NO EVENT SHALL THE AUTHOR BE LIABLE FOR ANY SPECIAL, DIRECT,
+// INDIRECT, OR CONSEQUENTIAL DAMAGES OR ANY DAMAGES WHATSOEVER RESULTING FROM
+// LOSS OF USE, DATA OR PROFITS, WHETHER IN AN ACTION OF CONTRACT, NEGLIGENCE OR
+// OTHER TORTIOUS ACTION, ARISING OUT OF OR IN CONNECTION WITH THE USE OR
+// PERFORMANCE OF THIS SOFTWARE.
+
+package coredata
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"maps"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"go.gearno.de/kit/pg"
+	"go.probo.inc/probo/pkg/gid"
+	"go.probo.inc/probo/pkg/page"
+)
+
+type (
+	SCIMGroup struct {
+		ID                  gid.GID   `db:"id"`
+		OrganizationID      gid.GID   `db:"organization_id"`
+		SCIMConfigurationID gid.GID   `db:"scim_configuration_id"`
+		DisplayName         string    `db:"display_name"`
+		ExternalID          *string   `db:"external_id"`
+		CreatedAt           time.Time `db:"created_at"`
+		UpdatedAt           time.Time `db:"updated_at"`
+	}
+
+	SCIMGroups []*SCIMGroup
+)
+
+func (g *SCIMGroup) CursorKey(orderBy SCIMGroupOrderField) page.CursorKey {
+	switch orderBy {
+	case SCIMGroupOrderFieldCreatedAt:
+		return page.NewCursorKey(g.ID, g.CreatedAt)
+	}
+
+	panic(fmt.Sprintf("unsupported order by: %s", orderBy))
+}
+
+func (g *SCIMGroup) AuthorizationAttributes(ctx context.Context, conn pg.Conn) (map[string]string, error) {
+	q := `SELECT organization_id FROM iam_scim_groups WHERE id = $1 LIMIT 1;`
+
+	var organizationID gid.GID
+	if err := conn.QueryRow(ctx, q, g.ID).Scan(&organizationID); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrResourceNotFound
+		}
+		return nil, fmt.Errorf("cannot query scim group authorization attributes: %w", err)
+	}
+
+	return map[string]string{"organization_id": organizationID.String()}, nil
+}
+
+func (g *SCIMGroup) LoadByID(
+	ctx context.Context,
+	conn pg.Conn,
+	scope Scoper,
+	groupID gid.GID,
+) error {
+	q := `
+SELECT
+    id,
+    organization_id,
+    scim_configuration_id,
+    display_name,
+    external_id,
+    created_at,
+    updated_at
+FROM
+    iam_scim_groups
+WHERE
+    %s
+    AND id = @id
+LIMIT 1;
+`
+
+	q = fmt.Sprintf(q, scope.SQLFragment())
+
+	args := pgx.StrictNamedArgs{"id": groupID}
+	maps.Copy(args, scope.SQLArguments())
+
+	rows, err := conn.Query(ctx, q, args)
+	if err != nil {
+		return fmt.Errorf("cannot query iam_scim_groups: %w", err)
+	}
+
+	group, err := pgx.CollectExactlyOneRow(rows, pgx.RowToStructByName[SCIMGroup])
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return ErrResourceNotFound
+		}
+
+		return fmt.Errorf("cannot collect scim_group: %w", err)
+	}
+
+	*g = group
+
+	return nil
+}
+
+func (g *SCIMGroup) Insert(
+	ctx context.Context,
+	conn pg.Conn,
+	scope Scoper,
+) error {
+	q := `
+INSERT INTO iam_scim_groups (
+    id,
+    tenant_id,
+    organization_id,
+    scim_configuration_id,
+    display_name,
+    external_id,
+    created_at,
+    updated_at
+) VALUES (
+    @id,
+    @tenant_id,
+    @organization_id,
+    @scim_configuration_id,
+    @display_name,
+    @external_id,
+    @created_at,
+    @updated_at
+)
+`
+
+	args := pgx.StrictNamedArgs{
+		"id":                    g.ID,
+		"tenant_id":             scope.GetTenantID(),
+		"organization_id":       g.OrganizationID,
+		"scim_configuration_id": g.SCIMConfigurationID,
+		"display_name":          g.DisplayName,
+		"external_id":           g.ExternalID,
+		"created_at":            g.CreatedAt,
+		"updated_at":            g.UpdatedAt,
+	}
+
+	_, err := conn.Exec(ctx, q, args)
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) {
+			if pgErr.Code == "23505" && pgErr.ConstraintName == "iam_scim_groups_scim_configuration_id_display_name_unique" {
+				return ErrResourceAlreadyExists
+			}
+		}
+
+		return fmt.Errorf("cannot insert scim_group: %w", err)
+	}
+
+	return nil
+}
+
+func (g *SCIMGroup) Update(
+	ctx context.Context,
+	conn pg.Conn,
+	scope Scoper,
+) error {
+	q := `
+UPDATE iam_scim_groups
+SET
+    display_name = @display_name,
+    external_id = @external_id,
+    updated_at = @updated_at
+WHERE
+    %s
+    AND id = @id
+`
+
+	q = fmt.Sprintf(q, scope.SQLFragment())
+
+	args := pgx.StrictNamedArgs{
+		"id":           g.ID,
+		"display_name": g.DisplayName,
+		"external_id":  g.ExternalID,
+		"updated_at":   g.UpdatedAt,
+	}
+	maps.Copy(args, scope.SQLArguments())
+
+	_, err := conn.Exec(ctx, q, args)
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) {
+			if pgErr.Code == "23505" && pgErr.ConstraintName == "iam_scim_groups_scim_configuration_id_display_name_unique" {
+				return ErrResourceAlreadyExists
+			}
+		}
+
+		return fmt.Errorf("cannot update scim_group: %w", err)
+	}
+
+	return nil
+}
+
+func (g *SCIMGroup) Delete(
+	ctx context.Context,
+	conn pg.Conn,
+	scope Scoper,
+) error {
+	q := `
+DELETE FROM iam_scim_groups
+WHERE
+    %s
+    AND id = @id
+`
+
+	q = fmt.Sprintf(q, scope.SQLFragment())
+
+	args := pgx.StrictNamedArgs{"id": g.ID}
+	maps.Copy(args, scope.SQLArguments())
+
+	_, err := conn.Exec(ctx, q, args)
+	if err != nil {
+		return fmt.Errorf("cannot delete scim_group: %w", err)
+	}
+
+	return nil
+}
+
+func (g *SCIMGroups) LoadBySCIMConfigurationID(
+	ctx context.Context,
+	conn pg.Conn,
+	scope Scoper,
+	scimConfigurationID gid.GID,
+	cursor *page.Cursor[SCIMGroupOrderField],
+	filter *SCIMGroupFilter,
+) error {
+	q := `
+SELECT
+    id,
+    organization_id,
+    scim_configuration_id,
+    display_name,
+    external_id,
+    created_at,
+    updated_at
+FROM
+    iam_scim_groups
+WHERE
+    %s
+    AND scim_configuration_id = @scim_configuration_id
+    AND %s
+    AND %s
+`
+
+	q = fmt.Sprintf(q, scope.SQLFragment(), filter.SQLFragment(), cursor.SQLFragment())
+
+	args := pgx.StrictNamedArgs{"scim_configuration_id": scimConfigurationID}
+	maps.Copy(args, scope.SQLArguments())
+	maps.Copy(args, filter.SQLArguments())
+	maps.Copy(args, cursor.SQLArguments())
+
+	rows, err := conn.Query(ctx, q, args)
+	if err != nil {
+		return fmt.Errorf("cannot query iam_scim_groups: %w", err)
+	}
+
+	groups, err := pgx.CollectRows(rows, pgx.RowToAddrOfStructByName[SCIMGroup])
+	if err != nil {
+		return fmt.Errorf("cannot collect scim_groups: %w", err)
+	}
+
+	*g = groups
+
+	return nil
+}
+
+func (g *SCIMGroups) CountBySCIMConfigurationID(
+	ctx context.Context,
+	conn pg.Conn,
+	scope Scoper,
+	scimConfigurationID gid.GID,
+	filter *SCIMGroupFilter,
+) (int, error) {
+	q := `
+SELECT
+    COUNT(*)
+FROM
+    iam_scim_groups
+WHERE
+    %s
+    AND scim_configuration_id = @scim_configuration_id
+    AND %s
+`
+
+	q = fmt.Sprintf(q, scope.SQLFragment(), filter.SQLFragment())
+
+	args := pgx.StrictNamedArgs{"scim_configuration_id": scimConfigurationID}
+	maps.Copy(args, scope.SQLArguments())
+	maps.Copy(args, filter.SQLArguments())
+
+	var count int
+	if err := conn.QueryRow(ctx, q, args).Scan(&count); err != nil {
+		return 0, fmt.Errorf("cannot count scim_groups: %w", err)
+	}
+
+	return count, nil
+}
@@ -0,0 +1,53 @@
+// Copyright (c) 2025 Probo Inc <hello@getprobo.com>.
+//
+// Permission to use, copy, modify, and/or distribute this software for any
+// purpose with or without fee is hereby granted, provided that the above
+// copyright notice and this permission notice appear in all copies.
+//
+// THE SOFTWARE IS PROVIDED "AS IS" AND THE AUTHOR DISCLAIMS ALL WARRANTIES WITH
+// REGARD TO THIS SOFTWARE INCLUDING ALL IMPLIED WARRANTIES OF MERCHANTABILITY
+// AND FITNESS. IN NO EVENT SHALL THE AUTHOR BE LIABLE FOR ANY SPECIAL, DIRECT,
+// INDIRECT, OR CONSEQUENTIAL DAMAGES OR ANY DAMAGES WHATSOEVER RESULTING FROM
+// LOSS OF USE, DATA OR PROFITS, WHETHER IN AN ACTION OF CONTRACT, NEGLIGENCE OR
+// OTHER TORTIOUS ACTION, ARISING OUT OF OR IN CONNECTION WITH THE USE OR
+// PERFORMANCE OF THIS SOFTWARE.
+
+package coredata
+
+import (
+	"github.com/jackc/pgx/v5"
+)
+
+type SCIMGroupFilter struct {
+	displayName *string
+}
+
+func NewSCIMGroupFilter() *SCIMGroupFilter {
+	return &SCIMGroupFilter{}
+}
+
+func (f *SCIMGroupFilter) WithDisplayName(displayName string) *SCIMGroupFilter {
+	f.displayName = &displayName
+	return f
+}
+
+func (f *SCIMGroupFilter) DisplayName() *string {
+	return f.displayName
+}
+
+func (f *SCIMGroupFilter) SQLArguments() pgx.StrictNamedArgs {
+	return pgx.StrictNamedArgs{
+		"filter_display_name": f.displayName,
+	}
+}
+
+func (f *SCIMGroupFilter) SQLFragment() string {
+	return `
+(
+	CASE
+		WHEN @filter_display_name::text IS NOT NULL THEN
+			display_name = @filter_display_name::text
+		ELSE TRUE
+	END
+)`
+}
@@ -0,0 +1,246 @@
+// Copyright (c) 2025 Probo Inc <hello@getprobo.com>.
+//
+// Permission to use, copy, modify, and/or distribute this software for any
+// purpose with or without fee is hereby granted, provided that the above
+// copyright notice and this permission notice appear in all copies.
+//
+// THE SOFTWARE IS PROVIDED "AS IS" AND THE AUTHOR DISCLAIMS ALL WARRANTIES WITH
+// REGARD TO THIS SOFTWARE INCLUDING ALL IMPLIED WARRANTIES OF MERCHANTABILITY
+// AND FITNESS. IN NO EVENT SHALL THE AUTHOR BE LIABLE FOR ANY SPECIAL, DIRECT,
+// INDIRECT, OR CONSEQUENTIAL DAMAGES OR ANY DAMAGES WHATSOEVER RESULTING FROM
+// LOSS OF USE, DATA OR PROFITS, WHETHER IN AN ACTION OF CONTRACT, NEGLIGENCE OR
+// OTHER TORTIOUS ACTION, ARISING OUT OF OR IN CONNECTION WITH THE USE OR
+// PERFORMANCE OF THIS SOFTWARE.
+
+package coredata
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"go.gearno.de/kit/pg"
+	"go.probo.inc/probo/pkg/gid"
+)
+
+type (
+	SCIMGroupMember struct {
+		SCIMGroupID  gid.GID   `db:"scim_group_id"`
+		MembershipID gid.GID   `db:"membership_id"`
+		FullName     string    `db:"full_name"`
+		CreatedAt    time.Time `db:"created_at"`
+	}
+
+	SCIMGroupMembers []*SCIMGroupMember
+)
+
+func (m *SCIMGroupMember) Insert(
+	ctx context.Context,
+	conn pg.Conn,
+	scope Scoper,
+) error {
+	q := `
+INSERT INTO iam_scim_group_members (
+    scim_group_id,
+    membership_id,
+    tenant_id,
+    created_at
+) VALUES (
+    @scim_group_id,
+    @membership_id,
+    @tenant_id,
+    @created_at
+)
+ON CONFLICT (scim_group_id, membership_id) DO NOTHING
+`
+
+	args := pgx.StrictNamedArgs{
+		"scim_group_id": m.SCIMGroupID,
+		"membership_id": m.MembershipID,
+		"tenant_id":     scope.GetTenantID(),
+		"created_at":    m.CreatedAt,
+	}
+
+	_, err := conn.Exec(ctx, q, args)
+	if err != nil {
+		return fmt.Errorf("cannot insert scim_group_member: %w", err)
+	}
+
+	return nil
+}
+
+func (m *SCIMGroupMember) Delete(
+	ctx context.Context,
+	conn pg.Conn,
+	scope Scoper,
+) error {
+	q := `
+DELETE FROM iam_scim_group_members
+WHERE
+    %s
+    AND scim_group_id = @scim_group_id
+    AND membership_id = @membership_id
+`
+
+	q = fmt.Sprintf(q, scope.SQLFragment())
+
+	args := pgx.StrictNamedArgs{
+		"scim_group_id": m.SCIMGroupID,
+		"membership_id": m.MembershipID,
+	}
+	maps.Copy(args, scope.SQLArguments())
+
+	_, err := conn.Exec(ctx, q, args)
+	if err != nil {
+		return fmt.Errorf("cannot delete scim_group_member: %w", err)
+	}
+
+	return nil
+}
+
+func (m *SCIMGroupMembers) LoadBySCIMGroupID(
+	ctx context.Context,
+	conn pg.Conn,
+	scope Scoper,
+	groupID gid.GID,
+) error {
+	q := `
+WITH gm AS (
+    SELECT
+        scim_group_id,
+        membership_id,
+        created_at
+    FROM
+        iam_scim_group_members
+    WHERE
+        %s
+        AND scim_group_id = @scim_group_id
+)
+SELECT
+    gm.scim_group_id,
+    gm.membership_id,
+    COALESCE(mp.full_name, i.full_name, '') AS full_name,
+    gm.created_at
+FROM
+    gm
+JOIN
+    iam_memberships mbr ON mbr.id = gm.membership_id
+JOIN
+    identities i ON i.id = mbr.identity_id
+LEFT JOIN
+    iam_membership_profiles mp ON mp.membership_id = gm.membership_id
+ORDER BY
+    gm.created_at ASC, gm.membership_id ASC
+`
+
+	q = fmt.Sprintf(q, scope.SQLFragment())
+
+	args := pgx.StrictNamedArgs{"scim_group_id": groupID}
+	maps.Copy(args, scope.SQLArguments())
+
+	rows, err := conn.Query(ctx, q, args)
+	if err != nil {
+		return fmt.Errorf("cannot query iam_scim_group_members: %w", err)
+	}
+
+	members, err := pgx.CollectRows(rows, pgx.RowToAddrOfStructByName[SCIMGroupMember])
+	if err != nil {
+		return fmt.Errorf("cannot collect scim_group_members: %w", err)
+	}
+
+	*m = members
+
+	return nil
+}
+
+// LoadByGroupDisplayName loads the members of every SCIM group of the
+// organization named displayName.
+func (m *SCIMGroupMembers) LoadByGroupDisplayName(
+	ctx context.Context,
+	conn pg.Conn,
+	scope Scoper,
+	organizationID gid.GID,
+	displayName string,
+) error {
+	q := `
+WITH gm AS (
+    SELECT
+        scim_group_id,
+        membership_id,
+        created_at
+    FROM
+        iam_scim_group_members
+    WHERE
+        %s
+        AND scim_group_id IN (
+            SELECT id
+            FROM iam_scim_groups
+            WHERE organization_id = @organization_id
+                AND display_name = @display_name
+        )
+)
+SELECT
+    gm.scim_group_id,
+    gm.membership_id,
+    COALESCE(mp.full_name, i.full_name, '') AS full_name,
+    gm.created_at
+FROM
+    gm
+JOIN
+    iam_memberships mbr ON mbr.id = gm.membership_id
+JOIN
+    identities i ON i.id = mbr.identity_id
+LEFT JOIN
+    iam_membership_profiles mp ON mp.membership_id = gm.membership_id
+`
+
+	q = fmt.Sprintf(q, scope.SQLFragment())
+
+	args := pgx.StrictNamedArgs{
+		"organization_id": organizationID,
+		"display_name":    displayName,
+	}
+	maps.Copy(args, scope.SQLArguments())
+
+	rows, err := conn.Query(ctx, q, args)
+	if err != nil {
+		return fmt.Errorf("cannot query iam_scim_group_members: %w", err)
+	}
+
+	members, err := pgx.CollectRows(rows, pgx.RowToAddrOfStructByName[SCIMGroupMember])
+	if err != nil {
+		return fmt.Errorf("cannot collect scim_group_members: %w", err)
+	}
+
+	*m = members
+
+	return nil
+}
+
+func (m *SCIMGroupMembers) DeleteBySCIMGroupID(
+	ctx context.Context,
+	conn pg.Conn,
+	scope Scoper,
+	groupID gid.GID,
+) error {
+	q := `
+DELETE FROM iam_scim_group_members
+WHERE
+    %s
+    AND scim_group_id = @scim_group_id
+`
+
+	q = fmt.Sprintf(q, scope.SQLFragment())
+
+	args := pgx.StrictNamedArgs{"scim_group_id": groupID}
+	maps.Copy(args, scope.SQLArguments())
+
+	_, err := conn.Exec(ctx, q, args)
+	if err != nil {
+		return fmt.Errorf("cannot delete scim_group_members: %w", err)
+	}
+
+	return nil
+}
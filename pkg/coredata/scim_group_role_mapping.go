@@ -0,0 +1,334 @@
+// Copyright (c) 2025 Probo Inc <hello@getprobo.com>.
+//
+// Permission to use, copy, modify, and/or distribute this software for any
+// purpose with or without fee is hereby granted, provided that the above
+// copyright notice and this permission notice appear in all copies.
+//
+// THE SOFTWARE IS PROVIDED "AS IS" AND THE AUTHOR DISCLAIMS ALL WARRANTIES WITH
+// REGARD TO THIS SOFTWARE INCLUDING ALL IMPLIED WARRANTIES OF MERCHANTABILITY
+// AND FITNESS. IN NO EVENT SHALL THE AUTHOR BE LIABLE FOR ANY SPECIAL, DIRECT,
+// INDIRECT, OR CONSEQUENTIAL DAMAGES OR ANY DAMAGES WHATSOEVER RESULTING FROM
+// LOSS OF USE, DATA OR PROFITS, WHETHER IN AN ACTION OF CONTRACT, NEGLIGENCE OR
+// OTHER TORTIOUS ACTION, ARISING OUT OF OR IN CONNECTION WITH THE USE OR
+// PERFORMANCE OF THIS SOFTWARE.
+
+package coredata
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"maps"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"go.gearno.de/kit/pg"
+	"go.probo.inc/probo/pkg/gid"
+)
+
+type (
+	SCIMGroupRoleMapping struct {
+		ID               gid.GID        `db:"id"`
+		OrganizationID   gid.GID        `db:"organization_id"`
+		GroupDisplayName string         `db:"group_display_name"`
+		Role             MembershipRole `db:"role"`
+		CreatedAt        time.Time      `db:"created_at"`
+		UpdatedAt        time.Time      `db:"updated_at"`
+	}
+
+	SCIMGroupRoleMappings []*SCIMGroupRoleMapping
+)
+
+func (m *SCIMGroupRoleMapping) AuthorizationAttributes(ctx context.Context, conn pg.Conn) (map[string]string, error) {
+	q := `SELECT organization_id FROM iam_scim_group_role_mappings WHERE id = $1 LIMIT 1;`
+
+	var organizationID gid.GID
+	if err := conn.QueryRow(ctx, q, m.ID).Scan(&organizationID); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrResourceNotFound
+		}
+		return nil, fmt.Errorf("cannot query scim group role mapping authorization attributes: %w", err)
+	}
+
+	return map[string]string{"organization_id": organizationID.String()}, nil
+}
+
+func (m *SCIMGroupRoleMapping) LoadByID(
+	ctx context.Context,
+	conn pg.Conn,
+	scope Scoper,
+	mappingID gid.GID,
+) error {
+	q := `
+SELECT
+    id,
+    organization_id,
+    group_display_name,
+    role,
+    created_at,
+    updated_at
+FROM
+    iam_scim_group_role_mappings
+WHERE
+    %s
+    AND id = @id
+LIMIT 1;
+`
+
+	q = fmt.Sprintf(q, scope.SQLFragment())
+
+	args := pgx.StrictNamedArgs{"id": mappingID}
+	maps.Copy(args, scope.SQLArguments())
+
+	rows, err := conn.Query(ctx, q, args)
+	if err != nil {
+		return fmt.Errorf("cannot query iam_scim_group_role_mappings: %w", err)
+	}
+
+	mapping, err := pgx.CollectExactlyOneRow(rows, pgx.RowToStructByName[SCIMGroupRoleMapping])
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return ErrResourceNotFound
+		}
+
+		return fmt.Errorf("cannot collect scim_group_role_mapping: %w", err)
+	}
+
+	*m = mapping
+
+	return nil
+}
+
+func (m *SCIMGroupRoleMapping) Insert(
+	ctx context.Context,
+	conn pg.Conn,
+	scope Scoper,
+) error {
+	q := `
+INSERT INTO iam_scim_group_role_mappings (
+    id,
+    tenant_id,
+    organization_id,
+    group_display_name,
+    role,
+    created_at,
+    updated_at
+) VALUES (
+    @id,
+    @tenant_id,
+    @organization_id,
+    @group_display_name,
+    @role,
+    @created_at,
+    @updated_at
+)
+`
+
+	args := pgx.StrictNamedArgs{
+		"id":                 m.ID,
+		"tenant_id":          scope.GetTenantID(),
+		"organization_id":    m.OrganizationID,
+		"group_display_name": m.GroupDisplayName,
+		"role":               m.Role,
+		"created_at":         m.CreatedAt,
+		"updated_at":         m.UpdatedAt,
+	}
+
+	_, err := conn.Exec(ctx, q, args)
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) {
+			if pgErr.Code == "23505" && pgErr.ConstraintName == "iam_scim_group_role_mappings_organization_id_group_display_name_unique" {
+				return ErrResourceAlreadyExists
+			}
+		}
+
+		return fmt.Errorf("cannot insert scim_group_role_mapping: %w", err)
+	}
+
+	return nil
+}
+
+func (m *SCIMGroupRoleMapping) Update(
+	ctx context.Context,
+	conn pg.Conn,
+	scope Scoper,
+) error {
+	q := `
+UPDATE iam_scim_group_role_mappings
+SET
+    role = @role,
+    updated_at = @updated_at
+WHERE
+    %s
+    AND id = @id
+`
+
+	q = fmt.Sprintf(q, scope.SQLFragment())
+
+	args := pgx.StrictNamedArgs{
+		"id":         m.ID,
+		"role":       m.Role,
+		"updated_at": m.UpdatedAt,
+	}
+	maps.Copy(args, scope.SQLArguments())
+
+	_, err := conn.Exec(ctx, q, args)
+	if err != nil {
+		return fmt.Errorf("cannot update scim_group_role_mapping: %w", err)
+	}
+
+	return nil
+}
+
+func (m *SCIMGroupRoleMapping) Delete(
+	ctx context.Context,
+	conn pg.Conn,
+	scope Scoper,
+) error {
+	q := `
+DELETE FROM iam_scim_group_role_mappings
+WHERE
+    %s
+    AND id = @id
+`
+
+	q = fmt.Sprintf(q, scope.SQLFragment())
+
+	args := pgx.StrictNamedArgs{"id": m.ID}
+	maps.Copy(args, scope.SQLArguments())
+
+	_, err := conn.Exec(ctx, q, args)
+	if err != nil {
+		return fmt.Errorf("cannot delete scim_group_role_mapping: %w", err)
+	}
+
+	return nil
+}
+
+func (m *SCIMGroupRoleMappings) LoadByOrganizationID(
+	ctx context.Context,
+	conn pg.Conn,
+	scope Scoper,
+	organizationID gid.GID,
+) error {
+	q := `
+SELECT
+    id,
+    organization_id,
+    group_display_name,
+    role,
+    created_at,
+    updated_at
+FROM
+    iam_scim_group_role_mappings
+WHERE
+    %s
+    AND organization_id = @organization_id
+ORDER BY
+    group_display_name ASC
+`
+
+	q = fmt.Sprintf(q, scope.SQLFragment())
+
+	args := pgx.StrictNamedArgs{"organization_id": organizationID}
+	maps.Copy(args, scope.SQLArguments())
+
+	rows, err := conn.Query(ctx, q, args)
+	if err != nil {
+		return fmt.Errorf("cannot query iam_scim_group_role_mappings: %w", err)
+	}
+
+	mappings, err := pgx.CollectRows(rows, pgx.RowToAddrOfStructByName[SCIMGroupRoleMapping])
+	if err != nil {
+		return fmt.Errorf("cannot collect scim_group_role_mappings: %w", err)
+	}
+
+	*m = mappings
+
+	return nil
+}
+
+// LoadByMembershipID loads the mappings matching any SCIM group the
+// membership belongs to.
+func (m *SCIMGroupRoleMappings) LoadByMembershipID(
+	ctx context.Context,
+	conn pg.Conn,
+	scope Scoper,
+	organizationID gid.GID,
+	membershipID gid.GID,
+) error {
+	q := `
+SELECT
+    id,
+    organization_id,
+    group_display_name,
+    role,
+    created_at,
+    updated_at
+FROM
+    iam_scim_group_role_mappings
+WHERE
+    %s
+    AND organization_id = @organization_id
+    AND group_display_name IN (
+        SELECT g.display_name
+        FROM iam_scim_groups g
+        JOIN iam_scim_group_members gm ON gm.scim_group_id = g.id
+        WHERE g.organization_id = @organization_id
+            AND gm.membership_id = @membership_id
+    )
+`
+
+	q = fmt.Sprintf(q, scope.SQLFragment())
+
+	args := pgx.StrictNamedArgs{
+		"organization_id": organizationID,
+		"membership_id":   membershipID,
+	}
+	maps.Copy(args, scope.SQLArguments())
+
+	rows, err := conn.Query(ctx, q, args)
+	if err != nil {
+		return fmt.Errorf("cannot query iam_scim_group_role_mappings: %w", err)
+	}
+
+	mappings, err := pgx.CollectRows(rows, pgx.RowToAddrOfStructByName[SCIMGroupRoleMapping])
+	if err != nil {
+		return fmt.Errorf("cannot collect scim_group_role_mappings: %w", err)
+	}
+
+	*m = mappings
+
+	return nil
+}
+
+func (m *SCIMGroupRoleMappings) CountByOrganizationID(
+	ctx context.Context,
+	conn pg.Conn,
+	scope Scoper,
+	organizationID gid.GID,
+) (int, error) {
+	q := `
+SELECT
+    COUNT(*)
+FROM
+    iam_scim_group_role_mappings
+WHERE
+    %s
+    AND organization_id = @organization_id
+`
+
+	q = fmt.Sprintf(q, scope.SQLFragment())
+
+	args := pgx.StrictNamedArgs{"organization_id": organizationID}
+	maps.Copy(args, scope.SQLArguments())
+
+	var count int
+	if err := conn.QueryRow(ctx, q, args).Scan(&count); err != nil {
+		return 0, fmt.Errorf("cannot count scim_group_role_mappings: %w", err)
+	}
+
+	return count, nil
+}
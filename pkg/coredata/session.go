@@ -38,6 +38,7 @@ type (
 		Data            SessionData   `db:"data"`
 		AuthMethod      AuthMethod    `db:"auth_method"`
 		AuthenticatedAt time.Time     `db:"authenticated_at"`
+		MFAVerifiedAt   *time.Time    `db:"mfa_verified_at"`
 		UserAgent       string        `db:"user_agent"`
 		IPAddress       net.IP        `db:"ip_address"`
 		ExpireReason    *ExpireReason `db:"expire_reason"`
@@ -57,6 +58,7 @@ const (
 	AuthMethodMagicLink AuthMethod = "MAGIC_LINK"
 	AuthMethodPassword  AuthMethod = "PASSWORD"
 	AuthMethodSAML      AuthMethod = "SAML"
+	AuthMethodOIDC      AuthMethod = "OIDC"
 )
 
 func NewRootSession(identityID gid.GID, method AuthMethod, duration time.Duration) *Session {
@@ -108,6 +110,7 @@ SELECT
     parent_session_id,
     auth_method,
     authenticated_at,
+    mfa_verified_at,
     expire_reason,
     user_agent,
     ip_address,
@@ -171,7 +174,7 @@ func (s *Session) Insert(
 ) error {
 	q := `
 INSERT INTO
-    iam_sessions (id, identity_id, tenant_id, membership_id, data, parent_session_id, auth_method, authenticated_at, expire_reason, user_agent, ip_address, expired_at, created_at, updated_at)
+    iam_sessions (id, identity_id, tenant_id, membership_id, data, parent_session_id, auth_method, authenticated_at, mfa_verified_at, expire_reason, user_agent, ip_address, expired_at, created_at, updated_at)
 VALUES (
     @session_id,
     @identity_id,
@@ -181,6 +184,7 @@ VALUES (
     @parent_session_id,
     @auth_method,
     @authenticated_at,
+    @mfa_verified_at,
     @expire_reason,
     @user_agent,
     @ip_address,
@@ -199,6 +203,7 @@ VALUES (
 		"parent_session_id": s.ParentSessionID,
 		"auth_method":       s.AuthMethod,
 		"authenticated_at":  s.AuthenticatedAt,
+		"mfa_verified_at":   s.MFAVerifiedAt,
 		"expire_reason":     s.ExpireReason,
 		"user_agent":        s.UserAgent,
 		"ip_address":        s.IPAddress,
@@ -223,19 +228,21 @@ SET
     user_agent = @user_agent,
     ip_address = @ip_address,
     expire_reason = @expire_reason,
+    mfa_verified_at = @mfa_verified_at,
     data = @data
 WHERE
     id = @session_id
 `
 
 	args := pgx.StrictNamedArgs{
-		"session_id":    s.ID,
-		"user_agent":    s.UserAgent,
-		"ip_address":    s.IPAddress,
-		"expire_reason": s.ExpireReason,
-		"data":          s.Data,
-		"expired_at":    s.ExpiredAt,
-		"updated_at":    s.UpdatedAt,
+		"session_id":      s.ID,
+		"user_agent":      s.UserAgent,
+		"ip_address":      s.IPAddress,
+		"expire_reason":   s.ExpireReason,
+		"mfa_verified_at": s.MFAVerifiedAt,
+		"data":            s.Data,
+		"expired_at":      s.ExpiredAt,
+		"updated_at":      s.UpdatedAt,
 	}
 
 	result, err := conn.Exec(ctx, q, args)
@@ -261,6 +268,7 @@ SELECT
     parent_session_id,
     auth_method,
     authenticated_at,
+    mfa_verified_at,
     expire_reason,
     user_agent,
     ip_address,
@@ -358,6 +366,7 @@ SELECT
     parent_session_id,
     auth_method,
     authenticated_at,
+    mfa_verified_at,
     expire_reason,
     user_agent,
     ip_address,
@@ -24,6 +24,7 @@ type SlackMessageType string
 const (
 	SlackMessageTypeTrustCenterAccessRequest SlackMessageType = "TRUST_CENTER_ACCESS_REQUEST"
 	SlackMessageTypeWelcome                  SlackMessageType = "WELCOME"
+	SlackMessageTypeDeadlineReminder         SlackMessageType = "DEADLINE_REMINDER"
 )
 
 func (smt SlackMessageType) String() string {
@@ -46,6 +47,8 @@ func (smt *SlackMessageType) Scan(value any) error {
 		*smt = SlackMessageTypeTrustCenterAccessRequest
 	case "WELCOME":
 		*smt = SlackMessageTypeWelcome
+	case "DEADLINE_REMINDER":
+		*smt = SlackMessageTypeDeadlineReminder
 	default:
 		return fmt.Errorf("invalid SlackMessageType value: %q", s)
 	}
@@ -0,0 +1,117 @@
+// Copyright (c) 2025 Probo Inc <hello@getprobo.com>.
+//
+// Permission to use, copy, modify, and/or distribute this software for any
+// purpose with or without fee is hereby granted, provided that the above
+// copyright notice and this permission notice appear in all copies.
+//
+// THE SOFTWARE IS PROVIDED "AS IS" AND THE AUTHOR DISCLAIMS ALL WARRANTIES WITH
+// REGARD TO THIS SOFTWARE INCLUDING ALL IMPLIED WARRANTIES OF MERCHANTABILITY
+// AND FITNESS. IN NO EVENT SHALL THE AUTHOR BE LIABLE FOR ANY SPECIAL, DIRECT,
+// INDIRECT, OR CONSEQUENTIAL DAMAGES OR ANY DAMAGES WHATSOEVER RESULTING FROM
+// LOSS OF USE, DATA OR PROFITS, WHETHER IN AN ACTION OF CONTRACT, NEGLIGENCE OR
+// OTHER TORTIOUS ACTION, ARISING OUT OF OR IN CONNECTION WITH THE USE OR
+// PERFORMANCE OF THIS SOFTWARE.
+
+package coredata
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"maps"
+
+	"github.com/jackc/pgx/v5"
+	"go.gearno.de/kit/pg"
+	"go.probo.inc/probo/pkg/gid"
+)
+
+type (
+	// SnapshotRow is a row copied into a snapshot with its columns as
+	// JSON values, excluding the columns managed by the snapshot itself.
+	SnapshotRow struct {
+		ID       gid.GID                    `db:"id"`
+		SourceID *gid.GID                   `db:"source_id"`
+		Fields   map[string]json.RawMessage `db:"fields"`
+	}
+
+	SnapshotRows []*SnapshotRow
+
+	snapshotRowsTable struct {
+		name       string
+		labelField string
+	}
+)
+
+// snapshotRowsTables lists the tables whose snapshot rows can be compared,
+// with the column identifying a row to a human.
+var snapshotRowsTables = map[SnapshotsType]snapshotRowsTable{
+	SnapshotsTypeRisks:                {name: "risks", labelField: "name"},
+	SnapshotsTypeVendors:              {name: "vendors", labelField: "name"},
+	SnapshotsTypeAssets:               {name: "assets", labelField: "name"},
+	SnapshotsTypeData:                 {name: "data", labelField: "name"},
+	SnapshotsTypeObligations:          {name: "obligations", labelField: "requirement"},
+	SnapshotsTypeProcessingActivities: {name: "processing_activities", labelField: "name"},
+}
+
+// SnapshotRowsLabelField returns the column identifying a row of the
+// given snapshot type, and false if the type does not support row
+// comparison.
+func SnapshotRowsLabelField(snapshotType SnapshotsType) (string, bool) {
+	table, ok := snapshotRowsTables[snapshotType]
+	return table.labelField, ok
+}
+
+func (r *SnapshotRows) LoadBySnapshotID(
+	ctx context.Context,
+	conn pg.Conn,
+	scope Scoper,
+	snapshotType SnapshotsType,
+	snapshotID gid.GID,
+) error {
+	table, ok := snapshotRowsTables[snapshotType]
+	if !ok {
+		return fmt.Errorf("unsupported snapshot type: %s", snapshotType)
+	}
+
+	q := `
+SELECT
+    t.id,
+    t.source_id,
+    to_jsonb(t) - ARRAY[
+        'id',
+        'tenant_id',
+        'organization_id',
+        'snapshot_id',
+        'source_id',
+        'search_vector',
+        'created_at',
+        'updated_at'
+    ] AS fields
+FROM
+    %s t
+WHERE
+    %s
+    AND t.snapshot_id = @snapshot_id
+ORDER BY
+    t.id ASC
+`
+
+	q = fmt.Sprintf(q, table.name, scope.SQLFragment())
+
+	args := pgx.StrictNamedArgs{"snapshot_id": snapshotID}
+	maps.Copy(args, scope.SQLArguments())
+
+	rows, err := conn.Query(ctx, q, args)
+	if err != nil {
+		return fmt.Errorf("cannot query %s snapshot rows: %w", table.name, err)
+	}
+
+	snapshotRows, err := pgx.CollectRows(rows, pgx.RowToAddrOfStructByName[SnapshotRow])
+	if err != nil {
+		return fmt.Errorf("cannot collect %s snapshot rows: %w", table.name, err)
+	}
+
+	*r = snapshotRows
+
+	return nil
+}
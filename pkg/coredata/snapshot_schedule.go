@@ -0,0 +1,400 @@
+// Copyright (c) 2025 Probo Inc <hello@getprobo.com>.
+//
+// Permission to use, copy, modify, and/or distribute this software for any
+// purpose with or without fee is hereby granted, provided that the above
+// copyright notice and this permission notice appear in all copies.
+//
+// THE SOFTWARE IS PROVIDED "AS IS" AND THE AUTHOR DISCLAIMS ALL WARRANTIES WITH
+// REGARD TO THIS SOFTWARE INCLUDING ALL IMPLIED WARRANTIES OF MERCHANTABILITY
+// AND FITNESS. IN NO EVENT SHALL THE AUTHOR BE LIABLE FOR ANY SPECIAL, DIRECT,
+// INDIRECT, OR CONSEQUENTIAL DAMAGES OR ANY DAMAGES WHATSOEVER RESULTING FROM
+// LOSS OF USE, DATA OR PROFITS, WHETHER IN AN ACTION OF CONTRACT, NEGLIGENCE OR
+// OTHER TORTIOUS ACTION, ARISING OUT OF OR IN CONNECTION WITH THE USE OR
+// PERFORMANCE OF THIS SOFTWARE.
+
+package coredata
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"maps"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"go.gearno.de/kit/pg"
+	"go.probo.inc/probo/pkg/gid"
+	"go.probo.inc/probo/pkg/page"
+)
+
+type (
+	// SnapshotSchedule periodically takes a snapshot of the given type
+	// for an organization, following a cron expression.
+	SnapshotSchedule struct {
+		ID             gid.GID       `db:"id"`
+		OrganizationID gid.GID       `db:"organization_id"`
+		Name           string        `db:"name"`
+		Description    *string       `db:"description"`
+		Type           SnapshotsType `db:"type"`
+		CronExpression string        `db:"cron_expression"`
+		NextRunAt      time.Time     `db:"next_run_at"`
+		LastRunAt      *time.Time    `db:"last_run_at"`
+		LastSnapshotID *gid.GID      `db:"last_snapshot_id"`
+		LastError      *string       `db:"last_error"`
+		CreatedAt      time.Time     `db:"created_at"`
+		UpdatedAt      time.Time     `db:"updated_at"`
+	}
+
+	SnapshotSchedules []*SnapshotSchedule
+)
+
+var ErrNoSnapshotScheduleDue = errors.New("no snapshot schedule due")
+
+func (s *SnapshotSchedule) CursorKey(field SnapshotScheduleOrderField) page.CursorKey {
+	switch field {
+	case SnapshotScheduleOrderFieldCreatedAt:
+		return page.NewCursorKey(s.ID, s.CreatedAt)
+	case SnapshotScheduleOrderFieldName:
+		return page.NewCursorKey(s.ID, s.Name)
+	case SnapshotScheduleOrderFieldNextRunAt:
+		return page.NewCursorKey(s.ID, s.NextRunAt)
+	}
+
+	panic(fmt.Sprintf("unsupported order by: %s", field))
+}
+
+// AuthorizationAttributes returns the authorization attributes for policy evaluation.
+func (s *SnapshotSchedule) AuthorizationAttributes(ctx context.Context, conn pg.Conn) (map[string]string, error) {
+	q := `SELECT organization_id FROM snapshot_schedules WHERE id = $1 LIMIT 1;`
+
+	var organizationID gid.GID
+	if err := conn.QueryRow(ctx, q, s.ID).Scan(&organizationID); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrResourceNotFound
+		}
+		return nil, fmt.Errorf("cannot query snapshot schedule authorization attributes: %w", err)
+	}
+
+	return map[string]string{"organization_id": organizationID.String()}, nil
+}
+
+func (s *SnapshotSchedule) LoadByID(
+	ctx context.Context,
+	conn pg.Conn,
+	scope Scoper,
+	scheduleID gid.GID,
+) error {
+	q := `
+SELECT
+    id,
+    organization_id,
+    name,
+    description,
+    type,
+    cron_expression,
+    next_run_at,
+    last_run_at,
+    last_snapshot_id,
+    last_error,
+    created_at,
+    updated_at
+FROM
+    snapshot_schedules
+WHERE
+    %s
+    AND id = @id
+LIMIT 1;
+`
+
+	q = fmt.Sprintf(q, scope.SQLFragment())
+
+	args := pgx.StrictNamedArgs{"id": scheduleID}
+	maps.Copy(args, scope.SQLArguments())
+
+	rows, err := conn.Query(ctx, q, args)
+	if err != nil {
+		return fmt.Errorf("cannot query snapshot schedule: %w", err)
+	}
+
+	schedule, err := pgx.CollectExactlyOneRow(rows, pgx.RowToStructByName[SnapshotSchedule])
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return ErrResourceNotFound
+		}
+		return fmt.Errorf("cannot collect snapshot schedule: %w", err)
+	}
+
+	*s = schedule
+
+	return nil
+}
+
+// LoadNextDueForUpdateSkipLocked locks the schedule whose next run is the
+// most overdue, skipping schedules locked by other workers. It returns
+// ErrNoSnapshotScheduleDue when no schedule is due.
+func (s *SnapshotSchedule) LoadNextDueForUpdateSkipLocked(
+	ctx context.Context,
+	conn pg.Conn,
+	now time.Time,
+) error {
+	q := `
+SELECT
+    id,
+    organization_id,
+    name,
+    description,
+    type,
+    cron_expression,
+    next_run_at,
+    last_run_at,
+    last_snapshot_id,
+    last_error,
+    created_at,
+    updated_at
+FROM
+    snapshot_schedules
+WHERE
+    next_run_at <= @now
+ORDER BY
+    next_run_at ASC
+LIMIT 1
+FOR UPDATE SKIP LOCKED
+`
+
+	args := pgx.StrictNamedArgs{"now": now}
+
+	rows, err := conn.Query(ctx, q, args)
+	if err != nil {
+		return fmt.Errorf("cannot query snapshot schedules: %w", err)
+	}
+
+	schedule, err := pgx.CollectExactlyOneRow(rows, pgx.RowToStructByName[SnapshotSchedule])
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return ErrNoSnapshotScheduleDue
+		}
+		return fmt.Errorf("cannot collect snapshot schedule: %w", err)
+	}
+
+	*s = schedule
+
+	return nil
+}
+
+func (s *SnapshotSchedules) CountByOrganizationID(
+	ctx context.Context,
+	conn pg.Conn,
+	scope Scoper,
+	organizationID gid.GID,
+) (int, error) {
+	q := `
+SELECT
+    COUNT(id)
+FROM
+    snapshot_schedules
+WHERE
+    %s
+    AND organization_id = @organization_id
+`
+
+	q = fmt.Sprintf(q, scope.SQLFragment())
+
+	args := pgx.StrictNamedArgs{"organization_id": organizationID}
+	maps.Copy(args, scope.SQLArguments())
+
+	var count int
+	if err := conn.QueryRow(ctx, q, args).Scan(&count); err != nil {
+		return 0, fmt.Errorf("cannot count snapshot schedules: %w", err)
+	}
+
+	return count, nil
+}
+
+func (s *SnapshotSchedules) LoadByOrganizationID(
+	ctx context.Context,
+	conn pg.Conn,
+	scope Scoper,
+	organizationID gid.GID,
+	cursor *page.Cursor[SnapshotScheduleOrderField],
+) error {
+	q := `
+SELECT
+    id,
+    organization_id,
+    name,
+    description,
+    type,
+    cron_expression,
+    next_run_at,
+    last_run_at,
+    last_snapshot_id,
+    last_error,
+    created_at,
+    updated_at
+FROM
+    snapshot_schedules
+WHERE
+    %s
+    AND organization_id = @organization_id
+    AND %s
+`
+
+	q = fmt.Sprintf(q, scope.SQLFragment(), cursor.SQLFragment())
+
+	args := pgx.StrictNamedArgs{"organization_id": organizationID}
+	maps.Copy(args, scope.SQLArguments())
+	maps.Copy(args, cursor.SQLArguments())
+
+	rows, err := conn.Query(ctx, q, args)
+	if err != nil {
+		return fmt.Errorf("cannot query snapshot schedules: %w", err)
+	}
+
+	schedules, err := pgx.CollectRows(rows, pgx.RowToAddrOfStructByName[SnapshotSchedule])
+	if err != nil {
+		return fmt.Errorf("cannot collect snapshot schedules: %w", err)
+	}
+
+	*s = schedules
+
+	return nil
+}
+
+func (s *SnapshotSchedule) Insert(
+	ctx context.Context,
+	conn pg.Conn,
+	scope Scoper,
+) error {
+	q := `
+INSERT INTO snapshot_schedules (
+    id,
+    tenant_id,
+    organization_id,
+    name,
+    description,
+    type,
+    cron_expression,
+    next_run_at,
+    last_run_at,
+    last_snapshot_id,
+    last_error,
+    created_at,
+    updated_at
+) VALUES (
+    @id,
+    @tenant_id,
+    @organization_id,
+    @name,
+    @description,
+    @type,
+    @cron_expression,
+    @next_run_at,
+    @last_run_at,
+    @last_snapshot_id,
+    @last_error,
+    @created_at,
+    @updated_at
+)
+`
+
+	args := pgx.StrictNamedArgs{
+		"id":               s.ID,
+		"tenant_id":        scope.GetTenantID(),
+		"organization_id":  s.OrganizationID,
+		"name":             s.Name,
+		"description":      s.Description,
+		"type":             s.Type,
+		"cron_expression":  s.CronExpression,
+		"next_run_at":      s.NextRunAt,
+		"last_run_at":      s.LastRunAt,
+		"last_snapshot_id": s.LastSnapshotID,
+		"last_error":       s.LastError,
+		"created_at":       s.CreatedAt,
+		"updated_at":       s.UpdatedAt,
+	}
+
+	if _, err := conn.Exec(ctx, q, args); err != nil {
+		return fmt.Errorf("cannot insert snapshot schedule: %w", err)
+	}
+
+	return nil
+}
+
+func (s *SnapshotSchedule) Update(
+	ctx context.Context,
+	conn pg.Conn,
+	scope Scoper,
+) error {
+	q := `
+UPDATE snapshot_schedules
+SET
+    name = @name,
+    description = @description,
+    type = @type,
+    cron_expression = @cron_expression,
+    next_run_at = @next_run_at,
+    last_run_at = @last_run_at,
+    last_snapshot_id = @last_snapshot_id,
+    last_error = @last_error,
+    updated_at = @updated_at
+WHERE
+    %s
+    AND id = @id
+`
+
+	q = fmt.Sprintf(q, scope.SQLFragment())
+
+	args := pgx.StrictNamedArgs{
+		"id":               s.ID,
+		"name":             s.Name,
+		"description":      s.Description,
+		"type":             s.Type,
+		"cron_expression":  s.CronExpression,
+		"next_run_at":      s.NextRunAt,
+		"last_run_at":      s.LastRunAt,
+		"last_snapshot_id": s.LastSnapshotID,
+		"last_error":       s.LastError,
+		"updated_at":       s.UpdatedAt,
+	}
+	maps.Copy(args, scope.SQLArguments())
+
+	result, err := conn.Exec(ctx, q, args)
+	if err != nil {
+		return fmt.Errorf("cannot update snapshot schedule: %w", err)
+	}
+
+	if result.RowsAffected() == 0 {
+		return ErrResourceNotFound
+	}
+
+	return nil
+}
+
+func (s *SnapshotSchedule) Delete(
+	ctx context.Context,
+	conn pg.Conn,
+	scope Scoper,
+) error {
+	q := `
+DELETE FROM snapshot_schedules
+WHERE
+    %s
+    AND id = @id
+`
+
+	q = fmt.Sprintf(q, scope.SQLFragment())
+
+	args := pgx.StrictNamedArgs{"id": s.ID}
+	maps.Copy(args, scope.SQLArguments())
+
+	result, err := conn.Exec(ctx, q, args)
+	if err != nil {
+		return fmt.Errorf("cannot delete snapshot schedule: %w", err)
+	}
+
+	if result.RowsAffected() == 0 {
+		return ErrResourceNotFound
+	}
+
+	return nil
+}
@@ -0,0 +1,285 @@
+// Copyright (c) 2025 Probo Inc <hello@getprobo.com>.
+//
+// Permission to use, copy, modify, and/or distribute this software for any
+// purpose with or without fee is hereby granted, provided that the above
+// copyright notice and this permission notice appear in all copies.
+//
+// THE SOFTWARE IS PROVIDED "AS IS" AND THE AUTHOR DISCLAIMS ALL WARRANTIES WITH
+// REGARD TO THIS SOFTWARE INCLUDING ALL IMPLIED WARRANTIES OF MERCHANTABILITY
+// AND FITNESS. IN NO EVENT SHALL THE AUTHOR BE LIABLE FOR ANY SPECIAL, DIRECT,
+// INDIRECT, OR CONSEQUENTIAL DAMAGES OR ANY DAMAGES WHATSOEVER RESULTING FROM
+// LOSS OF USE, DATA OR PROFITS, WHETHER IN AN ACTION OF CONTRACT, NEGLIGENCE OR
+// OTHER TORTIOUS ACTION, ARISING OUT OF OR IN CONNECTION WITH THE USE OR
+// PERFORMANCE OF THIS SOFTWARE.
+
+package coredata
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"go.gearno.de/kit/pg"
+	"go.probo.inc/probo/pkg/gid"
+)
+
+type (
+	// WebAuthnCredential is a passkey or security key registered by an
+	// identity. Credential holds the serialized public key credential as
+	// returned by the WebAuthn ceremony.
+	WebAuthnCredential struct {
+		ID           gid.GID         `db:"id"`
+		IdentityID   gid.GID         `db:"identity_id"`
+		Name         string          `db:"name"`
+		CredentialID []byte          `db:"credential_id"`
+		Credential   json.RawMessage `db:"credential"`
+		LastUsedAt   *time.Time      `db:"last_used_at"`
+		CreatedAt    time.Time       `db:"created_at"`
+		UpdatedAt    time.Time       `db:"updated_at"`
+	}
+
+	WebAuthnCredentials []*WebAuthnCredential
+)
+
+// AuthorizationAttributes returns the authorization attributes for policy evaluation.
+func (c *WebAuthnCredential) AuthorizationAttributes(ctx context.Context, conn pg.Conn) (map[string]string, error) {
+	q := `
+SELECT
+    identity_id
+FROM
+    iam_webauthn_credentials
+WHERE
+    id = $1
+LIMIT 1;
+`
+
+	var identityID gid.GID
+	if err := conn.QueryRow(ctx, q, c.ID).Scan(&identityID); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrResourceNotFound
+		}
+		return nil, fmt.Errorf("cannot query webauthn credential iam attributes: %w", err)
+	}
+
+	return map[string]string{"identity_id": identityID.String()}, nil
+}
+
+func (c *WebAuthnCredential) LoadByID(
+	ctx context.Context,
+	conn pg.Conn,
+	credentialID gid.GID,
+) error {
+	q := `
+SELECT
+    id,
+    identity_id,
+    name,
+    credential_id,
+    credential,
+    last_used_at,
+    created_at,
+    updated_at
+FROM
+    iam_webauthn_credentials
+WHERE
+    id = @id
+LIMIT 1;
+`
+
+	args := pgx.StrictNamedArgs{"id": credentialID}
+
+	rows, err := conn.Query(ctx, q, args)
+	if err != nil {
+		return fmt.Errorf("cannot query iam_webauthn_credentials: %w", err)
+	}
+
+	credential, err := pgx.CollectExactlyOneRow(rows, pgx.RowToStructByName[WebAuthnCredential])
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return ErrResourceNotFound
+		}
+
+		return fmt.Errorf("cannot collect iam_webauthn_credentials: %w", err)
+	}
+
+	*c = credential
+
+	return nil
+}
+
+func (c *WebAuthnCredentials) LoadAllByIdentityID(
+	ctx context.Context,
+	conn pg.Conn,
+	identityID gid.GID,
+) error {
+	q := `
+SELECT
+    id,
+    identity_id,
+    name,
+    credential_id,
+    credential,
+    last_used_at,
+    created_at,
+    updated_at
+FROM
+    iam_webauthn_credentials
+WHERE
+    identity_id = @identity_id
+ORDER BY
+    created_at ASC, id ASC
+`
+
+	args := pgx.StrictNamedArgs{"identity_id": identityID}
+
+	rows, err := conn.Query(ctx, q, args)
+	if err != nil {
+		return fmt.Errorf("cannot query iam_webauthn_credentials: %w", err)
+	}
+
+	credentials, err := pgx.CollectRows(rows, pgx.RowToAddrOfStructByName[WebAuthnCredential])
+	if err != nil {
+		return fmt.Errorf("cannot collect iam_webauthn_credentials: %w", err)
+	}
+
+	*c = credentials
+
+	return nil
+}
+
+func (c *WebAuthnCredentials) CountByIdentityID(
+	ctx context.Context,
+	conn pg.Conn,
+	identityID gid.GID,
+) (int, error) {
+	q := `
+SELECT
+    COUNT(*)
+FROM
+    iam_webauthn_credentials
+WHERE
+    identity_id = @identity_id
+`
+
+	args := pgx.StrictNamedArgs{"identity_id": identityID}
+
+	row := conn.QueryRow(ctx, q, args)
+
+	var count int
+	if err := row.Scan(&count); err != nil {
+		return 0, fmt.Errorf("cannot scan count: %w", err)
+	}
+
+	return count, nil
+}
+
+func (c *WebAuthnCredential) Insert(
+	ctx context.Context,
+	conn pg.Conn,
+) error {
+	q := `
+INSERT INTO iam_webauthn_credentials (
+    id,
+    identity_id,
+    name,
+    credential_id,
+    credential,
+    last_used_at,
+    created_at,
+    updated_at
+) VALUES (
+    @id,
+    @identity_id,
+    @name,
+    @credential_id,
+    @credential,
+    @last_used_at,
+    @created_at,
+    @updated_at
+)
+`
+
+	args := pgx.StrictNamedArgs{
+		"id":            c.ID,
+		"identity_id":   c.IdentityID,
+		"name":          c.Name,
+		"credential_id": c.CredentialID,
+		"credential":    c.Credential,
+		"last_used_at":  c.LastUsedAt,
+		"created_at":    c.CreatedAt,
+		"updated_at":    c.UpdatedAt,
+	}
+
+	_, err := conn.Exec(ctx, q, args)
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) {
+			if pgErr.Code == "23505" && pgErr.ConstraintName == "iam_webauthn_credentials_credential_id_unique" {
+				return ErrResourceAlreadyExists
+			}
+		}
+
+		return fmt.Errorf("cannot insert iam_webauthn_credentials: %w", err)
+	}
+
+	return nil
+}
+
+func (c *WebAuthnCredential) Update(
+	ctx context.Context,
+	conn pg.Conn,
+) error {
+	q := `
+UPDATE iam_webauthn_credentials
+SET
+    name = @name,
+    credential = @credential,
+    last_used_at = @last_used_at,
+    updated_at = @updated_at
+WHERE
+    id = @id
+`
+
+	args := pgx.StrictNamedArgs{
+		"id":           c.ID,
+		"name":         c.Name,
+		"credential":   c.Credential,
+		"last_used_at": c.LastUsedAt,
+		"updated_at":   c.UpdatedAt,
+	}
+
+	result, err := conn.Exec(ctx, q, args)
+	if err != nil {
+		return fmt.Errorf("cannot update iam_webauthn_credentials: %w", err)
+	}
+
+	if result.RowsAffected() == 0 {
+		return ErrResourceNotFound
+	}
+
+	return nil
+}
+
+func (c *WebAuthnCredential) Delete(
+	ctx context.Context,
+	conn pg.Conn,
+) error {
+	q := `
+DELETE FROM iam_webauthn_credentials
+WHERE
+    id = @id
+`
+
+	args := pgx.StrictNamedArgs{"id": c.ID}
+
+	_, err := conn.Exec(ctx, q, args)
+	if err != nil {
+		return fmt.Errorf("cannot delete iam_webauthn_credentials: %w", err)
+	}
+
+	return nil
+}
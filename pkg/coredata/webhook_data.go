@@ -29,12 +29,12 @@ import (
 
 type (
 	WebhookData struct {
-		ID             gid.GID         `db:"id"`
-		OrganizationID gid.GID         `db:"organization_id"`
+		ID             gid.GID          `db:"id"`
+		OrganizationID gid.GID          `db:"organization_id"`
 		EventType      WebhookEventType `db:"event_type"`
-		Data           json.RawMessage `db:"data"`
-		CreatedAt      time.Time       `db:"created_at"`
-		ProcessedAt    *time.Time      `db:"processed_at"`
+		Data           json.RawMessage  `db:"data"`
+		CreatedAt      time.Time        `db:"created_at"`
+		ProcessedAt    *time.Time       `db:"processed_at"`
 	}
 
 	WebhookDataList []*WebhookData
@@ -81,6 +81,49 @@ VALUES (
 	return nil
 }
 
+func (w *WebhookData) LoadByID(
+	ctx context.Context,
+	conn pg.Conn,
+	scope Scoper,
+	webhookDataID gid.GID,
+) error {
+	q := `
+SELECT
+    id,
+    organization_id,
+    event_type,
+    data,
+    created_at,
+    processed_at
+FROM webhook_data
+WHERE %s
+    AND id = @webhook_data_id
+LIMIT 1
+`
+
+	q = fmt.Sprintf(q, scope.SQLFragment())
+
+	args := pgx.StrictNamedArgs{"webhook_data_id": webhookDataID}
+	maps.Copy(args, scope.SQLArguments())
+
+	rows, err := conn.Query(ctx, q, args)
+	if err != nil {
+		return fmt.Errorf("cannot query webhook data: %w", err)
+	}
+
+	data, err := pgx.CollectExactlyOneRow(rows, pgx.RowToStructByName[WebhookData])
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return ErrResourceNotFound
+		}
+
+		return fmt.Errorf("cannot collect webhook data: %w", err)
+	}
+
+	*w = data
+	return nil
+}
+
 func (w *WebhookData) LoadNextUnprocessedForUpdate(
 	ctx context.Context,
 	conn pg.Conn,
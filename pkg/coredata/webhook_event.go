@@ -17,6 +17,7 @@ package coredata
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"maps"
 	"time"
@@ -29,12 +30,15 @@ import (
 
 type (
 	WebhookEvent struct {
-		ID                     gid.GID            `db:"id"`
-		WebhookDataID          gid.GID            `db:"webhook_data_id"`
+		ID                    gid.GID            `db:"id"`
+		WebhookDataID         gid.GID            `db:"webhook_data_id"`
 		WebhookSubscriptionID gid.GID            `db:"webhook_subscription_id"`
-		Status                 WebhookEventStatus `db:"status"`
-		Response               json.RawMessage    `db:"response"`
-		CreatedAt              time.Time          `db:"created_at"`
+		Status                WebhookEventStatus `db:"status"`
+		Response              json.RawMessage    `db:"response"`
+		Attempts              int                `db:"attempts"`
+		NextAttemptAt         *time.Time         `db:"next_attempt_at"`
+		LastAttemptedAt       *time.Time         `db:"last_attempted_at"`
+		CreatedAt             time.Time          `db:"created_at"`
 	}
 
 	WebhookEvents []*WebhookEvent
@@ -49,6 +53,129 @@ func (w WebhookEvent) CursorKey(orderBy WebhookEventOrderField) page.CursorKey {
 	panic(fmt.Sprintf("unsupported order by: %s", orderBy))
 }
 
+// AuthorizationAttributes returns the authorization attributes for policy evaluation.
+func (w *WebhookEvent) AuthorizationAttributes(ctx context.Context, conn pg.Conn) (map[string]string, error) {
+	q := `
+SELECT
+    ws.organization_id
+FROM
+    webhook_events we
+    INNER JOIN webhook_subscriptions ws ON ws.id = we.webhook_subscription_id
+WHERE
+    we.id = $1
+LIMIT 1;
+`
+
+	var organizationID gid.GID
+	if err := conn.QueryRow(ctx, q, w.ID).Scan(&organizationID); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrResourceNotFound
+		}
+		return nil, fmt.Errorf("cannot query webhook event authorization attributes: %w", err)
+	}
+
+	return map[string]string{"organization_id": organizationID.String()}, nil
+}
+
+func (w *WebhookEvent) LoadByID(
+	ctx context.Context,
+	conn pg.Conn,
+	scope Scoper,
+	webhookEventID gid.GID,
+) error {
+	q := `
+SELECT
+    id,
+    webhook_data_id,
+    webhook_subscription_id,
+    status,
+    response,
+    attempts,
+    next_attempt_at,
+    last_attempted_at,
+    created_at
+FROM
+    webhook_events
+WHERE
+    %s
+    AND id = @webhook_event_id
+LIMIT 1;
+`
+
+	q = fmt.Sprintf(q, scope.SQLFragment())
+
+	args := pgx.StrictNamedArgs{"webhook_event_id": webhookEventID}
+	maps.Copy(args, scope.SQLArguments())
+
+	rows, err := conn.Query(ctx, q, args)
+	if err != nil {
+		return fmt.Errorf("cannot query webhook events: %w", err)
+	}
+
+	event, err := pgx.CollectExactlyOneRow(rows, pgx.RowToStructByName[WebhookEvent])
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return ErrResourceNotFound
+		}
+
+		return fmt.Errorf("cannot collect webhook event: %w", err)
+	}
+
+	*w = event
+	return nil
+}
+
+// LoadNextDueForUpdate locks the oldest event whose next attempt is due.
+// Events that are pending their first delivery and events whose previous
+// attempt failed are both eligible; succeeded and dead-lettered events
+// never are.
+func (w *WebhookEvent) LoadNextDueForUpdate(
+	ctx context.Context,
+	conn pg.Conn,
+	now time.Time,
+) error {
+	q := `
+SELECT
+    id,
+    webhook_data_id,
+    webhook_subscription_id,
+    status,
+    response,
+    attempts,
+    next_attempt_at,
+    last_attempted_at,
+    created_at
+FROM
+    webhook_events
+WHERE
+    status IN ('PENDING', 'FAILED')
+    AND next_attempt_at <= @now
+ORDER BY
+    next_attempt_at ASC
+LIMIT 1
+FOR UPDATE SKIP LOCKED
+`
+
+	args := pgx.StrictNamedArgs{"now": now}
+
+	rows, err := conn.Query(ctx, q, args)
+	if err != nil {
+		return fmt.Errorf("cannot query due webhook events: %w", err)
+	}
+
+	event, err := pgx.CollectExactlyOneRow(rows, pgx.RowToStructByName[WebhookEvent])
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return ErrResourceNotFound
+		}
+
+		return fmt.Errorf("cannot collect webhook event: %w", err)
+	}
+
+	*w = event
+	return nil
+}
+
 func (w *WebhookEvents) LoadBySubscriptionID(
 	ctx context.Context,
 	conn pg.Conn,
@@ -63,6 +190,9 @@ SELECT
     webhook_subscription_id,
     status,
     response,
+    attempts,
+    next_attempt_at,
+    last_attempted_at,
     created_at
 FROM
     webhook_events
@@ -91,6 +221,60 @@ WHERE
 	return nil
 }
 
+func (w *WebhookEvents) LoadFailedBySubscriptionIDForUpdate(
+	ctx context.Context,
+	conn pg.Conn,
+	scope Scoper,
+	webhookSubscriptionID gid.GID,
+	from time.Time,
+	to time.Time,
+) error {
+	q := `
+SELECT
+    id,
+    webhook_data_id,
+    webhook_subscription_id,
+    status,
+    response,
+    attempts,
+    next_attempt_at,
+    last_attempted_at,
+    created_at
+FROM
+    webhook_events
+WHERE
+    %s
+    AND webhook_subscription_id = @webhook_subscription_id
+    AND status IN ('FAILED', 'DEAD_LETTER')
+    AND created_at >= @from
+    AND created_at < @to
+ORDER BY
+    created_at ASC
+FOR UPDATE
+`
+	q = fmt.Sprintf(q, scope.SQLFragment())
+
+	args := pgx.StrictNamedArgs{
+		"webhook_subscription_id": webhookSubscriptionID,
+		"from":                    from,
+		"to":                      to,
+	}
+	maps.Copy(args, scope.SQLArguments())
+
+	rows, err := conn.Query(ctx, q, args)
+	if err != nil {
+		return fmt.Errorf("cannot query failed webhook events: %w", err)
+	}
+
+	events, err := pgx.CollectRows(rows, pgx.RowToAddrOfStructByName[WebhookEvent])
+	if err != nil {
+		return fmt.Errorf("cannot collect webhook events: %w", err)
+	}
+
+	*w = events
+	return nil
+}
+
 func (w *WebhookEvents) CountBySubscriptionID(
 	ctx context.Context,
 	conn pg.Conn,
@@ -129,6 +313,9 @@ INSERT INTO webhook_events (
     webhook_subscription_id,
     status,
     response,
+    attempts,
+    next_attempt_at,
+    last_attempted_at,
     created_at
 )
 VALUES (
@@ -138,18 +325,24 @@ VALUES (
     @webhook_subscription_id,
     @status,
     @response,
+    @attempts,
+    @next_attempt_at,
+    @last_attempted_at,
     @created_at
 )
 `
 
 	args := pgx.StrictNamedArgs{
-		"id":                       w.ID,
-		"tenant_id":                scope.GetTenantID(),
-		"webhook_data_id":          w.WebhookDataID,
+		"id":                      w.ID,
+		"tenant_id":               scope.GetTenantID(),
+		"webhook_data_id":         w.WebhookDataID,
 		"webhook_subscription_id": w.WebhookSubscriptionID,
-		"status":                   w.Status,
-		"response":                 w.Response,
-		"created_at":               w.CreatedAt,
+		"status":                  w.Status,
+		"response":                w.Response,
+		"attempts":                w.Attempts,
+		"next_attempt_at":         w.NextAttemptAt,
+		"last_attempted_at":       w.LastAttemptedAt,
+		"created_at":              w.CreatedAt,
 	}
 
 	_, err := conn.Exec(ctx, q, args)
@@ -160,7 +353,7 @@ VALUES (
 	return nil
 }
 
-func (w *WebhookEvent) UpdateStatus(
+func (w *WebhookEvent) Update(
 	ctx context.Context,
 	conn pg.Conn,
 	scope Scoper,
@@ -169,7 +362,10 @@ func (w *WebhookEvent) UpdateStatus(
 UPDATE webhook_events
 SET
     status = @status,
-    response = @response
+    response = @response,
+    attempts = @attempts,
+    next_attempt_at = @next_attempt_at,
+    last_attempted_at = @last_attempted_at
 WHERE %s
     AND id = @id
 `
@@ -177,9 +373,12 @@ WHERE %s
 	q = fmt.Sprintf(q, scope.SQLFragment())
 
 	args := pgx.StrictNamedArgs{
-		"id":       w.ID,
-		"status":   w.Status,
-		"response": w.Response,
+		"id":                w.ID,
+		"status":            w.Status,
+		"response":          w.Response,
+		"attempts":          w.Attempts,
+		"next_attempt_at":   w.NextAttemptAt,
+		"last_attempted_at": w.LastAttemptedAt,
 	}
 	maps.Copy(args, scope.SQLArguments())
 
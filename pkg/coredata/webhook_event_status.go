@@ -22,9 +22,10 @@ import (
 type WebhookEventStatus string
 
 const (
-	WebhookEventStatusPending WebhookEventStatus = "PENDING"
+	WebhookEventStatusPending    WebhookEventStatus = "PENDING"
 	WebhookEventStatusSucceeded  WebhookEventStatus = "SUCCEEDED"
 	WebhookEventStatusFailed     WebhookEventStatus = "FAILED"
+	WebhookEventStatusDeadLetter WebhookEventStatus = "DEAD_LETTER"
 )
 
 func (s WebhookEventStatus) String() string {
@@ -33,7 +34,10 @@ func (s WebhookEventStatus) String() string {
 
 func (s WebhookEventStatus) IsValid() bool {
 	switch s {
-	case WebhookEventStatusPending, WebhookEventStatusSucceeded, WebhookEventStatusFailed:
+	case WebhookEventStatusPending,
+		WebhookEventStatusSucceeded,
+		WebhookEventStatusFailed,
+		WebhookEventStatusDeadLetter:
 		return true
 	}
 	return false
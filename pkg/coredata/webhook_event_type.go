@@ -29,6 +29,38 @@ const (
 	WebhookEventTypeVendorCreated  WebhookEventType = "vendor:created"
 	WebhookEventTypeVendorUpdated  WebhookEventType = "vendor:updated"
 	WebhookEventTypeVendorDeleted  WebhookEventType = "vendor:deleted"
+
+	WebhookEventTypeRiskCreated WebhookEventType = "risk:created"
+	WebhookEventTypeRiskUpdated WebhookEventType = "risk:updated"
+	WebhookEventTypeRiskDeleted WebhookEventType = "risk:deleted"
+
+	WebhookEventTypeMeasureCreated WebhookEventType = "measure:created"
+	WebhookEventTypeMeasureUpdated WebhookEventType = "measure:updated"
+	WebhookEventTypeMeasureDeleted WebhookEventType = "measure:deleted"
+
+	WebhookEventTypeControlCreated WebhookEventType = "control:created"
+	WebhookEventTypeControlUpdated WebhookEventType = "control:updated"
+	WebhookEventTypeControlDeleted WebhookEventType = "control:deleted"
+
+	WebhookEventTypeTaskCreated WebhookEventType = "task:created"
+	WebhookEventTypeTaskUpdated WebhookEventType = "task:updated"
+	WebhookEventTypeTaskDeleted WebhookEventType = "task:deleted"
+
+	WebhookEventTypeNonconformityCreated WebhookEventType = "nonconformity:created"
+	WebhookEventTypeNonconformityUpdated WebhookEventType = "nonconformity:updated"
+	WebhookEventTypeNonconformityDeleted WebhookEventType = "nonconformity:deleted"
+
+	WebhookEventTypeAuditCreated WebhookEventType = "audit:created"
+	WebhookEventTypeAuditUpdated WebhookEventType = "audit:updated"
+	WebhookEventTypeAuditDeleted WebhookEventType = "audit:deleted"
+
+	WebhookEventTypeEvidenceCreated WebhookEventType = "evidence:created"
+	WebhookEventTypeEvidenceDeleted WebhookEventType = "evidence:deleted"
+
+	WebhookEventTypeDocumentVersionPublished WebhookEventType = "document:version_published"
+	WebhookEventTypeDocumentSignatureSigned  WebhookEventType = "document:signature_signed"
+
+	WebhookEventTypeTrustCenterAccessRequested WebhookEventType = "trust_center_access:requested"
 )
 
 func (w WebhookEventType) String() string {
@@ -38,7 +70,16 @@ func (w WebhookEventType) String() string {
 func (w WebhookEventType) IsValid() bool {
 	switch w {
 	case WebhookEventTypeMeetingCreated, WebhookEventTypeMeetingUpdated, WebhookEventTypeMeetingDeleted,
-		WebhookEventTypeVendorCreated, WebhookEventTypeVendorUpdated, WebhookEventTypeVendorDeleted:
+		WebhookEventTypeVendorCreated, WebhookEventTypeVendorUpdated, WebhookEventTypeVendorDeleted,
+		WebhookEventTypeRiskCreated, WebhookEventTypeRiskUpdated, WebhookEventTypeRiskDeleted,
+		WebhookEventTypeMeasureCreated, WebhookEventTypeMeasureUpdated, WebhookEventTypeMeasureDeleted,
+		WebhookEventTypeControlCreated, WebhookEventTypeControlUpdated, WebhookEventTypeControlDeleted,
+		WebhookEventTypeTaskCreated, WebhookEventTypeTaskUpdated, WebhookEventTypeTaskDeleted,
+		WebhookEventTypeNonconformityCreated, WebhookEventTypeNonconformityUpdated, WebhookEventTypeNonconformityDeleted,
+		WebhookEventTypeAuditCreated, WebhookEventTypeAuditUpdated, WebhookEventTypeAuditDeleted,
+		WebhookEventTypeEvidenceCreated, WebhookEventTypeEvidenceDeleted,
+		WebhookEventTypeDocumentVersionPublished, WebhookEventTypeDocumentSignatureSigned,
+		WebhookEventTypeTrustCenterAccessRequested:
 		return true
 	}
 	return false
@@ -0,0 +1,228 @@
+// Copyright (c) 2025 Probo Inc <hello@getprobo.com>.
+//
+// Permission to use, copy, modify, and/or distribute this software for any
+// purpose with or without fee is hereby granted, provided that the above
+// copyright notice and this permission notice appear in all copies.
+//
+// THE SOFTWARE IS PROVIDED "AS IS" AND THE AUTHOR DISCLAIMS ALL WARRANTIES WITH
+// REGARD TO THIS SOFTWARE INCLUDING ALL IMPLIED WARRANTIES OF MERCHANTABILITY
+// AND FITNESS. IN NO EVENT SHALL THE AUTHOR BE LIABLE FOR ANY SPECIAL, DIRECT,
+// INDIRECT, OR CONSEQUENTIAL DAMAGES OR ANY DAMAGES WHATSOEVER RESULTING FROM
+// LOSS OF USE, DATA OR PROFITS, WHETHER IN AN ACTION OF CONTRACT, NEGLIGENCE OR
+// OTHER TORTIOUS ACTION, ARISING OUT OF OR IN CONNECTION WITH THE USE OR
+// PERFORMANCE OF THIS SOFTWARE.
+
+package coredata
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"go.gearno.de/kit/pg"
+	"go.probo.inc/probo/pkg/gid"
+)
+
+type (
+	// WeeklyDigestItem is a line of a section of the weekly digest sent
+	// to a member.
+	WeeklyDigestItem struct {
+		ID     gid.GID    `db:"id"`
+		Title  string     `db:"title"`
+		Date   *time.Time `db:"date"`
+		Detail *string    `db:"detail"`
+	}
+
+	WeeklyDigestItems []*WeeklyDigestItem
+)
+
+// LoadOpenTasksByProfileID loads the tasks assigned to the profile that
+// are not done, the ones with the closest deadline first.
+func (items *WeeklyDigestItems) LoadOpenTasksByProfileID(
+	ctx context.Context,
+	conn pg.Conn,
+	scope Scoper,
+	profileID gid.GID,
+) error {
+	q := `
+SELECT
+    id,
+    name AS title,
+    deadline AS date,
+    NULL::text AS detail
+FROM
+    tasks
+WHERE
+    %s
+    AND assigned_to_profile_id = @profile_id
+    AND state <> 'DONE'
+ORDER BY
+    deadline ASC NULLS LAST,
+    id ASC
+`
+
+	return items.load(ctx, conn, scope, q, pgx.StrictNamedArgs{"profile_id": profileID})
+}
+
+// LoadOverdueNonconformitiesByProfileID loads the nonconformities owned by
+// the profile that are not closed and whose due date is before today.
+func (items *WeeklyDigestItems) LoadOverdueNonconformitiesByProfileID(
+	ctx context.Context,
+	conn pg.Conn,
+	scope Scoper,
+	profileID gid.GID,
+	today time.Time,
+) error {
+	q := `
+SELECT
+    id,
+    reference_id AS title,
+    due_date AS date,
+    NULL::text AS detail
+FROM
+    nonconformities
+WHERE
+    %s
+    AND owner_profile_id = @profile_id
+    AND snapshot_id IS NULL
+    AND status <> 'CLOSED'
+    AND due_date < @today::date
+ORDER BY
+    due_date ASC,
+    id ASC
+`
+
+	return items.load(ctx, conn, scope, q, pgx.StrictNamedArgs{"profile_id": profileID, "today": today})
+}
+
+// LoadPendingSignaturesByProfileID loads the document versions the profile
+// was asked to sign and has not signed yet.
+func (items *WeeklyDigestItems) LoadPendingSignaturesByProfileID(
+	ctx context.Context,
+	conn pg.Conn,
+	scope Scoper,
+	profileID gid.GID,
+) error {
+	q := `
+SELECT
+    s.id,
+    dv.title,
+    s.requested_at AS date,
+    NULL::text AS detail
+FROM
+    document_version_signatures s
+INNER JOIN document_versions dv
+    ON dv.id = s.document_version_id
+WHERE
+    s.%s
+    AND s.signed_by_profile_id = @profile_id
+    AND s.state = 'REQUESTED'
+ORDER BY
+    s.requested_at ASC,
+    s.id ASC
+`
+
+	return items.load(ctx, conn, scope, q, pgx.StrictNamedArgs{"profile_id": profileID})
+}
+
+// LoadUpcomingAuditsByOrganizationID loads the audits of the organization
+// that are not started or in progress.
+func (items *WeeklyDigestItems) LoadUpcomingAuditsByOrganizationID(
+	ctx context.Context,
+	conn pg.Conn,
+	scope Scoper,
+	organizationID gid.GID,
+) error {
+	q := `
+SELECT
+    a.id,
+    COALESCE(a.name, f.name) AS title,
+    a.valid_from AS date,
+    lower(replace(a.state::text, '_', ' ')) AS detail
+FROM
+    audits a
+INNER JOIN frameworks f
+    ON f.id = a.framework_id
+WHERE
+    a.%s
+    AND a.organization_id = @organization_id
+    AND a.state IN ('NOT_STARTED', 'IN_PROGRESS')
+ORDER BY
+    a.valid_from ASC NULLS LAST,
+    a.id ASC
+`
+
+	return items.load(ctx, conn, scope, q, pgx.StrictNamedArgs{"organization_id": organizationID})
+}
+
+// LoadRisingRisksByProfileID loads the risks owned by the profile whose
+// residual score is higher than before their first update since the
+// given time, as recorded in the audit log.
+func (items *WeeklyDigestItems) LoadRisingRisksByProfileID(
+	ctx context.Context,
+	conn pg.Conn,
+	scope Scoper,
+	profileID gid.GID,
+	since time.Time,
+) error {
+	q := `
+SELECT
+    r.id,
+    r.name AS title,
+    NULL::timestamptz AS date,
+    format('%%s → %%s', previous.score, r.residual_risk_score) AS detail
+FROM
+    risks r
+INNER JOIN LATERAL (
+    SELECT
+        (e.changes -> 'residualRiskScore' ->> 'before')::integer AS score
+    FROM
+        audit_log_entries e
+    WHERE
+        e.resource_id = r.id
+        AND e.action = 'core:risk:update'
+        AND e.created_at >= @since
+        AND e.changes ? 'residualRiskScore'
+    ORDER BY
+        e.created_at ASC
+    LIMIT 1
+) previous ON TRUE
+WHERE
+    r.%s
+    AND r.owner_profile_id = @profile_id
+    AND r.snapshot_id IS NULL
+    AND r.residual_risk_score > previous.score
+ORDER BY
+    r.residual_risk_score DESC,
+    r.id ASC
+`
+
+	return items.load(ctx, conn, scope, q, pgx.StrictNamedArgs{"profile_id": profileID, "since": since})
+}
+
+func (items *WeeklyDigestItems) load(
+	ctx context.Context,
+	conn pg.Conn,
+	scope Scoper,
+	q string,
+	args pgx.StrictNamedArgs,
+) error {
+	q = fmt.Sprintf(q, scope.SQLFragment())
+	maps.Copy(args, scope.SQLArguments())
+
+	rows, err := conn.Query(ctx, q, args)
+	if err != nil {
+		return fmt.Errorf("cannot query weekly digest items: %w", err)
+	}
+
+	result, err := pgx.CollectRows(rows, pgx.RowToAddrOfStructByName[WeeklyDigestItem])
+	if err != nil {
+		return fmt.Errorf("cannot collect weekly digest items: %w", err)
+	}
+
+	*items = result
+
+	return nil
+}
@@ -0,0 +1,334 @@
+// Copyright (c) 2025 Probo Inc <hello@getprobo.com>.
+//
+// Permission to use, copy, modify, and/or distribute this software for any
+// purpose with or without fee is hereby granted, provided that the above
+// copyright notice and this permission notice appear in all copies.
+//
+// THE SOFTWARE IS PROVIDED "AS IS" AND THE AUTHOR DISCLAIMS ALL WARRANTIES WITH
+// REGARD TO THIS SOFTWARE INCLUDING ALL IMPLIED WARRANTIES OF MERCHANTABILITY
+// AND FITNESS. IN NO EVENT SHALL THE AUTHOR BE LIABLE FOR ANY SPECIAL, DIRECT,
+// INDIRECT, OR CONSEQUENTIAL DAMAGES OR ANY DAMAGES WHATSOEVER RESULTING FROM
+// LOSS OF USE, DATA OR PROFITS, WHETHER IN AN ACTION OF CONTRACT, NEGLIGENCE OR
+// OTHER TORTIOUS ACTION, ARISING OUT OF OR IN CONNECTION WITH THE USE OR
+// PERFORMANCE OF THIS SOFTWARE.
+
+// Package docdiff computes the differences between two versions of the
+// markdown content of a document.
+//
+// Contents are split in blocks, separated by blank lines, which are
+// matched with a longest common subsequence. A deleted block followed by
+// an inserted block sharing enough words with it is reported as a single
+// modified block, along with the word by word differences between them.
+package docdiff
+
+import (
+	"strings"
+	"unicode"
+)
+
+type (
+	Operation string
+
+	// Block is a block of the content that is unchanged, inserted,
+	// deleted or modified. Before is empty for inserted blocks, After is
+	// empty for deleted blocks, and Words is only set for modified
+	// blocks.
+	Block struct {
+		Operation Operation
+		Before    string
+		After     string
+		Words     []Word
+	}
+
+	// Word is a run of consecutive words and spaces of a modified block
+	// that are unchanged, inserted or deleted.
+	Word struct {
+		Operation Operation
+		Text      string
+	}
+
+	edit struct {
+		operation Operation
+		before    int
+		after     int
+	}
+)
+
+const (
+	OperationEqual    Operation = "EQUAL"
+	OperationInserted Operation = "INSERTED"
+	OperationDeleted  Operation = "DELETED"
+	OperationModified Operation = "MODIFIED"
+)
+
+const (
+	// maxCells bounds the size of the longest common subsequence table.
+	// Larger inputs are reported as entirely replaced.
+	maxCells = 4_000_000
+
+	// minSimilarity is the minimum share of unchanged words for a
+	// deleted and an inserted block to be reported as a modified block.
+	minSimilarity = 0.4
+)
+
+// Diff returns the blocks of before and after in order, along with how
+// each of them changed.
+func Diff(before, after string) []Block {
+	beforeBlocks := SplitBlocks(before)
+	afterBlocks := SplitBlocks(after)
+
+	var (
+		blocks   []Block
+		deleted  []string
+		inserted []string
+	)
+
+	flush := func() {
+		blocks = append(blocks, pairBlocks(deleted, inserted)...)
+		deleted = nil
+		inserted = nil
+	}
+
+	for _, e := range diff(beforeBlocks, afterBlocks) {
+		switch e.operation {
+		case OperationDeleted:
+			deleted = append(deleted, beforeBlocks[e.before])
+		case OperationInserted:
+			inserted = append(inserted, afterBlocks[e.after])
+		default:
+			flush()
+			blocks = append(
+				blocks,
+				Block{
+					Operation: OperationEqual,
+					Before:    beforeBlocks[e.before],
+					After:     afterBlocks[e.after],
+				},
+			)
+		}
+	}
+	flush()
+
+	return blocks
+}
+
+// Words returns the word by word differences between two texts.
+func Words(before, after string) []Word {
+	beforeTokens := splitWords(before)
+	afterTokens := splitWords(after)
+
+	var words []Word
+	for _, e := range diff(beforeTokens, afterTokens) {
+		var text string
+		if e.operation == OperationInserted {
+			text = afterTokens[e.after]
+		} else {
+			text = beforeTokens[e.before]
+		}
+
+		if n := len(words); n > 0 && words[n-1].Operation == e.operation {
+			words[n-1].Text += text
+			continue
+		}
+
+		words = append(words, Word{Operation: e.operation, Text: text})
+	}
+
+	return words
+}
+
+// SplitBlocks splits markdown content in blocks separated by blank lines.
+// Blank lines inside fenced code blocks do not separate blocks.
+func SplitBlocks(content string) []string {
+	var (
+		blocks  []string
+		current []string
+		fence   string
+	)
+
+	flush := func() {
+		if len(current) > 0 {
+			blocks = append(blocks, strings.Join(current, "\n"))
+			current = nil
+		}
+	}
+
+	for _, line := range strings.Split(strings.ReplaceAll(content, "\r\n", "\n"), "\n") {
+		line = strings.TrimRightFunc(line, unicode.IsSpace)
+		trimmed := strings.TrimSpace(line)
+
+		if fence == "" && (strings.HasPrefix(trimmed, "```") || strings.HasPrefix(trimmed, "~~~")) {
+			fence = trimmed[:3]
+		} else if fence != "" && strings.HasPrefix(trimmed, fence) {
+			fence = ""
+		} else if fence == "" && trimmed == "" {
+			flush()
+			continue
+		}
+
+		current = append(current, line)
+	}
+	flush()
+
+	return blocks
+}
+
+// pairBlocks reports a run of deleted blocks followed by a run of
+// inserted blocks, pairing them in order as modified blocks when they are
+// similar enough.
+func pairBlocks(deleted, inserted []string) []Block {
+	var blocks []Block
+
+	for i := 0; i < max(len(deleted), len(inserted)); i++ {
+		switch {
+		case i >= len(inserted):
+			blocks = append(blocks, Block{Operation: OperationDeleted, Before: deleted[i]})
+		case i >= len(deleted):
+			blocks = append(blocks, Block{Operation: OperationInserted, After: inserted[i]})
+		default:
+			words := Words(deleted[i], inserted[i])
+			if similarity(words, deleted[i], inserted[i]) < minSimilarity {
+				blocks = append(
+					blocks,
+					Block{Operation: OperationDeleted, Before: deleted[i]},
+					Block{Operation: OperationInserted, After: inserted[i]},
+				)
+				continue
+			}
+
+			blocks = append(
+				blocks,
+				Block{
+					Operation: OperationModified,
+					Before:    deleted[i],
+					After:     inserted[i],
+					Words:     words,
+				},
+			)
+		}
+	}
+
+	return blocks
+}
+
+func similarity(words []Word, before, after string) float64 {
+	total := len(before) + len(after)
+	if total == 0 {
+		return 1
+	}
+
+	equal := 0
+	for _, w := range words {
+		if w.Operation == OperationEqual {
+			equal += len(w.Text)
+		}
+	}
+
+	return float64(2*equal) / float64(total)
+}
+
+// splitWords splits a text in alternating runs of spaces and non-spaces,
+// so that joining them gives back the text.
+func splitWords(text string) []string {
+	var (
+		tokens []string
+		start  int
+		space  bool
+	)
+
+	for i, r := range text {
+		if i > start && unicode.IsSpace(r) != space {
+			tokens = append(tokens, text[start:i])
+			start = i
+		}
+		space = unicode.IsSpace(r)
+	}
+
+	if start < len(text) {
+		tokens = append(tokens, text[start:])
+	}
+
+	return tokens
+}
+
+// diff returns the edits turning a into b, using the longest common
+// subsequence of a and b once their common prefix and suffix are removed.
+func diff(a, b []string) []edit {
+	var edits []edit
+
+	prefix := 0
+	for prefix < len(a) && prefix < len(b) && a[prefix] == b[prefix] {
+		edits = append(edits, edit{operation: OperationEqual, before: prefix, after: prefix})
+		prefix++
+	}
+
+	suffix := 0
+	for suffix < len(a)-prefix && suffix < len(b)-prefix && a[len(a)-1-suffix] == b[len(b)-1-suffix] {
+		suffix++
+	}
+
+	edits = append(edits, lcs(a[prefix:len(a)-suffix], b[prefix:len(b)-suffix], prefix)...)
+
+	for i := suffix; i > 0; i-- {
+		edits = append(edits, edit{operation: OperationEqual, before: len(a) - i, after: len(b) - i})
+	}
+
+	return edits
+}
+
+func lcs(a, b []string, offset int) []edit {
+	n, m := len(a), len(b)
+
+	var edits []edit
+	if n*m > maxCells {
+		for i := range a {
+			edits = append(edits, edit{operation: OperationDeleted, before: offset + i})
+		}
+		for j := range b {
+			edits = append(edits, edit{operation: OperationInserted, after: offset + j})
+		}
+		return edits
+	}
+
+	// lengths[i][j] is the length of the longest common subsequence of
+	// a[i:] and b[j:].
+	lengths := make([][]int, n+1)
+	for i := range lengths {
+		lengths[i] = make([]int, m+1)
+	}
+
+	for i := n - 1; i >= 0; i-- {
+		for j := m - 1; j >= 0; j-- {
+			if a[i] == b[j] {
+				lengths[i][j] = lengths[i+1][j+1] + 1
+			} else {
+				lengths[i][j] = max(lengths[i+1][j], lengths[i][j+1])
+			}
+		}
+	}
+
+	i, j := 0, 0
+	for i < n && j < m {
+		switch {
+		case a[i] == b[j]:
+			edits = append(edits, edit{operation: OperationEqual, before: offset + i, after: offset + j})
+			i++
+			j++
+		case lengths[i+1][j] >= lengths[i][j+1]:
+			edits = append(edits, edit{operation: OperationDeleted, before: offset + i})
+			i++
+		default:
+			edits = append(edits, edit{operation: OperationInserted, after: offset + j})
+			j++
+		}
+	}
+
+	for ; i < n; i++ {
+		edits = append(edits, edit{operation: OperationDeleted, before: offset + i})
+	}
+	for ; j < m; j++ {
+		edits = append(edits, edit{operation: OperationInserted, after: offset + j})
+	}
+
+	return edits
+}
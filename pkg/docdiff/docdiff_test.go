@@ -0,0 +1,141 @@
+// Copyright (c) 2025 Probo Inc <hello@getprobo.com>.
+//
+// Permission to use, copy, modify, and/or distribute this software for any
+// purpose with or without fee is hereby granted, provided that the above
+// copyright notice and this permission notice appear in all copies.
+//
+// THE SOFTWARE IS PROVIDED "AS IS" AND THE AUTHOR DISCLAIMS ALL WARRANTIES WITH
+// REGARD TO THIS SOFTWARE INCLUDING ALL IMPLIED WARRANTIES OF MERCHANTABILITY
+// AND FITNESS. IN NO EVENT SHALL THE AUTHOR BE LIABLE FOR ANY SPECIAL, DIRECT,
+// INDIRECT, OR CONSEQUENTIAL DAMAGES OR ANY DAMAGES WHATSOEVER RESULTING FROM
+// LOSS OF USE, DATA OR PROFITS, WHETHER IN AN ACTION OF CONTRACT, NEGLIGENCE OR
+// OTHER TORTIOUS ACTION, ARISING OUT OF OR IN CONNECTION WITH THE USE OR
+// PERFORMANCE OF THIS SOFTWARE.
+
+package docdiff
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSplitBlocks(t *testing.T) {
+	t.Run("splits on blank lines", func(t *testing.T) {
+		assert.Equal(
+			t,
+			[]string{"# Title", "First paragraph\nstill first", "Second"},
+			SplitBlocks("# Title\n\nFirst paragraph\nstill first\n\n\n  \nSecond\n"),
+		)
+	})
+
+	t.Run("keeps fenced code together", func(t *testing.T) {
+		assert.Equal(
+			t,
+			[]string{"Intro", "```\na\n\nb\n```", "Outro"},
+			SplitBlocks("Intro\n\n```\na\n\nb\n```\n\nOutro"),
+		)
+	})
+
+	t.Run("empty content", func(t *testing.T) {
+		assert.Empty(t, SplitBlocks(""))
+		assert.Empty(t, SplitBlocks("\n\n  \n"))
+	})
+}
+
+func TestWords(t *testing.T) {
+	words := Words("The quick brown fox", "The slow brown fox jumps")
+
+	assert.Equal(
+		t,
+		[]Word{
+			{Operation: OperationEqual, Text: "The "},
+			{Operation: OperationDeleted, Text: "quick"},
+			{Operation: OperationInserted, Text: "slow"},
+			{Operation: OperationEqual, Text: " brown fox"},
+			{Operation: OperationInserted, Text: " jumps"},
+		},
+		words,
+	)
+}
+
+func TestDiff(t *testing.T) {
+	t.Run("identical contents", func(t *testing.T) {
+		blocks := Diff("# Policy\n\nBody", "# Policy\n\nBody")
+
+		require.Len(t, blocks, 2)
+		for _, b := range blocks {
+			assert.Equal(t, OperationEqual, b.Operation)
+			assert.Equal(t, b.Before, b.After)
+		}
+	})
+
+	t.Run("inserted and deleted blocks", func(t *testing.T) {
+		blocks := Diff(
+			"# Policy\n\nRemoved section entirely\n\nFooter",
+			"# Policy\n\nFooter\n\nAppendix",
+		)
+
+		assert.Equal(
+			t,
+			[]Block{
+				{Operation: OperationEqual, Before: "# Policy", After: "# Policy"},
+				{Operation: OperationDeleted, Before: "Removed section entirely"},
+				{Operation: OperationEqual, Before: "Footer", After: "Footer"},
+				{Operation: OperationInserted, After: "Appendix"},
+			},
+			blocks,
+		)
+	})
+
+	t.Run("modified block", func(t *testing.T) {
+		blocks := Diff(
+			"# Policy\n\nPasswords must be rotated every 90 days.",
+			"# Policy\n\nPasswords must be rotated every 180 days.",
+		)
+
+		require.Len(t, blocks, 2)
+		assert.Equal(t, OperationModified, blocks[1].Operation)
+		assert.Equal(t, "Passwords must be rotated every 90 days.", blocks[1].Before)
+		assert.Equal(t, "Passwords must be rotated every 180 days.", blocks[1].After)
+		assert.Contains(t, blocks[1].Words, Word{Operation: OperationDeleted, Text: "90"})
+		assert.Contains(t, blocks[1].Words, Word{Operation: OperationInserted, Text: "180"})
+	})
+
+	t.Run("dissimilar blocks are replaced", func(t *testing.T) {
+		blocks := Diff("Alpha beta gamma", "Completely different text here")
+
+		assert.Equal(
+			t,
+			[]Block{
+				{Operation: OperationDeleted, Before: "Alpha beta gamma"},
+				{Operation: OperationInserted, After: "Completely different text here"},
+			},
+			blocks,
+		)
+	})
+
+	t.Run("words rebuild both sides", func(t *testing.T) {
+		before := "Access reviews happen quarterly for all production systems."
+		after := "Access reviews happen monthly for all critical production systems."
+
+		blocks := Diff(before, after)
+		require.Len(t, blocks, 1)
+		require.Equal(t, OperationModified, blocks[0].Operation)
+
+		var b, a strings.Builder
+		for _, w := range blocks[0].Words {
+			if w.Operation != OperationInserted {
+				b.WriteString(w.Text)
+			}
+			if w.Operation != OperationDeleted {
+				a.WriteString(w.Text)
+			}
+		}
+
+		assert.Equal(t, before, b.String())
+		assert.Equal(t, after, a.String())
+	})
+}
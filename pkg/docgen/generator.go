@@ -27,6 +27,7 @@ import (
 	"github.com/yuin/goldmark/extension"
 	gmhtml "github.com/yuin/goldmark/renderer/html"
 	"go.probo.inc/probo/pkg/coredata"
+	"go.probo.inc/probo/pkg/docdiff"
 )
 
 var (
@@ -45,6 +46,12 @@ var (
 	//go:embed soa_template.html
 	soaTemplateContent string
 
+	//go:embed signature_certificate_template.html
+	signatureCertificateTemplateContent string
+
+	//go:embed document_diff_template.html
+	documentDiffTemplateContent string
+
 	templateFuncs = template.FuncMap{
 		"now":                  func() time.Time { return time.Now() },
 		"eq":                   func(a, b any) bool { return a == b },
@@ -71,18 +78,26 @@ var (
 			return "No"
 		},
 		"formatContent": func(content string) template.HTML {
-			md := goldmark.New(
-				goldmark.WithExtensions(extension.Table),
-				goldmark.WithRendererOptions(
-					gmhtml.WithUnsafe(),
-				),
-			)
-
-			var buf bytes.Buffer
-			if err := md.Convert([]byte(content), &buf); err != nil {
+			rendered, err := RenderContentHTML(content)
+			if err != nil {
+				return template.HTML(fmt.Sprintf("<p>%s</p>", html.EscapeString(content)))
+			}
+			return template.HTML(rendered)
+		},
+		"formatDiffBlock": func(block docdiff.Block) template.HTML {
+			content := block.After
+			switch block.Operation {
+			case docdiff.OperationDeleted:
+				content = block.Before
+			case docdiff.OperationModified:
+				content = redlineContent(block.Words)
+			}
+
+			rendered, err := RenderContentHTML(content)
+			if err != nil {
 				return template.HTML(fmt.Sprintf("<p>%s</p>", html.EscapeString(content)))
 			}
-			return template.HTML(buf.String())
+			return template.HTML(rendered)
 		},
 		"imgTag": func(src, alt, class string) template.HTML {
 			return template.HTML(fmt.Sprintf(`<img src="%s" alt="%s" class="%s">`, html.EscapeString(src), html.EscapeString(alt), html.EscapeString(class)))
@@ -194,6 +209,10 @@ var (
 	transferImpactAssessmentsTemplate = template.Must(template.New("transferImpactAssessments").Funcs(templateFuncs).Parse(transferImpactAssessmentsTemplateContent))
 
 	stateOfApplicabilityTemplate = template.Must(template.New("state-of-applicability").Funcs(templateFuncs).Parse(soaTemplateContent))
+
+	signatureCertificateTemplate = template.Must(template.New("signature-certificate").Funcs(templateFuncs).Parse(signatureCertificateTemplateContent))
+
+	documentDiffTemplate = template.Must(template.New("document-diff").Funcs(templateFuncs).Parse(documentDiffTemplateContent))
 )
 
 type (
@@ -300,6 +319,44 @@ type (
 		Controls      []ControlData
 	}
 
+	SignatureCertificateData struct {
+		Title                       string
+		OrganizationName            string
+		Version                     int
+		PublishedAt                 *time.Time
+		ContentHash                 string
+		GenesisHash                 string
+		ChainValid                  bool
+		ChainError                  string
+		GeneratedAt                 time.Time
+		Signers                     []SignatureCertificateSignerData
+		CompanyHorizontalLogoBase64 string
+	}
+
+	SignatureCertificateSignerData struct {
+		Sequence     int
+		FullName     string
+		EmailAddress string
+		IPAddress    string
+		UserAgent    string
+		AuthMethod   string
+		SignedAt     time.Time
+		ContentHash  string
+		PreviousHash string
+		Hash         string
+		Entry        string
+	}
+
+	DocumentDiffData struct {
+		Title                       string
+		OrganizationName            string
+		FromVersion                 int
+		ToVersion                   int
+		GeneratedAt                 time.Time
+		Blocks                      []docdiff.Block
+		CompanyHorizontalLogoBase64 string
+	}
+
 	ControlData struct {
 		FrameworkName  string
 		SectionTitle   string
@@ -329,6 +386,25 @@ func RenderHTML(data DocumentData) ([]byte, error) {
 	return buf.Bytes(), nil
 }
 
+// RenderContentHTML renders the markdown content of a document version.
+// The output only depends on the content, so it can be hashed to bind a
+// signature to what the signer was shown.
+func RenderContentHTML(content string) ([]byte, error) {
+	md := goldmark.New(
+		goldmark.WithExtensions(extension.Table),
+		goldmark.WithRendererOptions(
+			gmhtml.WithUnsafe(),
+		),
+	)
+
+	var buf bytes.Buffer
+	if err := md.Convert([]byte(content), &buf); err != nil {
+		return nil, fmt.Errorf("cannot convert content: %w", err)
+	}
+
+	return buf.Bytes(), nil
+}
+
 func RenderProcessingActivitiesTableHTML(data ProcessingActivityTableData) ([]byte, error) {
 	var buf bytes.Buffer
 	if err := processingActivitiesTemplate.Execute(&buf, data); err != nil {
@@ -364,3 +440,44 @@ func RenderStateOfApplicabilityHTML(data StateOfApplicabilityData) ([]byte, erro
 
 	return buf.Bytes(), nil
 }
+
+func RenderSignatureCertificateHTML(data SignatureCertificateData) ([]byte, error) {
+	var buf bytes.Buffer
+	if err := signatureCertificateTemplate.Execute(&buf, data); err != nil {
+		return nil, fmt.Errorf("cannot execute signature certificate template: %w", err)
+	}
+
+	return buf.Bytes(), nil
+}
+
+func RenderDocumentDiffHTML(data DocumentDiffData) ([]byte, error) {
+	var buf bytes.Buffer
+	if err := documentDiffTemplate.Execute(&buf, data); err != nil {
+		return nil, fmt.Errorf("cannot execute document diff template: %w", err)
+	}
+
+	return buf.Bytes(), nil
+}
+
+// redlineContent rebuilds the markdown of a modified block with its
+// deleted and inserted words wrapped in del and ins tags, so that the
+// markdown structure shared by both versions is still rendered.
+func redlineContent(words []docdiff.Word) string {
+	var b strings.Builder
+	for _, w := range words {
+		switch {
+		case strings.TrimSpace(w.Text) == "" && w.Operation != docdiff.OperationEqual:
+			if w.Operation == docdiff.OperationInserted {
+				b.WriteString(w.Text)
+			}
+		case w.Operation == docdiff.OperationDeleted:
+			b.WriteString("<del>" + w.Text + "</del>")
+		case w.Operation == docdiff.OperationInserted:
+			b.WriteString("<ins>" + w.Text + "</ins>")
+		default:
+			b.WriteString(w.Text)
+		}
+	}
+
+	return b.String()
+}
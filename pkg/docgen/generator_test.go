@@ -23,6 +23,7 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"go.probo.inc/probo/pkg/coredata"
+	"go.probo.inc/probo/pkg/docdiff"
 )
 
 func TestRenderHTML(t *testing.T) {
@@ -377,6 +378,85 @@ func TestLargeContent(t *testing.T) {
 	assert.True(t, len(result) > 10000) // Should be reasonably large
 }
 
+func TestRenderContentHTML(t *testing.T) {
+	first, err := RenderContentHTML("# Policy\n\n| A | B |\n|---|---|\n| 1 | 2 |")
+	require.NoError(t, err)
+	second, err := RenderContentHTML("# Policy\n\n| A | B |\n|---|---|\n| 1 | 2 |")
+	require.NoError(t, err)
+
+	assert.Equal(t, first, second)
+	assert.Contains(t, string(first), "<h1>Policy</h1>")
+	assert.Contains(t, string(first), "<table>")
+}
+
+func TestRenderSignatureCertificateHTML(t *testing.T) {
+	signedAt := time.Date(2026, 2, 21, 10, 0, 0, 0, time.UTC)
+
+	data := SignatureCertificateData{
+		Title:            "Security Policy",
+		OrganizationName: "Acme <Inc>",
+		Version:          2,
+		ContentHash:      "abc123",
+		GenesisHash:      "000000",
+		ChainValid:       false,
+		ChainError:       "hash mismatch",
+		GeneratedAt:      signedAt,
+		Signers: []SignatureCertificateSignerData{
+			{
+				Sequence:     1,
+				FullName:     "Alice Smith",
+				EmailAddress: "alice@example.com",
+				IPAddress:    "192.0.2.1",
+				AuthMethod:   "PASSWORD",
+				SignedAt:     signedAt,
+				ContentHash:  "abc123",
+				PreviousHash: "000000",
+				Hash:         "def456",
+				Entry:        `{"sequence":1}`,
+			},
+		},
+	}
+
+	result, err := RenderSignatureCertificateHTML(data)
+	require.NoError(t, err)
+
+	html := string(result)
+	assert.Contains(t, html, "Certificate of Completion")
+	assert.Contains(t, html, "Acme &lt;Inc&gt;")
+	assert.Contains(t, html, "Alice Smith")
+	assert.Contains(t, html, "192.0.2.1")
+	assert.Contains(t, html, "def456")
+	assert.Contains(t, html, "Broken: hash mismatch")
+	assert.Contains(t, html, "February 21, 2026 10:00:00 UTC")
+}
+
+func TestRenderDocumentDiffHTML(t *testing.T) {
+	data := DocumentDiffData{
+		Title:            "Security Policy",
+		OrganizationName: "Acme",
+		FromVersion:      1,
+		ToVersion:        2,
+		GeneratedAt:      time.Date(2026, 2, 21, 10, 0, 0, 0, time.UTC),
+		Blocks: docdiff.Diff(
+			"# Scope\n\nPasswords must be rotated every **90** days.\n\nObsolete paragraph",
+			"# Scope\n\nPasswords must be rotated every **180** days.\n\n| A |\n|---|\n| B |",
+		),
+	}
+
+	result, err := RenderDocumentDiffHTML(data)
+	require.NoError(t, err)
+
+	html := string(result)
+	assert.Contains(t, html, "1 &rarr; 2")
+	assert.Contains(t, html, "<h1>Scope</h1>")
+	assert.Contains(t, html, "<del><strong>90</strong></del>")
+	assert.Contains(t, html, "<ins><strong>180</strong></ins>")
+	assert.Contains(t, html, `class="block block-deleted"`)
+	assert.Contains(t, html, "<p>Obsolete paragraph</p>")
+	assert.Contains(t, html, `class="block block-inserted"`)
+	assert.Contains(t, html, "<table>")
+}
+
 func BenchmarkGenerateHTML(b *testing.B) {
 	now := time.Now()
 
@@ -22,6 +22,7 @@ import (
 
 	"go.gearno.de/kit/pg"
 	"go.probo.inc/probo/packages/emails"
+	"go.probo.inc/probo/pkg/auditlog"
 	"go.probo.inc/probo/pkg/coredata"
 	"go.probo.inc/probo/pkg/gid"
 	"go.probo.inc/probo/pkg/mail"
@@ -107,6 +108,8 @@ func (s AccountService) ChangeEmail(ctx context.Context, identityID gid.GID, req
 				return NewInvalidPasswordError("invalid password")
 			}
 
+			before := identityAuditState(identity)
+
 			identity.EmailAddress = req.NewEmail
 			identity.EmailAddressVerified = false
 			identity.UpdatedAt = time.Now()
@@ -116,6 +119,10 @@ func (s AccountService) ChangeEmail(ctx context.Context, identityID gid.GID, req
 				return fmt.Errorf("cannot update identity: %w", err)
 			}
 
+			if err := recordIdentityAuditLog(ctx, tx, identity.ID, ActionIdentityUpdate, identity.ID, before, identityAuditState(identity)); err != nil {
+				return fmt.Errorf("cannot record audit log entry: %w", err)
+			}
+
 			emailPresenter := emails.NewPresenter(s.fm, s.bucket, s.baseURL, identity.FullName)
 
 			subject, textBody, htmlBody, err := emailPresenter.RenderConfirmEmail(ctx, "/auth/verify-email", confirmationToken)
@@ -168,6 +175,8 @@ func (s AccountService) VerifyEmail(ctx context.Context, token string) error {
 				return NewEmailAlreadyVerifiedError()
 			}
 
+			before := identityAuditState(identity)
+
 			identity.EmailAddressVerified = true
 			identity.UpdatedAt = time.Now()
 
@@ -176,6 +185,10 @@ func (s AccountService) VerifyEmail(ctx context.Context, token string) error {
 				return fmt.Errorf("cannot update identity: %w", err)
 			}
 
+			if err := recordIdentityAuditLog(ctx, tx, identity.ID, ActionIdentityUpdate, identity.ID, before, identityAuditState(identity)); err != nil {
+				return fmt.Errorf("cannot record audit log entry: %w", err)
+			}
+
 			return nil
 		},
 	)
@@ -280,6 +293,8 @@ func (s *AccountService) AcceptInvitation(
 				}
 			}
 
+			before := invitationAuditState(invitation)
+
 			invitation.AcceptedAt = &now
 			if err := invitation.Update(ctx, tx, scope); err != nil {
 				if err == coredata.ErrResourceNotFound {
@@ -289,6 +304,12 @@ func (s *AccountService) AcceptInvitation(
 				return fmt.Errorf("cannot update invitation: %w", err)
 			}
 
+			invitation.Status = coredata.InvitationStatusAccepted
+
+			if err := auditlog.Record(ctx, tx, scope, invitation.OrganizationID, ActionInvitationAccept, invitation.ID, before, invitationAuditState(invitation)); err != nil {
+				return fmt.Errorf("cannot record audit log entry: %w", err)
+			}
+
 			// Expire other pending invitations for email in organization
 			invitations := &coredata.Invitations{}
 			onlyPending := coredata.NewInvitationFilter([]coredata.InvitationStatus{coredata.InvitationStatusPending})
@@ -477,6 +498,13 @@ func (s AccountService) ChangePassword(ctx context.Context, identityID gid.GID,
 				return fmt.Errorf("cannot update identity: %w", err)
 			}
 
+			after := identityAuditState(identity)
+			after["passwordChanged"] = true
+
+			if err := recordIdentityAuditLog(ctx, tx, identity.ID, ActionIdentityUpdate, identity.ID, identityAuditState(identity), after); err != nil {
+				return fmt.Errorf("cannot record audit log entry: %w", err)
+			}
+
 			// TODO: email to notify identity that their password has been changed
 
 			return nil
@@ -707,6 +735,10 @@ func (s *AccountService) CreatePersonalAPIKey(
 				return fmt.Errorf("cannot insert personal api key: %w", err)
 			}
 
+			if err := recordIdentityAuditLog(ctx, tx, identityID, ActionPersonalAPIKeyCreate, personalAPIKey.ID, nil, personalAPIKeyAuditState(personalAPIKey)); err != nil {
+				return fmt.Errorf("cannot record audit log entry: %w", err)
+			}
+
 			token, err = securetoken.Sign(
 				personalAPIKey.ID.String(),
 				s.tokenSecret,
@@ -753,6 +785,10 @@ func (s *AccountService) DeletePersonalAPIKey(
 				return fmt.Errorf("cannot delete personal api key: %w", err)
 			}
 
+			if err := recordIdentityAuditLog(ctx, tx, identityID, ActionPersonalAPIKeyDelete, personalAPIKey.ID, personalAPIKeyAuditState(personalAPIKey), nil); err != nil {
+				return fmt.Errorf("cannot record audit log entry: %w", err)
+			}
+
 			return nil
 		},
 	)
@@ -909,3 +945,54 @@ func (s AccountService) CountSAMLConfigurationsForEmail(
 
 	return count, nil
 }
+
+func (s AccountService) ListOIDCConfigurationsForEmail(
+	ctx context.Context,
+	email mail.Addr,
+) (coredata.OIDCConfigurations, error) {
+	oidcConfigurations := coredata.OIDCConfigurations{}
+
+	err := s.pg.WithConn(
+		ctx,
+		func(conn pg.Conn) error {
+			err := oidcConfigurations.LoadVerifiedByEmailDomain(ctx, conn, email.Domain())
+			if err != nil {
+				return fmt.Errorf("cannot load oidc configurations: %w", err)
+			}
+
+			return nil
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return oidcConfigurations, nil
+}
+
+func (s AccountService) CountOIDCConfigurationsForEmail(
+	ctx context.Context,
+	email mail.Addr,
+) (int, error) {
+	var (
+		count              int
+		oidcConfigurations coredata.OIDCConfigurations
+	)
+
+	err := s.pg.WithConn(
+		ctx,
+		func(conn pg.Conn) (err error) {
+			count, err = oidcConfigurations.CountVerifiedByEmailDomain(ctx, conn, email.Domain())
+			if err != nil {
+				return fmt.Errorf("cannot count oidc configurations: %w", err)
+			}
+
+			return nil
+		},
+	)
+	if err != nil {
+		return 0, err
+	}
+
+	return count, nil
+}
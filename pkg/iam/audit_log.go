@@ -0,0 +1,181 @@
+// Copyright (c) 2025 Probo Inc <hello@getprobo.com>.
+//
+// Permission to use, copy, modify, and/or distribute this software for any
+// purpose with or without fee is hereby granted, provided that the above
+// copyright notice and this permission notice appear in all copies.
+//
+// THE SOFTWARE IS PROVIDED "AS IS" AND THE AUTHOR DISCLAIMS ALL WARRANTIES WITH
+// REGARD TO THIS SOFTWARE INCLUDING ALL IMPLIED WARRANTIES OF MERCHANTABILITY
+// AND FITNESS. IN NO EVENT SHALL THE AUTHOR BE LIABLE FOR ANY SPECIAL, DIRECT,
+// INDIRECT, OR CONSEQUENTIAL DAMAGES OR ANY DAMAGES WHATSOEVER RESULTING FROM
+// LOSS OF USE, DATA OR PROFITS, WHETHER IN AN ACTION OF CONTRACT, NEGLIGENCE OR
+// OTHER TORTIOUS ACTION, ARISING OUT OF OR IN CONNECTION WITH THE USE OR
+// PERFORMANCE OF THIS SOFTWARE.
+
+package iam
+
+import (
+	"context"
+	"fmt"
+
+	"go.gearno.de/kit/pg"
+	"go.probo.inc/probo/pkg/auditlog"
+	"go.probo.inc/probo/pkg/coredata"
+	"go.probo.inc/probo/pkg/gid"
+)
+
+// The audit log states below only expose the fields an organization
+// administrator needs to follow a change; secrets and tokens are left
+// out on purpose.
+
+func organizationAuditState(organization *coredata.Organization) map[string]any {
+	return map[string]any{
+		"name":                 organization.Name,
+		"description":          organization.Description,
+		"websiteUrl":           organization.WebsiteURL,
+		"email":                organization.Email,
+		"headquarterAddress":   organization.HeadquarterAddress,
+		"logoFileId":           organization.LogoFileID,
+		"horizontalLogoFileId": organization.HorizontalLogoFileID,
+		"mfaRequired":          organization.MFARequired,
+	}
+}
+
+func membershipAuditState(membership *coredata.Membership) map[string]any {
+	return map[string]any{
+		"identityId":   membership.IdentityID,
+		"emailAddress": membership.EmailAddress,
+		"role":         membership.Role,
+		"source":       membership.Source,
+		"state":        membership.State,
+	}
+}
+
+func invitationAuditState(invitation *coredata.Invitation) map[string]any {
+	return map[string]any{
+		"email":     invitation.Email,
+		"fullName":  invitation.FullName,
+		"role":      invitation.Role,
+		"status":    invitation.Status,
+		"expiresAt": invitation.ExpiresAt,
+	}
+}
+
+func samlConfigurationAuditState(config *coredata.SAMLConfiguration) map[string]any {
+	return map[string]any{
+		"emailDomain":        config.EmailDomain,
+		"enforcementPolicy":  config.EnforcementPolicy,
+		"idpEntityId":        config.IdPEntityID,
+		"idpSsoUrl":          config.IdPSsoURL,
+		"idpCertificate":     config.IdPCertificate,
+		"attributeEmail":     config.AttributeEmail,
+		"attributeFirstname": config.AttributeFirstname,
+		"attributeLastname":  config.AttributeLastname,
+		"attributeRole":      config.AttributeRole,
+		"autoSignupEnabled":  config.AutoSignupEnabled,
+	}
+}
+
+func oidcConfigurationAuditState(config *coredata.OIDCConfiguration) map[string]any {
+	return map[string]any{
+		"emailDomain":       config.EmailDomain,
+		"enforcementPolicy": config.EnforcementPolicy,
+		"issuerUrl":         config.IssuerURL,
+		"clientId":          config.ClientID,
+		"autoSignupEnabled": config.AutoSignupEnabled,
+	}
+}
+
+func scimConfigurationAuditState(config *coredata.SCIMConfiguration) map[string]any {
+	return map[string]any{
+		"organizationId": config.OrganizationID,
+	}
+}
+
+func scimBridgeAuditState(bridge *coredata.SCIMBridge) map[string]any {
+	return map[string]any{
+		"scimConfigurationId": bridge.ScimConfigurationID,
+		"connectorId":         bridge.ConnectorID,
+		"type":                bridge.Type,
+		"state":               bridge.State,
+		"excludedUserNames":   bridge.ExcludedUserNames,
+	}
+}
+
+func membershipProfileAuditState(profile *coredata.MembershipProfile) map[string]any {
+	return map[string]any{
+		"fullName":                 profile.FullName,
+		"kind":                     profile.Kind,
+		"additionalEmailAddresses": profile.AdditionalEmailAddresses,
+		"position":                 profile.Position,
+		"contractStartDate":        profile.ContractStartDate,
+		"contractEndDate":          profile.ContractEndDate,
+	}
+}
+
+func scimGroupRoleMappingAuditState(mapping *coredata.SCIMGroupRoleMapping) map[string]any {
+	return map[string]any{
+		"groupDisplayName": mapping.GroupDisplayName,
+		"role":             mapping.Role,
+	}
+}
+
+func customRoleAuditState(customRole *coredata.CustomRole) map[string]any {
+	return map[string]any{
+		"name":        customRole.Name,
+		"description": customRole.Description,
+		"policy":      customRole.Policy,
+	}
+}
+
+func identityAuditState(identity *coredata.Identity) map[string]any {
+	return map[string]any{
+		"fullName":             identity.FullName,
+		"emailAddress":         identity.EmailAddress,
+		"emailAddressVerified": identity.EmailAddressVerified,
+	}
+}
+
+func personalAPIKeyAuditState(personalAPIKey *coredata.PersonalAPIKey) map[string]any {
+	return map[string]any{
+		"name":      personalAPIKey.Name,
+		"expiresAt": personalAPIKey.ExpiresAt,
+	}
+}
+
+func webAuthnCredentialAuditState(credential *coredata.WebAuthnCredential) map[string]any {
+	return map[string]any{
+		"name": credential.Name,
+	}
+}
+
+// recordIdentityAuditLog records a mutation of a resource owned by an
+// identity rather than an organization in the audit log of every
+// organization the identity is an active member of.
+func recordIdentityAuditLog(
+	ctx context.Context,
+	conn pg.Conn,
+	identityID gid.GID,
+	action string,
+	resourceID gid.GID,
+	before any,
+	after any,
+) error {
+	var memberships coredata.Memberships
+	if err := memberships.LoadAllByIdentityID(ctx, conn, identityID); err != nil {
+		return fmt.Errorf("cannot load memberships: %w", err)
+	}
+
+	for _, membership := range memberships {
+		if membership.State != coredata.MembershipStateActive {
+			continue
+		}
+
+		scope := coredata.NewScopeFromObjectID(membership.OrganizationID)
+		if err := auditlog.Record(ctx, conn, scope, membership.OrganizationID, action, resourceID, before, after); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
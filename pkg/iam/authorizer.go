@@ -16,6 +16,7 @@ package iam
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"maps"
@@ -38,15 +39,39 @@ type AuthorizeParams struct {
 	Principal          gid.GID
 	Resource           gid.GID
 	Session            *gid.GID
+	APIKey             *gid.GID
 	Action             string
 	ResourceAttributes map[string]string
 }
 
+// AuthorizationSimulation explains the decision of an authorization
+// request.
+type AuthorizationSimulation struct {
+	// Allowed is the decision Authorize would have made.
+	Allowed bool
+
+	// Trace is the evaluation of the identity policies.
+	Trace policy.EvaluationTrace
+
+	// APIKeyTrace is the evaluation of the custom role restricting the
+	// personal API key, if any.
+	APIKeyTrace *policy.EvaluationTrace
+}
+
+// evaluation holds the request and the policies an authorization request
+// is evaluated against.
+type evaluation struct {
+	request      policy.AuthorizationRequest
+	policies     []*policy.Policy
+	apiKeyPolicy *policy.Policy
+}
+
 // Authorizer evaluates authorization requests against registered policies.
 type Authorizer struct {
 	pg        *pg.Client
 	evaluator *policy.Evaluator
 	policySet *PolicySet
+	actions   *policy.ActionRegistry
 }
 
 // NewAuthorizer creates a new Authorizer instance.
@@ -55,12 +80,39 @@ func NewAuthorizer(pgClient *pg.Client) *Authorizer {
 		pg:        pgClient,
 		evaluator: policy.NewEvaluator(),
 		policySet: NewPolicySet(),
+		actions:   policy.NewActionRegistry(),
 	}
 }
 
 // RegisterPolicySet merges the given policy set into the authorizer.
 func (a *Authorizer) RegisterPolicySet(ps *PolicySet) {
 	a.policySet.Merge(ps)
+
+	for _, action := range ps.Actions {
+		if a.actions.Exists(policy.Action(action)) {
+			continue
+		}
+
+		service, resource, operation, err := policy.ParseAction(policy.Action(action))
+		if err != nil {
+			panic(fmt.Errorf("cannot register action: %w", err))
+		}
+
+		a.actions.MustRegister(
+			policy.ActionDefinition{
+				Action:    policy.Action(action),
+				Service:   service,
+				Resource:  resource,
+				Operation: operation,
+			},
+		)
+	}
+}
+
+// Actions returns the registry of the actions exposed by the registered
+// policy sets.
+func (a *Authorizer) Actions() *policy.ActionRegistry {
+	return a.actions
 }
 
 // Authorize checks if the principal is allowed to perform the action on the resource.
@@ -73,9 +125,67 @@ func (a *Authorizer) Authorize(ctx context.Context, params AuthorizeParams) erro
 }
 
 func (a *Authorizer) authorize(ctx context.Context, conn pg.Conn, params AuthorizeParams) error {
+	ev, err := a.prepareEvaluation(ctx, conn, params)
+	if err != nil {
+		return err
+	}
+
+	if !a.evaluator.Evaluate(ev.request, ev.policies).IsAllowed() {
+		return NewInsufficientPermissionsError(params.Principal, params.Resource, params.Action)
+	}
+
+	if ev.apiKeyPolicy != nil && !a.evaluator.Evaluate(ev.request, []*policy.Policy{ev.apiKeyPolicy}).IsAllowed() {
+		return NewInsufficientPermissionsError(params.Principal, params.Resource, params.Action)
+	}
+
+	return nil
+}
+
+// Simulate evaluates the request like Authorize without enforcing the
+// decision, and returns how every policy statement was evaluated. The
+// organization assumption of the session is not checked.
+func (a *Authorizer) Simulate(ctx context.Context, params AuthorizeParams) (*AuthorizationSimulation, error) {
+	if params.Principal.EntityType() != coredata.IdentityEntityType {
+		return nil, NewUnsupportedPrincipalTypeError(params.Principal.EntityType())
+	}
+
+	params.Session = nil
+
+	var simulation *AuthorizationSimulation
+
+	err := a.pg.WithConn(
+		ctx,
+		func(conn pg.Conn) error {
+			ev, err := a.prepareEvaluation(ctx, conn, params)
+			if err != nil {
+				return err
+			}
+
+			simulation = &AuthorizationSimulation{
+				Trace: a.evaluator.Trace(ev.request, ev.policies),
+			}
+			simulation.Allowed = simulation.Trace.Result.IsAllowed()
+
+			if ev.apiKeyPolicy != nil {
+				apiKeyTrace := a.evaluator.Trace(ev.request, []*policy.Policy{ev.apiKeyPolicy})
+				simulation.APIKeyTrace = &apiKeyTrace
+				simulation.Allowed = simulation.Allowed && apiKeyTrace.Result.IsAllowed()
+			}
+
+			return nil
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return simulation, nil
+}
+
+func (a *Authorizer) prepareEvaluation(ctx context.Context, conn pg.Conn, params AuthorizeParams) (*evaluation, error) {
 	resourceAttrs, err := a.buildResourceAttributes(ctx, conn, params)
 	if err != nil {
-		return fmt.Errorf("cannot build resource attributes: %w", err)
+		return nil, fmt.Errorf("cannot build resource attributes: %w", err)
 	}
 
 	resourceOrgID := resourceAttrs["organization_id"]
@@ -83,7 +193,7 @@ func (a *Authorizer) authorize(ctx context.Context, conn pg.Conn, params Authori
 	// Find role for resource's organization
 	memberships, err := a.loadMemberships(ctx, conn, params.Principal)
 	if err != nil {
-		return fmt.Errorf("cannot load memberships for principal: %w", err)
+		return nil, fmt.Errorf("cannot load memberships for principal: %w", err)
 	}
 	membership := findMembershipForOrg(memberships, resourceOrgID)
 
@@ -99,10 +209,10 @@ func (a *Authorizer) authorize(ctx context.Context, conn pg.Conn, params Authori
 			var errSessionExpired *ErrSessionExpired
 
 			if errors.As(err, &errSessionNotFound) || errors.As(err, &errSessionExpired) {
-				return NewAssumptionRequiredError(params.Principal, membership.ID)
+				return nil, NewAssumptionRequiredError(params.Principal, membership.ID)
 			}
 
-			return fmt.Errorf("cannot get active child session for membership: %w", err)
+			return nil, fmt.Errorf("cannot get active child session for membership: %w", err)
 		}
 	}
 
@@ -122,11 +232,22 @@ func (a *Authorizer) authorize(ctx context.Context, conn pg.Conn, params Authori
 
 	principalAttrs, err := a.buildPrincipalAttributes(ctx, conn, params.Principal, scopedPrincipalAttrs)
 	if err != nil {
-		return fmt.Errorf("cannot build principal attributes: %w", err)
+		return nil, fmt.Errorf("cannot build principal attributes: %w", err)
 	}
 
 	policies := a.buildPoliciesForRole(role)
 
+	if membership != nil {
+		customRolePolicy, err := a.loadMembershipCustomRolePolicy(ctx, conn, membership)
+		if err != nil {
+			return nil, fmt.Errorf("cannot load membership custom role policy: %w", err)
+		}
+
+		if customRolePolicy != nil {
+			policies = append(policies, customRolePolicy)
+		}
+	}
+
 	req := policy.AuthorizationRequest{
 		Principal: params.Principal,
 		Resource:  params.Resource,
@@ -137,11 +258,21 @@ func (a *Authorizer) authorize(ctx context.Context, conn pg.Conn, params Authori
 		},
 	}
 
-	if a.evaluator.Evaluate(req, policies).IsAllowed() {
-		return nil
+	ev := &evaluation{
+		request:  req,
+		policies: policies,
 	}
 
-	return NewInsufficientPermissionsError(params.Principal, params.Resource, params.Action)
+	// A personal API key restricted by a custom role can only perform the
+	// actions allowed by both the identity and the custom role.
+	if membership != nil && params.APIKey != nil {
+		ev.apiKeyPolicy, err = a.loadPersonalAPIKeyCustomRolePolicy(ctx, conn, *params.APIKey, membership)
+		if err != nil {
+			return nil, fmt.Errorf("cannot load personal api key custom role policy: %w", err)
+		}
+	}
+
+	return ev, nil
 }
 
 func (a *Authorizer) loadMemberships(ctx context.Context, conn pg.Conn, principalID gid.GID) (coredata.Memberships, error) {
@@ -241,6 +372,64 @@ func (a *Authorizer) buildPoliciesForRole(role string) []*policy.Policy {
 	return policies
 }
 
+func (a *Authorizer) loadMembershipCustomRolePolicy(
+	ctx context.Context,
+	conn pg.Conn,
+	membership *coredata.Membership,
+) (*policy.Policy, error) {
+	var (
+		scope      = coredata.NewScopeFromObjectID(membership.OrganizationID)
+		customRole = &coredata.CustomRole{}
+	)
+
+	if err := customRole.LoadByMembershipID(ctx, conn, scope, membership.ID); err != nil {
+		if errors.Is(err, coredata.ErrResourceNotFound) {
+			return nil, nil
+		}
+
+		return nil, fmt.Errorf("cannot load custom role: %w", err)
+	}
+
+	return customRolePolicy(customRole)
+}
+
+func (a *Authorizer) loadPersonalAPIKeyCustomRolePolicy(
+	ctx context.Context,
+	conn pg.Conn,
+	apiKeyID gid.GID,
+	membership *coredata.Membership,
+) (*policy.Policy, error) {
+	var (
+		scope      = coredata.NewScopeFromObjectID(membership.OrganizationID)
+		customRole = &coredata.CustomRole{}
+	)
+
+	if err := customRole.LoadByPersonalAPIKeyIDAndOrganizationID(ctx, conn, scope, apiKeyID, membership.OrganizationID); err != nil {
+		if errors.Is(err, coredata.ErrResourceNotFound) {
+			return nil, nil
+		}
+
+		return nil, fmt.Errorf("cannot load custom role: %w", err)
+	}
+
+	return customRolePolicy(customRole)
+}
+
+// customRolePolicy converts the custom role document into a policy
+// confined to the custom role organization.
+func customRolePolicy(customRole *coredata.CustomRole) (*policy.Policy, error) {
+	var document policy.Document
+	if err := json.Unmarshal(customRole.Policy, &document); err != nil {
+		return nil, fmt.Errorf("cannot decode custom role policy document: %w", err)
+	}
+
+	return document.Policy(
+		"custom-role:"+customRole.ID.String(),
+		customRole.Name,
+		policy.Equals("principal.organization_id", "resource.organization_id"),
+	), nil
+}
+
 func findMembershipForOrg(memberships coredata.Memberships, orgID string) *coredata.Membership {
 	for _, m := range memberships {
 		if m.OrganizationID.String() == orgID && m.State == coredata.MembershipStateActive {
@@ -0,0 +1,537 @@
+// Copyright (c) 2025 Probo Inc <hello@getprobo.com>.
+//
+// Permission to use, copy, modify, and/or distribute this software for any
+// purpose with or without fee is hereby granted, provided that the above
+// copyright notice and this permission notice appear in all copies.
+//
+// THE SOFTWARE IS PROVIDED "AS IS" AND THE AUTHOR DISCLAIMS ALL WARRANTIES WITH
+// REGARD TO THIS SOFTWARE INCLUDING ALL IMPLIED WARRANTIES OF MERCHANTABILITY
+// AND FITNESS. IN NO EVENT SHALL THE AUTHOR BE LIABLE FOR ANY SPECIAL, DIRECT,
+// INDIRECT, OR CONSEQUENTIAL DAMAGES OR ANY DAMAGES WHATSOEVER RESULTING FROM
+// LOSS OF USE, DATA OR PROFITS, WHETHER IN AN ACTION OF CONTRACT, NEGLIGENCE OR
+// OTHER TORTIOUS ACTION, ARISING OUT OF OR IN CONNECTION WITH THE USE OR
+// PERFORMANCE OF THIS SOFTWARE.
+
+package iam
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"go.gearno.de/kit/pg"
+	"go.probo.inc/probo/pkg/auditlog"
+	"go.probo.inc/probo/pkg/coredata"
+	"go.probo.inc/probo/pkg/gid"
+	"go.probo.inc/probo/pkg/iam/policy"
+	"go.probo.inc/probo/pkg/page"
+	"go.probo.inc/probo/pkg/validator"
+)
+
+type (
+	CustomRoleService struct {
+		*Service
+	}
+
+	CreateCustomRoleRequest struct {
+		OrganizationID gid.GID
+		Name           string
+		Description    *string
+		Policy         json.RawMessage
+	}
+
+	UpdateCustomRoleRequest struct {
+		ID          gid.GID
+		Name        *string
+		Description **string
+		Policy      json.RawMessage
+	}
+)
+
+func (req *CreateCustomRoleRequest) Validate() error {
+	v := validator.New()
+
+	v.Check(req.OrganizationID, "organization_id", validator.Required(), validator.GID(coredata.OrganizationEntityType))
+	v.Check(req.Name, "name", validator.Required(), validator.SafeTextNoNewLine(NameMaxLength))
+	v.Check(req.Description, "description", validator.SafeText(ContentMaxLength))
+
+	return v.Error()
+}
+
+func (req *UpdateCustomRoleRequest) Validate() error {
+	v := validator.New()
+
+	v.Check(req.ID, "id", validator.Required(), validator.GID(coredata.CustomRoleEntityType))
+	v.Check(req.Name, "name", validator.SafeTextNoNewLine(NameMaxLength))
+	v.Check(req.Description, "description", validator.SafeText(ContentMaxLength))
+
+	return v.Error()
+}
+
+func NewCustomRoleService(svc *Service) *CustomRoleService {
+	return &CustomRoleService{Service: svc}
+}
+
+// parsePolicy checks the document against the actions registered on the
+// authorizer and returns it in its canonical form.
+func (s *CustomRoleService) parsePolicy(data json.RawMessage) (json.RawMessage, error) {
+	document, err := policy.ParseDocument(data, s.Authorizer.Actions())
+	if err != nil {
+		return nil, NewInvalidCustomRolePolicyError(err)
+	}
+
+	canonical, err := json.Marshal(document)
+	if err != nil {
+		return nil, fmt.Errorf("cannot encode policy document: %w", err)
+	}
+
+	return canonical, nil
+}
+
+func (s *CustomRoleService) Get(ctx context.Context, customRoleID gid.GID) (*coredata.CustomRole, error) {
+	var (
+		scope      = coredata.NewScopeFromObjectID(customRoleID)
+		customRole = &coredata.CustomRole{}
+	)
+
+	err := s.pg.WithConn(
+		ctx,
+		func(conn pg.Conn) error {
+			if err := customRole.LoadByID(ctx, conn, scope, customRoleID); err != nil {
+				if errors.Is(err, coredata.ErrResourceNotFound) {
+					return NewCustomRoleNotFoundError(customRoleID)
+				}
+
+				return fmt.Errorf("cannot load custom role: %w", err)
+			}
+
+			return nil
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return customRole, nil
+}
+
+// GetForMembership returns the custom role assigned to the membership, or
+// nil when the membership only has its built-in role.
+func (s *CustomRoleService) GetForMembership(ctx context.Context, membershipID gid.GID) (*coredata.CustomRole, error) {
+	var (
+		scope      = coredata.NewScopeFromObjectID(membershipID)
+		customRole = &coredata.CustomRole{}
+	)
+
+	err := s.pg.WithConn(
+		ctx,
+		func(conn pg.Conn) error {
+			if err := customRole.LoadByMembershipID(ctx, conn, scope, membershipID); err != nil {
+				if errors.Is(err, coredata.ErrResourceNotFound) {
+					customRole = nil
+					return nil
+				}
+
+				return fmt.Errorf("cannot load custom role: %w", err)
+			}
+
+			return nil
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return customRole, nil
+}
+
+func (s *CustomRoleService) List(
+	ctx context.Context,
+	organizationID gid.GID,
+	cursor *page.Cursor[coredata.CustomRoleOrderField],
+) (*page.Page[*coredata.CustomRole, coredata.CustomRoleOrderField], error) {
+	var (
+		scope       = coredata.NewScopeFromObjectID(organizationID)
+		customRoles coredata.CustomRoles
+	)
+
+	err := s.pg.WithConn(
+		ctx,
+		func(conn pg.Conn) error {
+			if err := customRoles.LoadByOrganizationID(ctx, conn, scope, organizationID, cursor); err != nil {
+				return fmt.Errorf("cannot load custom roles: %w", err)
+			}
+
+			return nil
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return page.NewPage(customRoles, cursor), nil
+}
+
+func (s *CustomRoleService) Count(ctx context.Context, organizationID gid.GID) (int, error) {
+	var (
+		scope = coredata.NewScopeFromObjectID(organizationID)
+		count int
+	)
+
+	err := s.pg.WithConn(
+		ctx,
+		func(conn pg.Conn) (err error) {
+			customRoles := coredata.CustomRoles{}
+			count, err = customRoles.CountByOrganizationID(ctx, conn, scope, organizationID)
+			if err != nil {
+				return fmt.Errorf("cannot count custom roles: %w", err)
+			}
+
+			return nil
+		},
+	)
+	if err != nil {
+		return 0, err
+	}
+
+	return count, nil
+}
+
+func (s *CustomRoleService) Create(ctx context.Context, req *CreateCustomRoleRequest) (*coredata.CustomRole, error) {
+	if err := req.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid request: %w", err)
+	}
+
+	document, err := s.parsePolicy(req.Policy)
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		now        = time.Now()
+		scope      = coredata.NewScopeFromObjectID(req.OrganizationID)
+		customRole = &coredata.CustomRole{
+			ID:             gid.New(req.OrganizationID.TenantID(), coredata.CustomRoleEntityType),
+			OrganizationID: req.OrganizationID,
+			Name:           req.Name,
+			Description:    req.Description,
+			Policy:         document,
+			CreatedAt:      now,
+			UpdatedAt:      now,
+		}
+	)
+
+	err = s.pg.WithTx(
+		ctx,
+		func(tx pg.Conn) error {
+			organization := &coredata.Organization{}
+			if err := organization.LoadByID(ctx, tx, scope, req.OrganizationID); err != nil {
+				return fmt.Errorf("cannot load organization: %w", err)
+			}
+
+			if err := customRole.Insert(ctx, tx, scope); err != nil {
+				if errors.Is(err, coredata.ErrResourceAlreadyExists) {
+					return NewCustomRoleNameAlreadyExistsError(req.Name)
+				}
+
+				return fmt.Errorf("cannot insert custom role: %w", err)
+			}
+
+			if err := auditlog.Record(ctx, tx, scope, customRole.OrganizationID, ActionCustomRoleCreate, customRole.ID, nil, customRoleAuditState(customRole)); err != nil {
+				return fmt.Errorf("cannot record audit log entry: %w", err)
+			}
+
+			return nil
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return customRole, nil
+}
+
+func (s *CustomRoleService) Update(ctx context.Context, req *UpdateCustomRoleRequest) (*coredata.CustomRole, error) {
+	if err := req.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid request: %w", err)
+	}
+
+	var document json.RawMessage
+	if req.Policy != nil {
+		var err error
+		document, err = s.parsePolicy(req.Policy)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	var (
+		scope      = coredata.NewScopeFromObjectID(req.ID)
+		customRole = &coredata.CustomRole{}
+	)
+
+	err := s.pg.WithTx(
+		ctx,
+		func(tx pg.Conn) error {
+			if err := customRole.LoadByID(ctx, tx, scope, req.ID); err != nil {
+				if errors.Is(err, coredata.ErrResourceNotFound) {
+					return NewCustomRoleNotFoundError(req.ID)
+				}
+
+				return fmt.Errorf("cannot load custom role: %w", err)
+			}
+
+			before := customRoleAuditState(customRole)
+
+			if req.Name != nil {
+				customRole.Name = *req.Name
+			}
+
+			if req.Description != nil {
+				customRole.Description = *req.Description
+			}
+
+			if document != nil {
+				customRole.Policy = document
+			}
+
+			customRole.UpdatedAt = time.Now()
+
+			if err := customRole.Update(ctx, tx, scope); err != nil {
+				if errors.Is(err, coredata.ErrResourceAlreadyExists) {
+					return NewCustomRoleNameAlreadyExistsError(customRole.Name)
+				}
+
+				return fmt.Errorf("cannot update custom role: %w", err)
+			}
+
+			if err := auditlog.Record(ctx, tx, scope, customRole.OrganizationID, ActionCustomRoleUpdate, customRole.ID, before, customRoleAuditState(customRole)); err != nil {
+				return fmt.Errorf("cannot record audit log entry: %w", err)
+			}
+
+			return nil
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return customRole, nil
+}
+
+// Delete removes the custom role. Memberships and personal API keys it was
+// assigned to fall back to their built-in permissions.
+func (s *CustomRoleService) Delete(ctx context.Context, customRoleID gid.GID) error {
+	scope := coredata.NewScopeFromObjectID(customRoleID)
+
+	return s.pg.WithTx(
+		ctx,
+		func(tx pg.Conn) error {
+			customRole := &coredata.CustomRole{}
+			if err := customRole.LoadByID(ctx, tx, scope, customRoleID); err != nil {
+				if errors.Is(err, coredata.ErrResourceNotFound) {
+					return NewCustomRoleNotFoundError(customRoleID)
+				}
+
+				return fmt.Errorf("cannot load custom role: %w", err)
+			}
+
+			if err := customRole.Delete(ctx, tx, scope); err != nil {
+				return fmt.Errorf("cannot delete custom role: %w", err)
+			}
+
+			if err := auditlog.Record(ctx, tx, scope, customRole.OrganizationID, ActionCustomRoleDelete, customRole.ID, customRoleAuditState(customRole), nil); err != nil {
+				return fmt.Errorf("cannot record audit log entry: %w", err)
+			}
+
+			return nil
+		},
+	)
+}
+
+// AssignToMembership grants the custom role permissions to the membership
+// on top of its built-in role. A nil custom role removes the assignment.
+func (s *CustomRoleService) AssignToMembership(
+	ctx context.Context,
+	membershipID gid.GID,
+	customRoleID *gid.GID,
+) (*coredata.Membership, error) {
+	var (
+		scope      = coredata.NewScopeFromObjectID(membershipID)
+		membership = &coredata.Membership{}
+	)
+
+	err := s.pg.WithTx(
+		ctx,
+		func(tx pg.Conn) error {
+			if err := membership.LoadByID(ctx, tx, scope, membershipID); err != nil {
+				if errors.Is(err, coredata.ErrResourceNotFound) {
+					return NewMembershipNotFoundError(membershipID)
+				}
+
+				return fmt.Errorf("cannot load membership: %w", err)
+			}
+
+			before, err := s.membershipCustomRoleID(ctx, tx, scope, membershipID)
+			if err != nil {
+				return err
+			}
+
+			assignment := &coredata.MembershipCustomRole{}
+
+			if customRoleID == nil {
+				if err := assignment.DeleteByMembershipID(ctx, tx, scope, membershipID); err != nil {
+					return fmt.Errorf("cannot delete membership custom role: %w", err)
+				}
+			} else {
+				customRole := &coredata.CustomRole{}
+				if err := customRole.LoadByID(ctx, tx, scope, *customRoleID); err != nil {
+					if errors.Is(err, coredata.ErrResourceNotFound) {
+						return NewCustomRoleNotFoundError(*customRoleID)
+					}
+
+					return fmt.Errorf("cannot load custom role: %w", err)
+				}
+
+				if customRole.OrganizationID != membership.OrganizationID {
+					return NewCustomRoleNotFoundError(*customRoleID)
+				}
+
+				assignment.MembershipID = membershipID
+				assignment.CustomRoleID = customRole.ID
+				assignment.CreatedAt = time.Now()
+
+				if err := assignment.Upsert(ctx, tx, scope); err != nil {
+					return fmt.Errorf("cannot upsert membership custom role: %w", err)
+				}
+			}
+
+			if err := auditlog.Record(
+				ctx,
+				tx,
+				scope,
+				membership.OrganizationID,
+				ActionCustomRoleAssign,
+				membership.ID,
+				map[string]any{"customRoleId": before},
+				map[string]any{"customRoleId": customRoleID},
+			); err != nil {
+				return fmt.Errorf("cannot record audit log entry: %w", err)
+			}
+
+			return nil
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return membership, nil
+}
+
+// AssignToPersonalAPIKey restricts the personal API key to the custom role
+// permissions within the organization. A nil custom role lifts the
+// restriction.
+func (s *CustomRoleService) AssignToPersonalAPIKey(
+	ctx context.Context,
+	personalAPIKeyID gid.GID,
+	organizationID gid.GID,
+	customRoleID *gid.GID,
+) (*coredata.PersonalAPIKey, error) {
+	var (
+		scope  = coredata.NewScopeFromObjectID(organizationID)
+		apiKey = &coredata.PersonalAPIKey{}
+	)
+
+	err := s.pg.WithTx(
+		ctx,
+		func(tx pg.Conn) error {
+			if err := apiKey.LoadByID(ctx, tx, personalAPIKeyID); err != nil {
+				if errors.Is(err, coredata.ErrResourceNotFound) {
+					return NewPersonalAPIKeyNotFoundError(personalAPIKeyID)
+				}
+
+				return fmt.Errorf("cannot load personal api key: %w", err)
+			}
+
+			membership := &coredata.Membership{}
+			if err := membership.LoadByIdentityInOrganization(ctx, tx, apiKey.IdentityID, organizationID); err != nil {
+				if errors.Is(err, coredata.ErrResourceNotFound) {
+					return NewMembershipNotFoundError(organizationID)
+				}
+
+				return fmt.Errorf("cannot load membership: %w", err)
+			}
+
+			assignment := &coredata.PersonalAPIKeyCustomRole{}
+
+			if customRoleID == nil {
+				if err := assignment.DeleteByPersonalAPIKeyIDAndOrganizationID(ctx, tx, scope, personalAPIKeyID, organizationID); err != nil {
+					return fmt.Errorf("cannot delete personal api key custom role: %w", err)
+				}
+			} else {
+				customRole := &coredata.CustomRole{}
+				if err := customRole.LoadByID(ctx, tx, scope, *customRoleID); err != nil {
+					if errors.Is(err, coredata.ErrResourceNotFound) {
+						return NewCustomRoleNotFoundError(*customRoleID)
+					}
+
+					return fmt.Errorf("cannot load custom role: %w", err)
+				}
+
+				if customRole.OrganizationID != organizationID {
+					return NewCustomRoleNotFoundError(*customRoleID)
+				}
+
+				assignment.PersonalAPIKeyID = personalAPIKeyID
+				assignment.OrganizationID = organizationID
+				assignment.CustomRoleID = customRole.ID
+				assignment.CreatedAt = time.Now()
+
+				if err := assignment.Upsert(ctx, tx, scope); err != nil {
+					return fmt.Errorf("cannot upsert personal api key custom role: %w", err)
+				}
+			}
+
+			if err := auditlog.Record(
+				ctx,
+				tx,
+				scope,
+				organizationID,
+				ActionCustomRoleAssign,
+				apiKey.ID,
+				nil,
+				map[string]any{"customRoleId": customRoleID},
+			); err != nil {
+				return fmt.Errorf("cannot record audit log entry: %w", err)
+			}
+
+			return nil
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return apiKey, nil
+}
+
+func (s *CustomRoleService) membershipCustomRoleID(
+	ctx context.Context,
+	conn pg.Conn,
+	scope coredata.Scoper,
+	membershipID gid.GID,
+) (*gid.GID, error) {
+	customRole := &coredata.CustomRole{}
+	if err := customRole.LoadByMembershipID(ctx, conn, scope, membershipID); err != nil {
+		if errors.Is(err, coredata.ErrResourceNotFound) {
+			return nil, nil
+		}
+
+		return nil, fmt.Errorf("cannot load membership custom role: %w", err)
+	}
+
+	return &customRole.ID, nil
+}
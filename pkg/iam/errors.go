@@ -361,6 +361,49 @@ func (e ErrSAMLConfigurationEmailDomainAlreadyExists) Error() string {
 	return fmt.Sprintf("SAML configuration email domain %q already exists", e.EmailDomain)
 }
 
+type ErrOIDCConfigurationNotFound struct{ ConfigID gid.GID }
+
+func NewOIDCConfigurationNotFoundError(configID gid.GID) error {
+	return &ErrOIDCConfigurationNotFound{ConfigID: configID}
+}
+
+func (e ErrOIDCConfigurationNotFound) Error() string {
+	return fmt.Sprintf("OIDC configuration %q not found", e.ConfigID)
+}
+
+type ErrOIDCConfigurationDomainNotVerified struct{ ConfigID gid.GID }
+
+func NewOIDCConfigurationDomainNotVerifiedError(configID gid.GID) error {
+	return &ErrOIDCConfigurationDomainNotVerified{ConfigID: configID}
+}
+
+func (e ErrOIDCConfigurationDomainNotVerified) Error() string {
+	return fmt.Sprintf("OIDC configuration %q domain not verified", e.ConfigID)
+}
+
+type ErrOIDCConfigurationEmailDomainAlreadyExists struct{ EmailDomain string }
+
+func NewOIDCConfigurationEmailDomainAlreadyExistsError(emailDomain string) error {
+	return &ErrOIDCConfigurationEmailDomainAlreadyExists{EmailDomain: emailDomain}
+}
+
+func (e ErrOIDCConfigurationEmailDomainAlreadyExists) Error() string {
+	return fmt.Sprintf("OIDC configuration email domain %q already exists", e.EmailDomain)
+}
+
+type ErrOIDCAuthenticationRequired struct {
+	Reason      string
+	RedirectURL string
+}
+
+func NewOIDCAuthenticationRequiredError(reason string, redirectURL string) *ErrOIDCAuthenticationRequired {
+	return &ErrOIDCAuthenticationRequired{Reason: reason, RedirectURL: redirectURL}
+}
+
+func (e *ErrOIDCAuthenticationRequired) Error() string {
+	return fmt.Sprintf("OIDC authentication required: %s", e.Reason)
+}
+
 type ErrNoSCIMConfigurationFound struct{ OrganizationID gid.GID }
 
 func NewNoSCIMConfigurationFoundError(organizationID gid.GID) error {
@@ -381,6 +424,26 @@ func (e ErrSCIMBridgeNotFound) Error() string {
 	return fmt.Sprintf("SCIM bridge %q not found", e.BridgeID)
 }
 
+type ErrSCIMGroupRoleMappingNotFound struct{ MappingID gid.GID }
+
+func NewSCIMGroupRoleMappingNotFoundError(mappingID gid.GID) error {
+	return &ErrSCIMGroupRoleMappingNotFound{MappingID: mappingID}
+}
+
+func (e ErrSCIMGroupRoleMappingNotFound) Error() string {
+	return fmt.Sprintf("SCIM group role mapping %q not found", e.MappingID)
+}
+
+type ErrSCIMGroupRoleMappingAlreadyExists struct{ GroupDisplayName string }
+
+func NewSCIMGroupRoleMappingAlreadyExistsError(groupDisplayName string) error {
+	return &ErrSCIMGroupRoleMappingAlreadyExists{GroupDisplayName: groupDisplayName}
+}
+
+func (e ErrSCIMGroupRoleMappingAlreadyExists) Error() string {
+	return fmt.Sprintf("SCIM group role mapping for group %q already exists", e.GroupDisplayName)
+}
+
 type ErrConnectorNotFound struct{ ConnectorID gid.GID }
 
 func NewConnectorNotFoundError(connectorID gid.GID) error {
@@ -390,3 +453,134 @@ func NewConnectorNotFoundError(connectorID gid.GID) error {
 func (e ErrConnectorNotFound) Error() string {
 	return fmt.Sprintf("connector %q not found", e.ConnectorID)
 }
+
+type ErrCustomRoleNotFound struct{ CustomRoleID gid.GID }
+
+func NewCustomRoleNotFoundError(customRoleID gid.GID) error {
+	return &ErrCustomRoleNotFound{CustomRoleID: customRoleID}
+}
+
+func (e ErrCustomRoleNotFound) Error() string {
+	return fmt.Sprintf("custom role %q not found", e.CustomRoleID)
+}
+
+type ErrCustomRoleNameAlreadyExists struct{ Name string }
+
+func NewCustomRoleNameAlreadyExistsError(name string) error {
+	return &ErrCustomRoleNameAlreadyExists{Name: name}
+}
+
+func (e ErrCustomRoleNameAlreadyExists) Error() string {
+	return fmt.Sprintf("custom role %q already exists", e.Name)
+}
+
+type ErrInvalidCustomRolePolicy struct{ Err error }
+
+func NewInvalidCustomRolePolicyError(err error) error {
+	return &ErrInvalidCustomRolePolicy{Err: err}
+}
+
+func (e ErrInvalidCustomRolePolicy) Error() string {
+	return e.Err.Error()
+}
+
+func (e ErrInvalidCustomRolePolicy) Unwrap() error {
+	return e.Err
+}
+
+type ErrResourceNotInOrganization struct {
+	ResourceID     gid.GID
+	OrganizationID gid.GID
+}
+
+func NewResourceNotInOrganizationError(resourceID gid.GID, organizationID gid.GID) error {
+	return &ErrResourceNotInOrganization{ResourceID: resourceID, OrganizationID: organizationID}
+}
+
+func (e ErrResourceNotInOrganization) Error() string {
+	return fmt.Sprintf("resource %q not found in organization %q", e.ResourceID, e.OrganizationID)
+}
+
+type ErrUnknownAction struct {
+	Action string
+}
+
+func NewUnknownActionError(action string) error {
+	return &ErrUnknownAction{Action: action}
+}
+
+func (e ErrUnknownAction) Error() string {
+	return fmt.Sprintf("unknown action %q", e.Action)
+}
+
+type ErrMFARequired struct {
+	Reason string
+}
+
+func NewMFARequiredError(reason string) *ErrMFARequired {
+	return &ErrMFARequired{Reason: reason}
+}
+
+func (e *ErrMFARequired) Error() string {
+	return fmt.Sprintf("multi-factor authentication required: %s", e.Reason)
+}
+
+type ErrInvalidMFACode struct{ message string }
+
+func NewInvalidMFACodeError() error {
+	return &ErrInvalidMFACode{"invalid multi-factor authentication code"}
+}
+
+func (e ErrInvalidMFACode) Error() string {
+	return e.message
+}
+
+type ErrTOTPAlreadyEnrolled struct{ IdentityID gid.GID }
+
+func NewTOTPAlreadyEnrolledError(identityID gid.GID) error {
+	return &ErrTOTPAlreadyEnrolled{IdentityID: identityID}
+}
+
+func (e ErrTOTPAlreadyEnrolled) Error() string {
+	return fmt.Sprintf("identity %q already has a TOTP factor", e.IdentityID)
+}
+
+type ErrTOTPNotEnrolled struct{ IdentityID gid.GID }
+
+func NewTOTPNotEnrolledError(identityID gid.GID) error {
+	return &ErrTOTPNotEnrolled{IdentityID: identityID}
+}
+
+func (e ErrTOTPNotEnrolled) Error() string {
+	return fmt.Sprintf("identity %q has no pending or active TOTP factor", e.IdentityID)
+}
+
+type ErrMFANotEnrolled struct{ IdentityID gid.GID }
+
+func NewMFANotEnrolledError(identityID gid.GID) error {
+	return &ErrMFANotEnrolled{IdentityID: identityID}
+}
+
+func (e ErrMFANotEnrolled) Error() string {
+	return fmt.Sprintf("identity %q has no second factor", e.IdentityID)
+}
+
+type ErrWebAuthnCredentialNotFound struct{ CredentialID gid.GID }
+
+func NewWebAuthnCredentialNotFoundError(credentialID gid.GID) error {
+	return &ErrWebAuthnCredentialNotFound{CredentialID: credentialID}
+}
+
+func (e ErrWebAuthnCredentialNotFound) Error() string {
+	return fmt.Sprintf("webauthn credential %q not found", e.CredentialID)
+}
+
+type ErrWebAuthnCredentialAlreadyExists struct{ message string }
+
+func NewWebAuthnCredentialAlreadyExistsError() error {
+	return &ErrWebAuthnCredentialAlreadyExists{"webauthn credential already registered"}
+}
+
+func (e ErrWebAuthnCredentialAlreadyExists) Error() string {
+	return e.message
+}
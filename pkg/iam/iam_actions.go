@@ -29,6 +29,11 @@ const (
 	ActionIdentityUpdate = "iam:identity:update"
 	ActionIdentityDelete = "iam:identity:delete"
 
+	// Multi-factor authentication actions
+	ActionIdentityMFAGet           = "iam:identity-mfa:get"
+	ActionIdentityMFAUpdate        = "iam:identity-mfa:update"
+	ActionWebAuthnCredentialDelete = "iam:webauthn-credential:delete"
+
 	// Session actions
 	ActionSessionList      = "iam:session:list"
 	ActionSessionGet       = "iam:session:get"
@@ -56,6 +61,8 @@ const (
 	ActionMembershipProfileList   = "iam:membership-profile:list"
 	ActionMembershipProfileUpdate = "iam:membership-profile:update"
 
+	ActionMembershipProfileNotificationPreferencesUpdate = "iam:membership-profile:update-notification-preferences"
+
 	// Personal API Key actions
 	ActionPersonalAPIKeyCreate = "iam:personal-api-key:create"
 	ActionPersonalAPIKeyGet    = "iam:personal-api-key:get"
@@ -70,6 +77,13 @@ const (
 	ActionSAMLConfigurationDelete = "iam:saml-configuration:delete"
 	ActionSAMLConfigurationList   = "iam:saml-configuration:list"
 
+	// OIDC Configuration actions
+	ActionOIDCConfigurationCreate = "iam:oidc-configuration:create"
+	ActionOIDCConfigurationGet    = "iam:oidc-configuration:get"
+	ActionOIDCConfigurationUpdate = "iam:oidc-configuration:update"
+	ActionOIDCConfigurationDelete = "iam:oidc-configuration:delete"
+	ActionOIDCConfigurationList   = "iam:oidc-configuration:list"
+
 	// SCIM Configuration actions
 	ActionSCIMConfigurationCreate = "iam:scim-configuration:create"
 	ActionSCIMConfigurationGet    = "iam:scim-configuration:get"
@@ -86,6 +100,133 @@ const (
 	ActionSCIMBridgeUpdate = "iam:scim-bridge:update"
 	ActionSCIMBridgeDelete = "iam:scim-bridge:delete"
 
+	// SCIM Group Role Mapping actions
+	ActionSCIMGroupRoleMappingCreate = "iam:scim-group-role-mapping:create"
+	ActionSCIMGroupRoleMappingGet    = "iam:scim-group-role-mapping:get"
+	ActionSCIMGroupRoleMappingUpdate = "iam:scim-group-role-mapping:update"
+	ActionSCIMGroupRoleMappingDelete = "iam:scim-group-role-mapping:delete"
+	ActionSCIMGroupRoleMappingList   = "iam:scim-group-role-mapping:list"
+
 	// Connector actions
 	ActionConnectorGet = "iam:connector:get"
+
+	// Custom role actions
+	ActionCustomRoleCreate = "iam:custom-role:create"
+	ActionCustomRoleGet    = "iam:custom-role:get"
+	ActionCustomRoleList   = "iam:custom-role:list"
+	ActionCustomRoleUpdate = "iam:custom-role:update"
+	ActionCustomRoleDelete = "iam:custom-role:delete"
+	ActionCustomRoleAssign = "iam:custom-role:assign"
+
+	// Authorization actions
+	ActionAuthorizationSimulate = "iam:authorization:simulate"
 )
+
+// IAMActions returns every action of the IAM service, used to validate
+// custom role policy documents.
+func IAMActions() []Action {
+	return []Action{
+		// Organization actions
+		ActionOrganizationCreate,
+		ActionOrganizationGet,
+		ActionOrganizationUpdate,
+		ActionOrganizationDelete,
+		ActionOrganizationList,
+
+		// Identity actions
+		ActionIdentityGet,
+		ActionIdentityUpdate,
+		ActionIdentityDelete,
+
+		// Multi-factor authentication actions
+		ActionIdentityMFAGet,
+		ActionIdentityMFAUpdate,
+		ActionWebAuthnCredentialDelete,
+
+		// Session actions
+		ActionSessionList,
+		ActionSessionGet,
+		ActionSessionRevoke,
+		ActionSessionRevokeAll,
+
+		// Invitation actions
+		ActionInvitationList,
+		ActionInvitationCreate,
+		ActionInvitationGet,
+		ActionInvitationAccept,
+		ActionInvitationDelete,
+
+		// Membership actions
+		ActionMembershipGet,
+		ActionMembershipList,
+		ActionMembershipUpdate,
+		ActionMembershipDelete,
+
+		// Membership role actions
+		ActionMembershipRoleSetOwner,
+
+		// Membership Profile actions
+		ActionMembershipProfileGet,
+		ActionMembershipProfileList,
+		ActionMembershipProfileUpdate,
+		ActionMembershipProfileNotificationPreferencesUpdate,
+
+		// Personal API Key actions
+		ActionPersonalAPIKeyCreate,
+		ActionPersonalAPIKeyGet,
+		ActionPersonalAPIKeyList,
+		ActionPersonalAPIKeyUpdate,
+		ActionPersonalAPIKeyDelete,
+
+		// SAML Configuration actions
+		ActionSAMLConfigurationCreate,
+		ActionSAMLConfigurationGet,
+		ActionSAMLConfigurationUpdate,
+		ActionSAMLConfigurationDelete,
+		ActionSAMLConfigurationList,
+
+		// OIDC Configuration actions
+		ActionOIDCConfigurationCreate,
+		ActionOIDCConfigurationGet,
+		ActionOIDCConfigurationUpdate,
+		ActionOIDCConfigurationDelete,
+		ActionOIDCConfigurationList,
+
+		// SCIM Configuration actions
+		ActionSCIMConfigurationCreate,
+		ActionSCIMConfigurationGet,
+		ActionSCIMConfigurationUpdate,
+		ActionSCIMConfigurationDelete,
+
+		// SCIM Event actions
+		ActionSCIMEventList,
+		ActionSCIMEventGet,
+
+		// SCIM Bridge actions
+		ActionSCIMBridgeGet,
+		ActionSCIMBridgeCreate,
+		ActionSCIMBridgeUpdate,
+		ActionSCIMBridgeDelete,
+
+		// SCIM Group Role Mapping actions
+		ActionSCIMGroupRoleMappingCreate,
+		ActionSCIMGroupRoleMappingGet,
+		ActionSCIMGroupRoleMappingUpdate,
+		ActionSCIMGroupRoleMappingDelete,
+		ActionSCIMGroupRoleMappingList,
+
+		// Connector actions
+		ActionConnectorGet,
+
+		// Custom role actions
+		ActionCustomRoleCreate,
+		ActionCustomRoleGet,
+		ActionCustomRoleList,
+		ActionCustomRoleUpdate,
+		ActionCustomRoleDelete,
+		ActionCustomRoleAssign,
+
+		// Authorization actions
+		ActionAuthorizationSimulate,
+	}
+}
@@ -36,6 +36,15 @@ var IAMSelfManageIdentityPolicy = policy.NewPolicy(
 		WithSID("manage-own-identity").
 		When(policy.Equals("principal.id", "resource.identity_id")),
 
+	// Users can enroll and remove their own second factors
+	policy.Allow(
+		ActionIdentityMFAGet,
+		ActionIdentityMFAUpdate,
+		ActionWebAuthnCredentialDelete,
+	).
+		WithSID("manage-own-mfa").
+		When(policy.Equals("principal.id", "resource.identity_id")),
+
 	// Users can list their own memberships, invitations, sessions, and API keys
 	policy.Allow(
 		ActionMembershipList,
@@ -46,7 +55,7 @@ var IAMSelfManageIdentityPolicy = policy.NewPolicy(
 		WithSID("list-own-associations").
 		When(policy.Equals("principal.id", "resource.identity_id")),
 ).
-	WithDescription("Allows users to manage their own identity, sessions, API keys, and view their memberships")
+	WithDescription("Allows users to manage their own identity, second factors, sessions, API keys, and view their memberships")
 
 // IAMSelfManageSessionPolicy allows users to manage their own sessions.
 var IAMSelfManageSessionPolicy = policy.NewPolicy(
@@ -84,7 +93,8 @@ var IAMSelfManageInvitationPolicy = policy.NewPolicy(
 ).
 	WithDescription("Allows users to view and accept invitations sent to them")
 
-// IAMSelfManageProfilePolicy allows users to view their own profiles.
+// IAMSelfManageProfilePolicy allows users to view their own profiles and
+// manage their notification preferences.
 var IAMSelfManageProfilePolicy = policy.NewPolicy(
 	"iam:self-manage-profile",
 	"Self-Manage Profiles",
@@ -93,8 +103,13 @@ var IAMSelfManageProfilePolicy = policy.NewPolicy(
 	policy.Allow(ActionMembershipProfileGet).
 		WithSID("view-own-profiles").
 		When(policy.Equals("principal.id", "resource.identity_id")),
+
+	// Users can choose which notifications they receive
+	policy.Allow(ActionMembershipProfileNotificationPreferencesUpdate).
+		WithSID("update-own-notification-preferences").
+		When(policy.Equals("principal.id", "resource.identity_id")),
 ).
-	WithDescription("Allows users to view their organization profiles")
+	WithDescription("Allows users to view their organization profiles and manage their notification preferences")
 
 // IAMSelfManageMembershipPolicy allows users to view their own memberships.
 var IAMSelfManageMembershipPolicy = policy.NewPolicy(
@@ -187,6 +202,11 @@ var IAMOwnerPolicy = policy.NewPolicy(
 		WithSID("full-saml-access").
 		When(policy.Equals("principal.organization_id", "resource.organization_id")),
 
+	// Full access to OIDC configuration management (scoped to own organization)
+	policy.Allow("iam:oidc-configuration:*").
+		WithSID("full-oidc-access").
+		When(policy.Equals("principal.organization_id", "resource.organization_id")),
+
 	// Full access to SCIM configuration management (scoped to own organization)
 	policy.Allow("iam:scim-configuration:*").
 		WithSID("full-scim-configuration-access").
@@ -201,6 +221,21 @@ var IAMOwnerPolicy = policy.NewPolicy(
 	policy.Allow(ActionSCIMBridgeUpdate).
 		WithSID("scim-bridge-update-access").
 		When(policy.Equals("principal.organization_id", "resource.organization_id")),
+
+	// Full access to SCIM group role mappings (scoped to own organization)
+	policy.Allow("iam:scim-group-role-mapping:*").
+		WithSID("full-scim-group-role-mapping-access").
+		When(policy.Equals("principal.organization_id", "resource.organization_id")),
+
+	// Full access to custom roles management (scoped to own organization)
+	policy.Allow("iam:custom-role:*").
+		WithSID("full-custom-role-access").
+		When(policy.Equals("principal.organization_id", "resource.organization_id")),
+
+	// Can explain authorization decisions (scoped to own organization)
+	policy.Allow(ActionAuthorizationSimulate).
+		WithSID("authorization-simulation-access").
+		When(policy.Equals("principal.organization_id", "resource.organization_id")),
 ).
 	WithDescription("Full IAM access for organization owners")
 
@@ -263,6 +298,11 @@ var IAMAdminPolicy = policy.NewPolicy(
 		WithSID("saml-configuration-admin-access").
 		When(policy.Equals("principal.organization_id", "resource.organization_id")),
 
+	// Can view OIDC configurations (scoped to own organization)
+	policy.Allow(ActionOIDCConfigurationGet).
+		WithSID("oidc-configuration-admin-access").
+		When(policy.Equals("principal.organization_id", "resource.organization_id")),
+
 	// Cannot delete organization
 	policy.Deny(ActionOrganizationDelete).
 		WithSID("deny-org-delete"),
@@ -279,6 +319,14 @@ var IAMAdminPolicy = policy.NewPolicy(
 	).
 		WithSID("deny-saml-management"),
 
+	// Cannot manage OIDC configurations (only owner can)
+	policy.Deny(
+		ActionOIDCConfigurationCreate,
+		ActionOIDCConfigurationUpdate,
+		ActionOIDCConfigurationDelete,
+	).
+		WithSID("deny-oidc-management"),
+
 	// Can view SCIM configuration and events (scoped to own organization)
 	policy.Allow(
 		ActionSCIMConfigurationGet,
@@ -295,6 +343,35 @@ var IAMAdminPolicy = policy.NewPolicy(
 		ActionSCIMConfigurationDelete,
 	).
 		WithSID("deny-scim-management"),
+
+	// Can view SCIM group role mappings (scoped to own organization)
+	policy.Allow(
+		ActionSCIMGroupRoleMappingGet,
+		ActionSCIMGroupRoleMappingList,
+	).
+		WithSID("scim-group-role-mapping-admin-view-access").
+		When(policy.Equals("principal.organization_id", "resource.organization_id")),
+
+	// Cannot manage SCIM group role mappings (only owner can)
+	policy.Deny(
+		ActionSCIMGroupRoleMappingCreate,
+		ActionSCIMGroupRoleMappingUpdate,
+		ActionSCIMGroupRoleMappingDelete,
+	).
+		WithSID("deny-scim-group-role-mapping-management"),
+
+	// Can view custom roles (scoped to own organization)
+	policy.Allow(
+		ActionCustomRoleGet,
+		ActionCustomRoleList,
+	).
+		WithSID("custom-role-admin-view-access").
+		When(policy.Equals("principal.organization_id", "resource.organization_id")),
+
+	// Can explain authorization decisions (scoped to own organization)
+	policy.Allow(ActionAuthorizationSimulate).
+		WithSID("authorization-simulation-admin-access").
+		When(policy.Equals("principal.organization_id", "resource.organization_id")),
 ).
 	WithDescription("IAM admin access - can manage members but cannot delete organization or manage SAML/SCIM")
 
@@ -328,5 +405,10 @@ var IAMViewerPolicy = policy.NewPolicy(
 	policy.Allow(ActionIdentityGet).
 		WithSID("view-member-identity").
 		When(policy.Equals("principal.organization_id", "resource.organization_id")),
+
+	// Can view custom roles, e.g. to restrict their own API keys
+	policy.Allow(ActionCustomRoleGet).
+		WithSID("custom-role-viewer-access").
+		When(policy.Equals("principal.organization_id", "resource.organization_id")),
 ).
 	WithDescription("Read-only IAM access for organization viewers")
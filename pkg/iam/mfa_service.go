@@ -0,0 +1,1118 @@
+// Copyright (c) 2025 Probo Inc <hello@getprobo.com>.
+//
+// Permission to use, copy, modify, and/or distribute this software for any
+// purpose with or without fee is hereby granted, provided that the above
+// copyright notice and this permission notice appear in all copies.
+//
+// THE SOFTWARE IS PROVIDED "AS IS" AND THE AUTHOR DISCLAIMS ALL WARRANTIES WITH
+// REGARD TO THIS SOFTWARE INCLUDING ALL IMPLIED WARRANTIES OF MERCHANTABILITY
+// AND FITNESS. IN NO EVENT SHALL THE AUTHOR BE LIABLE FOR ANY SPECIAL, DIRECT,
+// INDIRECT, OR CONSEQUENTIAL DAMAGES OR ANY DAMAGES WHATSOEVER RESULTING FROM
+// LOSS OF USE, DATA OR PROFITS, WHETHER IN AN ACTION OF CONTRACT, NEGLIGENCE OR
+// OTHER TORTIOUS ACTION, ARISING OUT OF OR IN CONNECTION WITH THE USE OR
+// PERFORMANCE OF THIS SOFTWARE.
+
+package iam
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/go-webauthn/webauthn/protocol"
+	"github.com/go-webauthn/webauthn/webauthn"
+	"go.gearno.de/kit/pg"
+	"go.probo.inc/probo/pkg/coredata"
+	"go.probo.inc/probo/pkg/crypto/cipher"
+	"go.probo.inc/probo/pkg/gid"
+	"go.probo.inc/probo/pkg/statelesstoken"
+	"go.probo.inc/probo/pkg/validator"
+)
+
+type (
+	MFAService struct {
+		*Service
+		webAuthn *webauthn.WebAuthn
+	}
+
+	MFAMethod string
+
+	MFAStatus struct {
+		TOTPEnabled            bool
+		RecoveryCodesRemaining int
+		WebAuthnCredentials    coredata.WebAuthnCredentials
+	}
+
+	TOTPEnrollment struct {
+		Secret          string
+		ProvisioningURI string
+	}
+
+	// MFAChallenge is handed to a client whose password was accepted but
+	// who still has to present a second factor before a session is opened.
+	MFAChallenge struct {
+		Token           string
+		Methods         []MFAMethod
+		WebAuthnOptions json.RawMessage
+	}
+
+	WebAuthnRegistration struct {
+		Token   string
+		Options json.RawMessage
+	}
+
+	VerifyMFAChallengeRequest struct {
+		Token             string
+		TOTPCode          *string
+		RecoveryCode      *string
+		WebAuthnAssertion *string
+	}
+
+	FinishWebAuthnRegistrationRequest struct {
+		Token    string
+		Name     string
+		Response string
+	}
+
+	MFAChallengeData struct {
+		IdentityID      gid.GID               `json:"identity_id"`
+		WebAuthnSession *webauthn.SessionData `json:"webauthn_session,omitempty"`
+	}
+
+	WebAuthnRegistrationData struct {
+		IdentityID gid.GID              `json:"identity_id"`
+		Session    webauthn.SessionData `json:"session"`
+	}
+
+	webAuthnUser struct {
+		identity    *coredata.Identity
+		credentials []webauthn.Credential
+	}
+)
+
+const (
+	MFAMethodTOTP         MFAMethod = "TOTP"
+	MFAMethodWebAuthn     MFAMethod = "WEBAUTHN"
+	MFAMethodRecoveryCode MFAMethod = "RECOVERY_CODE"
+)
+
+const (
+	TokenTypeMFAChallenge         = "mfa_challenge"
+	TokenTypeWebAuthnRegistration = "webauthn_registration"
+
+	mfaChallengeTokenValidity         = 5 * time.Minute
+	webAuthnRegistrationTokenValidity = 5 * time.Minute
+
+	// mfaRecentVerificationWindow bounds how long after completing a second
+	// factor a session may remove factors or recovery codes.
+	mfaRecentVerificationWindow = 15 * time.Minute
+
+	mfaIssuer         = "Probo"
+	recoveryCodeCount = 10
+)
+
+var _ webauthn.User = (*webAuthnUser)(nil)
+
+func (u *webAuthnUser) WebAuthnID() []byte {
+	return []byte(u.identity.ID.String())
+}
+
+func (u *webAuthnUser) WebAuthnName() string {
+	return u.identity.EmailAddress.String()
+}
+
+func (u *webAuthnUser) WebAuthnDisplayName() string {
+	if u.identity.FullName != "" {
+		return u.identity.FullName
+	}
+
+	return u.identity.EmailAddress.String()
+}
+
+func (u *webAuthnUser) WebAuthnCredentials() []webauthn.Credential {
+	return u.credentials
+}
+
+func NewMFAService(svc *Service, rpID string, rpOrigin string) (*MFAService, error) {
+	wa, err := webauthn.New(
+		&webauthn.Config{
+			RPID:          rpID,
+			RPDisplayName: mfaIssuer,
+			RPOrigins:     []string{rpOrigin},
+		},
+	)
+	if err != nil {
+		return nil, fmt.Errorf("cannot create webauthn relying party: %w", err)
+	}
+
+	return &MFAService{Service: svc, webAuthn: wa}, nil
+}
+
+func (req VerifyMFAChallengeRequest) Validate() error {
+	v := validator.New()
+
+	v.Check(req.Token, "token", validator.NotEmpty())
+	v.Check(req.TOTPCode, "totpCode", validator.NotEmpty(), validator.MaxLen(totpDigits))
+	v.Check(req.RecoveryCode, "recoveryCode", validator.NotEmpty(), validator.MaxLen(64))
+	v.Check(req.WebAuthnAssertion, "webAuthnAssertion", validator.NotEmpty(), validator.MaxLen(64*1024))
+
+	factors := []*string{req.TOTPCode, req.RecoveryCode, req.WebAuthnAssertion}
+	v.Check(
+		factors,
+		"factor",
+		validator.Custom(
+			validator.ErrorCodeRequired,
+			"exactly one of totpCode, recoveryCode or webAuthnAssertion must be provided",
+			func(any) bool {
+				provided := 0
+				for _, factor := range factors {
+					if factor != nil {
+						provided++
+					}
+				}
+
+				return provided == 1
+			},
+		),
+	)
+
+	return v.Error()
+}
+
+func (req FinishWebAuthnRegistrationRequest) Validate() error {
+	v := validator.New()
+
+	v.Check(req.Token, "token", validator.NotEmpty())
+	v.Check(req.Name, "name", validator.SafeTextNoNewLine(255), validator.NotEmpty())
+	v.Check(req.Response, "response", validator.NotEmpty(), validator.MaxLen(64*1024))
+
+	return v.Error()
+}
+
+func (s *MFAService) GetMFAStatus(ctx context.Context, identityID gid.GID) (*MFAStatus, error) {
+	status := &MFAStatus{}
+
+	err := s.pg.WithConn(
+		ctx,
+		func(conn pg.Conn) error {
+			factor := &coredata.IdentityTOTPFactor{}
+			if err := factor.LoadByIdentityID(ctx, conn, identityID); err != nil {
+				if !errors.Is(err, coredata.ErrResourceNotFound) {
+					return fmt.Errorf("cannot load totp factor: %w", err)
+				}
+			} else {
+				status.TOTPEnabled = factor.IsConfirmed()
+			}
+
+			recoveryCodes := coredata.RecoveryCodes{}
+			count, err := recoveryCodes.CountUnusedByIdentityID(ctx, conn, identityID)
+			if err != nil {
+				return fmt.Errorf("cannot count recovery codes: %w", err)
+			}
+			status.RecoveryCodesRemaining = count
+
+			if err := status.WebAuthnCredentials.LoadAllByIdentityID(ctx, conn, identityID); err != nil {
+				return fmt.Errorf("cannot load webauthn credentials: %w", err)
+			}
+
+			return nil
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return status, nil
+}
+
+// BeginTOTPEnrollment generates a new TOTP secret for the identity. The
+// factor stays inactive until ConfirmTOTPEnrollment receives a valid code.
+func (s *MFAService) BeginTOTPEnrollment(ctx context.Context, identityID gid.GID) (*TOTPEnrollment, error) {
+	secret, err := generateTOTPSecret()
+	if err != nil {
+		return nil, err
+	}
+
+	encryptedSecret, err := cipher.Encrypt(secret, s.encryptionKey)
+	if err != nil {
+		return nil, fmt.Errorf("cannot encrypt totp secret: %w", err)
+	}
+
+	var (
+		now      = time.Now()
+		identity = &coredata.Identity{}
+	)
+
+	err = s.pg.WithTx(
+		ctx,
+		func(tx pg.Conn) error {
+			if err := identity.LoadByID(ctx, tx, identityID); err != nil {
+				if errors.Is(err, coredata.ErrResourceNotFound) {
+					return NewIdentityNotFoundError(identityID)
+				}
+
+				return fmt.Errorf("cannot load identity: %w", err)
+			}
+
+			factor := &coredata.IdentityTOTPFactor{}
+			if err := factor.LoadByIdentityIDForUpdate(ctx, tx, identityID); err != nil {
+				if !errors.Is(err, coredata.ErrResourceNotFound) {
+					return fmt.Errorf("cannot load totp factor: %w", err)
+				}
+			} else if factor.IsConfirmed() {
+				return NewTOTPAlreadyEnrolledError(identityID)
+			}
+
+			factor = &coredata.IdentityTOTPFactor{
+				IdentityID:      identityID,
+				EncryptedSecret: encryptedSecret,
+				CreatedAt:       now,
+				UpdatedAt:       now,
+			}
+
+			if err := factor.Upsert(ctx, tx); err != nil {
+				return fmt.Errorf("cannot upsert totp factor: %w", err)
+			}
+
+			return nil
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &TOTPEnrollment{
+		Secret:          encodeTOTPSecret(secret),
+		ProvisioningURI: totpProvisioningURI(mfaIssuer, identity.EmailAddress.String(), secret),
+	}, nil
+}
+
+// ConfirmTOTPEnrollment activates the pending TOTP factor. When it is the
+// identity's first second factor, freshly generated recovery codes are
+// returned; they are not retrievable afterwards.
+func (s *MFAService) ConfirmTOTPEnrollment(
+	ctx context.Context,
+	sessionID gid.GID,
+	identityID gid.GID,
+	code string,
+) ([]string, error) {
+	var (
+		now           = time.Now()
+		recoveryCodes []string
+	)
+
+	err := s.pg.WithTx(
+		ctx,
+		func(tx pg.Conn) error {
+			factor := &coredata.IdentityTOTPFactor{}
+			if err := factor.LoadByIdentityIDForUpdate(ctx, tx, identityID); err != nil {
+				if errors.Is(err, coredata.ErrResourceNotFound) {
+					return NewTOTPNotEnrolledError(identityID)
+				}
+
+				return fmt.Errorf("cannot load totp factor: %w", err)
+			}
+
+			if factor.IsConfirmed() {
+				return NewTOTPAlreadyEnrolledError(identityID)
+			}
+
+			secret, err := cipher.Decrypt(factor.EncryptedSecret, s.encryptionKey)
+			if err != nil {
+				return fmt.Errorf("cannot decrypt totp secret: %w", err)
+			}
+
+			counter, ok := validateTOTPCode(secret, code, now, nil)
+			if !ok {
+				return NewInvalidMFACodeError()
+			}
+
+			hadSecondFactor, err := hasSecondFactor(ctx, tx, identityID)
+			if err != nil {
+				return err
+			}
+
+			factor.LastUsedCounter = &counter
+			factor.ConfirmedAt = &now
+			factor.UpdatedAt = now
+
+			if err := factor.Update(ctx, tx); err != nil {
+				return fmt.Errorf("cannot update totp factor: %w", err)
+			}
+
+			if err := recordIdentityAuditLog(
+				ctx,
+				tx,
+				identityID,
+				ActionIdentityMFAUpdate,
+				identityID,
+				map[string]any{"totpEnabled": false},
+				map[string]any{"totpEnabled": true},
+			); err != nil {
+				return fmt.Errorf("cannot record audit log entry: %w", err)
+			}
+
+			if !hadSecondFactor {
+				recoveryCodes, err = issueRecoveryCodes(ctx, tx, identityID, now)
+				if err != nil {
+					return err
+				}
+			}
+
+			return markSessionMFAVerified(ctx, tx, sessionID, now)
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return recoveryCodes, nil
+}
+
+// DisableTOTP removes the identity TOTP factor. The session must have
+// completed a second factor recently.
+func (s *MFAService) DisableTOTP(ctx context.Context, sessionID gid.GID, identityID gid.GID) error {
+	return s.pg.WithTx(
+		ctx,
+		func(tx pg.Conn) error {
+			if err := requireRecentMFAVerification(ctx, tx, sessionID, time.Now()); err != nil {
+				return err
+			}
+
+			factor := &coredata.IdentityTOTPFactor{}
+			if err := factor.LoadByIdentityIDForUpdate(ctx, tx, identityID); err != nil {
+				if errors.Is(err, coredata.ErrResourceNotFound) {
+					return NewTOTPNotEnrolledError(identityID)
+				}
+
+				return fmt.Errorf("cannot load totp factor: %w", err)
+			}
+
+			if err := factor.Delete(ctx, tx); err != nil {
+				return fmt.Errorf("cannot delete totp factor: %w", err)
+			}
+
+			if factor.IsConfirmed() {
+				if err := recordIdentityAuditLog(
+					ctx,
+					tx,
+					identityID,
+					ActionIdentityMFAUpdate,
+					identityID,
+					map[string]any{"totpEnabled": true},
+					map[string]any{"totpEnabled": false},
+				); err != nil {
+					return fmt.Errorf("cannot record audit log entry: %w", err)
+				}
+			}
+
+			return deleteOrphanRecoveryCodes(ctx, tx, identityID)
+		},
+	)
+}
+
+// RegenerateRecoveryCodes replaces the identity recovery codes. The session
+// must have completed a second factor recently.
+func (s *MFAService) RegenerateRecoveryCodes(ctx context.Context, sessionID gid.GID, identityID gid.GID) ([]string, error) {
+	var (
+		now           = time.Now()
+		recoveryCodes []string
+	)
+
+	err := s.pg.WithTx(
+		ctx,
+		func(tx pg.Conn) error {
+			if err := requireRecentMFAVerification(ctx, tx, sessionID, now); err != nil {
+				return err
+			}
+
+			ok, err := hasSecondFactor(ctx, tx, identityID)
+			if err != nil {
+				return err
+			}
+
+			if !ok {
+				return NewMFANotEnrolledError(identityID)
+			}
+
+			recoveryCodes, err = issueRecoveryCodes(ctx, tx, identityID, now)
+			if err != nil {
+				return err
+			}
+
+			if err := recordIdentityAuditLog(
+				ctx,
+				tx,
+				identityID,
+				ActionIdentityMFAUpdate,
+				identityID,
+				nil,
+				map[string]any{"recoveryCodesRegenerated": true},
+			); err != nil {
+				return fmt.Errorf("cannot record audit log entry: %w", err)
+			}
+
+			return nil
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return recoveryCodes, nil
+}
+
+func (s *MFAService) BeginWebAuthnRegistration(ctx context.Context, identityID gid.GID) (*WebAuthnRegistration, error) {
+	var user *webAuthnUser
+
+	err := s.pg.WithConn(
+		ctx,
+		func(conn pg.Conn) (err error) {
+			user, err = loadWebAuthnUser(ctx, conn, identityID)
+			return err
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	creation, session, err := s.webAuthn.BeginRegistration(
+		user,
+		webauthn.WithExclusions(webauthn.Credentials(user.credentials).CredentialDescriptors()),
+		webauthn.WithResidentKeyRequirement(protocol.ResidentKeyRequirementPreferred),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("cannot begin webauthn registration: %w", err)
+	}
+
+	options, err := json.Marshal(creation)
+	if err != nil {
+		return nil, fmt.Errorf("cannot marshal webauthn creation options: %w", err)
+	}
+
+	token, err := statelesstoken.NewToken(
+		s.tokenSecret,
+		TokenTypeWebAuthnRegistration,
+		webAuthnRegistrationTokenValidity,
+		WebAuthnRegistrationData{IdentityID: identityID, Session: *session},
+	)
+	if err != nil {
+		return nil, fmt.Errorf("cannot generate webauthn registration token: %w", err)
+	}
+
+	return &WebAuthnRegistration{Token: token, Options: options}, nil
+}
+
+// FinishWebAuthnRegistration verifies the authenticator attestation and
+// stores the new credential. Recovery codes are returned when it is the
+// identity's first second factor.
+func (s *MFAService) FinishWebAuthnRegistration(
+	ctx context.Context,
+	sessionID gid.GID,
+	identityID gid.GID,
+	req *FinishWebAuthnRegistrationRequest,
+) (*coredata.WebAuthnCredential, []string, error) {
+	if err := req.Validate(); err != nil {
+		return nil, nil, fmt.Errorf("invalid request: %w", err)
+	}
+
+	payload, err := statelesstoken.ValidateToken[WebAuthnRegistrationData](s.tokenSecret, TokenTypeWebAuthnRegistration, req.Token)
+	if err != nil {
+		return nil, nil, NewInvalidTokenError()
+	}
+
+	if payload.Data.IdentityID != identityID {
+		return nil, nil, NewInvalidTokenError()
+	}
+
+	parsed, err := protocol.ParseCredentialCreationResponseBytes([]byte(req.Response))
+	if err != nil {
+		return nil, nil, NewInvalidMFACodeError()
+	}
+
+	var (
+		now           = time.Now()
+		credential    = &coredata.WebAuthnCredential{}
+		recoveryCodes []string
+	)
+
+	err = s.pg.WithTx(
+		ctx,
+		func(tx pg.Conn) error {
+			user, err := loadWebAuthnUser(ctx, tx, identityID)
+			if err != nil {
+				return err
+			}
+
+			created, err := s.webAuthn.CreateCredential(user, payload.Data.Session, parsed)
+			if err != nil {
+				return NewInvalidMFACodeError()
+			}
+
+			rawCredential, err := json.Marshal(created)
+			if err != nil {
+				return fmt.Errorf("cannot marshal webauthn credential: %w", err)
+			}
+
+			hadSecondFactor, err := hasSecondFactor(ctx, tx, identityID)
+			if err != nil {
+				return err
+			}
+
+			credential = &coredata.WebAuthnCredential{
+				ID:           gid.New(gid.NilTenant, coredata.WebAuthnCredentialEntityType),
+				IdentityID:   identityID,
+				Name:         req.Name,
+				CredentialID: created.ID,
+				Credential:   rawCredential,
+				CreatedAt:    now,
+				UpdatedAt:    now,
+			}
+
+			if err := credential.Insert(ctx, tx); err != nil {
+				if errors.Is(err, coredata.ErrResourceAlreadyExists) {
+					return NewWebAuthnCredentialAlreadyExistsError()
+				}
+
+				return fmt.Errorf("cannot insert webauthn credential: %w", err)
+			}
+
+			if err := recordIdentityAuditLog(ctx, tx, identityID, ActionIdentityMFAUpdate, credential.ID, nil, webAuthnCredentialAuditState(credential)); err != nil {
+				return fmt.Errorf("cannot record audit log entry: %w", err)
+			}
+
+			if !hadSecondFactor {
+				recoveryCodes, err = issueRecoveryCodes(ctx, tx, identityID, now)
+				if err != nil {
+					return err
+				}
+			}
+
+			return markSessionMFAVerified(ctx, tx, sessionID, now)
+		},
+	)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return credential, recoveryCodes, nil
+}
+
+func (s *MFAService) GetWebAuthnCredential(ctx context.Context, credentialID gid.GID) (*coredata.WebAuthnCredential, error) {
+	credential := &coredata.WebAuthnCredential{}
+
+	err := s.pg.WithConn(
+		ctx,
+		func(conn pg.Conn) error {
+			if err := credential.LoadByID(ctx, conn, credentialID); err != nil {
+				if errors.Is(err, coredata.ErrResourceNotFound) {
+					return NewWebAuthnCredentialNotFoundError(credentialID)
+				}
+
+				return fmt.Errorf("cannot load webauthn credential: %w", err)
+			}
+
+			return nil
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return credential, nil
+}
+
+// DeleteWebAuthnCredential removes a passkey. The session must have
+// completed a second factor recently.
+func (s *MFAService) DeleteWebAuthnCredential(ctx context.Context, sessionID gid.GID, credentialID gid.GID) error {
+	return s.pg.WithTx(
+		ctx,
+		func(tx pg.Conn) error {
+			if err := requireRecentMFAVerification(ctx, tx, sessionID, time.Now()); err != nil {
+				return err
+			}
+
+			credential := &coredata.WebAuthnCredential{}
+			if err := credential.LoadByID(ctx, tx, credentialID); err != nil {
+				if errors.Is(err, coredata.ErrResourceNotFound) {
+					return NewWebAuthnCredentialNotFoundError(credentialID)
+				}
+
+				return fmt.Errorf("cannot load webauthn credential: %w", err)
+			}
+
+			if err := credential.Delete(ctx, tx); err != nil {
+				return fmt.Errorf("cannot delete webauthn credential: %w", err)
+			}
+
+			if err := recordIdentityAuditLog(ctx, tx, credential.IdentityID, ActionWebAuthnCredentialDelete, credential.ID, webAuthnCredentialAuditState(credential), nil); err != nil {
+				return fmt.Errorf("cannot record audit log entry: %w", err)
+			}
+
+			return deleteOrphanRecoveryCodes(ctx, tx, credential.IdentityID)
+		},
+	)
+}
+
+// BeginChallenge returns the second factor challenge for the identity, or
+// nil when the identity has not enrolled any second factor.
+func (s *MFAService) BeginChallenge(ctx context.Context, identityID gid.GID) (*MFAChallenge, error) {
+	var (
+		challenge = &MFAChallenge{}
+		data      = MFAChallengeData{IdentityID: identityID}
+	)
+
+	err := s.pg.WithConn(
+		ctx,
+		func(conn pg.Conn) error {
+			factor := &coredata.IdentityTOTPFactor{}
+			if err := factor.LoadByIdentityID(ctx, conn, identityID); err != nil {
+				if !errors.Is(err, coredata.ErrResourceNotFound) {
+					return fmt.Errorf("cannot load totp factor: %w", err)
+				}
+			} else if factor.IsConfirmed() {
+				challenge.Methods = append(challenge.Methods, MFAMethodTOTP)
+			}
+
+			user, err := loadWebAuthnUser(ctx, conn, identityID)
+			if err != nil {
+				return err
+			}
+
+			if len(user.credentials) > 0 {
+				assertion, session, err := s.webAuthn.BeginLogin(user)
+				if err != nil {
+					return fmt.Errorf("cannot begin webauthn login: %w", err)
+				}
+
+				challenge.WebAuthnOptions, err = json.Marshal(assertion)
+				if err != nil {
+					return fmt.Errorf("cannot marshal webauthn assertion options: %w", err)
+				}
+
+				data.WebAuthnSession = session
+				challenge.Methods = append(challenge.Methods, MFAMethodWebAuthn)
+			}
+
+			return nil
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(challenge.Methods) == 0 {
+		return nil, nil
+	}
+
+	challenge.Methods = append(challenge.Methods, MFAMethodRecoveryCode)
+
+	challenge.Token, err = statelesstoken.NewToken(
+		s.tokenSecret,
+		TokenTypeMFAChallenge,
+		mfaChallengeTokenValidity,
+		data,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("cannot generate mfa challenge token: %w", err)
+	}
+
+	err = s.pg.WithConn(
+		ctx,
+		func(conn pg.Conn) error {
+			token := &coredata.Token{
+				ID:          gid.New(gid.NilTenant, coredata.TokenEntityType),
+				HashedValue: HashToken(challenge.Token),
+				CreatedAt:   time.Now(),
+			}
+
+			if err := token.Insert(ctx, conn); err != nil {
+				return fmt.Errorf("cannot insert token: %w", err)
+			}
+
+			return nil
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return challenge, nil
+}
+
+// VerifyChallenge checks the second factor presented for a challenge and
+// opens an MFA-verified password session. A challenge can be attempted only
+// once: a wrong factor consumes it and the client must sign in again.
+func (s *MFAService) VerifyChallenge(
+	ctx context.Context,
+	req *VerifyMFAChallengeRequest,
+) (*coredata.Identity, *coredata.Session, error) {
+	if err := req.Validate(); err != nil {
+		return nil, nil, fmt.Errorf("invalid request: %w", err)
+	}
+
+	payload, err := statelesstoken.ValidateToken[MFAChallengeData](s.tokenSecret, TokenTypeMFAChallenge, req.Token)
+	if err != nil {
+		return nil, nil, NewInvalidTokenError()
+	}
+
+	var (
+		now        = time.Now()
+		identityID = payload.Data.IdentityID
+		identity   = &coredata.Identity{}
+		session    = &coredata.Session{}
+		verified   bool
+	)
+
+	err = s.pg.WithTx(
+		ctx,
+		func(tx pg.Conn) error {
+			token := &coredata.Token{}
+			if err := token.LoadByHashedValueForUpdate(ctx, tx, HashToken(req.Token)); err != nil {
+				if errors.Is(err, coredata.ErrResourceNotFound) {
+					return NewInvalidTokenError()
+				}
+
+				return fmt.Errorf("cannot load token: %w", err)
+			}
+
+			if err := token.Delete(ctx, tx); err != nil {
+				return fmt.Errorf("cannot delete token: %w", err)
+			}
+
+			if err := identity.LoadByID(ctx, tx, identityID); err != nil {
+				if errors.Is(err, coredata.ErrResourceNotFound) {
+					return NewIdentityNotFoundError(identityID)
+				}
+
+				return fmt.Errorf("cannot load identity: %w", err)
+			}
+
+			switch {
+			case req.TOTPCode != nil:
+				verified, err = s.verifyTOTP(ctx, tx, identityID, *req.TOTPCode, now)
+			case req.RecoveryCode != nil:
+				verified, err = verifyRecoveryCode(ctx, tx, identityID, *req.RecoveryCode, now)
+			case req.WebAuthnAssertion != nil:
+				verified, err = s.verifyWebAuthnAssertion(ctx, tx, identityID, payload.Data.WebAuthnSession, *req.WebAuthnAssertion, now)
+			}
+			if err != nil {
+				return err
+			}
+
+			if !verified {
+				// Commit the token deletion so the challenge cannot be
+				// brute-forced.
+				return nil
+			}
+
+			session = coredata.NewRootSession(identityID, coredata.AuthMethodPassword, s.sessionDuration)
+			session.MFAVerifiedAt = &now
+
+			if err := session.Insert(ctx, tx); err != nil {
+				return fmt.Errorf("cannot insert session: %w", err)
+			}
+
+			return nil
+		},
+	)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if !verified {
+		return nil, nil, NewInvalidMFACodeError()
+	}
+
+	return identity, session, nil
+}
+
+func (s *MFAService) verifyTOTP(
+	ctx context.Context,
+	conn pg.Conn,
+	identityID gid.GID,
+	code string,
+	now time.Time,
+) (bool, error) {
+	factor := &coredata.IdentityTOTPFactor{}
+	if err := factor.LoadByIdentityIDForUpdate(ctx, conn, identityID); err != nil {
+		if errors.Is(err, coredata.ErrResourceNotFound) {
+			return false, nil
+		}
+
+		return false, fmt.Errorf("cannot load totp factor: %w", err)
+	}
+
+	if !factor.IsConfirmed() {
+		return false, nil
+	}
+
+	secret, err := cipher.Decrypt(factor.EncryptedSecret, s.encryptionKey)
+	if err != nil {
+		return false, fmt.Errorf("cannot decrypt totp secret: %w", err)
+	}
+
+	counter, ok := validateTOTPCode(secret, code, now, factor.LastUsedCounter)
+	if !ok {
+		return false, nil
+	}
+
+	factor.LastUsedCounter = &counter
+	factor.UpdatedAt = now
+
+	if err := factor.Update(ctx, conn); err != nil {
+		return false, fmt.Errorf("cannot update totp factor: %w", err)
+	}
+
+	return true, nil
+}
+
+func (s *MFAService) verifyWebAuthnAssertion(
+	ctx context.Context,
+	conn pg.Conn,
+	identityID gid.GID,
+	session *webauthn.SessionData,
+	assertion string,
+	now time.Time,
+) (bool, error) {
+	if session == nil {
+		return false, nil
+	}
+
+	parsed, err := protocol.ParseCredentialRequestResponseBytes([]byte(assertion))
+	if err != nil {
+		return false, nil
+	}
+
+	user, err := loadWebAuthnUser(ctx, conn, identityID)
+	if err != nil {
+		return false, err
+	}
+
+	validated, err := s.webAuthn.ValidateLogin(user, *session, parsed)
+	if err != nil {
+		return false, nil
+	}
+
+	if validated.Authenticator.CloneWarning {
+		return false, nil
+	}
+
+	credentials := coredata.WebAuthnCredentials{}
+	if err := credentials.LoadAllByIdentityID(ctx, conn, identityID); err != nil {
+		return false, fmt.Errorf("cannot load webauthn credentials: %w", err)
+	}
+
+	for _, credential := range credentials {
+		if string(credential.CredentialID) != string(validated.ID) {
+			continue
+		}
+
+		rawCredential, err := json.Marshal(validated)
+		if err != nil {
+			return false, fmt.Errorf("cannot marshal webauthn credential: %w", err)
+		}
+
+		credential.Credential = rawCredential
+		credential.LastUsedAt = &now
+		credential.UpdatedAt = now
+
+		if err := credential.Update(ctx, conn); err != nil {
+			return false, fmt.Errorf("cannot update webauthn credential: %w", err)
+		}
+
+		return true, nil
+	}
+
+	return false, nil
+}
+
+func verifyRecoveryCode(
+	ctx context.Context,
+	conn pg.Conn,
+	identityID gid.GID,
+	code string,
+	now time.Time,
+) (bool, error) {
+	recoveryCode := &coredata.RecoveryCode{}
+	if err := recoveryCode.LoadUnusedByIdentityIDAndHashedCodeForUpdate(
+		ctx,
+		conn,
+		identityID,
+		HashToken(normalizeRecoveryCode(code)),
+	); err != nil {
+		if errors.Is(err, coredata.ErrResourceNotFound) {
+			return false, nil
+		}
+
+		return false, fmt.Errorf("cannot load recovery code: %w", err)
+	}
+
+	recoveryCode.UsedAt = &now
+
+	if err := recoveryCode.Update(ctx, conn); err != nil {
+		return false, fmt.Errorf("cannot update recovery code: %w", err)
+	}
+
+	return true, nil
+}
+
+func loadWebAuthnUser(ctx context.Context, conn pg.Conn, identityID gid.GID) (*webAuthnUser, error) {
+	identity := &coredata.Identity{}
+	if err := identity.LoadByID(ctx, conn, identityID); err != nil {
+		if errors.Is(err, coredata.ErrResourceNotFound) {
+			return nil, NewIdentityNotFoundError(identityID)
+		}
+
+		return nil, fmt.Errorf("cannot load identity: %w", err)
+	}
+
+	credentials := coredata.WebAuthnCredentials{}
+	if err := credentials.LoadAllByIdentityID(ctx, conn, identityID); err != nil {
+		return nil, fmt.Errorf("cannot load webauthn credentials: %w", err)
+	}
+
+	user := &webAuthnUser{identity: identity}
+	for _, credential := range credentials {
+		var c webauthn.Credential
+		if err := json.Unmarshal(credential.Credential, &c); err != nil {
+			return nil, fmt.Errorf("cannot unmarshal webauthn credential %q: %w", credential.ID, err)
+		}
+
+		user.credentials = append(user.credentials, c)
+	}
+
+	return user, nil
+}
+
+func hasSecondFactor(ctx context.Context, conn pg.Conn, identityID gid.GID) (bool, error) {
+	factor := &coredata.IdentityTOTPFactor{}
+	if err := factor.LoadByIdentityID(ctx, conn, identityID); err != nil {
+		if !errors.Is(err, coredata.ErrResourceNotFound) {
+			return false, fmt.Errorf("cannot load totp factor: %w", err)
+		}
+	} else if factor.IsConfirmed() {
+		return true, nil
+	}
+
+	credentials := coredata.WebAuthnCredentials{}
+	count, err := credentials.CountByIdentityID(ctx, conn, identityID)
+	if err != nil {
+		return false, fmt.Errorf("cannot count webauthn credentials: %w", err)
+	}
+
+	return count > 0, nil
+}
+
+// deleteOrphanRecoveryCodes drops the recovery codes of an identity that no
+// longer has any second factor, so that enrolling again starts fresh.
+func deleteOrphanRecoveryCodes(ctx context.Context, conn pg.Conn, identityID gid.GID) error {
+	ok, err := hasSecondFactor(ctx, conn, identityID)
+	if err != nil {
+		return err
+	}
+
+	if ok {
+		return nil
+	}
+
+	recoveryCodes := coredata.RecoveryCodes{}
+	if err := recoveryCodes.DeleteByIdentityID(ctx, conn, identityID); err != nil {
+		return fmt.Errorf("cannot delete recovery codes: %w", err)
+	}
+
+	return nil
+}
+
+func issueRecoveryCodes(ctx context.Context, conn pg.Conn, identityID gid.GID, now time.Time) ([]string, error) {
+	existing := coredata.RecoveryCodes{}
+	if err := existing.DeleteByIdentityID(ctx, conn, identityID); err != nil {
+		return nil, fmt.Errorf("cannot delete recovery codes: %w", err)
+	}
+
+	codes := make([]string, 0, recoveryCodeCount)
+	for range recoveryCodeCount {
+		code, err := generateRecoveryCode()
+		if err != nil {
+			return nil, err
+		}
+
+		recoveryCode := &coredata.RecoveryCode{
+			ID:         gid.New(gid.NilTenant, coredata.RecoveryCodeEntityType),
+			IdentityID: identityID,
+			HashedCode: HashToken(normalizeRecoveryCode(code)),
+			CreatedAt:  now,
+		}
+
+		if err := recoveryCode.Insert(ctx, conn); err != nil {
+			return nil, fmt.Errorf("cannot insert recovery code: %w", err)
+		}
+
+		codes = append(codes, code)
+	}
+
+	return codes, nil
+}
+
+func generateRecoveryCode() (string, error) {
+	raw := make([]byte, 10)
+	if _, err := rand.Read(raw); err != nil {
+		return "", fmt.Errorf("cannot generate recovery code: %w", err)
+	}
+
+	encoded := strings.ToLower(totpSecretEncoding.EncodeToString(raw))[:10]
+
+	return encoded[:5] + "-" + encoded[5:], nil
+}
+
+func normalizeRecoveryCode(code string) string {
+	code = strings.ToLower(code)
+	code = strings.ReplaceAll(code, "-", "")
+	code = strings.ReplaceAll(code, " ", "")
+
+	return code
+}
+
+func markSessionMFAVerified(ctx context.Context, conn pg.Conn, sessionID gid.GID, now time.Time) error {
+	session := &coredata.Session{}
+	if err := session.LoadByID(ctx, conn, sessionID); err != nil {
+		if errors.Is(err, coredata.ErrResourceNotFound) {
+			return NewSessionNotFoundError(sessionID)
+		}
+
+		return fmt.Errorf("cannot load session: %w", err)
+	}
+
+	session.MFAVerifiedAt = &now
+	session.UpdatedAt = now
+
+	if err := session.Update(ctx, conn); err != nil {
+		return fmt.Errorf("cannot update session: %w", err)
+	}
+
+	return nil
+}
+
+// requireRecentMFAVerification rejects sessions that did not complete a
+// second factor within mfaRecentVerificationWindow, so a stolen session
+// cannot remove the factors protecting the account.
+func requireRecentMFAVerification(ctx context.Context, conn pg.Conn, sessionID gid.GID, now time.Time) error {
+	session := &coredata.Session{}
+	if err := session.LoadByID(ctx, conn, sessionID); err != nil {
+		if errors.Is(err, coredata.ErrResourceNotFound) {
+			return NewSessionNotFoundError(sessionID)
+		}
+
+		return fmt.Errorf("cannot load session: %w", err)
+	}
+
+	if session.MFAVerifiedAt == nil || now.Sub(*session.MFAVerifiedAt) > mfaRecentVerificationWindow {
+		return NewMFARequiredError("recent_verification_required")
+	}
+
+	return nil
+}
@@ -0,0 +1,92 @@
+// Copyright (c) 2025 Probo Inc <hello@getprobo.com>.
+//
+// Permission to use, copy, modify, and/or distribute this software for any
+// purpose with or without fee is hereby granted, provided that the above
+// copyright notice and this permission notice appear in all copies.
+//
+// THE SOFTWARE IS PROVIDED "AS IS" AND THE AUTHOR DISCLAIMS ALL WARRANTIES WITH
+// REGARD TO THIS SOFTWARE INCLUDING ALL IMPLIED WARRANTIES OF MERCHANTABILITY
+// AND FITNESS. IN NO EVENT SHALL THE AUTHOR BE LIABLE FOR ANY SPECIAL, DIRECT,
+// INDIRECT, OR CONSEQUENTIAL DAMAGES OR ANY DAMAGES WHATSOEVER RESULTING FROM
+// LOSS OF USE, DATA OR PROFITS, WHETHER IN AN ACTION OF CONTRACT, NEGLIGENCE OR
+// OTHER TORTIOUS ACTION, ARISING OUT OF OR IN CONNECTION WITH THE USE OR
+// PERFORMANCE OF THIS SOFTWARE.
+
+package oidc
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"go.probo.inc/probo/pkg/mail"
+)
+
+type (
+	idTokenClaims struct {
+		Subject           string    `json:"sub"`
+		Email             string    `json:"email"`
+		EmailVerified     *flexBool `json:"email_verified"`
+		Name              string    `json:"name"`
+		GivenName         string    `json:"given_name"`
+		FamilyName        string    `json:"family_name"`
+		PreferredUsername string    `json:"preferred_username"`
+	}
+
+	// flexBool accepts both JSON booleans and their string form as some
+	// providers (e.g. AWS Cognito) serialize email_verified as "true".
+	flexBool bool
+)
+
+func (b *flexBool) UnmarshalJSON(data []byte) error {
+	var v bool
+	if err := json.Unmarshal(data, &v); err == nil {
+		*b = flexBool(v)
+		return nil
+	}
+
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return fmt.Errorf("cannot unmarshal boolean claim: %w", err)
+	}
+
+	v, err := strconv.ParseBool(s)
+	if err != nil {
+		return fmt.Errorf("cannot parse boolean claim %q: %w", s, err)
+	}
+
+	*b = flexBool(v)
+	return nil
+}
+
+// extractUserInfo returns the email address and display name carried by
+// the ID token. Microsoft Entra ID omits the email claim for accounts
+// without a mailbox, in which case the preferred_username (the UPN) is
+// used when it is a valid email address.
+func extractUserInfo(claims *idTokenClaims) (mail.Addr, string, error) {
+	emailString := claims.Email
+	if emailString == "" {
+		emailString = claims.PreferredUsername
+	}
+
+	if emailString == "" {
+		return mail.Nil, "", fmt.Errorf("no email claim in ID token")
+	}
+
+	email, err := mail.ParseAddr(emailString)
+	if err != nil {
+		return mail.Nil, "", fmt.Errorf("cannot parse email: %w", err)
+	}
+
+	fullname := strings.TrimSpace(claims.Name)
+	if fullname == "" {
+		fullname = strings.TrimSpace(claims.GivenName + " " + claims.FamilyName)
+	}
+
+	if fullname == "" {
+		fullname = email.String()
+	}
+
+	return email, fullname, nil
+}
@@ -0,0 +1,88 @@
+// Copyright (c) 2025 Probo Inc <hello@getprobo.com>.
+//
+// Permission to use, copy, modify, and/or distribute this software for any
+// purpose with or without fee is hereby granted, provided that the above
+// copyright notice and this permission notice appear in all copies.
+//
+// THE SOFTWARE IS PROVIDED "AS IS" AND THE AUTHOR DISCLAIMS ALL WARRANTIES WITH
+// REGARD TO THIS SOFTWARE INCLUDING ALL IMPLIED WARRANTIES OF MERCHANTABILITY
+// AND FITNESS. IN NO EVENT SHALL THE AUTHOR BE LIABLE FOR ANY SPECIAL, DIRECT,
+// INDIRECT, OR CONSEQUENTIAL DAMAGES OR ANY DAMAGES WHATSOEVER RESULTING FROM
+// LOSS OF USE, DATA OR PROFITS, WHETHER IN AN ACTION OF CONTRACT, NEGLIGENCE OR
+// OTHER TORTIOUS ACTION, ARISING OUT OF OR IN CONNECTION WITH THE USE OR
+// PERFORMANCE OF THIS SOFTWARE.
+
+package oidc
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestIDTokenClaims_EmailVerified(t *testing.T) {
+	tests := []struct {
+		name     string
+		payload  string
+		present  bool
+		verified bool
+	}{
+		{"boolean", `{"email_verified": true}`, true, true},
+		{"string", `{"email_verified": "false"}`, true, false},
+		{"absent", `{}`, false, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var claims idTokenClaims
+			require.NoError(t, json.Unmarshal([]byte(tt.payload), &claims))
+
+			if !tt.present {
+				assert.Nil(t, claims.EmailVerified)
+				return
+			}
+
+			require.NotNil(t, claims.EmailVerified)
+			assert.Equal(t, tt.verified, bool(*claims.EmailVerified))
+		})
+	}
+
+	var claims idTokenClaims
+	assert.Error(t, json.Unmarshal([]byte(`{"email_verified": "maybe"}`), &claims))
+}
+
+func TestExtractUserInfo(t *testing.T) {
+	t.Run("email and name", func(t *testing.T) {
+		email, fullname, err := extractUserInfo(&idTokenClaims{
+			Email: "jane@example.com",
+			Name:  "Jane Doe",
+		})
+		require.NoError(t, err)
+		assert.Equal(t, "jane@example.com", email.String())
+		assert.Equal(t, "Jane Doe", fullname)
+	})
+
+	t.Run("preferred username fallback", func(t *testing.T) {
+		email, fullname, err := extractUserInfo(&idTokenClaims{
+			PreferredUsername: "jane@example.com",
+			GivenName:         "Jane",
+			FamilyName:        "Doe",
+		})
+		require.NoError(t, err)
+		assert.Equal(t, "jane@example.com", email.String())
+		assert.Equal(t, "Jane Doe", fullname)
+	})
+
+	t.Run("name defaults to email", func(t *testing.T) {
+		_, fullname, err := extractUserInfo(&idTokenClaims{Email: "jane@example.com"})
+		require.NoError(t, err)
+		assert.Equal(t, "jane@example.com", fullname)
+	})
+
+	t.Run("missing email", func(t *testing.T) {
+		_, _, err := extractUserInfo(&idTokenClaims{Name: "Jane Doe"})
+		assert.Error(t, err)
+	})
+}
@@ -0,0 +1,109 @@
+// Copyright (c) 2025 Probo Inc <hello@getprobo.com>.
+//
+// Permission to use, copy, modify, and/or distribute this software for any
+// purpose with or without fee is hereby granted, provided that the above
+// copyright notice and this permission notice appear in all copies.
+//
+// THE SOFTWARE IS PROVIDED "AS IS" AND THE AUTHOR DISCLAIMS ALL WARRANTIES WITH
+// REGARD TO THIS SOFTWARE INCLUDING ALL IMPLIED WARRANTIES OF MERCHANTABILITY
+// AND FITNESS. IN NO EVENT SHALL THE AUTHOR BE LIABLE FOR ANY SPECIAL, DIRECT,
+// INDIRECT, OR CONSEQUENTIAL DAMAGES OR ANY DAMAGES WHATSOEVER RESULTING FROM
+// LOSS OF USE, DATA OR PROFITS, WHETHER IN AN ACTION OF CONTRACT, NEGLIGENCE OR
+// OTHER TORTIOUS ACTION, ARISING OUT OF OR IN CONNECTION WITH THE USE OR
+// PERFORMANCE OF THIS SOFTWARE.
+
+package oidc
+
+import (
+	"fmt"
+
+	"go.probo.inc/probo/pkg/gid"
+	"go.probo.inc/probo/pkg/mail"
+)
+
+type ErrOIDCConfigurationNotFound struct{ ConfigID gid.GID }
+
+func NewOIDCConfigurationNotFoundError(configID gid.GID) error {
+	return &ErrOIDCConfigurationNotFound{ConfigID: configID}
+}
+
+func (e ErrOIDCConfigurationNotFound) Error() string {
+	return fmt.Sprintf("OIDC configuration %q not found", e.ConfigID)
+}
+
+type ErrOIDCDisabled struct{}
+
+func NewOIDCDisabledError() error {
+	return &ErrOIDCDisabled{}
+}
+
+func (e ErrOIDCDisabled) Error() string {
+	return "OIDC is disabled for this organization"
+}
+
+type ErrInvalidAuthorizationRequest struct{}
+
+func NewInvalidAuthorizationRequestError() error {
+	return &ErrInvalidAuthorizationRequest{}
+}
+
+func (e ErrInvalidAuthorizationRequest) Error() string {
+	return "invalid or expired OIDC authorization request"
+}
+
+type ErrInvalidIDToken struct{ Err error }
+
+func NewInvalidIDTokenError(err error) error {
+	return &ErrInvalidIDToken{Err: err}
+}
+
+func (e ErrInvalidIDToken) Error() string {
+	return fmt.Sprintf("invalid ID token: %v", e.Err)
+}
+
+func (e ErrInvalidIDToken) Unwrap() error {
+	return e.Err
+}
+
+type ErrEmailNotVerified struct{ Email mail.Addr }
+
+func NewEmailNotVerifiedError(email mail.Addr) error {
+	return &ErrEmailNotVerified{Email: email}
+}
+
+func (e ErrEmailNotVerified) Error() string {
+	return fmt.Sprintf("email %q is not verified by the identity provider", e.Email)
+}
+
+type ErrEmailDomainMismatch struct {
+	Email          mail.Addr
+	ExpectedDomain string
+}
+
+func NewEmailDomainMismatchError(email mail.Addr, expectedDomain string) error {
+	return &ErrEmailDomainMismatch{Email: email, ExpectedDomain: expectedDomain}
+}
+
+func (e ErrEmailDomainMismatch) Error() string {
+	return fmt.Sprintf("email domain mismatch: ID token contains email %q but OIDC config is for domain %q", e.Email, e.ExpectedDomain)
+}
+
+type ErrOIDCAutoSignupDisabled struct{ ConfigID gid.GID }
+
+func NewOIDCAutoSignupDisabledError(configID gid.GID) error {
+	return &ErrOIDCAutoSignupDisabled{ConfigID: configID}
+}
+
+func (e ErrOIDCAutoSignupDisabled) Error() string {
+	return fmt.Sprintf("OIDC auto-signup is disabled for configuration %q", e.ConfigID)
+}
+
+type ErrMembershipInactive struct{ MembershipID gid.GID }
+
+func NewMembershipInactiveError(membershipID gid.GID) error {
+	return &ErrMembershipInactive{MembershipID: membershipID}
+}
+
+func (e ErrMembershipInactive) Error() string {
+	return fmt.Sprintf("membership %q is inactive", e.MembershipID)
+}
@@ -0,0 +1,453 @@
+// Copyright (c) 2025 Probo Inc <hello@getprobo.com>.
+//
+// Permission to use, copy, modify, and/or distribute this software for any
+// purpose with or without fee is hereby granted, provided that the above
+// copyright notice and this permission notice appear in all copies.
+//
+// THE SOFTWARE IS PROVIDED "AS IS" AND THE AUTHOR DISCLAIMS ALL WARRANTIES WITH
+// REGARD TO THIS SOFTWARE INCLUDING ALL IMPLIED WARRANTIES OF MERCHANTABILITY
+// AND FITNESS. IN NO EVENT SHALL THE AUTHOR BE LIABLE FOR ANY SPECIAL, DIRECT,
+// INDIRECT, OR CONSEQUENTIAL DAMAGES OR ANY DAMAGES WHATSOEVER RESULTING FROM
+// LOSS OF USE, DATA OR PROFITS, WHETHER IN AN ACTION OF CONTRACT, NEGLIGENCE OR
+// OTHER TORTIOUS ACTION, ARISING OUT OF OR IN CONNECTION WITH THE USE OR
+// PERFORMANCE OF THIS SOFTWARE.
+
+package oidc
+
+import (
+	"context"
+	"crypto/rand"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	gooidc "github.com/coreos/go-oidc/v3/oidc"
+	"go.gearno.de/kit/httpclient"
+	"go.gearno.de/kit/log"
+	"go.gearno.de/kit/pg"
+	"go.probo.inc/probo/pkg/baseurl"
+	"go.probo.inc/probo/pkg/coredata"
+	"go.probo.inc/probo/pkg/crypto/cipher"
+	"go.probo.inc/probo/pkg/gid"
+	"golang.org/x/oauth2"
+)
+
+const (
+	authorizationRequestTTL = 10 * time.Minute
+	httpClientTimeout       = 10 * time.Second
+)
+
+type (
+	Service struct {
+		pg            *pg.Client
+		encryptionKey cipher.EncryptionKey
+		baseURL       string
+		httpClient    *http.Client
+		logger        *log.Logger
+	}
+
+	CallbackResult struct {
+		Identity    *coredata.Identity
+		Membership  *coredata.Membership
+		ContinueURL string
+	}
+)
+
+func NewService(
+	pg *pg.Client,
+	encryptionKey cipher.EncryptionKey,
+	baseURL string,
+	logger *log.Logger,
+) (*Service, error) {
+	return &Service{
+		pg:            pg,
+		encryptionKey: encryptionKey,
+		baseURL:       baseURL,
+		httpClient: &http.Client{
+			Timeout:   httpClientTimeout,
+			Transport: httpclient.DefaultPooledTransport(httpclient.WithLogger(logger)),
+		},
+		logger: logger,
+	}, nil
+}
+
+func (s *Service) Run(ctx context.Context) error {
+	gc := NewGarbageCollector(s.pg, DefaultGarbageCollectionInterval, s.logger)
+
+	gcCtx, stopGC := context.WithCancel(ctx)
+	defer stopGC()
+
+	errCh := make(chan error, 1)
+	go func() {
+		errCh <- gc.Run(gcCtx)
+	}()
+
+	select {
+	case <-ctx.Done():
+		stopGC()
+		<-errCh
+		return ctx.Err()
+	case err := <-errCh:
+		if err != nil {
+			s.logger.ErrorCtx(ctx, "oidc garbage collector failed", log.Error(err))
+			return err
+		}
+
+		return nil
+	}
+}
+
+// RedirectURL returns the callback URL that must be registered as an
+// allowed redirect URI in the identity provider.
+func (s *Service) RedirectURL() string {
+	return baseurl.MustParse(s.baseURL).WithPath("/api/connect/v1/oidc/callback").MustString()
+}
+
+func (s *Service) InitiateLogin(
+	ctx context.Context,
+	configID gid.GID,
+	continuePath string,
+) (*url.URL, error) {
+	var (
+		now    = time.Now()
+		config = &coredata.OIDCConfiguration{}
+	)
+
+	err := s.pg.WithConn(
+		ctx,
+		func(conn pg.Conn) error {
+			err := config.LoadByID(ctx, conn, coredata.NewNoScope(), configID)
+			if err != nil {
+				if err == coredata.ErrResourceNotFound {
+					return NewOIDCConfigurationNotFoundError(configID)
+				}
+
+				return fmt.Errorf("cannot load OIDC configuration: %w", err)
+			}
+
+			return nil
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	if config.EnforcementPolicy == coredata.SAMLEnforcementPolicyOff {
+		return nil, NewOIDCDisabledError()
+	}
+
+	oauth2Config, _, err := s.oauth2Config(ctx, config)
+	if err != nil {
+		return nil, err
+	}
+
+	authorizationRequest := &coredata.OIDCAuthorizationRequest{
+		ID:                  rand.Text(),
+		OIDCConfigurationID: config.ID,
+		Nonce:               rand.Text(),
+		CodeVerifier:        oauth2.GenerateVerifier(),
+		ContinueURL:         continuePath,
+		CreatedAt:           now,
+		ExpiresAt:           now.Add(authorizationRequestTTL),
+	}
+
+	err = s.pg.WithConn(
+		ctx,
+		func(conn pg.Conn) error {
+			if err := authorizationRequest.Insert(ctx, conn); err != nil {
+				return fmt.Errorf("cannot insert OIDC authorization request: %w", err)
+			}
+
+			return nil
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	redirect, err := url.Parse(
+		oauth2Config.AuthCodeURL(
+			authorizationRequest.ID,
+			gooidc.Nonce(authorizationRequest.Nonce),
+			oauth2.S256ChallengeOption(authorizationRequest.CodeVerifier),
+		),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("cannot parse authorization URL: %w", err)
+	}
+
+	return redirect, nil
+}
+
+// HandleCallback completes the authorization code flow. The authorization
+// request matching state is consumed before the code is exchanged so a
+// state value can never be replayed, even when the exchange fails.
+func (s *Service) HandleCallback(
+	ctx context.Context,
+	state string,
+	code string,
+) (*CallbackResult, error) {
+	var (
+		now                  = time.Now()
+		authorizationRequest = &coredata.OIDCAuthorizationRequest{}
+		config               = &coredata.OIDCConfiguration{}
+	)
+
+	err := s.pg.WithTx(
+		ctx,
+		func(tx pg.Conn) error {
+			if err := authorizationRequest.LoadByIDForUpdate(ctx, tx, state); err != nil {
+				if err == coredata.ErrResourceNotFound {
+					return NewInvalidAuthorizationRequestError()
+				}
+
+				return fmt.Errorf("cannot load OIDC authorization request: %w", err)
+			}
+
+			if err := authorizationRequest.Delete(ctx, tx); err != nil {
+				return fmt.Errorf("cannot delete OIDC authorization request: %w", err)
+			}
+
+			err := config.LoadByID(ctx, tx, coredata.NewNoScope(), authorizationRequest.OIDCConfigurationID)
+			if err != nil {
+				if err == coredata.ErrResourceNotFound {
+					return NewOIDCConfigurationNotFoundError(authorizationRequest.OIDCConfigurationID)
+				}
+
+				return fmt.Errorf("cannot load OIDC configuration: %w", err)
+			}
+
+			return nil
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	if authorizationRequest.IsExpired(now) {
+		return nil, NewInvalidAuthorizationRequestError()
+	}
+
+	if config.EnforcementPolicy == coredata.SAMLEnforcementPolicyOff {
+		return nil, NewOIDCDisabledError()
+	}
+
+	claims, err := s.exchangeCode(ctx, config, authorizationRequest, code)
+	if err != nil {
+		return nil, err
+	}
+
+	email, fullname, err := extractUserInfo(claims)
+	if err != nil {
+		return nil, NewInvalidIDTokenError(err)
+	}
+
+	if claims.EmailVerified != nil && !bool(*claims.EmailVerified) {
+		return nil, NewEmailNotVerifiedError(email)
+	}
+
+	if !strings.EqualFold(email.Domain(), config.EmailDomain) {
+		return nil, NewEmailDomainMismatchError(email, config.EmailDomain)
+	}
+
+	var (
+		identity   = &coredata.Identity{}
+		membership = &coredata.Membership{}
+	)
+
+	err = s.pg.WithTx(
+		ctx,
+		func(tx pg.Conn) error {
+			err := identity.LoadByEmail(ctx, tx, email)
+			if err == coredata.ErrResourceNotFound && !config.AutoSignupEnabled {
+				return NewOIDCAutoSignupDisabledError(config.ID)
+			} else if err == coredata.ErrResourceNotFound && config.AutoSignupEnabled {
+				*identity = coredata.Identity{
+					ID:                   gid.New(gid.NilTenant, coredata.IdentityEntityType),
+					EmailAddress:         email,
+					FullName:             fullname,
+					HashedPassword:       nil,
+					EmailAddressVerified: true,
+					CreatedAt:            now,
+					UpdatedAt:            now,
+				}
+
+				if err := identity.Insert(ctx, tx); err != nil {
+					return fmt.Errorf("cannot insert identity: %w", err)
+				}
+			} else if err != nil {
+				return fmt.Errorf("cannot load identity: %w", err)
+			} else {
+				identity.FullName = fullname
+				identity.EmailAddressVerified = true
+				identity.UpdatedAt = now
+
+				if err := identity.Update(ctx, tx); err != nil {
+					return fmt.Errorf("cannot update identity: %w", err)
+				}
+			}
+
+			scope := coredata.NewScopeFromObjectID(config.OrganizationID)
+
+			err = membership.LoadByIdentityAndOrg(ctx, tx, scope, identity.ID, config.OrganizationID)
+			if err != nil && err != coredata.ErrResourceNotFound {
+				return fmt.Errorf("cannot load membership: %w", err)
+			}
+
+			if membership.ID != gid.Nil && membership.State == coredata.MembershipStateInactive {
+				return NewMembershipInactiveError(membership.ID)
+			}
+
+			if membership.ID == gid.Nil {
+				membership = &coredata.Membership{
+					ID:             gid.New(config.ID.TenantID(), coredata.MembershipEntityType),
+					IdentityID:     identity.ID,
+					OrganizationID: config.OrganizationID,
+					Role:           coredata.MembershipRoleEmployee,
+					Source:         coredata.MembershipSourceOIDC,
+					State:          coredata.MembershipStateActive,
+					CreatedAt:      now,
+					UpdatedAt:      now,
+				}
+
+				if err := membership.Insert(ctx, tx, scope); err != nil {
+					return fmt.Errorf("cannot insert membership: %w", err)
+				}
+
+				membershipProfile := &coredata.MembershipProfile{
+					ID:             gid.New(membership.ID.TenantID(), coredata.MembershipProfileEntityType),
+					IdentityID:     identity.ID,
+					OrganizationID: config.OrganizationID,
+					MembershipID:   membership.ID,
+					FullName:       fullname,
+					CreatedAt:      now,
+					UpdatedAt:      now,
+				}
+
+				if err := membershipProfile.Insert(ctx, tx); err != nil {
+					return fmt.Errorf("cannot insert membership profile: %w", err)
+				}
+
+				// Expire all pending invitations for email in organization
+				invitations := &coredata.Invitations{}
+				onlyPending := coredata.NewInvitationFilter([]coredata.InvitationStatus{coredata.InvitationStatusPending})
+				if err := invitations.ExpireByEmailAndOrganization(
+					ctx,
+					tx,
+					scope,
+					email,
+					config.OrganizationID,
+					onlyPending,
+				); err != nil {
+					return fmt.Errorf("cannot expire pending invitations by email: %w", err)
+				}
+
+				return nil
+			}
+
+			if membership.Source == coredata.MembershipSourceSCIM {
+				return nil
+			}
+
+			if membership.Source == coredata.MembershipSourceManual {
+				membership.Source = coredata.MembershipSourceOIDC
+				membership.UpdatedAt = now
+
+				if err := membership.Update(ctx, tx, scope); err != nil {
+					return fmt.Errorf("cannot update membership: %w", err)
+				}
+			}
+
+			memberProfile := &coredata.MembershipProfile{}
+			if err := memberProfile.LoadByMembershipID(ctx, tx, scope, membership.ID); err != nil {
+				return fmt.Errorf("cannot load membership profile: %w", err)
+			}
+
+			memberProfile.FullName = fullname
+			memberProfile.UpdatedAt = now
+			if err := memberProfile.Update(ctx, tx, scope); err != nil {
+				return fmt.Errorf("cannot update membership profile: %w", err)
+			}
+
+			return nil
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &CallbackResult{
+		Identity:    identity,
+		Membership:  membership,
+		ContinueURL: authorizationRequest.ContinueURL,
+	}, nil
+}
+
+func (s *Service) exchangeCode(
+	ctx context.Context,
+	config *coredata.OIDCConfiguration,
+	authorizationRequest *coredata.OIDCAuthorizationRequest,
+	code string,
+) (*idTokenClaims, error) {
+	oauth2Config, provider, err := s.oauth2Config(ctx, config)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx = context.WithValue(ctx, oauth2.HTTPClient, s.httpClient)
+
+	token, err := oauth2Config.Exchange(ctx, code, oauth2.VerifierOption(authorizationRequest.CodeVerifier))
+	if err != nil {
+		return nil, fmt.Errorf("cannot exchange authorization code: %w", err)
+	}
+
+	rawIDToken, ok := token.Extra("id_token").(string)
+	if !ok || rawIDToken == "" {
+		return nil, NewInvalidIDTokenError(fmt.Errorf("token response has no id_token"))
+	}
+
+	idToken, err := provider.
+		Verifier(&gooidc.Config{ClientID: config.ClientID}).
+		Verify(gooidc.ClientContext(ctx, s.httpClient), rawIDToken)
+	if err != nil {
+		return nil, NewInvalidIDTokenError(err)
+	}
+
+	if idToken.Nonce != authorizationRequest.Nonce {
+		return nil, NewInvalidIDTokenError(fmt.Errorf("nonce mismatch"))
+	}
+
+	claims := &idTokenClaims{}
+	if err := idToken.Claims(claims); err != nil {
+		return nil, NewInvalidIDTokenError(err)
+	}
+
+	return claims, nil
+}
+
+// oauth2Config performs issuer discovery and builds the OAuth2 client
+// configuration for the given OIDC configuration.
+func (s *Service) oauth2Config(
+	ctx context.Context,
+	config *coredata.OIDCConfiguration,
+) (*oauth2.Config, *gooidc.Provider, error) {
+	provider, err := gooidc.NewProvider(gooidc.ClientContext(ctx, s.httpClient), config.IssuerURL)
+	if err != nil {
+		return nil, nil, fmt.Errorf("cannot discover OIDC provider %q: %w", config.IssuerURL, err)
+	}
+
+	clientSecret, err := cipher.Decrypt(config.EncryptedClientSecret, s.encryptionKey)
+	if err != nil {
+		return nil, nil, fmt.Errorf("cannot decrypt client secret: %w", err)
+	}
+
+	return &oauth2.Config{
+		ClientID:     config.ClientID,
+		ClientSecret: string(clientSecret),
+		Endpoint:     provider.Endpoint(),
+		RedirectURL:  s.RedirectURL(),
+		Scopes:       []string{gooidc.ScopeOpenID, "email", "profile"},
+	}, provider, nil
+}
@@ -19,14 +19,18 @@ import (
 	"errors"
 	"fmt"
 	"io"
+	"strings"
 	"time"
 
 	"go.gearno.de/crypto/uuid"
 	"go.gearno.de/kit/pg"
 	"go.probo.inc/probo/packages/emails"
+	"go.probo.inc/probo/pkg/auditlog"
 	"go.probo.inc/probo/pkg/coredata"
+	"go.probo.inc/probo/pkg/crypto/cipher"
 	"go.probo.inc/probo/pkg/filevalidation"
 	"go.probo.inc/probo/pkg/gid"
+	"go.probo.inc/probo/pkg/iam/policy"
 	"go.probo.inc/probo/pkg/iam/scim"
 	"go.probo.inc/probo/pkg/mail"
 	"go.probo.inc/probo/pkg/page"
@@ -65,6 +69,7 @@ type (
 		WebsiteURL         **string
 		Email              **string
 		HeadquarterAddress **string
+		MFARequired        *bool
 	}
 
 	CreateSAMLConfigurationRequest struct {
@@ -92,6 +97,31 @@ type (
 		AutoSignupEnabled  *bool
 	}
 
+	CreateOIDCConfigurationRequest struct {
+		EmailDomain       string
+		IssuerURL         string
+		ClientID          string
+		ClientSecret      string
+		AutoSignupEnabled bool
+	}
+
+	UpdateOIDCConfigurationRequest struct {
+		EnforcementPolicy *coredata.SAMLEnforcementPolicy
+		IssuerURL         *string
+		ClientID          *string
+		ClientSecret      *string
+		AutoSignupEnabled *bool
+	}
+
+	CreateSCIMGroupRoleMappingRequest struct {
+		GroupDisplayName string
+		Role             coredata.MembershipRole
+	}
+
+	UpdateSCIMGroupRoleMappingRequest struct {
+		Role coredata.MembershipRole
+	}
+
 	CreateInvitationRequest struct {
 		Email    mail.Addr
 		FullName string
@@ -107,6 +137,11 @@ type (
 		ContractStartDate        **time.Time
 		ContractEndDate          **time.Time
 	}
+
+	UpdateProfileNotificationPreferencesRequest struct {
+		ID                  gid.GID
+		WeeklyDigestEnabled *bool
+	}
 )
 
 var (
@@ -129,6 +164,15 @@ var (
 		TermsOfServiceURL:    "https://www.getprobo.com/terms",
 		SubprocessorsListURL: "https://www.getprobo.com/subprocessors",
 	}
+
+	// Owners are granted by hand only, never through an identity provider
+	// group.
+	scimGroupMappableRoles = []coredata.MembershipRole{
+		coredata.MembershipRoleAdmin,
+		coredata.MembershipRoleEmployee,
+		coredata.MembershipRoleViewer,
+		coredata.MembershipRoleAuditor,
+	}
 )
 
 const (
@@ -263,6 +307,8 @@ func (s *OrganizationService) UpdateMempership(
 				return NewMembershipNotFoundError(membership.ID)
 			}
 
+			before := membershipAuditState(&membership)
+
 			membership.Role = role
 			membership.UpdatedAt = time.Now()
 
@@ -270,6 +316,10 @@ func (s *OrganizationService) UpdateMempership(
 				return fmt.Errorf("cannot update membership: %w", err)
 			}
 
+			if err := auditlog.Record(ctx, tx, scope, organizationID, ActionMembershipUpdate, membership.ID, before, membershipAuditState(&membership)); err != nil {
+				return fmt.Errorf("cannot record audit log entry: %w", err)
+			}
+
 			return nil
 		},
 	); err != nil {
@@ -327,6 +377,10 @@ func (s *OrganizationService) RemoveMember(
 				return fmt.Errorf("cannot delete membership: %w", err)
 			}
 
+			if err := auditlog.Record(ctx, tx, scope, organizationID, ActionMembershipDelete, membership.ID, membershipAuditState(&membership), nil); err != nil {
+				return fmt.Errorf("cannot record audit log entry: %w", err)
+			}
+
 			return nil
 		},
 	)
@@ -363,6 +417,10 @@ func (s *OrganizationService) DeleteInvitation(
 				return fmt.Errorf("cannot delete invitation: %w", err)
 			}
 
+			if err := auditlog.Record(ctx, tx, scope, organizationID, ActionInvitationDelete, invitation.ID, invitationAuditState(&invitation), nil); err != nil {
+				return fmt.Errorf("cannot record audit log entry: %w", err)
+			}
+
 			return nil
 		},
 	)
@@ -482,6 +540,10 @@ func (s *OrganizationService) InviteMember(
 				return fmt.Errorf("cannot insert invitation: %w", err)
 			}
 
+			if err := auditlog.Record(ctx, tx, scope, organizationID, ActionInvitationCreate, invitation.ID, nil, invitationAuditState(invitation)); err != nil {
+				return fmt.Errorf("cannot record audit log entry: %w", err)
+			}
+
 			invitationToken, err := statelesstoken.NewToken(
 				s.tokenSecret,
 				TokenTypeOrganizationInvitation,
@@ -511,6 +573,7 @@ func (s *OrganizationService) InviteMember(
 				textBody,
 				htmlBody,
 			)
+			email.OrganizationID = &organization.ID
 
 			err = email.Insert(ctx, tx)
 			if err != nil {
@@ -576,6 +639,8 @@ func (s *OrganizationService) CreateOrganization(
 			UpdatedAt:      now,
 		}
 
+		deadlineReminderSettings = coredata.NewDeadlineReminderSettings(tenantID, organizationID, now)
+
 		logoFile           *coredata.File
 		horizontalLogoFile *coredata.File
 		scope              = coredata.NewScope(tenantID)
@@ -716,6 +781,10 @@ func (s *OrganizationService) CreateOrganization(
 				return fmt.Errorf("cannot insert trust center: %w", err)
 			}
 
+			if err := deadlineReminderSettings.Insert(ctx, tx, scope); err != nil {
+				return fmt.Errorf("cannot insert deadline reminder settings: %w", err)
+			}
+
 			proboData := &coredata.Vendor{
 				ID:                   gid.New(scope.GetTenantID(), coredata.VendorEntityType),
 				TenantID:             organization.TenantID,
@@ -738,6 +807,10 @@ func (s *OrganizationService) CreateOrganization(
 				return fmt.Errorf("cannot insert vendor: %w", err)
 			}
 
+			if err := auditlog.Record(ctx, tx, scope, organization.ID, ActionOrganizationCreate, organization.ID, nil, organizationAuditState(organization)); err != nil {
+				return fmt.Errorf("cannot record audit log entry: %w", err)
+			}
+
 			return nil
 		},
 	)
@@ -846,6 +919,8 @@ func (s *OrganizationService) UpdateOrganization(ctx context.Context, organizati
 				return fmt.Errorf("cannot load organization: %w", err)
 			}
 
+			before := organizationAuditState(organization)
+
 			organization.UpdatedAt = now
 
 			if req.Name != nil {
@@ -877,6 +952,10 @@ func (s *OrganizationService) UpdateOrganization(ctx context.Context, organizati
 				organization.HeadquarterAddress = *req.HeadquarterAddress
 			}
 
+			if req.MFARequired != nil {
+				organization.MFARequired = *req.MFARequired
+			}
+
 			if logoFile != nil {
 				if err := logoFile.Insert(ctx, tx, scope); err != nil {
 					return fmt.Errorf("cannot insert file: %w", err)
@@ -913,6 +992,10 @@ func (s *OrganizationService) UpdateOrganization(ctx context.Context, organizati
 				return fmt.Errorf("cannot update organization: %w", err)
 			}
 
+			if err := auditlog.Record(ctx, tx, scope, organizationID, ActionOrganizationUpdate, organization.ID, before, organizationAuditState(organization)); err != nil {
+				return fmt.Errorf("cannot record audit log entry: %w", err)
+			}
+
 			return nil
 		},
 	)
@@ -940,6 +1023,10 @@ func (s *OrganizationService) DeleteOrganization(ctx context.Context, organizati
 				return fmt.Errorf("cannot delete organization: %w", err)
 			}
 
+			if err := auditlog.Record(ctx, tx, scope, organizationID, ActionOrganizationDelete, organization.ID, organizationAuditState(organization), nil); err != nil {
+				return fmt.Errorf("cannot record audit log entry: %w", err)
+			}
+
 			return nil
 		},
 	)
@@ -984,13 +1071,15 @@ func (s *OrganizationService) UpdateProfile(ctx context.Context, req *UpdateProf
 		profile = &coredata.MembershipProfile{}
 	)
 
-	err := s.pg.WithConn(
+	err := s.pg.WithTx(
 		ctx,
-		func(conn pg.Conn) error {
-			if err := profile.LoadByID(ctx, conn, scope, req.ID); err != nil {
+		func(tx pg.Conn) error {
+			if err := profile.LoadByID(ctx, tx, scope, req.ID); err != nil {
 				return fmt.Errorf("cannot load profile: %w", err)
 			}
 
+			before := membershipProfileAuditState(profile)
+
 			profile.FullName = req.FullName
 			profile.Kind = req.Kind
 
@@ -1010,10 +1099,14 @@ func (s *OrganizationService) UpdateProfile(ctx context.Context, req *UpdateProf
 
 			profile.UpdatedAt = time.Now()
 
-			if err := profile.Update(ctx, conn, scope); err != nil {
+			if err := profile.Update(ctx, tx, scope); err != nil {
 				return fmt.Errorf("cannot update profile: %w", err)
 			}
 
+			if err := auditlog.Record(ctx, tx, scope, profile.OrganizationID, ActionMembershipProfileUpdate, profile.ID, before, membershipProfileAuditState(profile)); err != nil {
+				return fmt.Errorf("cannot record audit log entry: %w", err)
+			}
+
 			return nil
 		},
 	)
@@ -1025,6 +1118,56 @@ func (s *OrganizationService) UpdateProfile(ctx context.Context, req *UpdateProf
 	return profile, nil
 }
 
+func (r *UpdateProfileNotificationPreferencesRequest) Validate() error {
+	v := validator.New()
+
+	v.Check(r.ID, "id", validator.Required(), validator.GID(coredata.MembershipProfileEntityType))
+
+	return v.Error()
+}
+
+// UpdateProfileNotificationPreferences changes which optional emails the
+// member of a profile receives.
+func (s *OrganizationService) UpdateProfileNotificationPreferences(
+	ctx context.Context,
+	req *UpdateProfileNotificationPreferencesRequest,
+) (*coredata.MembershipProfile, error) {
+	if err := req.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid request: %w", err)
+	}
+
+	var (
+		scope   = coredata.NewScopeFromObjectID(req.ID)
+		profile = &coredata.MembershipProfile{}
+	)
+
+	err := s.pg.WithTx(
+		ctx,
+		func(tx pg.Conn) error {
+			if err := profile.LoadByID(ctx, tx, scope, req.ID); err != nil {
+				return fmt.Errorf("cannot load profile: %w", err)
+			}
+
+			if req.WeeklyDigestEnabled != nil {
+				profile.WeeklyDigestEnabled = *req.WeeklyDigestEnabled
+			}
+
+			profile.UpdatedAt = time.Now()
+
+			if err := profile.Update(ctx, tx, scope); err != nil {
+				return fmt.Errorf("cannot update profile: %w", err)
+			}
+
+			return nil
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return profile, nil
+}
+
 func (s *OrganizationService) GetProfile(ctx context.Context, profileID gid.GID) (*coredata.MembershipProfile, error) {
 	profile := &coredata.MembershipProfile{}
 
@@ -1294,6 +1437,10 @@ func (s OrganizationService) DeleteSAMLConfiguration(
 				return fmt.Errorf("cannot delete saml configuration: %w", err)
 			}
 
+			if err := auditlog.Record(ctx, tx, scope, organizationID, ActionSAMLConfigurationDelete, config.ID, samlConfigurationAuditState(&config), nil); err != nil {
+				return fmt.Errorf("cannot record audit log entry: %w", err)
+			}
+
 			return nil
 		},
 	)
@@ -1467,6 +1614,11 @@ func (s OrganizationService) CreateSCIMConfiguration(
 				}
 				return fmt.Errorf("cannot insert SCIM configuration: %w", err)
 			}
+
+			if err := auditlog.Record(ctx, tx, scope, organizationID, ActionSCIMConfigurationCreate, config.ID, nil, scimConfigurationAuditState(config)); err != nil {
+				return fmt.Errorf("cannot record audit log entry: %w", err)
+			}
+
 			return nil
 		},
 	)
@@ -1537,6 +1689,10 @@ func (s OrganizationService) DeleteSCIMConfiguration(
 				return fmt.Errorf("cannot delete SCIM configuration: %w", err)
 			}
 
+			if err := auditlog.Record(ctx, tx, scope, organizationID, ActionSCIMConfigurationDelete, config.ID, scimConfigurationAuditState(config), nil); err != nil {
+				return fmt.Errorf("cannot record audit log entry: %w", err)
+			}
+
 			return nil
 		},
 	)
@@ -1580,6 +1736,13 @@ func (s OrganizationService) RegenerateSCIMToken(
 				return fmt.Errorf("cannot update SCIM configuration: %w", err)
 			}
 
+			after := scimConfigurationAuditState(config)
+			after["tokenRegenerated"] = true
+
+			if err := auditlog.Record(ctx, tx, scope, organizationID, ActionSCIMConfigurationUpdate, config.ID, scimConfigurationAuditState(config), after); err != nil {
+				return fmt.Errorf("cannot record audit log entry: %w", err)
+			}
+
 			return nil
 		},
 	)
@@ -1616,6 +1779,8 @@ func (s OrganizationService) UpdateSCIMBridge(
 				return fmt.Errorf("SCIM bridge not found")
 			}
 
+			before := scimBridgeAuditState(bridge)
+
 			bridge.ExcludedUserNames = excludedUserNames
 			bridge.UpdatedAt = time.Now()
 
@@ -1624,6 +1789,10 @@ func (s OrganizationService) UpdateSCIMBridge(
 				return fmt.Errorf("cannot update SCIM bridge: %w", err)
 			}
 
+			if err := auditlog.Record(ctx, tx, scope, organizationID, ActionSCIMBridgeUpdate, bridge.ID, before, scimBridgeAuditState(bridge)); err != nil {
+				return fmt.Errorf("cannot record audit log entry: %w", err)
+			}
+
 			return nil
 		},
 	)
@@ -1688,6 +1857,220 @@ func (s OrganizationService) CountSCIMEventsByConfigID(
 	return count, err
 }
 
+func (req *CreateSCIMGroupRoleMappingRequest) Validate() error {
+	v := validator.New()
+
+	v.Check(req.GroupDisplayName, "group_display_name", validator.Required(), validator.SafeTextNoNewLine(TitleMaxLength))
+	v.Check(req.Role, "role", validator.Required(), validator.OneOfSlice(scimGroupMappableRoles))
+
+	return v.Error()
+}
+
+func (req *UpdateSCIMGroupRoleMappingRequest) Validate() error {
+	v := validator.New()
+
+	v.Check(req.Role, "role", validator.Required(), validator.OneOfSlice(scimGroupMappableRoles))
+
+	return v.Error()
+}
+
+func (s OrganizationService) ListSCIMGroupRoleMappings(
+	ctx context.Context,
+	organizationID gid.GID,
+) (coredata.SCIMGroupRoleMappings, error) {
+	var (
+		scope    = coredata.NewScopeFromObjectID(organizationID)
+		mappings = coredata.SCIMGroupRoleMappings{}
+	)
+
+	err := s.pg.WithConn(
+		ctx,
+		func(conn pg.Conn) error {
+			if err := mappings.LoadByOrganizationID(ctx, conn, scope, organizationID); err != nil {
+				return fmt.Errorf("cannot load SCIM group role mappings: %w", err)
+			}
+
+			return nil
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return mappings, nil
+}
+
+func (s OrganizationService) CreateSCIMGroupRoleMapping(
+	ctx context.Context,
+	organizationID gid.GID,
+	req *CreateSCIMGroupRoleMappingRequest,
+) (*coredata.SCIMGroupRoleMapping, error) {
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
+
+	var (
+		now     = time.Now()
+		scope   = coredata.NewScopeFromObjectID(organizationID)
+		mapping = &coredata.SCIMGroupRoleMapping{
+			ID:               gid.New(scope.GetTenantID(), coredata.SCIMGroupRoleMappingEntityType),
+			OrganizationID:   organizationID,
+			GroupDisplayName: req.GroupDisplayName,
+			Role:             req.Role,
+			CreatedAt:        now,
+			UpdatedAt:        now,
+		}
+	)
+
+	err := s.pg.WithTx(
+		ctx,
+		func(tx pg.Conn) error {
+			if err := mapping.Insert(ctx, tx, scope); err != nil {
+				if errors.Is(err, coredata.ErrResourceAlreadyExists) {
+					return NewSCIMGroupRoleMappingAlreadyExistsError(req.GroupDisplayName)
+				}
+
+				return fmt.Errorf("cannot insert SCIM group role mapping: %w", err)
+			}
+
+			if err := syncSCIMGroupMemberRoles(ctx, tx, scope, organizationID, mapping.GroupDisplayName); err != nil {
+				return err
+			}
+
+			if err := auditlog.Record(ctx, tx, scope, organizationID, ActionSCIMGroupRoleMappingCreate, mapping.ID, nil, scimGroupRoleMappingAuditState(mapping)); err != nil {
+				return fmt.Errorf("cannot record audit log entry: %w", err)
+			}
+
+			return nil
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return mapping, nil
+}
+
+func (s OrganizationService) UpdateSCIMGroupRoleMapping(
+	ctx context.Context,
+	organizationID gid.GID,
+	mappingID gid.GID,
+	req *UpdateSCIMGroupRoleMappingRequest,
+) (*coredata.SCIMGroupRoleMapping, error) {
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
+
+	var (
+		scope   = coredata.NewScopeFromObjectID(organizationID)
+		mapping = &coredata.SCIMGroupRoleMapping{}
+	)
+
+	err := s.pg.WithTx(
+		ctx,
+		func(tx pg.Conn) error {
+			if err := mapping.LoadByID(ctx, tx, scope, mappingID); err != nil {
+				if err == coredata.ErrResourceNotFound {
+					return NewSCIMGroupRoleMappingNotFoundError(mappingID)
+				}
+
+				return fmt.Errorf("cannot load SCIM group role mapping: %w", err)
+			}
+
+			if mapping.OrganizationID != organizationID {
+				return NewSCIMGroupRoleMappingNotFoundError(mappingID)
+			}
+
+			before := scimGroupRoleMappingAuditState(mapping)
+
+			mapping.Role = req.Role
+			mapping.UpdatedAt = time.Now()
+
+			if err := mapping.Update(ctx, tx, scope); err != nil {
+				return fmt.Errorf("cannot update SCIM group role mapping: %w", err)
+			}
+
+			if err := syncSCIMGroupMemberRoles(ctx, tx, scope, organizationID, mapping.GroupDisplayName); err != nil {
+				return err
+			}
+
+			if err := auditlog.Record(ctx, tx, scope, organizationID, ActionSCIMGroupRoleMappingUpdate, mapping.ID, before, scimGroupRoleMappingAuditState(mapping)); err != nil {
+				return fmt.Errorf("cannot record audit log entry: %w", err)
+			}
+
+			return nil
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return mapping, nil
+}
+
+func (s OrganizationService) DeleteSCIMGroupRoleMapping(
+	ctx context.Context,
+	organizationID gid.GID,
+	mappingID gid.GID,
+) error {
+	scope := coredata.NewScopeFromObjectID(organizationID)
+
+	return s.pg.WithTx(
+		ctx,
+		func(tx pg.Conn) error {
+			var mapping coredata.SCIMGroupRoleMapping
+			if err := mapping.LoadByID(ctx, tx, scope, mappingID); err != nil {
+				if err == coredata.ErrResourceNotFound {
+					return NewSCIMGroupRoleMappingNotFoundError(mappingID)
+				}
+
+				return fmt.Errorf("cannot load SCIM group role mapping: %w", err)
+			}
+
+			if mapping.OrganizationID != organizationID {
+				return NewSCIMGroupRoleMappingNotFoundError(mappingID)
+			}
+
+			if err := mapping.Delete(ctx, tx, scope); err != nil {
+				return fmt.Errorf("cannot delete SCIM group role mapping: %w", err)
+			}
+
+			if err := syncSCIMGroupMemberRoles(ctx, tx, scope, organizationID, mapping.GroupDisplayName); err != nil {
+				return err
+			}
+
+			if err := auditlog.Record(ctx, tx, scope, organizationID, ActionSCIMGroupRoleMappingDelete, mapping.ID, scimGroupRoleMappingAuditState(&mapping), nil); err != nil {
+				return fmt.Errorf("cannot record audit log entry: %w", err)
+			}
+
+			return nil
+		},
+	)
+}
+
+// syncSCIMGroupMemberRoles re-evaluates the role of every member of the
+// SCIM groups named groupDisplayName after a mapping change.
+func syncSCIMGroupMemberRoles(
+	ctx context.Context,
+	tx pg.Conn,
+	scope coredata.Scoper,
+	organizationID gid.GID,
+	groupDisplayName string,
+) error {
+	members := coredata.SCIMGroupMembers{}
+	if err := members.LoadByGroupDisplayName(ctx, tx, scope, organizationID, groupDisplayName); err != nil {
+		return fmt.Errorf("cannot load SCIM group members: %w", err)
+	}
+
+	for _, member := range members {
+		if err := scim.SyncMembershipRole(ctx, tx, scope, organizationID, member.MembershipID); err != nil {
+			return fmt.Errorf("cannot sync membership role: %w", err)
+		}
+	}
+
+	return nil
+}
+
 func (s OrganizationService) CreateSAMLConfiguration(
 	ctx context.Context,
 	organizationID gid.GID,
@@ -1750,6 +2133,10 @@ func (s OrganizationService) CreateSAMLConfiguration(
 				return fmt.Errorf("cannot insert saml configuration: %w", err)
 			}
 
+			if err := auditlog.Record(ctx, tx, scope, organizationID, ActionSAMLConfigurationCreate, config.ID, nil, samlConfigurationAuditState(config)); err != nil {
+				return fmt.Errorf("cannot record audit log entry: %w", err)
+			}
+
 			return nil
 		},
 	)
@@ -1786,6 +2173,8 @@ func (s OrganizationService) UpdateSAMLConfiguration(
 				return fmt.Errorf("cannot load saml configuration: %w", err)
 			}
 
+			before := samlConfigurationAuditState(config)
+
 			if req.EnforcementPolicy != nil {
 				if config.DomainVerifiedAt == nil {
 					return NewSAMLConfigurationDomainNotVerifiedError(configID)
@@ -1833,6 +2222,10 @@ func (s OrganizationService) UpdateSAMLConfiguration(
 				return fmt.Errorf("cannot update saml configuration: %w", err)
 			}
 
+			if err := auditlog.Record(ctx, tx, scope, organizationID, ActionSAMLConfigurationUpdate, config.ID, before, samlConfigurationAuditState(config)); err != nil {
+				return fmt.Errorf("cannot record audit log entry: %w", err)
+			}
+
 			return nil
 		},
 	)
@@ -1844,6 +2237,262 @@ func (s OrganizationService) UpdateSAMLConfiguration(
 
 }
 
+func (req *CreateOIDCConfigurationRequest) Validate() error {
+	v := validator.New()
+
+	v.Check(req.EmailDomain, "email_domain", validator.Required(), validator.Domain())
+	v.Check(req.IssuerURL, "issuer_url", validator.Required(), validator.HTTPSUrl())
+	v.Check(req.ClientID, "client_id", validator.Required(), validator.SafeTextNoNewLine(ContentMaxLength))
+	v.Check(req.ClientSecret, "client_secret", validator.Required())
+
+	return v.Error()
+}
+
+func (req *UpdateOIDCConfigurationRequest) Validate() error {
+	v := validator.New()
+
+	v.Check(req.IssuerURL, "issuer_url", validator.Required(), validator.HTTPSUrl())
+	v.Check(req.ClientID, "client_id", validator.Required(), validator.SafeTextNoNewLine(ContentMaxLength))
+	v.Check(req.ClientSecret, "client_secret", validator.Required())
+
+	return v.Error()
+}
+
+func (s OrganizationService) CreateOIDCConfiguration(
+	ctx context.Context,
+	organizationID gid.GID,
+	req *CreateOIDCConfigurationRequest,
+) (*coredata.OIDCConfiguration, error) {
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
+
+	encryptedClientSecret, err := cipher.Encrypt([]byte(req.ClientSecret), s.encryptionKey)
+	if err != nil {
+		return nil, fmt.Errorf("cannot encrypt client secret: %w", err)
+	}
+
+	var (
+		now                     = time.Now()
+		scope                   = coredata.NewScopeFromObjectID(organizationID)
+		domainVerificationToken = uuid.MustNewV4().String()
+		config                  = &coredata.OIDCConfiguration{
+			ID:                      gid.New(scope.GetTenantID(), coredata.OIDCConfigurationEntityType),
+			OrganizationID:          organizationID,
+			EmailDomain:             strings.ToLower(req.EmailDomain),
+			EnforcementPolicy:       coredata.SAMLEnforcementPolicyOff,
+			IssuerURL:               req.IssuerURL,
+			ClientID:                req.ClientID,
+			EncryptedClientSecret:   encryptedClientSecret,
+			AutoSignupEnabled:       req.AutoSignupEnabled,
+			DomainVerificationToken: &domainVerificationToken,
+			CreatedAt:               now,
+			UpdatedAt:               now,
+		}
+	)
+
+	err = s.pg.WithTx(
+		ctx,
+		func(tx pg.Conn) error {
+			organization := &coredata.Organization{}
+			err := organization.LoadByID(ctx, tx, scope, organizationID)
+			if err != nil {
+				return fmt.Errorf("cannot load organization: %w", err)
+			}
+
+			err = config.Insert(ctx, tx, scope)
+			if err != nil {
+				if errors.Is(err, coredata.ErrResourceAlreadyExists) {
+					return NewOIDCConfigurationEmailDomainAlreadyExistsError(req.EmailDomain)
+				}
+
+				return fmt.Errorf("cannot insert oidc configuration: %w", err)
+			}
+
+			if err := auditlog.Record(ctx, tx, scope, organizationID, ActionOIDCConfigurationCreate, config.ID, nil, oidcConfigurationAuditState(config)); err != nil {
+				return fmt.Errorf("cannot record audit log entry: %w", err)
+			}
+
+			return nil
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return config, nil
+}
+
+func (s OrganizationService) UpdateOIDCConfiguration(
+	ctx context.Context,
+	organizationID gid.GID,
+	configID gid.GID,
+	req *UpdateOIDCConfigurationRequest,
+) (*coredata.OIDCConfiguration, error) {
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
+
+	var (
+		scope  = coredata.NewScopeFromObjectID(organizationID)
+		config = &coredata.OIDCConfiguration{}
+	)
+
+	err := s.pg.WithTx(
+		ctx,
+		func(tx pg.Conn) error {
+			err := config.LoadByID(ctx, tx, scope, configID)
+			if err != nil {
+				if err == coredata.ErrResourceNotFound {
+					return NewOIDCConfigurationNotFoundError(configID)
+				}
+
+				return fmt.Errorf("cannot load oidc configuration: %w", err)
+			}
+
+			if config.OrganizationID != organizationID {
+				return NewOIDCConfigurationNotFoundError(configID)
+			}
+
+			before := oidcConfigurationAuditState(config)
+
+			if req.EnforcementPolicy != nil {
+				if config.DomainVerifiedAt == nil {
+					return NewOIDCConfigurationDomainNotVerifiedError(configID)
+				}
+
+				config.EnforcementPolicy = *req.EnforcementPolicy
+			}
+
+			if req.IssuerURL != nil {
+				config.IssuerURL = *req.IssuerURL
+			}
+
+			if req.ClientID != nil {
+				config.ClientID = *req.ClientID
+			}
+
+			if req.ClientSecret != nil {
+				config.EncryptedClientSecret, err = cipher.Encrypt([]byte(*req.ClientSecret), s.encryptionKey)
+				if err != nil {
+					return fmt.Errorf("cannot encrypt client secret: %w", err)
+				}
+			}
+
+			if req.AutoSignupEnabled != nil {
+				config.AutoSignupEnabled = *req.AutoSignupEnabled
+			}
+
+			config.UpdatedAt = time.Now()
+
+			err = config.Update(ctx, tx, scope)
+			if err != nil {
+				return fmt.Errorf("cannot update oidc configuration: %w", err)
+			}
+
+			if err := auditlog.Record(ctx, tx, scope, organizationID, ActionOIDCConfigurationUpdate, config.ID, before, oidcConfigurationAuditState(config)); err != nil {
+				return fmt.Errorf("cannot record audit log entry: %w", err)
+			}
+
+			return nil
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return config, nil
+}
+
+func (s OrganizationService) DeleteOIDCConfiguration(
+	ctx context.Context,
+	organizationID gid.GID,
+	configID gid.GID,
+) error {
+	scope := coredata.NewScopeFromObjectID(organizationID)
+
+	return s.pg.WithTx(
+		ctx,
+		func(tx pg.Conn) error {
+			var config coredata.OIDCConfiguration
+			if err := config.LoadByID(ctx, tx, scope, configID); err != nil {
+				if err == coredata.ErrResourceNotFound {
+					return NewOIDCConfigurationNotFoundError(configID)
+				}
+
+				return fmt.Errorf("cannot load oidc configuration: %w", err)
+			}
+
+			if config.OrganizationID != organizationID {
+				return NewOIDCConfigurationNotFoundError(configID)
+			}
+
+			if err := config.Delete(ctx, tx, scope); err != nil {
+				return fmt.Errorf("cannot delete oidc configuration: %w", err)
+			}
+
+			if err := auditlog.Record(ctx, tx, scope, organizationID, ActionOIDCConfigurationDelete, config.ID, oidcConfigurationAuditState(&config), nil); err != nil {
+				return fmt.Errorf("cannot record audit log entry: %w", err)
+			}
+
+			return nil
+		},
+	)
+}
+
+func (s OrganizationService) ListOIDCConfigurations(
+	ctx context.Context,
+	organizationID gid.GID,
+	cursor *page.Cursor[coredata.OIDCConfigurationOrderField],
+) (*page.Page[*coredata.OIDCConfiguration, coredata.OIDCConfigurationOrderField], error) {
+	var (
+		scope              = coredata.NewScopeFromObjectID(organizationID)
+		oidcConfigurations = coredata.OIDCConfigurations{}
+	)
+
+	err := s.pg.WithConn(
+		ctx,
+		func(conn pg.Conn) error {
+			err := oidcConfigurations.LoadByOrganizationID(ctx, conn, scope, organizationID)
+			if err != nil {
+				return fmt.Errorf("cannot load oidc configurations: %w", err)
+			}
+
+			return nil
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return page.NewPage(oidcConfigurations, cursor), nil
+}
+
+func (s OrganizationService) CountOIDCConfigurations(
+	ctx context.Context,
+	organizationID gid.GID,
+) (int, error) {
+	var (
+		scope = coredata.NewScopeFromObjectID(organizationID)
+		count int
+	)
+
+	err := s.pg.WithConn(
+		ctx,
+		func(conn pg.Conn) (err error) {
+			oidcConfigurations := coredata.OIDCConfigurations{}
+			count, err = oidcConfigurations.CountByOrganizationID(ctx, conn, scope, organizationID)
+			if err != nil {
+				return fmt.Errorf("cannot count oidc configurations: %w", err)
+			}
+
+			return nil
+		},
+	)
+
+	return count, err
+}
+
 func (s OrganizationService) GetOrganization(ctx context.Context, organizationID gid.GID) (*coredata.Organization, error) {
 	var (
 		scope        = coredata.NewScopeFromObjectID(organizationID)
@@ -2053,6 +2702,10 @@ func (s OrganizationService) CreateSCIMBridge(
 			switch existingConnector.Provider {
 			case coredata.ConnectorProviderGoogleWorkspace:
 				bridgeType = coredata.SCIMBridgeTypeGoogleWorkspace
+			case coredata.ConnectorProviderMicrosoftEntraID:
+				bridgeType = coredata.SCIMBridgeTypeMicrosoftEntraID
+			case coredata.ConnectorProviderOkta:
+				bridgeType = coredata.SCIMBridgeTypeOkta
 			default:
 				return fmt.Errorf("connector provider %s is not supported for SCIM bridge", existingConnector.Provider)
 			}
@@ -2073,6 +2726,10 @@ func (s OrganizationService) CreateSCIMBridge(
 				return fmt.Errorf("cannot insert SCIM bridge: %w", err)
 			}
 
+			if err := auditlog.Record(ctx, tx, scope, organizationID, ActionSCIMBridgeCreate, bridge.ID, nil, scimBridgeAuditState(bridge)); err != nil {
+				return fmt.Errorf("cannot record audit log entry: %w", err)
+			}
+
 			return nil
 		},
 	)
@@ -2111,6 +2768,10 @@ func (s OrganizationService) DeleteSCIMBridge(ctx context.Context, organizationI
 				return fmt.Errorf("cannot delete SCIM bridge: %w", err)
 			}
 
+			if err := auditlog.Record(ctx, tx, scope, organizationID, ActionSCIMBridgeDelete, bridge.ID, scimBridgeAuditState(bridge), nil); err != nil {
+				return fmt.Errorf("cannot record audit log entry: %w", err)
+			}
+
 			return nil
 		},
 	)
@@ -2121,3 +2782,69 @@ func (s OrganizationService) DeleteSCIMBridge(ctx context.Context, organizationI
 
 	return nil
 }
+
+// SimulateAuthorization explains whether a member of the organization is
+// allowed to perform the action on a resource of the organization, and
+// which policy statements led to that decision.
+func (s *OrganizationService) SimulateAuthorization(
+	ctx context.Context,
+	organizationID gid.GID,
+	params AuthorizeParams,
+) (*AuthorizationSimulation, error) {
+	if !s.Authorizer.Actions().Exists(policy.Action(params.Action)) {
+		return nil, NewUnknownActionError(params.Action)
+	}
+
+	if _, ok := coredata.NewEntityFromID(params.Resource); !ok {
+		return nil, NewResourceNotInOrganizationError(params.Resource, organizationID)
+	}
+
+	err := s.pg.WithConn(
+		ctx,
+		func(conn pg.Conn) error {
+			membership := &coredata.Membership{}
+			if err := membership.LoadByIdentityInOrganization(ctx, conn, params.Principal, organizationID); err != nil {
+				if errors.Is(err, coredata.ErrResourceNotFound) {
+					return NewMembershipNotFoundError(params.Principal)
+				}
+
+				return fmt.Errorf("cannot load membership: %w", err)
+			}
+
+			if params.APIKey != nil {
+				apiKey := &coredata.PersonalAPIKey{}
+				if err := apiKey.LoadByID(ctx, conn, *params.APIKey); err != nil {
+					if errors.Is(err, coredata.ErrResourceNotFound) {
+						return NewPersonalAPIKeyNotFoundError(*params.APIKey)
+					}
+
+					return fmt.Errorf("cannot load personal api key: %w", err)
+				}
+
+				if apiKey.IdentityID != params.Principal {
+					return NewPersonalAPIKeyNotFoundError(*params.APIKey)
+				}
+			}
+
+			return nil
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	simulation, err := s.Authorizer.Simulate(ctx, params)
+	if err != nil {
+		if errors.Is(err, coredata.ErrResourceNotFound) {
+			return nil, NewResourceNotInOrganizationError(params.Resource, organizationID)
+		}
+
+		return nil, fmt.Errorf("cannot simulate authorization: %w", err)
+	}
+
+	if simulation.Trace.Request.ConditionContext.Resource["organization_id"] != organizationID.String() {
+		return nil, NewResourceNotInOrganizationError(params.Resource, organizationID)
+	}
+
+	return simulation, nil
+}
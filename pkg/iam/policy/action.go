@@ -89,6 +89,18 @@ func (r *ActionRegistry) All() []ActionDefinition {
 	return result
 }
 
+// MatchesAny checks if a pattern, possibly containing wildcards, matches
+// at least one registered action.
+func (r *ActionRegistry) MatchesAny(pattern string) bool {
+	matcher := NewActionMatcher()
+	for action := range r.actions {
+		if matcher.Matches(pattern, string(action)) {
+			return true
+		}
+	}
+	return false
+}
+
 // ByService returns all actions for a given service.
 func (r *ActionRegistry) ByService(service string) []ActionDefinition {
 	var result []ActionDefinition
@@ -0,0 +1,160 @@
+// Copyright (c) 2025 Probo Inc <hello@getprobo.com>.
+//
+// Permission to use, copy, modify, and/or distribute this software for any
+// purpose with or without fee is hereby granted, provided that the above
+// copyright notice and this permission notice appear in all copies.
+//
+// THE SOFTWARE IS PROVIDED "AS IS" AND THE AUTHOR DISCLAIMS ALL WARRANTIES WITH
+// REGARD TO THIS SOFTWARE INCLUDING ALL IMPLIED WARRANTIES OF MERCHANTABILITY
+// AND FITNESS. IN NO EVENT SHALL THE AUTHOR BE LIABLE FOR ANY SPECIAL, DIRECT,
+// INDIRECT, OR CONSEQUENTIAL DAMAGES OR ANY DAMAGES WHATSOEVER RESULTING FROM
+// LOSS OF USE, DATA OR PROFITS, WHETHER IN AN ACTION OF CONTRACT, NEGLIGENCE OR
+// OTHER TORTIOUS ACTION, ARISING OUT OF OR IN CONNECTION WITH THE USE OR
+// PERFORMANCE OF THIS SOFTWARE.
+
+package policy
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+)
+
+type (
+	// Document is the JSON representation of a policy, used to persist
+	// policies defined at runtime (e.g. organization custom roles).
+	Document struct {
+		Statements []DocumentStatement `json:"statements"`
+	}
+
+	// DocumentStatement is the JSON representation of a Statement.
+	DocumentStatement struct {
+		SID        string              `json:"sid,omitempty"`
+		Effect     Effect              `json:"effect"`
+		Actions    []string            `json:"actions"`
+		Resources  []DocumentResource  `json:"resources,omitempty"`
+		Conditions []DocumentCondition `json:"conditions,omitempty"`
+	}
+
+	// DocumentResource is the JSON representation of a ResourcePattern.
+	// Tenants are not exposed as documents are always scoped to the
+	// organization they belong to.
+	DocumentResource struct {
+		EntityType *uint16 `json:"entityType,omitempty"`
+	}
+
+	// DocumentCondition is the JSON representation of a Condition.
+	DocumentCondition struct {
+		Operator ConditionOperator `json:"operator"`
+		Key      string            `json:"key"`
+		Values   []string          `json:"values"`
+	}
+)
+
+var (
+	// ErrInvalidDocument is returned when a policy document is malformed or
+	// references unknown actions.
+	ErrInvalidDocument = errors.New("invalid policy document")
+
+	conditionOperators = []ConditionOperator{
+		ConditionEquals,
+		ConditionNotEquals,
+		ConditionIn,
+		ConditionNotIn,
+	}
+)
+
+// ParseDocument decodes and validates a JSON policy document. Every action
+// pattern must match at least one action of the registry.
+func ParseDocument(data []byte, registry *ActionRegistry) (*Document, error) {
+	var doc Document
+
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.DisallowUnknownFields()
+	if err := decoder.Decode(&doc); err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidDocument, err)
+	}
+
+	if err := doc.Validate(registry); err != nil {
+		return nil, err
+	}
+
+	return &doc, nil
+}
+
+// Validate checks the document statements against the registry.
+func (d *Document) Validate(registry *ActionRegistry) error {
+	if len(d.Statements) == 0 {
+		return fmt.Errorf("%w: at least one statement is required", ErrInvalidDocument)
+	}
+
+	for i, stmt := range d.Statements {
+		if stmt.Effect != EffectAllow && stmt.Effect != EffectDeny {
+			return fmt.Errorf("%w: statement %d: effect must be %q or %q", ErrInvalidDocument, i, EffectAllow, EffectDeny)
+		}
+
+		if len(stmt.Actions) == 0 {
+			return fmt.Errorf("%w: statement %d: at least one action is required", ErrInvalidDocument, i)
+		}
+
+		for _, action := range stmt.Actions {
+			if !registry.MatchesAny(action) {
+				return fmt.Errorf("%w: statement %d: action %q does not match any registered action", ErrInvalidDocument, i, action)
+			}
+		}
+
+		for _, condition := range stmt.Conditions {
+			if !slices.Contains(conditionOperators, condition.Operator) {
+				return fmt.Errorf("%w: statement %d: unknown condition operator %q", ErrInvalidDocument, i, condition.Operator)
+			}
+
+			if !strings.HasPrefix(condition.Key, "principal.") && !strings.HasPrefix(condition.Key, "resource.") {
+				return fmt.Errorf("%w: statement %d: condition key %q must start with \"principal.\" or \"resource.\"", ErrInvalidDocument, i, condition.Key)
+			}
+
+			if len(condition.Values) == 0 {
+				return fmt.Errorf("%w: statement %d: condition on %q has no value", ErrInvalidDocument, i, condition.Key)
+			}
+		}
+	}
+
+	return nil
+}
+
+// Policy converts the document into a policy. The given conditions are
+// added to every statement, which lets callers confine the document to a
+// scope (e.g. its organization) whatever its content.
+func (d *Document) Policy(id, name string, conditions ...Condition) *Policy {
+	p := NewPolicy(id, name)
+
+	for _, stmt := range d.Statements {
+		s := Statement{
+			SID:     stmt.SID,
+			Effect:  stmt.Effect,
+			Actions: stmt.Actions,
+		}
+
+		for _, resource := range stmt.Resources {
+			s.Resources = append(s.Resources, ResourcePattern{EntityType: resource.EntityType})
+		}
+
+		for _, condition := range stmt.Conditions {
+			s.Conditions = append(
+				s.Conditions,
+				Condition{
+					Operator: condition.Operator,
+					Key:      condition.Key,
+					Values:   condition.Values,
+				},
+			)
+		}
+		s.Conditions = append(s.Conditions, conditions...)
+
+		p.AddStatement(s)
+	}
+
+	return p
+}
@@ -0,0 +1,143 @@
+// Copyright (c) 2025 Probo Inc <hello@getprobo.com>.
+//
+// Permission to use, copy, modify, and/or distribute this software for any
+// purpose with or without fee is hereby granted, provided that the above
+// copyright notice and this permission notice appear in all copies.
+//
+// THE SOFTWARE IS PROVIDED "AS IS" AND THE AUTHOR DISCLAIMS ALL WARRANTIES WITH
+// REGARD TO THIS SOFTWARE INCLUDING ALL IMPLIED WARRANTIES OF MERCHANTABILITY
+// AND FITNESS. IN NO EVENT SHALL THE AUTHOR BE LIABLE FOR ANY SPECIAL, DIRECT,
+// INDIRECT, OR CONSEQUENTIAL DAMAGES OR ANY DAMAGES WHATSOEVER RESULTING FROM
+// LOSS OF USE, DATA OR PROFITS, WHETHER IN AN ACTION OF CONTRACT, NEGLIGENCE OR
+// OTHER TORTIOUS ACTION, ARISING OUT OF OR IN CONNECTION WITH THE USE OR
+// PERFORMANCE OF THIS SOFTWARE.
+
+package policy
+
+import (
+	"errors"
+	"testing"
+
+	"go.probo.inc/probo/pkg/gid"
+)
+
+func newDocumentTestRegistry() *ActionRegistry {
+	r := NewActionRegistry()
+	r.MustRegister(ActionDefinition{Action: "core:risk:get", Service: "core", Resource: "risk", Operation: "get"})
+	r.MustRegister(ActionDefinition{Action: "core:risk:update", Service: "core", Resource: "risk", Operation: "update"})
+	r.MustRegister(ActionDefinition{Action: "iam:membership:get", Service: "iam", Resource: "membership", Operation: "get"})
+	return r
+}
+
+func TestParseDocument(t *testing.T) {
+	registry := newDocumentTestRegistry()
+
+	tests := []struct {
+		name    string
+		data    string
+		wantErr bool
+	}{
+		{
+			name: "valid document",
+			data: `{"statements": [{"sid": "risks", "effect": "allow", "actions": ["core:risk:*"]}]}`,
+		},
+		{
+			name: "valid document with conditions",
+			data: `{"statements": [{"effect": "deny", "actions": ["core:risk:update"],
+				"conditions": [{"operator": "NotEquals", "key": "resource.owner_id", "values": ["principal.id"]}]}]}`,
+		},
+		{
+			name:    "malformed json",
+			data:    `{"statements": [`,
+			wantErr: true,
+		},
+		{
+			name:    "unknown field",
+			data:    `{"statements": [{"effect": "allow", "actions": ["core:risk:get"], "principal": "*"}]}`,
+			wantErr: true,
+		},
+		{
+			name:    "no statement",
+			data:    `{"statements": []}`,
+			wantErr: true,
+		},
+		{
+			name:    "invalid effect",
+			data:    `{"statements": [{"effect": "permit", "actions": ["core:risk:get"]}]}`,
+			wantErr: true,
+		},
+		{
+			name:    "unknown action",
+			data:    `{"statements": [{"effect": "allow", "actions": ["core:vendor:get"]}]}`,
+			wantErr: true,
+		},
+		{
+			name:    "unknown condition operator",
+			data:    `{"statements": [{"effect": "allow", "actions": ["core:risk:get"], "conditions": [{"operator": "Like", "key": "resource.id", "values": ["x"]}]}]}`,
+			wantErr: true,
+		},
+		{
+			name:    "invalid condition key",
+			data:    `{"statements": [{"effect": "allow", "actions": ["core:risk:get"], "conditions": [{"operator": "Equals", "key": "owner_id", "values": ["x"]}]}]}`,
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := ParseDocument([]byte(tt.data), registry)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ParseDocument() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err != nil && !errors.Is(err, ErrInvalidDocument) {
+				t.Errorf("ParseDocument() error = %v, want ErrInvalidDocument", err)
+			}
+		})
+	}
+}
+
+func TestDocument_Policy(t *testing.T) {
+	doc, err := ParseDocument(
+		[]byte(`{"statements": [{"effect": "allow", "actions": ["core:risk:get"]}]}`),
+		newDocumentTestRegistry(),
+	)
+	if err != nil {
+		t.Fatalf("ParseDocument() error = %v", err)
+	}
+
+	p := doc.Policy("custom", "Custom", Equals("principal.organization_id", "resource.organization_id"))
+
+	tenantID := gid.NewTenantID()
+	resource := gid.New(tenantID, 1)
+	evaluator := NewEvaluator()
+
+	allowed := evaluator.Evaluate(
+		AuthorizationRequest{
+			Resource: resource,
+			Action:   "core:risk:get",
+			ConditionContext: ConditionContext{
+				Principal: map[string]string{"organization_id": "org_1"},
+				Resource:  map[string]string{"organization_id": "org_1"},
+			},
+		},
+		[]*Policy{p},
+	)
+	if !allowed.IsAllowed() {
+		t.Error("Expected access to be allowed in the same organization")
+	}
+
+	denied := evaluator.Evaluate(
+		AuthorizationRequest{
+			Resource: resource,
+			Action:   "core:risk:get",
+			ConditionContext: ConditionContext{
+				Principal: map[string]string{"organization_id": "org_1"},
+				Resource:  map[string]string{"organization_id": "org_2"},
+			},
+		},
+		[]*Policy{p},
+	)
+	if denied.IsAllowed() {
+		t.Error("Expected access to be denied in another organization")
+	}
+}
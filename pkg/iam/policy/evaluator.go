@@ -127,23 +127,12 @@ func (e *Evaluator) Evaluate(req AuthorizationRequest, policies []*Policy) Evalu
 
 // statementMatches checks if a statement applies to the request.
 func (e *Evaluator) statementMatches(stmt *Statement, req AuthorizationRequest) bool {
-	// Check action match
-	if !e.matcher.MatchesAny(stmt.Actions, req.Action) {
+	if !e.actionMatches(stmt, req) {
 		return false
 	}
 
-	// Check resource match (if resources are specified)
-	if len(stmt.Resources) > 0 {
-		resourceMatched := false
-		for _, pattern := range stmt.Resources {
-			if pattern.MatchesResource(req.Resource) {
-				resourceMatched = true
-				break
-			}
-		}
-		if !resourceMatched {
-			return false
-		}
+	if !e.resourceMatches(stmt, req) {
+		return false
 	}
 
 	// Check conditions (all must be satisfied)
@@ -155,3 +144,24 @@ func (e *Evaluator) statementMatches(stmt *Statement, req AuthorizationRequest)
 
 	return true
 }
+
+// actionMatches checks if the statement applies to the requested action.
+func (e *Evaluator) actionMatches(stmt *Statement, req AuthorizationRequest) bool {
+	return e.matcher.MatchesAny(stmt.Actions, req.Action)
+}
+
+// resourceMatches checks if the statement applies to the requested
+// resource. Statements without resources apply to all resources.
+func (e *Evaluator) resourceMatches(stmt *Statement, req AuthorizationRequest) bool {
+	if len(stmt.Resources) == 0 {
+		return true
+	}
+
+	for _, pattern := range stmt.Resources {
+		if pattern.MatchesResource(req.Resource) {
+			return true
+		}
+	}
+
+	return false
+}
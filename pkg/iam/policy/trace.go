@@ -0,0 +1,118 @@
+// Copyright (c) 2025 Probo Inc <hello@getprobo.com>.
+//
+// Permission to use, copy, modify, and/or distribute this software for any
+// purpose with or without fee is hereby granted, provided that the above
+// copyright notice and this permission notice appear in all copies.
+//
+// THE SOFTWARE IS PROVIDED "AS IS" AND THE AUTHOR DISCLAIMS ALL WARRANTIES WITH
+// REGARD TO THIS SOFTWARE INCLUDING ALL IMPLIED WARRANTIES OF MERCHANTABILITY
+// AND FITNESS. IN NO EVENT SHALL THE AUTHOR BE LIABLE FOR ANY SPECIAL, DIRECT,
+// INDIRECT, OR CONSEQUENTIAL DAMAGES OR ANY DAMAGES WHATSOEVER RESULTING FROM
+// LOSS OF USE, DATA OR PROFITS, WHETHER IN AN ACTION OF CONTRACT, NEGLIGENCE OR
+// OTHER TORTIOUS ACTION, ARISING OUT OF OR IN CONNECTION WITH THE USE OR
+// PERFORMANCE OF THIS SOFTWARE.
+
+package policy
+
+type (
+	// EvaluationTrace explains an evaluation: every statement of every
+	// policy considered, and why it matched or not.
+	EvaluationTrace struct {
+		Request  AuthorizationRequest
+		Result   EvaluationResult
+		Policies []PolicyTrace
+	}
+
+	// PolicyTrace is the evaluation trace of a policy.
+	PolicyTrace struct {
+		Policy     *Policy
+		Statements []StatementTrace
+	}
+
+	// StatementTrace is the evaluation trace of a statement. Matched is
+	// true when the statement applies to the request, which requires the
+	// action, the resource and all the conditions to match.
+	StatementTrace struct {
+		Statement       *Statement
+		ActionMatched   bool
+		ResourceMatched bool
+		Conditions      []ConditionTrace
+		Matched         bool
+	}
+
+	// ConditionTrace is the evaluation trace of a condition with the
+	// attributes its key and values resolved to.
+	ConditionTrace struct {
+		Condition Condition
+
+		// KeyValue is the value the condition key resolved to, nil when
+		// the attribute is missing from the context.
+		KeyValue *string
+
+		// Values are the condition values once resolved, nil for
+		// references to attributes missing from the context.
+		Values []*string
+
+		Satisfied bool
+	}
+)
+
+// Trace evaluates the policies like Evaluate and records how each
+// statement was evaluated. Unlike Evaluate, every statement is evaluated
+// even when an explicit deny has been found.
+func (e *Evaluator) Trace(req AuthorizationRequest, policies []*Policy) EvaluationTrace {
+	trace := EvaluationTrace{
+		Request:  req,
+		Result:   e.Evaluate(req, policies),
+		Policies: make([]PolicyTrace, 0, len(policies)),
+	}
+
+	for _, policy := range policies {
+		policyTrace := PolicyTrace{
+			Policy:     policy,
+			Statements: make([]StatementTrace, 0, len(policy.Statements)),
+		}
+
+		for i := range policy.Statements {
+			policyTrace.Statements = append(policyTrace.Statements, e.traceStatement(&policy.Statements[i], req))
+		}
+
+		trace.Policies = append(trace.Policies, policyTrace)
+	}
+
+	return trace
+}
+
+func (e *Evaluator) traceStatement(stmt *Statement, req AuthorizationRequest) StatementTrace {
+	trace := StatementTrace{
+		Statement:       stmt,
+		ActionMatched:   e.actionMatches(stmt, req),
+		ResourceMatched: e.resourceMatches(stmt, req),
+		Conditions:      make([]ConditionTrace, 0, len(stmt.Conditions)),
+		Matched:         e.statementMatches(stmt, req),
+	}
+
+	for _, condition := range stmt.Conditions {
+		conditionTrace := ConditionTrace{
+			Condition: condition,
+			Values:    make([]*string, 0, len(condition.Values)),
+			Satisfied: condition.Evaluate(req.ConditionContext),
+		}
+
+		if value, ok := resolveKey(condition.Key, req.ConditionContext); ok {
+			conditionTrace.KeyValue = &value
+		}
+
+		for _, v := range condition.Values {
+			if resolved, ok := resolveValue(v, req.ConditionContext); ok {
+				conditionTrace.Values = append(conditionTrace.Values, &resolved)
+			} else {
+				conditionTrace.Values = append(conditionTrace.Values, nil)
+			}
+		}
+
+		trace.Conditions = append(trace.Conditions, conditionTrace)
+	}
+
+	return trace
+}
@@ -0,0 +1,101 @@
+// Copyright (c) 2025 Probo Inc <hello@getprobo.com>.
+//
+// Permission to use, copy, modify, and/or distribute this software for any
+// purpose with or without fee is hereby granted, provided that the above
+// copyright notice and this permission notice appear in all copies.
+//
+// THE SOFTWARE IS PROVIDED "AS IS" AND THE AUTHOR DISCLAIMS ALL WARRANTIES WITH
+// REGARD TO THIS SOFTWARE INCLUDING ALL IMPLIED WARRANTIES OF MERCHANTABILITY
+// AND FITNESS. IN NO EVENT SHALL THE AUTHOR BE LIABLE FOR ANY SPECIAL, DIRECT,
+// INDIRECT, OR CONSEQUENTIAL DAMAGES OR ANY DAMAGES WHATSOEVER RESULTING FROM
+// LOSS OF USE, DATA OR PROFITS, WHETHER IN AN ACTION OF CONTRACT, NEGLIGENCE OR
+// OTHER TORTIOUS ACTION, ARISING OUT OF OR IN CONNECTION WITH THE USE OR
+// PERFORMANCE OF THIS SOFTWARE.
+
+package policy
+
+import (
+	"testing"
+)
+
+func TestEvaluator_Trace(t *testing.T) {
+	evaluator := NewEvaluator()
+
+	policy := NewPolicy("test", "Test Policy",
+		Allow("iam:organization:*").
+			WithSID("org-access").
+			When(Equals("principal.organization_id", "resource.organization_id")),
+		Deny("iam:organization:delete").WithSID("deny-org-delete"),
+		Allow("iam:identity:get").WithSID("identity-access"),
+	)
+
+	req := AuthorizationRequest{
+		Action: "iam:organization:update",
+		ConditionContext: ConditionContext{
+			Principal: map[string]string{"id": "user_123", "organization_id": "org_1"},
+			Resource:  map[string]string{"id": "org_2", "organization_id": "org_2"},
+		},
+	}
+
+	trace := evaluator.Trace(req, []*Policy{policy})
+
+	if trace.Result.Decision != DecisionNoMatch {
+		t.Fatalf("Trace() decision = %v, want %v", trace.Result.Decision, DecisionNoMatch)
+	}
+
+	if len(trace.Policies) != 1 || len(trace.Policies[0].Statements) != 3 {
+		t.Fatalf("Trace() should record the 3 statements of the policy")
+	}
+
+	orgAccess := trace.Policies[0].Statements[0]
+	if !orgAccess.ActionMatched || !orgAccess.ResourceMatched || orgAccess.Matched {
+		t.Errorf("org-access: action %v, resource %v, matched %v", orgAccess.ActionMatched, orgAccess.ResourceMatched, orgAccess.Matched)
+	}
+
+	if len(orgAccess.Conditions) != 1 {
+		t.Fatalf("org-access should have 1 condition trace, got %d", len(orgAccess.Conditions))
+	}
+
+	condition := orgAccess.Conditions[0]
+	if condition.Satisfied {
+		t.Errorf("org-access condition should not be satisfied")
+	}
+	if condition.KeyValue == nil || *condition.KeyValue != "org_1" {
+		t.Errorf("org-access condition key should resolve to org_1, got %v", condition.KeyValue)
+	}
+	if len(condition.Values) != 1 || condition.Values[0] == nil || *condition.Values[0] != "org_2" {
+		t.Errorf("org-access condition value should resolve to org_2")
+	}
+
+	denyDelete := trace.Policies[0].Statements[1]
+	if denyDelete.ActionMatched || denyDelete.Matched {
+		t.Errorf("deny-org-delete should not match iam:organization:update")
+	}
+}
+
+func TestEvaluator_Trace_MissingAttribute(t *testing.T) {
+	evaluator := NewEvaluator()
+
+	policy := NewPolicy("test", "Test Policy",
+		Allow("iam:identity:get").When(Equals("principal.id", "resource.identity_id")),
+	)
+
+	req := AuthorizationRequest{
+		Action: "iam:identity:get",
+		ConditionContext: ConditionContext{
+			Principal: map[string]string{"id": "user_123"},
+			Resource:  map[string]string{"id": "res_456"},
+		},
+	}
+
+	trace := evaluator.Trace(req, []*Policy{policy})
+
+	condition := trace.Policies[0].Statements[0].Conditions[0]
+	if condition.Values[0] != nil {
+		t.Errorf("missing resource attribute should not resolve, got %q", *condition.Values[0])
+	}
+
+	if trace.Result.IsAllowed() {
+		t.Errorf("Trace() should not allow when an attribute is missing")
+	}
+}
@@ -24,6 +24,10 @@ type PolicySet struct {
 
 	// IdentityScopedPolicies are applied to all authenticated users, independent of organization membership.
 	IdentityScopedPolicies []*policy.Policy
+
+	// Actions lists the actions exposed by the service. Custom role policy
+	// documents may only reference registered actions.
+	Actions []Action
 }
 
 // NewPolicySet creates an empty PolicySet.
@@ -31,6 +35,7 @@ func NewPolicySet() *PolicySet {
 	return &PolicySet{
 		RolePolicies:           make(map[string][]*policy.Policy),
 		IdentityScopedPolicies: make([]*policy.Policy, 0),
+		Actions:                make([]Action, 0),
 	}
 }
 
@@ -46,12 +51,19 @@ func (ps *PolicySet) AddIdentityScopedPolicy(policies ...*policy.Policy) *Policy
 	return ps
 }
 
+// AddActions registers the actions exposed by the service.
+func (ps *PolicySet) AddActions(actions ...Action) *PolicySet {
+	ps.Actions = append(ps.Actions, actions...)
+	return ps
+}
+
 // Merge combines another PolicySet into this one.
 func (ps *PolicySet) Merge(other *PolicySet) *PolicySet {
 	for role, policies := range other.RolePolicies {
 		ps.RolePolicies[role] = append(ps.RolePolicies[role], policies...)
 	}
 	ps.IdentityScopedPolicies = append(ps.IdentityScopedPolicies, other.IdentityScopedPolicies...)
+	ps.Actions = append(ps.Actions, other.Actions...)
 	return ps
 }
 
@@ -69,5 +81,6 @@ func IAMPolicySet() *PolicySet {
 			IAMSelfManageProfilePolicy,
 			IAMSelfManageMembershipPolicy,
 			IAMSelfManagePersonalAPIKeyPolicy,
-		)
+		).
+		AddActions(IAMActions()...)
 }
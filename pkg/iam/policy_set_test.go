@@ -70,4 +70,7 @@ func TestIAMPolicySet(t *testing.T) {
 
 	// Should have self-manage policies
 	assert.NotEmpty(t, policySet.IdentityScopedPolicies, "expected identity-scoped policies")
+
+	// Should expose its actions for custom role documents
+	assert.Contains(t, policySet.Actions, ActionCustomRoleCreate, "expected IAM actions")
 }
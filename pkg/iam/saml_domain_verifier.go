@@ -80,6 +80,10 @@ func (v *SAMLDomainVerifier) runOnce(ctx context.Context) {
 	if err := v.checkUnverifiedDomains(ctx); err != nil {
 		v.logger.ErrorCtx(ctx, "cannot check unverified domains", log.Error(err))
 	}
+
+	if err := v.checkUnverifiedOIDCDomains(ctx); err != nil {
+		v.logger.ErrorCtx(ctx, "cannot check unverified OIDC domains", log.Error(err))
+	}
 }
 
 func (v *SAMLDomainVerifier) checkUnverifiedDomains(ctx context.Context) error {
@@ -167,6 +171,91 @@ func (v *SAMLDomainVerifier) tryVerifyDomain(ctx context.Context, configID gid.G
 	)
 }
 
+func (v *SAMLDomainVerifier) checkUnverifiedOIDCDomains(ctx context.Context) error {
+	var configs coredata.OIDCConfigurations
+
+	err := v.pg.WithConn(
+		ctx,
+		func(conn pg.Conn) error {
+			err := configs.LoadUnverified(ctx, conn)
+			if err != nil {
+				return fmt.Errorf("cannot load unverified OIDC configurations: %w", err)
+			}
+
+			return nil
+		},
+	)
+	if err != nil {
+		return err
+	}
+
+	for _, config := range configs {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		default:
+		}
+
+		if err := v.tryVerifyOIDCDomain(ctx, config.ID); err != nil {
+			v.logger.ErrorCtx(ctx, "cannot verify OIDC domain",
+				log.String("config_id", config.ID.String()),
+				log.Error(err),
+			)
+
+			continue
+		}
+	}
+
+	return nil
+}
+
+func (v *SAMLDomainVerifier) tryVerifyOIDCDomain(ctx context.Context, configID gid.GID) error {
+	return v.pg.WithTx(
+		ctx,
+		func(tx pg.Conn) error {
+			config := &coredata.OIDCConfiguration{}
+			if err := config.LoadByIDForUpdateSkipLocked(ctx, tx, configID); err != nil {
+				if err == coredata.ErrResourceNotFound {
+					return nil
+				}
+
+				return fmt.Errorf("cannot load OIDC configuration: %w", err)
+			}
+
+			if config.DomainVerifiedAt != nil {
+				return nil
+			}
+
+			if config.DomainVerificationToken == nil {
+				return fmt.Errorf("cannot verify domain %q: no verification token", config.EmailDomain)
+			}
+
+			expectedValue := txtRecordValuePrefix + *config.DomainVerificationToken
+
+			if err := v.checkDNSTXTRecord(config.EmailDomain, expectedValue); err != nil {
+				return err
+			}
+
+			v.logger.InfoCtx(ctx, "OIDC domain verified",
+				log.String("config_id", config.ID.String()),
+			)
+
+			now := time.Now()
+			config.DomainVerificationToken = nil
+			config.DomainVerifiedAt = &now
+			config.EnforcementPolicy = coredata.SAMLEnforcementPolicyOptional
+			config.UpdatedAt = now
+
+			scope := coredata.NewScopeFromObjectID(config.OrganizationID)
+			if err := config.Update(ctx, tx, scope); err != nil {
+				return fmt.Errorf("cannot update OIDC configuration: %w", err)
+			}
+
+			return nil
+		},
+	)
+}
+
 func (v *SAMLDomainVerifier) checkDNSTXTRecord(emailDomain string, expectedValue string) error {
 	fqdn := emailDomain
 	if !strings.HasSuffix(fqdn, ".") {
@@ -0,0 +1,155 @@
+// Copyright (c) 2025 Probo Inc <hello@getprobo.com>.
+//
+// Permission to use, copy, modify, and/or distribute this software for any
+// purpose with or without fee is hereby granted, provided that the above
+// copyright notice and this permission notice appear in all copies.
+//
+// THE SOFTWARE IS PROVIDED "AS IS" AND THE AUTHOR DISCLAIMS ALL WARRANTIES WITH
+// REGARD TO THIS SOFTWARE INCLUDING ALL IMPLIED WARRANTIES OF MERCHANTABILITY
+// AND FITNESS. IN NO EVENT SHALL THE AUTHOR BE LIABLE FOR ANY SPECIAL, DIRECT,
+// INDIRECT, OR CONSEQUENTIAL DAMAGES OR ANY DAMAGES WHATSOEVER RESULTING FROM
+// LOSS OF USE, DATA OR PROFITS, WHETHER IN AN ACTION OF CONTRACT, NEGLIGENCE OR
+// OTHER TORTIOUS ACTION, ARISING OUT OF OR IN CONNECTION WITH THE USE OR
+// PERFORMANCE OF THIS SOFTWARE.
+
+// Package microsoftentraid provides a Microsoft Entra ID identity provider
+// for SCIM synchronization using the Microsoft Graph API and OAuth2.
+package microsoftentraid
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+
+	scimclient "go.probo.inc/probo/pkg/iam/scim/bridge/client"
+	"go.probo.inc/probo/pkg/iam/scim/bridge/provider"
+)
+
+const (
+	graphBaseURL  = "https://graph.microsoft.com/v1.0"
+	pageSize      = "999"
+	userTypeGuest = "Guest"
+)
+
+var _ provider.Provider = (*Provider)(nil)
+
+type (
+	Provider struct {
+		httpClient        *http.Client
+		baseURL           string
+		excludedUserNames []string
+	}
+
+	graphUser struct {
+		UserPrincipalName string `json:"userPrincipalName"`
+		Mail              string `json:"mail"`
+		DisplayName       string `json:"displayName"`
+		GivenName         string `json:"givenName"`
+		Surname           string `json:"surname"`
+		AccountEnabled    bool   `json:"accountEnabled"`
+		UserType          string `json:"userType"`
+	}
+
+	graphUsersResponse struct {
+		Value    []graphUser `json:"value"`
+		NextLink string      `json:"@odata.nextLink"`
+	}
+)
+
+func New(httpClient *http.Client, excludedUserNames []string) *Provider {
+	return &Provider{
+		httpClient:        httpClient,
+		baseURL:           graphBaseURL,
+		excludedUserNames: excludedUserNames,
+	}
+}
+
+func (p *Provider) Name() string {
+	return "microsoft-entra-id"
+}
+
+func (p *Provider) isExcluded(email string) bool {
+	emailLower := strings.ToLower(email)
+	for _, excluded := range p.excludedUserNames {
+		if strings.ToLower(excluded) == emailLower {
+			return true
+		}
+	}
+	return false
+}
+
+// ListUsers returns the member users of the tenant. Guest accounts invited
+// from other tenants are skipped as they are not part of the directory.
+func (p *Provider) ListUsers(ctx context.Context) (scimclient.Users, error) {
+	query := url.Values{}
+	query.Set("$select", "userPrincipalName,mail,displayName,givenName,surname,accountEnabled,userType")
+	query.Set("$top", pageSize)
+
+	var allUsers scimclient.Users
+	next := p.baseURL + "/users?" + query.Encode()
+
+	for next != "" {
+		resp, err := p.listUsersPage(ctx, next)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, u := range resp.Value {
+			if u.UserType == userTypeGuest {
+				continue
+			}
+
+			userName := u.Mail
+			if userName == "" {
+				userName = u.UserPrincipalName
+			}
+
+			if p.isExcluded(userName) {
+				continue
+			}
+
+			allUsers = append(
+				allUsers,
+				scimclient.User{
+					UserName:    userName,
+					DisplayName: u.DisplayName,
+					GivenName:   u.GivenName,
+					FamilyName:  u.Surname,
+					Active:      u.AccountEnabled,
+				},
+			)
+		}
+
+		next = resp.NextLink
+	}
+
+	return allUsers, nil
+}
+
+func (p *Provider) listUsersPage(ctx context.Context, pageURL string) (*graphUsersResponse, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, pageURL, nil)
+	if err != nil {
+		return nil, fmt.Errorf("cannot create request: %w", err)
+	}
+	req.Header.Set("Accept", "application/json")
+
+	resp, err := p.httpClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("cannot list users: %w", err)
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("cannot list users: unexpected status code %d", resp.StatusCode)
+	}
+
+	var page graphUsersResponse
+	if err := json.NewDecoder(resp.Body).Decode(&page); err != nil {
+		return nil, fmt.Errorf("cannot decode users response: %w", err)
+	}
+
+	return &page, nil
+}
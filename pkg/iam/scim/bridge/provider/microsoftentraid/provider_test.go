@@ -0,0 +1,90 @@
+// Copyright (c) 2025 Probo Inc <hello@getprobo.com>.
+//
+// Permission to use, copy, modify, and/or distribute this software for any
+// purpose with or without fee is hereby granted, provided that the above
+// copyright notice and this permission notice appear in all copies.
+//
+// THE SOFTWARE IS PROVIDED "AS IS" AND THE AUTHOR DISCLAIMS ALL WARRANTIES WITH
+// REGARD TO THIS SOFTWARE INCLUDING ALL IMPLIED WARRANTIES OF MERCHANTABILITY
+// AND FITNESS. IN NO EVENT SHALL THE AUTHOR BE LIABLE FOR ANY SPECIAL, DIRECT,
+// INDIRECT, OR CONSEQUENTIAL DAMAGES OR ANY DAMAGES WHATSOEVER RESULTING FROM
+// LOSS OF USE, DATA OR PROFITS, WHETHER IN AN ACTION OF CONTRACT, NEGLIGENCE OR
+// OTHER TORTIOUS ACTION, ARISING OUT OF OR IN CONNECTION WITH THE USE OR
+// PERFORMANCE OF THIS SOFTWARE.
+
+package microsoftentraid
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	scimclient "go.probo.inc/probo/pkg/iam/scim/bridge/client"
+)
+
+func TestListUsers(t *testing.T) {
+	var server *httptest.Server
+	server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/users", r.URL.Path)
+		assert.Equal(t, "application/json", r.Header.Get("Accept"))
+
+		w.Header().Set("Content-Type", "application/json")
+
+		switch r.URL.Query().Get("$skiptoken") {
+		case "":
+			assert.Equal(t, pageSize, r.URL.Query().Get("$top"))
+			assert.Contains(t, r.URL.Query().Get("$select"), "userPrincipalName")
+
+			_, _ = w.Write([]byte(`{
+				"value": [
+					{"userPrincipalName": "alice@acme.onmicrosoft.com", "mail": "alice@acme.com", "displayName": "Alice Doe", "givenName": "Alice", "surname": "Doe", "accountEnabled": true, "userType": "Member"},
+					{"userPrincipalName": "guest_example.com#EXT#@acme.onmicrosoft.com", "mail": "guest@example.com", "accountEnabled": true, "userType": "Guest"},
+					{"userPrincipalName": "bob@acme.onmicrosoft.com", "mail": "", "displayName": "Bob Roe", "givenName": "Bob", "surname": "Roe", "accountEnabled": false, "userType": "Member"}
+				],
+				"@odata.nextLink": "` + server.URL + `/users?$skiptoken=page2"
+			}`))
+		case "page2":
+			_, _ = w.Write([]byte(`{
+				"value": [
+					{"userPrincipalName": "carol@acme.onmicrosoft.com", "mail": "carol@acme.com", "displayName": "Carol", "accountEnabled": true, "userType": "Member"},
+					{"userPrincipalName": "admin@acme.onmicrosoft.com", "mail": "Admin@Acme.com", "accountEnabled": true, "userType": "Member"}
+				]
+			}`))
+		default:
+			w.WriteHeader(http.StatusBadRequest)
+		}
+	}))
+	defer server.Close()
+
+	p := New(server.Client(), []string{"admin@acme.com"})
+	p.baseURL = server.URL
+
+	users, err := p.ListUsers(context.Background())
+	require.NoError(t, err)
+
+	assert.Equal(
+		t,
+		scimclient.Users{
+			{UserName: "alice@acme.com", DisplayName: "Alice Doe", GivenName: "Alice", FamilyName: "Doe", Active: true},
+			{UserName: "bob@acme.onmicrosoft.com", DisplayName: "Bob Roe", GivenName: "Bob", FamilyName: "Roe", Active: false},
+			{UserName: "carol@acme.com", DisplayName: "Carol", Active: true},
+		},
+		users,
+	)
+}
+
+func TestListUsersError(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusForbidden)
+	}))
+	defer server.Close()
+
+	p := New(server.Client(), nil)
+	p.baseURL = server.URL
+
+	_, err := p.ListUsers(context.Background())
+	assert.Error(t, err)
+}
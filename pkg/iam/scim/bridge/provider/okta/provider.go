@@ -0,0 +1,185 @@
+// Copyright (c) 2025 Probo Inc <hello@getprobo.com>.
+//
+// Permission to use, copy, modify, and/or distribute this software for any
+// purpose with or without fee is hereby granted, provided that the above
+// copyright notice and this permission notice appear in all copies.
+//
+// THE SOFTWARE IS PROVIDED "AS IS" AND THE AUTHOR DISCLAIMS ALL WARRANTIES WITH
+// REGARD TO THIS SOFTWARE INCLUDING ALL IMPLIED WARRANTIES OF MERCHANTABILITY
+// AND FITNESS. IN NO EVENT SHALL THE AUTHOR BE LIABLE FOR ANY SPECIAL, DIRECT,
+// INDIRECT, OR CONSEQUENTIAL DAMAGES OR ANY DAMAGES WHATSOEVER RESULTING FROM
+// LOSS OF USE, DATA OR PROFITS, WHETHER IN AN ACTION OF CONTRACT, NEGLIGENCE OR
+// OTHER TORTIOUS ACTION, ARISING OUT OF OR IN CONNECTION WITH THE USE OR
+// PERFORMANCE OF THIS SOFTWARE.
+
+// Package okta provides an Okta identity provider for SCIM synchronization
+// using the Okta Users API and an API token.
+package okta
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+
+	scimclient "go.probo.inc/probo/pkg/iam/scim/bridge/client"
+	"go.probo.inc/probo/pkg/iam/scim/bridge/provider"
+)
+
+const (
+	pageSize = "200"
+)
+
+var _ provider.Provider = (*Provider)(nil)
+
+type (
+	Provider struct {
+		httpClient        *http.Client
+		baseURL           string
+		excludedUserNames []string
+	}
+
+	oktaUser struct {
+		Status  string `json:"status"`
+		Profile struct {
+			Login       string `json:"login"`
+			Email       string `json:"email"`
+			FirstName   string `json:"firstName"`
+			LastName    string `json:"lastName"`
+			DisplayName string `json:"displayName"`
+		} `json:"profile"`
+	}
+)
+
+// New returns a provider listing the users of the Okta organization
+// reachable at domain (e.g. acme.okta.com). The HTTP client must
+// authenticate requests with an Okta API token.
+func New(httpClient *http.Client, domain string, excludedUserNames []string) *Provider {
+	return &Provider{
+		httpClient:        httpClient,
+		baseURL:           "https://" + domain,
+		excludedUserNames: excludedUserNames,
+	}
+}
+
+func (p *Provider) Name() string {
+	return "okta"
+}
+
+func (p *Provider) isExcluded(email string) bool {
+	emailLower := strings.ToLower(email)
+	for _, excluded := range p.excludedUserNames {
+		if strings.ToLower(excluded) == emailLower {
+			return true
+		}
+	}
+	return false
+}
+
+func (p *Provider) ListUsers(ctx context.Context) (scimclient.Users, error) {
+	var allUsers scimclient.Users
+	next := p.baseURL + "/api/v1/users?limit=" + pageSize
+
+	for next != "" {
+		users, nextURL, err := p.listUsersPage(ctx, next)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, u := range users {
+			userName := u.Profile.Email
+			if userName == "" {
+				userName = u.Profile.Login
+			}
+
+			if p.isExcluded(userName) {
+				continue
+			}
+
+			displayName := u.Profile.DisplayName
+			if displayName == "" {
+				displayName = strings.TrimSpace(u.Profile.FirstName + " " + u.Profile.LastName)
+			}
+
+			allUsers = append(
+				allUsers,
+				scimclient.User{
+					UserName:    userName,
+					DisplayName: displayName,
+					GivenName:   u.Profile.FirstName,
+					FamilyName:  u.Profile.LastName,
+					Active:      isActiveStatus(u.Status),
+				},
+			)
+		}
+
+		next = nextURL
+	}
+
+	return allUsers, nil
+}
+
+func (p *Provider) listUsersPage(ctx context.Context, pageURL string) ([]oktaUser, string, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, pageURL, nil)
+	if err != nil {
+		return nil, "", fmt.Errorf("cannot create request: %w", err)
+	}
+	req.Header.Set("Accept", "application/json")
+
+	resp, err := p.httpClient.Do(req)
+	if err != nil {
+		return nil, "", fmt.Errorf("cannot list users: %w", err)
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, "", fmt.Errorf("cannot list users: unexpected status code %d", resp.StatusCode)
+	}
+
+	var users []oktaUser
+	if err := json.NewDecoder(resp.Body).Decode(&users); err != nil {
+		return nil, "", fmt.Errorf("cannot decode users response: %w", err)
+	}
+
+	return users, nextLink(resp.Header), nil
+}
+
+// isActiveStatus reports whether a user in the given Okta lifecycle status
+// can sign in. Locked out or expired-password users are still active
+// members of the directory.
+func isActiveStatus(status string) bool {
+	switch status {
+	case "ACTIVE", "RECOVERY", "PASSWORD_EXPIRED", "LOCKED_OUT":
+		return true
+	default:
+		return false
+	}
+}
+
+// nextLink returns the URL of the next page advertised in the Link
+// headers of an Okta response, or an empty string on the last page.
+func nextLink(header http.Header) string {
+	for _, value := range header.Values("Link") {
+		for _, link := range strings.Split(value, ",") {
+			parts := strings.Split(link, ";")
+			if len(parts) < 2 {
+				continue
+			}
+
+			target := strings.TrimSpace(parts[0])
+			if !strings.HasPrefix(target, "<") || !strings.HasSuffix(target, ">") {
+				continue
+			}
+
+			for _, param := range parts[1:] {
+				param = strings.ReplaceAll(strings.TrimSpace(param), " ", "")
+				if param == `rel="next"` || param == "rel=next" {
+					return target[1 : len(target)-1]
+				}
+			}
+		}
+	}
+
+	return ""
+}
@@ -0,0 +1,60 @@
+// Copyright (c) 2025 Probo Inc <hello@getprobo.com>.
+//
+// Permission to use, copy, modify, and/or distribute this software for any
+// purpose with or without fee is hereby granted, provided that the above
+// copyright notice and this permission notice appear in all copies.
+//
+// THE SOFTWARE IS PROVIDED "AS IS" AND THE AUTHOR DISCLAIMS ALL WARRANTIES WITH
+// REGARD TO THIS SOFTWARE INCLUDING ALL IMPLIED WARRANTIES OF MERCHANTABILITY
+// AND FITNESS. IN NO EVENT SHALL THE AUTHOR BE LIABLE FOR ANY SPECIAL, DIRECT,
+// INDIRECT, OR CONSEQUENTIAL DAMAGES OR ANY DAMAGES WHATSOEVER RESULTING FROM
+// LOSS OF USE, DATA OR PROFITS, WHETHER IN AN ACTION OF CONTRACT, NEGLIGENCE OR
+// OTHER TORTIOUS ACTION, ARISING OUT OF OR IN CONNECTION WITH THE USE OR
+// PERFORMANCE OF THIS SOFTWARE.
+
+package okta
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNextLink(t *testing.T) {
+	t.Run("no link header", func(t *testing.T) {
+		assert.Equal(t, "", nextLink(http.Header{}))
+	})
+
+	t.Run("self only on last page", func(t *testing.T) {
+		header := http.Header{}
+		header.Add("Link", `<https://acme.okta.com/api/v1/users?limit=200>; rel="self"`)
+
+		assert.Equal(t, "", nextLink(header))
+	})
+
+	t.Run("separate self and next headers", func(t *testing.T) {
+		header := http.Header{}
+		header.Add("Link", `<https://acme.okta.com/api/v1/users?limit=200>; rel="self"`)
+		header.Add("Link", `<https://acme.okta.com/api/v1/users?after=00u1&limit=200>; rel="next"`)
+
+		assert.Equal(t, "https://acme.okta.com/api/v1/users?after=00u1&limit=200", nextLink(header))
+	})
+
+	t.Run("comma separated links", func(t *testing.T) {
+		header := http.Header{}
+		header.Add("Link", `<https://acme.okta.com/api/v1/users?limit=200>; rel="self", <https://acme.okta.com/api/v1/users?after=00u2&limit=200>; rel="next"`)
+
+		assert.Equal(t, "https://acme.okta.com/api/v1/users?after=00u2&limit=200", nextLink(header))
+	})
+}
+
+func TestIsActiveStatus(t *testing.T) {
+	for _, status := range []string{"ACTIVE", "RECOVERY", "PASSWORD_EXPIRED", "LOCKED_OUT"} {
+		assert.True(t, isActiveStatus(status), status)
+	}
+
+	for _, status := range []string{"STAGED", "PROVISIONED", "SUSPENDED", "DEPROVISIONED", ""} {
+		assert.False(t, isActiveStatus(status), status)
+	}
+}
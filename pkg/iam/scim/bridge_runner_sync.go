@@ -17,6 +17,7 @@ package scim
 import (
 	"context"
 	"fmt"
+	"net/http"
 	"time"
 
 	"go.gearno.de/kit/httpclient"
@@ -28,6 +29,8 @@ import (
 	scimclient "go.probo.inc/probo/pkg/iam/scim/bridge/client"
 	"go.probo.inc/probo/pkg/iam/scim/bridge/provider"
 	"go.probo.inc/probo/pkg/iam/scim/bridge/provider/googleworkspace"
+	"go.probo.inc/probo/pkg/iam/scim/bridge/provider/microsoftentraid"
+	"go.probo.inc/probo/pkg/iam/scim/bridge/provider/okta"
 )
 
 func (r *BridgeRunner) executeSync(
@@ -126,18 +129,29 @@ func (r *BridgeRunner) createProvider(
 ) (provider.Provider, error) {
 	switch bridgeType {
 	case coredata.SCIMBridgeTypeGoogleWorkspace:
-		return r.createGoogleWorkspaceProvider(ctx, logger, dbConnector, excludedUserNames)
+		httpClient, err := r.createOAuth2HTTPClient(ctx, logger, dbConnector)
+		if err != nil {
+			return nil, err
+		}
+		return googleworkspace.New(httpClient, excludedUserNames), nil
+	case coredata.SCIMBridgeTypeMicrosoftEntraID:
+		httpClient, err := r.createOAuth2HTTPClient(ctx, logger, dbConnector)
+		if err != nil {
+			return nil, err
+		}
+		return microsoftentraid.New(httpClient, excludedUserNames), nil
+	case coredata.SCIMBridgeTypeOkta:
+		return r.createOktaProvider(ctx, logger, dbConnector, excludedUserNames)
 	default:
 		return nil, fmt.Errorf("unsupported bridge type: %s", bridgeType)
 	}
 }
 
-func (r *BridgeRunner) createGoogleWorkspaceProvider(
+func (r *BridgeRunner) createOAuth2HTTPClient(
 	ctx context.Context,
 	logger *log.Logger,
 	dbConnector *coredata.Connector,
-	excludedUserNames []string,
-) (provider.Provider, error) {
+) (*http.Client, error) {
 	if dbConnector.Connection == nil {
 		return nil, fmt.Errorf("connector has no connection configured")
 	}
@@ -164,7 +178,7 @@ func (r *BridgeRunner) createGoogleWorkspaceProvider(
 		if err != nil {
 			return nil, fmt.Errorf("cannot create HTTP client: %w", err)
 		}
-		return googleworkspace.New(httpClient, excludedUserNames), nil
+		return httpClient, nil
 	}
 
 	httpClient, err := oauth2Conn.RefreshableClient(ctx, *refreshCfg, httpClientOpts...)
@@ -172,5 +186,33 @@ func (r *BridgeRunner) createGoogleWorkspaceProvider(
 		return nil, fmt.Errorf("cannot create refreshable HTTP client: %w", err)
 	}
 
-	return googleworkspace.New(httpClient, excludedUserNames), nil
+	return httpClient, nil
+}
+
+func (r *BridgeRunner) createOktaProvider(
+	ctx context.Context,
+	logger *log.Logger,
+	dbConnector *coredata.Connector,
+	excludedUserNames []string,
+) (provider.Provider, error) {
+	if dbConnector.Connection == nil {
+		return nil, fmt.Errorf("connector has no connection configured")
+	}
+
+	oktaConn, ok := dbConnector.Connection.(*connector.OktaConnection)
+	if !ok {
+		return nil, fmt.Errorf("connector is not an Okta connection")
+	}
+
+	httpClient, err := oktaConn.ClientWithOptions(
+		ctx,
+		httpclient.WithLogger(logger),
+		httpclient.WithTracerProvider(r.tp),
+		httpclient.WithRegisterer(r.registerer),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("cannot create HTTP client: %w", err)
+	}
+
+	return okta.New(httpClient, oktaConn.Domain, excludedUserNames), nil
 }
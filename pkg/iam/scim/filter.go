@@ -83,3 +83,58 @@ func ParseUserFilter(expr scimfilter.Expression) (*coredata.MembershipFilter, er
 
 	return filter, nil
 }
+
+func ParseGroupFilter(expr scimfilter.Expression) (*coredata.SCIMGroupFilter, error) {
+	filter := coredata.NewSCIMGroupFilter()
+
+	if expr == nil {
+		return filter, nil
+	}
+
+	stack := []scimfilter.Expression{expr}
+
+	for len(stack) > 0 {
+		current := stack[len(stack)-1]
+		stack = stack[:len(stack)-1]
+
+		switch e := current.(type) {
+		case *scimfilter.AttributeExpression:
+			if e.Operator != scimfilter.EQ {
+				return nil, scimerrors.ScimErrorBadRequest(
+					fmt.Sprintf("operator '%s' is not supported, only 'eq' is supported", e.Operator))
+			}
+
+			value, ok := e.CompareValue.(string)
+			if !ok {
+				return nil, scimerrors.ScimErrorBadRequest("filter value must be a string")
+			}
+
+			attrName := strings.ToLower(e.AttributePath.AttributeName)
+			switch attrName {
+			case "displayname":
+				filter.WithDisplayName(value)
+			default:
+				return nil, scimerrors.ScimErrorBadRequest(
+					fmt.Sprintf("attribute '%s' is not supported for filtering, only 'displayName' is supported", e.AttributePath.AttributeName))
+			}
+
+		case *scimfilter.LogicalExpression:
+			if e.Operator != scimfilter.AND {
+				return nil, scimerrors.ScimErrorBadRequest(
+					fmt.Sprintf("logical operator '%s' is not supported, only 'and' is supported", e.Operator))
+			}
+			stack = append(stack, e.Left, e.Right)
+
+		case *scimfilter.NotExpression:
+			return nil, scimerrors.ScimErrorBadRequest("NOT expressions are not supported")
+
+		case *scimfilter.ValuePath:
+			return nil, scimerrors.ScimErrorBadRequest("value path expressions are not supported")
+
+		default:
+			return nil, scimerrors.ScimErrorBadRequest("unknown filter expression type")
+		}
+	}
+
+	return filter, nil
+}
@@ -126,3 +126,31 @@ func TestParseUserFilter(t *testing.T) {
 		assert.Contains(t, err.Error(), "invalid email format")
 	})
 }
+
+func TestParseGroupFilter(t *testing.T) {
+	t.Run("nil expression returns empty filter", func(t *testing.T) {
+		filter, err := ParseGroupFilter(nil)
+		require.NoError(t, err)
+		require.NotNil(t, filter)
+		assert.Nil(t, filter.DisplayName())
+	})
+
+	t.Run("displayName eq filter", func(t *testing.T) {
+		expr, err := scimfilter.ParseFilter([]byte(`displayName eq "Engineering"`))
+		require.NoError(t, err)
+
+		filter, err := ParseGroupFilter(expr)
+		require.NoError(t, err)
+		require.NotNil(t, filter.DisplayName())
+		assert.Equal(t, "Engineering", *filter.DisplayName())
+	})
+
+	t.Run("unsupported attribute returns error", func(t *testing.T) {
+		expr, err := scimfilter.ParseFilter([]byte(`externalId eq "123"`))
+		require.NoError(t, err)
+
+		filter, err := ParseGroupFilter(expr)
+		assert.Error(t, err)
+		assert.Nil(t, filter)
+	})
+}
@@ -0,0 +1,770 @@
+// Copyright (c) 2025 Probo Inc <hello@getprobo.com>.
+//
+// Permission to use, copy, modify, and/or distribute this software for any
+// purpose with or without fee is hereby granted, provided that the above
+// copyright notice and this permission notice appear in all copies.
+//
+// THE SOFTWARE IS PROVIDED "AS IS" AND THE AUTHOR DISCLAIMS ALL WARRANTIES WITH
+// REGARD TO THIS SOFTWARE INCLUDING ALL IMPLIED WARRANTIES OF MERCHANTABILITY
+// AND FITNESS. IN NO EVENT SHALL THE AUTHOR BE LIABLE FOR ANY SPECIAL, DIRECT,
+// INDIRECT, OR CONSEQUENTIAL DAMAGES OR ANY DAMAGES WHATSOEVER RESULTING FROM
+// LOSS OF USE, DATA OR PROFITS, WHETHER IN AN ACTION OF CONTRACT, NEGLIGENCE OR
+// OTHER TORTIOUS ACTION, ARISING OUT OF OR IN CONNECTION WITH THE USE OR
+// PERFORMANCE OF THIS SOFTWARE.
+
+package scim
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/elimity-com/scim"
+	scimerrors "github.com/elimity-com/scim/errors"
+	"github.com/elimity-com/scim/optional"
+	scimfilter "github.com/scim2/filter-parser/v2"
+	"go.gearno.de/kit/pg"
+	"go.probo.inc/probo/pkg/coredata"
+	"go.probo.inc/probo/pkg/gid"
+	"go.probo.inc/probo/pkg/page"
+)
+
+// mappedRolePrecedence orders the roles a group mapping can grant, from
+// the most to the least privileged.
+var mappedRolePrecedence = []coredata.MembershipRole{
+	coredata.MembershipRoleAdmin,
+	coredata.MembershipRoleEmployee,
+	coredata.MembershipRoleAuditor,
+	coredata.MembershipRoleViewer,
+}
+
+func (s *Service) CreateGroup(
+	ctx context.Context,
+	config *coredata.SCIMConfiguration,
+	attributes scim.ResourceAttributes,
+) (scim.Resource, error) {
+	displayName, externalID, memberIDs := ParseGroupFromAttributes(attributes)
+	if displayName == "" {
+		return scim.Resource{}, scimerrors.ScimErrorBadRequest("displayName is required")
+	}
+
+	now := time.Now()
+	scope := coredata.NewScopeFromObjectID(config.OrganizationID)
+
+	group := &coredata.SCIMGroup{
+		ID:                  gid.New(config.OrganizationID.TenantID(), coredata.SCIMGroupEntityType),
+		OrganizationID:      config.OrganizationID,
+		SCIMConfigurationID: config.ID,
+		DisplayName:         displayName,
+		ExternalID:          externalID,
+		CreatedAt:           now,
+		UpdatedAt:           now,
+	}
+
+	var members coredata.SCIMGroupMembers
+
+	err := s.pg.WithTx(
+		ctx,
+		func(tx pg.Conn) error {
+			if err := group.Insert(ctx, tx, scope); err != nil {
+				if err == coredata.ErrResourceAlreadyExists {
+					return scimerrors.ScimErrorUniqueness
+				}
+				return fmt.Errorf("cannot insert SCIM group: %w", err)
+			}
+
+			affected, err := addGroupMembers(ctx, tx, scope, config, group.ID, memberIDs, now)
+			if err != nil {
+				return err
+			}
+
+			if err := syncMembershipRoles(ctx, tx, scope, config.OrganizationID, affected); err != nil {
+				return err
+			}
+
+			if err := members.LoadBySCIMGroupID(ctx, tx, scope, group.ID); err != nil {
+				return fmt.Errorf("cannot load SCIM group members: %w", err)
+			}
+
+			return nil
+		},
+	)
+
+	if err != nil {
+		return scim.Resource{}, err
+	}
+
+	return groupToResource(group, members), nil
+}
+
+func (s *Service) GetGroup(
+	ctx context.Context,
+	config *coredata.SCIMConfiguration,
+	groupID gid.GID,
+) (scim.Resource, error) {
+	scope := coredata.NewScopeFromObjectID(config.OrganizationID)
+
+	var (
+		group   *coredata.SCIMGroup
+		members coredata.SCIMGroupMembers
+	)
+
+	err := s.pg.WithConn(
+		ctx,
+		func(conn pg.Conn) error {
+			var err error
+			group, err = loadGroup(ctx, conn, scope, config, groupID)
+			if err != nil {
+				return err
+			}
+
+			if err := members.LoadBySCIMGroupID(ctx, conn, scope, group.ID); err != nil {
+				return fmt.Errorf("cannot load SCIM group members: %w", err)
+			}
+
+			return nil
+		},
+	)
+
+	if err != nil {
+		return scim.Resource{}, err
+	}
+
+	return groupToResource(group, members), nil
+}
+
+func (s *Service) ListGroups(
+	ctx context.Context,
+	config *coredata.SCIMConfiguration,
+	filterExpr scimfilter.Expression,
+	startIndex int,
+	count int,
+) ([]scim.Resource, int, error) {
+	filter, err := ParseGroupFilter(filterExpr)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	scope := coredata.NewScopeFromObjectID(config.OrganizationID)
+
+	var (
+		groups     coredata.SCIMGroups
+		totalCount int
+		resources  []scim.Resource
+	)
+
+	err = s.pg.WithConn(
+		ctx,
+		func(conn pg.Conn) error {
+			var err error
+			totalCount, err = groups.CountBySCIMConfigurationID(ctx, conn, scope, config.ID, filter)
+			if err != nil {
+				return fmt.Errorf("cannot count SCIM groups: %w", err)
+			}
+
+			orderBy := page.OrderBy[coredata.SCIMGroupOrderField]{
+				Field:     coredata.SCIMGroupOrderFieldCreatedAt,
+				Direction: page.OrderDirectionDesc,
+			}
+			cursor := page.NewCursor(count, nil, page.Head, orderBy)
+
+			if err := groups.LoadBySCIMConfigurationID(ctx, conn, scope, config.ID, cursor, filter); err != nil {
+				return fmt.Errorf("cannot load SCIM groups: %w", err)
+			}
+
+			resources = make([]scim.Resource, 0, len(groups))
+			for _, g := range groups {
+				var members coredata.SCIMGroupMembers
+				if err := members.LoadBySCIMGroupID(ctx, conn, scope, g.ID); err != nil {
+					return fmt.Errorf("cannot load SCIM group members: %w", err)
+				}
+
+				resources = append(resources, groupToResource(g, members))
+			}
+
+			return nil
+		},
+	)
+
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return resources, totalCount, nil
+}
+
+func (s *Service) ReplaceGroup(
+	ctx context.Context,
+	config *coredata.SCIMConfiguration,
+	groupID gid.GID,
+	attributes scim.ResourceAttributes,
+) (scim.Resource, error) {
+	displayName, externalID, memberIDs := ParseGroupFromAttributes(attributes)
+	if displayName == "" {
+		return scim.Resource{}, scimerrors.ScimErrorBadRequest("displayName is required")
+	}
+
+	now := time.Now()
+	scope := coredata.NewScopeFromObjectID(config.OrganizationID)
+
+	var (
+		group   *coredata.SCIMGroup
+		members coredata.SCIMGroupMembers
+	)
+
+	err := s.pg.WithTx(
+		ctx,
+		func(tx pg.Conn) error {
+			var err error
+			group, err = loadGroup(ctx, tx, scope, config, groupID)
+			if err != nil {
+				return err
+			}
+
+			group.DisplayName = displayName
+			group.ExternalID = externalID
+			group.UpdatedAt = now
+
+			if err := group.Update(ctx, tx, scope); err != nil {
+				if err == coredata.ErrResourceAlreadyExists {
+					return scimerrors.ScimErrorUniqueness
+				}
+				return fmt.Errorf("cannot update SCIM group: %w", err)
+			}
+
+			removed, err := removeAllGroupMembers(ctx, tx, scope, group.ID)
+			if err != nil {
+				return err
+			}
+
+			added, err := addGroupMembers(ctx, tx, scope, config, group.ID, memberIDs, now)
+			if err != nil {
+				return err
+			}
+
+			// A rename changes which mappings apply, so every member is
+			// re-evaluated, not only the ones that joined or left.
+			if err := syncMembershipRoles(ctx, tx, scope, config.OrganizationID, append(removed, added...)); err != nil {
+				return err
+			}
+
+			if err := members.LoadBySCIMGroupID(ctx, tx, scope, group.ID); err != nil {
+				return fmt.Errorf("cannot load SCIM group members: %w", err)
+			}
+
+			return nil
+		},
+	)
+
+	if err != nil {
+		return scim.Resource{}, err
+	}
+
+	return groupToResource(group, members), nil
+}
+
+func (s *Service) PatchGroup(
+	ctx context.Context,
+	config *coredata.SCIMConfiguration,
+	groupID gid.GID,
+	operations []scim.PatchOperation,
+) (scim.Resource, error) {
+	now := time.Now()
+	scope := coredata.NewScopeFromObjectID(config.OrganizationID)
+
+	var (
+		group   *coredata.SCIMGroup
+		members coredata.SCIMGroupMembers
+	)
+
+	err := s.pg.WithTx(
+		ctx,
+		func(tx pg.Conn) error {
+			var err error
+			group, err = loadGroup(ctx, tx, scope, config, groupID)
+			if err != nil {
+				return err
+			}
+
+			var (
+				affected    []gid.GID
+				needsUpdate bool
+			)
+
+			for _, op := range operations {
+				path := ""
+				if op.Path != nil {
+					path = strings.ToLower(op.Path.AttributePath.AttributeName)
+				}
+
+				switch {
+				case path == "":
+					// Okta and Entra send attribute replacements as a
+					// value map without a path.
+					valueMap, ok := op.Value.(map[string]any)
+					if !ok {
+						continue
+					}
+
+					if name, ok := valueMap["displayName"].(string); ok && name != "" {
+						group.DisplayName = name
+						needsUpdate = true
+					}
+					if externalID, ok := valueMap["externalId"].(string); ok {
+						group.ExternalID = &externalID
+						needsUpdate = true
+					}
+					if values, ok := valueMap["members"]; ok {
+						ids, err := applyMembersOperation(ctx, tx, scope, config, group.ID, op.Op, values, now)
+						if err != nil {
+							return err
+						}
+						affected = append(affected, ids...)
+					}
+
+				case path == "displayname":
+					if name, ok := op.Value.(string); ok && name != "" {
+						group.DisplayName = name
+						needsUpdate = true
+					}
+
+				case path == "externalid":
+					if externalID, ok := op.Value.(string); ok {
+						group.ExternalID = &externalID
+						needsUpdate = true
+					}
+
+				case path == "members" && op.Path.ValueExpression != nil:
+					if !strings.EqualFold(op.Op, "remove") {
+						return scimerrors.ScimErrorBadRequest("member filters are only supported for remove operations")
+					}
+
+					memberID, ok := ParseMemberValueFilter(op.Path.ValueExpression)
+					if !ok {
+						return scimerrors.ScimErrorBadRequest("unsupported members filter")
+					}
+
+					ids, err := removeGroupMembers(ctx, tx, scope, group.ID, []string{memberID})
+					if err != nil {
+						return err
+					}
+					affected = append(affected, ids...)
+
+				case path == "members":
+					ids, err := applyMembersOperation(ctx, tx, scope, config, group.ID, op.Op, op.Value, now)
+					if err != nil {
+						return err
+					}
+					affected = append(affected, ids...)
+				}
+			}
+
+			if needsUpdate {
+				group.UpdatedAt = now
+				if err := group.Update(ctx, tx, scope); err != nil {
+					if err == coredata.ErrResourceAlreadyExists {
+						return scimerrors.ScimErrorUniqueness
+					}
+					return fmt.Errorf("cannot update SCIM group: %w", err)
+				}
+			}
+
+			if err := members.LoadBySCIMGroupID(ctx, tx, scope, group.ID); err != nil {
+				return fmt.Errorf("cannot load SCIM group members: %w", err)
+			}
+
+			if needsUpdate {
+				for _, m := range members {
+					affected = append(affected, m.MembershipID)
+				}
+			}
+
+			return syncMembershipRoles(ctx, tx, scope, config.OrganizationID, affected)
+		},
+	)
+
+	if err != nil {
+		return scim.Resource{}, err
+	}
+
+	return groupToResource(group, members), nil
+}
+
+func (s *Service) DeleteGroup(
+	ctx context.Context,
+	config *coredata.SCIMConfiguration,
+	groupID gid.GID,
+) error {
+	scope := coredata.NewScopeFromObjectID(config.OrganizationID)
+
+	return s.pg.WithTx(
+		ctx,
+		func(tx pg.Conn) error {
+			group, err := loadGroup(ctx, tx, scope, config, groupID)
+			if err != nil {
+				return err
+			}
+
+			removed, err := removeAllGroupMembers(ctx, tx, scope, group.ID)
+			if err != nil {
+				return err
+			}
+
+			if err := group.Delete(ctx, tx, scope); err != nil {
+				return fmt.Errorf("cannot delete SCIM group: %w", err)
+			}
+
+			return syncMembershipRoles(ctx, tx, scope, config.OrganizationID, removed)
+		},
+	)
+}
+
+// SyncMembershipRole re-evaluates the role of a membership against the
+// organization group role mappings. Organizations without any mapping
+// keep manually assigned roles, and owners are never changed.
+func SyncMembershipRole(
+	ctx context.Context,
+	conn pg.Conn,
+	scope coredata.Scoper,
+	organizationID gid.GID,
+	membershipID gid.GID,
+) error {
+	return syncMembershipRoles(ctx, conn, scope, organizationID, []gid.GID{membershipID})
+}
+
+// ResolveMappedRole returns the role a membership should have given the
+// roles mapped from the groups it belongs to. Owners keep their role and
+// memberships without any mapped group fall back to employee.
+func ResolveMappedRole(current coredata.MembershipRole, mapped []coredata.MembershipRole) coredata.MembershipRole {
+	if current == coredata.MembershipRoleOwner {
+		return current
+	}
+
+	for _, role := range mappedRolePrecedence {
+		for _, m := range mapped {
+			if m == role {
+				return role
+			}
+		}
+	}
+
+	return coredata.MembershipRoleEmployee
+}
+
+func ParseGroupFromAttributes(attributes scim.ResourceAttributes) (displayName string, externalID *string, memberIDs []string) {
+	displayName, _ = attributes["displayName"].(string)
+
+	if id, ok := attributes["externalId"].(string); ok && id != "" {
+		externalID = &id
+	}
+
+	memberIDs = ParseMemberValues(attributes["members"])
+
+	return displayName, externalID, memberIDs
+}
+
+// ParseMemberValues extracts member identifiers from a SCIM members
+// attribute, accepting both a list of member objects and a single one.
+func ParseMemberValues(value any) []string {
+	var items []any
+	switch v := value.(type) {
+	case []any:
+		items = v
+	case []map[string]any:
+		for _, item := range v {
+			items = append(items, item)
+		}
+	case map[string]any:
+		items = []any{v}
+	}
+
+	ids := make([]string, 0, len(items))
+	for _, item := range items {
+		member, ok := item.(map[string]any)
+		if !ok {
+			continue
+		}
+
+		if id, ok := member["value"].(string); ok && id != "" {
+			ids = append(ids, id)
+		}
+	}
+
+	return ids
+}
+
+// ParseMemberValueFilter extracts the member identifier from a
+// `members[value eq "..."]` patch path.
+func ParseMemberValueFilter(expr scimfilter.Expression) (string, bool) {
+	e, ok := expr.(*scimfilter.AttributeExpression)
+	if !ok || e.Operator != scimfilter.EQ {
+		return "", false
+	}
+
+	if !strings.EqualFold(e.AttributePath.AttributeName, "value") {
+		return "", false
+	}
+
+	value, ok := e.CompareValue.(string)
+	if !ok || value == "" {
+		return "", false
+	}
+
+	return value, true
+}
+
+func applyMembersOperation(
+	ctx context.Context,
+	tx pg.Conn,
+	scope coredata.Scoper,
+	config *coredata.SCIMConfiguration,
+	groupID gid.GID,
+	op string,
+	value any,
+	now time.Time,
+) ([]gid.GID, error) {
+	memberIDs := ParseMemberValues(value)
+
+	switch strings.ToLower(op) {
+	case "add":
+		return addGroupMembers(ctx, tx, scope, config, groupID, memberIDs, now)
+
+	case "replace":
+		removed, err := removeAllGroupMembers(ctx, tx, scope, groupID)
+		if err != nil {
+			return nil, err
+		}
+
+		added, err := addGroupMembers(ctx, tx, scope, config, groupID, memberIDs, now)
+		if err != nil {
+			return nil, err
+		}
+
+		return append(removed, added...), nil
+
+	case "remove":
+		// Entra sends the members to remove as the operation value, a
+		// remove without value clears the whole group.
+		if value == nil {
+			return removeAllGroupMembers(ctx, tx, scope, groupID)
+		}
+
+		return removeGroupMembers(ctx, tx, scope, groupID, memberIDs)
+	}
+
+	return nil, scimerrors.ScimErrorBadRequest(fmt.Sprintf("unsupported operation %q", op))
+}
+
+func addGroupMembers(
+	ctx context.Context,
+	tx pg.Conn,
+	scope coredata.Scoper,
+	config *coredata.SCIMConfiguration,
+	groupID gid.GID,
+	memberIDs []string,
+	now time.Time,
+) ([]gid.GID, error) {
+	added := make([]gid.GID, 0, len(memberIDs))
+
+	for _, memberID := range memberIDs {
+		membershipID, err := gid.ParseGID(memberID)
+		if err != nil {
+			return nil, scimerrors.ScimErrorBadRequest(fmt.Sprintf("invalid member %q", memberID))
+		}
+
+		membership := &coredata.Membership{}
+		if err := membership.LoadByID(ctx, tx, scope, membershipID); err != nil {
+			if err == coredata.ErrResourceNotFound {
+				return nil, scimerrors.ScimErrorBadRequest(fmt.Sprintf("invalid member %q", memberID))
+			}
+			return nil, fmt.Errorf("cannot load membership: %w", err)
+		}
+
+		if membership.OrganizationID != config.OrganizationID {
+			return nil, scimerrors.ScimErrorBadRequest(fmt.Sprintf("invalid member %q", memberID))
+		}
+
+		member := &coredata.SCIMGroupMember{
+			SCIMGroupID:  groupID,
+			MembershipID: membershipID,
+			CreatedAt:    now,
+		}
+
+		if err := member.Insert(ctx, tx, scope); err != nil {
+			return nil, fmt.Errorf("cannot insert SCIM group member: %w", err)
+		}
+
+		added = append(added, membershipID)
+	}
+
+	return added, nil
+}
+
+func removeGroupMembers(
+	ctx context.Context,
+	tx pg.Conn,
+	scope coredata.Scoper,
+	groupID gid.GID,
+	memberIDs []string,
+) ([]gid.GID, error) {
+	removed := make([]gid.GID, 0, len(memberIDs))
+
+	for _, memberID := range memberIDs {
+		membershipID, err := gid.ParseGID(memberID)
+		if err != nil {
+			// Unknown members cannot be part of the group.
+			continue
+		}
+
+		member := &coredata.SCIMGroupMember{
+			SCIMGroupID:  groupID,
+			MembershipID: membershipID,
+		}
+
+		if err := member.Delete(ctx, tx, scope); err != nil {
+			return nil, fmt.Errorf("cannot delete SCIM group member: %w", err)
+		}
+
+		removed = append(removed, membershipID)
+	}
+
+	return removed, nil
+}
+
+func removeAllGroupMembers(
+	ctx context.Context,
+	tx pg.Conn,
+	scope coredata.Scoper,
+	groupID gid.GID,
+) ([]gid.GID, error) {
+	members := coredata.SCIMGroupMembers{}
+	if err := members.LoadBySCIMGroupID(ctx, tx, scope, groupID); err != nil {
+		return nil, fmt.Errorf("cannot load SCIM group members: %w", err)
+	}
+
+	if err := members.DeleteBySCIMGroupID(ctx, tx, scope, groupID); err != nil {
+		return nil, fmt.Errorf("cannot delete SCIM group members: %w", err)
+	}
+
+	removed := make([]gid.GID, 0, len(members))
+	for _, m := range members {
+		removed = append(removed, m.MembershipID)
+	}
+
+	return removed, nil
+}
+
+func syncMembershipRoles(
+	ctx context.Context,
+	conn pg.Conn,
+	scope coredata.Scoper,
+	organizationID gid.GID,
+	membershipIDs []gid.GID,
+) error {
+	if len(membershipIDs) == 0 {
+		return nil
+	}
+
+	mappings := coredata.SCIMGroupRoleMappings{}
+	count, err := mappings.CountByOrganizationID(ctx, conn, scope, organizationID)
+	if err != nil {
+		return fmt.Errorf("cannot count SCIM group role mappings: %w", err)
+	}
+
+	if count == 0 {
+		return nil
+	}
+
+	seen := make(map[gid.GID]struct{}, len(membershipIDs))
+	for _, membershipID := range membershipIDs {
+		if _, ok := seen[membershipID]; ok {
+			continue
+		}
+		seen[membershipID] = struct{}{}
+
+		membership := &coredata.Membership{}
+		if err := membership.LoadByID(ctx, conn, scope, membershipID); err != nil {
+			if err == coredata.ErrResourceNotFound {
+				continue
+			}
+			return fmt.Errorf("cannot load membership: %w", err)
+		}
+
+		if err := mappings.LoadByMembershipID(ctx, conn, scope, organizationID, membershipID); err != nil {
+			return fmt.Errorf("cannot load SCIM group role mappings: %w", err)
+		}
+
+		mapped := make([]coredata.MembershipRole, 0, len(mappings))
+		for _, m := range mappings {
+			mapped = append(mapped, m.Role)
+		}
+
+		role := ResolveMappedRole(membership.Role, mapped)
+		if role == membership.Role {
+			continue
+		}
+
+		membership.Role = role
+		membership.UpdatedAt = time.Now()
+		if err := membership.Update(ctx, conn, scope); err != nil {
+			return fmt.Errorf("cannot update membership: %w", err)
+		}
+	}
+
+	return nil
+}
+
+func loadGroup(
+	ctx context.Context,
+	conn pg.Conn,
+	scope coredata.Scoper,
+	config *coredata.SCIMConfiguration,
+	groupID gid.GID,
+) (*coredata.SCIMGroup, error) {
+	group := &coredata.SCIMGroup{}
+	if err := group.LoadByID(ctx, conn, scope, groupID); err != nil {
+		if err == coredata.ErrResourceNotFound {
+			return nil, scimerrors.ScimErrorResourceNotFound(groupID.String())
+		}
+		return nil, fmt.Errorf("cannot load SCIM group: %w", err)
+	}
+
+	if group.SCIMConfigurationID != config.ID {
+		return nil, scimerrors.ScimErrorResourceNotFound(groupID.String())
+	}
+
+	return group, nil
+}
+
+func groupToResource(g *coredata.SCIMGroup, members coredata.SCIMGroupMembers) scim.Resource {
+	resourceMembers := make([]map[string]any, 0, len(members))
+	for _, m := range members {
+		resourceMembers = append(
+			resourceMembers,
+			map[string]any{
+				"value":   m.MembershipID.String(),
+				"display": m.FullName,
+				"type":    "User",
+			},
+		)
+	}
+
+	externalID := optional.String{}
+	if g.ExternalID != nil {
+		externalID = optional.NewString(*g.ExternalID)
+	}
+
+	return scim.Resource{
+		ID:         g.ID.String(),
+		ExternalID: externalID,
+		Attributes: scim.ResourceAttributes{
+			"displayName": g.DisplayName,
+			"members":     resourceMembers,
+		},
+		Meta: scim.Meta{
+			Created:      &g.CreatedAt,
+			LastModified: &g.UpdatedAt,
+		},
+	}
+}
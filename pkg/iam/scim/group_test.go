@@ -0,0 +1,112 @@
+// Copyright (c) 2025 Probo Inc <hello@getprobo.com>.
+//
+// Permission to use, copy, modify, and/or distribute this software for any
+// purpose with or without fee is hereby granted, provided that the above
+// copyright notice and this permission notice appear in all copies.
+//
+// THE SOFTWARE IS PROVIDED "AS IS" AND THE AUTHOR DISCLAIMS ALL WARRANTIES WITH
+// REGARD TO THIS SOFTWARE INCLUDING ALL IMPLIED WARRANTIES OF MERCHANTABILITY
+// AND FITNESS. IN NO EVENT SHALL THE AUTHOR BE LIABLE FOR ANY SPECIAL, DIRECT,
+// INDIRECT, OR CONSEQUENTIAL DAMAGES OR ANY DAMAGES WHATSOEVER RESULTING FROM
+// LOSS OF USE, DATA OR PROFITS, WHETHER IN AN ACTION OF CONTRACT, NEGLIGENCE OR
+// OTHER TORTIOUS ACTION, ARISING OUT OF OR IN CONNECTION WITH THE USE OR
+// PERFORMANCE OF THIS SOFTWARE.
+
+package scim
+
+import (
+	"testing"
+
+	"github.com/elimity-com/scim"
+	scimfilter "github.com/scim2/filter-parser/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.probo.inc/probo/pkg/coredata"
+)
+
+func TestResolveMappedRole(t *testing.T) {
+	t.Run("owner is never changed", func(t *testing.T) {
+		role := ResolveMappedRole(
+			coredata.MembershipRoleOwner,
+			[]coredata.MembershipRole{coredata.MembershipRoleViewer},
+		)
+		assert.Equal(t, coredata.MembershipRoleOwner, role)
+	})
+
+	t.Run("no mapped group falls back to employee", func(t *testing.T) {
+		role := ResolveMappedRole(coredata.MembershipRoleAdmin, nil)
+		assert.Equal(t, coredata.MembershipRoleEmployee, role)
+	})
+
+	t.Run("most privileged mapped role wins", func(t *testing.T) {
+		role := ResolveMappedRole(
+			coredata.MembershipRoleEmployee,
+			[]coredata.MembershipRole{
+				coredata.MembershipRoleViewer,
+				coredata.MembershipRoleAdmin,
+				coredata.MembershipRoleAuditor,
+			},
+		)
+		assert.Equal(t, coredata.MembershipRoleAdmin, role)
+	})
+
+	t.Run("auditor wins over viewer", func(t *testing.T) {
+		role := ResolveMappedRole(
+			coredata.MembershipRoleEmployee,
+			[]coredata.MembershipRole{
+				coredata.MembershipRoleViewer,
+				coredata.MembershipRoleAuditor,
+			},
+		)
+		assert.Equal(t, coredata.MembershipRoleAuditor, role)
+	})
+}
+
+func TestParseGroupFromAttributes(t *testing.T) {
+	displayName, externalID, memberIDs := ParseGroupFromAttributes(
+		scim.ResourceAttributes{
+			"displayName": "Engineering",
+			"externalId":  "00g1",
+			"members": []any{
+				map[string]any{"value": "member-1"},
+				map[string]any{"value": "member-2", "display": "Jane"},
+				map[string]any{"display": "no value"},
+			},
+		},
+	)
+
+	assert.Equal(t, "Engineering", displayName)
+	require.NotNil(t, externalID)
+	assert.Equal(t, "00g1", *externalID)
+	assert.Equal(t, []string{"member-1", "member-2"}, memberIDs)
+}
+
+func TestParseMemberValues(t *testing.T) {
+	t.Run("single member object", func(t *testing.T) {
+		ids := ParseMemberValues(map[string]any{"value": "member-1"})
+		assert.Equal(t, []string{"member-1"}, ids)
+	})
+
+	t.Run("nil value", func(t *testing.T) {
+		assert.Empty(t, ParseMemberValues(nil))
+	})
+}
+
+func TestParseMemberValueFilter(t *testing.T) {
+	t.Run("value eq filter", func(t *testing.T) {
+		path, err := scimfilter.ParsePath([]byte(`members[value eq "member-1"]`))
+		require.NoError(t, err)
+
+		id, ok := ParseMemberValueFilter(path.ValueExpression)
+		assert.True(t, ok)
+		assert.Equal(t, "member-1", id)
+	})
+
+	t.Run("other attribute is rejected", func(t *testing.T) {
+		path, err := scimfilter.ParsePath([]byte(`members[display eq "Jane"]`))
+		require.NoError(t, err)
+
+		_, ok := ParseMemberValueFilter(path.ValueExpression)
+		assert.False(t, ok)
+	})
+}
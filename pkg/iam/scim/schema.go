@@ -96,3 +96,44 @@ func UserSchema() schema.Schema {
 		},
 	}
 }
+
+func GroupSchema() schema.Schema {
+	return schema.Schema{
+		ID:          schema.GroupSchema,
+		Name:        optional.NewString("Group"),
+		Description: optional.NewString("Group"),
+		Attributes: []schema.CoreAttribute{
+			schema.SimpleCoreAttribute(
+				schema.SimpleStringParams(
+					schema.StringParams{
+						Name:     "displayName",
+						Required: true,
+					},
+				),
+			),
+			schema.ComplexCoreAttribute(
+				schema.ComplexParams{
+					Name:        "members",
+					MultiValued: true,
+					SubAttributes: []schema.SimpleParams{
+						schema.SimpleStringParams(
+							schema.StringParams{
+								Name: "value",
+							},
+						),
+						schema.SimpleStringParams(
+							schema.StringParams{
+								Name: "display",
+							},
+						),
+						schema.SimpleStringParams(
+							schema.StringParams{
+								Name: "type",
+							},
+						),
+					},
+				},
+			),
+		},
+	}
+}
@@ -458,6 +458,10 @@ func (s *Service) updateUser(
 				if err := membership.Update(ctx, tx, scope); err != nil {
 					return fmt.Errorf("cannot update membership: %w", err)
 				}
+
+				if err := SyncMembershipRole(ctx, tx, scope, config.OrganizationID, membership.ID); err != nil {
+					return fmt.Errorf("cannot sync membership role: %w", err)
+				}
 			}
 
 			profile := &coredata.MembershipProfile{}
@@ -18,6 +18,7 @@ import (
 	"go.probo.inc/probo/pkg/crypto/passwdhash"
 	"go.probo.inc/probo/pkg/filemanager"
 	"go.probo.inc/probo/pkg/gid"
+	"go.probo.inc/probo/pkg/iam/oidc"
 	"go.probo.inc/probo/pkg/iam/saml"
 	"go.probo.inc/probo/pkg/iam/scim"
 	"golang.org/x/sync/errgroup"
@@ -46,9 +47,12 @@ type (
 		CompliancePageService *CompliancePageService
 		SessionService        *SessionService
 		AuthService           *AuthService
+		MFAService            *MFAService
 		SAMLService           *saml.Service
+		OIDCService           *oidc.Service
 		SCIMService           *scim.Service
 		APIKeyService         *APIKeyService
+		CustomRoleService     *CustomRoleService
 		Authorizer            *Authorizer
 
 		samlDomainVerifier *SAMLDomainVerifier
@@ -104,6 +108,7 @@ func NewService(
 		pg:                         pgClient,
 		fm:                         fm,
 		hp:                         hp,
+		encryptionKey:              cfg.EncryptionKey,
 		baseURL:                    cfg.BaseURL.String(),
 		tokenSecret:                cfg.TokenSecret,
 		disableSignup:              cfg.DisableSignup,
@@ -122,7 +127,19 @@ func NewService(
 	svc.CompliancePageService = NewCompliancePageService(svc)
 	svc.SessionService = NewSessionService(svc)
 	svc.AuthService = NewAuthService(svc)
+
+	mfaService, err := NewMFAService(
+		svc,
+		cfg.BaseURL.Hostname(),
+		cfg.BaseURL.Scheme()+"://"+cfg.BaseURL.Host(),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("cannot create MFA service: %w", err)
+	}
+	svc.MFAService = mfaService
+
 	svc.APIKeyService = NewAPIKeyService(svc)
+	svc.CustomRoleService = NewCustomRoleService(svc)
 
 	svc.Authorizer = NewAuthorizer(pgClient)
 	svc.Authorizer.RegisterPolicySet(IAMPolicySet())
@@ -133,6 +150,12 @@ func NewService(
 	}
 	svc.SAMLService = samlService
 
+	oidcService, err := oidc.NewService(svc.pg, svc.encryptionKey, svc.baseURL, cfg.Logger.Named("oidc"))
+	if err != nil {
+		return nil, fmt.Errorf("cannot create OIDC service: %w", err)
+	}
+	svc.OIDCService = oidcService
+
 	svc.SCIMService = scim.NewService(svc.pg, cfg.Logger.Named("scim"), scim.ServiceConfig{
 		TracerProvider:    cfg.TracerProvider,
 		Registerer:        cfg.Registerer,
@@ -160,6 +183,7 @@ func (s *Service) Run(ctx context.Context) error {
 	g, ctx := errgroup.WithContext(ctx)
 
 	g.Go(func() error { return s.SAMLService.Run(ctx) })
+	g.Go(func() error { return s.OIDCService.Run(ctx) })
 	g.Go(func() error { return s.samlDomainVerifier.Run(ctx) })
 	g.Go(func() error { return s.SCIMService.Run(ctx) })
 
@@ -275,6 +299,62 @@ func (s *Service) GetSAMLconfiguration(ctx context.Context, samlConfigurationID
 	return samlConfiguration, nil
 }
 
+func (s *Service) GetOIDCConfiguration(ctx context.Context, oidcConfigurationID gid.GID) (*coredata.OIDCConfiguration, error) {
+	var (
+		scope             = coredata.NewScopeFromObjectID(oidcConfigurationID)
+		oidcConfiguration = &coredata.OIDCConfiguration{}
+	)
+
+	err := s.pg.WithConn(
+		ctx,
+		func(conn pg.Conn) error {
+			err := oidcConfiguration.LoadByID(ctx, conn, scope, oidcConfigurationID)
+			if err != nil {
+				if err == coredata.ErrResourceNotFound {
+					return NewOIDCConfigurationNotFoundError(oidcConfigurationID)
+				}
+
+				return fmt.Errorf("cannot load OIDC configuration: %w", err)
+			}
+
+			return nil
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return oidcConfiguration, nil
+}
+
+func (s *Service) GetSCIMGroupRoleMapping(ctx context.Context, mappingID gid.GID) (*coredata.SCIMGroupRoleMapping, error) {
+	var (
+		scope   = coredata.NewScopeFromObjectID(mappingID)
+		mapping = &coredata.SCIMGroupRoleMapping{}
+	)
+
+	err := s.pg.WithConn(
+		ctx,
+		func(conn pg.Conn) error {
+			err := mapping.LoadByID(ctx, conn, scope, mappingID)
+			if err != nil {
+				if err == coredata.ErrResourceNotFound {
+					return NewSCIMGroupRoleMappingNotFoundError(mappingID)
+				}
+
+				return fmt.Errorf("cannot load SCIM group role mapping: %w", err)
+			}
+
+			return nil
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return mapping, nil
+}
+
 func (s *Service) GetPersonalAPIKey(ctx context.Context, personalAPIKeyID gid.GID) (*coredata.PersonalAPIKey, error) {
 	personalAPIKey := &coredata.PersonalAPIKey{}
 
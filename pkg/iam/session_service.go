@@ -365,6 +365,10 @@ func (s SessionService) OpenPasswordChildSessionForOrganization(
 				return NewMembershipInactiveError(membership.ID)
 			}
 
+			if err := checkOrganizationMFA(ctx, tx, organizationID, coredata.AuthMethodPassword, rootSession.MFAVerifiedAt); err != nil {
+				return err
+			}
+
 			tenantID := scope.GetTenantID()
 			childSession = &coredata.Session{
 				ID:              gid.New(tenantID, coredata.SessionEntityType),
@@ -412,6 +416,26 @@ func (s SessionService) OpenSAMLChildSessionForOrganization(
 	ctx context.Context,
 	rootSessionID gid.GID,
 	organizationID gid.GID,
+) (*coredata.Session, *coredata.Membership, error) {
+	return s.openSSOChildSessionForOrganization(ctx, rootSessionID, organizationID, coredata.AuthMethodSAML)
+}
+
+// OpenOIDCChildSessionForOrganization is the OIDC counterpart of
+// OpenSAMLChildSessionForOrganization, used after a successful
+// authorization code exchange.
+func (s SessionService) OpenOIDCChildSessionForOrganization(
+	ctx context.Context,
+	rootSessionID gid.GID,
+	organizationID gid.GID,
+) (*coredata.Session, *coredata.Membership, error) {
+	return s.openSSOChildSessionForOrganization(ctx, rootSessionID, organizationID, coredata.AuthMethodOIDC)
+}
+
+func (s SessionService) openSSOChildSessionForOrganization(
+	ctx context.Context,
+	rootSessionID gid.GID,
+	organizationID gid.GID,
+	authMethod coredata.AuthMethod,
 ) (*coredata.Session, *coredata.Membership, error) {
 	var (
 		now          = time.Now()
@@ -465,7 +489,7 @@ func (s SessionService) OpenSAMLChildSessionForOrganization(
 				TenantID:        &tenantID,
 				MembershipID:    &membership.ID,
 				ParentSessionID: &rootSession.ID,
-				AuthMethod:      coredata.AuthMethodSAML,
+				AuthMethod:      authMethod,
 				AuthenticatedAt: now,
 				ExpiredAt:       rootSession.ExpiredAt,
 				CreatedAt:       now,
@@ -535,6 +559,7 @@ func (s SessionService) AssumeOrganizationSession(
 				return NewMembershipInactiveError(membership.ID)
 			}
 
+			samlPolicy := coredata.SAMLEnforcementPolicyOff
 			samlConfig := &coredata.SAMLConfiguration{}
 			err := samlConfig.LoadByOrganizationIDAndEmailDomain(
 				ctx,
@@ -543,27 +568,65 @@ func (s SessionService) AssumeOrganizationSession(
 				organizationID,
 				identity.EmailAddress.Domain(),
 			)
-			if err != nil && err != coredata.ErrResourceNotFound {
+			switch err {
+			case nil:
+				samlPolicy = samlConfig.EnforcementPolicy
+			case coredata.ErrResourceNotFound:
+			default:
 				return fmt.Errorf("cannot load SAML configuration: %w", err)
 			}
 
-			if err == nil && samlConfig.EnforcementPolicy == coredata.SAMLEnforcementPolicyRequired {
-				if rootSession.AuthMethod != coredata.AuthMethodSAML {
-					redirectURL, err := s.SAMLService.InitiateLogin(ctx, samlConfig.ID, continueURL)
-					if err != nil {
-						return fmt.Errorf("cannot initiate SAML login: %w", err)
-					}
+			oidcPolicy := coredata.SAMLEnforcementPolicyOff
+			oidcConfig := &coredata.OIDCConfiguration{}
+			err = oidcConfig.LoadByOrganizationIDAndEmailDomain(
+				ctx,
+				tx,
+				scope,
+				organizationID,
+				identity.EmailAddress.Domain(),
+			)
+			switch err {
+			case nil:
+				oidcPolicy = oidcConfig.EnforcementPolicy
+			case coredata.ErrResourceNotFound:
+			default:
+				return fmt.Errorf("cannot load OIDC configuration: %w", err)
+			}
+
+			samlRequired := samlPolicy == coredata.SAMLEnforcementPolicyRequired
+			oidcRequired := oidcPolicy == coredata.SAMLEnforcementPolicyRequired
+
+			switch {
+			case samlRequired && rootSession.AuthMethod == coredata.AuthMethodSAML,
+				oidcRequired && rootSession.AuthMethod == coredata.AuthMethodOIDC:
+				// The root session was opened by a required SSO provider.
+			case samlRequired:
+				redirectURL, err := s.SAMLService.InitiateLogin(ctx, samlConfig.ID, continueURL)
+				if err != nil {
+					return fmt.Errorf("cannot initiate SAML login: %w", err)
+				}
 
-					return NewSAMLAuthenticationRequiredError("policy_requirement", redirectURL.String())
+				return NewSAMLAuthenticationRequiredError("policy_requirement", redirectURL.String())
+			case oidcRequired:
+				redirectURL, err := s.OIDCService.InitiateLogin(ctx, oidcConfig.ID, continueURL)
+				if err != nil {
+					return fmt.Errorf("cannot initiate OIDC login: %w", err)
 				}
-			} else if err == nil && samlConfig.EnforcementPolicy == coredata.SAMLEnforcementPolicyOptional {
-				// SAML is optional: both PASSWORD and SAML root sessions are allowed.
-			} else if rootSession.AuthMethod != coredata.AuthMethodPassword {
-				// No (or non-required) SAML configuration: require a password-authenticated root session
+
+				return NewOIDCAuthenticationRequiredError("policy_requirement", redirectURL.String())
+			case samlPolicy == coredata.SAMLEnforcementPolicyOptional,
+				oidcPolicy == coredata.SAMLEnforcementPolicyOptional:
+				// SSO is optional: both PASSWORD and SSO root sessions are allowed.
+			case rootSession.AuthMethod != coredata.AuthMethodPassword:
+				// No (or non-required) SSO configuration: require a password-authenticated root session
 				// (eg. when switching into a password-based org from a SAML login).
 				return NewPasswordRequiredError("password_authentication_required")
 			}
 
+			if err := checkOrganizationMFA(ctx, tx, organizationID, rootSession.AuthMethod, rootSession.MFAVerifiedAt); err != nil {
+				return err
+			}
+
 			tenantID := scope.GetTenantID()
 			childSession = &coredata.Session{
 				ID:              gid.New(tenantID, coredata.SessionEntityType),
@@ -593,3 +656,30 @@ func (s SessionService) AssumeOrganizationSession(
 
 	return childSession, membership, nil
 }
+
+// checkOrganizationMFA rejects sessions that did not complete a second
+// factor when the organization requires one. SAML and OIDC sessions are
+// exempt as they come from an identity provider configured by the
+// organization, which is responsible for enforcing its own factors.
+func checkOrganizationMFA(
+	ctx context.Context,
+	conn pg.Conn,
+	organizationID gid.GID,
+	authMethod coredata.AuthMethod,
+	mfaVerifiedAt *time.Time,
+) error {
+	if authMethod == coredata.AuthMethodSAML || authMethod == coredata.AuthMethodOIDC || mfaVerifiedAt != nil {
+		return nil
+	}
+
+	organization := &coredata.Organization{}
+	if err := organization.LoadByID(ctx, conn, coredata.NewNoScope(), organizationID); err != nil {
+		return fmt.Errorf("cannot load organization: %w", err)
+	}
+
+	if organization.MFARequired {
+		return NewMFARequiredError("policy_requirement")
+	}
+
+	return nil
+}
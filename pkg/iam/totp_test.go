@@ -0,0 +1,88 @@
+// Copyright (c) 2025 Probo Inc <hello@getprobo.com>.
+//
+// Permission to use, copy, modify, and/or distribute this software for any
+// purpose with or without fee is hereby granted, provided that the above
+// copyright notice and this permission notice appear in all copies.
+//
+// THE SOFTWARE IS PROVIDED "AS IS" AND THE AUTHOR DISCLAIMS ALL WARRANTIES WITH
+// REGARD TO THIS SOFTWARE INCLUDING ALL IMPLIED WARRANTIES OF MERCHANTABILITY
+// AND FITNESS. IN NO EVENT SHALL THE AUTHOR BE LIABLE FOR ANY SPECIAL, DIRECT,
+// INDIRECT, OR CONSEQUENTIAL DAMAGES OR ANY DAMAGES WHATSOEVER RESULTING FROM
+// LOSS OF USE, DATA OR PROFITS, WHETHER IN AN ACTION OF CONTRACT, NEGLIGENCE OR
+// OTHER TORTIOUS ACTION, ARISING OUT OF OR IN CONNECTION WITH THE USE OR
+// PERFORMANCE OF THIS SOFTWARE.
+
+package iam
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// RFC 6238 appendix B vectors for HMAC-SHA1, truncated to six digits.
+func TestTOTPCode_RFC6238(t *testing.T) {
+	secret := []byte("12345678901234567890")
+
+	tests := []struct {
+		unix int64
+		code string
+	}{
+		{59, "287082"},
+		{1111111109, "081804"},
+		{1111111111, "050471"},
+		{1234567890, "005924"},
+		{2000000000, "279037"},
+	}
+
+	for _, tt := range tests {
+		got := totpCode(secret, totpCounter(time.Unix(tt.unix, 0)))
+		assert.Equal(t, tt.code, got, "unix time %d", tt.unix)
+	}
+}
+
+func TestValidateTOTPCode(t *testing.T) {
+	secret := []byte("12345678901234567890")
+	now := time.Unix(1111111109, 0)
+	current := totpCounter(now)
+
+	t.Run("current step", func(t *testing.T) {
+		counter, ok := validateTOTPCode(secret, "081804", now, nil)
+		require.True(t, ok)
+		assert.Equal(t, current, counter)
+	})
+
+	t.Run("adjacent steps", func(t *testing.T) {
+		_, ok := validateTOTPCode(secret, totpCode(secret, current-1), now, nil)
+		assert.True(t, ok)
+
+		_, ok = validateTOTPCode(secret, totpCode(secret, current+1), now, nil)
+		assert.True(t, ok)
+	})
+
+	t.Run("outside window", func(t *testing.T) {
+		_, ok := validateTOTPCode(secret, totpCode(secret, current-2), now, nil)
+		assert.False(t, ok)
+	})
+
+	t.Run("replayed code", func(t *testing.T) {
+		_, ok := validateTOTPCode(secret, "081804", now, &current)
+		assert.False(t, ok)
+	})
+
+	t.Run("malformed code", func(t *testing.T) {
+		_, ok := validateTOTPCode(secret, "81804", now, nil)
+		assert.False(t, ok)
+	})
+}
+
+func TestTOTPProvisioningURI(t *testing.T) {
+	uri := totpProvisioningURI("Probo", "jane@example.com", []byte("12345678901234567890"))
+
+	assert.True(t, strings.HasPrefix(uri, "otpauth://totp/Probo:jane@example.com?"))
+	assert.Contains(t, uri, "secret=GEZDGNBVGY3TQOJQGEZDGNBVGY3TQOJQ")
+	assert.Contains(t, uri, "issuer=Probo")
+}
@@ -0,0 +1,139 @@
+// Copyright (c) 2025 Probo Inc <hello@getprobo.com>.
+//
+// Permission to use, copy, modify, and/or distribute this software for any
+// purpose with or without fee is hereby granted, provided that the above
+// copyright notice and this permission notice appear in all copies.
+//
+// THE SOFTWARE IS PROVIDED "AS IS" AND THE AUTHOR DISCLAIMS ALL WARRANTIES WITH
+// REGARD TO THIS SOFTWARE INCLUDING ALL IMPLIED WARRANTIES OF MERCHANTABILITY
+// AND FITNESS. IN NO EVENT SHALL THE AUTHOR BE LIABLE FOR ANY SPECIAL, DIRECT,
+// INDIRECT, OR CONSEQUENTIAL DAMAGES OR ANY DAMAGES WHATSOEVER RESULTING FROM
+// LOSS OF USE, DATA OR PROFITS, WHETHER IN AN ACTION OF CONTRACT, NEGLIGENCE OR
+// OTHER TORTIOUS ACTION, ARISING OUT OF OR IN CONNECTION WITH THE USE OR
+// PERFORMANCE OF THIS SOFTWARE.
+
+package mailer
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/textproto"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRetryDelay(t *testing.T) {
+	t.Parallel()
+
+	base := time.Minute
+	maxDelay := 2 * time.Hour
+
+	tests := []struct {
+		name     string
+		attempts int
+		lower    time.Duration
+		upper    time.Duration
+	}{
+		{name: "no attempt", attempts: 0, lower: 0, upper: 0},
+		{name: "first retry", attempts: 1, lower: 30 * time.Second, upper: time.Minute},
+		{name: "third retry", attempts: 3, lower: 2 * time.Minute, upper: 4 * time.Minute},
+		{name: "capped", attempts: 20, lower: time.Hour, upper: 2 * time.Hour},
+		{name: "overflow", attempts: 200, lower: time.Hour, upper: 2 * time.Hour},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			for range 100 {
+				delay := retryDelay(tt.attempts, base, maxDelay)
+				assert.GreaterOrEqual(t, delay, tt.lower)
+				assert.LessOrEqual(t, delay, tt.upper)
+			}
+		})
+	}
+}
+
+func TestIsPermanentError(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name      string
+		err       error
+		email     bool
+		permanent bool
+	}{
+		{
+			name:      "unknown mailbox",
+			err:       &recipientError{err: fmt.Errorf("RCPT TO error: %w", &textproto.Error{Code: 550, Msg: "mailbox unavailable"})},
+			email:     true,
+			permanent: true,
+		},
+		{
+			name:      "message rejected",
+			err:       &recipientError{err: fmt.Errorf("message close error: %w", &textproto.Error{Code: 554, Msg: "message rejected"})},
+			email:     true,
+			permanent: true,
+		},
+		{
+			name:      "greylisted",
+			err:       &recipientError{err: fmt.Errorf("RCPT TO error: %w", &textproto.Error{Code: 451, Msg: "try again later"})},
+			email:     true,
+			permanent: false,
+		},
+		{
+			name:      "connection lost during RCPT",
+			err:       &recipientError{err: fmt.Errorf("RCPT TO error: %w", errors.New("broken pipe"))},
+			email:     true,
+			permanent: false,
+		},
+		{
+			name:      "connection lost during DATA",
+			err:       &recipientError{err: fmt.Errorf("message write error: %w", errors.New("connection reset by peer"))},
+			email:     true,
+			permanent: false,
+		},
+		{
+			name:      "authentication rejected",
+			err:       fmt.Errorf("SMTP authentication error: %w", &textproto.Error{Code: 535, Msg: "authentication failed"}),
+			email:     false,
+			permanent: false,
+		},
+		{
+			name:      "sender rejected",
+			err:       fmt.Errorf("MAIL FROM error: %w", &textproto.Error{Code: 550, Msg: "sender rejected"}),
+			email:     false,
+			permanent: false,
+		},
+		{
+			name:      "connection refused",
+			err:       fmt.Errorf("connection error: %w", errors.New("connection refused")),
+			email:     false,
+			permanent: false,
+		},
+		{
+			name:      "timeout",
+			err:       fmt.Errorf("connection error: %w", context.DeadlineExceeded),
+			email:     false,
+			permanent: false,
+		},
+		{
+			name:      "build error",
+			err:       &buildError{err: errors.New("cannot build email")},
+			email:     true,
+			permanent: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, tt.email, isEmailError(tt.err))
+			assert.Equal(t, tt.permanent, isPermanentError(tt.err))
+		})
+	}
+}
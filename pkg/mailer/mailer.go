@@ -24,18 +24,35 @@ import (
 	"net/smtp"
 	"time"
 
-	"go.probo.inc/probo/pkg/coredata"
 	"github.com/jhillyerd/enmime"
 	"go.gearno.de/kit/log"
 	"go.gearno.de/kit/pg"
+	"go.probo.inc/probo/pkg/coredata"
 )
 
 type (
 	Mailer struct {
-		pg       *pg.Client
-		l        *log.Logger
-		cfg      Config
-		interval time.Duration
+		pg             *pg.Client
+		l              *log.Logger
+		cfg            Config
+		interval       time.Duration
+		maxAttempts    int
+		retryBaseDelay time.Duration
+		retryMaxDelay  time.Duration
+	}
+
+	// buildError reports an email that cannot be turned into a MIME
+	// message. Retrying it would fail the same way.
+	buildError struct {
+		err error
+	}
+
+	// recipientError reports a failure once the RCPT command of an email
+	// has started. A reply of the SMTP server at that point is about the
+	// email itself, and a connection that keeps breaking on the same email
+	// must not stall the rest of the queue, so both count as an attempt.
+	recipientError struct {
+		err error
 	}
 
 	Config struct {
@@ -47,9 +64,29 @@ type (
 		Password    string
 		TLSRequired bool
 		Interval    time.Duration
+
+		MaxAttempts    int
+		RetryBaseDelay time.Duration
+		RetryMaxDelay  time.Duration
 	}
 )
 
+func (e *buildError) Error() string {
+	return e.err.Error()
+}
+
+func (e *buildError) Unwrap() error {
+	return e.err
+}
+
+func (e *recipientError) Error() string {
+	return e.err.Error()
+}
+
+func (e *recipientError) Unwrap() error {
+	return e.err
+}
+
 func NewMailer(pg *pg.Client, l *log.Logger, cfg Config) *Mailer {
 	// Set a default timeout if not provided
 	if cfg.Timeout == 0 {
@@ -61,7 +98,27 @@ func NewMailer(pg *pg.Client, l *log.Logger, cfg Config) *Mailer {
 		cfg.Interval = 60 * time.Second
 	}
 
-	return &Mailer{pg: pg, l: l, cfg: cfg, interval: cfg.Interval}
+	if cfg.MaxAttempts <= 0 {
+		cfg.MaxAttempts = DefaultMaxAttempts
+	}
+
+	if cfg.RetryBaseDelay <= 0 {
+		cfg.RetryBaseDelay = DefaultRetryBaseDelay
+	}
+
+	if cfg.RetryMaxDelay <= 0 {
+		cfg.RetryMaxDelay = DefaultRetryMaxDelay
+	}
+
+	return &Mailer{
+		pg:             pg,
+		l:              l,
+		cfg:            cfg,
+		interval:       cfg.Interval,
+		maxAttempts:    cfg.MaxAttempts,
+		retryBaseDelay: cfg.RetryBaseDelay,
+		retryMaxDelay:  cfg.RetryMaxDelay,
+	}
 }
 
 func (m *Mailer) Run(ctx context.Context) error {
@@ -119,27 +176,32 @@ func (m *Mailer) sendMailWithTimeout(ctx context.Context, to []string, msg []byt
 
 	for _, addr := range to {
 		if err = c.Rcpt(addr); err != nil {
-			return fmt.Errorf("RCPT TO error: %w", err)
+			return &recipientError{err: fmt.Errorf("RCPT TO error: %w", err)}
 		}
 	}
 
 	w, err := c.Data()
 	if err != nil {
-		return fmt.Errorf("DATA command error: %w", err)
+		return &recipientError{err: fmt.Errorf("DATA command error: %w", err)}
 	}
 
 	_, err = w.Write(msg)
 	if err != nil {
-		return fmt.Errorf("message write error: %w", err)
+		return &recipientError{err: fmt.Errorf("message write error: %w", err)}
 	}
 
 	if err = w.Close(); err != nil {
-		return fmt.Errorf("message close error: %w", err)
+		return &recipientError{err: fmt.Errorf("message close error: %w", err)}
 	}
 
 	return c.Quit()
 }
 
+// batchSendEmails sends every pending email that is due. A failure once
+// the RCPT command has started is recorded on the email so that one
+// undeliverable email never blocks the rest of the queue. Any earlier
+// failure, such as an unreachable server or rejected credentials, aborts
+// the batch without counting an attempt against the email.
 func (m *Mailer) batchSendEmails(ctx context.Context) error {
 	for {
 		err := m.pg.WithTx(
@@ -151,39 +213,21 @@ func (m *Mailer) batchSendEmails(ctx context.Context) error {
 					return err
 				}
 
-				mail := enmime.Builder().
-					Subject(email.Subject).
-					From(m.cfg.SenderName, m.cfg.SenderEmail).
-					To(email.RecipientName, email.RecipientEmail).
-					Text([]byte(email.TextBody))
-
-				if email.HtmlBody != nil {
-					mail = mail.HTML([]byte(*email.HtmlBody))
-				}
-
-				envelope, err := mail.Build()
-				if err != nil {
-					return fmt.Errorf("cannot build email: %w", err)
-				}
-
-				var buf bytes.Buffer
-				if err := envelope.Encode(&buf); err != nil {
-					return fmt.Errorf("cannot encode email: %w", err)
-				}
-
-				sendCtx, cancel := context.WithTimeout(ctx, m.cfg.Timeout)
-				defer cancel()
+				now := time.Now()
+				email.AttemptCount++
+				email.UpdatedAt = now
 
-				if err := m.sendMailWithTimeout(sendCtx, []string{email.RecipientEmail}, buf.Bytes()); err != nil {
-					if errors.Is(err, context.DeadlineExceeded) {
-						return fmt.Errorf("email sending timed out after %s: %w", m.cfg.Timeout, err)
+				if err := m.sendEmail(ctx, email); err != nil {
+					if !isEmailError(err) {
+						return err
 					}
-					return fmt.Errorf("cannot send email: %w", err)
-				}
 
-				now := time.Now()
-				email.SentAt = &now
-				email.UpdatedAt = now
+					m.recordFailure(ctx, email, err, now)
+				} else {
+					email.Status = coredata.EmailStatusSent
+					email.SentAt = &now
+					email.NextAttemptAt = nil
+				}
 
 				if err := email.Update(ctx, tx); err != nil {
 					return fmt.Errorf("cannot update email: %w", err)
@@ -202,3 +246,73 @@ func (m *Mailer) batchSendEmails(ctx context.Context) error {
 		}
 	}
 }
+
+func (m *Mailer) sendEmail(ctx context.Context, email *coredata.Email) error {
+	mail := enmime.Builder().
+		Subject(email.Subject).
+		From(m.cfg.SenderName, m.cfg.SenderEmail).
+		To(email.RecipientName, email.RecipientEmail).
+		Text([]byte(email.TextBody))
+
+	if email.HtmlBody != nil {
+		mail = mail.HTML([]byte(*email.HtmlBody))
+	}
+
+	envelope, err := mail.Build()
+	if err != nil {
+		return &buildError{err: fmt.Errorf("cannot build email: %w", err)}
+	}
+
+	var buf bytes.Buffer
+	if err := envelope.Encode(&buf); err != nil {
+		return &buildError{err: fmt.Errorf("cannot encode email: %w", err)}
+	}
+
+	sendCtx, cancel := context.WithTimeout(ctx, m.cfg.Timeout)
+	defer cancel()
+
+	if err := m.sendMailWithTimeout(sendCtx, []string{email.RecipientEmail}, buf.Bytes()); err != nil {
+		if errors.Is(err, context.DeadlineExceeded) {
+			return fmt.Errorf("email sending timed out after %s: %w", m.cfg.Timeout, err)
+		}
+		return fmt.Errorf("cannot send email: %w", err)
+	}
+
+	return nil
+}
+
+// recordFailure schedules the next attempt of an email that could not be
+// delivered, or marks it as failed when the error is permanent or all
+// attempts have been used.
+func (m *Mailer) recordFailure(ctx context.Context, email *coredata.Email, sendErr error, now time.Time) {
+	lastError := sendErr.Error()
+	email.LastError = &lastError
+
+	var errBuild *buildError
+	if errors.As(sendErr, &errBuild) || isPermanentError(sendErr) || email.AttemptCount >= m.maxAttempts {
+		email.Status = coredata.EmailStatusFailed
+		email.FailedAt = &now
+		email.NextAttemptAt = nil
+
+		m.l.ErrorCtx(
+			ctx,
+			"email delivery failed permanently",
+			log.String("email_id", email.ID.String()),
+			log.Int("attempts", email.AttemptCount),
+			log.Error(sendErr),
+		)
+		return
+	}
+
+	nextAttemptAt := now.Add(retryDelay(email.AttemptCount, m.retryBaseDelay, m.retryMaxDelay))
+	email.NextAttemptAt = &nextAttemptAt
+
+	m.l.WarnCtx(
+		ctx,
+		"email delivery failed, will retry",
+		log.String("email_id", email.ID.String()),
+		log.Int("attempts", email.AttemptCount),
+		log.Time("next_attempt_at", nextAttemptAt),
+		log.Error(sendErr),
+	)
+}
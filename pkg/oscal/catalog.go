@@ -0,0 +1,276 @@
+// Copyright (c) 2025 Probo Inc <hello@getprobo.com>.
+//
+// Permission to use, copy, modify, and/or distribute this software for any
+// purpose with or without fee is hereby granted, provided that the above
+// copyright notice and this permission notice appear in all copies.
+//
+// THE SOFTWARE IS PROVIDED "AS IS" AND THE AUTHOR DISCLAIMS ALL WARRANTIES WITH
+// REGARD TO THIS SOFTWARE INCLUDING ALL IMPLIED WARRANTIES OF MERCHANTABILITY
+// AND FITNESS. IN NO EVENT SHALL THE AUTHOR BE LIABLE FOR ANY SPECIAL, DIRECT,
+// INDIRECT, OR CONSEQUENTIAL DAMAGES OR ANY DAMAGES WHATSOEVER RESULTING FROM
+// LOSS OF USE, DATA OR PROFITS, WHETHER IN AN ACTION OF CONTRACT, NEGLIGENCE OR
+// OTHER TORTIOUS ACTION, ARISING OUT OF OR IN CONNECTION WITH THE USE OR
+// PERFORMANCE OF THIS SOFTWARE.
+
+package oscal
+
+import (
+	"fmt"
+	"path"
+	"regexp"
+	"strings"
+)
+
+var (
+	insertParamRe = regexp.MustCompile(`\{\{\s*insert:\s*param,\s*([^\s}]+)\s*\}\}`)
+)
+
+// AllControls returns every control of the catalog, including the ones
+// nested in groups and control enhancements, in document order. Withdrawn
+// controls are skipped.
+func (c *Catalog) AllControls() []*Control {
+	var controls []*Control
+
+	var walkControls func([]*Control)
+	walkControls = func(cs []*Control) {
+		for _, control := range cs {
+			if !control.Withdrawn() {
+				controls = append(controls, control)
+			}
+			walkControls(control.Controls)
+		}
+	}
+
+	var walkGroups func([]*Group)
+	walkGroups = func(groups []*Group) {
+		for _, group := range groups {
+			walkControls(group.Controls)
+			walkGroups(group.Groups)
+		}
+	}
+
+	walkControls(c.Controls)
+	walkGroups(c.Groups)
+
+	return controls
+}
+
+// params returns every parameter of the catalog keyed by ID, including the
+// ones declared on groups and controls.
+func (c *Catalog) params() map[string]*Param {
+	params := map[string]*Param{}
+	for _, param := range c.Params {
+		params[param.ID] = param
+	}
+
+	var walkControls func([]*Control)
+	walkControls = func(cs []*Control) {
+		for _, control := range cs {
+			for _, param := range control.Params {
+				params[param.ID] = param
+			}
+			walkControls(control.Controls)
+		}
+	}
+
+	var walkGroups func([]*Group)
+	walkGroups = func(groups []*Group) {
+		for _, group := range groups {
+			for _, param := range group.Params {
+				params[param.ID] = param
+			}
+			walkControls(group.Controls)
+			walkGroups(group.Groups)
+		}
+	}
+
+	walkControls(c.Controls)
+	walkGroups(c.Groups)
+
+	return params
+}
+
+// Withdrawn reports whether the control has been withdrawn from the
+// catalog.
+func (c *Control) Withdrawn() bool {
+	for _, prop := range c.Props {
+		if prop.Name == "status" && (prop.Value == "withdrawn" || prop.Value == "Withdrawn") {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Label returns the human readable label of the control, falling back to
+// its ID.
+func (c *Control) Label() string {
+	label := ""
+	for _, prop := range c.Props {
+		if prop.Name != "label" {
+			continue
+		}
+
+		if prop.Class == "" {
+			return prop.Value
+		}
+
+		if label == "" {
+			label = prop.Value
+		}
+	}
+
+	if label == "" {
+		return c.ID
+	}
+
+	return label
+}
+
+// Statement returns the prose of the control statement, with nested items
+// prefixed by their label and parameter insertions replaced by the
+// parameter label or values.
+func (c *Catalog) Statement(control *Control) string {
+	params := c.params()
+
+	var b strings.Builder
+	var walk func(parts []*Part, depth int)
+	walk = func(parts []*Part, depth int) {
+		for _, part := range parts {
+			if part.Name != "statement" && part.Name != "item" {
+				continue
+			}
+
+			if prose := strings.TrimSpace(part.Prose); prose != "" {
+				if b.Len() > 0 {
+					b.WriteString("\n")
+				}
+				b.WriteString(strings.Repeat("  ", depth))
+				for _, prop := range part.Props {
+					if prop.Name == "label" {
+						b.WriteString(prop.Value)
+						b.WriteString(" ")
+						break
+					}
+				}
+				b.WriteString(insertParams(prose, params))
+			}
+
+			walk(part.Parts, depth+1)
+		}
+	}
+
+	walk(control.Parts, 0)
+
+	return b.String()
+}
+
+func insertParams(prose string, params map[string]*Param) string {
+	return insertParamRe.ReplaceAllStringFunc(
+		prose,
+		func(match string) string {
+			id := insertParamRe.FindStringSubmatch(match)[1]
+
+			param, ok := params[id]
+			switch {
+			case !ok:
+				return "[Assignment: " + id + "]"
+			case len(param.Values) > 0:
+				return strings.Join(param.Values, ", ")
+			case param.Label != "":
+				return "[Assignment: " + param.Label + "]"
+			default:
+				return "[Assignment: " + id + "]"
+			}
+		},
+	)
+}
+
+// Resolve selects the controls of the catalog included by the profile.
+// Every import of the profile must target the given catalog, as imported
+// resources are not fetched.
+func (p *Profile) Resolve(catalog *Catalog) ([]*Control, error) {
+	if len(p.Imports) == 0 {
+		return nil, fmt.Errorf("cannot resolve profile: no import")
+	}
+
+	controls := catalog.AllControls()
+	selected := map[string]bool{}
+
+	for _, imp := range p.Imports {
+		included := map[string]bool{}
+
+		if imp.IncludeAll != nil {
+			for _, control := range controls {
+				included[control.ID] = true
+			}
+		}
+
+		for _, selection := range imp.IncludeControls {
+			for id := range selection.match(catalog) {
+				included[id] = true
+			}
+		}
+
+		for _, selection := range imp.ExcludeControls {
+			for id := range selection.match(catalog) {
+				delete(included, id)
+			}
+		}
+
+		for id := range included {
+			selected[id] = true
+		}
+	}
+
+	var result []*Control
+	for _, control := range controls {
+		if selected[control.ID] {
+			result = append(result, control)
+		}
+	}
+
+	return result, nil
+}
+
+func (s ControlSelection) match(catalog *Catalog) map[string]bool {
+	matched := map[string]bool{}
+
+	var walk func(cs []*Control, parentMatched bool)
+	walk = func(cs []*Control, parentMatched bool) {
+		for _, control := range cs {
+			isMatch := parentMatched && s.WithChildControls == "yes"
+
+			for _, id := range s.WithIDs {
+				if id == control.ID {
+					isMatch = true
+				}
+			}
+
+			for _, matching := range s.Matching {
+				if ok, _ := path.Match(matching.Pattern, control.ID); ok {
+					isMatch = true
+				}
+			}
+
+			if isMatch {
+				matched[control.ID] = true
+			}
+
+			walk(control.Controls, isMatch)
+		}
+	}
+
+	var walkGroups func([]*Group)
+	walkGroups = func(groups []*Group) {
+		for _, group := range groups {
+			walk(group.Controls, false)
+			walkGroups(group.Groups)
+		}
+	}
+
+	walk(catalog.Controls, false)
+	walkGroups(catalog.Groups)
+
+	return matched
+}
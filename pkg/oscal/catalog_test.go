@@ -0,0 +1,215 @@
+// Copyright (c) 2025 Probo Inc <hello@getprobo.com>.
+//
+// Permission to use, copy, modify, and/or distribute this software for any
+// purpose with or without fee is hereby granted, provided that the above
+// copyright notice and this permission notice appear in all copies.
+//
+// THE SOFTWARE IS PROVIDED "AS IS" AND THE AUTHOR DISCLAIMS ALL WARRANTIES WITH
+// REGARD TO THIS SOFTWARE INCLUDING ALL IMPLIED WARRANTIES OF MERCHANTABILITY
+// AND FITNESS. IN NO EVENT SHALL THE AUTHOR BE LIABLE FOR ANY SPECIAL, DIRECT,
+// INDIRECT, OR CONSEQUENTIAL DAMAGES OR ANY DAMAGES WHATSOEVER RESULTING FROM
+// LOSS OF USE, DATA OR PROFITS, WHETHER IN AN ACTION OF CONTRACT, NEGLIGENCE OR
+// OTHER TORTIOUS ACTION, ARISING OUT OF OR IN CONNECTION WITH THE USE OR
+// PERFORMANCE OF THIS SOFTWARE.
+
+package oscal
+
+import (
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testCatalog = `{
+  "catalog": {
+    "uuid": "74c8ba1e-5cd4-4ad1-bbfd-d888e2f6c724",
+    "metadata": {
+      "title": "Test Catalog",
+      "last-modified": "2024-01-01T00:00:00Z",
+      "version": "1.0",
+      "oscal-version": "1.1.2"
+    },
+    "groups": [
+      {
+        "id": "ac",
+        "title": "Access Control",
+        "controls": [
+          {
+            "id": "ac-1",
+            "title": "Policy and Procedures",
+            "params": [
+              {"id": "ac-1_prm_1", "label": "organization-defined personnel"},
+              {"id": "ac-1_prm_2", "values": ["annually"]}
+            ],
+            "props": [
+              {"name": "label", "value": "AC-1"},
+              {"name": "label", "class": "sp800-53a", "value": "AC-01"}
+            ],
+            "parts": [
+              {
+                "id": "ac-1_smt",
+                "name": "statement",
+                "prose": "Disseminate to {{ insert: param, ac-1_prm_1 }}:",
+                "parts": [
+                  {
+                    "id": "ac-1_smt.a",
+                    "name": "item",
+                    "props": [{"name": "label", "value": "a."}],
+                    "prose": "Review the policy {{ insert: param, ac-1_prm_2 }}."
+                  }
+                ]
+              },
+              {"id": "ac-1_gdn", "name": "guidance", "prose": "Not part of the statement."}
+            ]
+          },
+          {
+            "id": "ac-2",
+            "title": "Account Management",
+            "props": [{"name": "label", "value": "AC-2"}],
+            "controls": [
+              {
+                "id": "ac-2.1",
+                "title": "Automated System Account Management",
+                "props": [{"name": "label", "value": "AC-2(1)"}]
+              },
+              {
+                "id": "ac-2.10",
+                "title": "Shared and Group Account Credential Change",
+                "props": [{"name": "status", "value": "withdrawn"}]
+              }
+            ]
+          }
+        ]
+      }
+    ]
+  }
+}`
+
+func decodeTestCatalog(t *testing.T) *Catalog {
+	t.Helper()
+
+	document, err := Decode(strings.NewReader(testCatalog))
+	require.NoError(t, err)
+	require.NotNil(t, document.Catalog)
+
+	return document.Catalog
+}
+
+func controlIDs(controls []*Control) []string {
+	ids := make([]string, len(controls))
+	for i, control := range controls {
+		ids[i] = control.ID
+	}
+
+	return ids
+}
+
+func TestDecode(t *testing.T) {
+	_, err := Decode(strings.NewReader(`{"assessment-plan": {}}`))
+	assert.ErrorIs(t, err, ErrUnsupportedDocument)
+}
+
+func TestCatalogAllControls(t *testing.T) {
+	catalog := decodeTestCatalog(t)
+
+	assert.Equal(t, []string{"ac-1", "ac-2", "ac-2.1"}, controlIDs(catalog.AllControls()))
+}
+
+func TestControlLabel(t *testing.T) {
+	catalog := decodeTestCatalog(t)
+	controls := catalog.AllControls()
+
+	assert.Equal(t, "AC-1", controls[0].Label())
+	assert.Equal(t, "AC-2(1)", controls[2].Label())
+	assert.Equal(t, "ac-3", (&Control{ID: "ac-3"}).Label())
+}
+
+func TestCatalogStatement(t *testing.T) {
+	catalog := decodeTestCatalog(t)
+
+	assert.Equal(
+		t,
+		"Disseminate to [Assignment: organization-defined personnel]:\n  a. Review the policy annually.",
+		catalog.Statement(catalog.AllControls()[0]),
+	)
+}
+
+func TestProfileResolve(t *testing.T) {
+	catalog := decodeTestCatalog(t)
+
+	tests := []struct {
+		name    string
+		profile Profile
+		want    []string
+	}{
+		{
+			name:    "include all",
+			profile: Profile{Imports: []Import{{IncludeAll: &struct{}{}}}},
+			want:    []string{"ac-1", "ac-2", "ac-2.1"},
+		},
+		{
+			name: "include with ids",
+			profile: Profile{Imports: []Import{{
+				IncludeControls: []ControlSelection{{WithIDs: []string{"ac-2"}}},
+			}}},
+			want: []string{"ac-2"},
+		},
+		{
+			name: "include with child controls",
+			profile: Profile{Imports: []Import{{
+				IncludeControls: []ControlSelection{{WithIDs: []string{"ac-2"}, WithChildControls: "yes"}},
+			}}},
+			want: []string{"ac-2", "ac-2.1"},
+		},
+		{
+			name: "exclude matching",
+			profile: Profile{Imports: []Import{{
+				IncludeAll: &struct{}{},
+				ExcludeControls: []ControlSelection{{Matching: []struct {
+					Pattern string `json:"pattern"`
+				}{{Pattern: "ac-2*"}}}},
+			}}},
+			want: []string{"ac-1"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			controls, err := tt.profile.Resolve(catalog)
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, controlIDs(controls))
+		})
+	}
+
+	_, err := (&Profile{}).Resolve(catalog)
+	assert.Error(t, err)
+}
+
+func TestToken(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected string
+	}{
+		{"AC-2(1)", "ac-2.1"},
+		{"A.5.1", "a.5.1"},
+		{"CC1.1", "cc1.1"},
+		{"1.2", "_1.2"},
+		{"Art. 32", "art.-32"},
+		{"", "_"},
+	}
+
+	for _, test := range tests {
+		assert.Equal(t, test.expected, Token(test.input), "Token(%q)", test.input)
+	}
+}
+
+func TestNewUUID(t *testing.T) {
+	uuidRe := regexp.MustCompile(`^[0-9a-f]{8}-[0-9a-f]{4}-5[0-9a-f]{3}-[89ab][0-9a-f]{3}-[0-9a-f]{12}$`)
+
+	assert.Regexp(t, uuidRe, NewUUID("control"))
+	assert.Equal(t, NewUUID("control"), NewUUID("control"))
+	assert.NotEqual(t, NewUUID("control"), NewUUID("measure"))
+}
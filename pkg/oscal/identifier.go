@@ -0,0 +1,64 @@
+// Copyright (c) 2025 Probo Inc <hello@getprobo.com>.
+//
+// Permission to use, copy, modify, and/or distribute this software for any
+// purpose with or without fee is hereby granted, provided that the above
+// copyright notice and this permission notice appear in all copies.
+//
+// THE SOFTWARE IS PROVIDED "AS IS" AND THE AUTHOR DISCLAIMS ALL WARRANTIES WITH
+// REGARD TO THIS SOFTWARE INCLUDING ALL IMPLIED WARRANTIES OF MERCHANTABILITY
+// AND FITNESS. IN NO EVENT SHALL THE AUTHOR BE LIABLE FOR ANY SPECIAL, DIRECT,
+// INDIRECT, OR CONSEQUENTIAL DAMAGES OR ANY DAMAGES WHATSOEVER RESULTING FROM
+// LOSS OF USE, DATA OR PROFITS, WHETHER IN AN ACTION OF CONTRACT, NEGLIGENCE OR
+// OTHER TORTIOUS ACTION, ARISING OUT OF OR IN CONNECTION WITH THE USE OR
+// PERFORMANCE OF THIS SOFTWARE.
+
+package oscal
+
+import (
+	"crypto/sha1"
+	"fmt"
+	"strings"
+	"unicode"
+)
+
+// NewUUID returns a name based UUID (version 5) so that exporting the same
+// objects twice produces the same identifiers.
+func NewUUID(name string) string {
+	h := sha1.New()
+	_, _ = h.Write([]byte(Namespace))
+	_, _ = h.Write([]byte(name))
+	sum := h.Sum(nil)
+
+	sum[6] = (sum[6] & 0x0f) | 0x50
+	sum[8] = (sum[8] & 0x3f) | 0x80
+
+	return fmt.Sprintf("%x-%x-%x-%x-%x", sum[0:4], sum[4:6], sum[6:8], sum[8:10], sum[10:16])
+}
+
+// Token converts a control label such as "AC-2(1)" or "A.5.1" into an
+// OSCAL token such as "ac-2.1" or "a.5.1".
+func Token(s string) string {
+	var b strings.Builder
+	for _, r := range strings.ToLower(strings.TrimSpace(s)) {
+		switch {
+		case unicode.IsLetter(r) || unicode.IsDigit(r) || r == '.' || r == '-' || r == '_':
+			b.WriteRune(r)
+		case r == '(':
+			b.WriteRune('.')
+		case r == ')':
+		default:
+			b.WriteRune('-')
+		}
+	}
+
+	token := b.String()
+	if token == "" {
+		return "_"
+	}
+
+	if first := []rune(token)[0]; !unicode.IsLetter(first) && first != '_' {
+		token = "_" + token
+	}
+
+	return token
+}
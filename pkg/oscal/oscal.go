@@ -0,0 +1,210 @@
+// Copyright (c) 2025 Probo Inc <hello@getprobo.com>.
+//
+// Permission to use, copy, modify, and/or distribute this software for any
+// purpose with or without fee is hereby granted, provided that the above
+// copyright notice and this permission notice appear in all copies.
+//
+// THE SOFTWARE IS PROVIDED "AS IS" AND THE AUTHOR DISCLAIMS ALL WARRANTIES WITH
+// REGARD TO THIS SOFTWARE INCLUDING ALL IMPLIED WARRANTIES OF MERCHANTABILITY
+// AND FITNESS. IN NO EVENT SHALL THE AUTHOR BE LIABLE FOR ANY SPECIAL, DIRECT,
+// INDIRECT, OR CONSEQUENTIAL DAMAGES OR ANY DAMAGES WHATSOEVER RESULTING FROM
+// LOSS OF USE, DATA OR PROFITS, WHETHER IN AN ACTION OF CONTRACT, NEGLIGENCE OR
+// OTHER TORTIOUS ACTION, ARISING OUT OF OR IN CONNECTION WITH THE USE OR
+// PERFORMANCE OF THIS SOFTWARE.
+
+// Package oscal reads and writes the subset of the NIST Open Security
+// Controls Assessment Language (OSCAL) JSON models needed to exchange
+// frameworks and their implementation: catalogs, profiles and component
+// definitions.
+package oscal
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"time"
+)
+
+const (
+	Version = "1.1.2"
+
+	// Namespace qualifies the properties this package writes that are
+	// not defined by OSCAL itself.
+	Namespace = "https://probo.inc/ns/oscal"
+)
+
+var (
+	ErrUnsupportedDocument = errors.New("unsupported oscal document")
+)
+
+type (
+	// Document is the root of an OSCAL JSON file. Exactly one model is set.
+	Document struct {
+		Catalog             *Catalog             `json:"catalog,omitempty"`
+		Profile             *Profile             `json:"profile,omitempty"`
+		ComponentDefinition *ComponentDefinition `json:"component-definition,omitempty"`
+	}
+
+	Metadata struct {
+		Title        string    `json:"title"`
+		LastModified time.Time `json:"last-modified"`
+		Version      string    `json:"version"`
+		OSCALVersion string    `json:"oscal-version"`
+		Props        []Prop    `json:"props,omitempty"`
+	}
+
+	Prop struct {
+		Name  string `json:"name"`
+		Value string `json:"value"`
+		NS    string `json:"ns,omitempty"`
+		Class string `json:"class,omitempty"`
+	}
+
+	Link struct {
+		Href      string `json:"href"`
+		Rel       string `json:"rel,omitempty"`
+		MediaType string `json:"media-type,omitempty"`
+		Text      string `json:"text,omitempty"`
+	}
+
+	Part struct {
+		ID    string  `json:"id,omitempty"`
+		Name  string  `json:"name"`
+		Title string  `json:"title,omitempty"`
+		Props []Prop  `json:"props,omitempty"`
+		Prose string  `json:"prose,omitempty"`
+		Parts []*Part `json:"parts,omitempty"`
+	}
+
+	Param struct {
+		ID     string   `json:"id"`
+		Label  string   `json:"label,omitempty"`
+		Values []string `json:"values,omitempty"`
+	}
+
+	Catalog struct {
+		UUID     string     `json:"uuid"`
+		Metadata Metadata   `json:"metadata"`
+		Params   []*Param   `json:"params,omitempty"`
+		Controls []*Control `json:"controls,omitempty"`
+		Groups   []*Group   `json:"groups,omitempty"`
+	}
+
+	Group struct {
+		ID       string     `json:"id,omitempty"`
+		Class    string     `json:"class,omitempty"`
+		Title    string     `json:"title"`
+		Params   []*Param   `json:"params,omitempty"`
+		Props    []Prop     `json:"props,omitempty"`
+		Parts    []*Part    `json:"parts,omitempty"`
+		Groups   []*Group   `json:"groups,omitempty"`
+		Controls []*Control `json:"controls,omitempty"`
+	}
+
+	Control struct {
+		ID       string     `json:"id"`
+		Class    string     `json:"class,omitempty"`
+		Title    string     `json:"title"`
+		Params   []*Param   `json:"params,omitempty"`
+		Props    []Prop     `json:"props,omitempty"`
+		Links    []Link     `json:"links,omitempty"`
+		Parts    []*Part    `json:"parts,omitempty"`
+		Controls []*Control `json:"controls,omitempty"`
+	}
+
+	Profile struct {
+		UUID     string   `json:"uuid"`
+		Metadata Metadata `json:"metadata"`
+		Imports  []Import `json:"imports"`
+	}
+
+	Import struct {
+		Href            string             `json:"href"`
+		IncludeAll      *struct{}          `json:"include-all,omitempty"`
+		IncludeControls []ControlSelection `json:"include-controls,omitempty"`
+		ExcludeControls []ControlSelection `json:"exclude-controls,omitempty"`
+	}
+
+	ControlSelection struct {
+		WithChildControls string   `json:"with-child-controls,omitempty"`
+		WithIDs           []string `json:"with-ids,omitempty"`
+		Matching          []struct {
+			Pattern string `json:"pattern"`
+		} `json:"matching,omitempty"`
+	}
+
+	ComponentDefinition struct {
+		UUID       string       `json:"uuid"`
+		Metadata   Metadata     `json:"metadata"`
+		Components []*Component `json:"components,omitempty"`
+		BackMatter *BackMatter  `json:"back-matter,omitempty"`
+	}
+
+	Component struct {
+		UUID                   string                   `json:"uuid"`
+		Type                   string                   `json:"type"`
+		Title                  string                   `json:"title"`
+		Description            string                   `json:"description"`
+		Props                  []Prop                   `json:"props,omitempty"`
+		ControlImplementations []*ControlImplementation `json:"control-implementations,omitempty"`
+	}
+
+	ControlImplementation struct {
+		UUID                    string                    `json:"uuid"`
+		Source                  string                    `json:"source"`
+		Description             string                    `json:"description"`
+		ImplementedRequirements []*ImplementedRequirement `json:"implemented-requirements"`
+	}
+
+	ImplementedRequirement struct {
+		UUID        string `json:"uuid"`
+		ControlID   string `json:"control-id"`
+		Description string `json:"description"`
+		Props       []Prop `json:"props,omitempty"`
+		Links       []Link `json:"links,omitempty"`
+	}
+
+	BackMatter struct {
+		Resources []*Resource `json:"resources,omitempty"`
+	}
+
+	Resource struct {
+		UUID        string  `json:"uuid"`
+		Title       string  `json:"title,omitempty"`
+		Description string  `json:"description,omitempty"`
+		Props       []Prop  `json:"props,omitempty"`
+		RLinks      []RLink `json:"rlinks,omitempty"`
+	}
+
+	RLink struct {
+		Href      string `json:"href"`
+		MediaType string `json:"media-type,omitempty"`
+	}
+)
+
+// Decode reads an OSCAL JSON document.
+func Decode(r io.Reader) (*Document, error) {
+	var document Document
+	if err := json.NewDecoder(r).Decode(&document); err != nil {
+		return nil, fmt.Errorf("cannot decode oscal document: %w", err)
+	}
+
+	if document.Catalog == nil && document.Profile == nil && document.ComponentDefinition == nil {
+		return nil, ErrUnsupportedDocument
+	}
+
+	return &document, nil
+}
+
+// Encode writes an OSCAL JSON document.
+func Encode(w io.Writer, document *Document) error {
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+
+	if err := encoder.Encode(document); err != nil {
+		return fmt.Errorf("cannot encode oscal document: %w", err)
+	}
+
+	return nil
+}
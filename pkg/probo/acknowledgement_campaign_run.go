@@ -0,0 +1,182 @@
+// Copyright (c) 2025 Probo Inc <hello@getprobo.com>.
+//
+// Permission to use, copy, modify, and/or distribute this software for any
+// purpose with or without fee is hereby granted, provided that the above
+// copyright notice and this permission notice appear in all copies.
+//
+// THE SOFTWARE IS PROVIDED "AS IS" AND THE AUTHOR DISCLAIMS ALL WARRANTIES WITH
+// REGARD TO THIS SOFTWARE INCLUDING ALL IMPLIED WARRANTIES OF MERCHANTABILITY
+// AND FITNESS. IN NO EVENT SHALL THE AUTHOR BE LIABLE FOR ANY SPECIAL, DIRECT,
+// INDIRECT, OR CONSEQUENTIAL DAMAGES OR ANY DAMAGES WHATSOEVER RESULTING FROM
+// LOSS OF USE, DATA OR PROFITS, WHETHER IN AN ACTION OF CONTRACT, NEGLIGENCE OR
+// OTHER TORTIOUS ACTION, ARISING OUT OF OR IN CONNECTION WITH THE USE OR
+// PERFORMANCE OF THIS SOFTWARE.
+
+package probo
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"go.gearno.de/kit/pg"
+	"go.gearno.de/x/ref"
+	"go.probo.inc/probo/pkg/coredata"
+	"go.probo.inc/probo/pkg/gid"
+)
+
+// RunAcknowledgementCampaigns locks the acknowledgement campaign the most
+// overdue, reschedules it and asks its recipients to sign the current
+// published version of its document again. A failed run is recorded on
+// the campaign and retried at its next run. It returns
+// coredata.ErrNoAcknowledgementCampaignDue when no campaign is due.
+func (s *Service) RunAcknowledgementCampaigns(ctx context.Context) error {
+	campaign, err := s.lockAcknowledgementCampaignForRun(ctx)
+	if err != nil {
+		return fmt.Errorf("cannot lock acknowledgement campaign: %w", err)
+	}
+
+	tenantService := s.WithTenant(campaign.ID.TenantID())
+
+	if err := tenantService.AcknowledgementCampaigns.run(ctx, campaign); err != nil {
+		return fmt.Errorf("cannot run acknowledgement campaign %q: %w", campaign.ID, err)
+	}
+
+	return nil
+}
+
+func (s *Service) lockAcknowledgementCampaignForRun(ctx context.Context) (*coredata.AcknowledgementCampaign, error) {
+	campaign := &coredata.AcknowledgementCampaign{}
+
+	err := s.pg.WithTx(
+		ctx,
+		func(tx pg.Conn) error {
+			now := time.Now()
+
+			if err := campaign.LoadNextDueForUpdateSkipLocked(ctx, tx, now); err != nil {
+				return err
+			}
+
+			campaign.NextRunAt = now.AddDate(0, 0, campaign.IntervalDays)
+			campaign.UpdatedAt = now
+
+			return campaign.Update(ctx, tx, coredata.NewScope(campaign.ID.TenantID()))
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return campaign, nil
+}
+
+func (s AcknowledgementCampaignService) run(ctx context.Context, campaign *coredata.AcknowledgementCampaign) error {
+	now := time.Now()
+
+	runErr := s.requestAcknowledgements(ctx, campaign, now)
+
+	err := s.svc.pg.WithTx(
+		ctx,
+		func(conn pg.Conn) error {
+			if err := campaign.LoadByID(ctx, conn, s.svc.scope, campaign.ID); err != nil {
+				return fmt.Errorf("cannot load acknowledgement campaign: %w", err)
+			}
+
+			campaign.LastRunAt = &now
+			campaign.UpdatedAt = time.Now()
+
+			if runErr != nil {
+				campaign.LastError = ref.Ref(runErr.Error())
+			} else {
+				campaign.LastError = nil
+			}
+
+			if err := campaign.Update(ctx, conn, s.svc.scope); err != nil {
+				return fmt.Errorf("cannot update acknowledgement campaign: %w", err)
+			}
+
+			return nil
+		},
+	)
+
+	if runErr != nil {
+		if err != nil {
+			return fmt.Errorf("cannot request acknowledgements: %w, and cannot record the failure: %w", runErr, err)
+		}
+		return fmt.Errorf("cannot request acknowledgements: %w", runErr)
+	}
+
+	return err
+}
+
+// requestAcknowledgements starts a new run of the campaign: every recipient
+// without a pending signature on the current published version of the
+// document is asked to sign it again, and the recipients of the run are
+// notified by email.
+func (s AcknowledgementCampaignService) requestAcknowledgements(
+	ctx context.Context,
+	campaign *coredata.AcknowledgementCampaign,
+	now time.Time,
+) error {
+	return s.svc.pg.WithTx(
+		ctx,
+		func(tx pg.Conn) error {
+			document := &coredata.Document{}
+			if err := document.LoadByID(ctx, tx, s.svc.scope, campaign.DocumentID); err != nil {
+				return fmt.Errorf("cannot load document: %w", err)
+			}
+
+			if document.CurrentPublishedVersion == nil {
+				return fmt.Errorf("document %q has no published version", document.ID)
+			}
+
+			documentVersion := &coredata.DocumentVersion{}
+			if err := documentVersion.LoadByDocumentIDAndVersionNumber(ctx, tx, s.svc.scope, document.ID, *document.CurrentPublishedVersion); err != nil {
+				return fmt.Errorf("cannot load published version: %w", err)
+			}
+
+			organization := &coredata.Organization{}
+			if err := organization.LoadByID(ctx, tx, s.svc.scope, campaign.OrganizationID); err != nil {
+				return fmt.Errorf("cannot load organization: %w", err)
+			}
+
+			recipients := coredata.MembershipProfiles{}
+			if err := recipients.LoadActiveSignatoriesByOrganizationID(ctx, tx, s.svc.scope, campaign.OrganizationID, campaign.RecipientProfileIDs); err != nil {
+				return fmt.Errorf("cannot load recipients: %w", err)
+			}
+
+			run := &coredata.AcknowledgementCampaignRun{
+				ID:                gid.New(s.svc.scope.GetTenantID(), coredata.AcknowledgementCampaignRunEntityType),
+				OrganizationID:    campaign.OrganizationID,
+				CampaignID:        campaign.ID,
+				DocumentVersionID: documentVersion.ID,
+				StartedAt:         now,
+				CreatedAt:         now,
+			}
+
+			if err := run.Insert(ctx, tx, s.svc.scope); err != nil {
+				return fmt.Errorf("cannot insert acknowledgement campaign run: %w", err)
+			}
+
+			signatureIDs := make([]gid.GID, 0, len(recipients))
+			for _, recipient := range recipients {
+				signature, err := s.svc.Documents.requestAcknowledgementInTx(ctx, tx, documentVersion, recipient.ID, now)
+				if err != nil {
+					return fmt.Errorf("cannot request acknowledgement of %q: %w", recipient.ID, err)
+				}
+
+				signatureIDs = append(signatureIDs, signature.ID)
+			}
+
+			if err := run.InsertSignatures(ctx, tx, s.svc.scope, signatureIDs); err != nil {
+				return fmt.Errorf("cannot link signatures to acknowledgement campaign run: %w", err)
+			}
+
+			if err := s.svc.Documents.sendSigningNotificationsInTx(ctx, tx, organization, recipients); err != nil {
+				return fmt.Errorf("cannot send signing notifications: %w", err)
+			}
+
+			return nil
+		},
+	)
+}
@@ -0,0 +1,325 @@
+// Copyright (c) 2025 Probo Inc <hello@getprobo.com>.
+//
+// Permission to use, copy, modify, and/or distribute this software for any
+// purpose with or without fee is hereby granted, provided that the above
+// copyright notice and this permission notice appear in all copies.
+//
+// THE SOFTWARE IS PROVIDED "AS IS" AND THE AUTHOR DISCLAIMS ALL WARRANTIES WITH
+// REGARD TO THIS SOFTWARE INCLUDING ALL IMPLIED WARRANTIES OF MERCHANTABILITY
+// AND FITNESS. IN NO EVENT SHALL THE AUTHOR BE LIABLE FOR ANY SPECIAL, DIRECT,
+// INDIRECT, OR CONSEQUENTIAL DAMAGES OR ANY DAMAGES WHATSOEVER RESULTING FROM
+// LOSS OF USE, DATA OR PROFITS, WHETHER IN AN ACTION OF CONTRACT, NEGLIGENCE OR
+// OTHER TORTIOUS ACTION, ARISING OUT OF OR IN CONNECTION WITH THE USE OR
+// PERFORMANCE OF THIS SOFTWARE.
+
+package probo
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"go.gearno.de/kit/pg"
+	"go.probo.inc/probo/pkg/auditlog"
+	"go.probo.inc/probo/pkg/coredata"
+	"go.probo.inc/probo/pkg/gid"
+	"go.probo.inc/probo/pkg/validator"
+)
+
+type (
+	AcknowledgementCampaignService struct {
+		svc *TenantService
+	}
+
+	CreateAcknowledgementCampaignRequest struct {
+		DocumentID          gid.GID
+		RecipientProfileIDs []gid.GID
+		IntervalDays        int
+		StartAt             *time.Time
+	}
+
+	UpdateAcknowledgementCampaignRequest struct {
+		ID                  gid.GID
+		RecipientProfileIDs *[]gid.GID
+		IntervalDays        *int
+		Enabled             *bool
+		NextRunAt           *time.Time
+	}
+)
+
+const acknowledgementCampaignMaxRecipients = 10_000
+
+func (r *CreateAcknowledgementCampaignRequest) Validate() error {
+	v := validator.New()
+
+	v.Check(r.DocumentID, "document_id", validator.Required(), validator.GID(coredata.DocumentEntityType))
+	v.Check(r.RecipientProfileIDs, "recipient_profile_ids", validator.MaxItems(acknowledgementCampaignMaxRecipients))
+	v.CheckEach(r.RecipientProfileIDs, "recipient_profile_ids", func(index int, item any) {
+		v.Check(item, fmt.Sprintf("recipient_profile_ids[%d]", index), validator.Required(), validator.GID(coredata.MembershipProfileEntityType))
+	})
+	v.Check(r.IntervalDays, "interval_days", validator.Range(1, 3650))
+
+	return v.Error()
+}
+
+func (r *UpdateAcknowledgementCampaignRequest) Validate() error {
+	v := validator.New()
+
+	v.Check(r.ID, "id", validator.Required(), validator.GID(coredata.AcknowledgementCampaignEntityType))
+	v.Check(r.RecipientProfileIDs, "recipient_profile_ids", validator.MaxItems(acknowledgementCampaignMaxRecipients))
+	v.CheckEach(r.RecipientProfileIDs, "recipient_profile_ids", func(index int, item any) {
+		v.Check(item, fmt.Sprintf("recipient_profile_ids[%d]", index), validator.Required(), validator.GID(coredata.MembershipProfileEntityType))
+	})
+	v.Check(r.IntervalDays, "interval_days", validator.Range(1, 3650))
+
+	return v.Error()
+}
+
+func (s AcknowledgementCampaignService) Get(
+	ctx context.Context,
+	campaignID gid.GID,
+) (*coredata.AcknowledgementCampaign, error) {
+	campaign := &coredata.AcknowledgementCampaign{}
+
+	err := s.svc.pg.WithConn(
+		ctx,
+		func(conn pg.Conn) error {
+			return campaign.LoadByID(ctx, conn, s.svc.scope, campaignID)
+		},
+	)
+	if err != nil {
+		return nil, fmt.Errorf("cannot load acknowledgement campaign: %w", err)
+	}
+
+	return campaign, nil
+}
+
+func (s AcknowledgementCampaignService) ListForDocumentID(
+	ctx context.Context,
+	documentID gid.GID,
+) (coredata.AcknowledgementCampaigns, error) {
+	var campaigns coredata.AcknowledgementCampaigns
+
+	err := s.svc.pg.WithConn(
+		ctx,
+		func(conn pg.Conn) error {
+			return campaigns.LoadByDocumentID(ctx, conn, s.svc.scope, documentID)
+		},
+	)
+	if err != nil {
+		return nil, fmt.Errorf("cannot load acknowledgement campaigns: %w", err)
+	}
+
+	return campaigns, nil
+}
+
+// GetLatestRun returns the most recent run of the campaign along with its
+// completion. It returns coredata.ErrResourceNotFound when the campaign
+// never ran.
+func (s AcknowledgementCampaignService) GetLatestRun(
+	ctx context.Context,
+	campaignID gid.GID,
+) (*coredata.AcknowledgementCampaignRun, *coredata.AcknowledgementCampaignRunCompletion, error) {
+	run := &coredata.AcknowledgementCampaignRun{}
+	completion := &coredata.AcknowledgementCampaignRunCompletion{}
+
+	err := s.svc.pg.WithConn(
+		ctx,
+		func(conn pg.Conn) error {
+			if err := run.LoadLatestByCampaignID(ctx, conn, s.svc.scope, campaignID); err != nil {
+				return fmt.Errorf("cannot load acknowledgement campaign run: %w", err)
+			}
+
+			if err := completion.LoadByRunID(ctx, conn, s.svc.scope, run.ID); err != nil {
+				return fmt.Errorf("cannot load acknowledgement campaign run completion: %w", err)
+			}
+
+			return nil
+		},
+	)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return run, completion, nil
+}
+
+func (s AcknowledgementCampaignService) Create(
+	ctx context.Context,
+	req CreateAcknowledgementCampaignRequest,
+) (*coredata.AcknowledgementCampaign, error) {
+	if err := req.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid request: %w", err)
+	}
+
+	campaign := &coredata.AcknowledgementCampaign{}
+
+	err := s.svc.pg.WithTx(
+		ctx,
+		func(conn pg.Conn) error {
+			document := &coredata.Document{}
+			if err := document.LoadByID(ctx, conn, s.svc.scope, req.DocumentID); err != nil {
+				return fmt.Errorf("cannot load document: %w", err)
+			}
+
+			if err := s.checkRecipients(ctx, conn, document.OrganizationID, req.RecipientProfileIDs); err != nil {
+				return err
+			}
+
+			now := time.Now()
+			nextRunAt := now
+			if req.StartAt != nil {
+				nextRunAt = *req.StartAt
+			}
+
+			*campaign = coredata.AcknowledgementCampaign{
+				ID:                  gid.New(s.svc.scope.GetTenantID(), coredata.AcknowledgementCampaignEntityType),
+				OrganizationID:      document.OrganizationID,
+				DocumentID:          document.ID,
+				RecipientProfileIDs: req.RecipientProfileIDs,
+				IntervalDays:        req.IntervalDays,
+				Enabled:             true,
+				NextRunAt:           nextRunAt,
+				CreatedAt:           now,
+				UpdatedAt:           now,
+			}
+
+			if err := campaign.Insert(ctx, conn, s.svc.scope); err != nil {
+				return fmt.Errorf("cannot insert acknowledgement campaign: %w", err)
+			}
+
+			if err := auditlog.Record(ctx, conn, s.svc.scope, campaign.OrganizationID, ActionAcknowledgementCampaignCreate, campaign.ID, nil, acknowledgementCampaignAuditState(campaign)); err != nil {
+				return fmt.Errorf("cannot record audit log entry: %w", err)
+			}
+
+			return nil
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return campaign, nil
+}
+
+func (s AcknowledgementCampaignService) Update(
+	ctx context.Context,
+	req UpdateAcknowledgementCampaignRequest,
+) (*coredata.AcknowledgementCampaign, error) {
+	if err := req.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid request: %w", err)
+	}
+
+	campaign := &coredata.AcknowledgementCampaign{}
+
+	err := s.svc.pg.WithTx(
+		ctx,
+		func(conn pg.Conn) error {
+			if err := campaign.LoadByID(ctx, conn, s.svc.scope, req.ID); err != nil {
+				return fmt.Errorf("cannot load acknowledgement campaign: %w", err)
+			}
+
+			before := acknowledgementCampaignAuditState(campaign)
+
+			if req.RecipientProfileIDs != nil {
+				if err := s.checkRecipients(ctx, conn, campaign.OrganizationID, *req.RecipientProfileIDs); err != nil {
+					return err
+				}
+
+				campaign.RecipientProfileIDs = *req.RecipientProfileIDs
+			}
+
+			if req.IntervalDays != nil {
+				campaign.IntervalDays = *req.IntervalDays
+			}
+
+			if req.Enabled != nil {
+				campaign.Enabled = *req.Enabled
+			}
+
+			if req.NextRunAt != nil {
+				campaign.NextRunAt = *req.NextRunAt
+			}
+
+			campaign.UpdatedAt = time.Now()
+
+			if err := campaign.Update(ctx, conn, s.svc.scope); err != nil {
+				return fmt.Errorf("cannot update acknowledgement campaign: %w", err)
+			}
+
+			if err := auditlog.Record(ctx, conn, s.svc.scope, campaign.OrganizationID, ActionAcknowledgementCampaignUpdate, campaign.ID, before, acknowledgementCampaignAuditState(campaign)); err != nil {
+				return fmt.Errorf("cannot record audit log entry: %w", err)
+			}
+
+			return nil
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return campaign, nil
+}
+
+func (s AcknowledgementCampaignService) Delete(
+	ctx context.Context,
+	campaignID gid.GID,
+) error {
+	return s.svc.pg.WithTx(
+		ctx,
+		func(conn pg.Conn) error {
+			campaign := &coredata.AcknowledgementCampaign{}
+			if err := campaign.LoadByID(ctx, conn, s.svc.scope, campaignID); err != nil {
+				return fmt.Errorf("cannot load acknowledgement campaign: %w", err)
+			}
+
+			if err := campaign.Delete(ctx, conn, s.svc.scope); err != nil {
+				return fmt.Errorf("cannot delete acknowledgement campaign: %w", err)
+			}
+
+			if err := auditlog.Record(ctx, conn, s.svc.scope, campaign.OrganizationID, ActionAcknowledgementCampaignDelete, campaign.ID, acknowledgementCampaignAuditState(campaign), nil); err != nil {
+				return fmt.Errorf("cannot record audit log entry: %w", err)
+			}
+
+			return nil
+		},
+	)
+}
+
+func (s AcknowledgementCampaignService) checkRecipients(
+	ctx context.Context,
+	conn pg.Conn,
+	organizationID gid.GID,
+	profileIDs []gid.GID,
+) error {
+	if len(profileIDs) == 0 {
+		return nil
+	}
+
+	profiles := coredata.MembershipProfiles{}
+	if err := profiles.LoadByIDs(ctx, conn, s.svc.scope, profileIDs); err != nil {
+		return fmt.Errorf("cannot load recipient profiles: %w", err)
+	}
+
+	if len(profiles) != len(profileIDs) {
+		return fmt.Errorf("one or more recipient profiles not found")
+	}
+
+	for _, profile := range profiles {
+		if profile.OrganizationID != organizationID {
+			return fmt.Errorf("recipient profile %q is not a member of the document organization", profile.ID)
+		}
+	}
+
+	return nil
+}
+
+func acknowledgementCampaignAuditState(c *coredata.AcknowledgementCampaign) map[string]any {
+	return map[string]any{
+		"documentId":          c.DocumentID,
+		"recipientProfileIds": c.RecipientProfileIDs,
+		"intervalDays":        c.IntervalDays,
+		"enabled":             c.Enabled,
+		"nextRunAt":           c.NextRunAt,
+	}
+}
@@ -14,6 +14,8 @@
 
 package probo
 
+import "go.probo.inc/probo/pkg/iam"
+
 // Probo Service Actions
 // Format: core:<entity>:<action>
 const (
@@ -107,6 +109,9 @@ const (
 	ActionFrameworkDelete                       = "core:framework:delete"
 	ActionFrameworkExport = "core:framework:export"
 	ActionFrameworkImport                       = "core:framework:import"
+	ActionFrameworkImportCrosswalk              = "core:framework:import-crosswalk"
+	ActionFrameworkUpgrade                      = "core:framework:upgrade"
+	ActionFrameworkGetCrosswalkCoverage         = "core:framework:get-crosswalk-coverage"
 
 	// Control actions
 	ActionControlGet                     = "core:control:get"
@@ -124,6 +129,8 @@ const (
 	ActionControlSnapshotMappingDelete   = "core:control:delete-snapshot-mapping"
 	ActionControlObligationMappingCreate = "core:control:create-obligation-mapping"
 	ActionControlObligationMappingDelete = "core:control:delete-obligation-mapping"
+	ActionControlEquivalenceMappingCreate = "core:control:create-equivalence-mapping"
+	ActionControlEquivalenceMappingDelete = "core:control:delete-equivalence-mapping"
 
 	// Measure actions
 	ActionMeasureGet            = "core:measure:get"
@@ -156,17 +163,35 @@ const (
 	ActionDocumentChangelogGenerate        = "core:document:generate-changelog"
 	ActionDocumentDraftVersionCreate       = "core:document:create-draft-version"
 	ActionDocumentSendSigningNotifications = "core:document:send-signing-notifications"
+	ActionDocumentMarkReviewed             = "core:document:mark-reviewed"
+
+	// AcknowledgementCampaign actions
+	ActionAcknowledgementCampaignGet    = "core:acknowledgement-campaign:get"
+	ActionAcknowledgementCampaignList   = "core:acknowledgement-campaign:list"
+	ActionAcknowledgementCampaignCreate = "core:acknowledgement-campaign:create"
+	ActionAcknowledgementCampaignUpdate = "core:acknowledgement-campaign:update"
+	ActionAcknowledgementCampaignDelete = "core:acknowledgement-campaign:delete"
+
+	// ImportJob actions
+	ActionImportJobGet    = "core:import-job:get"
+	ActionImportJobCreate = "core:import-job:create"
 
 	// DocumentVersion actions
-	ActionDocumentVersionGet            = "core:document-version:get"
-	ActionDocumentVersionList           = "core:document-version:list"
-	ActionDocumentVersionExportPDF      = "core:document-version:export-pdf"
-	ActionDocumentVersionExportSignable = "core:document-version:export-signable-pdf"
-	ActionDocumentVersionSign           = "core:document-version:sign"
-	ActionDocumentVersionUpdate         = "core:document-version:update"
-	ActionDocumentVersionDeleteDraft    = "core:document-version:delete-draft"
-	ActionDocumentVersionPublish        = "core:document-version:publish"
-	ActionDocumentVersionExport         = "core:document-version:export"
+	ActionDocumentVersionGet                        = "core:document-version:get"
+	ActionDocumentVersionList                       = "core:document-version:list"
+	ActionDocumentVersionExportPDF                  = "core:document-version:export-pdf"
+	ActionDocumentVersionExportSignable             = "core:document-version:export-signable-pdf"
+	ActionDocumentVersionExportSignatureCertificate = "core:document-version:export-signature-certificate"
+	ActionDocumentVersionDiff                       = "core:document-version:diff"
+	ActionDocumentVersionExportDiff                 = "core:document-version:export-diff-pdf"
+	ActionDocumentVersionSign                       = "core:document-version:sign"
+	ActionDocumentVersionUpdate                     = "core:document-version:update"
+	ActionDocumentVersionDeleteDraft                = "core:document-version:delete-draft"
+	ActionDocumentVersionPublish                    = "core:document-version:publish"
+	ActionDocumentVersionExport                     = "core:document-version:export"
+	ActionDocumentVersionRequestApproval            = "core:document-version:request-approval"
+	ActionDocumentVersionApprove                    = "core:document-version:approve"
+	ActionDocumentVersionReject                     = "core:document-version:reject"
 
 	// DocumentVersionSignature actions
 	ActionDocumentVersionSignatureRequest = "core:document-version-signature:request"
@@ -214,6 +239,7 @@ const (
 	ActionReportGet            = "core:report:get"
 	ActionReportGetReportUrl   = "core:report:get-report-url"
 	ActionReportDownloadUrlGet = "core:report:get-download-url"
+	ActionReportDelete         = "core:report:delete"
 
 	// Nonconformity actions
 	ActionNonconformityGet    = "core:nonconformity:get"
@@ -249,6 +275,18 @@ const (
 	ActionSnapshotList   = "core:snapshot:list"
 	ActionSnapshotCreate = "core:snapshot:create"
 	ActionSnapshotDelete = "core:snapshot:delete"
+	ActionSnapshotDiff   = "core:snapshot:diff"
+
+	// SnapshotSchedule actions
+	ActionSnapshotScheduleGet    = "core:snapshot-schedule:get"
+	ActionSnapshotScheduleList   = "core:snapshot-schedule:list"
+	ActionSnapshotScheduleCreate = "core:snapshot-schedule:create"
+	ActionSnapshotScheduleUpdate = "core:snapshot-schedule:update"
+	ActionSnapshotScheduleDelete = "core:snapshot-schedule:delete"
+
+	// DeadlineReminderSettings actions
+	ActionDeadlineReminderSettingsGet    = "core:deadline-reminder-settings:get"
+	ActionDeadlineReminderSettingsUpdate = "core:deadline-reminder-settings:update"
 
 	// CustomDomain actions
 	ActionCustomDomainGet    = "core:custom-domain:get"
@@ -258,6 +296,7 @@ const (
 	// File actions
 	ActionFileGet         = "core:file:get"
 	ActionFileDownloadUrl = "core:file:download-url"
+	ActionFileUpload      = "core:file:upload"
 
 	// Meeting actions
 	ActionMeetingList   = "core:meeting:list"
@@ -273,8 +312,16 @@ const (
 	ActionSlackConnectionList = "core:slack-connection:list"
 
 	// Connector actions (generic)
-	ActionConnectorList   = "core:connector:list"
-	ActionConnectorDelete = "core:connector:delete"
+	ActionConnectorCreate          = "core:connector:create"
+	ActionConnectorList            = "core:connector:list"
+	ActionConnectorDelete          = "core:connector:delete"
+	ActionConnectorCollectEvidence = "core:connector:collect-evidence"
+
+	// ConnectorEvidenceMapping actions
+	ActionConnectorEvidenceMappingGet    = "core:connector-evidence-mapping:get"
+	ActionConnectorEvidenceMappingList   = "core:connector-evidence-mapping:list"
+	ActionConnectorEvidenceMappingCreate = "core:connector-evidence-mapping:create"
+	ActionConnectorEvidenceMappingDelete = "core:connector-evidence-mapping:delete"
 
 	// DataProtectionImpactAssessment actions
 	ActionDataProtectionImpactAssessmentList   = "core:data-protection-impact-assessment:list"
@@ -322,4 +369,388 @@ const (
 	ActionWebhookSubscriptionCreate = "core:webhook-subscription:create"
 	ActionWebhookSubscriptionUpdate = "core:webhook-subscription:update"
 	ActionWebhookSubscriptionDelete = "core:webhook-subscription:delete"
+	ActionWebhookSubscriptionReplay = "core:webhook-subscription:replay"
+	ActionWebhookSubscriptionTest   = "core:webhook-subscription:test"
+
+	// WebhookEvent actions
+	ActionWebhookEventRedrive = "core:webhook-event:redrive"
+	ActionWebhookEventReplay  = "core:webhook-event:replay"
+
+	// AuditLogEntry actions
+	ActionAuditLogEntryGet    = "core:audit-log-entry:get"
+	ActionAuditLogEntryList   = "core:audit-log-entry:list"
+	ActionAuditLogEntryExport = "core:audit-log-entry:export"
+
+	// Email actions
+	ActionEmailList = "core:email:list"
 )
+
+// ProboActions returns every action of the probo service, used to validate
+// custom role policy documents.
+func ProboActions() []iam.Action {
+	return []iam.Action{
+		// Organization actions
+		ActionOrganizationGet,
+		ActionOrganizationGetLogoUrl,
+		ActionOrganizationGetHorizontalLogoUrl,
+
+		// OrganizationContext actions
+		ActionOrganizationContextGet,
+		ActionOrganizationContextUpdate,
+
+		// TrustCenter actions
+		ActionTrustCenterGet,
+		ActionTrustCenterUpdate,
+		ActionTrustCenterGetNda,
+		ActionTrustCenterNonDisclosureAgreementUpload,
+		ActionTrustCenterNonDisclosureAgreementDelete,
+
+		// TrustCenterAccess actions
+		ActionTrustCenterAccessGet,
+		ActionTrustCenterAccessList,
+		ActionTrustCenterAccessCreate,
+		ActionTrustCenterAccessUpdate,
+		ActionTrustCenterAccessDelete,
+
+		// TrustCenterReference actions
+		ActionTrustCenterReferenceList,
+		ActionTrustCenterReferenceGetLogoUrl,
+		ActionTrustCenterReferenceCreate,
+		ActionTrustCenterReferenceUpdate,
+		ActionTrustCenterReferenceDelete,
+
+		// TrustCenterFile actions
+		ActionTrustCenterFileGet,
+		ActionTrustCenterFileList,
+		ActionTrustCenterFileGetFileUrl,
+		ActionTrustCenterFileUpdate,
+		ActionTrustCenterFileDelete,
+		ActionTrustCenterFileCreate,
+
+		// Vendor actions
+		ActionVendorList,
+		ActionVendorGet,
+		ActionVendorCreate,
+		ActionVendorUpdate,
+		ActionVendorDelete,
+		ActionVendorAssess,
+
+		// VendorContact actions
+		ActionVendorContactGet,
+		ActionVendorContactList,
+		ActionVendorContactCreate,
+		ActionVendorContactUpdate,
+		ActionVendorContactDelete,
+
+		// VendorService actions
+		ActionVendorServiceGet,
+		ActionVendorServiceList,
+		ActionVendorServiceCreate,
+		ActionVendorServiceUpdate,
+		ActionVendorServiceDelete,
+
+		// VendorComplianceReport actions
+		ActionVendorComplianceReportGet,
+		ActionVendorComplianceReportList,
+		ActionVendorComplianceReportUpload,
+		ActionVendorComplianceReportDelete,
+
+		// VendorBusinessAssociateAgreement actions
+		ActionVendorBusinessAssociateAgreementGet,
+		ActionVendorBusinessAssociateAgreementUpload,
+		ActionVendorBusinessAssociateAgreementUpdate,
+		ActionVendorBusinessAssociateAgreementDelete,
+
+		// VendorDataPrivacyAgreement actions
+		ActionVendorDataPrivacyAgreementGet,
+		ActionVendorDataPrivacyAgreementUpload,
+		ActionVendorDataPrivacyAgreementUpdate,
+		ActionVendorDataPrivacyAgreementDelete,
+
+		// VendorRiskAssessment actions
+		ActionVendorRiskAssessmentCreate,
+		ActionVendorRiskAssessmentList,
+
+		// Framework actions
+		ActionFrameworkGet,
+		ActionFrameworkList,
+		ActionFrameworkCreate,
+		ActionFrameworkUpdate,
+		ActionFrameworkDelete,
+		ActionFrameworkExport,
+		ActionFrameworkImport,
+		ActionFrameworkImportCrosswalk,
+		ActionFrameworkUpgrade,
+		ActionFrameworkGetCrosswalkCoverage,
+
+		// Control actions
+		ActionControlGet,
+		ActionControlList,
+		ActionControlCreate,
+		ActionControlUpdate,
+		ActionControlDelete,
+		ActionControlMeasureMappingCreate,
+		ActionControlMeasureMappingDelete,
+		ActionControlDocumentMappingCreate,
+		ActionControlDocumentMappingDelete,
+		ActionControlAuditMappingCreate,
+		ActionControlAuditMappingDelete,
+		ActionControlSnapshotMappingCreate,
+		ActionControlSnapshotMappingDelete,
+		ActionControlObligationMappingCreate,
+		ActionControlObligationMappingDelete,
+		ActionControlEquivalenceMappingCreate,
+		ActionControlEquivalenceMappingDelete,
+
+		// Measure actions
+		ActionMeasureGet,
+		ActionMeasureList,
+		ActionMeasureCreate,
+		ActionMeasureUpdate,
+		ActionMeasureDelete,
+		ActionMeasureEvidenceUpload,
+		ActionMeasureImport,
+
+		// Task actions
+		ActionTaskGet,
+		ActionTaskList,
+		ActionTaskCreate,
+		ActionTaskUpdate,
+		ActionTaskDelete,
+		ActionTaskAssign,
+		ActionTaskUnassign,
+
+		// Evidence actions
+		ActionEvidenceList,
+		ActionEvidenceDelete,
+
+		// Document actions
+		ActionDocumentGet,
+		ActionDocumentList,
+		ActionDocumentCreate,
+		ActionDocumentUpdate,
+		ActionDocumentDelete,
+		ActionDocumentChangelogGenerate,
+		ActionDocumentDraftVersionCreate,
+		ActionDocumentSendSigningNotifications,
+		ActionDocumentMarkReviewed,
+
+		// AcknowledgementCampaign actions
+		ActionAcknowledgementCampaignGet,
+		ActionAcknowledgementCampaignList,
+		ActionAcknowledgementCampaignCreate,
+		ActionAcknowledgementCampaignUpdate,
+		ActionAcknowledgementCampaignDelete,
+
+		// ImportJob actions
+		ActionImportJobGet,
+		ActionImportJobCreate,
+
+		// DocumentVersion actions
+		ActionDocumentVersionGet,
+		ActionDocumentVersionList,
+		ActionDocumentVersionExportPDF,
+		ActionDocumentVersionExportSignable,
+		ActionDocumentVersionExportSignatureCertificate,
+		ActionDocumentVersionDiff,
+		ActionDocumentVersionExportDiff,
+		ActionDocumentVersionSign,
+		ActionDocumentVersionUpdate,
+		ActionDocumentVersionDeleteDraft,
+		ActionDocumentVersionPublish,
+		ActionDocumentVersionExport,
+		ActionDocumentVersionRequestApproval,
+		ActionDocumentVersionApprove,
+		ActionDocumentVersionReject,
+
+		// DocumentVersionSignature actions
+		ActionDocumentVersionSignatureRequest,
+		ActionDocumentVersionCancelSignature,
+		ActionDocumentVersionSignatureGet,
+		ActionDocumentVersionSignatureList,
+
+		// Risk actions
+		ActionRiskGet,
+		ActionRiskList,
+		ActionRiskCreate,
+		ActionRiskUpdate,
+		ActionRiskDelete,
+		ActionRiskMeasureMappingCreate,
+		ActionRiskMeasureMappingDelete,
+		ActionRiskDocumentMappingCreate,
+		ActionRiskDocumentMappingDelete,
+		ActionRiskObligationMappingCreate,
+		ActionRiskObligationMappingDelete,
+
+		// Asset actions
+		ActionAssetGet,
+		ActionAssetList,
+		ActionAssetCreate,
+		ActionAssetUpdate,
+		ActionAssetDelete,
+
+		// Datum actions
+		ActionDatumGet,
+		ActionDatumList,
+		ActionDatumCreate,
+		ActionDatumUpdate,
+		ActionDatumDelete,
+
+		// Audit actions
+		ActionAuditGet,
+		ActionAuditList,
+		ActionAuditCreate,
+		ActionAuditUpdate,
+		ActionAuditDelete,
+		ActionAuditReportUpload,
+		ActionAuditReportDelete,
+
+		// Report actions
+		ActionReportGet,
+		ActionReportGetReportUrl,
+		ActionReportDownloadUrlGet,
+		ActionReportDelete,
+
+		// Nonconformity actions
+		ActionNonconformityGet,
+		ActionNonconformityList,
+		ActionNonconformityCreate,
+		ActionNonconformityUpdate,
+		ActionNonconformityDelete,
+
+		// Obligation actions
+		ActionObligationGet,
+		ActionObligationList,
+		ActionObligationCreate,
+		ActionObligationUpdate,
+		ActionObligationDelete,
+
+		// ContinualImprovement actions
+		ActionContinualImprovementGet,
+		ActionContinualImprovementList,
+		ActionContinualImprovementCreate,
+		ActionContinualImprovementUpdate,
+		ActionContinualImprovementDelete,
+
+		// ProcessingActivity actions
+		ActionProcessingActivityList,
+		ActionProcessingActivityGet,
+		ActionProcessingActivityCreate,
+		ActionProcessingActivityUpdate,
+		ActionProcessingActivityDelete,
+		ActionProcessingActivityExport,
+
+		// Snapshot actions
+		ActionSnapshotGet,
+		ActionSnapshotList,
+		ActionSnapshotCreate,
+		ActionSnapshotDelete,
+		ActionSnapshotDiff,
+
+		// SnapshotSchedule actions
+		ActionSnapshotScheduleGet,
+		ActionSnapshotScheduleList,
+		ActionSnapshotScheduleCreate,
+		ActionSnapshotScheduleUpdate,
+		ActionSnapshotScheduleDelete,
+
+		// DeadlineReminderSettings actions
+		ActionDeadlineReminderSettingsGet,
+		ActionDeadlineReminderSettingsUpdate,
+
+		// CustomDomain actions
+		ActionCustomDomainGet,
+		ActionCustomDomainCreate,
+		ActionCustomDomainDelete,
+
+		// File actions
+		ActionFileGet,
+		ActionFileDownloadUrl,
+		ActionFileUpload,
+
+		// Meeting actions
+		ActionMeetingList,
+		ActionMeetingGet,
+		ActionMeetingCreate,
+		ActionMeetingUpdate,
+		ActionMeetingDelete,
+
+		// Connector actions
+		ActionConnectorInitiate,
+
+		// SlackConnection actions
+		ActionSlackConnectionList,
+
+		// Connector actions (generic)
+		ActionConnectorCreate,
+		ActionConnectorList,
+		ActionConnectorDelete,
+		ActionConnectorCollectEvidence,
+
+		// ConnectorEvidenceMapping actions
+		ActionConnectorEvidenceMappingGet,
+		ActionConnectorEvidenceMappingList,
+		ActionConnectorEvidenceMappingCreate,
+		ActionConnectorEvidenceMappingDelete,
+
+		// DataProtectionImpactAssessment actions
+		ActionDataProtectionImpactAssessmentList,
+		ActionDataProtectionImpactAssessmentGet,
+		ActionDataProtectionImpactAssessmentCreate,
+		ActionDataProtectionImpactAssessmentUpdate,
+		ActionDataProtectionImpactAssessmentDelete,
+		ActionDataProtectionImpactAssessmentExport,
+
+		// TransferImpactAssessment actions
+		ActionTransferImpactAssessmentList,
+		ActionTransferImpactAssessmentGet,
+		ActionTransferImpactAssessmentCreate,
+		ActionTransferImpactAssessmentUpdate,
+		ActionTransferImpactAssessmentDelete,
+		ActionTransferImpactAssessmentExport,
+
+		// TrustCenterDocumentAccess actions
+		ActionTrustCenterDocumentAccessList,
+
+		// RightsRequest actions
+		ActionRightsRequestList,
+		ActionRightsRequestGet,
+		ActionRightsRequestCreate,
+		ActionRightsRequestUpdate,
+		ActionRightsRequestDelete,
+
+		// StateOfApplicability actions
+		ActionStateOfApplicabilityList,
+		ActionStateOfApplicabilityGet,
+		ActionStateOfApplicabilityCreate,
+		ActionStateOfApplicabilityUpdate,
+		ActionStateOfApplicabilityDelete,
+		ActionStateOfApplicabilityExport,
+		ActionApplicabilityStatementGet,
+		ActionApplicabilityStatementList,
+		ActionApplicabilityStatementCreate,
+		ActionApplicabilityStatementUpdate,
+		ActionApplicabilityStatementDelete,
+
+		// WebhookSubscription actions
+		ActionWebhookSubscriptionList,
+		ActionWebhookSubscriptionGet,
+		ActionWebhookSubscriptionCreate,
+		ActionWebhookSubscriptionUpdate,
+		ActionWebhookSubscriptionDelete,
+		ActionWebhookSubscriptionReplay,
+		ActionWebhookSubscriptionTest,
+
+		// WebhookEvent actions
+		ActionWebhookEventRedrive,
+		ActionWebhookEventReplay,
+
+		// AuditLogEntry actions
+		ActionAuditLogEntryGet,
+		ActionAuditLogEntryList,
+		ActionAuditLogEntryExport,
+
+		// Email actions
+		ActionEmailList,
+	}
+}
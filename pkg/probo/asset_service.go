@@ -6,6 +6,7 @@ import (
 	"time"
 
 	"go.gearno.de/kit/pg"
+	"go.probo.inc/probo/pkg/auditlog"
 	"go.probo.inc/probo/pkg/coredata"
 	"go.probo.inc/probo/pkg/gid"
 	"go.probo.inc/probo/pkg/page"
@@ -181,6 +182,8 @@ func (s AssetService) Update(
 			return fmt.Errorf("cannot load asset: %w", err)
 		}
 
+		before := assetAuditState(asset)
+
 		asset.UpdatedAt = now
 		if req.Name != nil {
 			asset.Name = *req.Name
@@ -212,6 +215,10 @@ func (s AssetService) Update(
 			}
 		}
 
+		if err := auditlog.Record(ctx, conn, s.svc.scope, asset.OrganizationID, ActionAssetUpdate, asset.ID, before, assetAuditState(asset)); err != nil {
+			return fmt.Errorf("cannot record audit log entry: %w", err)
+		}
+
 		return nil
 	})
 
@@ -230,6 +237,25 @@ func (s AssetService) Create(
 		return nil, fmt.Errorf("invalid request: %w", err)
 	}
 
+	var asset *coredata.Asset
+
+	err := s.svc.pg.WithTx(ctx, func(conn pg.Conn) (err error) {
+		asset, err = s.createInTx(ctx, conn, req)
+		return err
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return asset, nil
+}
+
+func (s AssetService) createInTx(
+	ctx context.Context,
+	conn pg.Conn,
+	req CreateAssetRequest,
+) (*coredata.Asset, error) {
 	now := time.Now()
 	assetID := gid.New(s.svc.scope.GetTenantID(), coredata.AssetEntityType)
 	assetVendors := &coredata.AssetVendors{}
@@ -246,27 +272,23 @@ func (s AssetService) Create(
 		UpdatedAt:       now,
 	}
 
-	err := s.svc.pg.WithTx(ctx, func(conn pg.Conn) error {
-		profile := &coredata.MembershipProfile{}
-		if err := profile.LoadByID(ctx, conn, s.svc.scope, req.OwnerID); err != nil {
-			return fmt.Errorf("cannot load owner profile: %w", err)
-		}
+	profile := &coredata.MembershipProfile{}
+	if err := profile.LoadByID(ctx, conn, s.svc.scope, req.OwnerID); err != nil {
+		return nil, fmt.Errorf("cannot load owner profile: %w", err)
+	}
 
-		if err := asset.Insert(ctx, conn, s.svc.scope); err != nil {
-			return fmt.Errorf("cannot insert asset: %w", err)
-		}
+	if err := asset.Insert(ctx, conn, s.svc.scope); err != nil {
+		return nil, fmt.Errorf("cannot insert asset: %w", err)
+	}
 
-		if len(req.VendorIDs) > 0 {
-			if err := assetVendors.Insert(ctx, conn, s.svc.scope, asset.ID, asset.OrganizationID, req.VendorIDs); err != nil {
-				return fmt.Errorf("cannot create asset vendors: %w", err)
-			}
+	if len(req.VendorIDs) > 0 {
+		if err := assetVendors.Insert(ctx, conn, s.svc.scope, asset.ID, asset.OrganizationID, req.VendorIDs); err != nil {
+			return nil, fmt.Errorf("cannot create asset vendors: %w", err)
 		}
+	}
 
-		return nil
-	})
-
-	if err != nil {
-		return nil, err
+	if err := auditlog.Record(ctx, conn, s.svc.scope, asset.OrganizationID, ActionAssetCreate, asset.ID, nil, assetAuditState(asset)); err != nil {
+		return nil, fmt.Errorf("cannot record audit log entry: %w", err)
 	}
 
 	return asset, nil
@@ -276,12 +298,30 @@ func (s AssetService) Delete(
 	ctx context.Context,
 	assetID gid.GID,
 ) error {
-	asset := &coredata.Asset{ID: assetID}
+	asset := &coredata.Asset{}
 
-	return s.svc.pg.WithConn(
+	return s.svc.pg.WithTx(
 		ctx,
 		func(conn pg.Conn) error {
+			if err := asset.LoadByID(ctx, conn, s.svc.scope, assetID); err != nil {
+				return fmt.Errorf("cannot load asset: %w", err)
+			}
+
+			if err := auditlog.Record(ctx, conn, s.svc.scope, asset.OrganizationID, ActionAssetDelete, asset.ID, assetAuditState(asset), nil); err != nil {
+				return fmt.Errorf("cannot record audit log entry: %w", err)
+			}
+
 			return asset.Delete(ctx, conn, s.svc.scope)
 		},
 	)
 }
+
+func assetAuditState(a *coredata.Asset) map[string]any {
+	return map[string]any{
+		"name":            a.Name,
+		"amount":          a.Amount,
+		"ownerId":         a.OwnerID,
+		"assetType":       a.AssetType,
+		"dataTypesStored": a.DataTypesStored,
+	}
+}
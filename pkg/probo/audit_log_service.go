@@ -0,0 +1,367 @@
+// Copyright (c) 2025 Probo Inc <hello@getprobo.com>.
+//
+// Permission to use, copy, modify, and/or distribute this software for any
+// purpose with or without fee is hereby granted, provided that the above
+// copyright notice and this permission notice appear in all copies.
+//
+// THE SOFTWARE IS PROVIDED "AS IS" AND THE AUTHOR DISCLAIMS ALL WARRANTIES WITH
+// REGARD TO THIS SOFTWARE INCLUDING ALL IMPLIED WARRANTIES OF MERCHANTABILITY
+// AND FITNESS. IN NO EVENT SHALL THE AUTHOR BE LIABLE FOR ANY SPECIAL, DIRECT,
+// INDIRECT, OR CONSEQUENTIAL DAMAGES OR ANY DAMAGES WHATSOEVER RESULTING FROM
+// LOSS OF USE, DATA OR PROFITS, WHETHER IN AN ACTION OF CONTRACT, NEGLIGENCE OR
+// OTHER TORTIOUS ACTION, ARISING OUT OF OR IN CONNECTION WITH THE USE OR
+// PERFORMANCE OF THIS SOFTWARE.
+
+package probo
+
+import (
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"go.gearno.de/crypto/uuid"
+	"go.gearno.de/kit/pg"
+	"go.gearno.de/x/ref"
+	"go.probo.inc/probo/packages/emails"
+	"go.probo.inc/probo/pkg/auditlog"
+	"go.probo.inc/probo/pkg/coredata"
+	"go.probo.inc/probo/pkg/gid"
+	"go.probo.inc/probo/pkg/mail"
+	"go.probo.inc/probo/pkg/page"
+)
+
+const (
+	auditLogExportEmailExpiresIn = 24 * time.Hour
+)
+
+type (
+	AuditLogService struct {
+		svc *TenantService
+	}
+)
+
+func (s AuditLogService) ListForOrganizationID(
+	ctx context.Context,
+	organizationID gid.GID,
+	cursor *page.Cursor[coredata.AuditLogEntryOrderField],
+	filter *coredata.AuditLogEntryFilter,
+) (*page.Page[*coredata.AuditLogEntry, coredata.AuditLogEntryOrderField], error) {
+	var entries coredata.AuditLogEntries
+	organization := &coredata.Organization{}
+
+	err := s.svc.pg.WithConn(
+		ctx,
+		func(conn pg.Conn) error {
+			if err := organization.LoadByID(ctx, conn, s.svc.scope, organizationID); err != nil {
+				return fmt.Errorf("cannot load organization: %w", err)
+			}
+
+			if err := entries.LoadByOrganizationID(ctx, conn, s.svc.scope, organization.ID, cursor, filter); err != nil {
+				return fmt.Errorf("cannot load audit log entries: %w", err)
+			}
+
+			return nil
+		},
+	)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return page.NewPage(entries, cursor), nil
+}
+
+func (s AuditLogService) CountForOrganizationID(
+	ctx context.Context,
+	organizationID gid.GID,
+	filter *coredata.AuditLogEntryFilter,
+) (int, error) {
+	var count int
+
+	err := s.svc.pg.WithConn(
+		ctx,
+		func(conn pg.Conn) (err error) {
+			entries := &coredata.AuditLogEntries{}
+			count, err = entries.CountByOrganizationID(ctx, conn, s.svc.scope, organizationID, filter)
+			if err != nil {
+				return fmt.Errorf("cannot count audit log entries: %w", err)
+			}
+
+			return nil
+		},
+	)
+
+	if err != nil {
+		return 0, err
+	}
+
+	return count, nil
+}
+
+func (s AuditLogService) Get(
+	ctx context.Context,
+	auditLogEntryID gid.GID,
+) (*coredata.AuditLogEntry, error) {
+	entry := &coredata.AuditLogEntry{}
+
+	err := s.svc.pg.WithConn(
+		ctx,
+		func(conn pg.Conn) error {
+			return entry.LoadByID(ctx, conn, s.svc.scope, auditLogEntryID)
+		},
+	)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return entry, nil
+}
+
+func (s AuditLogService) RequestExport(
+	ctx context.Context,
+	organizationID gid.GID,
+	args coredata.AuditLogExportArguments,
+	recipientEmail mail.Addr,
+	recipientName string,
+) (*coredata.ExportJob, error) {
+	exportJob := &coredata.ExportJob{}
+
+	err := s.svc.pg.WithTx(ctx, func(conn pg.Conn) error {
+		organization := &coredata.Organization{}
+		if err := organization.LoadByID(ctx, conn, s.svc.scope, organizationID); err != nil {
+			return fmt.Errorf("cannot load organization: %w", err)
+		}
+
+		argsJSON, err := json.Marshal(args)
+		if err != nil {
+			return fmt.Errorf("cannot marshal audit log export arguments: %w", err)
+		}
+
+		exportJob = &coredata.ExportJob{
+			ID:             gid.New(s.svc.scope.GetTenantID(), coredata.ExportJobEntityType),
+			OrganizationID: organization.ID,
+			Type:           coredata.ExportJobTypeAuditLog,
+			Arguments:      argsJSON,
+			Status:         coredata.ExportJobStatusPending,
+			RecipientEmail: recipientEmail,
+			RecipientName:  recipientName,
+			CreatedAt:      time.Now(),
+		}
+
+		if err := exportJob.Insert(ctx, conn, s.svc.scope); err != nil {
+			return fmt.Errorf("cannot insert export job: %w", err)
+		}
+
+		// Exporting the audit log is itself audited so that bulk reads of
+		// the trail can be traced back to their requester.
+		if err := auditlog.Record(ctx, conn, s.svc.scope, organization.ID, ActionAuditLogEntryExport, exportJob.ID, nil, args); err != nil {
+			return fmt.Errorf("cannot record audit log entry: %w", err)
+		}
+
+		return nil
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return exportJob, nil
+}
+
+func (s *AuditLogService) BuildAndUploadExport(ctx context.Context, exportJobID gid.GID) (*coredata.ExportJob, error) {
+	exportJob := &coredata.ExportJob{}
+	err := s.svc.pg.WithTx(
+		ctx,
+		func(tx pg.Conn) error {
+			if err := exportJob.LoadByID(ctx, tx, s.svc.scope, exportJobID); err != nil {
+				return fmt.Errorf("cannot load export job: %w", err)
+			}
+
+			args, err := exportJob.GetAuditLogExportArguments()
+			if err != nil {
+				return fmt.Errorf("cannot get audit log export arguments: %w", err)
+			}
+
+			filter := coredata.NewAuditLogEntryFilter().
+				WithAction(args.Action).
+				WithActorID(args.ActorID).
+				WithResourceID(args.ResourceID).
+				WithCreatedBetween(args.From, args.To)
+
+			var entries coredata.AuditLogEntries
+			if err := entries.LoadAllByOrganizationID(ctx, tx, s.svc.scope, exportJob.OrganizationID, filter); err != nil {
+				return fmt.Errorf("cannot load audit log entries: %w", err)
+			}
+
+			tempFile, err := os.CreateTemp(os.TempDir(), "probo-audit-log-export-*.csv")
+			if err != nil {
+				return fmt.Errorf("cannot create temp file: %w", err)
+			}
+			defer func() { _ = tempFile.Close() }()
+			defer func() { _ = os.Remove(tempFile.Name()) }()
+
+			if err := writeAuditLogCSV(tempFile, entries); err != nil {
+				return fmt.Errorf("cannot write audit log export: %w", err)
+			}
+
+			if _, err := tempFile.Seek(0, 0); err != nil {
+				return fmt.Errorf("cannot seek temp file: %w", err)
+			}
+
+			fileInfo, err := tempFile.Stat()
+			if err != nil {
+				return fmt.Errorf("cannot stat temp file: %w", err)
+			}
+
+			objectKey, err := uuid.NewV4()
+			if err != nil {
+				return fmt.Errorf("cannot generate uuid: %w", err)
+			}
+
+			_, err = s.svc.s3.PutObject(
+				ctx,
+				&s3.PutObjectInput{
+					Bucket:        ref.Ref(s.svc.bucket),
+					Key:           ref.Ref(objectKey.String()),
+					Body:          tempFile,
+					ContentLength: ref.Ref(fileInfo.Size()),
+					ContentType:   ref.Ref("text/csv"),
+					Metadata: map[string]string{
+						"type":            "audit-log-export",
+						"export-job-id":   exportJob.ID.String(),
+						"organization-id": exportJob.OrganizationID.String(),
+					},
+				},
+			)
+			if err != nil {
+				return fmt.Errorf("cannot upload file to S3: %w", err)
+			}
+
+			now := time.Now()
+
+			file := coredata.File{
+				ID:         gid.New(exportJob.ID.TenantID(), coredata.FileEntityType),
+				BucketName: s.svc.bucket,
+				MimeType:   "text/csv",
+				FileName:   fmt.Sprintf("Audit Log Export %s.csv", now.Format("2006-01-02")),
+				FileKey:    objectKey.String(),
+				FileSize:   fileInfo.Size(),
+				CreatedAt:  now,
+				UpdatedAt:  now,
+			}
+
+			if err := file.Insert(ctx, tx, s.svc.scope); err != nil {
+				return fmt.Errorf("cannot insert file: %w", err)
+			}
+
+			exportJob.FileID = &file.ID
+			if err := exportJob.Update(ctx, tx, s.svc.scope); err != nil {
+				return fmt.Errorf("cannot update export job: %w", err)
+			}
+
+			return nil
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return exportJob, nil
+}
+
+func (s AuditLogService) SendExportEmail(
+	ctx context.Context,
+	fileID gid.GID,
+	recipientName string,
+	recipientEmail mail.Addr,
+) error {
+	return s.svc.pg.WithTx(
+		ctx,
+		func(tx pg.Conn) error {
+			file := &coredata.File{}
+			if err := file.LoadByID(ctx, tx, s.svc.scope, fileID); err != nil {
+				return fmt.Errorf("cannot load file: %w", err)
+			}
+
+			presignClient := s3.NewPresignClient(s.svc.s3)
+			presignedReq, err := presignClient.PresignGetObject(
+				ctx,
+				&s3.GetObjectInput{
+					Bucket:                     ref.Ref(s.svc.bucket),
+					Key:                        ref.Ref(file.FileKey),
+					ResponseCacheControl:       ref.Ref("max-age=3600, public"),
+					ResponseContentType:        ref.Ref(file.MimeType),
+					ResponseContentDisposition: ref.Ref(fmt.Sprintf("attachment; filename=\"%s\"", file.FileName)),
+				},
+				func(opts *s3.PresignOptions) {
+					opts.Expires = auditLogExportEmailExpiresIn
+				},
+			)
+			if err != nil {
+				return fmt.Errorf("cannot presign GetObject request: %w", err)
+			}
+
+			emailPresenter := emails.NewPresenter(s.svc.fileManager, s.svc.bucket, s.svc.baseURL, recipientName)
+
+			subject, textBody, htmlBody, err := emailPresenter.RenderAuditLogExport(ctx, presignedReq.URL)
+			if err != nil {
+				return fmt.Errorf("cannot render audit log export email: %w", err)
+			}
+
+			email := coredata.NewEmail(
+				recipientName,
+				recipientEmail,
+				subject,
+				textBody,
+				htmlBody,
+			)
+
+			if err := email.Insert(ctx, tx); err != nil {
+				return fmt.Errorf("cannot insert email: %w", err)
+			}
+
+			return nil
+		},
+	)
+}
+
+func writeAuditLogCSV(f *os.File, entries coredata.AuditLogEntries) error {
+	w := csv.NewWriter(f)
+
+	header := []string{"id", "created_at", "actor_type", "actor_id", "api_key_id", "action", "resource_id", "changes"}
+	if err := w.Write(header); err != nil {
+		return fmt.Errorf("cannot write header: %w", err)
+	}
+
+	for _, entry := range entries {
+		var actorID, apiKeyID string
+		if entry.ActorID != nil {
+			actorID = entry.ActorID.String()
+		}
+		if entry.APIKeyID != nil {
+			apiKeyID = entry.APIKeyID.String()
+		}
+
+		record := []string{
+			entry.ID.String(),
+			entry.CreatedAt.UTC().Format(time.RFC3339),
+			entry.ActorType.String(),
+			actorID,
+			apiKeyID,
+			entry.Action,
+			entry.ResourceID.String(),
+			string(entry.Changes),
+		}
+		if err := w.Write(record); err != nil {
+			return fmt.Errorf("cannot write record: %w", err)
+		}
+	}
+
+	w.Flush()
+	return w.Error()
+}
@@ -23,11 +23,14 @@ import (
 	"github.com/aws/aws-sdk-go-v2/service/s3"
 	"go.gearno.de/crypto/uuid"
 	"go.gearno.de/kit/pg"
+	"go.probo.inc/probo/pkg/auditlog"
 	"go.probo.inc/probo/pkg/coredata"
 	"go.probo.inc/probo/pkg/filevalidation"
 	"go.probo.inc/probo/pkg/gid"
 	"go.probo.inc/probo/pkg/page"
 	"go.probo.inc/probo/pkg/validator"
+	"go.probo.inc/probo/pkg/webhook"
+	webhooktypes "go.probo.inc/probo/pkg/webhook/types"
 )
 
 type AuditService struct {
@@ -191,6 +194,14 @@ func (s *AuditService) Create(
 				return fmt.Errorf("cannot insert audit: %w", err)
 			}
 
+			if err := webhook.InsertData(ctx, conn, s.svc.scope, audit.OrganizationID, coredata.WebhookEventTypeAuditCreated, webhooktypes.NewAudit(audit)); err != nil {
+				return fmt.Errorf("cannot insert webhook event: %w", err)
+			}
+
+			if err := auditlog.Record(ctx, conn, s.svc.scope, audit.OrganizationID, ActionAuditCreate, audit.ID, nil, webhooktypes.NewAudit(audit)); err != nil {
+				return fmt.Errorf("cannot record audit log entry: %w", err)
+			}
+
 			return nil
 		},
 	)
@@ -218,6 +229,8 @@ func (s *AuditService) Update(
 				return fmt.Errorf("cannot load audit: %w", err)
 			}
 
+			before := webhooktypes.NewAudit(audit)
+
 			if req.Name != nil {
 				audit.Name = *req.Name
 			}
@@ -240,6 +253,14 @@ func (s *AuditService) Update(
 				return fmt.Errorf("cannot update audit: %w", err)
 			}
 
+			if err := webhook.InsertData(ctx, conn, s.svc.scope, audit.OrganizationID, coredata.WebhookEventTypeAuditUpdated, webhooktypes.NewAudit(audit)); err != nil {
+				return fmt.Errorf("cannot insert webhook event: %w", err)
+			}
+
+			if err := auditlog.Record(ctx, conn, s.svc.scope, audit.OrganizationID, ActionAuditUpdate, audit.ID, before, webhooktypes.NewAudit(audit)); err != nil {
+				return fmt.Errorf("cannot record audit log entry: %w", err)
+			}
+
 			return nil
 		},
 	)
@@ -255,10 +276,22 @@ func (s AuditService) Delete(
 	ctx context.Context,
 	auditID gid.GID,
 ) error {
-	audit := coredata.Audit{ID: auditID}
-	return s.svc.pg.WithConn(
+	audit := &coredata.Audit{}
+	return s.svc.pg.WithTx(
 		ctx,
 		func(conn pg.Conn) error {
+			if err := audit.LoadByID(ctx, conn, s.svc.scope, auditID); err != nil {
+				return fmt.Errorf("cannot load audit: %w", err)
+			}
+
+			if err := webhook.InsertData(ctx, conn, s.svc.scope, audit.OrganizationID, coredata.WebhookEventTypeAuditDeleted, webhooktypes.NewAudit(audit)); err != nil {
+				return fmt.Errorf("cannot insert webhook event: %w", err)
+			}
+
+			if err := auditlog.Record(ctx, conn, s.svc.scope, audit.OrganizationID, ActionAuditDelete, audit.ID, webhooktypes.NewAudit(audit), nil); err != nil {
+				return fmt.Errorf("cannot record audit log entry: %w", err)
+			}
+
 			err := audit.Delete(ctx, conn, s.svc.scope)
 			if err != nil {
 				return fmt.Errorf("cannot delete audit: %w", err)
@@ -338,6 +371,8 @@ func (s AuditService) UploadReport(
 				return fmt.Errorf("cannot load audit: %w", err)
 			}
 
+			before := webhooktypes.NewAudit(audit)
+
 			reportID := gid.New(s.svc.scope.GetTenantID(), coredata.ReportEntityType)
 			now := time.Now()
 
@@ -383,6 +418,14 @@ func (s AuditService) UploadReport(
 				return fmt.Errorf("cannot update audit: %w", err)
 			}
 
+			if err := webhook.InsertData(ctx, conn, s.svc.scope, audit.OrganizationID, coredata.WebhookEventTypeAuditUpdated, webhooktypes.NewAudit(audit)); err != nil {
+				return fmt.Errorf("cannot insert webhook event: %w", err)
+			}
+
+			if err := auditlog.Record(ctx, conn, s.svc.scope, audit.OrganizationID, ActionAuditReportUpload, audit.ID, before, webhooktypes.NewAudit(audit)); err != nil {
+				return fmt.Errorf("cannot record audit log entry: %w", err)
+			}
+
 			return nil
 		},
 	)
@@ -429,6 +472,8 @@ func (s AuditService) DeleteReport(
 				return fmt.Errorf("cannot load audit: %w", err)
 			}
 
+			before := webhooktypes.NewAudit(audit)
+
 			if audit.ReportID != nil {
 				report := &coredata.Report{ID: *audit.ReportID}
 
@@ -442,6 +487,14 @@ func (s AuditService) DeleteReport(
 				if err := audit.Update(ctx, conn, s.svc.scope); err != nil {
 					return fmt.Errorf("cannot update audit: %w", err)
 				}
+
+				if err := webhook.InsertData(ctx, conn, s.svc.scope, audit.OrganizationID, coredata.WebhookEventTypeAuditUpdated, webhooktypes.NewAudit(audit)); err != nil {
+					return fmt.Errorf("cannot insert webhook event: %w", err)
+				}
+
+				if err := auditlog.Record(ctx, conn, s.svc.scope, audit.OrganizationID, ActionAuditReportDelete, audit.ID, before, webhooktypes.NewAudit(audit)); err != nil {
+					return fmt.Errorf("cannot record audit log entry: %w", err)
+				}
 			}
 
 			return nil
@@ -0,0 +1,442 @@
+// Copyright (c) 2025 Probo Inc <hello@getprobo.com>.
+//
+// Permission to use, copy, modify, and/or distribute this software for any
+// purpose with or without fee is hereby granted, provided that the above
+// copyright notice and this permission notice appear in all copies.
+//
+// THE SOFTWARE IS PROVIDED "AS IS" AND THE AUTHOR DISCLAIMS ALL WARRANTIES WITH
+// REGARD TO THIS SOFTWARE INCLUDING ALL IMPLIED WARRANTIES OF MERCHANTABILITY
+// AND FITNESS. IN NO EVENT SHALL THE AUTHOR BE LIABLE FOR ANY SPECIAL, DIRECT,
+// INDIRECT, OR CONSEQUENTIAL DAMAGES OR ANY DAMAGES WHATSOEVER RESULTING FROM
+// LOSS OF USE, DATA OR PROFITS, WHETHER IN AN ACTION OF CONTRACT, NEGLIGENCE OR
+// OTHER TORTIOUS ACTION, ARISING OUT OF OR IN CONNECTION WITH THE USE OR
+// PERFORMANCE OF THIS SOFTWARE.
+
+package probo
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"go.gearno.de/crypto/uuid"
+	"go.gearno.de/kit/pg"
+	"go.gearno.de/x/ref"
+	"go.probo.inc/probo/pkg/auditlog"
+	"go.probo.inc/probo/pkg/connector"
+	"go.probo.inc/probo/pkg/coredata"
+	"go.probo.inc/probo/pkg/gid"
+	"go.probo.inc/probo/pkg/webhook"
+	webhooktypes "go.probo.inc/probo/pkg/webhook/types"
+)
+
+// CollectEvidence locks the next connector with evidence mappings due for
+// collection, runs its collector and stores the result as evidence on the
+// mapped measures. Mappings are rescheduled interval from now whether the
+// collection succeeds or not. It returns coredata.ErrNoConnectorAvailable
+// when there is nothing to collect.
+func (s *Service) CollectEvidence(ctx context.Context, interval time.Duration) error {
+	cnnctr, err := s.lockConnectorForEvidenceCollection(ctx, interval)
+	if err != nil {
+		return fmt.Errorf("cannot lock connector: %w", err)
+	}
+
+	tenantService := s.WithTenant(cnnctr.ID.TenantID())
+
+	if err := tenantService.ConnectorEvidenceMappings.collect(ctx, cnnctr.ID); err != nil {
+		return fmt.Errorf("cannot collect evidence for connector %q: %w", cnnctr.ID, err)
+	}
+
+	return nil
+}
+
+func (s *Service) lockConnectorForEvidenceCollection(
+	ctx context.Context,
+	interval time.Duration,
+) (*coredata.Connector, error) {
+	providers := s.connectorRegistry.CollectorProviders()
+	if len(providers) == 0 {
+		return nil, coredata.ErrNoConnectorAvailable
+	}
+
+	cnnctr := &coredata.Connector{}
+
+	err := s.pg.WithTx(
+		ctx,
+		func(tx pg.Conn) error {
+			if err := cnnctr.LoadNextForEvidenceCollectionSkipLocked(ctx, tx, providers); err != nil {
+				return err
+			}
+
+			scope := coredata.NewScope(cnnctr.ID.TenantID())
+			mappings := coredata.ConnectorEvidenceMappings{}
+
+			return mappings.ScheduleByConnectorID(ctx, tx, scope, cnnctr.ID, time.Now().Add(interval))
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return cnnctr, nil
+}
+
+func (s ConnectorEvidenceMappingService) collect(ctx context.Context, connectorID gid.GID) error {
+	var (
+		cnnctr   = &coredata.Connector{}
+		mappings = coredata.ConnectorEvidenceMappings{}
+	)
+
+	err := s.svc.pg.WithConn(
+		ctx,
+		func(conn pg.Conn) error {
+			if err := cnnctr.LoadByID(ctx, conn, s.svc.scope, connectorID, s.svc.encryptionKey); err != nil {
+				return fmt.Errorf("cannot load connector: %w", err)
+			}
+
+			if err := mappings.LoadAllByConnectorID(ctx, conn, s.svc.scope, connectorID); err != nil {
+				return fmt.Errorf("cannot load connector evidence mappings: %w", err)
+			}
+
+			return nil
+		},
+	)
+	if err != nil {
+		return err
+	}
+
+	collected, collectErr := s.runCollector(ctx, cnnctr)
+	if collectErr != nil {
+		if err := s.markFailed(ctx, mappings, collectErr); err != nil {
+			return fmt.Errorf("cannot run collector: %w, and cannot mark mappings as failed: %w", collectErr, err)
+		}
+		return fmt.Errorf("cannot run collector: %w", collectErr)
+	}
+
+	byKey := make(map[string]connector.CollectedEvidence, len(collected))
+	for _, item := range collected {
+		byKey[item.Key] = item
+	}
+
+	var errs []error
+	for _, mapping := range mappings {
+		item, ok := byKey[mapping.EvidenceKey]
+		if !ok {
+			missingErr := fmt.Errorf("collector returned no evidence for key %q", mapping.EvidenceKey)
+			errs = append(errs, missingErr, s.markFailed(ctx, coredata.ConnectorEvidenceMappings{mapping}, missingErr))
+			continue
+		}
+
+		if item.Err != nil {
+			collectErr := fmt.Errorf("cannot collect evidence %q: %w", mapping.EvidenceKey, item.Err)
+			errs = append(errs, collectErr, s.markFailed(ctx, coredata.ConnectorEvidenceMappings{mapping}, collectErr))
+			continue
+		}
+
+		if err := s.recordCollectedEvidence(ctx, mapping, item); err != nil {
+			errs = append(errs, fmt.Errorf("cannot record evidence %q: %w", mapping.EvidenceKey, err))
+			errs = append(errs, s.markFailed(ctx, coredata.ConnectorEvidenceMappings{mapping}, err))
+			continue
+		}
+
+		if err := s.openFindings(ctx, mapping, item.Findings); err != nil {
+			errs = append(errs, fmt.Errorf("cannot open findings of evidence %q: %w", mapping.EvidenceKey, err))
+		}
+	}
+
+	if _, ok := cnnctr.Connection.(*connector.OAuth2Connection); ok {
+		err := s.svc.pg.WithConn(
+			ctx,
+			func(conn pg.Conn) error {
+				cnnctr.UpdatedAt = time.Now()
+				return cnnctr.Update(ctx, conn, s.svc.scope, s.svc.encryptionKey)
+			},
+		)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("cannot persist refreshed OAuth2 token: %w", err))
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+func (s ConnectorEvidenceMappingService) runCollector(
+	ctx context.Context,
+	cnnctr *coredata.Connector,
+) ([]connector.CollectedEvidence, error) {
+	if cnnctr.Connection == nil {
+		return nil, fmt.Errorf("connector has no connection configured")
+	}
+
+	collector, err := s.svc.connectorRegistry.GetCollector(cnnctr.Provider.String())
+	if err != nil {
+		return nil, err
+	}
+
+	httpClient, err := s.httpClient(ctx, cnnctr)
+	if err != nil {
+		return nil, fmt.Errorf("cannot create HTTP client: %w", err)
+	}
+
+	return collector.Collect(ctx, httpClient, cnnctr.Connection)
+}
+
+func (s ConnectorEvidenceMappingService) httpClient(
+	ctx context.Context,
+	cnnctr *coredata.Connector,
+) (*http.Client, error) {
+	oauth2Conn, ok := cnnctr.Connection.(*connector.OAuth2Connection)
+	if !ok {
+		return cnnctr.Connection.Client(ctx)
+	}
+
+	refreshCfg := s.svc.connectorRegistry.GetOAuth2RefreshConfig(cnnctr.Provider.String())
+	if refreshCfg == nil {
+		return oauth2Conn.Client(ctx)
+	}
+
+	return oauth2Conn.RefreshableClient(ctx, *refreshCfg)
+}
+
+func (s ConnectorEvidenceMappingService) recordCollectedEvidence(
+	ctx context.Context,
+	mapping *coredata.ConnectorEvidenceMapping,
+	item connector.CollectedEvidence,
+) error {
+	data, err := json.MarshalIndent(item.Data, "", "  ")
+	if err != nil {
+		return fmt.Errorf("cannot marshal evidence data: %w", err)
+	}
+
+	filename := item.Filename
+	if filename == "" {
+		filename = item.Key + ".json"
+	}
+
+	referenceID, err := uuid.NewV4()
+	if err != nil {
+		return fmt.Errorf("cannot generate reference id: %w", err)
+	}
+
+	now := time.Now()
+	evidence := &coredata.Evidence{
+		ID:             gid.New(s.svc.scope.GetTenantID(), coredata.EvidenceEntityType),
+		OrganizationID: mapping.OrganizationID,
+		MeasureID:      mapping.MeasureID,
+		State:          coredata.EvidenceStateFulfilled,
+		ReferenceID:    "connector-evidence-" + referenceID.String(),
+		Type:           coredata.EvidenceTypeFile,
+		CreatedAt:      now,
+		UpdatedAt:      now,
+	}
+	if item.Description != "" {
+		evidence.Description = ref.Ref(item.Description)
+	}
+
+	file, err := s.svc.Files.UploadAndSaveFile(
+		ctx,
+		s.svc.Evidences.fileValidator,
+		map[string]string{
+			"type":            "evidence",
+			"evidence-id":     evidence.ID.String(),
+			"organization-id": mapping.OrganizationID.String(),
+		},
+		&FileUpload{
+			Content:     bytes.NewReader(data),
+			Filename:    filename,
+			Size:        int64(len(data)),
+			ContentType: "application/json",
+		},
+	)
+	if err != nil {
+		return fmt.Errorf("cannot upload evidence file: %w", err)
+	}
+
+	evidence.EvidenceFileId = &file.ID
+
+	return s.svc.pg.WithTx(
+		ctx,
+		func(conn pg.Conn) error {
+			if err := evidence.Insert(ctx, conn, s.svc.scope); err != nil {
+				return fmt.Errorf("cannot insert evidence: %w", err)
+			}
+
+			if err := webhook.InsertData(ctx, conn, s.svc.scope, evidence.OrganizationID, coredata.WebhookEventTypeEvidenceCreated, webhooktypes.NewEvidence(evidence)); err != nil {
+				return fmt.Errorf("cannot insert webhook event: %w", err)
+			}
+
+			if err := auditlog.Record(ctx, conn, s.svc.scope, evidence.OrganizationID, ActionConnectorCollectEvidence, evidence.ID, nil, webhooktypes.NewEvidence(evidence)); err != nil {
+				return fmt.Errorf("cannot record audit log entry: %w", err)
+			}
+
+			mapping.LastCollectedAt = &now
+			mapping.CollectionError = nil
+			mapping.UpdatedAt = now
+
+			if err := mapping.Update(ctx, conn, s.svc.scope); err != nil {
+				return fmt.Errorf("cannot update connector evidence mapping: %w", err)
+			}
+
+			return nil
+		},
+	)
+}
+
+// openFindings opens a task or a nonconformity, depending on the mapping
+// finding action, for each finding not already opened by a previous
+// collection. Findings that are not reported anymore are forgotten so they
+// open again if they reappear.
+func (s ConnectorEvidenceMappingService) openFindings(
+	ctx context.Context,
+	mapping *coredata.ConnectorEvidenceMapping,
+	findings []connector.Finding,
+) error {
+	if mapping.FindingAction == coredata.ConnectorEvidenceFindingActionNone {
+		return nil
+	}
+
+	keys := make([]string, 0, len(findings))
+	for _, finding := range findings {
+		keys = append(keys, finding.Key)
+	}
+
+	existing := coredata.ConnectorEvidenceFindings{}
+
+	err := s.svc.pg.WithTx(
+		ctx,
+		func(conn pg.Conn) error {
+			if err := existing.DeleteResolvedByMappingID(ctx, conn, s.svc.scope, mapping.ID, keys); err != nil {
+				return err
+			}
+
+			return existing.LoadAllByMappingID(ctx, conn, s.svc.scope, mapping.ID)
+		},
+	)
+	if err != nil {
+		return err
+	}
+
+	opened := make(map[string]bool, len(existing))
+	for _, finding := range existing {
+		opened[finding.FindingKey] = true
+	}
+
+	for _, finding := range findings {
+		if opened[finding.Key] {
+			continue
+		}
+		opened[finding.Key] = true
+
+		if err := s.openFinding(ctx, mapping, finding); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// openFinding opens the task or nonconformity of a finding and records
+// it in the same transaction, so a failure cannot leave an action that the
+// next collection would open again.
+func (s ConnectorEvidenceMappingService) openFinding(
+	ctx context.Context,
+	mapping *coredata.ConnectorEvidenceMapping,
+	finding connector.Finding,
+) error {
+	record := &coredata.ConnectorEvidenceFinding{
+		MappingID:  mapping.ID,
+		FindingKey: finding.Key,
+		CreatedAt:  time.Now(),
+	}
+
+	var (
+		taskReq          *CreateTaskRequest
+		nonconformityReq *CreateNonconformityRequest
+	)
+
+	switch mapping.FindingAction {
+	case coredata.ConnectorEvidenceFindingActionTask:
+		taskReq = &CreateTaskRequest{
+			OrganizationID: mapping.OrganizationID,
+			MeasureID:      &mapping.MeasureID,
+			Name:           finding.Title,
+			Description:    &finding.Description,
+			AssignedToID:   mapping.FindingOwnerID,
+		}
+		if err := taskReq.Validate(); err != nil {
+			return fmt.Errorf("cannot open task for finding %q: %w", finding.Key, err)
+		}
+
+	case coredata.ConnectorEvidenceFindingActionNonconformity:
+		if mapping.FindingOwnerID == nil {
+			return fmt.Errorf("cannot open nonconformity for finding %q: mapping has no finding owner", finding.Key)
+		}
+
+		referenceID := finding.Key
+		if len(referenceID) > NameMaxLength {
+			referenceID = referenceID[:NameMaxLength]
+		}
+
+		nonconformityReq = &CreateNonconformityRequest{
+			OrganizationID: mapping.OrganizationID,
+			ReferenceID:    referenceID,
+			Description:    &finding.Title,
+			DateIdentified: &record.CreatedAt,
+			RootCause:      finding.Description,
+			OwnerID:        *mapping.FindingOwnerID,
+		}
+		if err := nonconformityReq.Validate(); err != nil {
+			return fmt.Errorf("cannot open nonconformity for finding %q: %w", finding.Key, err)
+		}
+	}
+
+	return s.svc.pg.WithTx(
+		ctx,
+		func(tx pg.Conn) error {
+			switch {
+			case taskReq != nil:
+				task, err := s.svc.Tasks.createInTx(ctx, tx, *taskReq)
+				if err != nil {
+					return fmt.Errorf("cannot open task for finding %q: %w", finding.Key, err)
+				}
+				record.TaskID = &task.ID
+
+			case nonconformityReq != nil:
+				nonconformity, err := s.svc.Nonconformities.createInTx(ctx, tx, nonconformityReq)
+				if err != nil {
+					return fmt.Errorf("cannot open nonconformity for finding %q: %w", finding.Key, err)
+				}
+				record.NonconformityID = &nonconformity.ID
+			}
+
+			return record.Insert(ctx, tx, s.svc.scope)
+		},
+	)
+}
+
+func (s ConnectorEvidenceMappingService) markFailed(
+	ctx context.Context,
+	mappings coredata.ConnectorEvidenceMappings,
+	collectErr error,
+) error {
+	return s.svc.pg.WithTx(
+		ctx,
+		func(conn pg.Conn) error {
+			now := time.Now()
+			for _, mapping := range mappings {
+				mapping.CollectionError = ref.Ref(collectErr.Error())
+				mapping.UpdatedAt = now
+
+				if err := mapping.Update(ctx, conn, s.svc.scope); err != nil {
+					return fmt.Errorf("cannot update connector evidence mapping: %w", err)
+				}
+			}
+
+			return nil
+		},
+	)
+}
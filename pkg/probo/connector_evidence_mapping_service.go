@@ -0,0 +1,237 @@
+// Copyright (c) 2025 Probo Inc <hello@getprobo.com>.
+//
+// Permission to use, copy, modify, and/or distribute this software for any
+// purpose with or without fee is hereby granted, provided that the above
+// copyright notice and this permission notice appear in all copies.
+//
+// THE SOFTWARE IS PROVIDED "AS IS" AND THE AUTHOR DISCLAIMS ALL WARRANTIES WITH
+// REGARD TO THIS SOFTWARE INCLUDING ALL IMPLIED WARRANTIES OF MERCHANTABILITY
+// AND FITNESS. IN NO EVENT SHALL THE AUTHOR BE LIABLE FOR ANY SPECIAL, DIRECT,
+// INDIRECT, OR CONSEQUENTIAL DAMAGES OR ANY DAMAGES WHATSOEVER RESULTING FROM
+// LOSS OF USE, DATA OR PROFITS, WHETHER IN AN ACTION OF CONTRACT, NEGLIGENCE OR
+// OTHER TORTIOUS ACTION, ARISING OUT OF OR IN CONNECTION WITH THE USE OR
+// PERFORMANCE OF THIS SOFTWARE.
+
+package probo
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"go.gearno.de/kit/pg"
+	"go.probo.inc/probo/pkg/auditlog"
+	"go.probo.inc/probo/pkg/coredata"
+	"go.probo.inc/probo/pkg/gid"
+	"go.probo.inc/probo/pkg/page"
+	"go.probo.inc/probo/pkg/validator"
+)
+
+type (
+	ConnectorEvidenceMappingService struct {
+		svc *TenantService
+	}
+
+	ErrConnectorEvidenceCollectionUnsupported struct {
+		Provider coredata.ConnectorProvider
+	}
+
+	CreateConnectorEvidenceMappingRequest struct {
+		ConnectorID    gid.GID
+		MeasureID      gid.GID
+		EvidenceKey    string
+		FindingAction  *coredata.ConnectorEvidenceFindingAction
+		FindingOwnerID *gid.GID
+	}
+)
+
+func (e ErrConnectorEvidenceCollectionUnsupported) Error() string {
+	return fmt.Sprintf("connector provider %q does not support evidence collection", e.Provider)
+}
+
+func (r *CreateConnectorEvidenceMappingRequest) Validate() error {
+	v := validator.New()
+
+	v.Check(r.ConnectorID, "connector_id", validator.Required(), validator.GID(coredata.ConnectorEntityType))
+	v.Check(r.MeasureID, "measure_id", validator.Required(), validator.GID(coredata.MeasureEntityType))
+	v.Check(r.EvidenceKey, "evidence_key", validator.Required(), validator.NotEmpty(), validator.NoSpaces(), validator.MaxLen(NameMaxLength))
+	v.Check(r.FindingAction, "finding_action", validator.OneOfSlice(coredata.ConnectorEvidenceFindingActions()))
+	v.Check(r.FindingOwnerID, "finding_owner_id", validator.GID(coredata.MembershipProfileEntityType))
+
+	if r.FindingAction != nil && *r.FindingAction == coredata.ConnectorEvidenceFindingActionNonconformity {
+		v.Check(r.FindingOwnerID, "finding_owner_id", validator.Required())
+	}
+
+	return v.Error()
+}
+
+func (s ConnectorEvidenceMappingService) Get(
+	ctx context.Context,
+	mappingID gid.GID,
+) (*coredata.ConnectorEvidenceMapping, error) {
+	mapping := &coredata.ConnectorEvidenceMapping{}
+
+	err := s.svc.pg.WithConn(
+		ctx,
+		func(conn pg.Conn) error {
+			return mapping.LoadByID(ctx, conn, s.svc.scope, mappingID)
+		},
+	)
+	if err != nil {
+		return nil, fmt.Errorf("cannot load connector evidence mapping: %w", err)
+	}
+
+	return mapping, nil
+}
+
+func (s ConnectorEvidenceMappingService) CountForMeasureID(
+	ctx context.Context,
+	measureID gid.GID,
+) (int, error) {
+	var count int
+
+	err := s.svc.pg.WithConn(
+		ctx,
+		func(conn pg.Conn) (err error) {
+			mappings := coredata.ConnectorEvidenceMappings{}
+			count, err = mappings.CountByMeasureID(ctx, conn, s.svc.scope, measureID)
+			return err
+		},
+	)
+	if err != nil {
+		return 0, fmt.Errorf("cannot count connector evidence mappings: %w", err)
+	}
+
+	return count, nil
+}
+
+func (s ConnectorEvidenceMappingService) ListForMeasureID(
+	ctx context.Context,
+	measureID gid.GID,
+	cursor *page.Cursor[coredata.ConnectorEvidenceMappingOrderField],
+) (*page.Page[*coredata.ConnectorEvidenceMapping, coredata.ConnectorEvidenceMappingOrderField], error) {
+	var mappings coredata.ConnectorEvidenceMappings
+
+	err := s.svc.pg.WithConn(
+		ctx,
+		func(conn pg.Conn) error {
+			return mappings.LoadByMeasureID(ctx, conn, s.svc.scope, measureID, cursor)
+		},
+	)
+	if err != nil {
+		return nil, fmt.Errorf("cannot list connector evidence mappings: %w", err)
+	}
+
+	return page.NewPage(mappings, cursor), nil
+}
+
+func (s ConnectorEvidenceMappingService) Create(
+	ctx context.Context,
+	req CreateConnectorEvidenceMappingRequest,
+) (*coredata.ConnectorEvidenceMapping, error) {
+	if err := req.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid request: %w", err)
+	}
+
+	now := time.Now()
+	mapping := &coredata.ConnectorEvidenceMapping{
+		ID:             gid.New(s.svc.scope.GetTenantID(), coredata.ConnectorEvidenceMappingEntityType),
+		ConnectorID:    req.ConnectorID,
+		MeasureID:      req.MeasureID,
+		EvidenceKey:    req.EvidenceKey,
+		FindingAction:  coredata.ConnectorEvidenceFindingActionNone,
+		FindingOwnerID: req.FindingOwnerID,
+		CreatedAt:      now,
+		UpdatedAt:      now,
+	}
+	if req.FindingAction != nil {
+		mapping.FindingAction = *req.FindingAction
+	}
+
+	err := s.svc.pg.WithTx(
+		ctx,
+		func(conn pg.Conn) error {
+			cnnctr := &coredata.Connector{}
+			if err := cnnctr.LoadMetadataByID(ctx, conn, s.svc.scope, req.ConnectorID); err != nil {
+				return fmt.Errorf("cannot load connector: %w", err)
+			}
+
+			if _, err := s.svc.connectorRegistry.GetCollector(cnnctr.Provider.String()); err != nil {
+				return &ErrConnectorEvidenceCollectionUnsupported{Provider: cnnctr.Provider}
+			}
+
+			measure := &coredata.Measure{}
+			if err := measure.LoadByID(ctx, conn, s.svc.scope, req.MeasureID); err != nil {
+				return fmt.Errorf("cannot load measure: %w", err)
+			}
+
+			if measure.OrganizationID != cnnctr.OrganizationID {
+				return coredata.ErrResourceNotFound
+			}
+
+			if req.FindingOwnerID != nil {
+				owner := &coredata.MembershipProfile{}
+				if err := owner.LoadByID(ctx, conn, s.svc.scope, *req.FindingOwnerID); err != nil {
+					return fmt.Errorf("cannot load finding owner profile: %w", err)
+				}
+
+				if owner.OrganizationID != measure.OrganizationID {
+					return coredata.ErrResourceNotFound
+				}
+			}
+
+			mapping.OrganizationID = measure.OrganizationID
+
+			if err := mapping.Insert(ctx, conn, s.svc.scope); err != nil {
+				return fmt.Errorf("cannot insert connector evidence mapping: %w", err)
+			}
+
+			if err := auditlog.Record(ctx, conn, s.svc.scope, mapping.OrganizationID, ActionConnectorEvidenceMappingCreate, mapping.ID, nil, connectorEvidenceMappingAuditState(mapping)); err != nil {
+				return fmt.Errorf("cannot record audit log entry: %w", err)
+			}
+
+			return nil
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return mapping, nil
+}
+
+func (s ConnectorEvidenceMappingService) Delete(
+	ctx context.Context,
+	mappingID gid.GID,
+) error {
+	mapping := &coredata.ConnectorEvidenceMapping{}
+
+	return s.svc.pg.WithTx(
+		ctx,
+		func(conn pg.Conn) error {
+			if err := mapping.LoadByID(ctx, conn, s.svc.scope, mappingID); err != nil {
+				return fmt.Errorf("cannot load connector evidence mapping: %w", err)
+			}
+
+			if err := auditlog.Record(ctx, conn, s.svc.scope, mapping.OrganizationID, ActionConnectorEvidenceMappingDelete, mapping.ID, connectorEvidenceMappingAuditState(mapping), nil); err != nil {
+				return fmt.Errorf("cannot record audit log entry: %w", err)
+			}
+
+			if err := mapping.Delete(ctx, conn, s.svc.scope); err != nil {
+				return fmt.Errorf("cannot delete connector evidence mapping: %w", err)
+			}
+
+			return nil
+		},
+	)
+}
+
+func connectorEvidenceMappingAuditState(m *coredata.ConnectorEvidenceMapping) map[string]any {
+	return map[string]any{
+		"connectorId":    m.ConnectorID,
+		"measureId":      m.MeasureID,
+		"evidenceKey":    m.EvidenceKey,
+		"findingAction":  m.FindingAction,
+		"findingOwnerId": m.FindingOwnerID,
+	}
+}
@@ -17,12 +17,14 @@ package probo
 import (
 	"bytes"
 	"context"
+	"crypto/rand"
 	"encoding/json"
 	"fmt"
 	"text/template"
 	"time"
 
 	"go.gearno.de/kit/pg"
+	"go.probo.inc/probo/pkg/auditlog"
 	"go.probo.inc/probo/pkg/connector"
 	"go.probo.inc/probo/pkg/coredata"
 	"go.probo.inc/probo/pkg/gid"
@@ -54,6 +56,20 @@ type (
 		Protocol       coredata.ConnectorProtocol
 		Connection     connector.Connection
 	}
+
+	CreateAWSConnectorRequest struct {
+		OrganizationID  gid.GID
+		Region          string
+		AccessKeyID     *string
+		SecretAccessKey *string
+		RoleARN         *string
+	}
+
+	CreateOktaConnectorRequest struct {
+		OrganizationID gid.GID
+		Domain         string
+		APIToken       string
+	}
 )
 
 func (car *CreateConnectorRequest) Validate() error {
@@ -65,6 +81,33 @@ func (car *CreateConnectorRequest) Validate() error {
 	return v.Error()
 }
 
+func (r *CreateAWSConnectorRequest) Validate() error {
+	v := validator.New()
+	v.Check(r.OrganizationID, "organization_id", validator.Required(), validator.GID(coredata.OrganizationEntityType))
+	v.Check(r.Region, "region", validator.Required(), validator.Pattern(`^[a-z]{2}(-[a-z]+)+-\d$`, "must be an AWS region such as us-east-1"))
+	v.Check(r.AccessKeyID, "access_key_id", validator.NotEmpty(), validator.AlphaNumeric(), validator.MaxLen(128))
+	v.Check(r.SecretAccessKey, "secret_access_key", validator.NotEmpty(), validator.NoSpaces(), validator.MaxLen(128))
+	v.Check(r.RoleARN, "role_arn", validator.NotEmpty(), validator.Pattern(`^arn:aws[a-z-]*:iam::\d{12}:role/\S+$`, "must be an IAM role ARN"))
+
+	if r.RoleARN == nil {
+		v.Check(r.AccessKeyID, "access_key_id", validator.Required())
+	}
+	if r.AccessKeyID != nil {
+		v.Check(r.SecretAccessKey, "secret_access_key", validator.Required())
+	}
+
+	return v.Error()
+}
+
+func (r *CreateOktaConnectorRequest) Validate() error {
+	v := validator.New()
+	v.Check(r.OrganizationID, "organization_id", validator.Required(), validator.GID(coredata.OrganizationEntityType))
+	v.Check(r.Domain, "domain", validator.Required(), validator.Domain())
+	v.Check(r.APIToken, "api_token", validator.Required(), validator.NoSpaces(), validator.MaxLen(256))
+
+	return v.Error()
+}
+
 func (s *ConnectorService) ListForOrganizationID(
 	ctx context.Context,
 	organizationID gid.GID,
@@ -211,3 +254,115 @@ func (s *ConnectorService) Create(
 
 	return newConnector, nil
 }
+
+// CreateAWS connects an AWS account with either a static access key or a
+// role to assume. The credentials are stored encrypted with the connector.
+// Roles are always assumed with an external ID generated here, never chosen
+// by the client, so a tenant cannot make Probo assume a role trusting
+// another tenant.
+func (s *ConnectorService) CreateAWS(
+	ctx context.Context,
+	req CreateAWSConnectorRequest,
+) (*coredata.Connector, error) {
+	if err := req.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid request: %w", err)
+	}
+
+	awsConn := &connector.AWSConnection{Region: req.Region}
+	if req.AccessKeyID != nil {
+		awsConn.AccessKeyID = *req.AccessKeyID
+		awsConn.SecretAccessKey = *req.SecretAccessKey
+	}
+	if req.RoleARN != nil {
+		awsConn.RoleARN = *req.RoleARN
+		awsConn.ExternalID = rand.Text()
+	}
+
+	now := time.Now()
+	newConnector := &coredata.Connector{
+		ID:             gid.New(s.svc.scope.GetTenantID(), coredata.ConnectorEntityType),
+		OrganizationID: req.OrganizationID,
+		Provider:       coredata.ConnectorProviderAWS,
+		Protocol:       coredata.ConnectorProtocolAWSCredentials,
+		Connection:     awsConn,
+		CreatedAt:      now,
+		UpdatedAt:      now,
+	}
+
+	err := s.svc.pg.WithTx(
+		ctx,
+		func(conn pg.Conn) error {
+			if err := newConnector.Insert(ctx, conn, s.svc.scope, s.svc.encryptionKey); err != nil {
+				return fmt.Errorf("cannot insert connector: %w", err)
+			}
+
+			// Secrets are never written to the audit log.
+			state := map[string]any{
+				"provider":    newConnector.Provider,
+				"region":      req.Region,
+				"accessKeyId": req.AccessKeyID,
+				"roleArn":     req.RoleARN,
+			}
+			if err := auditlog.Record(ctx, conn, s.svc.scope, newConnector.OrganizationID, ActionConnectorCreate, newConnector.ID, nil, state); err != nil {
+				return fmt.Errorf("cannot record audit log entry: %w", err)
+			}
+
+			return nil
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return newConnector, nil
+}
+
+// CreateOkta connects an Okta organization with an API token. The token is
+// stored encrypted with the connector.
+func (s *ConnectorService) CreateOkta(
+	ctx context.Context,
+	req CreateOktaConnectorRequest,
+) (*coredata.Connector, error) {
+	if err := req.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid request: %w", err)
+	}
+
+	now := time.Now()
+	newConnector := &coredata.Connector{
+		ID:             gid.New(s.svc.scope.GetTenantID(), coredata.ConnectorEntityType),
+		OrganizationID: req.OrganizationID,
+		Provider:       coredata.ConnectorProviderOkta,
+		Protocol:       coredata.ConnectorProtocolAPIToken,
+		Connection: &connector.OktaConnection{
+			Domain:   req.Domain,
+			APIToken: req.APIToken,
+		},
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+
+	err := s.svc.pg.WithTx(
+		ctx,
+		func(conn pg.Conn) error {
+			if err := newConnector.Insert(ctx, conn, s.svc.scope, s.svc.encryptionKey); err != nil {
+				return fmt.Errorf("cannot insert connector: %w", err)
+			}
+
+			// Secrets are never written to the audit log.
+			state := map[string]any{
+				"provider": newConnector.Provider,
+				"domain":   req.Domain,
+			}
+			if err := auditlog.Record(ctx, conn, s.svc.scope, newConnector.OrganizationID, ActionConnectorCreate, newConnector.ID, nil, state); err != nil {
+				return fmt.Errorf("cannot record audit log entry: %w", err)
+			}
+
+			return nil
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return newConnector, nil
+}
@@ -20,6 +20,7 @@ import (
 	"time"
 
 	"go.gearno.de/kit/pg"
+	"go.probo.inc/probo/pkg/auditlog"
 	"go.probo.inc/probo/pkg/coredata"
 	"go.probo.inc/probo/pkg/gid"
 	"go.probo.inc/probo/pkg/page"
@@ -147,6 +148,10 @@ func (s *ContinualImprovementService) Create(
 				return fmt.Errorf("cannot insert continual improvement: %w", err)
 			}
 
+			if err := auditlog.Record(ctx, conn, s.svc.scope, improvement.OrganizationID, ActionContinualImprovementCreate, improvement.ID, nil, continualImprovementAuditState(improvement)); err != nil {
+				return fmt.Errorf("cannot record audit log entry: %w", err)
+			}
+
 			return nil
 		},
 	)
@@ -171,6 +176,8 @@ func (s *ContinualImprovementService) Update(
 				return fmt.Errorf("cannot load continual improvement: %w", err)
 			}
 
+			before := continualImprovementAuditState(improvement)
+
 			if req.ReferenceID != nil {
 				improvement.ReferenceID = *req.ReferenceID
 			}
@@ -209,6 +216,10 @@ func (s *ContinualImprovementService) Update(
 				return fmt.Errorf("cannot update continual improvement: %w", err)
 			}
 
+			if err := auditlog.Record(ctx, conn, s.svc.scope, improvement.OrganizationID, ActionContinualImprovementUpdate, improvement.ID, before, continualImprovementAuditState(improvement)); err != nil {
+				return fmt.Errorf("cannot record audit log entry: %w", err)
+			}
+
 			return nil
 		},
 	)
@@ -232,6 +243,10 @@ func (s *ContinualImprovementService) Delete(
 				return fmt.Errorf("cannot load continual improvement: %w", err)
 			}
 
+			if err := auditlog.Record(ctx, conn, s.svc.scope, improvement.OrganizationID, ActionContinualImprovementDelete, improvement.ID, continualImprovementAuditState(improvement), nil); err != nil {
+				return fmt.Errorf("cannot record audit log entry: %w", err)
+			}
+
 			if err := improvement.Delete(ctx, conn, s.svc.scope); err != nil {
 				return fmt.Errorf("cannot delete continual improvement: %w", err)
 			}
@@ -296,3 +311,15 @@ func (s ContinualImprovementService) ListForOrganizationID(
 
 	return page.NewPage(improvements, cursor), nil
 }
+
+func continualImprovementAuditState(ci *coredata.ContinualImprovement) map[string]any {
+	return map[string]any{
+		"referenceId": ci.ReferenceID,
+		"description": ci.Description,
+		"source":      ci.Source,
+		"ownerId":     ci.OwnerID,
+		"targetDate":  ci.TargetDate,
+		"status":      ci.Status,
+		"priority":    ci.Priority,
+	}
+}
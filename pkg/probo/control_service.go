@@ -20,10 +20,13 @@ import (
 	"time"
 
 	"go.gearno.de/kit/pg"
+	"go.probo.inc/probo/pkg/auditlog"
 	"go.probo.inc/probo/pkg/coredata"
 	"go.probo.inc/probo/pkg/gid"
 	"go.probo.inc/probo/pkg/page"
 	"go.probo.inc/probo/pkg/validator"
+	"go.probo.inc/probo/pkg/webhook"
+	webhooktypes "go.probo.inc/probo/pkg/webhook/types"
 )
 
 type (
@@ -428,6 +431,118 @@ func (s ControlService) DeleteMeasureMapping(
 	return control, measure, nil
 }
 
+func (s ControlService) ListEquivalents(
+	ctx context.Context,
+	controlID gid.GID,
+) (coredata.Controls, error) {
+	var controls coredata.Controls
+
+	err := s.svc.pg.WithConn(
+		ctx,
+		func(conn pg.Conn) error {
+			return controls.LoadEquivalentsByControlID(ctx, conn, s.svc.scope, controlID)
+		},
+	)
+
+	if err != nil {
+		return nil, fmt.Errorf("cannot list equivalent controls: %w", err)
+	}
+
+	return controls, nil
+}
+
+func (s ControlService) CreateEquivalenceMapping(
+	ctx context.Context,
+	controlID gid.GID,
+	equivalentControlID gid.GID,
+) (*coredata.Control, *coredata.Control, error) {
+	v := validator.New()
+	v.Check(equivalentControlID, "equivalent_control_id", validator.NotEqualTo(controlID))
+	if err := v.Error(); err != nil {
+		return nil, nil, err
+	}
+
+	control := &coredata.Control{}
+	equivalentControl := &coredata.Control{}
+
+	err := s.svc.pg.WithConn(
+		ctx,
+		func(conn pg.Conn) error {
+			if err := control.LoadByID(ctx, conn, s.svc.scope, controlID); err != nil {
+				return fmt.Errorf("cannot load control: %w", err)
+			}
+
+			if err := equivalentControl.LoadByID(ctx, conn, s.svc.scope, equivalentControlID); err != nil {
+				return fmt.Errorf("cannot load equivalent control: %w", err)
+			}
+
+			if control.OrganizationID != equivalentControl.OrganizationID {
+				return fmt.Errorf("cannot map controls of different organizations")
+			}
+
+			controlEquivalence := coredata.NewControlEquivalence(
+				control.OrganizationID,
+				control.ID,
+				equivalentControl.ID,
+				time.Now(),
+			)
+
+			if _, err := controlEquivalence.Upsert(ctx, conn, s.svc.scope); err != nil {
+				return fmt.Errorf("cannot upsert control equivalence: %w", err)
+			}
+
+			return nil
+		},
+	)
+
+	if err != nil {
+		return nil, nil, fmt.Errorf("cannot create control equivalence mapping: %w", err)
+	}
+
+	return control, equivalentControl, nil
+}
+
+func (s ControlService) DeleteEquivalenceMapping(
+	ctx context.Context,
+	controlID gid.GID,
+	equivalentControlID gid.GID,
+) (*coredata.Control, *coredata.Control, error) {
+	control := &coredata.Control{}
+	equivalentControl := &coredata.Control{}
+
+	err := s.svc.pg.WithConn(
+		ctx,
+		func(conn pg.Conn) error {
+			if err := control.LoadByID(ctx, conn, s.svc.scope, controlID); err != nil {
+				return fmt.Errorf("cannot load control: %w", err)
+			}
+
+			if err := equivalentControl.LoadByID(ctx, conn, s.svc.scope, equivalentControlID); err != nil {
+				return fmt.Errorf("cannot load equivalent control: %w", err)
+			}
+
+			controlEquivalence := coredata.NewControlEquivalence(
+				control.OrganizationID,
+				control.ID,
+				equivalentControl.ID,
+				time.Now(),
+			)
+
+			if err := controlEquivalence.Delete(ctx, conn, s.svc.scope); err != nil {
+				return fmt.Errorf("cannot delete control equivalence: %w", err)
+			}
+
+			return nil
+		},
+	)
+
+	if err != nil {
+		return nil, nil, fmt.Errorf("cannot delete control equivalence mapping: %w", err)
+	}
+
+	return control, equivalentControl, nil
+}
+
 func (s ControlService) CreateDocumentMapping(
 	ctx context.Context,
 	controlID gid.GID,
@@ -851,7 +966,19 @@ func (s ControlService) Create(
 			control.FrameworkID = framework.ID
 			control.OrganizationID = framework.OrganizationID
 
-			return control.Insert(ctx, conn, s.svc.scope)
+			if err := control.Insert(ctx, conn, s.svc.scope); err != nil {
+				return fmt.Errorf("cannot insert control: %w", err)
+			}
+
+			if err := webhook.InsertData(ctx, conn, s.svc.scope, control.OrganizationID, coredata.WebhookEventTypeControlCreated, webhooktypes.NewControl(control)); err != nil {
+				return fmt.Errorf("cannot insert webhook event: %w", err)
+			}
+
+			if err := auditlog.Record(ctx, conn, s.svc.scope, control.OrganizationID, ActionControlCreate, control.ID, nil, webhooktypes.NewControl(control)); err != nil {
+				return fmt.Errorf("cannot record audit log entry: %w", err)
+			}
+
+			return nil
 		},
 	)
 
@@ -899,6 +1026,8 @@ func (s ControlService) Update(
 				return fmt.Errorf("cannot load control: %w", err)
 			}
 
+			before := webhooktypes.NewControl(control)
+
 			if req.Name != nil {
 				control.Name = *req.Name
 			}
@@ -917,7 +1046,19 @@ func (s ControlService) Update(
 
 			control.UpdatedAt = time.Now()
 
-			return control.Update(ctx, conn, s.svc.scope)
+			if err := control.Update(ctx, conn, s.svc.scope); err != nil {
+				return fmt.Errorf("cannot update control: %w", err)
+			}
+
+			if err := webhook.InsertData(ctx, conn, s.svc.scope, control.OrganizationID, coredata.WebhookEventTypeControlUpdated, webhooktypes.NewControl(control)); err != nil {
+				return fmt.Errorf("cannot insert webhook event: %w", err)
+			}
+
+			if err := auditlog.Record(ctx, conn, s.svc.scope, control.OrganizationID, ActionControlUpdate, control.ID, before, webhooktypes.NewControl(control)); err != nil {
+				return fmt.Errorf("cannot record audit log entry: %w", err)
+			}
+
+			return nil
 		},
 	)
 	if err != nil {
@@ -931,11 +1072,23 @@ func (s ControlService) Delete(
 	ctx context.Context,
 	controlID gid.GID,
 ) error {
-	control := &coredata.Control{ID: controlID}
+	control := &coredata.Control{}
 
-	return s.svc.pg.WithConn(
+	return s.svc.pg.WithTx(
 		ctx,
 		func(conn pg.Conn) error {
+			if err := control.LoadByID(ctx, conn, s.svc.scope, controlID); err != nil {
+				return fmt.Errorf("cannot load control: %w", err)
+			}
+
+			if err := webhook.InsertData(ctx, conn, s.svc.scope, control.OrganizationID, coredata.WebhookEventTypeControlDeleted, webhooktypes.NewControl(control)); err != nil {
+				return fmt.Errorf("cannot insert webhook event: %w", err)
+			}
+
+			if err := auditlog.Record(ctx, conn, s.svc.scope, control.OrganizationID, ActionControlDelete, control.ID, webhooktypes.NewControl(control), nil); err != nil {
+				return fmt.Errorf("cannot record audit log entry: %w", err)
+			}
+
 			return control.Delete(ctx, conn, s.svc.scope)
 		},
 	)
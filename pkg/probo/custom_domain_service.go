@@ -20,6 +20,7 @@ import (
 
 	"go.gearno.de/kit/log"
 	"go.gearno.de/kit/pg"
+	"go.probo.inc/probo/pkg/auditlog"
 	"go.probo.inc/probo/pkg/certmanager"
 	"go.probo.inc/probo/pkg/coredata"
 	"go.probo.inc/probo/pkg/crypto/cipher"
@@ -94,6 +95,10 @@ func (s *CustomDomainService) CreateCustomDomain(
 				return fmt.Errorf("cannot update organization: %w", err)
 			}
 
+			if err := auditlog.Record(ctx, tx, s.svc.scope, domain.OrganizationID, ActionCustomDomainCreate, domain.ID, nil, customDomainAuditState(domain)); err != nil {
+				return fmt.Errorf("cannot record audit log entry: %w", err)
+			}
+
 			return nil
 		},
 	)
@@ -126,6 +131,10 @@ func (s *CustomDomainService) DeleteCustomDomain(
 				return fmt.Errorf("cannot load domain: %w", err)
 			}
 
+			if err := auditlog.Record(ctx, tx, s.svc.scope, org.ID, ActionCustomDomainDelete, domain.ID, customDomainAuditState(domain), nil); err != nil {
+				return fmt.Errorf("cannot record audit log entry: %w", err)
+			}
+
 			if err := domain.Delete(ctx, tx, s.svc.scope); err != nil {
 				return fmt.Errorf("cannot delete domain: %w", err)
 			}
@@ -173,3 +182,12 @@ func (s *CustomDomainService) GetOrganizationCustomDomain(
 
 	return domain, nil
 }
+
+// customDomainAuditState leaves the certificate and its private key out
+// of the audit log.
+func customDomainAuditState(d *coredata.CustomDomain) map[string]any {
+	return map[string]any{
+		"domain":    d.Domain,
+		"sslStatus": d.SSLStatus,
+	}
+}
@@ -21,6 +21,7 @@ import (
 	"time"
 
 	"go.gearno.de/kit/pg"
+	"go.probo.inc/probo/pkg/auditlog"
 	"go.probo.inc/probo/pkg/coredata"
 	"go.probo.inc/probo/pkg/docgen"
 	"go.probo.inc/probo/pkg/gid"
@@ -214,6 +215,10 @@ func (s *DataProtectionImpactAssessmentService) Create(
 				return fmt.Errorf("cannot insert data protection impact assessment: %w", err)
 			}
 
+			if err := auditlog.Record(ctx, conn, s.svc.scope, dpia.OrganizationID, ActionDataProtectionImpactAssessmentCreate, dpia.ID, nil, dataProtectionImpactAssessmentAuditState(dpia)); err != nil {
+				return fmt.Errorf("cannot record audit log entry: %w", err)
+			}
+
 			return nil
 		},
 	)
@@ -242,6 +247,8 @@ func (s *DataProtectionImpactAssessmentService) Update(
 				return fmt.Errorf("cannot load data protection impact assessment: %w", err)
 			}
 
+			before := dataProtectionImpactAssessmentAuditState(dpia)
+
 			if req.Description != nil {
 				dpia.Description = *req.Description
 			}
@@ -268,6 +275,10 @@ func (s *DataProtectionImpactAssessmentService) Update(
 				return fmt.Errorf("cannot update data protection impact assessment: %w", err)
 			}
 
+			if err := auditlog.Record(ctx, conn, s.svc.scope, dpia.OrganizationID, ActionDataProtectionImpactAssessmentUpdate, dpia.ID, before, dataProtectionImpactAssessmentAuditState(dpia)); err != nil {
+				return fmt.Errorf("cannot record audit log entry: %w", err)
+			}
+
 			return nil
 		},
 	)
@@ -291,6 +302,10 @@ func (s *DataProtectionImpactAssessmentService) Delete(
 				return fmt.Errorf("cannot load data protection impact assessment: %w", err)
 			}
 
+			if err := auditlog.Record(ctx, conn, s.svc.scope, dpia.OrganizationID, ActionDataProtectionImpactAssessmentDelete, dpia.ID, dataProtectionImpactAssessmentAuditState(dpia), nil); err != nil {
+				return fmt.Errorf("cannot record audit log entry: %w", err)
+			}
+
 			if err := dpia.Delete(ctx, conn, s.svc.scope); err != nil {
 				return fmt.Errorf("cannot delete data protection impact assessment: %w", err)
 			}
@@ -427,3 +442,14 @@ func (s *DataProtectionImpactAssessmentService) ExportPDF(
 
 	return pdfData, nil
 }
+
+func dataProtectionImpactAssessmentAuditState(dpia *coredata.DataProtectionImpactAssessment) map[string]any {
+	return map[string]any{
+		"processingActivityId":        dpia.ProcessingActivityID,
+		"description":                 dpia.Description,
+		"necessityAndProportionality": dpia.NecessityAndProportionality,
+		"potentialRisk":               dpia.PotentialRisk,
+		"mitigations":                 dpia.Mitigations,
+		"residualRisk":                dpia.ResidualRisk,
+	}
+}
@@ -20,6 +20,7 @@ import (
 	"time"
 
 	"go.gearno.de/kit/pg"
+	"go.probo.inc/probo/pkg/auditlog"
 	"go.probo.inc/probo/pkg/coredata"
 	"go.probo.inc/probo/pkg/gid"
 	"go.probo.inc/probo/pkg/page"
@@ -189,6 +190,8 @@ func (s DatumService) Update(
 			return fmt.Errorf("cannot load data: %w", err)
 		}
 
+		before := datumAuditState(datum)
+
 		if req.Name != nil {
 			datum.Name = *req.Name
 		}
@@ -214,6 +217,10 @@ func (s DatumService) Update(
 			}
 		}
 
+		if err := auditlog.Record(ctx, conn, s.svc.scope, datum.OrganizationID, ActionDatumUpdate, datum.ID, before, datumAuditState(datum)); err != nil {
+			return fmt.Errorf("cannot record audit log entry: %w", err)
+		}
+
 		return nil
 	})
 
@@ -232,6 +239,28 @@ func (s DatumService) Create(
 		return nil, fmt.Errorf("invalid request: %w", err)
 	}
 
+	var datum *coredata.Datum
+
+	err := s.svc.pg.WithTx(
+		ctx,
+		func(conn pg.Conn) (err error) {
+			datum, err = s.createInTx(ctx, conn, req)
+			return err
+		},
+	)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return datum, nil
+}
+
+func (s DatumService) createInTx(
+	ctx context.Context,
+	conn pg.Conn,
+	req CreateDatumRequest,
+) (*coredata.Datum, error) {
 	now := time.Now()
 	datumID := gid.New(s.svc.scope.GetTenantID(), coredata.DatumEntityType)
 	datumVendors := &coredata.DatumVendors{}
@@ -246,30 +275,23 @@ func (s DatumService) Create(
 		UpdatedAt:          now,
 	}
 
-	err := s.svc.pg.WithTx(
-		ctx,
-		func(conn pg.Conn) error {
-			owner := &coredata.MembershipProfile{}
-			if err := owner.LoadByID(ctx, conn, s.svc.scope, req.OwnerID); err != nil {
-				return fmt.Errorf("cannot load owner profile: %w", err)
-			}
-
-			if err := datum.Insert(ctx, conn, s.svc.scope); err != nil {
-				return fmt.Errorf("cannot insert datum: %w", err)
-			}
+	owner := &coredata.MembershipProfile{}
+	if err := owner.LoadByID(ctx, conn, s.svc.scope, req.OwnerID); err != nil {
+		return nil, fmt.Errorf("cannot load owner profile: %w", err)
+	}
 
-			if len(req.VendorIDs) > 0 {
-				if err := datumVendors.Insert(ctx, conn, s.svc.scope, datum.ID, datum.OrganizationID, req.VendorIDs); err != nil {
-					return fmt.Errorf("cannot create data vendors: %w", err)
-				}
-			}
+	if err := datum.Insert(ctx, conn, s.svc.scope); err != nil {
+		return nil, fmt.Errorf("cannot insert datum: %w", err)
+	}
 
-			return nil
-		},
-	)
+	if len(req.VendorIDs) > 0 {
+		if err := datumVendors.Insert(ctx, conn, s.svc.scope, datum.ID, datum.OrganizationID, req.VendorIDs); err != nil {
+			return nil, fmt.Errorf("cannot create data vendors: %w", err)
+		}
+	}
 
-	if err != nil {
-		return nil, err
+	if err := auditlog.Record(ctx, conn, s.svc.scope, datum.OrganizationID, ActionDatumCreate, datum.ID, nil, datumAuditState(datum)); err != nil {
+		return nil, fmt.Errorf("cannot record audit log entry: %w", err)
 	}
 
 	return datum, nil
@@ -279,11 +301,19 @@ func (s DatumService) Delete(
 	ctx context.Context,
 	datumID gid.GID,
 ) error {
-	datum := &coredata.Datum{ID: datumID}
+	datum := &coredata.Datum{}
 
-	return s.svc.pg.WithConn(
+	return s.svc.pg.WithTx(
 		ctx,
 		func(conn pg.Conn) error {
+			if err := datum.LoadByID(ctx, conn, s.svc.scope, datumID); err != nil {
+				return fmt.Errorf("cannot load data: %w", err)
+			}
+
+			if err := auditlog.Record(ctx, conn, s.svc.scope, datum.OrganizationID, ActionDatumDelete, datum.ID, datumAuditState(datum), nil); err != nil {
+				return fmt.Errorf("cannot record audit log entry: %w", err)
+			}
+
 			return datum.Delete(ctx, conn, s.svc.scope)
 		},
 	)
@@ -309,3 +339,11 @@ func (s DatumService) ListVendors(
 
 	return page.NewPage(vendors, cursor), nil
 }
+
+func datumAuditState(d *coredata.Datum) map[string]any {
+	return map[string]any{
+		"name":               d.Name,
+		"ownerId":            d.OwnerID,
+		"dataClassification": d.DataClassification,
+	}
+}
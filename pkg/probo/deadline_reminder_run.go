@@ -0,0 +1,456 @@
+// Copyright (c) 2025 Probo Inc <hello@getprobo.com>.
+//
+// Permission to use, copy, modify, and/or distribute this software for any
+// purpose with or without fee is hereby granted, provided that the above
+// copyright notice and this permission notice appear in all copies.
+//
+// THE SOFTWARE IS PROVIDED "AS IS" AND THE AUTHOR DISCLAIMS ALL WARRANTIES WITH
+// REGARD TO THIS SOFTWARE INCLUDING ALL IMPLIED WARRANTIES OF MERCHANTABILITY
+// AND FITNESS. IN NO EVENT SHALL THE AUTHOR BE LIABLE FOR ANY SPECIAL, DIRECT,
+// INDIRECT, OR CONSEQUENTIAL DAMAGES OR ANY DAMAGES WHATSOEVER RESULTING FROM
+// LOSS OF USE, DATA OR PROFITS, WHETHER IN AN ACTION OF CONTRACT, NEGLIGENCE OR
+// OTHER TORTIOUS ACTION, ARISING OUT OF OR IN CONNECTION WITH THE USE OR
+// PERFORMANCE OF THIS SOFTWARE.
+
+package probo
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"text/template"
+	"time"
+
+	"go.gearno.de/kit/pg"
+	"go.gearno.de/x/ref"
+	"go.probo.inc/probo/packages/emails"
+	"go.probo.inc/probo/pkg/baseurl"
+	"go.probo.inc/probo/pkg/coredata"
+	"go.probo.inc/probo/pkg/gid"
+)
+
+const (
+	// deadlineReminderRunInterval is the time between two reminder runs
+	// of an organization.
+	deadlineReminderRunInterval = time.Hour
+
+	// deadlineReminderMaxOverdueDays bounds how long reminders are sent
+	// for an overdue item, so that enabling reminders on an organization
+	// with an old backlog does not flood its members.
+	deadlineReminderMaxOverdueDays = 30
+)
+
+var (
+	deadlineReminderTemplate = template.Must(
+		template.New("deadline-reminder.json.tmpl").
+			Funcs(template.FuncMap{
+				"jsonEscape": func(s string) string {
+					b, _ := json.Marshal(s)
+					return string(b[1 : len(b)-1])
+				},
+			}).
+			ParseFS(Templates, "templates/deadline-reminder.json.tmpl"),
+	)
+
+	deadlineItemTypeLabels = map[coredata.DeadlineItemType]string{
+		coredata.DeadlineItemTypeTask:                 "Task",
+		coredata.DeadlineItemTypeNonconformity:        "Nonconformity",
+		coredata.DeadlineItemTypeObligation:           "Obligation",
+		coredata.DeadlineItemTypeContinualImprovement: "Continual improvement",
+		coredata.DeadlineItemTypeRightsRequest:        "Rights request",
+		coredata.DeadlineItemTypeProcessingActivity:   "Processing activity review",
+		coredata.DeadlineItemTypeVendorRiskAssessment: "Vendor risk assessment",
+		coredata.DeadlineItemTypeDocumentReview:       "Document review",
+	}
+)
+
+type (
+	deadlineReminder struct {
+		Kind       coredata.DeadlineReminderKind
+		OffsetDays int
+	}
+)
+
+// SendDeadlineReminders locks the deadline reminder settings the most
+// overdue, reschedules them and sends the reminders due for the deadlines
+// of their organization. A failed run is recorded on the settings and
+// retried at the next run. It returns
+// coredata.ErrNoDeadlineReminderSettingsDue when no settings are due.
+func (s *Service) SendDeadlineReminders(ctx context.Context) error {
+	settings, err := s.lockDeadlineReminderSettingsForRun(ctx)
+	if err != nil {
+		return fmt.Errorf("cannot lock deadline reminder settings: %w", err)
+	}
+
+	tenantService := s.WithTenant(settings.ID.TenantID())
+
+	if err := tenantService.DeadlineReminderSettings.run(ctx, settings); err != nil {
+		return fmt.Errorf("cannot send deadline reminders of %q: %w", settings.OrganizationID, err)
+	}
+
+	return nil
+}
+
+func (s *Service) lockDeadlineReminderSettingsForRun(ctx context.Context) (*coredata.DeadlineReminderSettings, error) {
+	settings := &coredata.DeadlineReminderSettings{}
+
+	err := s.pg.WithTx(
+		ctx,
+		func(tx pg.Conn) error {
+			now := time.Now()
+
+			if err := settings.LoadNextDueForUpdateSkipLocked(ctx, tx, now); err != nil {
+				return err
+			}
+
+			settings.NextRunAt = now.Add(deadlineReminderRunInterval)
+			settings.UpdatedAt = now
+
+			return settings.Update(ctx, tx, coredata.NewScope(settings.ID.TenantID()))
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return settings, nil
+}
+
+func (s DeadlineReminderSettingsService) run(ctx context.Context, settings *coredata.DeadlineReminderSettings) error {
+	now := time.Now()
+
+	var sendErr error
+	if settings.Enabled {
+		sendErr = s.sendReminders(ctx, settings, now)
+	}
+
+	err := s.svc.pg.WithTx(
+		ctx,
+		func(conn pg.Conn) error {
+			if err := settings.LoadByOrganizationID(ctx, conn, s.svc.scope, settings.OrganizationID); err != nil {
+				return fmt.Errorf("cannot load deadline reminder settings: %w", err)
+			}
+
+			settings.LastRunAt = &now
+			settings.UpdatedAt = time.Now()
+
+			if sendErr != nil {
+				settings.LastError = ref.Ref(sendErr.Error())
+			} else {
+				settings.LastError = nil
+			}
+
+			if err := settings.Update(ctx, conn, s.svc.scope); err != nil {
+				return fmt.Errorf("cannot update deadline reminder settings: %w", err)
+			}
+
+			return nil
+		},
+	)
+
+	if sendErr != nil {
+		if err != nil {
+			return fmt.Errorf("cannot send reminders: %w, and cannot record the failure: %w", sendErr, err)
+		}
+		return fmt.Errorf("cannot send reminders: %w", sendErr)
+	}
+
+	return err
+}
+
+func (s DeadlineReminderSettingsService) sendReminders(
+	ctx context.Context,
+	settings *coredata.DeadlineReminderSettings,
+	now time.Time,
+) error {
+	today := truncateToDate(now)
+	since := today.AddDate(0, 0, -deadlineReminderMaxOverdueDays)
+	until := today
+	if len(settings.DaysBefore) > 0 {
+		until = today.AddDate(0, 0, slices.Max(settings.DaysBefore))
+	}
+
+	var (
+		organization coredata.Organization
+		items        coredata.DeadlineItems
+		owners       coredata.MembershipProfiles
+		admins       coredata.MembershipProfiles
+		slackEnabled bool
+	)
+
+	err := s.svc.pg.WithConn(
+		ctx,
+		func(conn pg.Conn) error {
+			if err := organization.LoadByID(ctx, conn, s.svc.scope, settings.OrganizationID); err != nil {
+				return fmt.Errorf("cannot load organization: %w", err)
+			}
+
+			if err := items.LoadOpenByOrganizationID(ctx, conn, s.svc.scope, settings.OrganizationID, since, until); err != nil {
+				return fmt.Errorf("cannot load deadline items: %w", err)
+			}
+
+			ownerIDs := make([]gid.GID, 0, len(items))
+			for _, item := range items {
+				if item.OwnerProfileID != nil {
+					ownerIDs = append(ownerIDs, *item.OwnerProfileID)
+				}
+			}
+
+			if len(ownerIDs) > 0 {
+				if err := owners.LoadByIDs(ctx, conn, s.svc.scope, ownerIDs); err != nil {
+					return fmt.Errorf("cannot load owner profiles: %w", err)
+				}
+			}
+
+			if err := admins.LoadActiveAdminsByOrganizationID(ctx, conn, s.svc.scope, settings.OrganizationID); err != nil {
+				return fmt.Errorf("cannot load admin profiles: %w", err)
+			}
+
+			if settings.SlackEnabled {
+				var connectors coredata.Connectors
+				if err := connectors.LoadAllByOrganizationIDProtocolAndProvider(
+					ctx,
+					conn,
+					s.svc.scope,
+					settings.OrganizationID,
+					coredata.ConnectorProtocolOAuth2,
+					coredata.ConnectorProviderSlack,
+					s.svc.encryptionKey,
+				); err != nil {
+					return fmt.Errorf("cannot load slack connectors: %w", err)
+				}
+
+				slackEnabled = len(connectors) > 0
+			}
+
+			return nil
+		},
+	)
+	if err != nil {
+		return err
+	}
+
+	ownersByID := make(map[gid.GID]*coredata.MembershipProfile, len(owners))
+	for _, owner := range owners {
+		ownersByID[owner.ID] = owner
+	}
+
+	for _, item := range items {
+		var owner *coredata.MembershipProfile
+		if item.OwnerProfileID != nil {
+			owner = ownersByID[*item.OwnerProfileID]
+		}
+
+		for _, reminder := range dueDeadlineReminders(settings, item.Deadline, today) {
+			// Reminders of items without owner already go to the admins.
+			if owner == nil && reminder.Kind == coredata.DeadlineReminderKindEscalation {
+				continue
+			}
+
+			recipients := admins
+			if owner != nil && reminder.Kind != coredata.DeadlineReminderKindEscalation {
+				recipients = coredata.MembershipProfiles{owner}
+			}
+
+			if err := s.sendReminder(ctx, &organization, item, owner, reminder, recipients, slackEnabled, today); err != nil {
+				return fmt.Errorf("cannot send %s reminder for %q: %w", reminder.Kind, item.EntityID, err)
+			}
+		}
+	}
+
+	return nil
+}
+
+func (s DeadlineReminderSettingsService) sendReminder(
+	ctx context.Context,
+	organization *coredata.Organization,
+	item *coredata.DeadlineItem,
+	owner *coredata.MembershipProfile,
+	reminder deadlineReminder,
+	recipients coredata.MembershipProfiles,
+	slackEnabled bool,
+	today time.Time,
+) error {
+	escalated := reminder.Kind == coredata.DeadlineReminderKindEscalation
+	status := deadlineStatus(item.Deadline, today)
+	itemType := deadlineItemTypeLabels[item.Type]
+	itemURL := baseurl.MustParse(s.svc.baseURL).
+		AppendPath(fmt.Sprintf("/organizations/%s/%s", organization.ID, item.Path)).
+		MustString()
+
+	return s.svc.pg.WithTx(
+		ctx,
+		func(tx pg.Conn) error {
+			sent := &coredata.DeadlineReminder{
+				ID:             gid.New(s.svc.scope.GetTenantID(), coredata.DeadlineReminderEntityType),
+				OrganizationID: organization.ID,
+				EntityID:       item.EntityID,
+				Deadline:       item.Deadline,
+				Kind:           reminder.Kind,
+				OffsetDays:     reminder.OffsetDays,
+				CreatedAt:      time.Now(),
+			}
+
+			inserted, err := sent.InsertIfNotSent(ctx, tx, s.svc.scope)
+			if err != nil {
+				return fmt.Errorf("cannot insert deadline reminder: %w", err)
+			}
+
+			if !inserted {
+				return nil
+			}
+
+			for _, recipient := range recipients {
+				emailPresenter := emails.NewPresenter(s.svc.fileManager, s.svc.bucket, s.svc.baseURL, recipient.FullName)
+
+				subject, textBody, htmlBody, err := emailPresenter.RenderDeadlineReminder(
+					ctx,
+					itemURL,
+					itemType,
+					item.Title,
+					item.Deadline,
+					status,
+					escalated,
+					organization.Name,
+				)
+				if err != nil {
+					return fmt.Errorf("cannot render deadline reminder email: %w", err)
+				}
+
+				email := coredata.NewEmail(
+					recipient.FullName,
+					recipient.EmailAddress,
+					subject,
+					textBody,
+					htmlBody,
+				)
+				email.OrganizationID = &organization.ID
+
+				if err := email.Insert(ctx, tx); err != nil {
+					return fmt.Errorf("cannot insert email: %w", err)
+				}
+			}
+
+			if slackEnabled {
+				ownerName := "Unassigned"
+				if owner != nil {
+					ownerName = owner.FullName
+				}
+
+				data := struct {
+					ItemType  string
+					ItemTitle string
+					ItemURL   string
+					Deadline  string
+					Status    string
+					OwnerName string
+					Escalated bool
+				}{
+					ItemType:  itemType,
+					ItemTitle: item.Title,
+					ItemURL:   itemURL,
+					Deadline:  item.Deadline.Format(time.DateOnly),
+					Status:    status,
+					OwnerName: ownerName,
+					Escalated: escalated,
+				}
+
+				var buf bytes.Buffer
+				if err := deadlineReminderTemplate.Execute(&buf, data); err != nil {
+					return fmt.Errorf("cannot execute template: %w", err)
+				}
+
+				var body map[string]any
+				if err := json.NewDecoder(&buf).Decode(&body); err != nil {
+					return fmt.Errorf("cannot parse template JSON: %w", err)
+				}
+
+				slackMessage := coredata.NewSlackMessage(s.svc.scope, organization.ID, coredata.SlackMessageTypeDeadlineReminder, body)
+				if err := slackMessage.Insert(ctx, tx, s.svc.scope); err != nil {
+					return fmt.Errorf("cannot insert slack message: %w", err)
+				}
+			}
+
+			return nil
+		},
+	)
+}
+
+// dueDeadlineReminders returns the reminders due today for a deadline.
+// Reminders missed while the worker was not running are caught up with
+// the closest one only, and already sent reminders are skipped when they
+// are recorded.
+func dueDeadlineReminders(
+	settings *coredata.DeadlineReminderSettings,
+	deadline time.Time,
+	today time.Time,
+) []deadlineReminder {
+	daysUntil := daysBetween(today, deadline)
+
+	switch {
+	case daysUntil > 0:
+		offset := -1
+		for _, d := range settings.DaysBefore {
+			if d >= daysUntil && (offset == -1 || d < offset) {
+				offset = d
+			}
+		}
+
+		if offset == -1 {
+			return nil
+		}
+
+		return []deadlineReminder{{Kind: coredata.DeadlineReminderKindUpcoming, OffsetDays: offset}}
+
+	case daysUntil == 0:
+		if !settings.RemindOnDueDate {
+			return nil
+		}
+
+		return []deadlineReminder{{Kind: coredata.DeadlineReminderKindDue, OffsetDays: 0}}
+	}
+
+	overdue := -daysUntil
+	if overdue > deadlineReminderMaxOverdueDays {
+		return nil
+	}
+
+	offset := 1
+	if settings.OverdueIntervalDays > 0 {
+		offset += ((overdue - 1) / settings.OverdueIntervalDays) * settings.OverdueIntervalDays
+	}
+
+	reminders := []deadlineReminder{{Kind: coredata.DeadlineReminderKindOverdue, OffsetDays: offset}}
+
+	if grace := settings.EscalationGraceDays; grace != nil && overdue >= *grace {
+		reminders = append(reminders, deadlineReminder{Kind: coredata.DeadlineReminderKindEscalation, OffsetDays: *grace})
+	}
+
+	return reminders
+}
+
+func deadlineStatus(deadline time.Time, today time.Time) string {
+	switch days := daysBetween(today, deadline); {
+	case days > 1:
+		return fmt.Sprintf("is due in %d days", days)
+	case days == 1:
+		return "is due tomorrow"
+	case days == 0:
+		return "is due today"
+	case days == -1:
+		return "is 1 day overdue"
+	default:
+		return fmt.Sprintf("is %d days overdue", -days)
+	}
+}
+
+func daysBetween(from time.Time, to time.Time) int {
+	return int(truncateToDate(to).Sub(truncateToDate(from)).Hours() / 24)
+}
+
+func truncateToDate(t time.Time) time.Time {
+	t = t.UTC()
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+}
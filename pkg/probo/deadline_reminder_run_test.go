@@ -0,0 +1,81 @@
+// Copyright (c) 2025 Probo Inc <hello@getprobo.com>.
+//
+// Permission to use, copy, modify, and/or distribute this software for any
+// purpose with or without fee is hereby granted, provided that the above
+// copyright notice and this permission notice appear in all copies.
+//
+// THE SOFTWARE IS PROVIDED "AS IS" AND THE AUTHOR DISCLAIMS ALL WARRANTIES WITH
+// REGARD TO THIS SOFTWARE INCLUDING ALL IMPLIED WARRANTIES OF MERCHANTABILITY
+// AND FITNESS. IN NO EVENT SHALL THE AUTHOR BE LIABLE FOR ANY SPECIAL, DIRECT,
+// INDIRECT, OR CONSEQUENTIAL DAMAGES OR ANY DAMAGES WHATSOEVER RESULTING FROM
+// LOSS OF USE, DATA OR PROFITS, WHETHER IN AN ACTION OF CONTRACT, NEGLIGENCE OR
+// OTHER TORTIOUS ACTION, ARISING OUT OF OR IN CONNECTION WITH THE USE OR
+// PERFORMANCE OF THIS SOFTWARE.
+
+package probo
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"go.probo.inc/probo/pkg/coredata"
+)
+
+func TestDueDeadlineReminders(t *testing.T) {
+	today := time.Date(2025, time.March, 14, 15, 30, 0, 0, time.UTC)
+	grace := 3
+
+	settings := func(update func(*coredata.DeadlineReminderSettings)) *coredata.DeadlineReminderSettings {
+		s := &coredata.DeadlineReminderSettings{
+			DaysBefore:          []int{7, 1},
+			RemindOnDueDate:     true,
+			OverdueIntervalDays: 7,
+			EscalationGraceDays: &grace,
+		}
+		if update != nil {
+			update(s)
+		}
+
+		return s
+	}
+
+	upcoming := func(offset int) deadlineReminder {
+		return deadlineReminder{Kind: coredata.DeadlineReminderKindUpcoming, OffsetDays: offset}
+	}
+	overdue := func(offset int) deadlineReminder {
+		return deadlineReminder{Kind: coredata.DeadlineReminderKindOverdue, OffsetDays: offset}
+	}
+	escalation := deadlineReminder{Kind: coredata.DeadlineReminderKindEscalation, OffsetDays: grace}
+
+	tests := []struct {
+		name     string
+		settings *coredata.DeadlineReminderSettings
+		days     int
+		want     []deadlineReminder
+	}{
+		{name: "before the first reminder", settings: settings(nil), days: 10},
+		{name: "on the first reminder", settings: settings(nil), days: 7, want: []deadlineReminder{upcoming(7)}},
+		{name: "between two reminders", settings: settings(nil), days: 5, want: []deadlineReminder{upcoming(7)}},
+		{name: "on the last reminder", settings: settings(nil), days: 1, want: []deadlineReminder{upcoming(1)}},
+		{name: "no reminder before", settings: settings(func(s *coredata.DeadlineReminderSettings) { s.DaysBefore = nil }), days: 1},
+		{name: "due date", settings: settings(nil), days: 0, want: []deadlineReminder{{Kind: coredata.DeadlineReminderKindDue}}},
+		{name: "due date reminder disabled", settings: settings(func(s *coredata.DeadlineReminderSettings) { s.RemindOnDueDate = false }), days: 0},
+		{name: "first overdue day", settings: settings(nil), days: -1, want: []deadlineReminder{overdue(1)}},
+		{name: "escalation after grace", settings: settings(nil), days: -3, want: []deadlineReminder{overdue(1), escalation}},
+		{name: "second overdue interval", settings: settings(nil), days: -8, want: []deadlineReminder{overdue(8), escalation}},
+		{name: "end of second overdue interval", settings: settings(nil), days: -14, want: []deadlineReminder{overdue(8), escalation}},
+		{name: "no overdue interval", settings: settings(func(s *coredata.DeadlineReminderSettings) { s.OverdueIntervalDays = 0 }), days: -10, want: []deadlineReminder{overdue(1), escalation}},
+		{name: "no escalation", settings: settings(func(s *coredata.DeadlineReminderSettings) { s.EscalationGraceDays = nil }), days: -10, want: []deadlineReminder{overdue(8)}},
+		{name: "last overdue day", settings: settings(nil), days: -deadlineReminderMaxOverdueDays, want: []deadlineReminder{overdue(29), escalation}},
+		{name: "overdue for too long", settings: settings(nil), days: -deadlineReminderMaxOverdueDays - 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			deadline := truncateToDate(today).AddDate(0, 0, tt.days)
+
+			assert.Equal(t, tt.want, dueDeadlineReminders(tt.settings, deadline, today))
+		})
+	}
+}
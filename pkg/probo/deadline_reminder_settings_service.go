@@ -0,0 +1,155 @@
+// Copyright (c) 2025 Probo Inc <hello@getprobo.com>.
+//
+// Permission to use, copy, modify, and/or distribute this software for any
+// purpose with or without fee is hereby granted, provided that the above
+// copyright notice and this permission notice appear in all copies.
+//
+// THE SOFTWARE IS PROVIDED "AS IS" AND THE AUTHOR DISCLAIMS ALL WARRANTIES WITH
+// REGARD TO THIS SOFTWARE INCLUDING ALL IMPLIED WARRANTIES OF MERCHANTABILITY
+// AND FITNESS. IN NO EVENT SHALL THE AUTHOR BE LIABLE FOR ANY SPECIAL, DIRECT,
+// INDIRECT, OR CONSEQUENTIAL DAMAGES OR ANY DAMAGES WHATSOEVER RESULTING FROM
+// LOSS OF USE, DATA OR PROFITS, WHETHER IN AN ACTION OF CONTRACT, NEGLIGENCE OR
+// OTHER TORTIOUS ACTION, ARISING OUT OF OR IN CONNECTION WITH THE USE OR
+// PERFORMANCE OF THIS SOFTWARE.
+
+package probo
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"go.gearno.de/kit/pg"
+	"go.probo.inc/probo/pkg/auditlog"
+	"go.probo.inc/probo/pkg/coredata"
+	"go.probo.inc/probo/pkg/gid"
+	"go.probo.inc/probo/pkg/validator"
+)
+
+type (
+	DeadlineReminderSettingsService struct {
+		svc *TenantService
+	}
+
+	UpdateDeadlineReminderSettingsRequest struct {
+		OrganizationID      gid.GID
+		Enabled             *bool
+		DaysBefore          *[]int
+		RemindOnDueDate     *bool
+		OverdueIntervalDays *int
+		EscalationGraceDays **int
+		SlackEnabled        *bool
+	}
+)
+
+func (r *UpdateDeadlineReminderSettingsRequest) Validate() error {
+	v := validator.New()
+
+	v.Check(r.OrganizationID, "organization_id", validator.Required(), validator.GID(coredata.OrganizationEntityType))
+	v.Check(r.DaysBefore, "days_before", validator.MaxItems(10))
+	v.CheckEach(r.DaysBefore, "days_before", func(index int, item any) {
+		v.Check(item, fmt.Sprintf("days_before[%d]", index), validator.Range(1, 365))
+	})
+	v.Check(r.OverdueIntervalDays, "overdue_interval_days", validator.Range(0, 365))
+	v.Check(r.EscalationGraceDays, "escalation_grace_days", validator.Range(0, 365))
+
+	return v.Error()
+}
+
+func (s DeadlineReminderSettingsService) GetByOrganizationID(
+	ctx context.Context,
+	organizationID gid.GID,
+) (*coredata.DeadlineReminderSettings, error) {
+	settings := &coredata.DeadlineReminderSettings{}
+
+	err := s.svc.pg.WithConn(
+		ctx,
+		func(conn pg.Conn) error {
+			return settings.LoadByOrganizationID(ctx, conn, s.svc.scope, organizationID)
+		},
+	)
+	if err != nil {
+		return nil, fmt.Errorf("cannot load deadline reminder settings: %w", err)
+	}
+
+	return settings, nil
+}
+
+// Update changes the reminder settings of an organization. The settings
+// are rescheduled to run immediately so that the change is applied to the
+// current deadlines.
+func (s DeadlineReminderSettingsService) Update(
+	ctx context.Context,
+	req UpdateDeadlineReminderSettingsRequest,
+) (*coredata.DeadlineReminderSettings, error) {
+	if err := req.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid request: %w", err)
+	}
+
+	settings := &coredata.DeadlineReminderSettings{}
+
+	err := s.svc.pg.WithTx(
+		ctx,
+		func(conn pg.Conn) error {
+			if err := settings.LoadByOrganizationID(ctx, conn, s.svc.scope, req.OrganizationID); err != nil {
+				return fmt.Errorf("cannot load deadline reminder settings: %w", err)
+			}
+
+			before := deadlineReminderSettingsAuditState(settings)
+			now := time.Now()
+
+			if req.Enabled != nil {
+				settings.Enabled = *req.Enabled
+			}
+
+			if req.DaysBefore != nil {
+				settings.DaysBefore = *req.DaysBefore
+			}
+
+			if req.RemindOnDueDate != nil {
+				settings.RemindOnDueDate = *req.RemindOnDueDate
+			}
+
+			if req.OverdueIntervalDays != nil {
+				settings.OverdueIntervalDays = *req.OverdueIntervalDays
+			}
+
+			if req.EscalationGraceDays != nil {
+				settings.EscalationGraceDays = *req.EscalationGraceDays
+			}
+
+			if req.SlackEnabled != nil {
+				settings.SlackEnabled = *req.SlackEnabled
+			}
+
+			settings.NextRunAt = now
+			settings.UpdatedAt = now
+
+			if err := settings.Update(ctx, conn, s.svc.scope); err != nil {
+				return fmt.Errorf("cannot update deadline reminder settings: %w", err)
+			}
+
+			if err := auditlog.Record(ctx, conn, s.svc.scope, settings.OrganizationID, ActionDeadlineReminderSettingsUpdate, settings.ID, before, deadlineReminderSettingsAuditState(settings)); err != nil {
+				return fmt.Errorf("cannot record audit log entry: %w", err)
+			}
+
+			return nil
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return settings, nil
+}
+
+func deadlineReminderSettingsAuditState(s *coredata.DeadlineReminderSettings) map[string]any {
+	return map[string]any{
+		"enabled":             s.Enabled,
+		"daysBefore":          s.DaysBefore,
+		"remindOnDueDate":     s.RemindOnDueDate,
+		"overdueIntervalDays": s.OverdueIntervalDays,
+		"escalationGraceDays": s.EscalationGraceDays,
+		"slackEnabled":        s.SlackEnabled,
+	}
+}
@@ -0,0 +1,342 @@
+// Copyright (c) 2025 Probo Inc <hello@getprobo.com>.
+//
+// Permission to use, copy, modify, and/or distribute this software for any
+// purpose with or without fee is hereby granted, provided that the above
+// copyright notice and this permission notice appear in all copies.
+//
+// THE SOFTWARE IS PROVIDED "AS IS" AND THE AUTHOR DISCLAIMS ALL WARRANTIES WITH
+// REGARD TO THIS SOFTWARE INCLUDING ALL IMPLIED WARRANTIES OF MERCHANTABILITY
+// AND FITNESS. IN NO EVENT SHALL THE AUTHOR BE LIABLE FOR ANY SPECIAL, DIRECT,
+// INDIRECT, OR CONSEQUENTIAL DAMAGES OR ANY DAMAGES WHATSOEVER RESULTING FROM
+// LOSS OF USE, DATA OR PROFITS, WHETHER IN AN ACTION OF CONTRACT, NEGLIGENCE OR
+// OTHER TORTIOUS ACTION, ARISING OUT OF OR IN CONNECTION WITH THE USE OR
+// PERFORMANCE OF THIS SOFTWARE.
+
+package probo
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"go.gearno.de/kit/pg"
+	"go.probo.inc/probo/packages/emails"
+	"go.probo.inc/probo/pkg/auditlog"
+	"go.probo.inc/probo/pkg/baseurl"
+	"go.probo.inc/probo/pkg/coredata"
+	"go.probo.inc/probo/pkg/gid"
+	"go.probo.inc/probo/pkg/validator"
+)
+
+type (
+	DecideDocumentVersionApprovalRequest struct {
+		DocumentVersionID gid.GID
+		IdentityID        gid.GID
+		Comment           *string
+	}
+
+	ErrDocumentVersionApprovalNotPending struct {
+		Stage int
+	}
+)
+
+func (e ErrDocumentVersionApprovalNotPending) Error() string {
+	if e.Stage == 0 {
+		return "document version is not waiting for approvals"
+	}
+
+	return fmt.Sprintf("document version is waiting for the approvals of stage %d", e.Stage)
+}
+
+func (r *DecideDocumentVersionApprovalRequest) Validate(commentRequired bool) error {
+	v := validator.New()
+
+	v.Check(r.DocumentVersionID, "document_version_id", validator.Required(), validator.GID(coredata.DocumentVersionEntityType))
+	if commentRequired {
+		v.Check(r.Comment, "comment", validator.Required(), validator.NotEmpty())
+	}
+	v.Check(r.Comment, "comment", validator.SafeText(ContentMaxLength))
+
+	return v.Error()
+}
+
+func checkApprovalStages(v *validator.Validator, approvalStages [][]gid.GID) {
+	v.CheckEach(approvalStages, "approval_stages", func(index int, item any) {
+		v.Check(item, fmt.Sprintf("approval_stages[%d]", index), validator.Required(), validator.NotEmpty())
+	})
+
+	for i, stage := range approvalStages {
+		for j, id := range stage {
+			v.Check(id, fmt.Sprintf("approval_stages[%d][%d]", i, j), validator.Required(), validator.GID(coredata.MembershipProfileEntityType))
+		}
+	}
+}
+
+// documentApproverStages returns the approvers of a document along with
+// their approval stage, starting at 1. When approvalStages is empty,
+// approverIDs all approve in a single stage. An approver listed in several
+// stages only approves in the first one.
+func documentApproverStages(approverIDs []gid.GID, approvalStages [][]gid.GID) ([]gid.GID, map[gid.GID]int) {
+	if len(approvalStages) == 0 {
+		approvalStages = [][]gid.GID{approverIDs}
+	}
+
+	var ids []gid.GID
+	stages := make(map[gid.GID]int)
+
+	for i, stage := range approvalStages {
+		for _, id := range stage {
+			if _, ok := stages[id]; ok {
+				continue
+			}
+
+			ids = append(ids, id)
+			stages[id] = i + 1
+		}
+	}
+
+	return ids, stages
+}
+
+func (s *DocumentService) ListVersionApprovals(
+	ctx context.Context,
+	documentVersionID gid.GID,
+) (coredata.DocumentVersionApprovers, error) {
+	var approvers coredata.DocumentVersionApprovers
+
+	err := s.svc.pg.WithConn(
+		ctx,
+		func(conn pg.Conn) error {
+			return approvers.LoadByDocumentVersionID(ctx, conn, s.svc.scope, documentVersionID)
+		},
+	)
+	if err != nil {
+		return nil, fmt.Errorf("cannot load document version approvers: %w", err)
+	}
+
+	return approvers, nil
+}
+
+// RequestVersionApproval starts a new approval round on a draft: every
+// previous decision is cleared and the approvers of the first stage are
+// notified.
+func (s *DocumentService) RequestVersionApproval(
+	ctx context.Context,
+	documentVersionID gid.GID,
+) (*coredata.DocumentVersion, error) {
+	documentVersion := &coredata.DocumentVersion{}
+
+	err := s.svc.pg.WithTx(
+		ctx,
+		func(tx pg.Conn) error {
+			now := time.Now()
+
+			if err := documentVersion.LoadByID(ctx, tx, s.svc.scope, documentVersionID); err != nil {
+				return fmt.Errorf("cannot load document version %q: %w", documentVersionID, err)
+			}
+
+			if documentVersion.Status != coredata.DocumentStatusDraft {
+				return fmt.Errorf("cannot request approval of published version")
+			}
+
+			approvers := &coredata.DocumentVersionApprovers{}
+			if err := approvers.ResetByDocumentVersionID(ctx, tx, s.svc.scope, documentVersion.ID, now); err != nil {
+				return fmt.Errorf("cannot reset document version approvers: %w", err)
+			}
+
+			if err := approvers.LoadByDocumentVersionID(ctx, tx, s.svc.scope, documentVersion.ID); err != nil {
+				return fmt.Errorf("cannot load document version approvers: %w", err)
+			}
+
+			if err := s.notifyPendingApproversInTx(ctx, tx, documentVersion, approvers); err != nil {
+				return fmt.Errorf("cannot notify approvers: %w", err)
+			}
+
+			if err := auditlog.Record(ctx, tx, s.svc.scope, documentVersion.OrganizationID, ActionDocumentVersionRequestApproval, documentVersion.ID, nil, nil); err != nil {
+				return fmt.Errorf("cannot record audit log entry: %w", err)
+			}
+
+			return nil
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return documentVersion, nil
+}
+
+func (s *DocumentService) ApproveVersion(
+	ctx context.Context,
+	req DecideDocumentVersionApprovalRequest,
+) (*coredata.DocumentVersionApprover, error) {
+	if err := req.Validate(false); err != nil {
+		return nil, err
+	}
+
+	return s.decideVersionApproval(ctx, req, coredata.DocumentVersionApprovalStateApproved, ActionDocumentVersionApprove)
+}
+
+// RejectVersion rejects a draft. The draft cannot be published until a new
+// approval round is requested or its content changes.
+func (s *DocumentService) RejectVersion(
+	ctx context.Context,
+	req DecideDocumentVersionApprovalRequest,
+) (*coredata.DocumentVersionApprover, error) {
+	if err := req.Validate(true); err != nil {
+		return nil, err
+	}
+
+	return s.decideVersionApproval(ctx, req, coredata.DocumentVersionApprovalStateRejected, ActionDocumentVersionReject)
+}
+
+func (s *DocumentService) decideVersionApproval(
+	ctx context.Context,
+	req DecideDocumentVersionApprovalRequest,
+	state coredata.DocumentVersionApprovalState,
+	action string,
+) (*coredata.DocumentVersionApprover, error) {
+	approver := &coredata.DocumentVersionApprover{}
+
+	err := s.svc.pg.WithTx(
+		ctx,
+		func(tx pg.Conn) error {
+			now := time.Now()
+
+			// Lock the version so concurrent decisions in the same stage
+			// see each other and exactly one of them advances the stage.
+			documentVersion := &coredata.DocumentVersion{}
+			if err := documentVersion.LoadByIDForUpdate(ctx, tx, s.svc.scope, req.DocumentVersionID); err != nil {
+				return fmt.Errorf("cannot load document version %q: %w", req.DocumentVersionID, err)
+			}
+
+			if documentVersion.Status != coredata.DocumentStatusDraft {
+				return fmt.Errorf("cannot decide on published version")
+			}
+
+			profile := &coredata.MembershipProfile{}
+			if err := profile.LoadByIdentityIDAndOrganizationID(ctx, tx, s.svc.scope, req.IdentityID, documentVersion.OrganizationID); err != nil {
+				return fmt.Errorf("cannot load approver profile: %w", err)
+			}
+
+			if err := approver.LoadByDocumentVersionIDAndApproverProfileIDForUpdate(ctx, tx, s.svc.scope, documentVersion.ID, profile.ID); err != nil {
+				return fmt.Errorf("cannot load document version approver: %w", err)
+			}
+
+			approvers := &coredata.DocumentVersionApprovers{}
+			if err := approvers.LoadByDocumentVersionID(ctx, tx, s.svc.scope, documentVersion.ID); err != nil {
+				return fmt.Errorf("cannot load document version approvers: %w", err)
+			}
+
+			pendingStage := approvers.PendingStage()
+			if approvers.Rejected() || approver.State != coredata.DocumentVersionApprovalStatePending || approver.Stage != pendingStage {
+				return &ErrDocumentVersionApprovalNotPending{Stage: pendingStage}
+			}
+
+			before := documentVersionApprovalAuditState(approver)
+
+			approver.State = state
+			approver.Comment = req.Comment
+			approver.DecidedAt = &now
+			approver.UpdatedAt = now
+
+			if err := approver.Update(ctx, tx, s.svc.scope); err != nil {
+				return fmt.Errorf("cannot update document version approver: %w", err)
+			}
+
+			if err := auditlog.Record(ctx, tx, s.svc.scope, documentVersion.OrganizationID, action, documentVersion.ID, before, documentVersionApprovalAuditState(approver)); err != nil {
+				return fmt.Errorf("cannot record audit log entry: %w", err)
+			}
+
+			if state != coredata.DocumentVersionApprovalStateApproved {
+				return nil
+			}
+
+			if err := approvers.LoadByDocumentVersionID(ctx, tx, s.svc.scope, documentVersion.ID); err != nil {
+				return fmt.Errorf("cannot load document version approvers: %w", err)
+			}
+
+			if approvers.PendingStage() == pendingStage {
+				return nil
+			}
+
+			if err := s.notifyPendingApproversInTx(ctx, tx, documentVersion, approvers); err != nil {
+				return fmt.Errorf("cannot notify approvers: %w", err)
+			}
+
+			return nil
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return approver, nil
+}
+
+// notifyPendingApproversInTx emails the approvers of the first stage still
+// waiting for decisions.
+func (s *DocumentService) notifyPendingApproversInTx(
+	ctx context.Context,
+	tx pg.Conn,
+	documentVersion *coredata.DocumentVersion,
+	approvers *coredata.DocumentVersionApprovers,
+) error {
+	stage := approvers.PendingStage()
+	if stage == 0 {
+		return nil
+	}
+
+	organization := &coredata.Organization{}
+	if err := organization.LoadByID(ctx, tx, s.svc.scope, documentVersion.OrganizationID); err != nil {
+		return fmt.Errorf("cannot load organization: %w", err)
+	}
+
+	profiles := coredata.MembershipProfiles{}
+	if err := profiles.LoadByIDs(ctx, tx, s.svc.scope, approvers.PendingApproverProfileIDs(stage)); err != nil {
+		return fmt.Errorf("cannot load approver profiles: %w", err)
+	}
+
+	documentURL := baseurl.MustParse(s.svc.baseURL).
+		AppendPath(fmt.Sprintf("/organizations/%s/documents/%s", organization.ID, documentVersion.DocumentID)).
+		MustString()
+
+	for _, profile := range profiles {
+		emailPresenter := emails.NewPresenter(s.svc.fileManager, s.svc.bucket, s.svc.baseURL, profile.FullName)
+
+		subject, textBody, htmlBody, err := emailPresenter.RenderDocumentApproval(
+			ctx,
+			documentURL,
+			documentVersion.Title,
+			organization.Name,
+		)
+		if err != nil {
+			return fmt.Errorf("cannot render document approval email: %w", err)
+		}
+
+		email := coredata.NewEmail(
+			profile.FullName,
+			profile.EmailAddress,
+			subject,
+			textBody,
+			htmlBody,
+		)
+		email.OrganizationID = &organization.ID
+
+		if err := email.Insert(ctx, tx); err != nil {
+			return fmt.Errorf("cannot insert email: %w", err)
+		}
+	}
+
+	return nil
+}
+
+func documentVersionApprovalAuditState(approver *coredata.DocumentVersionApprover) map[string]any {
+	return map[string]any{
+		"approverProfileId": approver.ApproverProfileID,
+		"stage":             approver.Stage,
+		"state":             approver.State,
+		"comment":           approver.Comment,
+		"decidedAt":         approver.DecidedAt,
+	}
+}
@@ -0,0 +1,135 @@
+// Copyright (c) 2025 Probo Inc <hello@getprobo.com>.
+//
+// Permission to use, copy, modify, and/or distribute this software for any
+// purpose with or without fee is hereby granted, provided that the above
+// copyright notice and this permission notice appear in all copies.
+//
+// THE SOFTWARE IS PROVIDED "AS IS" AND THE AUTHOR DISCLAIMS ALL WARRANTIES WITH
+// REGARD TO THIS SOFTWARE INCLUDING ALL IMPLIED WARRANTIES OF MERCHANTABILITY
+// AND FITNESS. IN NO EVENT SHALL THE AUTHOR BE LIABLE FOR ANY SPECIAL, DIRECT,
+// INDIRECT, OR CONSEQUENTIAL DAMAGES OR ANY DAMAGES WHATSOEVER RESULTING FROM
+// LOSS OF USE, DATA OR PROFITS, WHETHER IN AN ACTION OF CONTRACT, NEGLIGENCE OR
+// OTHER TORTIOUS ACTION, ARISING OUT OF OR IN CONNECTION WITH THE USE OR
+// PERFORMANCE OF THIS SOFTWARE.
+
+package probo
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.probo.inc/probo/pkg/coredata"
+	"go.probo.inc/probo/pkg/gid"
+)
+
+func TestDocumentApproverStages(t *testing.T) {
+	tenantID := gid.NewTenantID()
+	alice := gid.New(tenantID, coredata.MembershipProfileEntityType)
+	bob := gid.New(tenantID, coredata.MembershipProfileEntityType)
+	carol := gid.New(tenantID, coredata.MembershipProfileEntityType)
+
+	tests := []struct {
+		name           string
+		approverIDs    []gid.GID
+		approvalStages [][]gid.GID
+		wantIDs        []gid.GID
+		wantStages     map[gid.GID]int
+	}{
+		{
+			name:       "no approver",
+			wantStages: map[gid.GID]int{},
+		},
+		{
+			name:        "approvers without stages approve together",
+			approverIDs: []gid.GID{alice, bob},
+			wantIDs:     []gid.GID{alice, bob},
+			wantStages:  map[gid.GID]int{alice: 1, bob: 1},
+		},
+		{
+			name:           "stages take precedence over approvers",
+			approverIDs:    []gid.GID{carol},
+			approvalStages: [][]gid.GID{{alice}, {bob, carol}},
+			wantIDs:        []gid.GID{alice, bob, carol},
+			wantStages:     map[gid.GID]int{alice: 1, bob: 2, carol: 2},
+		},
+		{
+			name:           "approver in several stages approves in the first",
+			approvalStages: [][]gid.GID{{alice, bob}, {bob, carol}, {alice}},
+			wantIDs:        []gid.GID{alice, bob, carol},
+			wantStages:     map[gid.GID]int{alice: 1, bob: 1, carol: 2},
+		},
+		{
+			name:        "duplicate approvers are kept once",
+			approverIDs: []gid.GID{alice, alice},
+			wantIDs:     []gid.GID{alice},
+			wantStages:  map[gid.GID]int{alice: 1},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ids, stages := documentApproverStages(tt.approverIDs, tt.approvalStages)
+
+			assert.Equal(t, tt.wantIDs, ids)
+			assert.Equal(t, tt.wantStages, stages)
+		})
+	}
+}
+
+func TestCheckDocumentVersionPublishable(t *testing.T) {
+	approver := func(state coredata.DocumentVersionApprovalState) *coredata.DocumentVersionApprover {
+		return &coredata.DocumentVersionApprover{Stage: 1, State: state}
+	}
+
+	tests := []struct {
+		name            string
+		status          coredata.DocumentStatus
+		approvers       coredata.DocumentVersionApprovers
+		wantErr         bool
+		wantNotApproved bool
+	}{
+		{
+			name:   "draft without approver",
+			status: coredata.DocumentStatusDraft,
+		},
+		{
+			name:      "draft approved",
+			status:    coredata.DocumentStatusDraft,
+			approvers: coredata.DocumentVersionApprovers{approver(coredata.DocumentVersionApprovalStateApproved)},
+		},
+		{
+			name:            "draft pending approval",
+			status:          coredata.DocumentStatusDraft,
+			approvers:       coredata.DocumentVersionApprovers{approver(coredata.DocumentVersionApprovalStateApproved), approver(coredata.DocumentVersionApprovalStatePending)},
+			wantErr:         true,
+			wantNotApproved: true,
+		},
+		{
+			name:            "draft rejected",
+			status:          coredata.DocumentStatusDraft,
+			approvers:       coredata.DocumentVersionApprovers{approver(coredata.DocumentVersionApprovalStateRejected)},
+			wantErr:         true,
+			wantNotApproved: true,
+		},
+		{
+			name:    "already published",
+			status:  coredata.DocumentStatusPublished,
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := checkDocumentVersionPublishable(&coredata.DocumentVersion{Status: tt.status}, &tt.approvers)
+			if !tt.wantErr {
+				assert.NoError(t, err)
+				return
+			}
+
+			var errNotApproved *ErrDocumentVersionNotApproved
+			assert.Error(t, err)
+			assert.Equal(t, tt.wantNotApproved, errors.As(err, &errNotApproved))
+		})
+	}
+}
@@ -0,0 +1,183 @@
+// Copyright (c) 2025 Probo Inc <hello@getprobo.com>.
+//
+// Permission to use, copy, modify, and/or distribute this software for any
+// purpose with or without fee is hereby granted, provided that the above
+// copyright notice and this permission notice appear in all copies.
+//
+// THE SOFTWARE IS PROVIDED "AS IS" AND THE AUTHOR DISCLAIMS ALL WARRANTIES WITH
+// REGARD TO THIS SOFTWARE INCLUDING ALL IMPLIED WARRANTIES OF MERCHANTABILITY
+// AND FITNESS. IN NO EVENT SHALL THE AUTHOR BE LIABLE FOR ANY SPECIAL, DIRECT,
+// INDIRECT, OR CONSEQUENTIAL DAMAGES OR ANY DAMAGES WHATSOEVER RESULTING FROM
+// LOSS OF USE, DATA OR PROFITS, WHETHER IN AN ACTION OF CONTRACT, NEGLIGENCE OR
+// OTHER TORTIOUS ACTION, ARISING OUT OF OR IN CONNECTION WITH THE USE OR
+// PERFORMANCE OF THIS SOFTWARE.
+
+package probo
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"time"
+
+	"go.gearno.de/kit/pg"
+	"go.probo.inc/probo/pkg/coredata"
+	"go.probo.inc/probo/pkg/docdiff"
+	"go.probo.inc/probo/pkg/docgen"
+	"go.probo.inc/probo/pkg/gid"
+	"go.probo.inc/probo/pkg/html2pdf"
+)
+
+type (
+	// DocumentVersionDiff is the difference between the content of two
+	// versions of the same document.
+	DocumentVersionDiff struct {
+		From   *coredata.DocumentVersion
+		To     *coredata.DocumentVersion
+		Blocks []docdiff.Block
+	}
+
+	ErrDocumentVersionsMismatch struct{}
+)
+
+func (e ErrDocumentVersionsMismatch) Error() string {
+	return "document versions do not belong to the same document"
+}
+
+// DiffVersions compares the content of two versions of the document.
+// Versions of another document are reported as not found.
+func (s *DocumentService) DiffVersions(
+	ctx context.Context,
+	documentID gid.GID,
+	fromDocumentVersionID gid.GID,
+	toDocumentVersionID gid.GID,
+) (*DocumentVersionDiff, error) {
+	var diff *DocumentVersionDiff
+
+	err := s.svc.pg.WithConn(
+		ctx,
+		func(conn pg.Conn) (err error) {
+			diff, err = s.diffVersions(ctx, conn, fromDocumentVersionID, toDocumentVersionID)
+			if err != nil {
+				return err
+			}
+
+			if diff.From.DocumentID != documentID || diff.To.DocumentID != documentID {
+				return coredata.ErrResourceNotFound
+			}
+
+			return nil
+		},
+	)
+
+	if err != nil {
+		return nil, fmt.Errorf("cannot diff document versions: %w", err)
+	}
+
+	return diff, nil
+}
+
+// ExportVersionDiffPDF renders the changes between two versions of the
+// same document as a redline PDF.
+func (s *DocumentService) ExportVersionDiffPDF(
+	ctx context.Context,
+	fromDocumentVersionID gid.GID,
+	toDocumentVersionID gid.GID,
+) ([]byte, error) {
+	var data []byte
+
+	err := s.svc.pg.WithConn(
+		ctx,
+		func(conn pg.Conn) error {
+			diff, err := s.diffVersions(ctx, conn, fromDocumentVersionID, toDocumentVersionID)
+			if err != nil {
+				return err
+			}
+
+			organization := &coredata.Organization{}
+			if err := organization.LoadByID(ctx, conn, s.svc.scope, diff.To.OrganizationID); err != nil {
+				return fmt.Errorf("cannot load organization: %w", err)
+			}
+
+			diffData := docgen.DocumentDiffData{
+				Title:            diff.To.Title,
+				OrganizationName: organization.Name,
+				FromVersion:      diff.From.VersionNumber,
+				ToVersion:        diff.To.VersionNumber,
+				GeneratedAt:      time.Now(),
+				Blocks:           diff.Blocks,
+			}
+
+			if organization.HorizontalLogoFileID != nil {
+				fileRecord := &coredata.File{}
+				if err := fileRecord.LoadByID(ctx, conn, s.svc.scope, *organization.HorizontalLogoFileID); err == nil {
+					base64Data, mimeType, err := s.svc.fileManager.GetFileBase64(ctx, fileRecord)
+					if err == nil {
+						diffData.CompanyHorizontalLogoBase64 = fmt.Sprintf("data:%s;base64,%s", mimeType, base64Data)
+					}
+				}
+			}
+
+			htmlContent, err := docgen.RenderDocumentDiffHTML(diffData)
+			if err != nil {
+				return fmt.Errorf("cannot generate HTML: %w", err)
+			}
+
+			cfg := html2pdf.RenderConfig{
+				PageFormat:      html2pdf.PageFormatA4,
+				Orientation:     html2pdf.OrientationPortrait,
+				MarginTop:       html2pdf.NewMarginInches(1.0),
+				MarginBottom:    html2pdf.NewMarginInches(1.0),
+				MarginLeft:      html2pdf.NewMarginInches(1.0),
+				MarginRight:     html2pdf.NewMarginInches(1.0),
+				PrintBackground: true,
+				Scale:           1.0,
+			}
+
+			pdfReader, err := s.html2pdfConverter.GeneratePDF(ctx, htmlContent, cfg)
+			if err != nil {
+				return fmt.Errorf("cannot generate PDF: %w", err)
+			}
+
+			data, err = io.ReadAll(pdfReader)
+			if err != nil {
+				return fmt.Errorf("cannot read PDF data: %w", err)
+			}
+
+			return nil
+		},
+	)
+
+	if err != nil {
+		return nil, fmt.Errorf("cannot export document version diff PDF: %w", err)
+	}
+
+	return data, nil
+}
+
+func (s *DocumentService) diffVersions(
+	ctx context.Context,
+	conn pg.Conn,
+	fromDocumentVersionID gid.GID,
+	toDocumentVersionID gid.GID,
+) (*DocumentVersionDiff, error) {
+	from := &coredata.DocumentVersion{}
+	if err := from.LoadByID(ctx, conn, s.svc.scope, fromDocumentVersionID); err != nil {
+		return nil, fmt.Errorf("cannot load document version: %w", err)
+	}
+
+	to := &coredata.DocumentVersion{}
+	if err := to.LoadByID(ctx, conn, s.svc.scope, toDocumentVersionID); err != nil {
+		return nil, fmt.Errorf("cannot load document version: %w", err)
+	}
+
+	if from.DocumentID != to.DocumentID {
+		return nil, &ErrDocumentVersionsMismatch{}
+	}
+
+	return &DocumentVersionDiff{
+		From:   from,
+		To:     to,
+		Blocks: docdiff.Diff(from.Content, to.Content),
+	}, nil
+}
@@ -0,0 +1,80 @@
+// Copyright (c) 2025 Probo Inc <hello@getprobo.com>.
+//
+// Permission to use, copy, modify, and/or distribute this software for any
+// purpose with or without fee is hereby granted, provided that the above
+// copyright notice and this permission notice appear in all copies.
+//
+// THE SOFTWARE IS PROVIDED "AS IS" AND THE AUTHOR DISCLAIMS ALL WARRANTIES WITH
+// REGARD TO THIS SOFTWARE INCLUDING ALL IMPLIED WARRANTIES OF MERCHANTABILITY
+// AND FITNESS. IN NO EVENT SHALL THE AUTHOR BE LIABLE FOR ANY SPECIAL, DIRECT,
+// INDIRECT, OR CONSEQUENTIAL DAMAGES OR ANY DAMAGES WHATSOEVER RESULTING FROM
+// LOSS OF USE, DATA OR PROFITS, WHETHER IN AN ACTION OF CONTRACT, NEGLIGENCE OR
+// OTHER TORTIOUS ACTION, ARISING OUT OF OR IN CONNECTION WITH THE USE OR
+// PERFORMANCE OF THIS SOFTWARE.
+
+package probo
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"go.gearno.de/kit/pg"
+	"go.probo.inc/probo/pkg/coredata"
+	"go.probo.inc/probo/pkg/gid"
+)
+
+// nextDocumentReviewDate returns when the document is due for review
+// according to its review interval, counted from its last review or from
+// now when it was never reviewed. It returns nil when the document has no
+// review interval.
+func nextDocumentReviewDate(document *coredata.Document, now time.Time) *time.Time {
+	if document.ReviewIntervalDays == nil {
+		return nil
+	}
+
+	reviewedAt := now
+	if document.LastReviewedAt != nil {
+		reviewedAt = *document.LastReviewedAt
+	}
+
+	next := reviewedAt.AddDate(0, 0, *document.ReviewIntervalDays)
+
+	return &next
+}
+
+// MarkReviewed records that the document was reviewed without changes and
+// schedules its next review.
+func (s *DocumentService) MarkReviewed(
+	ctx context.Context,
+	documentID gid.GID,
+) (*coredata.Document, error) {
+	document := &coredata.Document{}
+
+	err := s.svc.pg.WithTx(
+		ctx,
+		func(tx pg.Conn) error {
+			if err := document.LoadByID(ctx, tx, s.svc.scope, documentID); err != nil {
+				return fmt.Errorf("cannot load document %q: %w", documentID, err)
+			}
+
+			now := time.Now()
+
+			document.LastReviewedAt = &now
+			document.NextReviewDate = nextDocumentReviewDate(document, now)
+			document.UpdatedAt = now
+
+			if err := document.Update(ctx, tx, s.svc.scope); err != nil {
+				return fmt.Errorf("cannot update document: %w", err)
+			}
+
+			return nil
+		},
+	)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return document, nil
+}
@@ -3,6 +3,7 @@ package probo
 import (
 	"archive/zip"
 	"context"
+	"crypto/sha256"
 	"encoding/json"
 	"fmt"
 	"io"
@@ -18,6 +19,7 @@ import (
 	"go.gearno.de/kit/pg"
 	"go.gearno.de/x/ref"
 	"go.probo.inc/probo/packages/emails"
+	"go.probo.inc/probo/pkg/auditlog"
 	"go.probo.inc/probo/pkg/coredata"
 	"go.probo.inc/probo/pkg/docgen"
 	"go.probo.inc/probo/pkg/gid"
@@ -27,6 +29,8 @@ import (
 	"go.probo.inc/probo/pkg/statelesstoken"
 	"go.probo.inc/probo/pkg/validator"
 	"go.probo.inc/probo/pkg/watermarkpdf"
+	"go.probo.inc/probo/pkg/webhook"
+	webhooktypes "go.probo.inc/probo/pkg/webhook/types"
 )
 
 type (
@@ -46,11 +50,18 @@ type (
 	ErrDocumentVersionSignatureAlreadySigned struct {
 	}
 
+	ErrDocumentVersionNotApproved struct {
+	}
+
+	// CreateDocumentRequest creates a document approved by ApproverIDs in a
+	// single stage, or by ApprovalStages one stage after the other when
+	// set.
 	CreateDocumentRequest struct {
 		OrganizationID        gid.GID
 		Title                 string
 		Content               string
 		ApproverIDs           []gid.GID
+		ApprovalStages        [][]gid.GID
 		Classification        coredata.DocumentClassification
 		DocumentType          coredata.DocumentType
 		TrustCenterVisibility *coredata.TrustCenterVisibility
@@ -60,9 +71,12 @@ type (
 		DocumentID            gid.GID
 		Title                 *string
 		ApproverIDs           []gid.GID
+		ApprovalStages        [][]gid.GID
 		Classification        *coredata.DocumentClassification
 		DocumentType          *coredata.DocumentType
 		TrustCenterVisibility *coredata.TrustCenterVisibility
+		OwnerID               **gid.GID
+		ReviewIntervalDays    **int
 	}
 
 	UpdateDocumentVersionRequest struct {
@@ -102,10 +116,13 @@ func (cdr *CreateDocumentRequest) Validate() error {
 	v.Check(cdr.OrganizationID, "organization_id", validator.Required(), validator.GID(coredata.OrganizationEntityType))
 	v.Check(cdr.Title, "title", validator.Required(), validator.SafeTextNoNewLine(TitleMaxLength))
 	v.Check(cdr.Content, "content", validator.Required(), validator.NotEmpty(), validator.MaxLen(documentMaxLength))
-	v.Check(cdr.ApproverIDs, "approver_ids", validator.Required(), validator.NotEmpty())
+	if len(cdr.ApprovalStages) == 0 {
+		v.Check(cdr.ApproverIDs, "approver_ids", validator.Required(), validator.NotEmpty())
+	}
 	for _, id := range cdr.ApproverIDs {
 		v.Check(id, "approver_ids", validator.Required(), validator.GID(coredata.MembershipProfileEntityType))
 	}
+	checkApprovalStages(v, cdr.ApprovalStages)
 	v.Check(cdr.Classification, "classification", validator.Required(), validator.OneOfSlice(coredata.DocumentClassifications()))
 	v.Check(cdr.DocumentType, "document_type", validator.Required(), validator.OneOfSlice(coredata.DocumentTypes()))
 	v.Check(cdr.TrustCenterVisibility, "trust_center_visibility", validator.OneOfSlice(coredata.TrustCenterVisibilities()))
@@ -121,9 +138,12 @@ func (udr *UpdateDocumentRequest) Validate() error {
 	for _, id := range udr.ApproverIDs {
 		v.Check(id, "approver_ids", validator.Required(), validator.GID(coredata.MembershipProfileEntityType))
 	}
+	checkApprovalStages(v, udr.ApprovalStages)
 	v.Check(udr.Classification, "classification", validator.OneOfSlice(coredata.DocumentClassifications()))
 	v.Check(udr.DocumentType, "document_type", validator.OneOfSlice(coredata.DocumentTypes()))
 	v.Check(udr.TrustCenterVisibility, "trust_center_visibility", validator.OneOfSlice(coredata.TrustCenterVisibilities()))
+	v.Check(udr.OwnerID, "owner_id", validator.GID(coredata.MembershipProfileEntityType))
+	v.Check(udr.ReviewIntervalDays, "review_interval_days", validator.Range(1, 3650))
 
 	return v.Error()
 }
@@ -162,6 +182,10 @@ func (e ErrDocumentVersionSignatureAlreadySigned) Error() string {
 	return "document version signature already signed"
 }
 
+func (e ErrDocumentVersionNotApproved) Error() string {
+	return "document version is not approved by all its approvers"
+}
+
 func (s *DocumentService) Get(
 	ctx context.Context,
 	documentID gid.GID,
@@ -452,10 +476,17 @@ func (s *DocumentService) publishVersionInTx(
 		return document, documentVersion, nil
 	}
 
-	if documentVersion.Status != coredata.DocumentStatusDraft {
-		return nil, nil, fmt.Errorf("cannot publish version")
+	approvers := &coredata.DocumentVersionApprovers{}
+	if err := approvers.LoadByDocumentVersionID(ctx, tx, s.svc.scope, documentVersion.ID); err != nil {
+		return nil, nil, fmt.Errorf("cannot load document version approvers: %w", err)
+	}
+
+	if err := checkDocumentVersionPublishable(documentVersion, approvers); err != nil {
+		return nil, nil, err
 	}
 
+	before := webhooktypes.NewDocumentVersion(documentVersion)
+
 	if document.CurrentPublishedVersion != nil {
 		if err := publishedVersion.LoadByDocumentIDAndVersionNumber(ctx, tx, s.svc.scope, documentID, *document.CurrentPublishedVersion); err != nil {
 			return nil, nil, fmt.Errorf("cannot load published version: %w", err)
@@ -471,6 +502,8 @@ func (s *DocumentService) publishVersionInTx(
 	}
 
 	document.CurrentPublishedVersion = &documentVersion.VersionNumber
+	document.LastReviewedAt = &now
+	document.NextReviewDate = nextDocumentReviewDate(document, now)
 	document.UpdatedAt = now
 
 	documentVersion.Status = coredata.DocumentStatusPublished
@@ -485,9 +518,34 @@ func (s *DocumentService) publishVersionInTx(
 		return nil, nil, fmt.Errorf("cannot update document version: %w", err)
 	}
 
+	if err := webhook.InsertData(ctx, tx, s.svc.scope, documentVersion.OrganizationID, coredata.WebhookEventTypeDocumentVersionPublished, webhooktypes.NewDocumentVersion(documentVersion)); err != nil {
+		return nil, nil, fmt.Errorf("cannot insert webhook event: %w", err)
+	}
+
+	if err := auditlog.Record(ctx, tx, s.svc.scope, documentVersion.OrganizationID, ActionDocumentVersionPublish, documentVersion.ID, before, webhooktypes.NewDocumentVersion(documentVersion)); err != nil {
+		return nil, nil, fmt.Errorf("cannot record audit log entry: %w", err)
+	}
+
 	return document, documentVersion, nil
 }
 
+// checkDocumentVersionPublishable returns why a document version cannot
+// be published: only drafts every approver approved can be.
+func checkDocumentVersionPublishable(
+	documentVersion *coredata.DocumentVersion,
+	approvers *coredata.DocumentVersionApprovers,
+) error {
+	if documentVersion.Status != coredata.DocumentStatusDraft {
+		return fmt.Errorf("cannot publish version")
+	}
+
+	if !approvers.Approved() {
+		return &ErrDocumentVersionNotApproved{}
+	}
+
+	return nil
+}
+
 func (s *DocumentService) Create(
 	ctx context.Context,
 	req CreateDocumentRequest,
@@ -528,6 +586,8 @@ func (s *DocumentService) Create(
 		UpdatedAt:      now,
 	}
 
+	approverIDs, approverStages := documentApproverStages(req.ApproverIDs, req.ApprovalStages)
+
 	err := s.svc.pg.WithTx(
 		ctx,
 		func(conn pg.Conn) error {
@@ -537,11 +597,11 @@ func (s *DocumentService) Create(
 
 			// Validate all approver profiles exist
 			approverProfiles := coredata.MembershipProfiles{}
-			if err := approverProfiles.LoadByIDs(ctx, conn, s.svc.scope, req.ApproverIDs); err != nil {
+			if err := approverProfiles.LoadByIDs(ctx, conn, s.svc.scope, approverIDs); err != nil {
 				return fmt.Errorf("cannot load approver profiles: %w", err)
 			}
 
-			if len(approverProfiles) != len(req.ApproverIDs) {
+			if len(approverProfiles) != len(approverIDs) {
 				return fmt.Errorf("one or more approver profiles not found")
 			}
 
@@ -551,11 +611,16 @@ func (s *DocumentService) Create(
 				return fmt.Errorf("cannot insert document: %w", err)
 			}
 
+			if err := auditlog.Record(ctx, conn, s.svc.scope, document.OrganizationID, ActionDocumentCreate, document.ID, nil, documentAuditState(document)); err != nil {
+				return fmt.Errorf("cannot record audit log entry: %w", err)
+			}
+
 			// Insert document approvers
-			for _, approverID := range req.ApproverIDs {
+			for _, approverID := range approverIDs {
 				da := coredata.DocumentApprover{
 					DocumentID:        documentID,
 					ApproverProfileID: approverID,
+					Stage:             approverStages[approverID],
 					OrganizationID:    organization.ID,
 					CreatedAt:         now,
 				}
@@ -571,12 +636,15 @@ func (s *DocumentService) Create(
 			}
 
 			// Insert document version approvers
-			for _, approverID := range req.ApproverIDs {
+			for _, approverID := range approverIDs {
 				dva := coredata.DocumentVersionApprover{
 					DocumentVersionID: documentVersionID,
 					ApproverProfileID: approverID,
+					Stage:             approverStages[approverID],
+					State:             coredata.DocumentVersionApprovalStatePending,
 					OrganizationID:    organization.ID,
 					CreatedAt:         now,
+					UpdatedAt:         now,
 				}
 				if err := dva.Insert(ctx, conn, s.svc.scope); err != nil {
 					return fmt.Errorf("cannot insert document version approver: %w", err)
@@ -664,51 +732,61 @@ func (s *DocumentService) SendSigningNotifications(
 				return fmt.Errorf("cannot load organization: %w", err)
 			}
 
-			for _, signatory := range signatories {
-				token, err := statelesstoken.NewToken(
-					s.svc.tokenSecret,
-					TokenTypeSigningRequest,
-					time.Hour*24*30,
-					SigningRequestData{
-						OrganizationID: organizationID,
-						PeopleID:       signatory.ID,
-					},
-				)
-				if err != nil {
-					return fmt.Errorf("cannot create signing request token: %w", err)
-				}
+			return s.sendSigningNotificationsInTx(ctx, tx, organization, signatories)
+		},
+	)
 
-				emailPresenter := emails.NewPresenter(s.svc.fileManager, s.svc.bucket, s.svc.baseURL, signatory.FullName)
+	if err != nil {
+		return fmt.Errorf("cannot send signing notifications: %w", err)
+	}
 
-				subject, textBody, htmlBody, err := emailPresenter.RenderDocumentSigning(
-					ctx,
-					"/documents/signing-requests",
-					token,
-					organization.Name,
-				)
-				if err != nil {
-					return fmt.Errorf("cannot render signing request email: %w", err)
-				}
+	return nil
+}
 
-				email := coredata.NewEmail(
-					signatory.FullName,
-					signatory.EmailAddress,
-					subject,
-					textBody,
-					htmlBody,
-				)
+func (s *DocumentService) sendSigningNotificationsInTx(
+	ctx context.Context,
+	tx pg.Conn,
+	organization *coredata.Organization,
+	signatories coredata.MembershipProfiles,
+) error {
+	for _, signatory := range signatories {
+		token, err := statelesstoken.NewToken(
+			s.svc.tokenSecret,
+			TokenTypeSigningRequest,
+			time.Hour*24*30,
+			SigningRequestData{
+				OrganizationID: organization.ID,
+				PeopleID:       signatory.ID,
+			},
+		)
+		if err != nil {
+			return fmt.Errorf("cannot create signing request token: %w", err)
+		}
 
-				if err := email.Insert(ctx, tx); err != nil {
-					return fmt.Errorf("cannot insert email: %w", err)
-				}
-			}
+		emailPresenter := emails.NewPresenter(s.svc.fileManager, s.svc.bucket, s.svc.baseURL, signatory.FullName)
 
-			return nil
-		},
-	)
+		subject, textBody, htmlBody, err := emailPresenter.RenderDocumentSigning(
+			ctx,
+			"/documents/signing-requests",
+			token,
+			organization.Name,
+		)
+		if err != nil {
+			return fmt.Errorf("cannot render signing request email: %w", err)
+		}
 
-	if err != nil {
-		return fmt.Errorf("cannot send signing notifications: %w", err)
+		email := coredata.NewEmail(
+			signatory.FullName,
+			signatory.EmailAddress,
+			subject,
+			textBody,
+			htmlBody,
+		)
+		email.OrganizationID = &organization.ID
+
+		if err := email.Insert(ctx, tx); err != nil {
+			return fmt.Errorf("cannot insert email: %w", err)
+		}
 	}
 
 	return nil
@@ -718,12 +796,13 @@ func (s *DocumentService) SignDocumentVersion(
 	ctx context.Context,
 	documentVersionID gid.GID,
 	signatory gid.GID,
+	metadata SignatureMetadata,
 ) error {
 	err := s.svc.pg.WithTx(
 		ctx,
 		func(conn pg.Conn) error {
 			var err error
-			_, err = s.signDocumentVersionInTx(ctx, conn, documentVersionID, signatory)
+			_, err = s.signDocumentVersionInTx(ctx, conn, documentVersionID, signatory, metadata)
 			if err != nil {
 				return fmt.Errorf("cannot sign document version: %w", err)
 			}
@@ -743,6 +822,7 @@ func (s *DocumentService) SignDocumentVersionByIdentity(
 	ctx context.Context,
 	documentVersionID gid.GID,
 	identityID gid.GID,
+	metadata SignatureMetadata,
 ) (*coredata.DocumentVersionSignature, error) {
 	var documentVersionSignature *coredata.DocumentVersionSignature
 
@@ -761,7 +841,7 @@ func (s *DocumentService) SignDocumentVersionByIdentity(
 			}
 
 			var signErr error
-			documentVersionSignature, signErr = s.signDocumentVersionInTx(ctx, conn, documentVersionID, profile.ID)
+			documentVersionSignature, signErr = s.signDocumentVersionInTx(ctx, conn, documentVersionID, profile.ID, metadata)
 			return signErr
 		},
 	)
@@ -778,12 +858,15 @@ func (s *DocumentService) signDocumentVersionInTx(
 	conn pg.Conn,
 	documentVersionID gid.GID,
 	signatory gid.GID,
+	metadata SignatureMetadata,
 ) (*coredata.DocumentVersionSignature, error) {
 	documentVersion := &coredata.DocumentVersion{}
 	documentVersionSignature := &coredata.DocumentVersionSignature{}
 	now := time.Now()
 
-	if err := documentVersion.LoadByID(ctx, conn, s.svc.scope, documentVersionID); err != nil {
+	// The lock serializes the signatures of the version so each proof is
+	// chained to the previous one.
+	if err := documentVersion.LoadByIDForUpdate(ctx, conn, s.svc.scope, documentVersionID); err != nil {
 		return nil, fmt.Errorf("cannot load document version %q: %w", documentVersionID, err)
 	}
 
@@ -799,6 +882,8 @@ func (s *DocumentService) signDocumentVersionInTx(
 		return nil, &ErrDocumentVersionSignatureAlreadySigned{}
 	}
 
+	before := webhooktypes.NewDocumentVersionSignature(documentVersionSignature)
+
 	documentVersionSignature.State = coredata.DocumentVersionSignatureStateSigned
 	documentVersionSignature.SignedAt = &now
 	documentVersionSignature.UpdatedAt = now
@@ -811,6 +896,18 @@ func (s *DocumentService) signDocumentVersionInTx(
 		return nil, fmt.Errorf("cannot update document version signature: %w", err)
 	}
 
+	if err := s.insertSignatureProof(ctx, conn, documentVersion, documentVersionSignature, metadata); err != nil {
+		return nil, fmt.Errorf("cannot insert signature proof: %w", err)
+	}
+
+	if err := webhook.InsertData(ctx, conn, s.svc.scope, documentVersionSignature.OrganizationID, coredata.WebhookEventTypeDocumentSignatureSigned, webhooktypes.NewDocumentVersionSignature(documentVersionSignature)); err != nil {
+		return nil, fmt.Errorf("cannot insert webhook event: %w", err)
+	}
+
+	if err := auditlog.Record(ctx, conn, s.svc.scope, documentVersionSignature.OrganizationID, ActionDocumentVersionSign, documentVersionSignature.ID, before, webhooktypes.NewDocumentVersionSignature(documentVersionSignature)); err != nil {
+		return nil, fmt.Errorf("cannot record audit log entry: %w", err)
+	}
+
 	return documentVersionSignature, nil
 }
 
@@ -840,6 +937,8 @@ func (s *DocumentService) UpdateVersion(
 				return fmt.Errorf("cannot update published version")
 			}
 
+			before := documentVersionAuditState(documentVersion)
+
 			documentVersion.Title = document.Title
 			documentVersion.Classification = document.Classification
 			documentVersion.Content = req.Content
@@ -849,6 +948,10 @@ func (s *DocumentService) UpdateVersion(
 				return fmt.Errorf("cannot update document version: %w", err)
 			}
 
+			if err := auditlog.Record(ctx, conn, s.svc.scope, documentVersion.OrganizationID, ActionDocumentVersionUpdate, documentVersion.ID, before, documentVersionAuditState(documentVersion)); err != nil {
+				return fmt.Errorf("cannot record audit log entry: %w", err)
+			}
+
 			docApprovers := &coredata.DocumentApprovers{}
 			if err := docApprovers.LoadByDocumentID(ctx, conn, s.svc.scope, document.ID); err != nil {
 				return fmt.Errorf("cannot load document approvers: %w", err)
@@ -863,8 +966,11 @@ func (s *DocumentService) UpdateVersion(
 				dva := coredata.DocumentVersionApprover{
 					DocumentVersionID: documentVersion.ID,
 					ApproverProfileID: da.ApproverProfileID,
+					Stage:             da.Stage,
+					State:             coredata.DocumentVersionApprovalStatePending,
 					OrganizationID:    da.OrganizationID,
-					CreatedAt:         time.Now(),
+					CreatedAt:         documentVersion.UpdatedAt,
+					UpdatedAt:         documentVersion.UpdatedAt,
 				}
 				if err := dva.Insert(ctx, conn, s.svc.scope); err != nil {
 					return fmt.Errorf("cannot insert document version approver: %w", err)
@@ -982,6 +1088,48 @@ func (s *DocumentService) createSignatureRequestInTx(
 		return nil, fmt.Errorf("cannot insert document version signature: %w", err)
 	}
 
+	if err := auditlog.Record(ctx, tx, s.svc.scope, documentVersionSignature.OrganizationID, ActionDocumentVersionSignatureRequest, documentVersionSignature.ID, nil, webhooktypes.NewDocumentVersionSignature(documentVersionSignature)); err != nil {
+		return nil, fmt.Errorf("cannot record audit log entry: %w", err)
+	}
+
+	return documentVersionSignature, nil
+}
+
+// requestAcknowledgementInTx asks the signatory to sign the published
+// document version again. A pending signature request is reused, otherwise
+// a new one is created next to the signatory previous signatures.
+func (s *DocumentService) requestAcknowledgementInTx(
+	ctx context.Context,
+	tx pg.Conn,
+	documentVersion *coredata.DocumentVersion,
+	signatoryID gid.GID,
+	now time.Time,
+) (*coredata.DocumentVersionSignature, error) {
+	existingSignature := &coredata.DocumentVersionSignature{}
+	err := existingSignature.LoadByDocumentVersionIDAndSignatory(ctx, tx, s.svc.scope, documentVersion.ID, signatoryID)
+	if err == nil && existingSignature.State == coredata.DocumentVersionSignatureStateRequested {
+		return existingSignature, nil
+	}
+
+	documentVersionSignature := &coredata.DocumentVersionSignature{
+		ID:                gid.New(s.svc.scope.GetTenantID(), coredata.DocumentVersionSignatureEntityType),
+		OrganizationID:    documentVersion.OrganizationID,
+		DocumentVersionID: documentVersion.ID,
+		State:             coredata.DocumentVersionSignatureStateRequested,
+		RequestedAt:       now,
+		SignedBy:          signatoryID,
+		CreatedAt:         now,
+		UpdatedAt:         now,
+	}
+
+	if err := documentVersionSignature.Insert(ctx, tx, s.svc.scope); err != nil {
+		return nil, fmt.Errorf("cannot insert document version signature: %w", err)
+	}
+
+	if err := auditlog.Record(ctx, tx, s.svc.scope, documentVersionSignature.OrganizationID, ActionDocumentVersionSignatureRequest, documentVersionSignature.ID, nil, webhooktypes.NewDocumentVersionSignature(documentVersionSignature)); err != nil {
+		return nil, fmt.Errorf("cannot record audit log entry: %w", err)
+	}
+
 	return documentVersionSignature, nil
 }
 
@@ -1111,6 +1259,10 @@ func (s *DocumentService) CreateDraft(
 				return fmt.Errorf("cannot create draft: %w", err)
 			}
 
+			if err := auditlog.Record(ctx, conn, s.svc.scope, draftVersion.OrganizationID, ActionDocumentDraftVersionCreate, draftVersion.ID, nil, documentVersionAuditState(draftVersion)); err != nil {
+				return fmt.Errorf("cannot record audit log entry: %w", err)
+			}
+
 			docApprovers := &coredata.DocumentApprovers{}
 			if err := docApprovers.LoadByDocumentID(ctx, conn, s.svc.scope, documentID); err != nil {
 				return fmt.Errorf("cannot load document approvers: %w", err)
@@ -1120,8 +1272,11 @@ func (s *DocumentService) CreateDraft(
 				dva := coredata.DocumentVersionApprover{
 					DocumentVersionID: draftVersionID,
 					ApproverProfileID: da.ApproverProfileID,
+					Stage:             da.Stage,
+					State:             coredata.DocumentVersionApprovalStatePending,
 					OrganizationID:    da.OrganizationID,
 					CreatedAt:         now,
+					UpdatedAt:         now,
 				}
 				if err := dva.Insert(ctx, conn, s.svc.scope); err != nil {
 					return fmt.Errorf("cannot insert document version approver: %w", err)
@@ -1164,6 +1319,10 @@ func (s *DocumentService) DeleteDraft(
 				return fmt.Errorf("cannot delete document version: %w", err)
 			}
 
+			if err := auditlog.Record(ctx, conn, s.svc.scope, documentVersion.OrganizationID, ActionDocumentVersionDeleteDraft, documentVersion.ID, documentVersionAuditState(documentVersion), nil); err != nil {
+				return fmt.Errorf("cannot record audit log entry: %w", err)
+			}
+
 			return nil
 		},
 	)
@@ -1173,11 +1332,19 @@ func (s *DocumentService) SoftDelete(
 	ctx context.Context,
 	documentID gid.GID,
 ) error {
-	document := coredata.Document{ID: documentID}
+	document := &coredata.Document{}
 
-	return s.svc.pg.WithConn(
+	return s.svc.pg.WithTx(
 		ctx,
 		func(conn pg.Conn) error {
+			if err := document.LoadByID(ctx, conn, s.svc.scope, documentID); err != nil {
+				return fmt.Errorf("cannot load document %q: %w", documentID, err)
+			}
+
+			if err := auditlog.Record(ctx, conn, s.svc.scope, document.OrganizationID, ActionDocumentDelete, document.ID, documentAuditState(document), nil); err != nil {
+				return fmt.Errorf("cannot record audit log entry: %w", err)
+			}
+
 			return document.SoftDelete(ctx, conn, s.svc.scope)
 		},
 	)
@@ -1189,13 +1356,22 @@ func (s *DocumentService) BulkSoftDelete(
 ) error {
 	documents := coredata.Documents{}
 
-	for _, documentID := range documentIDs {
-		documents = append(documents, &coredata.Document{ID: documentID})
-	}
-
-	return s.svc.pg.WithConn(
+	return s.svc.pg.WithTx(
 		ctx,
 		func(conn pg.Conn) error {
+			for _, documentID := range documentIDs {
+				document := &coredata.Document{}
+				if err := document.LoadByID(ctx, conn, s.svc.scope, documentID); err != nil {
+					return fmt.Errorf("cannot load document %q: %w", documentID, err)
+				}
+
+				if err := auditlog.Record(ctx, conn, s.svc.scope, document.OrganizationID, ActionDocumentDelete, document.ID, documentAuditState(document), nil); err != nil {
+					return fmt.Errorf("cannot record audit log entry: %w", err)
+				}
+
+				documents = append(documents, document)
+			}
+
 			return documents.BulkSoftDelete(ctx, conn, s.svc.scope)
 		},
 	)
@@ -1557,6 +1733,7 @@ func (s *DocumentService) Update(
 
 	document := &coredata.Document{}
 	now := time.Now()
+	approverIDs, approverStages := documentApproverStages(req.ApproverIDs, req.ApprovalStages)
 
 	err := s.svc.pg.WithTx(
 		ctx,
@@ -1565,6 +1742,8 @@ func (s *DocumentService) Update(
 				return fmt.Errorf("cannot load document %q: %w", req.DocumentID, err)
 			}
 
+			before := documentAuditState(document)
+
 			if req.Title != nil {
 				document.Title = *req.Title
 			}
@@ -1585,12 +1764,32 @@ func (s *DocumentService) Update(
 				document.TrustCenterVisibility = *req.TrustCenterVisibility
 			}
 
-			if len(req.ApproverIDs) > 0 {
+			if req.OwnerID != nil {
+				if *req.OwnerID != nil {
+					owner := &coredata.MembershipProfile{}
+					if err := owner.LoadByID(ctx, tx, s.svc.scope, **req.OwnerID); err != nil {
+						return fmt.Errorf("cannot load owner profile: %w", err)
+					}
+
+					if owner.OrganizationID != document.OrganizationID {
+						return fmt.Errorf("owner profile %q is not a member of the document organization", owner.ID)
+					}
+				}
+
+				document.OwnerProfileID = *req.OwnerID
+			}
+
+			if req.ReviewIntervalDays != nil {
+				document.ReviewIntervalDays = *req.ReviewIntervalDays
+				document.NextReviewDate = nextDocumentReviewDate(document, now)
+			}
+
+			if len(approverIDs) > 0 {
 				approverProfiles := coredata.MembershipProfiles{}
-				if err := approverProfiles.LoadByIDs(ctx, tx, s.svc.scope, req.ApproverIDs); err != nil {
+				if err := approverProfiles.LoadByIDs(ctx, tx, s.svc.scope, approverIDs); err != nil {
 					return fmt.Errorf("cannot load approver profiles: %w", err)
 				}
-				if len(approverProfiles) != len(req.ApproverIDs) {
+				if len(approverProfiles) != len(approverIDs) {
 					return fmt.Errorf("one or more approver profiles not found")
 				}
 
@@ -1599,10 +1798,11 @@ func (s *DocumentService) Update(
 					return fmt.Errorf("cannot delete document approvers: %w", err)
 				}
 
-				for _, approverID := range req.ApproverIDs {
+				for _, approverID := range approverIDs {
 					da := coredata.DocumentApprover{
 						DocumentID:        req.DocumentID,
 						ApproverProfileID: approverID,
+						Stage:             approverStages[approverID],
 						OrganizationID:    document.OrganizationID,
 						CreatedAt:         now,
 					}
@@ -1618,6 +1818,10 @@ func (s *DocumentService) Update(
 				return fmt.Errorf("cannot update document: %w", err)
 			}
 
+			if err := auditlog.Record(ctx, tx, s.svc.scope, document.OrganizationID, ActionDocumentUpdate, document.ID, before, documentAuditState(document)); err != nil {
+				return fmt.Errorf("cannot record audit log entry: %w", err)
+			}
+
 			draftVersion := &coredata.DocumentVersion{}
 			err := draftVersion.LoadLatestVersion(ctx, tx, s.svc.scope, req.DocumentID)
 			if err == nil && draftVersion.Status == coredata.DocumentStatusDraft {
@@ -1629,18 +1833,21 @@ func (s *DocumentService) Update(
 					return fmt.Errorf("cannot update draft version: %w", err)
 				}
 
-				if len(req.ApproverIDs) > 0 {
+				if len(approverIDs) > 0 {
 					versionApprovers := &coredata.DocumentVersionApprovers{}
 					if err := versionApprovers.DeleteByDocumentVersionID(ctx, tx, s.svc.scope, draftVersion.ID); err != nil {
 						return fmt.Errorf("cannot delete draft version approvers: %w", err)
 					}
 
-					for _, approverID := range req.ApproverIDs {
+					for _, approverID := range approverIDs {
 						dva := coredata.DocumentVersionApprover{
 							DocumentVersionID: draftVersion.ID,
 							ApproverProfileID: approverID,
+							Stage:             approverStages[approverID],
+							State:             coredata.DocumentVersionApprovalStatePending,
 							OrganizationID:    document.OrganizationID,
 							CreatedAt:         now,
+							UpdatedAt:         now,
 						}
 						if err := dva.Insert(ctx, tx, s.svc.scope); err != nil {
 							return fmt.Errorf("cannot insert draft version approver: %w", err)
@@ -1684,6 +1891,10 @@ func (s *DocumentService) CancelSignatureRequest(
 				return fmt.Errorf("cannot delete document version signature: %w", err)
 			}
 
+			if err := auditlog.Record(ctx, tx, s.svc.scope, documentVersionSignature.OrganizationID, ActionDocumentVersionCancelSignature, documentVersionSignature.ID, webhooktypes.NewDocumentVersionSignature(documentVersionSignature), nil); err != nil {
+				return fmt.Errorf("cannot record audit log entry: %w", err)
+			}
+
 			return nil
 		},
 	)
@@ -2130,3 +2341,27 @@ func sanitizeFilename(title string) string {
 
 	return sanitized
 }
+
+func documentAuditState(d *coredata.Document) map[string]any {
+	return map[string]any{
+		"title":                 d.Title,
+		"documentType":          d.DocumentType,
+		"classification":        d.Classification,
+		"trustCenterVisibility": d.TrustCenterVisibility,
+		"ownerProfileId":        d.OwnerProfileID,
+		"reviewIntervalDays":    d.ReviewIntervalDays,
+	}
+}
+
+// documentVersionAuditState records a digest of the version content
+// rather than the content itself to keep audit log entries small.
+func documentVersionAuditState(v *coredata.DocumentVersion) map[string]any {
+	return map[string]any{
+		"documentId":     v.DocumentID,
+		"title":          v.Title,
+		"versionNumber":  v.VersionNumber,
+		"classification": v.Classification,
+		"status":         v.Status,
+		"contentSha256":  fmt.Sprintf("%x", sha256.Sum256([]byte(v.Content))),
+	}
+}
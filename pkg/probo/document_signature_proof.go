@@ -0,0 +1,267 @@
+// Copyright (c) 2025 Probo Inc <hello@getprobo.com>.
+//
+// Permission to use, copy, modify, and/or distribute this software for any
+// purpose with or without fee is hereby granted, provided that the above
+// copyright notice and this permission notice appear in all copies.
+//
+// THE SOFTWARE IS PROVIDED "AS IS" AND THE AUTHOR DISCLAIMS ALL WARRANTIES WITH
+// REGARD TO THIS SOFTWARE INCLUDING ALL IMPLIED WARRANTIES OF MERCHANTABILITY
+// AND FITNESS. IN NO EVENT SHALL THE AUTHOR BE LIABLE FOR ANY SPECIAL, DIRECT,
+// INDIRECT, OR CONSEQUENTIAL DAMAGES OR ANY DAMAGES WHATSOEVER RESULTING FROM
+// LOSS OF USE, DATA OR PROFITS, WHETHER IN AN ACTION OF CONTRACT, NEGLIGENCE OR
+// OTHER TORTIOUS ACTION, ARISING OUT OF OR IN CONNECTION WITH THE USE OR
+// PERFORMANCE OF THIS SOFTWARE.
+
+package probo
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"time"
+
+	"go.gearno.de/kit/pg"
+	"go.probo.inc/probo/pkg/coredata"
+	"go.probo.inc/probo/pkg/docgen"
+	"go.probo.inc/probo/pkg/gid"
+	"go.probo.inc/probo/pkg/html2pdf"
+	"go.probo.inc/probo/pkg/signaturechain"
+)
+
+type (
+	// SignatureMetadata describes the session a document version was
+	// signed from.
+	SignatureMetadata struct {
+		IPAddress  net.IP
+		UserAgent  string
+		AuthMethod string
+	}
+)
+
+const (
+	SignatureAuthMethodAPIKey              = "API_KEY"
+	SignatureAuthMethodSigningRequestToken = "SIGNING_REQUEST_TOKEN"
+)
+
+func signatureChainEntry(proof *coredata.DocumentVersionSignatureProof) signaturechain.Entry {
+	ipAddress := ""
+	if proof.SignerIPAddress != nil {
+		ipAddress = proof.SignerIPAddress.String()
+	}
+
+	return signaturechain.Entry{
+		Sequence:           proof.Sequence,
+		SignatureID:        proof.DocumentVersionSignatureID.String(),
+		DocumentVersionID:  proof.DocumentVersionID.String(),
+		ContentHash:        proof.ContentHash,
+		SignerFullName:     proof.SignerFullName,
+		SignerEmailAddress: proof.SignerEmailAddress.String(),
+		SignerIPAddress:    ipAddress,
+		SignerUserAgent:    proof.SignerUserAgent,
+		SignerAuthMethod:   proof.SignerAuthMethod,
+		SignedAt:           proof.SignedAt,
+	}
+}
+
+func signatureChainLink(proof *coredata.DocumentVersionSignatureProof) *signaturechain.Link {
+	return &signaturechain.Link{
+		Entry:        signatureChainEntry(proof),
+		PreviousHash: proof.PreviousHash,
+		Hash:         proof.Hash,
+	}
+}
+
+// insertSignatureProof appends the proof of the signature to the chain of
+// the document version. The caller must hold a lock on the document
+// version row so that concurrent signatures get distinct sequences.
+func (s *DocumentService) insertSignatureProof(
+	ctx context.Context,
+	conn pg.Conn,
+	documentVersion *coredata.DocumentVersion,
+	documentVersionSignature *coredata.DocumentVersionSignature,
+	metadata SignatureMetadata,
+) error {
+	rendered, err := docgen.RenderContentHTML(documentVersion.Content)
+	if err != nil {
+		return fmt.Errorf("cannot render document version content: %w", err)
+	}
+
+	signer := &coredata.MembershipProfile{}
+	if err := signer.LoadByID(ctx, conn, s.svc.scope, documentVersionSignature.SignedBy); err != nil {
+		return fmt.Errorf("cannot load signer profile: %w", err)
+	}
+
+	var previous *signaturechain.Link
+	last := &coredata.DocumentVersionSignatureProof{}
+	if err := last.LoadLastByDocumentVersionID(ctx, conn, s.svc.scope, documentVersion.ID); err != nil {
+		if !errors.Is(err, coredata.ErrResourceNotFound) {
+			return fmt.Errorf("cannot load last document version signature proof: %w", err)
+		}
+	} else {
+		previous = signatureChainLink(last)
+	}
+
+	// The database stores timestamps with microsecond precision, the
+	// hashed value must survive a round trip.
+	signedAt := documentVersionSignature.SignedAt.Truncate(time.Microsecond)
+
+	proof := &coredata.DocumentVersionSignatureProof{
+		DocumentVersionSignatureID: documentVersionSignature.ID,
+		DocumentVersionID:          documentVersion.ID,
+		OrganizationID:             documentVersion.OrganizationID,
+		ContentHash:                signaturechain.ContentHash(rendered),
+		SignerFullName:             signer.FullName,
+		SignerEmailAddress:         signer.EmailAddress,
+		SignerIPAddress:            metadata.IPAddress,
+		SignerUserAgent:            metadata.UserAgent,
+		SignerAuthMethod:           metadata.AuthMethod,
+		SignedAt:                   signedAt,
+		CreatedAt:                  time.Now(),
+	}
+
+	link, err := signaturechain.Append(previous, signatureChainEntry(proof))
+	if err != nil {
+		return fmt.Errorf("cannot append signature to chain: %w", err)
+	}
+
+	proof.Sequence = link.Entry.Sequence
+	proof.PreviousHash = link.PreviousHash
+	proof.Hash = link.Hash
+
+	if err := proof.Insert(ctx, conn, s.svc.scope); err != nil {
+		return fmt.Errorf("cannot insert document version signature proof: %w", err)
+	}
+
+	return nil
+}
+
+// ExportSignatureCertificatePDF renders the certificate of completion of
+// the document version, listing every signer along with the hash chain
+// needed to verify the signatures offline.
+func (s *DocumentService) ExportSignatureCertificatePDF(
+	ctx context.Context,
+	documentVersionID gid.GID,
+) ([]byte, error) {
+	var data []byte
+
+	err := s.svc.pg.WithConn(
+		ctx,
+		func(conn pg.Conn) error {
+			documentVersion := &coredata.DocumentVersion{}
+			if err := documentVersion.LoadByID(ctx, conn, s.svc.scope, documentVersionID); err != nil {
+				return fmt.Errorf("cannot load document version: %w", err)
+			}
+
+			organization := &coredata.Organization{}
+			if err := organization.LoadByID(ctx, conn, s.svc.scope, documentVersion.OrganizationID); err != nil {
+				return fmt.Errorf("cannot load organization: %w", err)
+			}
+
+			proofs := coredata.DocumentVersionSignatureProofs{}
+			if err := proofs.LoadByDocumentVersionID(ctx, conn, s.svc.scope, documentVersionID); err != nil {
+				return fmt.Errorf("cannot load document version signature proofs: %w", err)
+			}
+
+			rendered, err := docgen.RenderContentHTML(documentVersion.Content)
+			if err != nil {
+				return fmt.Errorf("cannot render document version content: %w", err)
+			}
+
+			certificate := docgen.SignatureCertificateData{
+				Title:            documentVersion.Title,
+				OrganizationName: organization.Name,
+				Version:          documentVersion.VersionNumber,
+				PublishedAt:      documentVersion.PublishedAt,
+				ContentHash:      signaturechain.ContentHash(rendered),
+				GenesisHash:      signaturechain.GenesisHash,
+				ChainValid:       true,
+				GeneratedAt:      time.Now(),
+				Signers:          make([]docgen.SignatureCertificateSignerData, 0, len(proofs)),
+			}
+
+			links := make([]*signaturechain.Link, 0, len(proofs))
+			for _, proof := range proofs {
+				link := signatureChainLink(proof)
+				links = append(links, link)
+
+				entry, err := link.Entry.Canonical()
+				if err != nil {
+					return fmt.Errorf("cannot encode signature chain entry: %w", err)
+				}
+
+				certificate.Signers = append(
+					certificate.Signers,
+					docgen.SignatureCertificateSignerData{
+						Sequence:     proof.Sequence,
+						FullName:     proof.SignerFullName,
+						EmailAddress: proof.SignerEmailAddress.String(),
+						IPAddress:    link.Entry.SignerIPAddress,
+						UserAgent:    proof.SignerUserAgent,
+						AuthMethod:   proof.SignerAuthMethod,
+						SignedAt:     proof.SignedAt,
+						ContentHash:  proof.ContentHash,
+						PreviousHash: proof.PreviousHash,
+						Hash:         proof.Hash,
+						Entry:        string(entry),
+					},
+				)
+
+				if proof.ContentHash != certificate.ContentHash && certificate.ChainValid {
+					certificate.ChainValid = false
+					certificate.ChainError = fmt.Sprintf("content of entry %d does not match the document version", proof.Sequence)
+				}
+			}
+
+			if err := signaturechain.Verify(links); err != nil && certificate.ChainValid {
+				certificate.ChainValid = false
+				certificate.ChainError = err.Error()
+			}
+
+			if organization.HorizontalLogoFileID != nil {
+				fileRecord := &coredata.File{}
+				if err := fileRecord.LoadByID(ctx, conn, s.svc.scope, *organization.HorizontalLogoFileID); err == nil {
+					base64Data, mimeType, err := s.svc.fileManager.GetFileBase64(ctx, fileRecord)
+					if err == nil {
+						certificate.CompanyHorizontalLogoBase64 = fmt.Sprintf("data:%s;base64,%s", mimeType, base64Data)
+					}
+				}
+			}
+
+			htmlContent, err := docgen.RenderSignatureCertificateHTML(certificate)
+			if err != nil {
+				return fmt.Errorf("cannot generate HTML: %w", err)
+			}
+
+			cfg := html2pdf.RenderConfig{
+				PageFormat:      html2pdf.PageFormatA4,
+				Orientation:     html2pdf.OrientationPortrait,
+				MarginTop:       html2pdf.NewMarginInches(1.0),
+				MarginBottom:    html2pdf.NewMarginInches(1.0),
+				MarginLeft:      html2pdf.NewMarginInches(1.0),
+				MarginRight:     html2pdf.NewMarginInches(1.0),
+				PrintBackground: true,
+				Scale:           1.0,
+			}
+
+			pdfReader, err := s.html2pdfConverter.GeneratePDF(ctx, htmlContent, cfg)
+			if err != nil {
+				return fmt.Errorf("cannot generate PDF: %w", err)
+			}
+
+			data, err = io.ReadAll(pdfReader)
+			if err != nil {
+				return fmt.Errorf("cannot read PDF data: %w", err)
+			}
+
+			return nil
+		},
+	)
+
+	if err != nil {
+		return nil, fmt.Errorf("cannot export signature certificate PDF: %w", err)
+	}
+
+	return data, nil
+}
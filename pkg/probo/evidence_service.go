@@ -21,11 +21,14 @@ import (
 
 	"go.gearno.de/crypto/uuid"
 	"go.gearno.de/kit/pg"
+	"go.probo.inc/probo/pkg/auditlog"
 	"go.probo.inc/probo/pkg/coredata"
 	"go.probo.inc/probo/pkg/filevalidation"
 	"go.probo.inc/probo/pkg/gid"
 	"go.probo.inc/probo/pkg/page"
 	"go.probo.inc/probo/pkg/validator"
+	"go.probo.inc/probo/pkg/webhook"
+	webhooktypes "go.probo.inc/probo/pkg/webhook/types"
 )
 
 type (
@@ -134,6 +137,14 @@ func (s EvidenceService) UploadMeasureEvidence(
 				return fmt.Errorf("cannot insert evidence: %w", err)
 			}
 
+			if err := webhook.InsertData(ctx, conn, s.svc.scope, evidence.OrganizationID, coredata.WebhookEventTypeEvidenceCreated, webhooktypes.NewEvidence(evidence)); err != nil {
+				return fmt.Errorf("cannot insert webhook event: %w", err)
+			}
+
+			if err := auditlog.Record(ctx, conn, s.svc.scope, evidence.OrganizationID, ActionMeasureEvidenceUpload, evidence.ID, nil, webhooktypes.NewEvidence(evidence)); err != nil {
+				return fmt.Errorf("cannot record audit log entry: %w", err)
+			}
+
 			return nil
 		},
 	)
@@ -256,11 +267,23 @@ func (s *EvidenceService) Delete(
 	ctx context.Context,
 	evidenceID gid.GID,
 ) error {
-	evidence := &coredata.Evidence{ID: evidenceID}
+	evidence := &coredata.Evidence{}
 
-	return s.svc.pg.WithConn(
+	return s.svc.pg.WithTx(
 		ctx,
 		func(conn pg.Conn) error {
+			if err := evidence.LoadByID(ctx, conn, s.svc.scope, evidenceID); err != nil {
+				return fmt.Errorf("cannot load evidence: %w", err)
+			}
+
+			if err := webhook.InsertData(ctx, conn, s.svc.scope, evidence.OrganizationID, coredata.WebhookEventTypeEvidenceDeleted, webhooktypes.NewEvidence(evidence)); err != nil {
+				return fmt.Errorf("cannot insert webhook event: %w", err)
+			}
+
+			if err := auditlog.Record(ctx, conn, s.svc.scope, evidence.OrganizationID, ActionEvidenceDelete, evidence.ID, webhooktypes.NewEvidence(evidence), nil); err != nil {
+				return fmt.Errorf("cannot record audit log entry: %w", err)
+			}
+
 			err := evidence.Delete(ctx, conn, s.svc.scope)
 			if err != nil {
 				return fmt.Errorf("cannot delete evidence: %w", err)
@@ -25,6 +25,7 @@ import (
 	"github.com/aws/aws-sdk-go-v2/service/s3"
 	"go.gearno.de/crypto/uuid"
 	"go.gearno.de/kit/pg"
+	"go.probo.inc/probo/pkg/auditlog"
 	"go.probo.inc/probo/pkg/coredata"
 	"go.probo.inc/probo/pkg/filevalidation"
 	"go.probo.inc/probo/pkg/gid"
@@ -150,6 +151,10 @@ func (s FileService) UploadAndSaveFile(
 				return fmt.Errorf("cannot insert file: %w", err)
 			}
 
+			if err := auditlog.Record(ctx, conn, s.svc.scope, file.OrganizationID, ActionFileUpload, file.ID, nil, fileAuditState(file)); err != nil {
+				return fmt.Errorf("cannot record audit log entry: %w", err)
+			}
+
 			return nil
 		},
 	)
@@ -195,3 +200,11 @@ func (s FileService) GenerateFileTempURL(
 
 	return presignedReq.URL, nil
 }
+
+func fileAuditState(f *coredata.File) map[string]any {
+	return map[string]any{
+		"fileName": f.FileName,
+		"mimeType": f.MimeType,
+		"fileSize": f.FileSize,
+	}
+}
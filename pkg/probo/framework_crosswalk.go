@@ -0,0 +1,318 @@
+// Copyright (c) 2025 Probo Inc <hello@getprobo.com>.
+//
+// Permission to use, copy, modify, and/or distribute this software for any
+// purpose with or without fee is hereby granted, provided that the above
+// copyright notice and this permission notice appear in all copies.
+//
+// THE SOFTWARE IS PROVIDED "AS IS" AND THE AUTHOR DISCLAIMS ALL WARRANTIES WITH
+// REGARD TO THIS SOFTWARE INCLUDING ALL IMPLIED WARRANTIES OF MERCHANTABILITY
+// AND FITNESS. IN NO EVENT SHALL THE AUTHOR BE LIABLE FOR ANY SPECIAL, DIRECT,
+// INDIRECT, OR CONSEQUENTIAL DAMAGES OR ANY DAMAGES WHATSOEVER RESULTING FROM
+// LOSS OF USE, DATA OR PROFITS, WHETHER IN AN ACTION OF CONTRACT, NEGLIGENCE OR
+// OTHER TORTIOUS ACTION, ARISING OUT OF OR IN CONNECTION WITH THE USE OR
+// PERFORMANCE OF THIS SOFTWARE.
+
+package probo
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"go.gearno.de/kit/pg"
+	"go.probo.inc/probo/pkg/auditlog"
+	"go.probo.inc/probo/pkg/coredata"
+	"go.probo.inc/probo/pkg/gid"
+)
+
+type (
+	// ImportCrosswalkRequest maps controls of a source framework to their
+	// equivalents in a target framework. Frameworks are identified by their
+	// reference ID and controls by their section title, as in framework
+	// import files.
+	ImportCrosswalkRequest struct {
+		Crosswalk struct {
+			SourceFramework string `json:"source_framework"`
+			TargetFramework string `json:"target_framework"`
+			Mappings        []struct {
+				Source  string   `json:"source"`
+				Targets []string `json:"targets"`
+			} `json:"mappings"`
+		}
+	}
+
+	ImportCrosswalkResult struct {
+		SourceFramework *coredata.Framework
+		TargetFramework *coredata.Framework
+		ImportedCount   int
+		SkippedCount    int
+	}
+
+	// ErrCrosswalkFrameworkNotFound is returned when a crosswalk names a
+	// framework the organization does not have.
+	ErrCrosswalkFrameworkNotFound struct {
+		ReferenceID string
+	}
+
+	// ErrCrosswalkSameFramework is returned when a crosswalk maps the
+	// controls of a framework to the same framework.
+	ErrCrosswalkSameFramework struct {
+		ReferenceID string
+	}
+
+	CrosswalkCoverage struct {
+		TotalControlCount int
+		Controls          []*CrosswalkControlCoverage
+	}
+
+	// CrosswalkControlCoverage is a target framework control satisfied by
+	// the measures of its equivalent source framework controls.
+	CrosswalkControlCoverage struct {
+		Control            *coredata.Control
+		EquivalentControls coredata.Controls
+		Measures           coredata.Measures
+	}
+)
+
+func (e ErrCrosswalkFrameworkNotFound) Error() string {
+	return fmt.Sprintf("organization has no framework with reference id %q", e.ReferenceID)
+}
+
+func (e ErrCrosswalkSameFramework) Error() string {
+	return fmt.Sprintf("crosswalk source and target are the same framework %q", e.ReferenceID)
+}
+
+func (s FrameworkService) ImportCrosswalk(
+	ctx context.Context,
+	organizationID gid.GID,
+	req ImportCrosswalkRequest,
+) (*ImportCrosswalkResult, error) {
+	result := &ImportCrosswalkResult{
+		SourceFramework: &coredata.Framework{},
+		TargetFramework: &coredata.Framework{},
+	}
+	now := time.Now()
+
+	err := s.svc.pg.WithTx(ctx, func(tx pg.Conn) error {
+		organization := &coredata.Organization{}
+		if err := organization.LoadByID(ctx, tx, s.svc.scope, organizationID); err != nil {
+			return fmt.Errorf("cannot load organization: %w", err)
+		}
+
+		if req.Crosswalk.SourceFramework == req.Crosswalk.TargetFramework {
+			return &ErrCrosswalkSameFramework{ReferenceID: req.Crosswalk.SourceFramework}
+		}
+
+		if err := loadCrosswalkFramework(ctx, tx, s.svc.scope, organization.ID, req.Crosswalk.SourceFramework, result.SourceFramework); err != nil {
+			return err
+		}
+
+		if err := loadCrosswalkFramework(ctx, tx, s.svc.scope, organization.ID, req.Crosswalk.TargetFramework, result.TargetFramework); err != nil {
+			return err
+		}
+
+		targetControls := map[string]*coredata.Control{}
+
+		for _, mapping := range req.Crosswalk.Mappings {
+			source := &coredata.Control{}
+			if err := source.LoadByFrameworkIDAndSectionTitle(ctx, tx, s.svc.scope, result.SourceFramework.ID, mapping.Source); err != nil {
+				if errors.Is(err, coredata.ErrResourceNotFound) {
+					result.SkippedCount += len(mapping.Targets)
+					continue
+				}
+
+				return fmt.Errorf("cannot load source control %q: %w", mapping.Source, err)
+			}
+
+			for _, target := range mapping.Targets {
+				targetControl, ok := targetControls[target]
+				if !ok {
+					targetControl = &coredata.Control{}
+					if err := targetControl.LoadByFrameworkIDAndSectionTitle(ctx, tx, s.svc.scope, result.TargetFramework.ID, target); err != nil {
+						if !errors.Is(err, coredata.ErrResourceNotFound) {
+							return fmt.Errorf("cannot load target control %q: %w", target, err)
+						}
+
+						targetControl = nil
+					}
+
+					targetControls[target] = targetControl
+				}
+
+				if targetControl == nil {
+					result.SkippedCount++
+					continue
+				}
+
+				controlEquivalence := coredata.NewControlEquivalence(organization.ID, source.ID, targetControl.ID, now)
+
+				inserted, err := controlEquivalence.Upsert(ctx, tx, s.svc.scope)
+				if err != nil {
+					return fmt.Errorf("cannot upsert control equivalence: %w", err)
+				}
+
+				if inserted {
+					result.ImportedCount++
+				}
+			}
+		}
+
+		after := map[string]any{
+			"targetFrameworkId": result.TargetFramework.ID,
+			"importedCount":     result.ImportedCount,
+			"skippedCount":      result.SkippedCount,
+		}
+		if err := auditlog.Record(ctx, tx, s.svc.scope, organization.ID, ActionFrameworkImportCrosswalk, result.SourceFramework.ID, nil, after); err != nil {
+			return fmt.Errorf("cannot record audit log entry: %w", err)
+		}
+
+		return nil
+	})
+
+	if err != nil {
+		return nil, fmt.Errorf("cannot import crosswalk: %w", err)
+	}
+
+	return result, nil
+}
+
+func loadCrosswalkFramework(
+	ctx context.Context,
+	conn pg.Conn,
+	scope coredata.Scoper,
+	organizationID gid.GID,
+	referenceID string,
+	framework *coredata.Framework,
+) error {
+	if err := framework.LoadByOrganizationIDAndReferenceID(ctx, conn, scope, organizationID, referenceID); err != nil {
+		if errors.Is(err, coredata.ErrResourceNotFound) {
+			return &ErrCrosswalkFrameworkNotFound{ReferenceID: referenceID}
+		}
+
+		return fmt.Errorf("cannot load framework %q: %w", referenceID, err)
+	}
+
+	return nil
+}
+
+// GetCrosswalkCoverage reports which controls of the target framework are
+// satisfied by measures mapped to their equivalent controls of the source
+// framework.
+func (s FrameworkService) GetCrosswalkCoverage(
+	ctx context.Context,
+	targetFrameworkID gid.GID,
+	sourceFrameworkID gid.GID,
+) (*CrosswalkCoverage, error) {
+	coverage := &CrosswalkCoverage{}
+
+	err := s.svc.pg.WithConn(
+		ctx,
+		func(conn pg.Conn) error {
+			targetFramework := &coredata.Framework{}
+			if err := targetFramework.LoadByID(ctx, conn, s.svc.scope, targetFrameworkID); err != nil {
+				return fmt.Errorf("cannot load target framework: %w", err)
+			}
+
+			sourceFramework := &coredata.Framework{}
+			if err := sourceFramework.LoadByID(ctx, conn, s.svc.scope, sourceFrameworkID); err != nil {
+				return fmt.Errorf("cannot load source framework: %w", err)
+			}
+
+			var controls coredata.Controls
+			totalControlCount, err := controls.CountByFrameworkID(ctx, conn, s.svc.scope, targetFramework.ID, coredata.NewControlFilter(nil))
+			if err != nil {
+				return fmt.Errorf("cannot count controls: %w", err)
+			}
+			coverage.TotalControlCount = totalControlCount
+
+			var rows coredata.ControlEquivalenceCoverages
+			if err := rows.LoadByFrameworkIDs(ctx, conn, s.svc.scope, targetFramework.ID, sourceFramework.ID); err != nil {
+				return fmt.Errorf("cannot load control equivalence coverage: %w", err)
+			}
+
+			var (
+				controlIDs       []gid.GID
+				measureIDs       []gid.GID
+				seenControls     = map[gid.GID]bool{}
+				seenMeasures     = map[gid.GID]bool{}
+				equivalentIDs    = map[gid.GID][]gid.GID{}
+				coveredMeasures  = map[gid.GID][]gid.GID{}
+				seenEquivalences = map[[2]gid.GID]bool{}
+				seenCoverages    = map[[2]gid.GID]bool{}
+				targetControlIDs []gid.GID
+			)
+
+			for _, row := range rows {
+				if _, ok := equivalentIDs[row.ControlID]; !ok {
+					targetControlIDs = append(targetControlIDs, row.ControlID)
+				}
+
+				for _, id := range []gid.GID{row.ControlID, row.EquivalentControlID} {
+					if !seenControls[id] {
+						seenControls[id] = true
+						controlIDs = append(controlIDs, id)
+					}
+				}
+
+				if !seenMeasures[row.MeasureID] {
+					seenMeasures[row.MeasureID] = true
+					measureIDs = append(measureIDs, row.MeasureID)
+				}
+
+				if key := [2]gid.GID{row.ControlID, row.EquivalentControlID}; !seenEquivalences[key] {
+					seenEquivalences[key] = true
+					equivalentIDs[row.ControlID] = append(equivalentIDs[row.ControlID], row.EquivalentControlID)
+				}
+
+				if key := [2]gid.GID{row.ControlID, row.MeasureID}; !seenCoverages[key] {
+					seenCoverages[key] = true
+					coveredMeasures[row.ControlID] = append(coveredMeasures[row.ControlID], row.MeasureID)
+				}
+			}
+
+			if err := controls.LoadByIDs(ctx, conn, s.svc.scope, controlIDs); err != nil {
+				return fmt.Errorf("cannot load controls: %w", err)
+			}
+
+			var measures coredata.Measures
+			if err := measures.LoadByIDs(ctx, conn, s.svc.scope, measureIDs); err != nil {
+				return fmt.Errorf("cannot load measures: %w", err)
+			}
+
+			controlsByID := make(map[gid.GID]*coredata.Control, len(controls))
+			for _, control := range controls {
+				controlsByID[control.ID] = control
+			}
+
+			measuresByID := make(map[gid.GID]*coredata.Measure, len(measures))
+			for _, measure := range measures {
+				measuresByID[measure.ID] = measure
+			}
+
+			for _, controlID := range targetControlIDs {
+				controlCoverage := &CrosswalkControlCoverage{
+					Control: controlsByID[controlID],
+				}
+
+				for _, id := range equivalentIDs[controlID] {
+					controlCoverage.EquivalentControls = append(controlCoverage.EquivalentControls, controlsByID[id])
+				}
+
+				for _, id := range coveredMeasures[controlID] {
+					controlCoverage.Measures = append(controlCoverage.Measures, measuresByID[id])
+				}
+
+				coverage.Controls = append(coverage.Controls, controlCoverage)
+			}
+
+			return nil
+		},
+	)
+
+	if err != nil {
+		return nil, fmt.Errorf("cannot get crosswalk coverage: %w", err)
+	}
+
+	return coverage, nil
+}
@@ -0,0 +1,310 @@
+// Copyright (c) 2025 Probo Inc <hello@getprobo.com>.
+//
+// Permission to use, copy, modify, and/or distribute this software for any
+// purpose with or without fee is hereby granted, provided that the above
+// copyright notice and this permission notice appear in all copies.
+//
+// THE SOFTWARE IS PROVIDED "AS IS" AND THE AUTHOR DISCLAIMS ALL WARRANTIES WITH
+// REGARD TO THIS SOFTWARE INCLUDING ALL IMPLIED WARRANTIES OF MERCHANTABILITY
+// AND FITNESS. IN NO EVENT SHALL THE AUTHOR BE LIABLE FOR ANY SPECIAL, DIRECT,
+// INDIRECT, OR CONSEQUENTIAL DAMAGES OR ANY DAMAGES WHATSOEVER RESULTING FROM
+// LOSS OF USE, DATA OR PROFITS, WHETHER IN AN ACTION OF CONTRACT, NEGLIGENCE OR
+// OTHER TORTIOUS ACTION, ARISING OUT OF OR IN CONNECTION WITH THE USE OR
+// PERFORMANCE OF THIS SOFTWARE.
+
+package probo
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"time"
+
+	"go.gearno.de/kit/pg"
+	"go.probo.inc/probo/pkg/coredata"
+	"go.probo.inc/probo/pkg/gid"
+	"go.probo.inc/probo/pkg/oscal"
+	"go.probo.inc/probo/pkg/page"
+	"go.probo.inc/probo/pkg/slug"
+)
+
+type (
+	// ImportOSCALFrameworkRequest imports an OSCAL catalog, or an OSCAL
+	// profile along with the catalog it selects controls from.
+	ImportOSCALFrameworkRequest struct {
+		Document *oscal.Document
+		Catalog  *oscal.Document
+	}
+)
+
+func (s FrameworkService) ImportOSCAL(
+	ctx context.Context,
+	organizationID gid.GID,
+	req ImportOSCALFrameworkRequest,
+) (*coredata.Framework, error) {
+	var (
+		catalog  *oscal.Catalog
+		title    string
+		controls []*oscal.Control
+	)
+
+	switch {
+	case req.Document.Catalog != nil:
+		catalog = req.Document.Catalog
+		title = catalog.Metadata.Title
+		controls = catalog.AllControls()
+	case req.Document.Profile != nil:
+		if req.Catalog == nil || req.Catalog.Catalog == nil {
+			return nil, fmt.Errorf("cannot import oscal profile without its catalog: %w", oscal.ErrUnsupportedDocument)
+		}
+
+		catalog = req.Catalog.Catalog
+		title = req.Document.Profile.Metadata.Title
+
+		var err error
+		controls, err = req.Document.Profile.Resolve(catalog)
+		if err != nil {
+			return nil, fmt.Errorf("cannot import oscal profile: %w", err)
+		}
+	default:
+		return nil, oscal.ErrUnsupportedDocument
+	}
+
+	definition := FrameworkDefinition{
+		ID:   slug.Make(title),
+		Name: title,
+	}
+
+	for _, control := range controls {
+		definition.Controls = append(
+			definition.Controls,
+			FrameworkControlDefinition{
+				ID:          control.Label(),
+				Name:        control.Title,
+				Description: catalog.Statement(control),
+			},
+		)
+	}
+
+	return s.Import(ctx, organizationID, ImportFrameworkRequest{Framework: definition})
+}
+
+// ExportOSCAL writes an OSCAL component definition where every measure
+// mapped to a control of the framework is a component implementing these
+// controls, with its state and its evidences as back matter resources.
+func (s FrameworkService) ExportOSCAL(
+	ctx context.Context,
+	frameworkID gid.GID,
+	w io.Writer,
+) error {
+	var componentDefinition *oscal.ComponentDefinition
+
+	err := s.svc.pg.WithConn(
+		ctx,
+		func(conn pg.Conn) error {
+			framework := &coredata.Framework{}
+			if err := framework.LoadByID(ctx, conn, s.svc.scope, frameworkID); err != nil {
+				return fmt.Errorf("cannot load framework: %w", err)
+			}
+
+			organization := &coredata.Organization{}
+			if err := organization.LoadByID(ctx, conn, s.svc.scope, framework.OrganizationID); err != nil {
+				return fmt.Errorf("cannot load organization: %w", err)
+			}
+
+			controls := coredata.Controls{}
+			err := controls.LoadByFrameworkID(
+				ctx,
+				conn,
+				s.svc.scope,
+				framework.ID,
+				page.NewCursor(
+					10_000,
+					nil,
+					page.Head,
+					page.OrderBy[coredata.ControlOrderField]{
+						Field:     coredata.ControlOrderFieldSectionTitle,
+						Direction: page.OrderDirectionAsc,
+					},
+				),
+				coredata.NewControlFilter(nil),
+			)
+			if err != nil {
+				return fmt.Errorf("cannot load controls: %w", err)
+			}
+
+			frameworkResource := &oscal.Resource{
+				UUID:  oscal.NewUUID(framework.ID.String()),
+				Title: framework.Name,
+				Props: []oscal.Prop{
+					{Name: "framework-reference-id", NS: oscal.Namespace, Value: framework.ReferenceID},
+				},
+			}
+			backMatter := &oscal.BackMatter{Resources: []*oscal.Resource{frameworkResource}}
+
+			var components []*oscal.Component
+			componentsByMeasureID := map[gid.GID]*oscal.Component{}
+			evidenceLinksByMeasureID := map[gid.GID][]oscal.Link{}
+
+			for _, control := range controls {
+				measures := coredata.Measures{}
+				err := measures.LoadByControlID(
+					ctx,
+					conn,
+					s.svc.scope,
+					control.ID,
+					page.NewCursor(
+						10_000,
+						nil,
+						page.Head,
+						page.OrderBy[coredata.MeasureOrderField]{
+							Field:     coredata.MeasureOrderFieldCreatedAt,
+							Direction: page.OrderDirectionAsc,
+						},
+					),
+					coredata.NewMeasureFilter(nil, nil),
+				)
+				if err != nil {
+					return fmt.Errorf("cannot load measures: %w", err)
+				}
+
+				for _, measure := range measures {
+					component, ok := componentsByMeasureID[measure.ID]
+					if !ok {
+						description := measure.Name
+						if measure.Description != nil && *measure.Description != "" {
+							description = *measure.Description
+						}
+
+						component = &oscal.Component{
+							UUID:        oscal.NewUUID(measure.ID.String()),
+							Type:        "process-procedure",
+							Title:       measure.Name,
+							Description: description,
+							Props: []oscal.Prop{
+								{Name: "measure-state", NS: oscal.Namespace, Value: measure.State.String()},
+								{Name: "measure-category", NS: oscal.Namespace, Value: measure.Category},
+							},
+							ControlImplementations: []*oscal.ControlImplementation{
+								{
+									UUID:        oscal.NewUUID(framework.ID.String() + measure.ID.String()),
+									Source:      "#" + frameworkResource.UUID,
+									Description: fmt.Sprintf("%s controls implemented by %s.", framework.Name, measure.Name),
+								},
+							},
+						}
+						components = append(components, component)
+						componentsByMeasureID[measure.ID] = component
+
+						resources, err := s.oscalEvidenceResources(ctx, conn, measure)
+						if err != nil {
+							return err
+						}
+
+						for _, resource := range resources {
+							backMatter.Resources = append(backMatter.Resources, resource)
+							evidenceLinksByMeasureID[measure.ID] = append(
+								evidenceLinksByMeasureID[measure.ID],
+								oscal.Link{Href: "#" + resource.UUID, Rel: "evidence", Text: resource.Title},
+							)
+						}
+					}
+
+					implementation := component.ControlImplementations[0]
+					implementation.ImplementedRequirements = append(
+						implementation.ImplementedRequirements,
+						&oscal.ImplementedRequirement{
+							UUID:        oscal.NewUUID(control.ID.String() + measure.ID.String()),
+							ControlID:   oscal.Token(control.SectionTitle),
+							Description: fmt.Sprintf("%s %s is addressed by %s.", control.SectionTitle, control.Name, measure.Name),
+							Props: []oscal.Prop{
+								{Name: "control-section-title", NS: oscal.Namespace, Value: control.SectionTitle},
+							},
+							Links: evidenceLinksByMeasureID[measure.ID],
+						},
+					)
+				}
+			}
+
+			componentDefinition = &oscal.ComponentDefinition{
+				UUID: oscal.NewUUID(organization.ID.String() + framework.ID.String()),
+				Metadata: oscal.Metadata{
+					Title:        fmt.Sprintf("%s %s implementation", organization.Name, framework.Name),
+					LastModified: time.Now().UTC().Truncate(time.Second),
+					Version:      framework.UpdatedAt.UTC().Format("2006-01-02"),
+					OSCALVersion: oscal.Version,
+				},
+				Components: components,
+				BackMatter: backMatter,
+			}
+
+			return nil
+		},
+	)
+	if err != nil {
+		return err
+	}
+
+	return oscal.Encode(w, &oscal.Document{ComponentDefinition: componentDefinition})
+}
+
+func (s FrameworkService) oscalEvidenceResources(
+	ctx context.Context,
+	conn pg.Conn,
+	measure *coredata.Measure,
+) ([]*oscal.Resource, error) {
+	evidences := coredata.Evidences{}
+	err := evidences.LoadByMeasureID(
+		ctx,
+		conn,
+		s.svc.scope,
+		measure.ID,
+		page.NewCursor(
+			10_000,
+			nil,
+			page.Head,
+			page.OrderBy[coredata.EvidenceOrderField]{
+				Field:     coredata.EvidenceOrderFieldCreatedAt,
+				Direction: page.OrderDirectionAsc,
+			},
+		),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("cannot load evidences: %w", err)
+	}
+
+	resources := make([]*oscal.Resource, 0, len(evidences))
+	for _, evidence := range evidences {
+		resource := &oscal.Resource{
+			UUID:  oscal.NewUUID(evidence.ID.String()),
+			Title: evidence.ReferenceID,
+			Props: []oscal.Prop{
+				{Name: "evidence-type", NS: oscal.Namespace, Value: evidence.Type.String()},
+				{Name: "evidence-state", NS: oscal.Namespace, Value: evidence.State.String()},
+			},
+		}
+
+		if evidence.Description != nil {
+			resource.Description = *evidence.Description
+		}
+
+		switch evidence.Type {
+		case coredata.EvidenceTypeFile:
+			if evidence.EvidenceFileId != nil {
+				file := &coredata.File{}
+				if err := file.LoadByID(ctx, conn, s.svc.scope, *evidence.EvidenceFileId); err != nil {
+					return nil, fmt.Errorf("cannot load evidence file: %w", err)
+				}
+				resource.Title = file.FileName
+			}
+		case coredata.EvidenceTypeLink:
+			if evidence.URL != "" {
+				resource.RLinks = []oscal.RLink{{Href: evidence.URL}}
+			}
+		}
+
+		resources = append(resources, resource)
+	}
+
+	return resources, nil
+}
@@ -30,6 +30,7 @@ import (
 	"go.gearno.de/kit/pg"
 	"go.gearno.de/x/ref"
 	"go.probo.inc/probo/packages/emails"
+	"go.probo.inc/probo/pkg/auditlog"
 	"go.probo.inc/probo/pkg/coredata"
 	"go.probo.inc/probo/pkg/gid"
 	"go.probo.inc/probo/pkg/html2pdf"
@@ -62,20 +63,25 @@ type (
 	}
 
 	ImportFrameworkRequest struct {
-		Framework struct {
-			ID   string `json:"id"`
-			Name string `json:"name"`
-			Logo *struct {
-				Light string `json:"light"`
-				Dark  string `json:"dark"`
-			} `json:"logo,omitempty"`
-			Controls []struct {
-				ID           string `json:"id"`
-				Name         string `json:"name"`
-				Description  string `json:"description"`
-				BestPractice *bool  `json:"best_practice,omitempty"`
-			} `json:"controls"`
-		}
+		Framework FrameworkDefinition
+	}
+
+	// FrameworkDefinition is the content of a framework import file.
+	FrameworkDefinition struct {
+		ID   string `json:"id"`
+		Name string `json:"name"`
+		Logo *struct {
+			Light string `json:"light"`
+			Dark  string `json:"dark"`
+		} `json:"logo,omitempty"`
+		Controls []FrameworkControlDefinition `json:"controls"`
+	}
+
+	FrameworkControlDefinition struct {
+		ID           string `json:"id"`
+		Name         string `json:"name"`
+		Description  string `json:"description"`
+		BestPractice *bool  `json:"best_practice,omitempty"`
 	}
 )
 
@@ -102,6 +108,7 @@ func (ufr *UpdateFrameworkRequest) Validate() error {
 func (s FrameworkService) RequestExport(
 	ctx context.Context,
 	frameworkID gid.GID,
+	format coredata.FrameworkExportFormat,
 	recipientEmail mail.Addr,
 	recipientName string,
 ) (*coredata.ExportJob, error) {
@@ -119,6 +126,7 @@ func (s FrameworkService) RequestExport(
 
 		args := coredata.FrameworkExportArguments{
 			FrameworkID: frameworkID,
+			Format:      format,
 		}
 		argsJSON, err := json.Marshal(args)
 		if err != nil {
@@ -364,6 +372,10 @@ func (s FrameworkService) Create(
 			return fmt.Errorf("cannot insert framework: %w", err)
 		}
 
+		if err := auditlog.Record(ctx, conn, s.svc.scope, framework.OrganizationID, ActionFrameworkCreate, framework.ID, nil, frameworkAuditState(framework)); err != nil {
+			return fmt.Errorf("cannot record audit log entry: %w", err)
+		}
+
 		return nil
 	})
 
@@ -462,6 +474,8 @@ func (s FrameworkService) Update(
 			return fmt.Errorf("cannot load framework: %w", err)
 		}
 
+		before := frameworkAuditState(framework)
+
 		if req.Name != nil {
 			framework.Name = *req.Name
 		}
@@ -470,7 +484,15 @@ func (s FrameworkService) Update(
 			framework.Description = *req.Description
 		}
 
-		return framework.Update(ctx, conn, s.svc.scope)
+		if err := framework.Update(ctx, conn, s.svc.scope); err != nil {
+			return fmt.Errorf("cannot update framework: %w", err)
+		}
+
+		if err := auditlog.Record(ctx, conn, s.svc.scope, framework.OrganizationID, ActionFrameworkUpdate, framework.ID, before, frameworkAuditState(framework)); err != nil {
+			return fmt.Errorf("cannot record audit log entry: %w", err)
+		}
+
+		return nil
 	})
 	if err != nil {
 		return nil, err
@@ -485,7 +507,15 @@ func (s FrameworkService) Delete(
 ) error {
 	framework := &coredata.Framework{}
 
-	return s.svc.pg.WithConn(ctx, func(conn pg.Conn) error {
+	return s.svc.pg.WithTx(ctx, func(conn pg.Conn) error {
+		if err := framework.LoadByID(ctx, conn, s.svc.scope, frameworkID); err != nil {
+			return fmt.Errorf("cannot load framework: %w", err)
+		}
+
+		if err := auditlog.Record(ctx, conn, s.svc.scope, framework.OrganizationID, ActionFrameworkDelete, framework.ID, frameworkAuditState(framework), nil); err != nil {
+			return fmt.Errorf("cannot record audit log entry: %w", err)
+		}
+
 		return framework.Delete(ctx, conn, s.svc.scope, frameworkID)
 	})
 }
@@ -496,8 +526,6 @@ func (s FrameworkService) Import(
 	req ImportFrameworkRequest,
 ) (*coredata.Framework, error) {
 	var framework *coredata.Framework
-	frameworkID := gid.New(organizationID.TenantID(), coredata.FrameworkEntityType)
-	now := time.Now()
 
 	err := s.svc.pg.WithTx(ctx, func(tx pg.Conn) error {
 		organization := &coredata.Organization{}
@@ -505,101 +533,126 @@ func (s FrameworkService) Import(
 			return fmt.Errorf("cannot load organization: %w", err)
 		}
 
-		framework = &coredata.Framework{
-			ID:             frameworkID,
-			OrganizationID: organization.ID,
-			ReferenceID:    req.Framework.ID,
-			Name:           req.Framework.Name,
-			CreatedAt:      now,
-			UpdatedAt:      now,
+		var err error
+		framework, _, err = s.importFramework(ctx, tx, organization, req.Framework)
+		if err != nil {
+			return err
 		}
 
-		if req.Framework.Logo != nil {
-			for name, logo := range map[string]string{
-				"light": req.Framework.Logo.Light,
-				"dark":  req.Framework.Logo.Dark,
-			} {
-				fileID := gid.New(s.svc.scope.GetTenantID(), coredata.FileEntityType)
-				objectKey, err := uuid.NewV7()
-				if err != nil {
-					return fmt.Errorf("cannot generate object key: %w", err)
-				}
+		if err := auditlog.Record(ctx, tx, s.svc.scope, organization.ID, ActionFrameworkImport, framework.ID, nil, frameworkAuditState(framework)); err != nil {
+			return fmt.Errorf("cannot record audit log entry: %w", err)
+		}
 
-				filename := "logo_" + name
-				contentType := "image/svg+xml"
-
-				fileRecord := &coredata.File{
-					ID:         fileID,
-					BucketName: s.svc.bucket,
-					MimeType:   contentType,
-					FileName:   filename,
-					FileKey:    objectKey.String(),
-					CreatedAt:  now,
-					UpdatedAt:  now,
-				}
+		return nil
+	})
 
-				fileSize, err := s.svc.fileManager.PutFile(ctx, fileRecord, strings.NewReader(logo), map[string]string{
-					"type":            "framework-logo",
-					"theme":           name,
-					"framework-id":    framework.ID.String(),
-					"organization-id": organization.ID.String(),
-				})
-				if err != nil {
-					return fmt.Errorf("cannot upload logo file: %w", err)
-				}
+	if err != nil {
+		return nil, err
+	}
 
-				fileRecord.FileSize = fileSize
+	return framework, nil
+}
 
-				if err := fileRecord.Insert(ctx, tx, s.svc.scope); err != nil {
-					return fmt.Errorf("cannot insert file: %w", err)
-				}
+func (s FrameworkService) importFramework(
+	ctx context.Context,
+	tx pg.Conn,
+	organization *coredata.Organization,
+	definition FrameworkDefinition,
+) (*coredata.Framework, coredata.Controls, error) {
+	frameworkID := gid.New(organization.ID.TenantID(), coredata.FrameworkEntityType)
+	now := time.Now()
 
-				if name == "light" {
-					framework.LightLogoFileID = &fileID
-				} else {
-					framework.DarkLogoFileID = &fileID
-				}
+	framework := &coredata.Framework{
+		ID:             frameworkID,
+		OrganizationID: organization.ID,
+		ReferenceID:    definition.ID,
+		Name:           definition.Name,
+		CreatedAt:      now,
+		UpdatedAt:      now,
+	}
+
+	if definition.Logo != nil {
+		for name, logo := range map[string]string{
+			"light": definition.Logo.Light,
+			"dark":  definition.Logo.Dark,
+		} {
+			fileID := gid.New(s.svc.scope.GetTenantID(), coredata.FileEntityType)
+			objectKey, err := uuid.NewV7()
+			if err != nil {
+				return nil, nil, fmt.Errorf("cannot generate object key: %w", err)
 			}
-		}
 
-		if err := framework.Insert(ctx, tx, s.svc.scope); err != nil {
-			return fmt.Errorf("cannot insert framework: %w", err)
-		}
+			filename := "logo_" + name
+			contentType := "image/svg+xml"
 
-		for _, control := range req.Framework.Controls {
-			controlID := gid.New(organization.ID.TenantID(), coredata.ControlEntityType)
+			fileRecord := &coredata.File{
+				ID:         fileID,
+				BucketName: s.svc.bucket,
+				MimeType:   contentType,
+				FileName:   filename,
+				FileKey:    objectKey.String(),
+				CreatedAt:  now,
+				UpdatedAt:  now,
+			}
 
-			now := time.Now()
-			description := control.Description
-			bestPractice := true
-			if control.BestPractice != nil {
-				bestPractice = *control.BestPractice
+			fileSize, err := s.svc.fileManager.PutFile(ctx, fileRecord, strings.NewReader(logo), map[string]string{
+				"type":            "framework-logo",
+				"theme":           name,
+				"framework-id":    framework.ID.String(),
+				"organization-id": organization.ID.String(),
+			})
+			if err != nil {
+				return nil, nil, fmt.Errorf("cannot upload logo file: %w", err)
 			}
-			control := &coredata.Control{
-				ID:             controlID,
-				FrameworkID:    frameworkID,
-				OrganizationID: organization.ID,
-				SectionTitle:   control.ID,
-				Name:           control.Name,
-				Description:    &description,
-				BestPractice:   bestPractice,
-				CreatedAt:      now,
-				UpdatedAt:      now,
+
+			fileRecord.FileSize = fileSize
+
+			if err := fileRecord.Insert(ctx, tx, s.svc.scope); err != nil {
+				return nil, nil, fmt.Errorf("cannot insert file: %w", err)
 			}
 
-			if err := control.Insert(ctx, tx, s.svc.scope); err != nil {
-				return fmt.Errorf("cannot insert control: %w", err)
+			if name == "light" {
+				framework.LightLogoFileID = &fileID
+			} else {
+				framework.DarkLogoFileID = &fileID
 			}
 		}
+	}
 
-		return nil
-	})
+	if err := framework.Insert(ctx, tx, s.svc.scope); err != nil {
+		return nil, nil, fmt.Errorf("cannot insert framework: %w", err)
+	}
 
-	if err != nil {
-		return nil, err
+	controls := make(coredata.Controls, 0, len(definition.Controls))
+	for _, control := range definition.Controls {
+		controlID := gid.New(organization.ID.TenantID(), coredata.ControlEntityType)
+
+		now := time.Now()
+		description := control.Description
+		bestPractice := true
+		if control.BestPractice != nil {
+			bestPractice = *control.BestPractice
+		}
+		control := &coredata.Control{
+			ID:             controlID,
+			FrameworkID:    frameworkID,
+			OrganizationID: organization.ID,
+			SectionTitle:   control.ID,
+			Name:           control.Name,
+			Description:    &description,
+			BestPractice:   bestPractice,
+			CreatedAt:      now,
+			UpdatedAt:      now,
+		}
+
+		if err := control.Insert(ctx, tx, s.svc.scope); err != nil {
+			return nil, nil, fmt.Errorf("cannot insert control: %w", err)
+		}
+
+		controls = append(controls, control)
 	}
 
-	return framework, nil
+	return framework, controls, nil
 }
 
 func (s FrameworkService) SendExportEmail(
@@ -684,25 +737,37 @@ func (s *FrameworkService) BuildAndUploadExport(ctx context.Context, exportJobID
 				return fmt.Errorf("cannot load export job: %w", err)
 			}
 
-			frameworkID, err := exportJob.GetFrameworkID()
+			args, err := exportJob.GetFrameworkExportArguments()
 			if err != nil {
-				return fmt.Errorf("cannot get framework ID: %w", err)
+				return fmt.Errorf("cannot get framework export arguments: %w", err)
 			}
+			frameworkID := args.FrameworkID
 
 			framework := &coredata.Framework{}
 			if err := framework.LoadByID(ctx, tx, s.svc.scope, frameworkID); err != nil {
 				return fmt.Errorf("cannot load framework: %w", err)
 			}
 
+			// Export jobs queued before formats existed have none and
+			// produce the evidence archive.
+			export := s.Export
+			extension := "zip"
+			contentType := "application/zip"
+			if args.Format == coredata.FrameworkExportFormatOSCAL {
+				export = s.ExportOSCAL
+				extension = "json"
+				contentType = "application/json"
+			}
+
 			tempDir := os.TempDir()
-			tempFile, err := os.CreateTemp(tempDir, "probo-framework-export-*.zip")
+			tempFile, err := os.CreateTemp(tempDir, "probo-framework-export-*."+extension)
 			if err != nil {
 				return fmt.Errorf("cannot create temp file: %w", err)
 			}
 			defer func() { _ = tempFile.Close() }()
 			defer func() { _ = os.Remove(tempFile.Name()) }()
 
-			err = s.Export(ctx, frameworkID, tempFile)
+			err = export(ctx, frameworkID, tempFile)
 			if err != nil {
 				return fmt.Errorf("cannot export framework: %w", err)
 			}
@@ -728,7 +793,7 @@ func (s *FrameworkService) BuildAndUploadExport(ctx context.Context, exportJobID
 					Key:           ref.Ref(uuid.String()),
 					Body:          tempFile,
 					ContentLength: ref.Ref(fileInfo.Size()),
-					ContentType:   ref.Ref("application/zip"),
+					ContentType:   ref.Ref(contentType),
 					Metadata: map[string]string{
 						"type":            "framework-export",
 						"export-job-id":   exportJob.ID.String(),
@@ -745,8 +810,8 @@ func (s *FrameworkService) BuildAndUploadExport(ctx context.Context, exportJobID
 			file := coredata.File{
 				ID:         gid.New(exportJob.ID.TenantID(), coredata.FileEntityType),
 				BucketName: s.svc.bucket,
-				MimeType:   "application/zip",
-				FileName:   fmt.Sprintf("Framework Export %s.zip", now.Format("2006-01-02")),
+				MimeType:   contentType,
+				FileName:   fmt.Sprintf("Framework Export %s.%s", now.Format("2006-01-02"), extension),
 				FileKey:    uuid.String(),
 				FileSize:   fileInfo.Size(),
 				CreatedAt:  now,
@@ -855,3 +920,11 @@ func (s FrameworkService) GenerateDarkLogoURL(
 
 	return &presignedURL, nil
 }
+
+func frameworkAuditState(f *coredata.Framework) map[string]any {
+	return map[string]any{
+		"referenceId": f.ReferenceID,
+		"name":        f.Name,
+		"description": f.Description,
+	}
+}
@@ -0,0 +1,336 @@
+// Copyright (c) 2025 Probo Inc <hello@getprobo.com>.
+//
+// Permission to use, copy, modify, and/or distribute this software for any
+// purpose with or without fee is hereby granted, provided that the above
+// copyright notice and this permission notice appear in all copies.
+//
+// THE SOFTWARE IS PROVIDED "AS IS" AND THE AUTHOR DISCLAIMS ALL WARRANTIES WITH
+// REGARD TO THIS SOFTWARE INCLUDING ALL IMPLIED WARRANTIES OF MERCHANTABILITY
+// AND FITNESS. IN NO EVENT SHALL THE AUTHOR BE LIABLE FOR ANY SPECIAL, DIRECT,
+// INDIRECT, OR CONSEQUENTIAL DAMAGES OR ANY DAMAGES WHATSOEVER RESULTING FROM
+// LOSS OF USE, DATA OR PROFITS, WHETHER IN AN ACTION OF CONTRACT, NEGLIGENCE OR
+// OTHER TORTIOUS ACTION, ARISING OUT OF OR IN CONNECTION WITH THE USE OR
+// PERFORMANCE OF THIS SOFTWARE.
+
+package probo
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+
+	"go.gearno.de/crypto/uuid"
+	"go.gearno.de/kit/pg"
+	"go.probo.inc/probo/pkg/auditlog"
+	"go.probo.inc/probo/pkg/coredata"
+	"go.probo.inc/probo/pkg/gid"
+	"go.probo.inc/probo/pkg/page"
+	"go.probo.inc/probo/pkg/webhook"
+	webhooktypes "go.probo.inc/probo/pkg/webhook/types"
+)
+
+// frameworkUpgradeMaxRows bounds the controls of the upgraded framework and
+// the applicability statements of each of its controls. Upgrades going past
+// it are refused rather than partially migrated.
+const frameworkUpgradeMaxRows = 10_000
+
+type (
+	// UpgradeFrameworkRequest replaces a framework by a new version of it.
+	// The mapping file lists, for each control of the current version, the
+	// controls of the new version it became.
+	UpgradeFrameworkRequest struct {
+		Framework FrameworkDefinition
+		Mapping   struct {
+			Mappings []struct {
+				Source  string   `json:"source"`
+				Targets []string `json:"targets"`
+			} `json:"mappings"`
+		}
+	}
+
+	UpgradeFrameworkResult struct {
+		Framework            *coredata.Framework
+		MigratedControlCount int
+		UnmappedControls     coredata.Controls
+		NewControls          coredata.Controls
+		Tasks                coredata.Tasks
+	}
+)
+
+// Upgrade imports a new version of a framework and moves the measure,
+// document and obligation mappings and the applicability statements of
+// the previous version controls onto the controls they map to. Previous
+// controls without a mapping and new controls nothing maps to are
+// reported as tasks. The previous version is kept for audit history.
+func (s FrameworkService) Upgrade(
+	ctx context.Context,
+	frameworkID gid.GID,
+	req UpgradeFrameworkRequest,
+) (*UpgradeFrameworkResult, error) {
+	result := &UpgradeFrameworkResult{}
+
+	err := s.svc.pg.WithTx(ctx, func(tx pg.Conn) error {
+		previousFramework := &coredata.Framework{}
+		if err := previousFramework.LoadByID(ctx, tx, s.svc.scope, frameworkID); err != nil {
+			return fmt.Errorf("cannot load framework: %w", err)
+		}
+
+		organization := &coredata.Organization{}
+		if err := organization.LoadByID(ctx, tx, s.svc.scope, previousFramework.OrganizationID); err != nil {
+			return fmt.Errorf("cannot load organization: %w", err)
+		}
+
+		previousControls := coredata.Controls{}
+		err := previousControls.LoadByFrameworkID(
+			ctx,
+			tx,
+			s.svc.scope,
+			previousFramework.ID,
+			page.NewCursor(
+				frameworkUpgradeMaxRows,
+				nil,
+				page.Head,
+				page.OrderBy[coredata.ControlOrderField]{
+					Field:     coredata.ControlOrderFieldSectionTitle,
+					Direction: page.OrderDirectionAsc,
+				},
+			),
+			coredata.NewControlFilter(nil),
+		)
+		if err != nil {
+			return fmt.Errorf("cannot load controls: %w", err)
+		}
+
+		if len(previousControls) > frameworkUpgradeMaxRows {
+			return fmt.Errorf("cannot upgrade framework with more than %d controls", frameworkUpgradeMaxRows)
+		}
+
+		framework, controls, err := s.importFramework(ctx, tx, organization, req.Framework)
+		if err != nil {
+			return err
+		}
+		result.Framework = framework
+
+		controlsBySectionTitle := make(map[string]*coredata.Control, len(controls))
+		for _, control := range controls {
+			controlsBySectionTitle[control.SectionTitle] = control
+		}
+
+		targetsBySectionTitle := map[string][]string{}
+		for _, mapping := range req.Mapping.Mappings {
+			targetsBySectionTitle[mapping.Source] = append(targetsBySectionTitle[mapping.Source], mapping.Targets...)
+		}
+
+		now := time.Now()
+		mappedControls := map[gid.GID]bool{}
+
+		for _, previousControl := range previousControls {
+			var targetControlIDs []gid.GID
+			for _, target := range targetsBySectionTitle[previousControl.SectionTitle] {
+				control, ok := controlsBySectionTitle[target]
+				if !ok || slices.Contains(targetControlIDs, control.ID) {
+					continue
+				}
+
+				targetControlIDs = append(targetControlIDs, control.ID)
+				mappedControls[control.ID] = true
+			}
+
+			if len(targetControlIDs) == 0 {
+				result.UnmappedControls = append(result.UnmappedControls, previousControl)
+				continue
+			}
+
+			if err := previousControl.MoveMappings(ctx, tx, s.svc.scope, targetControlIDs, now); err != nil {
+				return fmt.Errorf("cannot move control mappings: %w", err)
+			}
+
+			if err := s.moveApplicabilityStatements(ctx, tx, previousControl, targetControlIDs, now); err != nil {
+				return err
+			}
+
+			result.MigratedControlCount++
+		}
+
+		for _, control := range controls {
+			if !mappedControls[control.ID] {
+				result.NewControls = append(result.NewControls, control)
+			}
+		}
+
+		for _, control := range result.UnmappedControls {
+			task, err := s.insertUpgradeTask(
+				ctx,
+				tx,
+				organization.ID,
+				fmt.Sprintf("Review unmapped control %s %s", previousFramework.Name, control.SectionTitle),
+				fmt.Sprintf(
+					"Control %s %q of %s has no equivalent in %s. Its measures, documents and obligations were not migrated.",
+					control.SectionTitle,
+					control.Name,
+					previousFramework.Name,
+					framework.Name,
+				),
+				now,
+			)
+			if err != nil {
+				return err
+			}
+
+			result.Tasks = append(result.Tasks, task)
+		}
+
+		for _, control := range result.NewControls {
+			task, err := s.insertUpgradeTask(
+				ctx,
+				tx,
+				organization.ID,
+				fmt.Sprintf("Implement new control %s %s", framework.Name, control.SectionTitle),
+				fmt.Sprintf(
+					"Control %s %q was introduced by %s and does not replace any control of %s.",
+					control.SectionTitle,
+					control.Name,
+					framework.Name,
+					previousFramework.Name,
+				),
+				now,
+			)
+			if err != nil {
+				return err
+			}
+
+			result.Tasks = append(result.Tasks, task)
+		}
+
+		after := map[string]any{
+			"previousFrameworkId":  previousFramework.ID,
+			"referenceId":          framework.ReferenceID,
+			"name":                 framework.Name,
+			"migratedControlCount": result.MigratedControlCount,
+			"unmappedControlCount": len(result.UnmappedControls),
+			"newControlCount":      len(result.NewControls),
+		}
+		if err := auditlog.Record(ctx, tx, s.svc.scope, organization.ID, ActionFrameworkUpgrade, framework.ID, nil, after); err != nil {
+			return fmt.Errorf("cannot record audit log entry: %w", err)
+		}
+
+		return nil
+	})
+
+	if err != nil {
+		return nil, fmt.Errorf("cannot upgrade framework: %w", err)
+	}
+
+	return result, nil
+}
+
+func (s FrameworkService) moveApplicabilityStatements(
+	ctx context.Context,
+	tx pg.Conn,
+	control *coredata.Control,
+	targetControlIDs []gid.GID,
+	now time.Time,
+) error {
+	statements := coredata.ApplicabilityStatements{}
+	err := statements.LoadByControlID(
+		ctx,
+		tx,
+		s.svc.scope,
+		control.ID,
+		page.NewCursor(
+			frameworkUpgradeMaxRows,
+			nil,
+			page.Head,
+			page.OrderBy[coredata.ApplicabilityStatementOrderField]{
+				Field:     coredata.ApplicabilityStatementOrderFieldCreatedAt,
+				Direction: page.OrderDirectionAsc,
+			},
+		),
+	)
+	if err != nil {
+		return fmt.Errorf("cannot load applicability statements: %w", err)
+	}
+
+	if len(statements) > frameworkUpgradeMaxRows {
+		return fmt.Errorf("cannot move more than %d applicability statements of control %q", frameworkUpgradeMaxRows, control.ID)
+	}
+
+	for _, statement := range statements {
+		if statement.SnapshotID != nil {
+			continue
+		}
+
+		for _, targetControlID := range targetControlIDs {
+			existing := &coredata.ApplicabilityStatement{}
+			err := existing.LoadByStateOfApplicabilityIDAndControlID(ctx, tx, s.svc.scope, statement.StateOfApplicabilityID, targetControlID)
+			if err == nil {
+				continue
+			}
+			if !errors.Is(err, coredata.ErrResourceNotFound) {
+				return fmt.Errorf("cannot load applicability statement: %w", err)
+			}
+
+			moved := &coredata.ApplicabilityStatement{
+				ID:                     gid.New(s.svc.scope.GetTenantID(), coredata.ApplicabilityStatementEntityType),
+				StateOfApplicabilityID: statement.StateOfApplicabilityID,
+				ControlID:              targetControlID,
+				OrganizationID:         statement.OrganizationID,
+				Applicability:          statement.Applicability,
+				Justification:          statement.Justification,
+				CreatedAt:              now,
+				UpdatedAt:              now,
+			}
+
+			if err := moved.Insert(ctx, tx, s.svc.scope); err != nil {
+				return fmt.Errorf("cannot insert applicability statement: %w", err)
+			}
+		}
+
+		if err := statement.Delete(ctx, tx, s.svc.scope); err != nil {
+			return fmt.Errorf("cannot delete applicability statement: %w", err)
+		}
+	}
+
+	return nil
+}
+
+func (s FrameworkService) insertUpgradeTask(
+	ctx context.Context,
+	tx pg.Conn,
+	organizationID gid.GID,
+	name string,
+	description string,
+	now time.Time,
+) (*coredata.Task, error) {
+	referenceID, err := uuid.NewV4()
+	if err != nil {
+		return nil, fmt.Errorf("cannot generate reference id: %w", err)
+	}
+
+	task := &coredata.Task{
+		ID:             gid.New(s.svc.scope.GetTenantID(), coredata.TaskEntityType),
+		OrganizationID: organizationID,
+		Name:           name,
+		Description:    &description,
+		State:          coredata.TaskStateTodo,
+		ReferenceID:    "framework-upgrade-" + referenceID.String(),
+		CreatedAt:      now,
+		UpdatedAt:      now,
+	}
+
+	if err := task.Insert(ctx, tx, s.svc.scope); err != nil {
+		return nil, fmt.Errorf("cannot insert task: %w", err)
+	}
+
+	if err := webhook.InsertData(ctx, tx, s.svc.scope, task.OrganizationID, coredata.WebhookEventTypeTaskCreated, webhooktypes.NewTask(task)); err != nil {
+		return nil, fmt.Errorf("cannot insert webhook event: %w", err)
+	}
+
+	if err := auditlog.Record(ctx, tx, s.svc.scope, task.OrganizationID, ActionTaskCreate, task.ID, nil, webhooktypes.NewTask(task)); err != nil {
+		return nil, fmt.Errorf("cannot record audit log entry: %w", err)
+	}
+
+	return task, nil
+}
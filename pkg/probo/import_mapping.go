@@ -0,0 +1,585 @@
+// Copyright (c) 2025 Probo Inc <hello@getprobo.com>.
+//
+// Permission to use, copy, modify, and/or distribute this software for any
+// purpose with or without fee is hereby granted, provided that the above
+// copyright notice and this permission notice appear in all copies.
+//
+// THE SOFTWARE IS PROVIDED "AS IS" AND THE AUTHOR DISCLAIMS ALL WARRANTIES WITH
+// REGARD TO THIS SOFTWARE INCLUDING ALL IMPLIED WARRANTIES OF MERCHANTABILITY
+// AND FITNESS. IN NO EVENT SHALL THE AUTHOR BE LIABLE FOR ANY SPECIAL, DIRECT,
+// INDIRECT, OR CONSEQUENTIAL DAMAGES OR ANY DAMAGES WHATSOEVER RESULTING FROM
+// LOSS OF USE, DATA OR PROFITS, WHETHER IN AN ACTION OF CONTRACT, NEGLIGENCE OR
+// OTHER TORTIOUS ACTION, ARISING OUT OF OR IN CONNECTION WITH THE USE OR
+// PERFORMANCE OF THIS SOFTWARE.
+
+package probo
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"go.gearno.de/kit/pg"
+	"go.probo.inc/probo/pkg/coredata"
+	"go.probo.inc/probo/pkg/gid"
+	"go.probo.inc/probo/pkg/validator"
+)
+
+type (
+	// importColumn is a column of an import spreadsheet. Field is the
+	// name the request validator reports errors under when it differs
+	// from the column name.
+	importColumn struct {
+		name     string
+		field    string
+		required bool
+		profile  bool
+	}
+
+	// importRecord reads the cells of a data row by column name and
+	// collects the problems found while doing so.
+	importRecord struct {
+		row      int
+		cells    map[string]string
+		profiles map[string]gid.GID
+		errors   coredata.ImportJobRowErrors
+	}
+
+	importRequest interface {
+		Validate() error
+	}
+
+	// importedRow is a valid row of an import, ready to be created.
+	importedRow struct {
+		row    int
+		create func(ctx context.Context, conn pg.Conn) error
+	}
+)
+
+var importColumns = map[coredata.ImportJobType][]importColumn{
+	coredata.ImportJobTypeRisk: {
+		{name: "name", required: true},
+		{name: "description"},
+		{name: "category", required: true},
+		{name: "treatment", required: true},
+		{name: "owner", field: "owner_id", profile: true},
+		{name: "inherent_likelihood", required: true},
+		{name: "inherent_impact", required: true},
+		{name: "residual_likelihood"},
+		{name: "residual_impact"},
+		{name: "note"},
+	},
+	coredata.ImportJobTypeAsset: {
+		{name: "name", required: true},
+		{name: "amount", required: true},
+		{name: "owner", field: "owner_id", required: true, profile: true},
+		{name: "asset_type", required: true},
+		{name: "data_types_stored", required: true},
+	},
+	coredata.ImportJobTypeVendor: {
+		{name: "name", required: true},
+		{name: "description"},
+		{name: "legal_name", field: "cvr.LegalName"},
+		{name: "headquarter_address"},
+		{name: "website_url"},
+		{name: "category"},
+		{name: "privacy_policy_url"},
+		{name: "terms_of_service_url"},
+		{name: "security_page_url"},
+		{name: "trust_page_url"},
+		{name: "status_page_url"},
+		{name: "certifications"},
+		{name: "countries"},
+		{name: "business_owner", field: "business_owner_id", profile: true},
+		{name: "security_owner", field: "security_owner_id", profile: true},
+	},
+	coredata.ImportJobTypeDatum: {
+		{name: "name", required: true},
+		{name: "data_classification", required: true},
+		{name: "owner", field: "owner_id", required: true, profile: true},
+	},
+	coredata.ImportJobTypeProcessingActivity: {
+		{name: "name", required: true},
+		{name: "purpose"},
+		{name: "data_subject_category"},
+		{name: "personal_data_category"},
+		{name: "special_or_criminal_data", required: true},
+		{name: "consent_evidence_link"},
+		{name: "lawful_basis", required: true},
+		{name: "recipients"},
+		{name: "location"},
+		{name: "international_transfers"},
+		{name: "transfer_safeguard"},
+		{name: "retention_period"},
+		{name: "security_measures"},
+		{name: "data_protection_impact_assessment_needed", required: true},
+		{name: "transfer_impact_assessment_needed", required: true},
+		{name: "last_review_date"},
+		{name: "next_review_date"},
+		{name: "role", required: true},
+		{name: "data_protection_officer", field: "data_protection_officer_id", profile: true},
+	},
+}
+
+// excelEpoch is the day zero of the date serial numbers of spreadsheets.
+var excelEpoch = time.Date(1899, 12, 30, 0, 0, 0, 0, time.UTC)
+
+// buildImport maps the rows of an import spreadsheet to the requests
+// creating them. The first row is the header naming the columns; columns
+// are matched case insensitively with spaces and dashes read as
+// underscores, and unknown columns are ignored. Members are referenced by
+// email address and enumerations by their label.
+func (s ImportService) buildImport(
+	ctx context.Context,
+	conn pg.Conn,
+	organizationID gid.GID,
+	importType coredata.ImportJobType,
+	rows []coredata.ImportJobRow,
+) ([]importedRow, coredata.ImportJobRowErrors, error) {
+	columns, ok := importColumns[importType]
+	if !ok {
+		return nil, nil, fmt.Errorf("unsupported import type %q", importType)
+	}
+
+	records, rowErrors := readImportRecords(columns, rows)
+	if len(rowErrors) > 0 {
+		return nil, rowErrors, nil
+	}
+
+	emailAddresses := []string{}
+	for _, record := range records {
+		for _, column := range columns {
+			if value := record.cells[column.name]; column.profile && value != "" {
+				emailAddresses = append(emailAddresses, value)
+			}
+		}
+	}
+
+	profiles := coredata.MembershipProfiles{}
+	if err := profiles.LoadByOrganizationIDAndEmailAddresses(ctx, conn, s.svc.scope, organizationID, emailAddresses); err != nil {
+		return nil, nil, fmt.Errorf("cannot load profiles: %w", err)
+	}
+
+	profileIDs := make(map[string]gid.GID, len(profiles))
+	for _, profile := range profiles {
+		profileIDs[strings.ToLower(profile.EmailAddress.String())] = profile.ID
+	}
+
+	imported, rowErrors := s.mapImportRecords(records, profileIDs, organizationID, importType)
+
+	return imported, rowErrors, nil
+}
+
+// readImportRecords reads the data rows by column name after checking
+// the header has every required column once.
+func readImportRecords(
+	columns []importColumn,
+	rows []coredata.ImportJobRow,
+) ([]*importRecord, coredata.ImportJobRowErrors) {
+	if len(rows) == 0 {
+		return nil, coredata.ImportJobRowErrors{{Row: 1, Message: "spreadsheet is empty"}}
+	}
+
+	header := rows[0]
+	known := make(map[string]importColumn, len(columns))
+	for _, column := range columns {
+		known[column.name] = column
+	}
+
+	var rowErrors coredata.ImportJobRowErrors
+
+	indexes := make(map[string]int, len(header.Cells))
+	for i, cell := range header.Cells {
+		name := importColumnName(cell)
+		if _, ok := known[name]; !ok {
+			continue
+		}
+
+		if _, ok := indexes[name]; ok {
+			rowErrors = append(rowErrors, coredata.ImportJobRowError{Row: header.Number, Column: name, Message: "duplicate column"})
+			continue
+		}
+
+		indexes[name] = i
+	}
+
+	for _, column := range columns {
+		if _, ok := indexes[column.name]; !ok && column.required {
+			rowErrors = append(rowErrors, coredata.ImportJobRowError{Row: header.Number, Column: column.name, Message: "missing column"})
+		}
+	}
+
+	if len(rowErrors) > 0 {
+		return nil, rowErrors
+	}
+
+	if len(rows) == 1 {
+		return nil, coredata.ImportJobRowErrors{{Row: header.Number, Message: "spreadsheet has no data row"}}
+	}
+
+	records := make([]*importRecord, 0, len(rows)-1)
+	for _, row := range rows[1:] {
+		record := &importRecord{row: row.Number, cells: make(map[string]string, len(indexes))}
+		for name, i := range indexes {
+			if i < len(row.Cells) {
+				record.cells[name] = strings.TrimSpace(row.Cells[i])
+			}
+		}
+
+		records = append(records, record)
+	}
+
+	return records, nil
+}
+
+// mapImportRecords maps every record to the request creating it,
+// keeping the valid ones and the errors of the others.
+func (s ImportService) mapImportRecords(
+	records []*importRecord,
+	profileIDs map[string]gid.GID,
+	organizationID gid.GID,
+	importType coredata.ImportJobType,
+) ([]importedRow, coredata.ImportJobRowErrors) {
+	columns := importColumns[importType]
+
+	var rowErrors coredata.ImportJobRowErrors
+	imported := make([]importedRow, 0, len(records))
+	for _, record := range records {
+		record.profiles = profileIDs
+
+		for _, column := range columns {
+			if column.required {
+				record.required(column.name)
+			}
+		}
+
+		req, create := s.importRow(record, importType, organizationID)
+		if len(record.errors) == 0 {
+			record.validate(req, columns)
+		}
+
+		if len(record.errors) > 0 {
+			rowErrors = append(rowErrors, record.errors...)
+			continue
+		}
+
+		imported = append(imported, importedRow{row: record.row, create: create})
+	}
+
+	return imported, rowErrors
+}
+
+func (s ImportService) importRow(
+	r *importRecord,
+	importType coredata.ImportJobType,
+	organizationID gid.GID,
+) (importRequest, func(ctx context.Context, conn pg.Conn) error) {
+	switch importType {
+	case coredata.ImportJobTypeRisk:
+		req := CreateRiskRequest{
+			OrganizationID:     organizationID,
+			Name:               r.string("name"),
+			Description:        r.optionalString("description"),
+			Category:           r.string("category"),
+			Treatment:          importEnum(r, "treatment", coredata.RiskTreatments()),
+			OwnerID:            r.profile("owner"),
+			InherentLikelihood: r.int("inherent_likelihood"),
+			InherentImpact:     r.int("inherent_impact"),
+			ResidualLikelihood: r.optionalInt("residual_likelihood"),
+			ResidualImpact:     r.optionalInt("residual_impact"),
+			Note:               r.optionalString("note"),
+		}
+
+		return &req, func(ctx context.Context, conn pg.Conn) error {
+			_, err := s.svc.Risks.createInTx(ctx, conn, req)
+			return err
+		}
+
+	case coredata.ImportJobTypeAsset:
+		req := CreateAssetRequest{
+			OrganizationID:  organizationID,
+			Name:            r.string("name"),
+			Amount:          r.int("amount"),
+			AssetType:       importEnum(r, "asset_type", coredata.AssetTypes()),
+			DataTypesStored: r.string("data_types_stored"),
+		}
+		if ownerID := r.profile("owner"); ownerID != nil {
+			req.OwnerID = *ownerID
+		}
+
+		return &req, func(ctx context.Context, conn pg.Conn) error {
+			_, err := s.svc.Assets.createInTx(ctx, conn, req)
+			return err
+		}
+
+	case coredata.ImportJobTypeVendor:
+		req := CreateVendorRequest{
+			OrganizationID:     organizationID,
+			Name:               r.string("name"),
+			Description:        r.optionalString("description"),
+			LegalName:          r.optionalString("legal_name"),
+			HeadquarterAddress: r.optionalString("headquarter_address"),
+			WebsiteURL:         r.optionalString("website_url"),
+			PrivacyPolicyURL:   r.optionalString("privacy_policy_url"),
+			TermsOfServiceURL:  r.optionalString("terms_of_service_url"),
+			SecurityPageURL:    r.optionalString("security_page_url"),
+			TrustPageURL:       r.optionalString("trust_page_url"),
+			StatusPageURL:      r.optionalString("status_page_url"),
+			Certifications:     r.list("certifications"),
+			Countries:          r.countries("countries"),
+			BusinessOwnerID:    r.profile("business_owner"),
+			SecurityOwnerID:    r.profile("security_owner"),
+		}
+		if r.cells["category"] != "" {
+			category := importEnum(r, "category", coredata.VendorCategories())
+			req.Category = &category
+		}
+
+		return &req, func(ctx context.Context, conn pg.Conn) error {
+			_, err := s.svc.Vendors.createInTx(ctx, conn, req)
+			return err
+		}
+
+	case coredata.ImportJobTypeDatum:
+		req := CreateDatumRequest{
+			OrganizationID:     organizationID,
+			Name:               r.string("name"),
+			DataClassification: importEnum(r, "data_classification", coredata.DataClassifications()),
+		}
+		if ownerID := r.profile("owner"); ownerID != nil {
+			req.OwnerID = *ownerID
+		}
+
+		return &req, func(ctx context.Context, conn pg.Conn) error {
+			_, err := s.svc.Data.createInTx(ctx, conn, req)
+			return err
+		}
+
+	case coredata.ImportJobTypeProcessingActivity:
+		req := &CreateProcessingActivityRequest{
+			OrganizationID:                       organizationID,
+			Name:                                 r.string("name"),
+			Purpose:                              r.optionalString("purpose"),
+			DataSubjectCategory:                  r.optionalString("data_subject_category"),
+			PersonalDataCategory:                 r.optionalString("personal_data_category"),
+			SpecialOrCriminalData:                importEnum(r, "special_or_criminal_data", coredata.ProcessingActivitySpecialOrCriminalData()),
+			ConsentEvidenceLink:                  r.optionalString("consent_evidence_link"),
+			LawfulBasis:                          importEnum(r, "lawful_basis", coredata.ProcessingActivityLawfulBases()),
+			Recipients:                           r.optionalString("recipients"),
+			Location:                             r.optionalString("location"),
+			InternationalTransfers:               r.bool("international_transfers"),
+			RetentionPeriod:                      r.optionalString("retention_period"),
+			SecurityMeasures:                     r.optionalString("security_measures"),
+			DataProtectionImpactAssessmentNeeded: importEnum(r, "data_protection_impact_assessment_needed", coredata.ProcessingActivityDataProtectionImpactAssessments()),
+			TransferImpactAssessmentNeeded:       importEnum(r, "transfer_impact_assessment_needed", coredata.ProcessingActivityTransferImpactAssessments()),
+			LastReviewDate:                       r.date("last_review_date"),
+			NextReviewDate:                       r.date("next_review_date"),
+			Role:                                 importEnum(r, "role", coredata.ProcessingActivityRoles()),
+			DataProtectionOfficerID:              r.profile("data_protection_officer"),
+		}
+		if r.cells["transfer_safeguard"] != "" {
+			safeguard := importEnum(r, "transfer_safeguard", coredata.ProcessingActivityTransferSafeguards())
+			req.TransferSafeguard = &safeguard
+		}
+
+		return req, func(ctx context.Context, conn pg.Conn) error {
+			_, err := s.svc.ProcessingActivities.createInTx(ctx, conn, req)
+			return err
+		}
+	}
+
+	panic(fmt.Sprintf("unsupported import type %q", importType))
+}
+
+// importColumnName normalizes a header cell to a column name.
+func importColumnName(cell string) string {
+	name := strings.ToLower(strings.TrimSpace(cell))
+	name = strings.NewReplacer(" ", "_", "-", "_").Replace(name)
+
+	return name
+}
+
+// importEnum reads an enumeration value from its label, such as "Cloud
+// provider" for CLOUD_PROVIDER.
+func importEnum[T ~string](r *importRecord, column string, values []T) T {
+	value := r.cells[column]
+	if value == "" {
+		return ""
+	}
+
+	normalized := strings.ToUpper(strings.Join(strings.FieldsFunc(value, func(c rune) bool {
+		return c == ' ' || c == '-' || c == '_' || c == '/'
+	}), "_"))
+
+	labels := make([]string, len(values))
+	for i, v := range values {
+		if string(v) == normalized {
+			return v
+		}
+		labels[i] = string(v)
+	}
+
+	r.fail(column, fmt.Sprintf("invalid value %q, expected one of %s", value, strings.Join(labels, ", ")))
+
+	return ""
+}
+
+func (r *importRecord) fail(column string, message string) {
+	r.errors = append(r.errors, coredata.ImportJobRowError{Row: r.row, Column: column, Message: message})
+}
+
+func (r *importRecord) required(column string) {
+	if r.cells[column] == "" {
+		r.fail(column, "value is required")
+	}
+}
+
+func (r *importRecord) string(column string) string {
+	return r.cells[column]
+}
+
+func (r *importRecord) optionalString(column string) *string {
+	if value := r.cells[column]; value != "" {
+		return &value
+	}
+
+	return nil
+}
+
+func (r *importRecord) int(column string) int {
+	value := r.optionalInt(column)
+	if value == nil {
+		return 0
+	}
+
+	return *value
+}
+
+func (r *importRecord) optionalInt(column string) *int {
+	value := r.cells[column]
+	if value == "" {
+		return nil
+	}
+
+	// Spreadsheets store whole numbers as floating point, such as "3.0".
+	f, err := strconv.ParseFloat(value, 64)
+	if err != nil || f != float64(int(f)) {
+		r.fail(column, fmt.Sprintf("invalid number %q", value))
+		return nil
+	}
+
+	n := int(f)
+	return &n
+}
+
+func (r *importRecord) bool(column string) bool {
+	switch value := strings.ToLower(r.cells[column]); value {
+	case "", "no", "false", "0", "n":
+		return false
+	case "yes", "true", "1", "y":
+		return true
+	default:
+		r.fail(column, fmt.Sprintf("invalid boolean %q, expected yes or no", r.cells[column]))
+		return false
+	}
+}
+
+func (r *importRecord) date(column string) *time.Time {
+	value := r.cells[column]
+	if value == "" {
+		return nil
+	}
+
+	if t, err := time.Parse(time.DateOnly, value); err == nil {
+		return &t
+	}
+
+	if serial, err := strconv.ParseFloat(value, 64); err == nil && serial > 0 {
+		t := excelEpoch.AddDate(0, 0, int(serial))
+		return &t
+	}
+
+	r.fail(column, fmt.Sprintf("invalid date %q, expected YYYY-MM-DD", value))
+	return nil
+}
+
+func (r *importRecord) list(column string) []string {
+	value := r.cells[column]
+	if value == "" {
+		return nil
+	}
+
+	var items []string
+	for item := range strings.FieldsFuncSeq(value, func(c rune) bool { return c == ',' || c == ';' }) {
+		if item = strings.TrimSpace(item); item != "" {
+			items = append(items, item)
+		}
+	}
+
+	return items
+}
+
+func (r *importRecord) countries(column string) coredata.CountryCodes {
+	items := r.list(column)
+	if items == nil {
+		return nil
+	}
+
+	countries := make(coredata.CountryCodes, 0, len(items))
+	for _, item := range items {
+		var country coredata.CountryCode
+		if err := country.Scan(strings.ToUpper(item)); err != nil {
+			r.fail(column, fmt.Sprintf("invalid country code %q", item))
+			continue
+		}
+		countries = append(countries, country)
+	}
+
+	return countries
+}
+
+func (r *importRecord) profile(column string) *gid.GID {
+	value := r.cells[column]
+	if value == "" {
+		return nil
+	}
+
+	id, ok := r.profiles[strings.ToLower(value)]
+	if !ok {
+		r.fail(column, fmt.Sprintf("no member with email address %q", value))
+		return nil
+	}
+
+	return &id
+}
+
+// validate runs the request validation and reports its errors on the
+// columns they come from.
+func (r *importRecord) validate(req importRequest, columns []importColumn) {
+	err := req.Validate()
+	if err == nil {
+		return
+	}
+
+	var validationErrors validator.ValidationErrors
+	if !errors.As(err, &validationErrors) {
+		r.fail("", err.Error())
+		return
+	}
+
+	fields := make(map[string]string, len(columns))
+	for _, column := range columns {
+		field := column.field
+		if field == "" {
+			field = column.name
+		}
+		fields[field] = column.name
+	}
+
+	for _, validationError := range validationErrors {
+		r.fail(fields[validationError.Field], validationError.Message)
+	}
+}
@@ -0,0 +1,263 @@
+// Copyright (c) 2025 Probo Inc <hello@getprobo.com>.
+//
+// Permission to use, copy, modify, and/or distribute this software for any
+// purpose with or without fee is hereby granted, provided that the above
+// copyright notice and this permission notice appear in all copies.
+//
+// THE SOFTWARE IS PROVIDED "AS IS" AND THE AUTHOR DISCLAIMS ALL WARRANTIES WITH
+// REGARD TO THIS SOFTWARE INCLUDING ALL IMPLIED WARRANTIES OF MERCHANTABILITY
+// AND FITNESS. IN NO EVENT SHALL THE AUTHOR BE LIABLE FOR ANY SPECIAL, DIRECT,
+// INDIRECT, OR CONSEQUENTIAL DAMAGES OR ANY DAMAGES WHATSOEVER RESULTING FROM
+// LOSS OF USE, DATA OR PROFITS, WHETHER IN AN ACTION OF CONTRACT, NEGLIGENCE OR
+// OTHER TORTIOUS ACTION, ARISING OUT OF OR IN CONNECTION WITH THE USE OR
+// PERFORMANCE OF THIS SOFTWARE.
+
+package probo
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.probo.inc/probo/pkg/coredata"
+	"go.probo.inc/probo/pkg/gid"
+)
+
+func TestReadImportRecords(t *testing.T) {
+	columns := importColumns[coredata.ImportJobTypeDatum]
+
+	tests := []struct {
+		name       string
+		rows       []coredata.ImportJobRow
+		wantCells  []map[string]string
+		wantErrors coredata.ImportJobRowErrors
+	}{
+		{
+			name:       "empty spreadsheet",
+			wantErrors: coredata.ImportJobRowErrors{{Row: 1, Message: "spreadsheet is empty"}},
+		},
+		{
+			name: "header only",
+			rows: []coredata.ImportJobRow{
+				{Number: 1, Cells: []string{"Name", "Data classification", "Owner"}},
+			},
+			wantErrors: coredata.ImportJobRowErrors{{Row: 1, Message: "spreadsheet has no data row"}},
+		},
+		{
+			name: "missing and duplicate columns",
+			rows: []coredata.ImportJobRow{
+				{Number: 2, Cells: []string{"name", "NAME", "owner"}},
+				{Number: 3, Cells: []string{"Customer data", "Customer data", "alice@example.com"}},
+			},
+			wantErrors: coredata.ImportJobRowErrors{
+				{Row: 2, Column: "name", Message: "duplicate column"},
+				{Row: 2, Column: "data_classification", Message: "missing column"},
+			},
+		},
+		{
+			name: "columns are normalized and unknown columns ignored",
+			rows: []coredata.ImportJobRow{
+				{Number: 1, Cells: []string{" Name ", "data-classification", "Comment", "OWNER"}},
+				{Number: 2, Cells: []string{"Customer data ", "Confidential", "ignored", " alice@example.com"}},
+				{Number: 4, Cells: []string{"Logs"}},
+			},
+			wantCells: []map[string]string{
+				{"name": "Customer data", "data_classification": "Confidential", "owner": "alice@example.com"},
+				{"name": "Logs"},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			records, rowErrors := readImportRecords(columns, tt.rows)
+
+			assert.Equal(t, tt.wantErrors, rowErrors)
+			require.Len(t, records, len(tt.wantCells))
+			for i, cells := range tt.wantCells {
+				assert.Equal(t, cells, records[i].cells)
+			}
+		})
+	}
+}
+
+func TestMapImportRecords(t *testing.T) {
+	organizationID := gid.New(gid.NewTenantID(), coredata.OrganizationEntityType)
+	profileID := gid.New(organizationID.TenantID(), coredata.MembershipProfileEntityType)
+	profileIDs := map[string]gid.GID{"alice@example.com": profileID}
+
+	tests := []struct {
+		name       string
+		importType coredata.ImportJobType
+		cells      map[string]string
+		wantErrors coredata.ImportJobRowErrors
+	}{
+		{
+			name:       "valid risk",
+			importType: coredata.ImportJobTypeRisk,
+			cells: map[string]string{
+				"name":                "Data leak",
+				"category":            "Security",
+				"treatment":           "mitigated",
+				"owner":               "Alice@Example.com",
+				"inherent_likelihood": "3.0",
+				"inherent_impact":     "4",
+			},
+		},
+		{
+			name:       "missing required value",
+			importType: coredata.ImportJobTypeRisk,
+			cells: map[string]string{
+				"name":                "Data leak",
+				"treatment":           "Mitigated",
+				"inherent_likelihood": "3",
+				"inherent_impact":     "4",
+			},
+			wantErrors: coredata.ImportJobRowErrors{{Row: 2, Column: "category", Message: "value is required"}},
+		},
+		{
+			name:       "unknown member",
+			importType: coredata.ImportJobTypeDatum,
+			cells: map[string]string{
+				"name":                "Customer data",
+				"data_classification": "Confidential",
+				"owner":               "bob@example.com",
+			},
+			wantErrors: coredata.ImportJobRowErrors{{Row: 2, Column: "owner", Message: `no member with email address "bob@example.com"`}},
+		},
+		{
+			name:       "validator error on column",
+			importType: coredata.ImportJobTypeRisk,
+			cells: map[string]string{
+				"name":                "Data leak",
+				"category":            "Security",
+				"treatment":           "Mitigated",
+				"inherent_likelihood": "9",
+				"inherent_impact":     "4",
+			},
+			wantErrors: coredata.ImportJobRowErrors{{Row: 2, Column: "inherent_likelihood"}},
+		},
+		{
+			name:       "validator error on renamed field",
+			importType: coredata.ImportJobTypeVendor,
+			cells: map[string]string{
+				"name":       "Acme",
+				"legal_name": "Acme\nInc.",
+			},
+			wantErrors: coredata.ImportJobRowErrors{{Row: 2, Column: "legal_name"}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			records := []*importRecord{{row: 2, cells: tt.cells}}
+
+			imported, rowErrors := ImportService{}.mapImportRecords(records, profileIDs, organizationID, tt.importType)
+
+			if tt.wantErrors == nil {
+				assert.Empty(t, rowErrors)
+				require.Len(t, imported, 1)
+				assert.Equal(t, 2, imported[0].row)
+				return
+			}
+
+			assert.Empty(t, imported)
+			require.Len(t, rowErrors, len(tt.wantErrors))
+			for i, want := range tt.wantErrors {
+				assert.Equal(t, want.Row, rowErrors[i].Row)
+				assert.Equal(t, want.Column, rowErrors[i].Column)
+				if want.Message != "" {
+					assert.Equal(t, want.Message, rowErrors[i].Message)
+				}
+			}
+		})
+	}
+}
+
+func TestImportEnum(t *testing.T) {
+	tests := []struct {
+		value   string
+		want    coredata.VendorCategory
+		wantErr bool
+	}{
+		{value: "", want: ""},
+		{value: "Cloud provider", want: coredata.VendorCategoryCloudProvider},
+		{value: "cloud-provider", want: coredata.VendorCategoryCloudProvider},
+		{value: "CLOUD_PROVIDER", want: coredata.VendorCategoryCloudProvider},
+		{value: "Data storage and processing", want: coredata.VendorCategoryDataStorageAndProcessing},
+		{value: "  Cloud   provider ", want: coredata.VendorCategoryCloudProvider},
+		{value: "Cloud", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.value, func(t *testing.T) {
+			r := &importRecord{row: 2, cells: map[string]string{"category": tt.value}}
+
+			assert.Equal(t, tt.want, importEnum(r, "category", coredata.VendorCategories()))
+			if tt.wantErr {
+				require.Len(t, r.errors, 1)
+				assert.Equal(t, "category", r.errors[0].Column)
+			} else {
+				assert.Empty(t, r.errors)
+			}
+		})
+	}
+}
+
+func TestImportRecordDate(t *testing.T) {
+	date := func(year int, month time.Month, day int) *time.Time {
+		t := time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
+		return &t
+	}
+
+	tests := []struct {
+		value   string
+		want    *time.Time
+		wantErr bool
+	}{
+		{value: "", want: nil},
+		{value: "2025-03-14", want: date(2025, time.March, 14)},
+		{value: "45730", want: date(2025, time.March, 14)},
+		{value: "45730.5", want: date(2025, time.March, 14)},
+		{value: "1", want: date(1899, time.December, 31)},
+		{value: "14/03/2025", wantErr: true},
+		{value: "0", wantErr: true},
+		{value: "-3", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.value, func(t *testing.T) {
+			r := &importRecord{row: 2, cells: map[string]string{"date": tt.value}}
+
+			assert.Equal(t, tt.want, r.date("date"))
+			assert.Equal(t, tt.wantErr, len(r.errors) > 0)
+		})
+	}
+}
+
+func TestImportRecordOptionalInt(t *testing.T) {
+	n := func(i int) *int { return &i }
+
+	tests := []struct {
+		value   string
+		want    *int
+		wantErr bool
+	}{
+		{value: "", want: nil},
+		{value: "3", want: n(3)},
+		{value: "3.0", want: n(3)},
+		{value: "-2", want: n(-2)},
+		{value: "3.5", wantErr: true},
+		{value: "three", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.value, func(t *testing.T) {
+			r := &importRecord{row: 2, cells: map[string]string{"amount": tt.value}}
+
+			assert.Equal(t, tt.want, r.optionalInt("amount"))
+			assert.Equal(t, tt.wantErr, len(r.errors) > 0)
+		})
+	}
+}
@@ -0,0 +1,330 @@
+// Copyright (c) 2025 Probo Inc <hello@getprobo.com>.
+//
+// Permission to use, copy, modify, and/or distribute this software for any
+// purpose with or without fee is hereby granted, provided that the above
+// copyright notice and this permission notice appear in all copies.
+//
+// THE SOFTWARE IS PROVIDED "AS IS" AND THE AUTHOR DISCLAIMS ALL WARRANTIES WITH
+// REGARD TO THIS SOFTWARE INCLUDING ALL IMPLIED WARRANTIES OF MERCHANTABILITY
+// AND FITNESS. IN NO EVENT SHALL THE AUTHOR BE LIABLE FOR ANY SPECIAL, DIRECT,
+// INDIRECT, OR CONSEQUENTIAL DAMAGES OR ANY DAMAGES WHATSOEVER RESULTING FROM
+// LOSS OF USE, DATA OR PROFITS, WHETHER IN AN ACTION OF CONTRACT, NEGLIGENCE OR
+// OTHER TORTIOUS ACTION, ARISING OUT OF OR IN CONNECTION WITH THE USE OR
+// PERFORMANCE OF THIS SOFTWARE.
+
+package probo
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"go.gearno.de/kit/pg"
+	"go.gearno.de/x/ref"
+	"go.probo.inc/probo/pkg/auditlog"
+	"go.probo.inc/probo/pkg/coredata"
+	"go.probo.inc/probo/pkg/gid"
+	"go.probo.inc/probo/pkg/spreadsheet"
+	"go.probo.inc/probo/pkg/validator"
+)
+
+type (
+	ImportService struct {
+		svc *TenantService
+	}
+
+	// ImportRequest imports the rows of a spreadsheet, the first one being
+	// the header naming the columns, into one of the registers of an
+	// organization.
+	ImportRequest struct {
+		OrganizationID gid.GID
+		Type           coredata.ImportJobType
+		Rows           []spreadsheet.Row
+	}
+
+	ImportPreview struct {
+		RowCount  int
+		RowErrors coredata.ImportJobRowErrors
+	}
+
+	// ErrImportRowErrors is returned when an import job is run on rows
+	// that cannot all be imported.
+	ErrImportRowErrors struct {
+		RowErrors coredata.ImportJobRowErrors
+	}
+)
+
+const (
+	ImportMaxRows = 5000
+)
+
+func (e ErrImportRowErrors) Error() string {
+	return fmt.Sprintf("%d rows cannot be imported", len(e.RowErrors))
+}
+
+func (ir *ImportRequest) Validate() error {
+	v := validator.New()
+
+	v.Check(ir.OrganizationID, "organization_id", validator.Required(), validator.GID(coredata.OrganizationEntityType))
+	v.Check(ir.Type, "type", validator.Required(), validator.OneOfSlice(coredata.ImportJobTypes()))
+	v.Check(ir.Rows, "rows", validator.Required(), validator.MaxLen(ImportMaxRows+1))
+
+	return v.Error()
+}
+
+func (ir *ImportRequest) jobRows() []coredata.ImportJobRow {
+	rows := make([]coredata.ImportJobRow, len(ir.Rows))
+	for i, row := range ir.Rows {
+		rows[i] = coredata.ImportJobRow{Number: row.Number, Cells: row.Cells}
+	}
+
+	return rows
+}
+
+// Preview checks the rows of an import without creating anything and
+// returns the problems that would make the import job fail.
+func (s ImportService) Preview(
+	ctx context.Context,
+	req ImportRequest,
+) (*ImportPreview, error) {
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
+
+	preview := &ImportPreview{RowCount: len(req.Rows) - 1}
+
+	err := s.svc.pg.WithConn(
+		ctx,
+		func(conn pg.Conn) error {
+			organization := &coredata.Organization{}
+			if err := organization.LoadByID(ctx, conn, s.svc.scope, req.OrganizationID); err != nil {
+				return fmt.Errorf("cannot load organization: %w", err)
+			}
+
+			_, rowErrors, err := s.buildImport(ctx, conn, organization.ID, req.Type, req.jobRows())
+			if err != nil {
+				return err
+			}
+
+			preview.RowErrors = rowErrors
+
+			return nil
+		},
+	)
+
+	if err != nil {
+		return nil, fmt.Errorf("cannot preview import: %w", err)
+	}
+
+	return preview, nil
+}
+
+// CreateJob queues the import of the rows of a spreadsheet. The rows are
+// all created by the import job in a single transaction, or none of them
+// is when one cannot be imported.
+func (s ImportService) CreateJob(
+	ctx context.Context,
+	req ImportRequest,
+) (*coredata.ImportJob, error) {
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
+
+	arguments, err := json.Marshal(coredata.ImportJobArguments{Rows: req.jobRows()})
+	if err != nil {
+		return nil, fmt.Errorf("cannot marshal import job arguments: %w", err)
+	}
+
+	actor := auditlog.ActorFromContext(ctx)
+
+	importJob := &coredata.ImportJob{
+		ID:                gid.New(s.svc.scope.GetTenantID(), coredata.ImportJobEntityType),
+		OrganizationID:    req.OrganizationID,
+		Type:              req.Type,
+		Arguments:         arguments,
+		Status:            coredata.ImportJobStatusPending,
+		RowErrors:         coredata.ImportJobRowErrors{},
+		CreatedBy:         actor.IdentityID,
+		CreatedByAPIKeyID: actor.APIKeyID,
+		CreatedAt:         time.Now(),
+	}
+
+	err = s.svc.pg.WithTx(
+		ctx,
+		func(tx pg.Conn) error {
+			organization := &coredata.Organization{}
+			if err := organization.LoadByID(ctx, tx, s.svc.scope, req.OrganizationID); err != nil {
+				return fmt.Errorf("cannot load organization: %w", err)
+			}
+
+			if err := importJob.Insert(ctx, tx, s.svc.scope); err != nil {
+				return fmt.Errorf("cannot insert import job: %w", err)
+			}
+
+			state := map[string]any{
+				"type":     importJob.Type,
+				"rowCount": len(req.Rows) - 1,
+			}
+			if err := auditlog.Record(ctx, tx, s.svc.scope, importJob.OrganizationID, ActionImportJobCreate, importJob.ID, nil, state); err != nil {
+				return fmt.Errorf("cannot record audit log entry: %w", err)
+			}
+
+			return nil
+		},
+	)
+
+	if err != nil {
+		return nil, fmt.Errorf("cannot create import job: %w", err)
+	}
+
+	return importJob, nil
+}
+
+func (s ImportService) Get(
+	ctx context.Context,
+	importJobID gid.GID,
+) (*coredata.ImportJob, error) {
+	importJob := &coredata.ImportJob{}
+
+	err := s.svc.pg.WithConn(
+		ctx,
+		func(conn pg.Conn) error {
+			return importJob.LoadByID(ctx, conn, s.svc.scope, importJobID)
+		},
+	)
+
+	if err != nil {
+		return nil, fmt.Errorf("cannot get import job: %w", err)
+	}
+
+	return importJob, nil
+}
+
+// ImportJob runs the next pending import job. It returns
+// coredata.ErrNoImportJobAvailable when no import job is pending.
+func (s *Service) ImportJob(ctx context.Context) error {
+	importJob, err := s.lockImportJob(ctx)
+	if err != nil {
+		return fmt.Errorf("cannot lock import job: %w", err)
+	}
+
+	tenantService := s.WithTenant(importJob.ID.TenantID())
+
+	if err := tenantService.Imports.run(ctx, importJob); err != nil {
+		return fmt.Errorf("cannot run %s import job %q: %w", importJob.Type, importJob.ID, err)
+	}
+
+	return nil
+}
+
+func (s *Service) lockImportJob(ctx context.Context) (*coredata.ImportJob, error) {
+	importJob := &coredata.ImportJob{}
+
+	err := s.pg.WithTx(
+		ctx,
+		func(tx pg.Conn) error {
+			if err := importJob.LoadNextPendingForUpdateSkipLocked(ctx, tx); err != nil {
+				return err
+			}
+
+			importJob.Status = coredata.ImportJobStatusProcessing
+			importJob.StartedAt = ref.Ref(time.Now())
+
+			return importJob.Update(ctx, tx, coredata.NewScope(importJob.ID.TenantID()))
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return importJob, nil
+}
+
+func (s ImportService) run(ctx context.Context, importJob *coredata.ImportJob) error {
+	ctx = importJobContext(ctx, importJob)
+
+	runErr := s.svc.pg.WithTx(
+		ctx,
+		func(tx pg.Conn) error {
+			arguments, err := importJob.GetArguments()
+			if err != nil {
+				return err
+			}
+
+			imported, rowErrors, err := s.buildImport(ctx, tx, importJob.OrganizationID, importJob.Type, arguments.Rows)
+			if err != nil {
+				return err
+			}
+
+			if len(rowErrors) > 0 {
+				return &ErrImportRowErrors{RowErrors: rowErrors}
+			}
+
+			for _, row := range imported {
+				if err := row.create(ctx, tx); err != nil {
+					return fmt.Errorf("cannot import row %d: %w", row.row, err)
+				}
+			}
+
+			importJob.Status = coredata.ImportJobStatusCompleted
+			importJob.ImportedCount = len(imported)
+			importJob.CompletedAt = ref.Ref(time.Now())
+
+			if err := importJob.Update(ctx, tx, s.svc.scope); err != nil {
+				return fmt.Errorf("cannot update import job: %w", err)
+			}
+
+			return nil
+		},
+	)
+
+	if runErr == nil {
+		return nil
+	}
+
+	importJob.Status = coredata.ImportJobStatusFailed
+	importJob.ImportedCount = 0
+	importJob.Error = ref.Ref(runErr.Error())
+	importJob.CompletedAt = ref.Ref(time.Now())
+
+	var errRowErrors *ErrImportRowErrors
+	if errors.As(runErr, &errRowErrors) {
+		importJob.RowErrors = errRowErrors.RowErrors
+	}
+
+	err := s.svc.pg.WithConn(
+		ctx,
+		func(conn pg.Conn) error {
+			if err := importJob.Update(ctx, conn, s.svc.scope); err != nil {
+				return fmt.Errorf("cannot update import job: %w", err)
+			}
+
+			return nil
+		},
+	)
+
+	if err != nil {
+		return fmt.Errorf("cannot import rows: %w, and cannot record the failure: %w", runErr, err)
+	}
+
+	if errRowErrors != nil {
+		return nil
+	}
+
+	return fmt.Errorf("cannot import rows: %w", runErr)
+}
+
+// importJobContext attributes the rows created by the import job to
+// whoever requested it rather than to the worker running it.
+func importJobContext(ctx context.Context, importJob *coredata.ImportJob) context.Context {
+	switch {
+	case importJob.CreatedBy != nil && importJob.CreatedByAPIKeyID != nil:
+		return auditlog.ContextWithActor(ctx, auditlog.NewAPIKeyActor(*importJob.CreatedBy, *importJob.CreatedByAPIKeyID))
+	case importJob.CreatedBy != nil:
+		return auditlog.ContextWithActor(ctx, auditlog.NewUserActor(*importJob.CreatedBy))
+	default:
+		return ctx
+	}
+}
@@ -21,10 +21,13 @@ import (
 
 	"go.gearno.de/crypto/uuid"
 	"go.gearno.de/kit/pg"
+	"go.probo.inc/probo/pkg/auditlog"
 	"go.probo.inc/probo/pkg/coredata"
 	"go.probo.inc/probo/pkg/gid"
 	"go.probo.inc/probo/pkg/page"
 	"go.probo.inc/probo/pkg/validator"
+	"go.probo.inc/probo/pkg/webhook"
+	webhooktypes "go.probo.inc/probo/pkg/webhook/types"
 )
 
 type (
@@ -377,7 +380,7 @@ func (s MeasureService) Import(
 
 				for _, standard := range req.Measures[i].Standards {
 					framework := &coredata.Framework{}
-					if err := framework.LoadByReferenceID(ctx, tx, s.svc.scope, standard.Framework); err != nil {
+					if err := framework.LoadByOrganizationIDAndReferenceID(ctx, tx, s.svc.scope, organization.ID, standard.Framework); err != nil {
 						continue
 					}
 
@@ -437,6 +440,8 @@ func (s MeasureService) Update(
 				return fmt.Errorf("cannot load measure: %w", err)
 			}
 
+			before := webhooktypes.NewMeasure(measure)
+
 			if req.Name != nil {
 				measure.Name = *req.Name
 			}
@@ -459,6 +464,14 @@ func (s MeasureService) Update(
 				return fmt.Errorf("cannot update measure: %w", err)
 			}
 
+			if err := webhook.InsertData(ctx, conn, s.svc.scope, measure.OrganizationID, coredata.WebhookEventTypeMeasureUpdated, webhooktypes.NewMeasure(measure)); err != nil {
+				return fmt.Errorf("cannot insert webhook event: %w", err)
+			}
+
+			if err := auditlog.Record(ctx, conn, s.svc.scope, measure.OrganizationID, ActionMeasureUpdate, measure.ID, before, webhooktypes.NewMeasure(measure)); err != nil {
+				return fmt.Errorf("cannot record audit log entry: %w", err)
+			}
+
 			return nil
 		},
 	)
@@ -509,6 +522,14 @@ func (s MeasureService) Create(
 				return fmt.Errorf("cannot insert measure: %w", err)
 			}
 
+			if err := webhook.InsertData(ctx, conn, s.svc.scope, organization.ID, coredata.WebhookEventTypeMeasureCreated, webhooktypes.NewMeasure(measure)); err != nil {
+				return fmt.Errorf("cannot insert webhook event: %w", err)
+			}
+
+			if err := auditlog.Record(ctx, conn, s.svc.scope, organization.ID, ActionMeasureCreate, measure.ID, nil, webhooktypes.NewMeasure(measure)); err != nil {
+				return fmt.Errorf("cannot record audit log entry: %w", err)
+			}
+
 			return nil
 		},
 	)
@@ -527,6 +548,18 @@ func (s MeasureService) Delete(
 	return s.svc.pg.WithTx(ctx, func(conn pg.Conn) error {
 		measure := &coredata.Measure{}
 
+		if err := measure.LoadByID(ctx, conn, s.svc.scope, measureID); err != nil {
+			return fmt.Errorf("cannot load measure: %w", err)
+		}
+
+		if err := webhook.InsertData(ctx, conn, s.svc.scope, measure.OrganizationID, coredata.WebhookEventTypeMeasureDeleted, webhooktypes.NewMeasure(measure)); err != nil {
+			return fmt.Errorf("cannot insert webhook event: %w", err)
+		}
+
+		if err := auditlog.Record(ctx, conn, s.svc.scope, measure.OrganizationID, ActionMeasureDelete, measure.ID, webhooktypes.NewMeasure(measure), nil); err != nil {
+			return fmt.Errorf("cannot record audit log entry: %w", err)
+		}
+
 		if err := measure.Delete(ctx, conn, s.svc.scope, measureID); err != nil {
 			return fmt.Errorf("cannot delete measure: %w", err)
 		}
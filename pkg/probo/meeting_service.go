@@ -20,6 +20,7 @@ import (
 	"time"
 
 	"go.gearno.de/kit/pg"
+	"go.probo.inc/probo/pkg/auditlog"
 	"go.probo.inc/probo/pkg/coredata"
 	"go.probo.inc/probo/pkg/gid"
 	"go.probo.inc/probo/pkg/page"
@@ -214,6 +215,10 @@ func (s MeetingService) Create(
 				return fmt.Errorf("cannot insert webhook event: %w", err)
 			}
 
+			if err := auditlog.Record(ctx, conn, s.svc.scope, organization.ID, ActionMeetingCreate, meeting.ID, nil, webhooktypes.NewMeeting(meeting)); err != nil {
+				return fmt.Errorf("cannot record audit log entry: %w", err)
+			}
+
 			return nil
 		},
 	)
@@ -262,6 +267,8 @@ func (s MeetingService) Update(
 				return fmt.Errorf("cannot load meeting: %w", err)
 			}
 
+			before := webhooktypes.NewMeeting(meeting)
+
 			if req.Name != nil {
 				meeting.Name = *req.Name
 			}
@@ -294,6 +301,10 @@ func (s MeetingService) Update(
 				return fmt.Errorf("cannot insert webhook event: %w", err)
 			}
 
+			if err := auditlog.Record(ctx, conn, s.svc.scope, meeting.OrganizationID, ActionMeetingUpdate, meeting.ID, before, webhooktypes.NewMeeting(meeting)); err != nil {
+				return fmt.Errorf("cannot record audit log entry: %w", err)
+			}
+
 			return nil
 		},
 	)
@@ -322,6 +333,10 @@ func (s MeetingService) Delete(
 				return fmt.Errorf("cannot insert webhook event: %w", err)
 			}
 
+			if err := auditlog.Record(ctx, conn, s.svc.scope, meeting.OrganizationID, ActionMeetingDelete, meeting.ID, webhooktypes.NewMeeting(meeting), nil); err != nil {
+				return fmt.Errorf("cannot record audit log entry: %w", err)
+			}
+
 			if err := meeting.Delete(ctx, conn, s.svc.scope); err != nil {
 				return fmt.Errorf("cannot delete meeting: %w", err)
 			}
@@ -20,10 +20,13 @@ import (
 	"time"
 
 	"go.gearno.de/kit/pg"
+	"go.probo.inc/probo/pkg/auditlog"
 	"go.probo.inc/probo/pkg/coredata"
 	"go.probo.inc/probo/pkg/gid"
 	"go.probo.inc/probo/pkg/page"
 	"go.probo.inc/probo/pkg/validator"
+	"go.probo.inc/probo/pkg/webhook"
+	webhooktypes "go.probo.inc/probo/pkg/webhook/types"
 )
 
 type NonconformityService struct {
@@ -118,6 +121,28 @@ func (s *NonconformityService) Create(
 		return nil, fmt.Errorf("invalid request: %w", err)
 	}
 
+	var nonconformity *coredata.Nonconformity
+
+	err := s.svc.pg.WithTx(
+		ctx,
+		func(conn pg.Conn) (err error) {
+			nonconformity, err = s.createInTx(ctx, conn, req)
+			return err
+		},
+	)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return nonconformity, nil
+}
+
+func (s *NonconformityService) createInTx(
+	ctx context.Context,
+	conn pg.Conn,
+	req *CreateNonconformityRequest,
+) (*coredata.Nonconformity, error) {
 	now := time.Now()
 
 	nonconformity := &coredata.Nonconformity{
@@ -141,36 +166,33 @@ func (s *NonconformityService) Create(
 		nonconformity.Status = *req.Status
 	}
 
-	err := s.svc.pg.WithTx(
-		ctx,
-		func(conn pg.Conn) error {
-			organization := &coredata.Organization{}
-			if err := organization.LoadByID(ctx, conn, s.svc.scope, req.OrganizationID); err != nil {
-				return fmt.Errorf("cannot load organization: %w", err)
-			}
+	organization := &coredata.Organization{}
+	if err := organization.LoadByID(ctx, conn, s.svc.scope, req.OrganizationID); err != nil {
+		return nil, fmt.Errorf("cannot load organization: %w", err)
+	}
 
-			if req.AuditID != nil {
-				audit := &coredata.Audit{}
-				if err := audit.LoadByID(ctx, conn, s.svc.scope, *req.AuditID); err != nil {
-					return fmt.Errorf("cannot load audit: %w", err)
-				}
-			}
+	if req.AuditID != nil {
+		audit := &coredata.Audit{}
+		if err := audit.LoadByID(ctx, conn, s.svc.scope, *req.AuditID); err != nil {
+			return nil, fmt.Errorf("cannot load audit: %w", err)
+		}
+	}
 
-			owner := &coredata.MembershipProfile{}
-			if err := owner.LoadByID(ctx, conn, s.svc.scope, req.OwnerID); err != nil {
-				return fmt.Errorf("cannot load owner profile: %w", err)
-			}
+	owner := &coredata.MembershipProfile{}
+	if err := owner.LoadByID(ctx, conn, s.svc.scope, req.OwnerID); err != nil {
+		return nil, fmt.Errorf("cannot load owner profile: %w", err)
+	}
 
-			if err := nonconformity.Insert(ctx, conn, s.svc.scope); err != nil {
-				return fmt.Errorf("cannot insert nonconformity: %w", err)
-			}
+	if err := nonconformity.Insert(ctx, conn, s.svc.scope); err != nil {
+		return nil, fmt.Errorf("cannot insert nonconformity: %w", err)
+	}
 
-			return nil
-		},
-	)
+	if err := webhook.InsertData(ctx, conn, s.svc.scope, nonconformity.OrganizationID, coredata.WebhookEventTypeNonconformityCreated, webhooktypes.NewNonconformity(nonconformity)); err != nil {
+		return nil, fmt.Errorf("cannot insert webhook event: %w", err)
+	}
 
-	if err != nil {
-		return nil, err
+	if err := auditlog.Record(ctx, conn, s.svc.scope, nonconformity.OrganizationID, ActionNonconformityCreate, nonconformity.ID, nil, webhooktypes.NewNonconformity(nonconformity)); err != nil {
+		return nil, fmt.Errorf("cannot record audit log entry: %w", err)
 	}
 
 	return nonconformity, nil
@@ -193,6 +215,8 @@ func (s *NonconformityService) Update(
 				return fmt.Errorf("cannot load nonconformity: %w", err)
 			}
 
+			before := webhooktypes.NewNonconformity(nonconformity)
+
 			if req.ReferenceID != nil {
 				nonconformity.ReferenceID = *req.ReferenceID
 			}
@@ -240,6 +264,14 @@ func (s *NonconformityService) Update(
 				return fmt.Errorf("cannot update nonconformity: %w", err)
 			}
 
+			if err := webhook.InsertData(ctx, conn, s.svc.scope, nonconformity.OrganizationID, coredata.WebhookEventTypeNonconformityUpdated, webhooktypes.NewNonconformity(nonconformity)); err != nil {
+				return fmt.Errorf("cannot insert webhook event: %w", err)
+			}
+
+			if err := auditlog.Record(ctx, conn, s.svc.scope, nonconformity.OrganizationID, ActionNonconformityUpdate, nonconformity.ID, before, webhooktypes.NewNonconformity(nonconformity)); err != nil {
+				return fmt.Errorf("cannot record audit log entry: %w", err)
+			}
+
 			return nil
 		},
 	)
@@ -255,10 +287,22 @@ func (s NonconformityService) Delete(
 	ctx context.Context,
 	nonconformityID gid.GID,
 ) error {
-	nonconformity := coredata.Nonconformity{ID: nonconformityID}
-	return s.svc.pg.WithConn(
+	nonconformity := &coredata.Nonconformity{}
+	return s.svc.pg.WithTx(
 		ctx,
 		func(conn pg.Conn) error {
+			if err := nonconformity.LoadByID(ctx, conn, s.svc.scope, nonconformityID); err != nil {
+				return fmt.Errorf("cannot load nonconformity: %w", err)
+			}
+
+			if err := webhook.InsertData(ctx, conn, s.svc.scope, nonconformity.OrganizationID, coredata.WebhookEventTypeNonconformityDeleted, webhooktypes.NewNonconformity(nonconformity)); err != nil {
+				return fmt.Errorf("cannot insert webhook event: %w", err)
+			}
+
+			if err := auditlog.Record(ctx, conn, s.svc.scope, nonconformity.OrganizationID, ActionNonconformityDelete, nonconformity.ID, webhooktypes.NewNonconformity(nonconformity), nil); err != nil {
+				return fmt.Errorf("cannot record audit log entry: %w", err)
+			}
+
 			err := nonconformity.Delete(ctx, conn, s.svc.scope)
 			if err != nil {
 				return fmt.Errorf("cannot delete nonconformity: %w", err)
@@ -20,6 +20,7 @@ import (
 	"time"
 
 	"go.gearno.de/kit/pg"
+	"go.probo.inc/probo/pkg/auditlog"
 	"go.probo.inc/probo/pkg/coredata"
 	"go.probo.inc/probo/pkg/gid"
 	"go.probo.inc/probo/pkg/page"
@@ -160,6 +161,10 @@ func (s *ObligationService) Create(
 				return fmt.Errorf("cannot insert obligation: %w", err)
 			}
 
+			if err := auditlog.Record(ctx, conn, s.svc.scope, obligation.OrganizationID, ActionObligationCreate, obligation.ID, nil, obligationAuditState(obligation)); err != nil {
+				return fmt.Errorf("cannot record audit log entry: %w", err)
+			}
+
 			return nil
 		},
 	)
@@ -188,6 +193,8 @@ func (s *ObligationService) Update(
 				return fmt.Errorf("cannot load obligation: %w", err)
 			}
 
+			before := obligationAuditState(obligation)
+
 			if req.Area != nil {
 				obligation.Area = *req.Area
 			}
@@ -238,6 +245,10 @@ func (s *ObligationService) Update(
 				return fmt.Errorf("cannot update obligation: %w", err)
 			}
 
+			if err := auditlog.Record(ctx, conn, s.svc.scope, obligation.OrganizationID, ActionObligationUpdate, obligation.ID, before, obligationAuditState(obligation)); err != nil {
+				return fmt.Errorf("cannot record audit log entry: %w", err)
+			}
+
 			return nil
 		},
 	)
@@ -261,6 +272,10 @@ func (s *ObligationService) Delete(
 				return fmt.Errorf("cannot load obligation: %w", err)
 			}
 
+			if err := auditlog.Record(ctx, conn, s.svc.scope, obligation.OrganizationID, ActionObligationDelete, obligation.ID, obligationAuditState(obligation), nil); err != nil {
+				return fmt.Errorf("cannot record audit log entry: %w", err)
+			}
+
 			if err := obligation.Delete(ctx, conn, s.svc.scope); err != nil {
 				return fmt.Errorf("cannot delete obligation: %w", err)
 			}
@@ -411,3 +426,18 @@ func (s ObligationService) ListForRiskID(
 
 	return page.NewPage(obligations, cursor), nil
 }
+
+func obligationAuditState(o *coredata.Obligation) map[string]any {
+	return map[string]any{
+		"area":                   o.Area,
+		"source":                 o.Source,
+		"requirement":            o.Requirement,
+		"actionsToBeImplemented": o.ActionsToBeImplemented,
+		"regulator":              o.Regulator,
+		"ownerId":                o.OwnerID,
+		"lastReviewDate":         o.LastReviewDate,
+		"dueDate":                o.DueDate,
+		"status":                 o.Status,
+		"type":                   o.Type,
+	}
+}
@@ -24,9 +24,11 @@ import (
 
 	"go.gearno.de/crypto/uuid"
 	"go.gearno.de/kit/pg"
+	"go.probo.inc/probo/pkg/auditlog"
 	"go.probo.inc/probo/pkg/coredata"
 	"go.probo.inc/probo/pkg/filevalidation"
 	"go.probo.inc/probo/pkg/gid"
+	"go.probo.inc/probo/pkg/iam"
 	"go.probo.inc/probo/pkg/validator"
 )
 
@@ -167,12 +169,18 @@ func (s OrganizationService) UpdateContext(
 			}
 
 			if req.Summary != nil {
+				before := organizationContextAuditState(organizationContext)
+
 				organizationContext.Summary = *req.Summary
 				organizationContext.UpdatedAt = time.Now()
 
 				if err := organizationContext.Update(ctx, tx, s.svc.scope); err != nil {
 					return fmt.Errorf("cannot update organization context: %w", err)
 				}
+
+				if err := auditlog.Record(ctx, tx, s.svc.scope, organization.ID, ActionOrganizationContextUpdate, organization.ID, before, organizationContextAuditState(organizationContext)); err != nil {
+					return fmt.Errorf("cannot record audit log entry: %w", err)
+				}
 			}
 
 			return nil
@@ -203,6 +211,8 @@ func (s OrganizationService) Update(
 				return fmt.Errorf("cannot load organization: %w", err)
 			}
 
+			before := organizationAuditState(organization)
+
 			now := time.Now()
 			organization.UpdatedAt = now
 
@@ -349,6 +359,10 @@ func (s OrganizationService) Update(
 				return fmt.Errorf("cannot update organization: %w", err)
 			}
 
+			if err := auditlog.Record(ctx, tx, s.svc.scope, organization.ID, iam.ActionOrganizationUpdate, organization.ID, before, organizationAuditState(organization)); err != nil {
+				return fmt.Errorf("cannot record audit log entry: %w", err)
+			}
+
 			return nil
 		},
 	)
@@ -457,6 +471,8 @@ func (s OrganizationService) DeleteHorizontalLogo(
 				return fmt.Errorf("cannot load organization: %w", err)
 			}
 
+			before := organizationAuditState(organization)
+
 			organization.HorizontalLogoFileID = nil
 			organization.UpdatedAt = time.Now()
 
@@ -464,6 +480,10 @@ func (s OrganizationService) DeleteHorizontalLogo(
 				return fmt.Errorf("cannot update organization: %w", err)
 			}
 
+			if err := auditlog.Record(ctx, tx, s.svc.scope, organization.ID, iam.ActionOrganizationUpdate, organization.ID, before, organizationAuditState(organization)); err != nil {
+				return fmt.Errorf("cannot record audit log entry: %w", err)
+			}
+
 			return nil
 		},
 	)
@@ -474,3 +494,21 @@ func (s OrganizationService) DeleteHorizontalLogo(
 
 	return organization, nil
 }
+
+func organizationAuditState(organization *coredata.Organization) map[string]any {
+	return map[string]any{
+		"name":                 organization.Name,
+		"description":          organization.Description,
+		"websiteUrl":           organization.WebsiteURL,
+		"email":                organization.Email,
+		"headquarterAddress":   organization.HeadquarterAddress,
+		"logoFileId":           organization.LogoFileID,
+		"horizontalLogoFileId": organization.HorizontalLogoFileID,
+	}
+}
+
+func organizationContextAuditState(oc *coredata.OrganizationContext) map[string]any {
+	return map[string]any{
+		"summary": oc.Summary,
+	}
+}
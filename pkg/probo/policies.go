@@ -53,14 +53,15 @@ var ViewerPolicy = policy.NewPolicy(
 		ActionVendorBusinessAssociateAgreementGet,
 		ActionVendorDataPrivacyAgreementGet,
 		ActionVendorRiskAssessmentList,
-		ActionFrameworkGet, ActionFrameworkList,
+		ActionFrameworkGet, ActionFrameworkList, ActionFrameworkGetCrosswalkCoverage,
 		ActionControlGet, ActionControlList,
 		ActionMeasureGet, ActionMeasureList,
 		ActionTaskGet, ActionTaskList,
 		ActionEvidenceList,
 		ActionDocumentGet, ActionDocumentList,
-		ActionDocumentVersionGet, ActionDocumentVersionList,
+		ActionDocumentVersionGet, ActionDocumentVersionList, ActionDocumentVersionDiff,
 		ActionDocumentVersionSignatureGet, ActionDocumentVersionSignatureList,
+		ActionAcknowledgementCampaignGet, ActionAcknowledgementCampaignList,
 		ActionRiskGet, ActionRiskList,
 		ActionAssetGet, ActionAssetList,
 		ActionDatumGet, ActionDatumList,
@@ -72,10 +73,12 @@ var ViewerPolicy = policy.NewPolicy(
 		ActionProcessingActivityGet, ActionProcessingActivityList,
 		ActionDataProtectionImpactAssessmentGet, ActionDataProtectionImpactAssessmentList,
 		ActionTransferImpactAssessmentGet, ActionTransferImpactAssessmentList,
-		ActionSnapshotGet, ActionSnapshotList,
+		ActionSnapshotGet, ActionSnapshotList, ActionSnapshotDiff,
+		ActionSnapshotScheduleGet, ActionSnapshotScheduleList,
 		ActionMeetingGet, ActionMeetingList,
 		ActionFileGet, ActionFileDownloadUrl,
 		ActionSlackConnectionList, ActionConnectorList,
+		ActionConnectorEvidenceMappingGet, ActionConnectorEvidenceMappingList,
 		ActionRightsRequestGet, ActionRightsRequestList,
 		ActionStateOfApplicabilityGet, ActionStateOfApplicabilityList,
 		ActionApplicabilityStatementGet, ActionApplicabilityStatementList,
@@ -93,8 +96,11 @@ var ViewerPolicy = policy.NewPolicy(
 	policy.Allow(ActionCustomDomainGet).WithSID("custom-domain-read").When(organizationCondition),
 	policy.Allow(ActionOrganizationContextGet).WithSID("organization-context-read").When(organizationCondition),
 	policy.Allow(
-		ActionDocumentVersionExportPDF, ActionDocumentVersionExportSignable, ActionDocumentVersionSign,
+		ActionDocumentVersionExportPDF, ActionDocumentVersionExportSignable, ActionDocumentVersionExportDiff, ActionDocumentVersionSign,
 	).WithSID("document-signing").When(organizationCondition),
+	policy.Allow(
+		ActionDocumentVersionApprove, ActionDocumentVersionReject,
+	).WithSID("document-approval").When(organizationCondition),
 
 	policy.Allow(
 		ActionProcessingActivityExport,
@@ -121,13 +127,14 @@ var AuditorPolicy = policy.NewPolicy(
 		ActionVendorBusinessAssociateAgreementGet,
 		ActionVendorDataPrivacyAgreementGet,
 		ActionVendorRiskAssessmentList,
-		ActionFrameworkGet, ActionFrameworkList,
+		ActionFrameworkGet, ActionFrameworkList, ActionFrameworkGetCrosswalkCoverage,
 		ActionControlGet, ActionControlList,
 		ActionMeasureGet, ActionMeasureList,
 		ActionEvidenceList,
 		ActionDocumentGet, ActionDocumentList,
-		ActionDocumentVersionGet, ActionDocumentVersionList,
+		ActionDocumentVersionGet, ActionDocumentVersionList, ActionDocumentVersionDiff,
 		ActionDocumentVersionSignatureGet, ActionDocumentVersionSignatureList,
+		ActionAcknowledgementCampaignGet, ActionAcknowledgementCampaignList,
 		ActionRiskGet, ActionRiskList,
 		ActionAssetGet, ActionAssetList,
 		ActionDatumGet, ActionDatumList,
@@ -139,14 +146,20 @@ var AuditorPolicy = policy.NewPolicy(
 		ActionProcessingActivityGet, ActionProcessingActivityList,
 		ActionDataProtectionImpactAssessmentGet,
 		ActionTransferImpactAssessmentGet, ActionTransferImpactAssessmentList,
-		ActionSnapshotGet, ActionSnapshotList,
+		ActionSnapshotGet, ActionSnapshotList, ActionSnapshotDiff,
+		ActionSnapshotScheduleGet, ActionSnapshotScheduleList,
 		ActionMeetingGet, ActionMeetingList,
 		ActionFileGet, ActionFileDownloadUrl,
+		ActionConnectorEvidenceMappingGet, ActionConnectorEvidenceMappingList,
 	).WithSID("entity-read-access").When(organizationCondition),
 
 	policy.Allow(
-		ActionDocumentVersionExportPDF, ActionDocumentVersionExportSignable, ActionDocumentVersionSign,
+		ActionDocumentVersionExportPDF, ActionDocumentVersionExportSignable, ActionDocumentVersionExportSignatureCertificate, ActionDocumentVersionExportDiff, ActionDocumentVersionSign,
 	).WithSID("document-signing").When(organizationCondition),
+
+	policy.Allow(
+		ActionAuditLogEntryGet, ActionAuditLogEntryList,
+	).WithSID("audit-log-read-access").When(organizationCondition),
 ).WithDescription("Read-only probo access for auditors (excludes internal/employee content)")
 
 // EmployeePolicy defines permissions for employee role.
@@ -167,6 +180,10 @@ var EmployeePolicy = policy.NewPolicy(
 		ActionDocumentVersionSign,
 		ActionDocumentVersionExportSignable,
 	).WithSID("document-version-signing").When(organizationCondition),
+
+	policy.Allow(
+		ActionDocumentVersionApprove, ActionDocumentVersionReject,
+	).WithSID("document-approval").When(organizationCondition),
 ).WithDescription("Employee access - can sign documents and view internal content")
 
 // ProboPolicySet returns the PolicySet for the probo service.
@@ -176,5 +193,6 @@ func ProboPolicySet() *iam.PolicySet {
 		AddRolePolicy("ADMIN", AdminPolicy).
 		AddRolePolicy("VIEWER", ViewerPolicy).
 		AddRolePolicy("AUDITOR", AuditorPolicy).
-		AddRolePolicy("EMPLOYEE", EmployeePolicy)
+		AddRolePolicy("EMPLOYEE", EmployeePolicy).
+		AddActions(ProboActions()...)
 }
@@ -22,6 +22,7 @@ import (
 	"time"
 
 	"go.gearno.de/kit/pg"
+	"go.probo.inc/probo/pkg/auditlog"
 	"go.probo.inc/probo/pkg/coredata"
 	"go.probo.inc/probo/pkg/docgen"
 	"go.probo.inc/probo/pkg/gid"
@@ -163,6 +164,28 @@ func (s ProcessingActivityService) Get(
 func (s *ProcessingActivityService) Create(
 	ctx context.Context,
 	req *CreateProcessingActivityRequest,
+) (*coredata.ProcessingActivity, error) {
+	var processingActivity *coredata.ProcessingActivity
+
+	err := s.svc.pg.WithTx(
+		ctx,
+		func(conn pg.Conn) (err error) {
+			processingActivity, err = s.createInTx(ctx, conn, req)
+			return err
+		},
+	)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return processingActivity, nil
+}
+
+func (s *ProcessingActivityService) createInTx(
+	ctx context.Context,
+	conn pg.Conn,
+	req *CreateProcessingActivityRequest,
 ) (*coredata.ProcessingActivity, error) {
 	now := time.Now()
 	processingActivityVendors := &coredata.ProcessingActivityVendors{}
@@ -193,30 +216,23 @@ func (s *ProcessingActivityService) Create(
 		UpdatedAt:                            now,
 	}
 
-	err := s.svc.pg.WithTx(
-		ctx,
-		func(conn pg.Conn) error {
-			organization := &coredata.Organization{}
-			if err := organization.LoadByID(ctx, conn, s.svc.scope, req.OrganizationID); err != nil {
-				return fmt.Errorf("cannot load organization: %w", err)
-			}
-
-			if err := processingActivity.Insert(ctx, conn, s.svc.scope); err != nil {
-				return fmt.Errorf("cannot insert processing activity: %w", err)
-			}
+	organization := &coredata.Organization{}
+	if err := organization.LoadByID(ctx, conn, s.svc.scope, req.OrganizationID); err != nil {
+		return nil, fmt.Errorf("cannot load organization: %w", err)
+	}
 
-			if len(req.VendorIDs) > 0 {
-				if err := processingActivityVendors.Insert(ctx, conn, s.svc.scope, processingActivity.ID, req.OrganizationID, req.VendorIDs); err != nil {
-					return fmt.Errorf("cannot create processing activity vendors: %w", err)
-				}
-			}
+	if err := processingActivity.Insert(ctx, conn, s.svc.scope); err != nil {
+		return nil, fmt.Errorf("cannot insert processing activity: %w", err)
+	}
 
-			return nil
-		},
-	)
+	if len(req.VendorIDs) > 0 {
+		if err := processingActivityVendors.Insert(ctx, conn, s.svc.scope, processingActivity.ID, req.OrganizationID, req.VendorIDs); err != nil {
+			return nil, fmt.Errorf("cannot create processing activity vendors: %w", err)
+		}
+	}
 
-	if err != nil {
-		return nil, err
+	if err := auditlog.Record(ctx, conn, s.svc.scope, processingActivity.OrganizationID, ActionProcessingActivityCreate, processingActivity.ID, nil, processingActivityAuditState(processingActivity)); err != nil {
+		return nil, fmt.Errorf("cannot record audit log entry: %w", err)
 	}
 
 	return processingActivity, nil
@@ -236,6 +252,8 @@ func (s *ProcessingActivityService) Update(
 				return fmt.Errorf("cannot load processing activity: %w", err)
 			}
 
+			before := processingActivityAuditState(processingActivity)
+
 			if req.Name != nil {
 				processingActivity.Name = *req.Name
 			}
@@ -306,6 +324,10 @@ func (s *ProcessingActivityService) Update(
 				}
 			}
 
+			if err := auditlog.Record(ctx, conn, s.svc.scope, processingActivity.OrganizationID, ActionProcessingActivityUpdate, processingActivity.ID, before, processingActivityAuditState(processingActivity)); err != nil {
+				return fmt.Errorf("cannot record audit log entry: %w", err)
+			}
+
 			return nil
 		},
 	)
@@ -321,10 +343,18 @@ func (s ProcessingActivityService) Delete(
 	ctx context.Context,
 	processingActivityID gid.GID,
 ) error {
-	processingActivity := coredata.ProcessingActivity{ID: processingActivityID}
-	return s.svc.pg.WithConn(
+	processingActivity := &coredata.ProcessingActivity{}
+	return s.svc.pg.WithTx(
 		ctx,
 		func(conn pg.Conn) error {
+			if err := processingActivity.LoadByID(ctx, conn, s.svc.scope, processingActivityID); err != nil {
+				return fmt.Errorf("cannot load processing activity: %w", err)
+			}
+
+			if err := auditlog.Record(ctx, conn, s.svc.scope, processingActivity.OrganizationID, ActionProcessingActivityDelete, processingActivity.ID, processingActivityAuditState(processingActivity), nil); err != nil {
+				return fmt.Errorf("cannot record audit log entry: %w", err)
+			}
+
 			err := processingActivity.Delete(ctx, conn, s.svc.scope)
 			if err != nil {
 				return fmt.Errorf("cannot delete processing activity: %w", err)
@@ -541,3 +571,27 @@ func (s *ProcessingActivityService) ExportPDF(
 
 	return pdfData, nil
 }
+
+func processingActivityAuditState(pa *coredata.ProcessingActivity) map[string]any {
+	return map[string]any{
+		"name":                                 pa.Name,
+		"purpose":                              pa.Purpose,
+		"dataSubjectCategory":                  pa.DataSubjectCategory,
+		"personalDataCategory":                 pa.PersonalDataCategory,
+		"specialOrCriminalData":                pa.SpecialOrCriminalData,
+		"consentEvidenceLink":                  pa.ConsentEvidenceLink,
+		"lawfulBasis":                          pa.LawfulBasis,
+		"recipients":                           pa.Recipients,
+		"location":                             pa.Location,
+		"internationalTransfers":               pa.InternationalTransfers,
+		"transferSafeguard":                    pa.TransferSafeguard,
+		"retentionPeriod":                      pa.RetentionPeriod,
+		"securityMeasures":                     pa.SecurityMeasures,
+		"dataProtectionImpactAssessmentNeeded": pa.DataProtectionImpactAssessmentNeeded,
+		"transferImpactAssessmentNeeded":       pa.TransferImpactAssessmentNeeded,
+		"lastReviewDate":                       pa.LastReviewDate,
+		"nextReviewDate":                       pa.NextReviewDate,
+		"role":                                 pa.Role,
+		"dataProtectionOfficerId":              pa.DataProtectionOfficerID,
+	}
+}
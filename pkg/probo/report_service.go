@@ -21,9 +21,10 @@ import (
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"go.gearno.de/kit/pg"
+	"go.probo.inc/probo/pkg/auditlog"
 	"go.probo.inc/probo/pkg/coredata"
 	"go.probo.inc/probo/pkg/gid"
-	"go.gearno.de/kit/pg"
 )
 
 type ReportService struct {
@@ -66,6 +67,10 @@ func (s ReportService) Delete(
 			return fmt.Errorf("cannot get report: %w", err)
 		}
 
+		if err := auditlog.Record(ctx, conn, s.svc.scope, report.OrganizationID, ActionReportDelete, report.ID, reportAuditState(report), nil); err != nil {
+			return fmt.Errorf("cannot record audit log entry: %w", err)
+		}
+
 		err = report.Delete(ctx, conn, s.svc.scope)
 		if err != nil {
 			return fmt.Errorf("cannot delete report: %w", err)
@@ -102,3 +107,11 @@ func (s ReportService) GenerateDownloadURL(
 
 	return &presignedReq.URL, nil
 }
+
+func reportAuditState(r *coredata.Report) map[string]any {
+	return map[string]any{
+		"filename": r.Filename,
+		"mimeType": r.MimeType,
+		"size":     r.Size,
+	}
+}
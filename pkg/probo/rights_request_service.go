@@ -20,6 +20,7 @@ import (
 	"time"
 
 	"go.gearno.de/kit/pg"
+	"go.probo.inc/probo/pkg/auditlog"
 	"go.probo.inc/probo/pkg/coredata"
 	"go.probo.inc/probo/pkg/gid"
 	"go.probo.inc/probo/pkg/page"
@@ -142,6 +143,10 @@ func (s *RightsRequestService) Create(
 				return fmt.Errorf("cannot insert rights request: %w", err)
 			}
 
+			if err := auditlog.Record(ctx, conn, s.svc.scope, request.OrganizationID, ActionRightsRequestCreate, request.ID, nil, rightsRequestAuditState(request)); err != nil {
+				return fmt.Errorf("cannot record audit log entry: %w", err)
+			}
+
 			return nil
 		},
 	)
@@ -170,6 +175,8 @@ func (s *RightsRequestService) Update(
 				return fmt.Errorf("cannot load rights request: %w", err)
 			}
 
+			before := rightsRequestAuditState(request)
+
 			if req.RequestType != nil {
 				request.RequestType = *req.RequestType
 			}
@@ -204,6 +211,10 @@ func (s *RightsRequestService) Update(
 				return fmt.Errorf("cannot update rights request: %w", err)
 			}
 
+			if err := auditlog.Record(ctx, conn, s.svc.scope, request.OrganizationID, ActionRightsRequestUpdate, request.ID, before, rightsRequestAuditState(request)); err != nil {
+				return fmt.Errorf("cannot record audit log entry: %w", err)
+			}
+
 			return nil
 		},
 	)
@@ -227,6 +238,10 @@ func (s *RightsRequestService) Delete(
 				return fmt.Errorf("cannot load rights request: %w", err)
 			}
 
+			if err := auditlog.Record(ctx, conn, s.svc.scope, request.OrganizationID, ActionRightsRequestDelete, request.ID, rightsRequestAuditState(request), nil); err != nil {
+				return fmt.Errorf("cannot record audit log entry: %w", err)
+			}
+
 			if err := request.Delete(ctx, conn, s.svc.scope); err != nil {
 				return fmt.Errorf("cannot delete rights request: %w", err)
 			}
@@ -289,3 +304,15 @@ func (s RightsRequestService) ListForOrganizationID(
 
 	return page.NewPage(requests, cursor), nil
 }
+
+func rightsRequestAuditState(rr *coredata.RightsRequest) map[string]any {
+	return map[string]any{
+		"requestType":  rr.RequestType,
+		"requestState": rr.RequestState,
+		"dataSubject":  rr.DataSubject,
+		"contact":      rr.Contact,
+		"details":      rr.Details,
+		"deadline":     rr.Deadline,
+		"actionTaken":  rr.ActionTaken,
+	}
+}
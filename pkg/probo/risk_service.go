@@ -20,10 +20,13 @@ import (
 	"time"
 
 	"go.gearno.de/kit/pg"
+	"go.probo.inc/probo/pkg/auditlog"
 	"go.probo.inc/probo/pkg/coredata"
 	"go.probo.inc/probo/pkg/gid"
 	"go.probo.inc/probo/pkg/page"
 	"go.probo.inc/probo/pkg/validator"
+	"go.probo.inc/probo/pkg/webhook"
+	webhooktypes "go.probo.inc/probo/pkg/webhook/types"
 )
 
 type (
@@ -422,6 +425,28 @@ func (s RiskService) Create(
 		return nil, fmt.Errorf("invalid request: %w", err)
 	}
 
+	var risk *coredata.Risk
+
+	err := s.svc.pg.WithTx(
+		ctx,
+		func(conn pg.Conn) (err error) {
+			risk, err = s.createInTx(ctx, conn, req)
+			return err
+		},
+	)
+
+	if err != nil {
+		return nil, fmt.Errorf("cannot create risk: %w", err)
+	}
+
+	return risk, nil
+}
+
+func (s RiskService) createInTx(
+	ctx context.Context,
+	conn pg.Conn,
+	req CreateRiskRequest,
+) (*coredata.Risk, error) {
 	now := time.Now()
 	owner := coredata.MembershipProfile{}
 	organization := coredata.Organization{}
@@ -454,25 +479,26 @@ func (s RiskService) Create(
 		risk.ResidualImpact = *req.ResidualImpact
 	}
 
-	err := s.svc.pg.WithConn(
-		ctx,
-		func(conn pg.Conn) error {
-			if err := organization.LoadByID(ctx, conn, s.svc.scope, req.OrganizationID); err != nil {
-				return fmt.Errorf("cannot load organization: %w", err)
-			}
+	if err := organization.LoadByID(ctx, conn, s.svc.scope, req.OrganizationID); err != nil {
+		return nil, fmt.Errorf("cannot load organization: %w", err)
+	}
 
-			if req.OwnerID != nil {
-				if err := owner.LoadByID(ctx, conn, s.svc.scope, *req.OwnerID); err != nil {
-					return fmt.Errorf("cannot load owner profile: %w", err)
-				}
-			}
+	if req.OwnerID != nil {
+		if err := owner.LoadByID(ctx, conn, s.svc.scope, *req.OwnerID); err != nil {
+			return nil, fmt.Errorf("cannot load owner profile: %w", err)
+		}
+	}
 
-			return risk.Insert(ctx, conn, s.svc.scope)
-		},
-	)
+	if err := risk.Insert(ctx, conn, s.svc.scope); err != nil {
+		return nil, fmt.Errorf("cannot insert risk: %w", err)
+	}
 
-	if err != nil {
-		return nil, fmt.Errorf("cannot create risk: %w", err)
+	if err := webhook.InsertData(ctx, conn, s.svc.scope, risk.OrganizationID, coredata.WebhookEventTypeRiskCreated, webhooktypes.NewRisk(risk)); err != nil {
+		return nil, fmt.Errorf("cannot insert webhook event: %w", err)
+	}
+
+	if err := auditlog.Record(ctx, conn, s.svc.scope, risk.OrganizationID, ActionRiskCreate, risk.ID, nil, webhooktypes.NewRisk(risk)); err != nil {
+		return nil, fmt.Errorf("cannot record audit log entry: %w", err)
 	}
 
 	return risk, nil
@@ -515,6 +541,8 @@ func (s RiskService) Update(
 				return fmt.Errorf("cannot load risk: %w", err)
 			}
 
+			before := webhooktypes.NewRisk(risk)
+
 			if req.Name != nil {
 				risk.Name = *req.Name
 			}
@@ -569,6 +597,14 @@ func (s RiskService) Update(
 				return fmt.Errorf("cannot update risk: %w", err)
 			}
 
+			if err := webhook.InsertData(ctx, conn, s.svc.scope, risk.OrganizationID, coredata.WebhookEventTypeRiskUpdated, webhooktypes.NewRisk(risk)); err != nil {
+				return fmt.Errorf("cannot insert webhook event: %w", err)
+			}
+
+			if err := auditlog.Record(ctx, conn, s.svc.scope, risk.OrganizationID, ActionRiskUpdate, risk.ID, before, webhooktypes.NewRisk(risk)); err != nil {
+				return fmt.Errorf("cannot record audit log entry: %w", err)
+			}
+
 			return nil
 		},
 	)
@@ -585,9 +621,21 @@ func (s RiskService) Delete(
 ) error {
 	risk := &coredata.Risk{}
 
-	return s.svc.pg.WithConn(
+	return s.svc.pg.WithTx(
 		ctx,
 		func(conn pg.Conn) error {
+			if err := risk.LoadByID(ctx, conn, s.svc.scope, riskID); err != nil {
+				return fmt.Errorf("cannot load risk: %w", err)
+			}
+
+			if err := webhook.InsertData(ctx, conn, s.svc.scope, risk.OrganizationID, coredata.WebhookEventTypeRiskDeleted, webhooktypes.NewRisk(risk)); err != nil {
+				return fmt.Errorf("cannot insert webhook event: %w", err)
+			}
+
+			if err := auditlog.Record(ctx, conn, s.svc.scope, risk.OrganizationID, ActionRiskDelete, risk.ID, webhooktypes.NewRisk(risk), nil); err != nil {
+				return fmt.Errorf("cannot record audit log entry: %w", err)
+			}
+
 			return risk.Delete(ctx, conn, s.svc.scope, riskID)
 		},
 	)
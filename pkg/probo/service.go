@@ -25,6 +25,7 @@ import (
 	"go.gearno.de/x/ref"
 	"go.probo.inc/probo/pkg/agents"
 	"go.probo.inc/probo/pkg/certmanager"
+	"go.probo.inc/probo/pkg/connector"
 	"go.probo.inc/probo/pkg/coredata"
 	"go.probo.inc/probo/pkg/crypto/cipher"
 	"go.probo.inc/probo/pkg/filemanager"
@@ -34,6 +35,7 @@ import (
 	"go.probo.inc/probo/pkg/iam"
 	"go.probo.inc/probo/pkg/mail"
 	"go.probo.inc/probo/pkg/slack"
+	"go.probo.inc/probo/pkg/webhook"
 )
 
 const (
@@ -61,6 +63,8 @@ type (
 		fileManager       *filemanager.Service
 		logger            *log.Logger
 		slack             *slack.Service
+		webhookSender     *webhook.Sender
+		connectorRegistry *connector.ConnectorRegistry
 	}
 
 	TenantService struct {
@@ -73,6 +77,7 @@ type (
 		tokenSecret                       string
 		agent                             *agents.Agent
 		fileManager                       *filemanager.Service
+		connectorRegistry                 *connector.ConnectorRegistry
 		Frameworks                        *FrameworkService
 		Measures                          *MeasureService
 		Tasks                             *TaskService
@@ -88,9 +93,12 @@ type (
 		VendorDataPrivacyAgreements       *VendorDataPrivacyAgreementService
 		VendorServices                    *VendorServiceService
 		Connectors                        *ConnectorService
+		ConnectorEvidenceMappings         *ConnectorEvidenceMappingService
 		Assets                            *AssetService
 		Data                              *DatumService
 		Audits                            *AuditService
+		AuditLogEntries                   *AuditLogService
+		Emails                            *EmailService
 		Meetings                          *MeetingService
 		WebhookSubscriptions              *WebhookSubscriptionService
 		Reports                           *ReportService
@@ -101,6 +109,10 @@ type (
 		Nonconformities                   *NonconformityService
 		Obligations                       *ObligationService
 		Snapshots                         *SnapshotService
+		SnapshotSchedules                 *SnapshotScheduleService
+		DeadlineReminderSettings          *DeadlineReminderSettingsService
+		AcknowledgementCampaigns          *AcknowledgementCampaignService
+		Imports                           *ImportService
 		ContinualImprovements             *ContinualImprovementService
 		RightsRequests                    *RightsRequestService
 		ProcessingActivities              *ProcessingActivityService
@@ -128,6 +140,8 @@ func NewService(
 	logger *log.Logger,
 	slackService *slack.Service,
 	iamService *iam.Service,
+	webhookSender *webhook.Sender,
+	connectorRegistry *connector.ConnectorRegistry,
 ) (*Service, error) {
 	if bucket == "" {
 		return nil, fmt.Errorf("bucket is required")
@@ -148,6 +162,8 @@ func NewService(
 		fileManager:       fileManagerService,
 		logger:            logger,
 		slack:             slackService,
+		webhookSender:     webhookSender,
+		connectorRegistry: connectorRegistry,
 	}
 
 	return svc, nil
@@ -155,15 +171,16 @@ func NewService(
 
 func (s *Service) WithTenant(tenantID gid.TenantID) *TenantService {
 	tenantService := &TenantService{
-		pg:            s.pg,
-		s3:            s.s3,
-		bucket:        s.bucket,
-		encryptionKey: s.encryptionKey,
-		baseURL:       s.baseURL,
-		scope:         coredata.NewScope(tenantID),
-		tokenSecret:   s.tokenSecret,
-		agent:         agents.NewAgent(nil, s.agentConfig),
-		fileManager:   s.fileManager,
+		pg:                s.pg,
+		s3:                s.s3,
+		bucket:            s.bucket,
+		encryptionKey:     s.encryptionKey,
+		baseURL:           s.baseURL,
+		scope:             coredata.NewScope(tenantID),
+		tokenSecret:       s.tokenSecret,
+		agent:             agents.NewAgent(nil, s.agentConfig),
+		fileManager:       s.fileManager,
+		connectorRegistry: s.connectorRegistry,
 	}
 
 	tenantService.Frameworks = &FrameworkService{
@@ -210,11 +227,15 @@ func (s *Service) WithTenant(tenantID gid.TenantID) *TenantService {
 	tenantService.VendorDataPrivacyAgreements = &VendorDataPrivacyAgreementService{svc: tenantService}
 	tenantService.VendorServices = &VendorServiceService{svc: tenantService}
 	tenantService.Connectors = &ConnectorService{svc: tenantService}
+	tenantService.ConnectorEvidenceMappings = &ConnectorEvidenceMappingService{svc: tenantService}
 	tenantService.Assets = &AssetService{svc: tenantService}
 	tenantService.Data = &DatumService{svc: tenantService}
 	tenantService.Audits = &AuditService{svc: tenantService}
 	tenantService.Meetings = &MeetingService{svc: tenantService}
-	tenantService.WebhookSubscriptions = &WebhookSubscriptionService{svc: tenantService}
+	tenantService.WebhookSubscriptions = &WebhookSubscriptionService{
+		svc:           tenantService,
+		webhookSender: s.webhookSender,
+	}
 	tenantService.Reports = &ReportService{svc: tenantService}
 	tenantService.TrustCenters = &TrustCenterService{svc: tenantService}
 	tenantService.TrustCenterAccesses = &TrustCenterAccessService{svc: tenantService}
@@ -234,8 +255,14 @@ func (s *Service) WithTenant(tenantID gid.TenantID) *TenantService {
 		),
 	}
 	tenantService.Nonconformities = &NonconformityService{svc: tenantService}
+	tenantService.AuditLogEntries = &AuditLogService{svc: tenantService}
+	tenantService.Emails = &EmailService{svc: tenantService}
 	tenantService.Obligations = &ObligationService{svc: tenantService}
 	tenantService.Snapshots = &SnapshotService{svc: tenantService}
+	tenantService.SnapshotSchedules = &SnapshotScheduleService{svc: tenantService}
+	tenantService.DeadlineReminderSettings = &DeadlineReminderSettingsService{svc: tenantService}
+	tenantService.AcknowledgementCampaigns = &AcknowledgementCampaignService{svc: tenantService}
+	tenantService.Imports = &ImportService{svc: tenantService}
 	tenantService.ContinualImprovements = &ContinualImprovementService{svc: tenantService}
 	tenantService.RightsRequests = &RightsRequestService{svc: tenantService}
 	tenantService.ProcessingActivities = &ProcessingActivityService{
@@ -281,6 +308,8 @@ func (s *Service) ExportJob(ctx context.Context) error {
 		exportService = tenantService.Frameworks
 	case coredata.ExportJobTypeDocument:
 		exportService = tenantService.Documents
+	case coredata.ExportJobTypeAuditLog:
+		exportService = tenantService.AuditLogEntries
 	default:
 		unknownTypeErr := fmt.Errorf("unknown export job type: %q", exportJob.Type)
 		if err := s.commitFailedExport(ctx, exportJob, unknownTypeErr); err != nil {
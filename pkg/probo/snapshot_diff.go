@@ -0,0 +1,164 @@
+// Copyright (c) 2025 Probo Inc <hello@getprobo.com>.
+//
+// Permission to use, copy, modify, and/or distribute this software for any
+// purpose with or without fee is hereby granted, provided that the above
+// copyright notice and this permission notice appear in all copies.
+//
+// THE SOFTWARE IS PROVIDED "AS IS" AND THE AUTHOR DISCLAIMS ALL WARRANTIES WITH
+// REGARD TO THIS SOFTWARE INCLUDING ALL IMPLIED WARRANTIES OF MERCHANTABILITY
+// AND FITNESS. IN NO EVENT SHALL THE AUTHOR BE LIABLE FOR ANY SPECIAL, DIRECT,
+// INDIRECT, OR CONSEQUENTIAL DAMAGES OR ANY DAMAGES WHATSOEVER RESULTING FROM
+// LOSS OF USE, DATA OR PROFITS, WHETHER IN AN ACTION OF CONTRACT, NEGLIGENCE OR
+// OTHER TORTIOUS ACTION, ARISING OUT OF OR IN CONNECTION WITH THE USE OR
+// PERFORMANCE OF THIS SOFTWARE.
+
+package probo
+
+import (
+	"bytes"
+	"encoding/json"
+	"maps"
+	"slices"
+
+	"go.probo.inc/probo/pkg/coredata"
+	"go.probo.inc/probo/pkg/gid"
+)
+
+type (
+	// SnapshotDiff reports how the rows of a snapshot changed in a later
+	// snapshot of the same type. Rows are matched on the live row they
+	// were copied from.
+	SnapshotDiff struct {
+		From    *coredata.Snapshot
+		To      *coredata.Snapshot
+		Added   []*SnapshotRowDiff
+		Removed []*SnapshotRowDiff
+		Changed []*SnapshotRowDiff
+	}
+
+	// SnapshotRowDiff is a row added, removed or changed between two
+	// snapshots. FromRowID and ToRowID are the copies of the row in each
+	// snapshot, nil on the side the row is missing from.
+	SnapshotRowDiff struct {
+		SourceID  *gid.GID
+		FromRowID *gid.GID
+		ToRowID   *gid.GID
+		Label     string
+		Changes   []*SnapshotFieldChange
+	}
+
+	SnapshotFieldChange struct {
+		Field  string
+		Before json.RawMessage
+		After  json.RawMessage
+	}
+)
+
+// diffSnapshotRows compares the rows of two snapshots. Rows without a
+// source, whose live row has been deleted, cannot be matched and are
+// reported as removed or added.
+func diffSnapshotRows(from, to coredata.SnapshotRows, labelField string) ([]*SnapshotRowDiff, []*SnapshotRowDiff, []*SnapshotRowDiff) {
+	var (
+		added   []*SnapshotRowDiff
+		removed []*SnapshotRowDiff
+		changed []*SnapshotRowDiff
+	)
+
+	toBySource := make(map[gid.GID]*coredata.SnapshotRow, len(to))
+	for _, row := range to {
+		if row.SourceID != nil {
+			toBySource[*row.SourceID] = row
+		}
+	}
+
+	matched := make(map[gid.GID]bool, len(from))
+	for _, fromRow := range from {
+		var toRow *coredata.SnapshotRow
+		if fromRow.SourceID != nil {
+			toRow = toBySource[*fromRow.SourceID]
+		}
+
+		if toRow == nil {
+			removed = append(removed, &SnapshotRowDiff{
+				SourceID:  fromRow.SourceID,
+				FromRowID: &fromRow.ID,
+				Label:     snapshotRowLabel(fromRow, labelField),
+			})
+			continue
+		}
+
+		matched[toRow.ID] = true
+
+		changes := diffSnapshotRowFields(fromRow.Fields, toRow.Fields)
+		if len(changes) == 0 {
+			continue
+		}
+
+		changed = append(changed, &SnapshotRowDiff{
+			SourceID:  toRow.SourceID,
+			FromRowID: &fromRow.ID,
+			ToRowID:   &toRow.ID,
+			Label:     snapshotRowLabel(toRow, labelField),
+			Changes:   changes,
+		})
+	}
+
+	for _, toRow := range to {
+		if matched[toRow.ID] {
+			continue
+		}
+
+		added = append(added, &SnapshotRowDiff{
+			SourceID: toRow.SourceID,
+			ToRowID:  &toRow.ID,
+			Label:    snapshotRowLabel(toRow, labelField),
+		})
+	}
+
+	return added, removed, changed
+}
+
+func diffSnapshotRowFields(from, to map[string]json.RawMessage) []*SnapshotFieldChange {
+	fields := slices.Collect(maps.Keys(from))
+	for field := range to {
+		if _, ok := from[field]; !ok {
+			fields = append(fields, field)
+		}
+	}
+	slices.Sort(fields)
+
+	var changes []*SnapshotFieldChange
+	for _, field := range fields {
+		before := orJSONNull(from[field])
+		after := orJSONNull(to[field])
+
+		if bytes.Equal(before, after) {
+			continue
+		}
+
+		changes = append(changes, &SnapshotFieldChange{
+			Field:  field,
+			Before: before,
+			After:  after,
+		})
+	}
+
+	return changes
+}
+
+func snapshotRowLabel(row *coredata.SnapshotRow, labelField string) string {
+	var label string
+	if err := json.Unmarshal(row.Fields[labelField], &label); err != nil {
+		return row.ID.String()
+	}
+
+	return label
+}
+
+func orJSONNull(v json.RawMessage) json.RawMessage {
+	if v == nil {
+		return json.RawMessage("null")
+	}
+
+	return v
+}
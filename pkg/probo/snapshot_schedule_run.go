@@ -0,0 +1,123 @@
+// Copyright (c) 2025 Probo Inc <hello@getprobo.com>.
+//
+// Permission to use, copy, modify, and/or distribute this software for any
+// purpose with or without fee is hereby granted, provided that the above
+// copyright notice and this permission notice appear in all copies.
+//
+// THE SOFTWARE IS PROVIDED "AS IS" AND THE AUTHOR DISCLAIMS ALL WARRANTIES WITH
+// REGARD TO THIS SOFTWARE INCLUDING ALL IMPLIED WARRANTIES OF MERCHANTABILITY
+// AND FITNESS. IN NO EVENT SHALL THE AUTHOR BE LIABLE FOR ANY SPECIAL, DIRECT,
+// INDIRECT, OR CONSEQUENTIAL DAMAGES OR ANY DAMAGES WHATSOEVER RESULTING FROM
+// LOSS OF USE, DATA OR PROFITS, WHETHER IN AN ACTION OF CONTRACT, NEGLIGENCE OR
+// OTHER TORTIOUS ACTION, ARISING OUT OF OR IN CONNECTION WITH THE USE OR
+// PERFORMANCE OF THIS SOFTWARE.
+
+package probo
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"go.gearno.de/kit/pg"
+	"go.gearno.de/x/ref"
+	"go.probo.inc/probo/pkg/coredata"
+)
+
+// TakeScheduledSnapshot locks the snapshot schedule the most overdue,
+// reschedules it to the next time matching its cron expression and takes
+// its snapshot. A failed snapshot is recorded on the schedule and retried
+// at the next run only. It returns coredata.ErrNoSnapshotScheduleDue when
+// no schedule is due.
+func (s *Service) TakeScheduledSnapshot(ctx context.Context) error {
+	schedule, err := s.lockSnapshotScheduleForRun(ctx)
+	if err != nil {
+		return fmt.Errorf("cannot lock snapshot schedule: %w", err)
+	}
+
+	tenantService := s.WithTenant(schedule.ID.TenantID())
+
+	if err := tenantService.SnapshotSchedules.run(ctx, schedule); err != nil {
+		return fmt.Errorf("cannot run snapshot schedule %q: %w", schedule.ID, err)
+	}
+
+	return nil
+}
+
+func (s *Service) lockSnapshotScheduleForRun(ctx context.Context) (*coredata.SnapshotSchedule, error) {
+	schedule := &coredata.SnapshotSchedule{}
+
+	err := s.pg.WithTx(
+		ctx,
+		func(tx pg.Conn) error {
+			now := time.Now()
+
+			if err := schedule.LoadNextDueForUpdateSkipLocked(ctx, tx, now); err != nil {
+				return err
+			}
+
+			nextRunAt, err := nextSnapshotRunAt(schedule.CronExpression, now)
+			if err != nil {
+				return err
+			}
+
+			schedule.NextRunAt = nextRunAt
+			schedule.UpdatedAt = now
+
+			return schedule.Update(ctx, tx, coredata.NewScope(schedule.ID.TenantID()))
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return schedule, nil
+}
+
+func (s SnapshotScheduleService) run(ctx context.Context, schedule *coredata.SnapshotSchedule) error {
+	now := time.Now()
+
+	snapshot, snapshotErr := s.svc.Snapshots.Create(
+		ctx,
+		&CreateSnapshotRequest{
+			OrganizationID: schedule.OrganizationID,
+			Name:           fmt.Sprintf("%s - %s", schedule.Name, now.Format(time.DateOnly)),
+			Description:    schedule.Description,
+			Type:           schedule.Type,
+		},
+	)
+
+	err := s.svc.pg.WithTx(
+		ctx,
+		func(conn pg.Conn) error {
+			if err := schedule.LoadByID(ctx, conn, s.svc.scope, schedule.ID); err != nil {
+				return fmt.Errorf("cannot load snapshot schedule: %w", err)
+			}
+
+			schedule.LastRunAt = &now
+			schedule.UpdatedAt = time.Now()
+
+			if snapshotErr != nil {
+				schedule.LastError = ref.Ref(snapshotErr.Error())
+			} else {
+				schedule.LastSnapshotID = &snapshot.ID
+				schedule.LastError = nil
+			}
+
+			if err := schedule.Update(ctx, conn, s.svc.scope); err != nil {
+				return fmt.Errorf("cannot update snapshot schedule: %w", err)
+			}
+
+			return nil
+		},
+	)
+
+	if snapshotErr != nil {
+		if err != nil {
+			return fmt.Errorf("cannot create snapshot: %w, and cannot record the failure: %w", snapshotErr, err)
+		}
+		return fmt.Errorf("cannot create snapshot: %w", snapshotErr)
+	}
+
+	return err
+}
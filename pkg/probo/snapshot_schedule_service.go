@@ -0,0 +1,361 @@
+// Copyright (c) 2025 Probo Inc <hello@getprobo.com>.
+//
+// Permission to use, copy, modify, and/or distribute this software for any
+// purpose with or without fee is hereby granted, provided that the above
+// copyright notice and this permission notice appear in all copies.
+//
+// THE SOFTWARE IS PROVIDED "AS IS" AND THE AUTHOR DISCLAIMS ALL WARRANTIES WITH
+// REGARD TO THIS SOFTWARE INCLUDING ALL IMPLIED WARRANTIES OF MERCHANTABILITY
+// AND FITNESS. IN NO EVENT SHALL THE AUTHOR BE LIABLE FOR ANY SPECIAL, DIRECT,
+// INDIRECT, OR CONSEQUENTIAL DAMAGES OR ANY DAMAGES WHATSOEVER RESULTING FROM
+// LOSS OF USE, DATA OR PROFITS, WHETHER IN AN ACTION OF CONTRACT, NEGLIGENCE OR
+// OTHER TORTIOUS ACTION, ARISING OUT OF OR IN CONNECTION WITH THE USE OR
+// PERFORMANCE OF THIS SOFTWARE.
+
+package probo
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/robfig/cron/v3"
+	"go.gearno.de/kit/pg"
+	"go.probo.inc/probo/pkg/auditlog"
+	"go.probo.inc/probo/pkg/coredata"
+	"go.probo.inc/probo/pkg/gid"
+	"go.probo.inc/probo/pkg/page"
+	"go.probo.inc/probo/pkg/validator"
+)
+
+type (
+	SnapshotScheduleService struct {
+		svc *TenantService
+	}
+
+	CreateSnapshotScheduleRequest struct {
+		OrganizationID gid.GID
+		Name           string
+		Description    *string
+		Type           coredata.SnapshotsType
+		CronExpression string
+	}
+
+	UpdateSnapshotScheduleRequest struct {
+		ID             gid.GID
+		Name           *string
+		Description    **string
+		Type           *coredata.SnapshotsType
+		CronExpression *string
+	}
+)
+
+func (r *CreateSnapshotScheduleRequest) Validate() error {
+	v := validator.New()
+
+	v.Check(r.OrganizationID, "organization_id", validator.Required(), validator.GID(coredata.OrganizationEntityType))
+	v.Check(r.Name, "name", validator.Required(), validator.SafeTextNoNewLine(NameMaxLength))
+	v.Check(r.Description, "description", validator.SafeText(ContentMaxLength))
+	v.Check(r.Type, "type", validator.Required(), validator.OneOfSlice(coredata.SnapshotsTypes()))
+	v.Check(r.CronExpression, "cron_expression", validator.Required(), cronExpression())
+
+	return v.Error()
+}
+
+func (r *UpdateSnapshotScheduleRequest) Validate() error {
+	v := validator.New()
+
+	v.Check(r.ID, "id", validator.Required(), validator.GID(coredata.SnapshotScheduleEntityType))
+	v.Check(r.Name, "name", validator.SafeTextNoNewLine(NameMaxLength))
+	v.Check(r.Description, "description", validator.SafeText(ContentMaxLength))
+	v.Check(r.Type, "type", validator.OneOfSlice(coredata.SnapshotsTypes()))
+	v.Check(r.CronExpression, "cron_expression", cronExpression())
+
+	return v.Error()
+}
+
+// snapshotScheduleMinInterval is the shortest time allowed between two
+// runs of a snapshot schedule.
+const snapshotScheduleMinInterval = time.Hour
+
+// cronExpression validates a standard five fields cron expression, which
+// may be prefixed with CRON_TZ= to be evaluated in another timezone than
+// UTC, or a descriptor such as @monthly. The expression must fire, and
+// not more often than snapshotScheduleMinInterval.
+func cronExpression() validator.ValidatorFunc {
+	return validator.Custom(
+		validator.ErrorCodeInvalidFormat,
+		"must be a valid cron expression running at most once an hour",
+		func(value any) bool {
+			switch v := value.(type) {
+			case string:
+				_, err := parseSnapshotCron(v)
+				return err == nil
+			case *string:
+				if v == nil {
+					return true
+				}
+				_, err := parseSnapshotCron(*v)
+				return err == nil
+			}
+
+			return false
+		},
+	)
+}
+
+// parseSnapshotCron parses a cron expression and checks its first runs.
+// Checking a few consecutive runs is enough: a standard expression firing
+// more than once an hour does it within the first hour it fires in.
+func parseSnapshotCron(expression string) (cron.Schedule, error) {
+	schedule, err := cron.ParseStandard(expression)
+	if err != nil {
+		return nil, fmt.Errorf("cannot parse cron expression: %w", err)
+	}
+
+	run := schedule.Next(time.Now())
+	if run.IsZero() {
+		return nil, fmt.Errorf("cron expression %q never runs", expression)
+	}
+
+	for range 3 {
+		next := schedule.Next(run)
+		if next.IsZero() {
+			break
+		}
+
+		if next.Sub(run) < snapshotScheduleMinInterval {
+			return nil, fmt.Errorf("cron expression %q runs more than once every %s", expression, snapshotScheduleMinInterval)
+		}
+
+		run = next
+	}
+
+	return schedule, nil
+}
+
+// nextSnapshotRunAt returns the first time after from matching the cron
+// expression.
+func nextSnapshotRunAt(expression string, from time.Time) (time.Time, error) {
+	schedule, err := cron.ParseStandard(expression)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("cannot parse cron expression: %w", err)
+	}
+
+	next := schedule.Next(from)
+	if next.IsZero() {
+		return time.Time{}, fmt.Errorf("cron expression %q never runs", expression)
+	}
+
+	return next, nil
+}
+
+func (s SnapshotScheduleService) Get(
+	ctx context.Context,
+	scheduleID gid.GID,
+) (*coredata.SnapshotSchedule, error) {
+	schedule := &coredata.SnapshotSchedule{}
+
+	err := s.svc.pg.WithConn(
+		ctx,
+		func(conn pg.Conn) error {
+			return schedule.LoadByID(ctx, conn, s.svc.scope, scheduleID)
+		},
+	)
+	if err != nil {
+		return nil, fmt.Errorf("cannot load snapshot schedule: %w", err)
+	}
+
+	return schedule, nil
+}
+
+func (s SnapshotScheduleService) CountForOrganizationID(
+	ctx context.Context,
+	organizationID gid.GID,
+) (int, error) {
+	var count int
+
+	err := s.svc.pg.WithConn(
+		ctx,
+		func(conn pg.Conn) (err error) {
+			schedules := coredata.SnapshotSchedules{}
+			count, err = schedules.CountByOrganizationID(ctx, conn, s.svc.scope, organizationID)
+			return err
+		},
+	)
+	if err != nil {
+		return 0, fmt.Errorf("cannot count snapshot schedules: %w", err)
+	}
+
+	return count, nil
+}
+
+func (s SnapshotScheduleService) ListForOrganizationID(
+	ctx context.Context,
+	organizationID gid.GID,
+	cursor *page.Cursor[coredata.SnapshotScheduleOrderField],
+) (*page.Page[*coredata.SnapshotSchedule, coredata.SnapshotScheduleOrderField], error) {
+	var schedules coredata.SnapshotSchedules
+
+	err := s.svc.pg.WithConn(
+		ctx,
+		func(conn pg.Conn) error {
+			return schedules.LoadByOrganizationID(ctx, conn, s.svc.scope, organizationID, cursor)
+		},
+	)
+	if err != nil {
+		return nil, fmt.Errorf("cannot list snapshot schedules: %w", err)
+	}
+
+	return page.NewPage(schedules, cursor), nil
+}
+
+func (s SnapshotScheduleService) Create(
+	ctx context.Context,
+	req CreateSnapshotScheduleRequest,
+) (*coredata.SnapshotSchedule, error) {
+	if err := req.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid request: %w", err)
+	}
+
+	now := time.Now()
+
+	nextRunAt, err := nextSnapshotRunAt(req.CronExpression, now)
+	if err != nil {
+		return nil, err
+	}
+
+	schedule := &coredata.SnapshotSchedule{
+		ID:             gid.New(s.svc.scope.GetTenantID(), coredata.SnapshotScheduleEntityType),
+		OrganizationID: req.OrganizationID,
+		Name:           req.Name,
+		Description:    req.Description,
+		Type:           req.Type,
+		CronExpression: req.CronExpression,
+		NextRunAt:      nextRunAt,
+		CreatedAt:      now,
+		UpdatedAt:      now,
+	}
+
+	err = s.svc.pg.WithTx(
+		ctx,
+		func(conn pg.Conn) error {
+			organization := &coredata.Organization{}
+			if err := organization.LoadByID(ctx, conn, s.svc.scope, req.OrganizationID); err != nil {
+				return fmt.Errorf("cannot load organization: %w", err)
+			}
+
+			if err := schedule.Insert(ctx, conn, s.svc.scope); err != nil {
+				return fmt.Errorf("cannot insert snapshot schedule: %w", err)
+			}
+
+			if err := auditlog.Record(ctx, conn, s.svc.scope, schedule.OrganizationID, ActionSnapshotScheduleCreate, schedule.ID, nil, snapshotScheduleAuditState(schedule)); err != nil {
+				return fmt.Errorf("cannot record audit log entry: %w", err)
+			}
+
+			return nil
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return schedule, nil
+}
+
+func (s SnapshotScheduleService) Update(
+	ctx context.Context,
+	req UpdateSnapshotScheduleRequest,
+) (*coredata.SnapshotSchedule, error) {
+	if err := req.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid request: %w", err)
+	}
+
+	schedule := &coredata.SnapshotSchedule{}
+
+	err := s.svc.pg.WithTx(
+		ctx,
+		func(conn pg.Conn) error {
+			if err := schedule.LoadByID(ctx, conn, s.svc.scope, req.ID); err != nil {
+				return fmt.Errorf("cannot load snapshot schedule: %w", err)
+			}
+
+			before := snapshotScheduleAuditState(schedule)
+			now := time.Now()
+
+			if req.Name != nil {
+				schedule.Name = *req.Name
+			}
+
+			if req.Description != nil {
+				schedule.Description = *req.Description
+			}
+
+			if req.Type != nil {
+				schedule.Type = *req.Type
+			}
+
+			if req.CronExpression != nil && *req.CronExpression != schedule.CronExpression {
+				nextRunAt, err := nextSnapshotRunAt(*req.CronExpression, now)
+				if err != nil {
+					return err
+				}
+
+				schedule.CronExpression = *req.CronExpression
+				schedule.NextRunAt = nextRunAt
+			}
+
+			schedule.UpdatedAt = now
+
+			if err := schedule.Update(ctx, conn, s.svc.scope); err != nil {
+				return fmt.Errorf("cannot update snapshot schedule: %w", err)
+			}
+
+			if err := auditlog.Record(ctx, conn, s.svc.scope, schedule.OrganizationID, ActionSnapshotScheduleUpdate, schedule.ID, before, snapshotScheduleAuditState(schedule)); err != nil {
+				return fmt.Errorf("cannot record audit log entry: %w", err)
+			}
+
+			return nil
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return schedule, nil
+}
+
+func (s SnapshotScheduleService) Delete(
+	ctx context.Context,
+	scheduleID gid.GID,
+) error {
+	schedule := &coredata.SnapshotSchedule{}
+
+	return s.svc.pg.WithTx(
+		ctx,
+		func(conn pg.Conn) error {
+			if err := schedule.LoadByID(ctx, conn, s.svc.scope, scheduleID); err != nil {
+				return fmt.Errorf("cannot load snapshot schedule: %w", err)
+			}
+
+			if err := auditlog.Record(ctx, conn, s.svc.scope, schedule.OrganizationID, ActionSnapshotScheduleDelete, schedule.ID, snapshotScheduleAuditState(schedule), nil); err != nil {
+				return fmt.Errorf("cannot record audit log entry: %w", err)
+			}
+
+			if err := schedule.Delete(ctx, conn, s.svc.scope); err != nil {
+				return fmt.Errorf("cannot delete snapshot schedule: %w", err)
+			}
+
+			return nil
+		},
+	)
+}
+
+func snapshotScheduleAuditState(s *coredata.SnapshotSchedule) map[string]any {
+	return map[string]any{
+		"name":           s.Name,
+		"description":    s.Description,
+		"type":           s.Type,
+		"cronExpression": s.CronExpression,
+	}
+}
@@ -0,0 +1,81 @@
+// Copyright (c) 2025 Probo Inc <hello@getprobo.com>.
+//
+// Permission to use, copy, modify, and/or distribute this software for any
+// purpose with or without fee is hereby granted, provided that the above
+// copyright notice and this permission notice appear in all copies.
+//
+// THE SOFTWARE IS PROVIDED "AS IS" AND THE AUTHOR DISCLAIMS ALL WARRANTIES WITH
+// REGARD TO THIS SOFTWARE INCLUDING ALL IMPLIED WARRANTIES OF MERCHANTABILITY
+// AND FITNESS. IN NO EVENT SHALL THE AUTHOR BE LIABLE FOR ANY SPECIAL, DIRECT,
+// INDIRECT, OR CONSEQUENTIAL DAMAGES OR ANY DAMAGES WHATSOEVER RESULTING FROM
+// LOSS OF USE, DATA OR PROFITS, WHETHER IN AN ACTION OF CONTRACT, NEGLIGENCE OR
+// OTHER TORTIOUS ACTION, ARISING OUT OF OR IN CONNECTION WITH THE USE OR
+// PERFORMANCE OF THIS SOFTWARE.
+
+package probo
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.probo.inc/probo/pkg/coredata"
+	"go.probo.inc/probo/pkg/gid"
+)
+
+func TestParseSnapshotCron(t *testing.T) {
+	tests := []struct {
+		expression string
+		wantErr    bool
+	}{
+		{expression: "0 2 * * *"},
+		{expression: "0 * * * *"},
+		{expression: "@monthly"},
+		{expression: "@every 1h"},
+		{expression: "CRON_TZ=Europe/Paris 0 9 * * 1"},
+		{expression: "0 0 29 2 *"},
+		{expression: "not a cron", wantErr: true},
+		{expression: "0 0 30 2 *", wantErr: true},
+		{expression: "@every 1s", wantErr: true},
+		{expression: "@every 59m", wantErr: true},
+		{expression: "* * * * *", wantErr: true},
+		{expression: "0,30 * * * *", wantErr: true},
+		{expression: "*/5 3 1 1 *", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.expression, func(t *testing.T) {
+			_, err := parseSnapshotCron(tt.expression)
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+
+			req := UpdateSnapshotScheduleRequest{
+				ID:             gid.New(gid.NewTenantID(), coredata.SnapshotScheduleEntityType),
+				CronExpression: &tt.expression,
+			}
+			assert.Equal(t, tt.wantErr, req.Validate() != nil)
+		})
+	}
+}
+
+func TestNextSnapshotRunAt(t *testing.T) {
+	from := time.Date(2025, time.March, 14, 10, 30, 0, 0, time.UTC)
+
+	next, err := nextSnapshotRunAt("0 2 * * *", from)
+	require.NoError(t, err)
+	assert.Equal(t, time.Date(2025, time.March, 15, 2, 0, 0, 0, time.UTC), next)
+
+	next, err = nextSnapshotRunAt("0 0 29 2 *", from)
+	require.NoError(t, err)
+	assert.Equal(t, time.Date(2028, time.February, 29, 0, 0, 0, 0, time.UTC), next)
+
+	_, err = nextSnapshotRunAt("0 0 30 2 *", from)
+	assert.Error(t, err)
+
+	_, err = nextSnapshotRunAt("not a cron", from)
+	assert.Error(t, err)
+}
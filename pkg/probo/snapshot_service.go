@@ -20,6 +20,7 @@ import (
 	"time"
 
 	"go.gearno.de/kit/pg"
+	"go.probo.inc/probo/pkg/auditlog"
 	"go.probo.inc/probo/pkg/coredata"
 	"go.probo.inc/probo/pkg/gid"
 	"go.probo.inc/probo/pkg/page"
@@ -31,6 +32,15 @@ type SnapshotService struct {
 }
 
 type (
+	ErrSnapshotTypeMismatch struct {
+		From coredata.SnapshotsType
+		To   coredata.SnapshotsType
+	}
+
+	ErrSnapshotDiffUnsupported struct {
+		Type coredata.SnapshotsType
+	}
+
 	CreateSnapshotRequest struct {
 		OrganizationID gid.GID
 		Name           string
@@ -46,6 +56,14 @@ type (
 	}
 )
 
+func (e ErrSnapshotTypeMismatch) Error() string {
+	return fmt.Sprintf("cannot compare a %s snapshot with a %s snapshot", e.From, e.To)
+}
+
+func (e ErrSnapshotDiffUnsupported) Error() string {
+	return fmt.Sprintf("cannot compare %s snapshots", e.Type)
+}
+
 func (csr *CreateSnapshotRequest) Validate() error {
 	v := validator.New()
 
@@ -124,6 +142,10 @@ func (s *SnapshotService) Create(
 				return fmt.Errorf("cannot create snapshot: %w", err)
 			}
 
+			if err := auditlog.Record(ctx, conn, s.svc.scope, snapshot.OrganizationID, ActionSnapshotCreate, snapshot.ID, nil, snapshotAuditState(snapshot)); err != nil {
+				return fmt.Errorf("cannot record audit log entry: %w", err)
+			}
+
 			return nil
 		},
 	)
@@ -139,7 +161,7 @@ func (s *SnapshotService) Delete(
 	ctx context.Context,
 	snapshotID gid.GID,
 ) error {
-	err := s.svc.pg.WithConn(
+	err := s.svc.pg.WithTx(
 		ctx,
 		func(conn pg.Conn) error {
 			snapshot := &coredata.Snapshot{}
@@ -147,6 +169,10 @@ func (s *SnapshotService) Delete(
 				return fmt.Errorf("cannot load snapshot: %w", err)
 			}
 
+			if err := auditlog.Record(ctx, conn, s.svc.scope, snapshot.OrganizationID, ActionSnapshotDelete, snapshot.ID, snapshotAuditState(snapshot), nil); err != nil {
+				return fmt.Errorf("cannot record audit log entry: %w", err)
+			}
+
 			if err := snapshot.Delete(ctx, conn, s.svc.scope); err != nil {
 				return fmt.Errorf("cannot delete snapshot: %w", err)
 			}
@@ -239,3 +265,76 @@ func (s *SnapshotService) ListForControlID(
 
 	return page.NewPage(snapshots, cursor), nil
 }
+
+// Diff compares two snapshots of the same type taken for the given
+// organization.
+func (s *SnapshotService) Diff(
+	ctx context.Context,
+	organizationID gid.GID,
+	fromSnapshotID gid.GID,
+	toSnapshotID gid.GID,
+) (*SnapshotDiff, error) {
+	var (
+		from     = &coredata.Snapshot{}
+		to       = &coredata.Snapshot{}
+		fromRows = coredata.SnapshotRows{}
+		toRows   = coredata.SnapshotRows{}
+	)
+
+	err := s.svc.pg.WithConn(
+		ctx,
+		func(conn pg.Conn) error {
+			if err := from.LoadByID(ctx, conn, s.svc.scope, fromSnapshotID); err != nil {
+				return fmt.Errorf("cannot load snapshot %q: %w", fromSnapshotID, err)
+			}
+
+			if err := to.LoadByID(ctx, conn, s.svc.scope, toSnapshotID); err != nil {
+				return fmt.Errorf("cannot load snapshot %q: %w", toSnapshotID, err)
+			}
+
+			if from.OrganizationID != organizationID || to.OrganizationID != organizationID {
+				return coredata.ErrResourceNotFound
+			}
+
+			if from.Type != to.Type {
+				return &ErrSnapshotTypeMismatch{From: from.Type, To: to.Type}
+			}
+
+			if _, ok := coredata.SnapshotRowsLabelField(from.Type); !ok {
+				return &ErrSnapshotDiffUnsupported{Type: from.Type}
+			}
+
+			if err := fromRows.LoadBySnapshotID(ctx, conn, s.svc.scope, from.Type, from.ID); err != nil {
+				return fmt.Errorf("cannot load snapshot rows: %w", err)
+			}
+
+			if err := toRows.LoadBySnapshotID(ctx, conn, s.svc.scope, to.Type, to.ID); err != nil {
+				return fmt.Errorf("cannot load snapshot rows: %w", err)
+			}
+
+			return nil
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	labelField, _ := coredata.SnapshotRowsLabelField(from.Type)
+	added, removed, changed := diffSnapshotRows(fromRows, toRows, labelField)
+
+	return &SnapshotDiff{
+		From:    from,
+		To:      to,
+		Added:   added,
+		Removed: removed,
+		Changed: changed,
+	}, nil
+}
+
+func snapshotAuditState(s *coredata.Snapshot) map[string]any {
+	return map[string]any{
+		"name":        s.Name,
+		"description": s.Description,
+		"type":        s.Type,
+	}
+}
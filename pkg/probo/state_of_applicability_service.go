@@ -21,6 +21,7 @@ import (
 	"time"
 
 	"go.gearno.de/kit/pg"
+	"go.probo.inc/probo/pkg/auditlog"
 	"go.probo.inc/probo/pkg/coredata"
 	"go.probo.inc/probo/pkg/docgen"
 	"go.probo.inc/probo/pkg/gid"
@@ -192,6 +193,10 @@ func (s StateOfApplicabilityService) Create(
 				return fmt.Errorf("cannot insert state_of_applicability: %w", err)
 			}
 
+			if err := auditlog.Record(ctx, conn, s.svc.scope, stateOfApplicability.OrganizationID, ActionStateOfApplicabilityCreate, stateOfApplicability.ID, nil, stateOfApplicabilityAuditState(stateOfApplicability)); err != nil {
+				return fmt.Errorf("cannot record audit log entry: %w", err)
+			}
+
 			return nil
 		},
 	)
@@ -220,6 +225,8 @@ func (s StateOfApplicabilityService) Update(
 				return fmt.Errorf("cannot load state_of_applicability: %w", err)
 			}
 
+			before := stateOfApplicabilityAuditState(stateOfApplicability)
+
 			if req.Name != nil {
 				stateOfApplicability.Name = *req.Name
 			}
@@ -233,6 +240,10 @@ func (s StateOfApplicabilityService) Update(
 				return fmt.Errorf("cannot update state_of_applicability: %w", err)
 			}
 
+			if err := auditlog.Record(ctx, conn, s.svc.scope, stateOfApplicability.OrganizationID, ActionStateOfApplicabilityUpdate, stateOfApplicability.ID, before, stateOfApplicabilityAuditState(stateOfApplicability)); err != nil {
+				return fmt.Errorf("cannot record audit log entry: %w", err)
+			}
+
 			return nil
 		},
 	)
@@ -257,6 +268,10 @@ func (s StateOfApplicabilityService) Delete(
 				return fmt.Errorf("cannot load state_of_applicability: %w", err)
 			}
 
+			if err := auditlog.Record(ctx, conn, s.svc.scope, stateOfApplicability.OrganizationID, ActionStateOfApplicabilityDelete, stateOfApplicability.ID, stateOfApplicabilityAuditState(stateOfApplicability), nil); err != nil {
+				return fmt.Errorf("cannot record audit log entry: %w", err)
+			}
+
 			if err := stateOfApplicability.Delete(ctx, conn, s.svc.scope); err != nil {
 				return fmt.Errorf("cannot delete state_of_applicability: %w", err)
 			}
@@ -356,6 +371,10 @@ func (s StateOfApplicabilityService) CreateApplicabilityStatement(
 				return fmt.Errorf("cannot insert applicability statement: %w", err)
 			}
 
+			if err := auditlog.Record(ctx, conn, s.svc.scope, applicabilityStatement.OrganizationID, ActionApplicabilityStatementCreate, applicabilityStatement.ID, nil, applicabilityStatementAuditState(applicabilityStatement)); err != nil {
+				return fmt.Errorf("cannot record audit log entry: %w", err)
+			}
+
 			return nil
 		},
 	)
@@ -381,11 +400,21 @@ func (s StateOfApplicabilityService) UpdateApplicabilityStatement(
 				return err
 			}
 
+			before := applicabilityStatementAuditState(applicabilityStatement)
+
 			applicabilityStatement.Applicability = applicability
 			applicabilityStatement.Justification = justification
 			applicabilityStatement.UpdatedAt = time.Now()
 
-			return applicabilityStatement.UpdateByID(ctx, conn, s.svc.scope)
+			if err := applicabilityStatement.UpdateByID(ctx, conn, s.svc.scope); err != nil {
+				return fmt.Errorf("cannot update applicability statement: %w", err)
+			}
+
+			if err := auditlog.Record(ctx, conn, s.svc.scope, applicabilityStatement.OrganizationID, ActionApplicabilityStatementUpdate, applicabilityStatement.ID, before, applicabilityStatementAuditState(applicabilityStatement)); err != nil {
+				return fmt.Errorf("cannot record audit log entry: %w", err)
+			}
+
+			return nil
 		},
 	)
 	if err != nil {
@@ -404,6 +433,14 @@ func (s StateOfApplicabilityService) DeleteApplicabilityStatement(
 	return s.svc.pg.WithTx(
 		ctx,
 		func(conn pg.Conn) error {
+			if err := applicabilityStatement.LoadByID(ctx, conn, s.svc.scope, applicabilityStatementID); err != nil {
+				return fmt.Errorf("cannot load applicability statement: %w", err)
+			}
+
+			if err := auditlog.Record(ctx, conn, s.svc.scope, applicabilityStatement.OrganizationID, ActionApplicabilityStatementDelete, applicabilityStatement.ID, applicabilityStatementAuditState(applicabilityStatement), nil); err != nil {
+				return fmt.Errorf("cannot record audit log entry: %w", err)
+			}
+
 			return applicabilityStatement.DeleteByID(ctx, conn, s.svc.scope, applicabilityStatementID)
 		},
 	)
@@ -659,3 +696,19 @@ func (s StateOfApplicabilityService) ExportPDF(
 
 	return pdfData, nil
 }
+
+func stateOfApplicabilityAuditState(soa *coredata.StateOfApplicability) map[string]any {
+	return map[string]any{
+		"name":    soa.Name,
+		"ownerId": soa.OwnerID,
+	}
+}
+
+func applicabilityStatementAuditState(as *coredata.ApplicabilityStatement) map[string]any {
+	return map[string]any{
+		"stateOfApplicabilityId": as.StateOfApplicabilityID,
+		"controlId":              as.ControlID,
+		"applicability":          as.Applicability,
+		"justification":          as.Justification,
+	}
+}
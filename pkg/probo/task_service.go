@@ -21,10 +21,13 @@ import (
 
 	"go.gearno.de/crypto/uuid"
 	"go.gearno.de/kit/pg"
+	"go.probo.inc/probo/pkg/auditlog"
 	"go.probo.inc/probo/pkg/coredata"
 	"go.probo.inc/probo/pkg/gid"
 	"go.probo.inc/probo/pkg/page"
 	"go.probo.inc/probo/pkg/validator"
+	"go.probo.inc/probo/pkg/webhook"
+	webhooktypes "go.probo.inc/probo/pkg/webhook/types"
 )
 
 type (
@@ -89,6 +92,27 @@ func (s TaskService) Create(
 		return nil, err
 	}
 
+	var task *coredata.Task
+
+	err := s.svc.pg.WithTx(
+		ctx,
+		func(conn pg.Conn) (err error) {
+			task, err = s.createInTx(ctx, conn, req)
+			return err
+		},
+	)
+	if err != nil {
+		return nil, fmt.Errorf("cannot create task: %w", err)
+	}
+
+	return task, nil
+}
+
+func (s TaskService) createInTx(
+	ctx context.Context,
+	conn pg.Conn,
+	req CreateTaskRequest,
+) (*coredata.Task, error) {
 	now := time.Now()
 	taskID := gid.New(s.svc.scope.GetTenantID(), coredata.TaskEntityType)
 
@@ -112,32 +136,30 @@ func (s TaskService) Create(
 		UpdatedAt:      now,
 	}
 
-	err = s.svc.pg.WithTx(
-		ctx,
-		func(conn pg.Conn) error {
-			if req.MeasureID != nil {
-				measure := &coredata.Measure{}
-				if err := measure.LoadByID(ctx, conn, s.svc.scope, *req.MeasureID); err != nil {
-					return fmt.Errorf("cannot load measure: %w", err)
-				}
-			}
+	if req.MeasureID != nil {
+		measure := &coredata.Measure{}
+		if err := measure.LoadByID(ctx, conn, s.svc.scope, *req.MeasureID); err != nil {
+			return nil, fmt.Errorf("cannot load measure: %w", err)
+		}
+	}
 
-			if req.AssignedToID != nil {
-				assignee := &coredata.MembershipProfile{}
-				if err := assignee.LoadByID(ctx, conn, s.svc.scope, *req.AssignedToID); err != nil {
-					return fmt.Errorf("cannot load assignee profile: %w", err)
-				}
-			}
+	if req.AssignedToID != nil {
+		assignee := &coredata.MembershipProfile{}
+		if err := assignee.LoadByID(ctx, conn, s.svc.scope, *req.AssignedToID); err != nil {
+			return nil, fmt.Errorf("cannot load assignee profile: %w", err)
+		}
+	}
 
-			if err := task.Insert(ctx, conn, s.svc.scope); err != nil {
-				return fmt.Errorf("cannot insert task: %w", err)
-			}
+	if err := task.Insert(ctx, conn, s.svc.scope); err != nil {
+		return nil, fmt.Errorf("cannot insert task: %w", err)
+	}
 
-			return nil
-		},
-	)
-	if err != nil {
-		return nil, fmt.Errorf("cannot create task: %w", err)
+	if err := webhook.InsertData(ctx, conn, s.svc.scope, task.OrganizationID, coredata.WebhookEventTypeTaskCreated, webhooktypes.NewTask(task)); err != nil {
+		return nil, fmt.Errorf("cannot insert webhook event: %w", err)
+	}
+
+	if err := auditlog.Record(ctx, conn, s.svc.scope, task.OrganizationID, ActionTaskCreate, task.ID, nil, webhooktypes.NewTask(task)); err != nil {
+		return nil, fmt.Errorf("cannot record audit log entry: %w", err)
 	}
 
 	return task, nil
@@ -176,6 +198,8 @@ func (s TaskService) Assign(
 				return fmt.Errorf("cannot load task %q: %w", taskID, err)
 			}
 
+			before := webhooktypes.NewTask(task)
+
 			assignee := &coredata.MembershipProfile{}
 			if err := assignee.LoadByID(ctx, conn, s.svc.scope, assignedToID); err != nil {
 				return fmt.Errorf("cannot load assignee profile: %w", err)
@@ -188,6 +212,14 @@ func (s TaskService) Assign(
 				return fmt.Errorf("cannot assign task %q to %q: %w", taskID, assignedToID, err)
 			}
 
+			if err := webhook.InsertData(ctx, conn, s.svc.scope, task.OrganizationID, coredata.WebhookEventTypeTaskUpdated, webhooktypes.NewTask(task)); err != nil {
+				return fmt.Errorf("cannot insert webhook event: %w", err)
+			}
+
+			if err := auditlog.Record(ctx, conn, s.svc.scope, task.OrganizationID, ActionTaskAssign, task.ID, before, webhooktypes.NewTask(task)); err != nil {
+				return fmt.Errorf("cannot record audit log entry: %w", err)
+			}
+
 			return nil
 		},
 	)
@@ -211,6 +243,8 @@ func (s TaskService) Unassign(
 				return fmt.Errorf("cannot load task %q: %w", taskID, err)
 			}
 
+			before := webhooktypes.NewTask(task)
+
 			task.AssignedToID = nil
 			task.UpdatedAt = time.Now()
 
@@ -218,6 +252,14 @@ func (s TaskService) Unassign(
 				return fmt.Errorf("cannot unassign task %q: %w", taskID, err)
 			}
 
+			if err := webhook.InsertData(ctx, conn, s.svc.scope, task.OrganizationID, coredata.WebhookEventTypeTaskUpdated, webhooktypes.NewTask(task)); err != nil {
+				return fmt.Errorf("cannot insert webhook event: %w", err)
+			}
+
+			if err := auditlog.Record(ctx, conn, s.svc.scope, task.OrganizationID, ActionTaskUnassign, task.ID, before, webhooktypes.NewTask(task)); err != nil {
+				return fmt.Errorf("cannot record audit log entry: %w", err)
+			}
+
 			return nil
 		},
 	)
@@ -245,6 +287,8 @@ func (s TaskService) Update(
 				return fmt.Errorf("cannot load task %q: %w", req.TaskID, err)
 			}
 
+			before := webhooktypes.NewTask(task)
+
 			if req.Name != nil {
 				task.Name = *req.Name
 			}
@@ -295,6 +339,14 @@ func (s TaskService) Update(
 				return fmt.Errorf("cannot update task: %w", err)
 			}
 
+			if err := webhook.InsertData(ctx, conn, s.svc.scope, task.OrganizationID, coredata.WebhookEventTypeTaskUpdated, webhooktypes.NewTask(task)); err != nil {
+				return fmt.Errorf("cannot insert webhook event: %w", err)
+			}
+
+			if err := auditlog.Record(ctx, conn, s.svc.scope, task.OrganizationID, ActionTaskUpdate, task.ID, before, webhooktypes.NewTask(task)); err != nil {
+				return fmt.Errorf("cannot record audit log entry: %w", err)
+			}
+
 			return nil
 		},
 	)
@@ -309,11 +361,23 @@ func (s TaskService) Delete(
 	ctx context.Context,
 	taskID gid.GID,
 ) error {
-	task := &coredata.Task{ID: taskID}
+	task := &coredata.Task{}
 
 	err := s.svc.pg.WithTx(
 		ctx,
 		func(conn pg.Conn) error {
+			if err := task.LoadByID(ctx, conn, s.svc.scope, taskID); err != nil {
+				return fmt.Errorf("cannot load task %q: %w", taskID, err)
+			}
+
+			if err := webhook.InsertData(ctx, conn, s.svc.scope, task.OrganizationID, coredata.WebhookEventTypeTaskDeleted, webhooktypes.NewTask(task)); err != nil {
+				return fmt.Errorf("cannot insert webhook event: %w", err)
+			}
+
+			if err := auditlog.Record(ctx, conn, s.svc.scope, task.OrganizationID, ActionTaskDelete, task.ID, webhooktypes.NewTask(task), nil); err != nil {
+				return fmt.Errorf("cannot record audit log entry: %w", err)
+			}
+
 			return task.Delete(ctx, conn, s.svc.scope)
 		},
 	)
@@ -21,6 +21,7 @@ import (
 	"time"
 
 	"go.gearno.de/kit/pg"
+	"go.probo.inc/probo/pkg/auditlog"
 	"go.probo.inc/probo/pkg/coredata"
 	"go.probo.inc/probo/pkg/docgen"
 	"go.probo.inc/probo/pkg/gid"
@@ -214,6 +215,10 @@ func (s *TransferImpactAssessmentService) Create(
 				return fmt.Errorf("cannot insert transfer impact assessment: %w", err)
 			}
 
+			if err := auditlog.Record(ctx, conn, s.svc.scope, tia.OrganizationID, ActionTransferImpactAssessmentCreate, tia.ID, nil, transferImpactAssessmentAuditState(tia)); err != nil {
+				return fmt.Errorf("cannot record audit log entry: %w", err)
+			}
+
 			return nil
 		},
 	)
@@ -242,6 +247,8 @@ func (s *TransferImpactAssessmentService) Update(
 				return fmt.Errorf("cannot load transfer impact assessment: %w", err)
 			}
 
+			before := transferImpactAssessmentAuditState(tia)
+
 			if req.DataSubjects != nil {
 				tia.DataSubjects = *req.DataSubjects
 			}
@@ -268,6 +275,10 @@ func (s *TransferImpactAssessmentService) Update(
 				return fmt.Errorf("cannot update transfer impact assessment: %w", err)
 			}
 
+			if err := auditlog.Record(ctx, conn, s.svc.scope, tia.OrganizationID, ActionTransferImpactAssessmentUpdate, tia.ID, before, transferImpactAssessmentAuditState(tia)); err != nil {
+				return fmt.Errorf("cannot record audit log entry: %w", err)
+			}
+
 			return nil
 		},
 	)
@@ -291,6 +302,10 @@ func (s *TransferImpactAssessmentService) Delete(
 				return fmt.Errorf("cannot load transfer impact assessment: %w", err)
 			}
 
+			if err := auditlog.Record(ctx, conn, s.svc.scope, tia.OrganizationID, ActionTransferImpactAssessmentDelete, tia.ID, transferImpactAssessmentAuditState(tia), nil); err != nil {
+				return fmt.Errorf("cannot record audit log entry: %w", err)
+			}
+
 			if err := tia.Delete(ctx, conn, s.svc.scope); err != nil {
 				return fmt.Errorf("cannot delete transfer impact assessment: %w", err)
 			}
@@ -427,3 +442,14 @@ func (s *TransferImpactAssessmentService) ExportPDF(
 
 	return pdfData, nil
 }
+
+func transferImpactAssessmentAuditState(tia *coredata.TransferImpactAssessment) map[string]any {
+	return map[string]any{
+		"processingActivityId":  tia.ProcessingActivityID,
+		"dataSubjects":          tia.DataSubjects,
+		"legalMechanism":        tia.LegalMechanism,
+		"transfer":              tia.Transfer,
+		"localLawRisk":          tia.LocalLawRisk,
+		"supplementaryMeasures": tia.SupplementaryMeasures,
+	}
+}
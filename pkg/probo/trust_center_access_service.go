@@ -22,12 +22,15 @@ import (
 
 	"go.gearno.de/kit/pg"
 	"go.probo.inc/probo/packages/emails"
+	"go.probo.inc/probo/pkg/auditlog"
 	"go.probo.inc/probo/pkg/coredata"
 	"go.probo.inc/probo/pkg/gid"
 	"go.probo.inc/probo/pkg/mail"
 	"go.probo.inc/probo/pkg/page"
 	"go.probo.inc/probo/pkg/slack"
 	"go.probo.inc/probo/pkg/validator"
+
+	webhooktypes "go.probo.inc/probo/pkg/webhook/types"
 )
 
 type (
@@ -253,6 +256,10 @@ func (s TrustCenterAccessService) Create(
 				return fmt.Errorf("cannot insert trust center access: %w", err)
 			}
 
+			if err := auditlog.Record(ctx, tx, s.svc.scope, access.OrganizationID, ActionTrustCenterAccessCreate, access.ID, nil, webhooktypes.NewTrustCenterAccess(access)); err != nil {
+				return fmt.Errorf("cannot record audit log entry: %w", err)
+			}
+
 			return nil
 		},
 	)
@@ -286,6 +293,8 @@ func (s TrustCenterAccessService) Update(
 				return fmt.Errorf("cannot load trust center access: %w", err)
 			}
 
+			before := webhooktypes.NewTrustCenterAccess(access)
+
 			trustCenterAcessActivated = req.State != nil && *req.State == coredata.TrustCenterAccessStateActive && access.State != coredata.TrustCenterAccessStateActive
 			if req.Name != nil {
 				access.Name = *req.Name
@@ -299,6 +308,10 @@ func (s TrustCenterAccessService) Update(
 				return fmt.Errorf("cannot update trust center access: %w", err)
 			}
 
+			if err := auditlog.Record(ctx, tx, s.svc.scope, access.OrganizationID, ActionTrustCenterAccessUpdate, access.ID, before, webhooktypes.NewTrustCenterAccess(access)); err != nil {
+				return fmt.Errorf("cannot record audit log entry: %w", err)
+			}
+
 			var tcdas coredata.TrustCenterDocumentAccesses
 
 			if len(req.DocumentAccesses) > 0 {
@@ -387,6 +400,10 @@ func (s TrustCenterAccessService) Delete(
 				return fmt.Errorf("cannot load trust center access: %w", err)
 			}
 
+			if err := auditlog.Record(ctx, tx, s.svc.scope, access.OrganizationID, ActionTrustCenterAccessDelete, access.ID, webhooktypes.NewTrustCenterAccess(access), nil); err != nil {
+				return fmt.Errorf("cannot record audit log entry: %w", err)
+			}
+
 			if err := access.Delete(ctx, tx, s.svc.scope); err != nil {
 				return fmt.Errorf("cannot delete trust center access: %w", err)
 			}
@@ -430,6 +447,7 @@ func (s TrustCenterAccessService) sendAccessEmail(ctx context.Context, tx pg.Con
 		textBody,
 		htmlBody,
 	)
+	accessEmail.OrganizationID = &organization.ID
 
 	if err := accessEmail.Insert(ctx, tx); err != nil {
 		return fmt.Errorf("cannot insert access email: %w", err)
@@ -27,6 +27,7 @@ import (
 	"github.com/aws/aws-sdk-go-v2/service/s3"
 	"go.gearno.de/crypto/uuid"
 	"go.gearno.de/kit/pg"
+	"go.probo.inc/probo/pkg/auditlog"
 	"go.probo.inc/probo/pkg/coredata"
 	"go.probo.inc/probo/pkg/filevalidation"
 	"go.probo.inc/probo/pkg/gid"
@@ -202,6 +203,10 @@ func (s TrustCenterFileService) Create(
 				return fmt.Errorf("cannot insert trust center file: %w", err)
 			}
 
+			if err := auditlog.Record(ctx, tx, s.svc.scope, file.OrganizationID, ActionTrustCenterFileCreate, file.ID, nil, trustCenterFileAuditState(file)); err != nil {
+				return fmt.Errorf("cannot record audit log entry: %w", err)
+			}
+
 			return nil
 		},
 	)
@@ -235,6 +240,8 @@ func (s TrustCenterFileService) Update(
 				return fmt.Errorf("cannot load trust center file: %w", err)
 			}
 
+			before := trustCenterFileAuditState(file)
+
 			if req.Name != nil {
 				file.Name = *req.Name
 			}
@@ -250,6 +257,10 @@ func (s TrustCenterFileService) Update(
 				return fmt.Errorf("cannot update trust center file: %w", err)
 			}
 
+			if err := auditlog.Record(ctx, tx, s.svc.scope, file.OrganizationID, ActionTrustCenterFileUpdate, file.ID, before, trustCenterFileAuditState(file)); err != nil {
+				return fmt.Errorf("cannot record audit log entry: %w", err)
+			}
+
 			return nil
 		},
 	)
@@ -274,6 +285,10 @@ func (s TrustCenterFileService) Delete(
 				return fmt.Errorf("cannot load trust center file: %w", err)
 			}
 
+			if err := auditlog.Record(ctx, tx, s.svc.scope, file.OrganizationID, ActionTrustCenterFileDelete, file.ID, trustCenterFileAuditState(file), nil); err != nil {
+				return fmt.Errorf("cannot record audit log entry: %w", err)
+			}
+
 			if err := file.Delete(ctx, tx, s.svc.scope); err != nil {
 				return fmt.Errorf("cannot delete trust center file: %w", err)
 			}
@@ -417,3 +432,12 @@ func (s TrustCenterFileService) cleanupS3Object(ctx context.Context, s3Key strin
 		Key:    aws.String(s3Key),
 	})
 }
+
+func trustCenterFileAuditState(f *coredata.TrustCenterFile) map[string]any {
+	return map[string]any{
+		"name":                  f.Name,
+		"category":              f.Category,
+		"fileId":                f.FileID,
+		"trustCenterVisibility": f.TrustCenterVisibility,
+	}
+}
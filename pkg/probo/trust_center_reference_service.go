@@ -28,6 +28,7 @@ import (
 	"github.com/aws/aws-sdk-go-v2/service/s3"
 	"go.gearno.de/crypto/uuid"
 	"go.gearno.de/kit/pg"
+	"go.probo.inc/probo/pkg/auditlog"
 	"go.probo.inc/probo/pkg/coredata"
 	"go.probo.inc/probo/pkg/gid"
 	"go.probo.inc/probo/pkg/page"
@@ -191,6 +192,10 @@ func (s TrustCenterReferenceService) Create(
 			return fmt.Errorf("cannot insert trust center reference: %w", err)
 		}
 
+		if err := auditlog.Record(ctx, tx, s.svc.scope, reference.OrganizationID, ActionTrustCenterReferenceCreate, reference.ID, nil, trustCenterReferenceAuditState(reference)); err != nil {
+			return fmt.Errorf("cannot record audit log entry: %w", err)
+		}
+
 		return nil
 	})
 
@@ -223,6 +228,8 @@ func (s TrustCenterReferenceService) Update(
 			return fmt.Errorf("cannot load trust center reference: %w", err)
 		}
 
+		before := trustCenterReferenceAuditState(reference)
+
 		if req.LogoFile != nil {
 			fileID, s3Key, err := s.uploadLogoFile(ctx, tx, *req.LogoFile, req.ID, reference.TrustCenterID, now)
 			if err != nil {
@@ -257,6 +264,10 @@ func (s TrustCenterReferenceService) Update(
 			return fmt.Errorf("cannot update trust center reference: %w", err)
 		}
 
+		if err := auditlog.Record(ctx, tx, s.svc.scope, reference.OrganizationID, ActionTrustCenterReferenceUpdate, reference.ID, before, trustCenterReferenceAuditState(reference)); err != nil {
+			return fmt.Errorf("cannot record audit log entry: %w", err)
+		}
+
 		return nil
 	})
 
@@ -279,6 +290,10 @@ func (s TrustCenterReferenceService) Delete(
 			return fmt.Errorf("cannot load trust center reference: %w", err)
 		}
 
+		if err := auditlog.Record(ctx, tx, s.svc.scope, reference.OrganizationID, ActionTrustCenterReferenceDelete, reference.ID, trustCenterReferenceAuditState(reference), nil); err != nil {
+			return fmt.Errorf("cannot record audit log entry: %w", err)
+		}
+
 		if err := reference.Delete(ctx, tx, s.svc.scope); err != nil {
 			return fmt.Errorf("cannot delete trust center reference: %w", err)
 		}
@@ -437,3 +452,14 @@ func (s TrustCenterReferenceService) cleanupS3Object(ctx context.Context, s3Key
 		Key:    aws.String(s3Key),
 	})
 }
+
+func trustCenterReferenceAuditState(r *coredata.TrustCenterReference) map[string]any {
+	return map[string]any{
+		"trustCenterId": r.TrustCenterID,
+		"name":          r.Name,
+		"description":   r.Description,
+		"websiteUrl":    r.WebsiteURL,
+		"logoFileId":    r.LogoFileID,
+		"rank":          r.Rank,
+	}
+}
@@ -29,6 +29,7 @@ import (
 	"go.gearno.de/crypto/uuid"
 	"go.gearno.de/kit/pg"
 	"go.probo.inc/probo/packages/emails"
+	"go.probo.inc/probo/pkg/auditlog"
 	"go.probo.inc/probo/pkg/coredata"
 	"go.probo.inc/probo/pkg/filevalidation"
 	"go.probo.inc/probo/pkg/gid"
@@ -189,6 +190,8 @@ func (s TrustCenterService) Update(
 				return fmt.Errorf("cannot load trust center: %w", err)
 			}
 
+			before := trustCenterAuditState(trustCenter)
+
 			if req.Active != nil {
 				trustCenter.Active = *req.Active
 			}
@@ -202,6 +205,10 @@ func (s TrustCenterService) Update(
 				return fmt.Errorf("cannot update trust center: %w", err)
 			}
 
+			if err := auditlog.Record(ctx, conn, s.svc.scope, trustCenter.OrganizationID, ActionTrustCenterUpdate, trustCenter.ID, before, trustCenterAuditState(trustCenter)); err != nil {
+				return fmt.Errorf("cannot record audit log entry: %w", err)
+			}
+
 			if trustCenter.NonDisclosureAgreementFileID != nil {
 				file = &coredata.File{}
 				if err := file.LoadByID(ctx, conn, s.svc.scope, *trustCenter.NonDisclosureAgreementFileID); err != nil {
@@ -244,6 +251,8 @@ func (s TrustCenterService) UploadNDA(
 				return fmt.Errorf("cannot load trust center: %w", err)
 			}
 
+			before := trustCenterAuditState(trustCenter)
+
 			mimeType := mime.TypeByExtension(filepath.Ext(req.FileName))
 
 			_, err := s.svc.s3.PutObject(ctx, &s3.PutObjectInput{
@@ -294,6 +303,10 @@ func (s TrustCenterService) UploadNDA(
 				return fmt.Errorf("cannot update trust center: %w", err)
 			}
 
+			if err := auditlog.Record(ctx, conn, s.svc.scope, trustCenter.OrganizationID, ActionTrustCenterNonDisclosureAgreementUpload, trustCenter.ID, before, trustCenterAuditState(trustCenter)); err != nil {
+				return fmt.Errorf("cannot record audit log entry: %w", err)
+			}
+
 			return nil
 		},
 	)
@@ -319,6 +332,8 @@ func (s TrustCenterService) DeleteNDA(
 				return fmt.Errorf("cannot load trust center: %w", err)
 			}
 
+			before := trustCenterAuditState(trustCenter)
+
 			trustCenter.NonDisclosureAgreementFileID = nil
 			trustCenter.UpdatedAt = time.Now()
 
@@ -326,6 +341,10 @@ func (s TrustCenterService) DeleteNDA(
 				return fmt.Errorf("cannot update trust center: %w", err)
 			}
 
+			if err := auditlog.Record(ctx, conn, s.svc.scope, trustCenter.OrganizationID, ActionTrustCenterNonDisclosureAgreementDelete, trustCenter.ID, before, trustCenterAuditState(trustCenter)); err != nil {
+				return fmt.Errorf("cannot record audit log entry: %w", err)
+			}
+
 			return nil
 		},
 	)
@@ -356,6 +375,8 @@ func (s TrustCenterService) UpdateTrustCenterBrand(
 				return fmt.Errorf("cannot load trust center: %w", err)
 			}
 
+			before := trustCenterAuditState(trustCenter)
+
 			now := time.Now()
 
 			if req.LogoFile != nil {
@@ -388,6 +409,10 @@ func (s TrustCenterService) UpdateTrustCenterBrand(
 				return fmt.Errorf("cannot update trust center: %w", err)
 			}
 
+			if err := auditlog.Record(ctx, conn, s.svc.scope, trustCenter.OrganizationID, ActionTrustCenterUpdate, trustCenter.ID, before, trustCenterAuditState(trustCenter)); err != nil {
+				return fmt.Errorf("cannot record audit log entry: %w", err)
+			}
+
 			if trustCenter.NonDisclosureAgreementFileID != nil {
 				ndaFile = &coredata.File{}
 				if err := ndaFile.LoadByID(ctx, conn, s.svc.scope, *trustCenter.NonDisclosureAgreementFileID); err != nil {
@@ -689,3 +714,13 @@ func (s *TrustCenterService) EmailPresenterConfig(ctx context.Context, complianc
 
 	return emailPresenterCfg, nil
 }
+
+func trustCenterAuditState(tc *coredata.TrustCenter) map[string]any {
+	return map[string]any{
+		"active":                       tc.Active,
+		"slug":                         tc.Slug,
+		"logoFileId":                   tc.LogoFileID,
+		"darkLogoFileId":               tc.DarkLogoFileID,
+		"nonDisclosureAgreementFileId": tc.NonDisclosureAgreementFileID,
+	}
+}
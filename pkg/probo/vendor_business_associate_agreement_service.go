@@ -27,6 +27,7 @@ import (
 	"github.com/aws/aws-sdk-go-v2/service/s3"
 	"go.gearno.de/crypto/uuid"
 	"go.gearno.de/kit/pg"
+	"go.probo.inc/probo/pkg/auditlog"
 	"go.probo.inc/probo/pkg/coredata"
 	"go.probo.inc/probo/pkg/gid"
 	"go.probo.inc/probo/pkg/validator"
@@ -182,6 +183,10 @@ func (s VendorBusinessAssociateAgreementService) Upload(
 				return fmt.Errorf("cannot insert vendor business associate agreement: %w", err)
 			}
 
+			if err := auditlog.Record(ctx, conn, s.svc.scope, vendorBusinessAssociateAgreement.OrganizationID, ActionVendorBusinessAssociateAgreementUpload, vendorBusinessAssociateAgreement.ID, nil, vendorBusinessAssociateAgreementAuditState(vendorBusinessAssociateAgreement)); err != nil {
+				return fmt.Errorf("cannot record audit log entry: %w", err)
+			}
+
 			return nil
 		},
 	)
@@ -291,6 +296,8 @@ func (s VendorBusinessAssociateAgreementService) Update(
 				return fmt.Errorf("cannot load existing vendor business associate agreement: %w", err)
 			}
 
+			before := vendorBusinessAssociateAgreementAuditState(existingAgreement)
+
 			now := time.Now()
 			if req.ValidFrom != nil {
 				existingAgreement.ValidFrom = *req.ValidFrom
@@ -305,6 +312,10 @@ func (s VendorBusinessAssociateAgreementService) Update(
 				return fmt.Errorf("cannot update vendor business associate agreement: %w", err)
 			}
 
+			if err := auditlog.Record(ctx, conn, s.svc.scope, existingAgreement.OrganizationID, ActionVendorBusinessAssociateAgreementUpdate, existingAgreement.ID, before, vendorBusinessAssociateAgreementAuditState(existingAgreement)); err != nil {
+				return fmt.Errorf("cannot record audit log entry: %w", err)
+			}
+
 			if err := file.LoadByID(ctx, conn, s.svc.scope, existingAgreement.FileID); err != nil {
 				return fmt.Errorf("cannot load file: %w", err)
 			}
@@ -332,6 +343,10 @@ func (s VendorBusinessAssociateAgreementService) Delete(
 				return fmt.Errorf("cannot load vendor business associate agreement: %w", err)
 			}
 
+			if err := auditlog.Record(ctx, conn, s.svc.scope, vendorBusinessAssociateAgreement.OrganizationID, ActionVendorBusinessAssociateAgreementDelete, vendorBusinessAssociateAgreement.ID, vendorBusinessAssociateAgreementAuditState(vendorBusinessAssociateAgreement), nil); err != nil {
+				return fmt.Errorf("cannot record audit log entry: %w", err)
+			}
+
 			if err := vendorBusinessAssociateAgreement.Delete(ctx, conn, s.svc.scope); err != nil {
 				return fmt.Errorf("cannot delete vendor business associate agreement: %w", err)
 			}
@@ -353,6 +368,10 @@ func (s VendorBusinessAssociateAgreementService) DeleteByVendorID(
 				return fmt.Errorf("cannot load vendor business associate agreement: %w", err)
 			}
 
+			if err := auditlog.Record(ctx, conn, s.svc.scope, vendorBusinessAssociateAgreement.OrganizationID, ActionVendorBusinessAssociateAgreementDelete, vendorBusinessAssociateAgreement.ID, vendorBusinessAssociateAgreementAuditState(vendorBusinessAssociateAgreement), nil); err != nil {
+				return fmt.Errorf("cannot record audit log entry: %w", err)
+			}
+
 			if err := vendorBusinessAssociateAgreement.DeleteByVendorID(ctx, conn, s.svc.scope, vendorID); err != nil {
 				return fmt.Errorf("cannot delete vendor business associate agreement: %w", err)
 			}
@@ -361,3 +380,12 @@ func (s VendorBusinessAssociateAgreementService) DeleteByVendorID(
 		},
 	)
 }
+
+func vendorBusinessAssociateAgreementAuditState(a *coredata.VendorBusinessAssociateAgreement) map[string]any {
+	return map[string]any{
+		"vendorId":   a.VendorID,
+		"validFrom":  a.ValidFrom,
+		"validUntil": a.ValidUntil,
+		"fileId":     a.FileID,
+	}
+}
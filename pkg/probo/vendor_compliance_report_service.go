@@ -20,6 +20,7 @@ import (
 	"time"
 
 	"go.gearno.de/kit/pg"
+	"go.probo.inc/probo/pkg/auditlog"
 	"go.probo.inc/probo/pkg/coredata"
 	"go.probo.inc/probo/pkg/filevalidation"
 	"go.probo.inc/probo/pkg/gid"
@@ -114,10 +115,18 @@ func (s VendorComplianceReportService) Upload(
 		UpdatedAt:      now,
 	}
 
-	err = s.svc.pg.WithConn(
+	err = s.svc.pg.WithTx(
 		ctx,
 		func(conn pg.Conn) error {
-			return vendorComplianceReport.Insert(ctx, conn, s.svc.scope)
+			if err := vendorComplianceReport.Insert(ctx, conn, s.svc.scope); err != nil {
+				return fmt.Errorf("cannot insert vendor compliance report: %w", err)
+			}
+
+			if err := auditlog.Record(ctx, conn, s.svc.scope, vendorComplianceReport.OrganizationID, ActionVendorComplianceReportUpload, vendorComplianceReport.ID, nil, vendorComplianceReportAuditState(vendorComplianceReport)); err != nil {
+				return fmt.Errorf("cannot record audit log entry: %w", err)
+			}
+
+			return nil
 		},
 	)
 
@@ -152,11 +161,19 @@ func (s VendorComplianceReportService) Delete(
 	ctx context.Context,
 	vendorComplianceReportID gid.GID,
 ) error {
-	vendorComplianceReport := &coredata.VendorComplianceReport{ID: vendorComplianceReportID}
+	vendorComplianceReport := &coredata.VendorComplianceReport{}
 
-	err := s.svc.pg.WithConn(
+	err := s.svc.pg.WithTx(
 		ctx,
 		func(conn pg.Conn) error {
+			if err := vendorComplianceReport.LoadByID(ctx, conn, s.svc.scope, vendorComplianceReportID); err != nil {
+				return err
+			}
+
+			if err := auditlog.Record(ctx, conn, s.svc.scope, vendorComplianceReport.OrganizationID, ActionVendorComplianceReportDelete, vendorComplianceReport.ID, vendorComplianceReportAuditState(vendorComplianceReport), nil); err != nil {
+				return fmt.Errorf("cannot record audit log entry: %w", err)
+			}
+
 			if err := vendorComplianceReport.Delete(ctx, conn, s.svc.scope); err != nil {
 				return err
 			}
@@ -171,3 +188,13 @@ func (s VendorComplianceReportService) Delete(
 
 	return nil
 }
+
+func vendorComplianceReportAuditState(r *coredata.VendorComplianceReport) map[string]any {
+	return map[string]any{
+		"vendorId":     r.VendorID,
+		"reportDate":   r.ReportDate,
+		"validUntil":   r.ValidUntil,
+		"reportName":   r.ReportName,
+		"reportFileId": r.ReportFileId,
+	}
+}
@@ -20,6 +20,7 @@ import (
 	"time"
 
 	"go.gearno.de/kit/pg"
+	"go.probo.inc/probo/pkg/auditlog"
 	"go.probo.inc/probo/pkg/coredata"
 	"go.probo.inc/probo/pkg/gid"
 	"go.probo.inc/probo/pkg/mail"
@@ -156,6 +157,10 @@ func (s VendorContactService) Create(
 				return fmt.Errorf("cannot insert vendor contact: %w", err)
 			}
 
+			if err := auditlog.Record(ctx, conn, s.svc.scope, vendorContact.OrganizationID, ActionVendorContactCreate, vendorContact.ID, nil, vendorContactAuditState(vendorContact)); err != nil {
+				return fmt.Errorf("cannot record audit log entry: %w", err)
+			}
+
 			return nil
 		},
 	)
@@ -185,6 +190,8 @@ func (s VendorContactService) Update(
 				return fmt.Errorf("cannot load vendor contact: %w", err)
 			}
 
+			before := vendorContactAuditState(vendorContact)
+
 			if req.FullName != nil {
 				vendorContact.FullName = *req.FullName
 			}
@@ -199,7 +206,15 @@ func (s VendorContactService) Update(
 			}
 			vendorContact.UpdatedAt = time.Now()
 
-			return vendorContact.Update(ctx, conn, s.svc.scope)
+			if err := vendorContact.Update(ctx, conn, s.svc.scope); err != nil {
+				return fmt.Errorf("cannot update vendor contact: %w", err)
+			}
+
+			if err := auditlog.Record(ctx, conn, s.svc.scope, vendorContact.OrganizationID, ActionVendorContactUpdate, vendorContact.ID, before, vendorContactAuditState(vendorContact)); err != nil {
+				return fmt.Errorf("cannot record audit log entry: %w", err)
+			}
+
+			return nil
 		},
 	)
 
@@ -214,7 +229,7 @@ func (s VendorContactService) Delete(
 	ctx context.Context,
 	vendorContactID gid.GID,
 ) error {
-	vendorContact := coredata.VendorContact{ID: vendorContactID}
+	vendorContact := &coredata.VendorContact{}
 	return s.svc.pg.WithTx(
 		ctx,
 		func(conn pg.Conn) error {
@@ -222,6 +237,10 @@ func (s VendorContactService) Delete(
 				return fmt.Errorf("cannot load vendor contact: %w", err)
 			}
 
+			if err := auditlog.Record(ctx, conn, s.svc.scope, vendorContact.OrganizationID, ActionVendorContactDelete, vendorContact.ID, vendorContactAuditState(vendorContact), nil); err != nil {
+				return fmt.Errorf("cannot record audit log entry: %w", err)
+			}
+
 			if err := vendorContact.Delete(ctx, conn, s.svc.scope); err != nil {
 				return fmt.Errorf("cannot delete vendor contact: %w", err)
 			}
@@ -230,3 +249,13 @@ func (s VendorContactService) Delete(
 		},
 	)
 }
+
+func vendorContactAuditState(c *coredata.VendorContact) map[string]any {
+	return map[string]any{
+		"vendorId": c.VendorID,
+		"fullName": c.FullName,
+		"email":    c.Email,
+		"phone":    c.Phone,
+		"role":     c.Role,
+	}
+}
@@ -27,6 +27,7 @@ import (
 	"github.com/aws/aws-sdk-go-v2/service/s3"
 	"go.gearno.de/crypto/uuid"
 	"go.gearno.de/kit/pg"
+	"go.probo.inc/probo/pkg/auditlog"
 	"go.probo.inc/probo/pkg/coredata"
 	"go.probo.inc/probo/pkg/gid"
 	"go.probo.inc/probo/pkg/validator"
@@ -180,6 +181,10 @@ func (s VendorDataPrivacyAgreementService) Upload(
 				return fmt.Errorf("cannot insert vendor data privacy agreement: %w", err)
 			}
 
+			if err := auditlog.Record(ctx, conn, s.svc.scope, vendorDataPrivacyAgreement.OrganizationID, ActionVendorDataPrivacyAgreementUpload, vendorDataPrivacyAgreement.ID, nil, vendorDataPrivacyAgreementAuditState(vendorDataPrivacyAgreement)); err != nil {
+				return fmt.Errorf("cannot record audit log entry: %w", err)
+			}
+
 			return nil
 		},
 	)
@@ -289,6 +294,8 @@ func (s VendorDataPrivacyAgreementService) Update(
 				return fmt.Errorf("cannot load existing vendor data privacy agreement: %w", err)
 			}
 
+			before := vendorDataPrivacyAgreementAuditState(existingAgreement)
+
 			now := time.Now()
 			if req.ValidFrom != nil {
 				existingAgreement.ValidFrom = *req.ValidFrom
@@ -303,6 +310,10 @@ func (s VendorDataPrivacyAgreementService) Update(
 				return fmt.Errorf("cannot update vendor data privacy agreement: %w", err)
 			}
 
+			if err := auditlog.Record(ctx, conn, s.svc.scope, existingAgreement.OrganizationID, ActionVendorDataPrivacyAgreementUpdate, existingAgreement.ID, before, vendorDataPrivacyAgreementAuditState(existingAgreement)); err != nil {
+				return fmt.Errorf("cannot record audit log entry: %w", err)
+			}
+
 			if err := file.LoadByID(ctx, conn, s.svc.scope, existingAgreement.FileID); err != nil {
 				return fmt.Errorf("cannot load file: %w", err)
 			}
@@ -330,6 +341,10 @@ func (s VendorDataPrivacyAgreementService) Delete(
 				return fmt.Errorf("cannot load vendor data privacy agreement: %w", err)
 			}
 
+			if err := auditlog.Record(ctx, conn, s.svc.scope, vendorDataPrivacyAgreement.OrganizationID, ActionVendorDataPrivacyAgreementDelete, vendorDataPrivacyAgreement.ID, vendorDataPrivacyAgreementAuditState(vendorDataPrivacyAgreement), nil); err != nil {
+				return fmt.Errorf("cannot record audit log entry: %w", err)
+			}
+
 			if err := vendorDataPrivacyAgreement.Delete(ctx, conn, s.svc.scope); err != nil {
 				return fmt.Errorf("cannot delete vendor data privacy agreement: %w", err)
 			}
@@ -351,6 +366,10 @@ func (s VendorDataPrivacyAgreementService) DeleteByVendorID(
 				return fmt.Errorf("cannot load vendor data privacy agreement: %w", err)
 			}
 
+			if err := auditlog.Record(ctx, conn, s.svc.scope, vendorDataPrivacyAgreement.OrganizationID, ActionVendorDataPrivacyAgreementDelete, vendorDataPrivacyAgreement.ID, vendorDataPrivacyAgreementAuditState(vendorDataPrivacyAgreement), nil); err != nil {
+				return fmt.Errorf("cannot record audit log entry: %w", err)
+			}
+
 			if err := vendorDataPrivacyAgreement.DeleteByVendorID(ctx, conn, s.svc.scope, vendorID); err != nil {
 				return fmt.Errorf("cannot delete vendor data privacy agreement: %w", err)
 			}
@@ -359,3 +378,12 @@ func (s VendorDataPrivacyAgreementService) DeleteByVendorID(
 		},
 	)
 }
+
+func vendorDataPrivacyAgreementAuditState(a *coredata.VendorDataPrivacyAgreement) map[string]any {
+	return map[string]any{
+		"vendorId":   a.VendorID,
+		"validFrom":  a.ValidFrom,
+		"validUntil": a.ValidUntil,
+		"fileId":     a.FileID,
+	}
+}
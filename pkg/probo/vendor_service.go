@@ -20,6 +20,7 @@ import (
 	"time"
 
 	"go.gearno.de/kit/pg"
+	"go.probo.inc/probo/pkg/auditlog"
 	"go.probo.inc/probo/pkg/coredata"
 	"go.probo.inc/probo/pkg/gid"
 	"go.probo.inc/probo/pkg/page"
@@ -286,6 +287,8 @@ func (s VendorService) Update(
 				return fmt.Errorf("cannot load vendor %q: %w", req.ID, err)
 			}
 
+			before := webhooktypes.NewVendor(vendor)
+
 			if req.Name != nil {
 				vendor.Name = *req.Name
 			}
@@ -398,6 +401,10 @@ func (s VendorService) Update(
 				return fmt.Errorf("cannot insert webhook event: %w", err)
 			}
 
+			if err := auditlog.Record(ctx, conn, s.svc.scope, vendor.OrganizationID, ActionVendorUpdate, vendor.ID, before, webhooktypes.NewVendor(vendor)); err != nil {
+				return fmt.Errorf("cannot record audit log entry: %w", err)
+			}
+
 			return nil
 		},
 	)
@@ -446,6 +453,10 @@ func (s VendorService) Delete(
 				return fmt.Errorf("cannot insert webhook event: %w", err)
 			}
 
+			if err := auditlog.Record(ctx, conn, s.svc.scope, vendor.OrganizationID, ActionVendorDelete, vendor.ID, webhooktypes.NewVendor(vendor), nil); err != nil {
+				return fmt.Errorf("cannot record audit log entry: %w", err)
+			}
+
 			return vendor.Delete(ctx, conn, s.svc.scope)
 		},
 	)
@@ -459,6 +470,28 @@ func (s VendorService) Create(
 		return nil, err
 	}
 
+	var vendor *coredata.Vendor
+
+	err := s.svc.pg.WithTx(
+		ctx,
+		func(conn pg.Conn) (err error) {
+			vendor, err = s.createInTx(ctx, conn, req)
+			return err
+		},
+	)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return vendor, nil
+}
+
+func (s VendorService) createInTx(
+	ctx context.Context,
+	conn pg.Conn,
+	req CreateVendorRequest,
+) (*coredata.Vendor, error) {
 	now := time.Now()
 	vendor := &coredata.Vendor{
 		ID:                            gid.New(s.svc.scope.GetTenantID(), coredata.VendorEntityType),
@@ -483,52 +516,45 @@ func (s VendorService) Create(
 		ShowOnTrustCenter:             false,
 	}
 
-	err := s.svc.pg.WithTx(
-		ctx,
-		func(conn pg.Conn) error {
-			organization := &coredata.Organization{}
-			if err := organization.LoadByID(ctx, conn, s.svc.scope, req.OrganizationID); err != nil {
-				return fmt.Errorf("cannot load organization %q: %w", req.OrganizationID, err)
-			}
-
-			vendor.OrganizationID = organization.ID
+	organization := &coredata.Organization{}
+	if err := organization.LoadByID(ctx, conn, s.svc.scope, req.OrganizationID); err != nil {
+		return nil, fmt.Errorf("cannot load organization %q: %w", req.OrganizationID, err)
+	}
 
-			if req.BusinessOwnerID != nil {
-				businessOwner := &coredata.MembershipProfile{}
-				if err := businessOwner.LoadByID(ctx, conn, s.svc.scope, *req.BusinessOwnerID); err != nil {
-					return fmt.Errorf("cannot load business owner profile: %w", err)
-				}
-				vendor.BusinessOwnerID = &businessOwner.ID
-			}
+	vendor.OrganizationID = organization.ID
 
-			if req.SecurityOwnerID != nil {
-				securityOwner := &coredata.MembershipProfile{}
-				if err := securityOwner.LoadByID(ctx, conn, s.svc.scope, *req.SecurityOwnerID); err != nil {
-					return fmt.Errorf("cannot load security owner profile: %w", err)
-				}
-				vendor.SecurityOwnerID = &securityOwner.ID
-			}
+	if req.BusinessOwnerID != nil {
+		businessOwner := &coredata.MembershipProfile{}
+		if err := businessOwner.LoadByID(ctx, conn, s.svc.scope, *req.BusinessOwnerID); err != nil {
+			return nil, fmt.Errorf("cannot load business owner profile: %w", err)
+		}
+		vendor.BusinessOwnerID = &businessOwner.ID
+	}
 
-			if req.Category != nil {
-				vendor.Category = *req.Category
-			} else {
-				vendor.Category = coredata.VendorCategoryOther
-			}
+	if req.SecurityOwnerID != nil {
+		securityOwner := &coredata.MembershipProfile{}
+		if err := securityOwner.LoadByID(ctx, conn, s.svc.scope, *req.SecurityOwnerID); err != nil {
+			return nil, fmt.Errorf("cannot load security owner profile: %w", err)
+		}
+		vendor.SecurityOwnerID = &securityOwner.ID
+	}
 
-			if err := vendor.Insert(ctx, conn, s.svc.scope); err != nil {
-				return fmt.Errorf("cannot insert vendor: %w", err)
-			}
+	if req.Category != nil {
+		vendor.Category = *req.Category
+	} else {
+		vendor.Category = coredata.VendorCategoryOther
+	}
 
-			if err := webhook.InsertData(ctx, conn, s.svc.scope, organization.ID, coredata.WebhookEventTypeVendorCreated, webhooktypes.NewVendor(vendor)); err != nil {
-				return fmt.Errorf("cannot insert webhook event: %w", err)
-			}
+	if err := vendor.Insert(ctx, conn, s.svc.scope); err != nil {
+		return nil, fmt.Errorf("cannot insert vendor: %w", err)
+	}
 
-			return nil
-		},
-	)
+	if err := webhook.InsertData(ctx, conn, s.svc.scope, organization.ID, coredata.WebhookEventTypeVendorCreated, webhooktypes.NewVendor(vendor)); err != nil {
+		return nil, fmt.Errorf("cannot insert webhook event: %w", err)
+	}
 
-	if err != nil {
-		return nil, err
+	if err := auditlog.Record(ctx, conn, s.svc.scope, organization.ID, ActionVendorCreate, vendor.ID, nil, webhooktypes.NewVendor(vendor)); err != nil {
+		return nil, fmt.Errorf("cannot record audit log entry: %w", err)
 	}
 
 	return vendor, nil
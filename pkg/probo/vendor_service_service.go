@@ -20,6 +20,7 @@ import (
 	"time"
 
 	"go.gearno.de/kit/pg"
+	"go.probo.inc/probo/pkg/auditlog"
 	"go.probo.inc/probo/pkg/coredata"
 	"go.probo.inc/probo/pkg/gid"
 	"go.probo.inc/probo/pkg/page"
@@ -147,6 +148,10 @@ func (s VendorServiceService) Create(
 				return fmt.Errorf("cannot insert vendor service: %w", err)
 			}
 
+			if err := auditlog.Record(ctx, conn, s.svc.scope, vendorService.OrganizationID, ActionVendorServiceCreate, vendorService.ID, nil, vendorServiceAuditState(vendorService)); err != nil {
+				return fmt.Errorf("cannot record audit log entry: %w", err)
+			}
+
 			return nil
 		},
 	)
@@ -176,6 +181,8 @@ func (s VendorServiceService) Update(
 				return fmt.Errorf("cannot load vendor service: %w", err)
 			}
 
+			before := vendorServiceAuditState(vendorService)
+
 			if req.Name != nil {
 				vendorService.Name = *req.Name
 			}
@@ -188,6 +195,10 @@ func (s VendorServiceService) Update(
 				return fmt.Errorf("cannot update vendor service: %w", err)
 			}
 
+			if err := auditlog.Record(ctx, conn, s.svc.scope, vendorService.OrganizationID, ActionVendorServiceUpdate, vendorService.ID, before, vendorServiceAuditState(vendorService)); err != nil {
+				return fmt.Errorf("cannot record audit log entry: %w", err)
+			}
+
 			return nil
 		},
 	)
@@ -203,7 +214,7 @@ func (s VendorServiceService) Delete(
 	ctx context.Context,
 	vendorServiceID gid.GID,
 ) error {
-	vendorService := coredata.VendorService{ID: vendorServiceID}
+	vendorService := &coredata.VendorService{}
 	return s.svc.pg.WithTx(
 		ctx,
 		func(conn pg.Conn) error {
@@ -211,6 +222,10 @@ func (s VendorServiceService) Delete(
 				return fmt.Errorf("cannot load vendor service: %w", err)
 			}
 
+			if err := auditlog.Record(ctx, conn, s.svc.scope, vendorService.OrganizationID, ActionVendorServiceDelete, vendorService.ID, vendorServiceAuditState(vendorService), nil); err != nil {
+				return fmt.Errorf("cannot record audit log entry: %w", err)
+			}
+
 			if err := vendorService.Delete(ctx, conn, s.svc.scope); err != nil {
 				return fmt.Errorf("cannot delete vendor service: %w", err)
 			}
@@ -219,3 +234,11 @@ func (s VendorServiceService) Delete(
 		},
 	)
 }
+
+func vendorServiceAuditState(vs *coredata.VendorService) map[string]any {
+	return map[string]any{
+		"vendorId":    vs.VendorID,
+		"name":        vs.Name,
+		"description": vs.Description,
+	}
+}
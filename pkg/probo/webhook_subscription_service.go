@@ -20,17 +20,26 @@ import (
 	"time"
 
 	"go.gearno.de/kit/pg"
+	"go.probo.inc/probo/pkg/auditlog"
 	"go.probo.inc/probo/pkg/coredata"
 	"go.probo.inc/probo/pkg/gid"
 	"go.probo.inc/probo/pkg/page"
 	"go.probo.inc/probo/pkg/validator"
+	"go.probo.inc/probo/pkg/webhook"
 )
 
 type WebhookSubscriptionService struct {
-	svc *TenantService
+	svc           *TenantService
+	webhookSender *webhook.Sender
 }
 
 type (
+	ErrWebhookEventNotDeadLettered struct {
+		status coredata.WebhookEventStatus
+	}
+
+	ErrWebhookEventStillPending struct{}
+
 	CreateWebhookSubscriptionRequest struct {
 		OrganizationID gid.GID
 		EndpointURL    string
@@ -42,8 +51,23 @@ type (
 		EndpointURL           *string
 		SelectedEvents        []coredata.WebhookEventType
 	}
+
+	ReplayWebhookEventsRequest struct {
+		WebhookSubscriptionID gid.GID
+		From                  time.Time
+		To                    time.Time
+	}
 )
 
+func (e ErrWebhookEventNotDeadLettered) Error() string {
+	return fmt.Sprintf("cannot redrive webhook event: event is in status %v, expected %v",
+		e.status, coredata.WebhookEventStatusDeadLetter)
+}
+
+func (e ErrWebhookEventStillPending) Error() string {
+	return "cannot replay webhook event: event has not been delivered yet"
+}
+
 func (r *CreateWebhookSubscriptionRequest) Validate() error {
 	v := validator.New()
 
@@ -62,6 +86,16 @@ func (r *UpdateWebhookSubscriptionRequest) Validate() error {
 	return v.Error()
 }
 
+func (r *ReplayWebhookEventsRequest) Validate() error {
+	v := validator.New()
+
+	v.Check(r.WebhookSubscriptionID, "webhook_subscription_id", validator.Required(), validator.GID(coredata.WebhookSubscriptionEntityType))
+	v.Check(r.From, "from", validator.Required())
+	v.Check(r.To, "to", validator.Required(), validator.After(r.From))
+
+	return v.Error()
+}
+
 func (s WebhookSubscriptionService) ListForOrganizationID(
 	ctx context.Context,
 	organizationID gid.GID,
@@ -185,6 +219,10 @@ func (s WebhookSubscriptionService) Create(
 				return fmt.Errorf("cannot insert webhook subscription: %w", err)
 			}
 
+			if err := auditlog.Record(ctx, conn, s.svc.scope, wc.OrganizationID, ActionWebhookSubscriptionCreate, wc.ID, nil, webhookSubscriptionAuditState(wc)); err != nil {
+				return fmt.Errorf("cannot record audit log entry: %w", err)
+			}
+
 			return nil
 		},
 	)
@@ -213,6 +251,8 @@ func (s WebhookSubscriptionService) Update(
 				return fmt.Errorf("cannot load webhook subscription: %w", err)
 			}
 
+			before := webhookSubscriptionAuditState(wc)
+
 			if req.EndpointURL != nil {
 				wc.EndpointURL = *req.EndpointURL
 			}
@@ -226,6 +266,10 @@ func (s WebhookSubscriptionService) Update(
 				return fmt.Errorf("cannot update webhook subscription: %w", err)
 			}
 
+			if err := auditlog.Record(ctx, conn, s.svc.scope, wc.OrganizationID, ActionWebhookSubscriptionUpdate, wc.ID, before, webhookSubscriptionAuditState(wc)); err != nil {
+				return fmt.Errorf("cannot record audit log entry: %w", err)
+			}
+
 			return nil
 		},
 	)
@@ -313,6 +357,216 @@ func (s WebhookSubscriptionService) CountEventsForSubscriptionID(
 	return count, nil
 }
 
+// RedriveEvent schedules a dead-lettered event for immediate delivery
+// with a fresh attempt budget.
+func (s WebhookSubscriptionService) RedriveEvent(
+	ctx context.Context,
+	webhookEventID gid.GID,
+) (*coredata.WebhookEvent, error) {
+	event := &coredata.WebhookEvent{}
+
+	err := s.svc.pg.WithTx(
+		ctx,
+		func(conn pg.Conn) error {
+			if err := event.LoadByID(ctx, conn, s.svc.scope, webhookEventID); err != nil {
+				return fmt.Errorf("cannot load webhook event: %w", err)
+			}
+
+			if event.Status != coredata.WebhookEventStatusDeadLetter {
+				return &ErrWebhookEventNotDeadLettered{status: event.Status}
+			}
+
+			wc := &coredata.WebhookSubscription{}
+			if err := wc.LoadByID(ctx, conn, s.svc.scope, event.WebhookSubscriptionID); err != nil {
+				return fmt.Errorf("cannot load webhook subscription: %w", err)
+			}
+
+			before := webhookEventAuditState(event)
+
+			now := time.Now()
+			event.Status = coredata.WebhookEventStatusPending
+			event.Attempts = 0
+			event.NextAttemptAt = &now
+
+			if err := event.Update(ctx, conn, s.svc.scope); err != nil {
+				return fmt.Errorf("cannot update webhook event: %w", err)
+			}
+
+			if err := auditlog.Record(ctx, conn, s.svc.scope, wc.OrganizationID, ActionWebhookEventRedrive, event.ID, before, webhookEventAuditState(event)); err != nil {
+				return fmt.Errorf("cannot record audit log entry: %w", err)
+			}
+
+			return nil
+		},
+	)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return event, nil
+}
+
+// ReplayEvent enqueues a new delivery of the payload of an already
+// attempted event. The original event is left untouched so its delivery
+// history is kept.
+func (s WebhookSubscriptionService) ReplayEvent(
+	ctx context.Context,
+	webhookEventID gid.GID,
+) (*coredata.WebhookEvent, error) {
+	var replayedEvent *coredata.WebhookEvent
+
+	err := s.svc.pg.WithTx(
+		ctx,
+		func(conn pg.Conn) error {
+			event := &coredata.WebhookEvent{}
+			if err := event.LoadByID(ctx, conn, s.svc.scope, webhookEventID); err != nil {
+				return fmt.Errorf("cannot load webhook event: %w", err)
+			}
+
+			if event.Status == coredata.WebhookEventStatusPending {
+				return &ErrWebhookEventStillPending{}
+			}
+
+			wc := &coredata.WebhookSubscription{}
+			if err := wc.LoadByID(ctx, conn, s.svc.scope, event.WebhookSubscriptionID); err != nil {
+				return fmt.Errorf("cannot load webhook subscription: %w", err)
+			}
+
+			replayedEvent = newReplayedWebhookEvent(event, time.Now())
+			if err := replayedEvent.Insert(ctx, conn, s.svc.scope); err != nil {
+				return fmt.Errorf("cannot insert webhook event: %w", err)
+			}
+
+			if err := auditlog.Record(ctx, conn, s.svc.scope, wc.OrganizationID, ActionWebhookEventReplay, replayedEvent.ID, nil, webhookEventAuditState(replayedEvent)); err != nil {
+				return fmt.Errorf("cannot record audit log entry: %w", err)
+			}
+
+			return nil
+		},
+	)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return replayedEvent, nil
+}
+
+// ReplayEvents enqueues a new delivery for every failed or dead-lettered
+// event of the subscription created within the requested time window.
+func (s WebhookSubscriptionService) ReplayEvents(
+	ctx context.Context,
+	req ReplayWebhookEventsRequest,
+) (coredata.WebhookEvents, error) {
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
+
+	var replayedEvents coredata.WebhookEvents
+
+	err := s.svc.pg.WithTx(
+		ctx,
+		func(conn pg.Conn) error {
+			wc := &coredata.WebhookSubscription{}
+			if err := wc.LoadByID(ctx, conn, s.svc.scope, req.WebhookSubscriptionID); err != nil {
+				return fmt.Errorf("cannot load webhook subscription: %w", err)
+			}
+
+			var events coredata.WebhookEvents
+			if err := events.LoadFailedBySubscriptionIDForUpdate(ctx, conn, s.svc.scope, wc.ID, req.From, req.To); err != nil {
+				return fmt.Errorf("cannot load failed webhook events: %w", err)
+			}
+
+			now := time.Now()
+			for _, event := range events {
+				// The replay supersedes the retries still scheduled for a
+				// failed event, so the endpoint does not receive it twice.
+				if event.Status == coredata.WebhookEventStatusFailed {
+					event.Status = coredata.WebhookEventStatusDeadLetter
+					event.NextAttemptAt = nil
+
+					if err := event.Update(ctx, conn, s.svc.scope); err != nil {
+						return fmt.Errorf("cannot update webhook event: %w", err)
+					}
+				}
+
+				replayedEvent := newReplayedWebhookEvent(event, now)
+				if err := replayedEvent.Insert(ctx, conn, s.svc.scope); err != nil {
+					return fmt.Errorf("cannot insert webhook event: %w", err)
+				}
+
+				replayedEvents = append(replayedEvents, replayedEvent)
+			}
+
+			after := map[string]any{
+				"from":           req.From,
+				"to":             req.To,
+				"replayedEvents": len(replayedEvents),
+			}
+			if err := auditlog.Record(ctx, conn, s.svc.scope, wc.OrganizationID, ActionWebhookSubscriptionReplay, wc.ID, nil, after); err != nil {
+				return fmt.Errorf("cannot record audit log entry: %w", err)
+			}
+
+			return nil
+		},
+	)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return replayedEvents, nil
+}
+
+// SendTestEvent delivers a synthetic signed event to the subscription
+// endpoint and returns the captured response.
+func (s WebhookSubscriptionService) SendTestEvent(
+	ctx context.Context,
+	webhookSubscriptionID gid.GID,
+) (*webhook.TestDelivery, error) {
+	wc, err := s.Get(ctx, webhookSubscriptionID)
+	if err != nil {
+		return nil, err
+	}
+
+	delivery, err := s.webhookSender.SendTestEvent(ctx, wc)
+	if err != nil {
+		return nil, fmt.Errorf("cannot send test event: %w", err)
+	}
+
+	return delivery, nil
+}
+
+// webhookSubscriptionAuditState returns the audited state of a webhook
+// subscription, leaving the signing secret out.
+func webhookSubscriptionAuditState(wc *coredata.WebhookSubscription) map[string]any {
+	return map[string]any{
+		"endpointUrl":    wc.EndpointURL,
+		"selectedEvents": wc.SelectedEvents,
+	}
+}
+
+func webhookEventAuditState(event *coredata.WebhookEvent) map[string]any {
+	return map[string]any{
+		"webhookSubscriptionId": event.WebhookSubscriptionID,
+		"status":                event.Status,
+		"attempts":              event.Attempts,
+	}
+}
+
+func newReplayedWebhookEvent(event *coredata.WebhookEvent, now time.Time) *coredata.WebhookEvent {
+	return &coredata.WebhookEvent{
+		ID:                    gid.New(event.ID.TenantID(), coredata.WebhookEventEntityType),
+		WebhookDataID:         event.WebhookDataID,
+		WebhookSubscriptionID: event.WebhookSubscriptionID,
+		Status:                coredata.WebhookEventStatusPending,
+		Attempts:              0,
+		NextAttemptAt:         &now,
+		CreatedAt:             now,
+	}
+}
+
 func (s WebhookSubscriptionService) Delete(
 	ctx context.Context,
 	webhookSubscriptionID gid.GID,
@@ -326,6 +580,10 @@ func (s WebhookSubscriptionService) Delete(
 				return fmt.Errorf("cannot load webhook subscription: %w", err)
 			}
 
+			if err := auditlog.Record(ctx, conn, s.svc.scope, wc.OrganizationID, ActionWebhookSubscriptionDelete, wc.ID, webhookSubscriptionAuditState(wc), nil); err != nil {
+				return fmt.Errorf("cannot record audit log entry: %w", err)
+			}
+
 			if err := wc.Delete(ctx, conn, s.svc.scope); err != nil {
 				return fmt.Errorf("cannot delete webhook subscription: %w", err)
 			}
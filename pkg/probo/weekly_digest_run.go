@@ -0,0 +1,202 @@
+// Copyright (c) 2025 Probo Inc <hello@getprobo.com>.
+//
+// Permission to use, copy, modify, and/or distribute this software for any
+// purpose with or without fee is hereby granted, provided that the above
+// copyright notice and this permission notice appear in all copies.
+//
+// THE SOFTWARE IS PROVIDED "AS IS" AND THE AUTHOR DISCLAIMS ALL WARRANTIES WITH
+// REGARD TO THIS SOFTWARE INCLUDING ALL IMPLIED WARRANTIES OF MERCHANTABILITY
+// AND FITNESS. IN NO EVENT SHALL THE AUTHOR BE LIABLE FOR ANY SPECIAL, DIRECT,
+// INDIRECT, OR CONSEQUENTIAL DAMAGES OR ANY DAMAGES WHATSOEVER RESULTING FROM
+// LOSS OF USE, DATA OR PROFITS, WHETHER IN AN ACTION OF CONTRACT, NEGLIGENCE OR
+// OTHER TORTIOUS ACTION, ARISING OUT OF OR IN CONNECTION WITH THE USE OR
+// PERFORMANCE OF THIS SOFTWARE.
+
+package probo
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"go.gearno.de/kit/pg"
+	"go.probo.inc/probo/packages/emails"
+	"go.probo.inc/probo/pkg/baseurl"
+	"go.probo.inc/probo/pkg/coredata"
+)
+
+// SendWeeklyDigest locks a member opted in to the weekly digest who has not
+// received it this week and queues their digest email. Members with
+// nothing to report are skipped until next week. The member is marked as
+// done for the week before the digest is built, so a member whose digest
+// cannot be sent is retried next week instead of blocking the others. It
+// returns coredata.ErrNoWeeklyDigestDue when no digest is due.
+func (s *Service) SendWeeklyDigest(ctx context.Context) error {
+	profile, err := s.lockWeeklyDigestForRun(ctx)
+	if err != nil {
+		return fmt.Errorf("cannot lock weekly digest: %w", err)
+	}
+
+	if err := s.sendWeeklyDigest(ctx, profile); err != nil {
+		return fmt.Errorf("cannot send weekly digest of %q: %w", profile.ID, err)
+	}
+
+	return nil
+}
+
+func (s *Service) lockWeeklyDigestForRun(ctx context.Context) (*coredata.MembershipProfile, error) {
+	profile := &coredata.MembershipProfile{}
+
+	err := s.pg.WithTx(
+		ctx,
+		func(tx pg.Conn) error {
+			now := time.Now()
+
+			if err := profile.LoadNextWeeklyDigestDueForUpdateSkipLocked(ctx, tx, startOfWeek(now)); err != nil {
+				return err
+			}
+
+			profile.WeeklyDigestLastSentAt = &now
+
+			return profile.Update(ctx, tx, coredata.NewScopeFromObjectID(profile.ID))
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return profile, nil
+}
+
+func (s *Service) sendWeeklyDigest(ctx context.Context, profile *coredata.MembershipProfile) error {
+	return s.pg.WithTx(
+		ctx,
+		func(tx pg.Conn) error {
+			now := time.Now()
+			scope := coredata.NewScopeFromObjectID(profile.ID)
+
+			digest, err := loadWeeklyDigest(ctx, tx, scope, profile, now)
+			if err != nil {
+				return fmt.Errorf("cannot load weekly digest: %w", err)
+			}
+
+			if weeklyDigestEmpty(digest) {
+				return nil
+			}
+
+			organization := &coredata.Organization{}
+			if err := organization.LoadByID(ctx, tx, scope, profile.OrganizationID); err != nil {
+				return fmt.Errorf("cannot load organization: %w", err)
+			}
+
+			organizationURL := baseurl.MustParse(s.baseURL).
+				AppendPath(fmt.Sprintf("/organizations/%s", organization.ID)).
+				MustString()
+
+			emailPresenter := emails.NewPresenter(s.fileManager, s.bucket, s.baseURL, profile.FullName)
+
+			subject, textBody, htmlBody, err := emailPresenter.RenderWeeklyDigest(ctx, organizationURL, organization.Name, digest)
+			if err != nil {
+				return fmt.Errorf("cannot render weekly digest email: %w", err)
+			}
+
+			email := coredata.NewEmail(
+				profile.FullName,
+				profile.EmailAddress,
+				subject,
+				textBody,
+				htmlBody,
+			)
+			email.OrganizationID = &organization.ID
+
+			if err := email.Insert(ctx, tx); err != nil {
+				return fmt.Errorf("cannot insert email: %w", err)
+			}
+
+			return nil
+		},
+	)
+}
+
+func loadWeeklyDigest(
+	ctx context.Context,
+	conn pg.Conn,
+	scope coredata.Scoper,
+	profile *coredata.MembershipProfile,
+	now time.Time,
+) (emails.WeeklyDigest, error) {
+	var (
+		digest                 emails.WeeklyDigest
+		openTasks              coredata.WeeklyDigestItems
+		overdueNonconformities coredata.WeeklyDigestItems
+		pendingSignatures      coredata.WeeklyDigestItems
+		upcomingAudits         coredata.WeeklyDigestItems
+		risingRisks            coredata.WeeklyDigestItems
+	)
+
+	if err := openTasks.LoadOpenTasksByProfileID(ctx, conn, scope, profile.ID); err != nil {
+		return digest, fmt.Errorf("cannot load open tasks: %w", err)
+	}
+
+	if err := overdueNonconformities.LoadOverdueNonconformitiesByProfileID(ctx, conn, scope, profile.ID, truncateToDate(now)); err != nil {
+		return digest, fmt.Errorf("cannot load overdue nonconformities: %w", err)
+	}
+
+	if err := pendingSignatures.LoadPendingSignaturesByProfileID(ctx, conn, scope, profile.ID); err != nil {
+		return digest, fmt.Errorf("cannot load pending signatures: %w", err)
+	}
+
+	if err := upcomingAudits.LoadUpcomingAuditsByOrganizationID(ctx, conn, scope, profile.OrganizationID); err != nil {
+		return digest, fmt.Errorf("cannot load upcoming audits: %w", err)
+	}
+
+	if err := risingRisks.LoadRisingRisksByProfileID(ctx, conn, scope, profile.ID, now.AddDate(0, 0, -7)); err != nil {
+		return digest, fmt.Errorf("cannot load rising risks: %w", err)
+	}
+
+	digest.OpenTasks = weeklyDigestItems(openTasks, "due")
+	digest.OverdueNonconformities = weeklyDigestItems(overdueNonconformities, "due")
+	digest.PendingSignatures = weeklyDigestItems(pendingSignatures, "requested")
+	digest.UpcomingAudits = weeklyDigestItems(upcomingAudits, "starts")
+	digest.RisingRisks = weeklyDigestItems(risingRisks, "")
+
+	return digest, nil
+}
+
+// weeklyDigestItems formats the items of a digest section, describing
+// their date with the given verb.
+func weeklyDigestItems(items coredata.WeeklyDigestItems, dateVerb string) []emails.WeeklyDigestItem {
+	result := make([]emails.WeeklyDigestItem, 0, len(items))
+
+	for _, item := range items {
+		var detail string
+		switch {
+		case item.Detail != nil && item.Date != nil:
+			detail = fmt.Sprintf("%s, %s %s", *item.Detail, dateVerb, item.Date.Format(time.DateOnly))
+		case item.Detail != nil:
+			detail = *item.Detail
+		case item.Date != nil:
+			detail = fmt.Sprintf("%s %s", dateVerb, item.Date.Format(time.DateOnly))
+		}
+
+		result = append(result, emails.WeeklyDigestItem{Title: item.Title, Detail: detail})
+	}
+
+	return result
+}
+
+func weeklyDigestEmpty(digest emails.WeeklyDigest) bool {
+	return len(digest.OpenTasks) == 0 &&
+		len(digest.OverdueNonconformities) == 0 &&
+		len(digest.PendingSignatures) == 0 &&
+		len(digest.UpcomingAudits) == 0 &&
+		len(digest.RisingRisks) == 0
+}
+
+// startOfWeek returns the Monday at midnight UTC of the week of t.
+func startOfWeek(t time.Time) time.Time {
+	day := truncateToDate(t)
+	offset := (int(day.Weekday()) + 6) % 7
+
+	return day.AddDate(0, 0, -offset)
+}
@@ -21,6 +21,7 @@ import (
 	"strings"
 
 	"go.probo.inc/probo/pkg/connector"
+	"go.probo.inc/probo/pkg/connector/github"
 )
 
 type (
@@ -55,6 +56,19 @@ func (c *config) GetSlackSigningSecret() string {
 	return ""
 }
 
+func (c *config) GetGitHubAPIURL() string {
+	for _, conn := range c.Connectors {
+		if conn.Provider == "GITHUB" {
+			if settings, ok := conn.Settings.(map[string]any); ok {
+				if apiURL, ok := settings["api-url"].(string); ok {
+					return apiURL
+				}
+			}
+		}
+	}
+	return github.DefaultAPIURL
+}
+
 func (c *connectorConfig) UnmarshalJSON(data []byte) error {
 	var tmp struct {
 		Provider  string          `json:"provider"`
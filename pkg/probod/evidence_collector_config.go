@@ -0,0 +1,41 @@
+// Copyright (c) 2025 Probo Inc <hello@getprobo.com>.
+//
+// Permission to use, copy, modify, and/or distribute this software for any
+// purpose with or without fee is hereby granted, provided that the above
+// copyright notice and this permission notice appear in all copies.
+//
+// THE SOFTWARE IS PROVIDED "AS IS" AND THE AUTHOR DISCLAIMS ALL WARRANTIES WITH
+// REGARD TO THIS SOFTWARE INCLUDING ALL IMPLIED WARRANTIES OF MERCHANTABILITY
+// AND FITNESS. IN NO EVENT SHALL THE AUTHOR BE LIABLE FOR ANY SPECIAL, DIRECT,
+// INDIRECT, OR CONSEQUENTIAL DAMAGES OR ANY DAMAGES WHATSOEVER RESULTING FROM
+// LOSS OF USE, DATA OR PROFITS, WHETHER IN AN ACTION OF CONTRACT, NEGLIGENCE OR
+// OTHER TORTIOUS ACTION, ARISING OUT OF OR IN CONNECTION WITH THE USE OR
+// PERFORMANCE OF THIS SOFTWARE.
+
+package probod
+
+type evidenceCollectorConfig struct {
+	// Interval is the time between evidence collections for each connector (in seconds).
+	// Default: 86400 (24 hours)
+	Interval int `json:"interval"`
+
+	// PollInterval is the time between polling for connectors to collect (in seconds).
+	// Default: 60
+	PollInterval int `json:"poll-interval"`
+
+	// Timeout is the maximum time allowed for a single collection (in seconds).
+	// Default: 300 (5 minutes)
+	Timeout int `json:"timeout"`
+
+	// AWSEndpoint overrides the endpoint of the AWS APIs called by the AWS
+	// collector, e.g. to point it at a local AWS emulator.
+	// Default: "" (AWS public endpoints)
+	AWSEndpoint string `json:"aws-endpoint"`
+
+	// AWSAccessKeyID and AWSSecretAccessKey are the credentials of the
+	// Probo principal assuming the role of AWS connectors created without
+	// an access key. The ambient credentials of the host are never used.
+	// Default: "" (role-only AWS connectors cannot be collected)
+	AWSAccessKeyID     string `json:"aws-access-key-id"`
+	AWSSecretAccessKey string `json:"aws-secret-access-key"`
+}
@@ -20,6 +20,9 @@ type (
 		SenderName     string     `json:"sender-name"`
 		SenderEmail    string     `json:"sender-email"`
 		SMTP           smtpConfig `json:"smtp"`
+		MaxAttempts    int        `json:"max-attempts"`
+		RetryBaseDelay int        `json:"retry-base-delay"`
+		RetryMaxDelay  int        `json:"retry-max-delay"`
 	}
 
 	smtpConfig struct {
@@ -23,4 +23,7 @@ type notificationsConfig struct {
 type webhookConfig struct {
 	SenderInterval int `json:"sender-interval"`
 	CacheTTL       int `json:"cache-ttl"`
+	MaxAttempts    int `json:"max-attempts"`
+	RetryBaseDelay int `json:"retry-base-delay"`
+	RetryMaxDelay  int `json:"retry-max-delay"`
 }
@@ -47,6 +47,8 @@ import (
 	"go.probo.inc/probo/pkg/baseurl"
 	"go.probo.inc/probo/pkg/certmanager"
 	"go.probo.inc/probo/pkg/connector"
+	awscollector "go.probo.inc/probo/pkg/connector/aws"
+	"go.probo.inc/probo/pkg/connector/github"
 	"go.probo.inc/probo/pkg/coredata"
 	"go.probo.inc/probo/pkg/crypto/cipher"
 	"go.probo.inc/probo/pkg/crypto/keys"
@@ -70,19 +72,20 @@ type (
 	}
 
 	config struct {
-		BaseURL       *baseurl.BaseURL     `json:"base-url"`
-		EncryptionKey cipher.EncryptionKey `json:"encryption-key"`
-		Pg            pgConfig             `json:"pg"`
-		Api           apiConfig            `json:"api"`
-		Auth          authConfig           `json:"auth"`
-		TrustCenter   trustCenterConfig    `json:"trust-center"`
-		AWS           awsConfig            `json:"aws"`
-		Notifications notificationsConfig  `json:"notifications"`
-		Connectors    []connectorConfig    `json:"connectors"`
-		OpenAI        openaiConfig         `json:"openai"`
-		ChromeDPAddr  string               `json:"chrome-dp-addr"`
-		CustomDomains customDomainsConfig  `json:"custom-domains"`
-		SCIMBridge    scimBridgeConfig     `json:"scim-bridge"`
+		BaseURL           *baseurl.BaseURL        `json:"base-url"`
+		EncryptionKey     cipher.EncryptionKey    `json:"encryption-key"`
+		Pg                pgConfig                `json:"pg"`
+		Api               apiConfig               `json:"api"`
+		Auth              authConfig              `json:"auth"`
+		TrustCenter       trustCenterConfig       `json:"trust-center"`
+		AWS               awsConfig               `json:"aws"`
+		Notifications     notificationsConfig     `json:"notifications"`
+		Connectors        []connectorConfig       `json:"connectors"`
+		OpenAI            openaiConfig            `json:"openai"`
+		ChromeDPAddr      string                  `json:"chrome-dp-addr"`
+		CustomDomains     customDomainsConfig     `json:"custom-domains"`
+		SCIMBridge        scimBridgeConfig        `json:"scim-bridge"`
+		EvidenceCollector evidenceCollectorConfig `json:"evidence-collector"`
 	}
 
 	trustCenterConfig struct {
@@ -151,6 +154,9 @@ func New() *Implm {
 					SMTP: smtpConfig{
 						Addr: "localhost:1025",
 					},
+					MaxAttempts:    6,
+					RetryBaseDelay: 60,
+					RetryMaxDelay:  7200,
 				},
 				Slack: slackConfig{
 					SenderInterval: 60,
@@ -158,6 +164,9 @@ func New() *Implm {
 				Webhook: webhookConfig{
 					SenderInterval: 5,
 					CacheTTL:       86400,
+					MaxAttempts:    8,
+					RetryBaseDelay: 30,
+					RetryMaxDelay:  21600,
 				},
 			},
 			CustomDomains: customDomainsConfig{
@@ -174,6 +183,11 @@ func New() *Implm {
 				SyncInterval: 60, // 15 minutes
 				PollInterval: 30, // 30 seconds
 			},
+			EvidenceCollector: evidenceCollectorConfig{
+				Interval:     86400, // 24 hours
+				PollInterval: 60,    // 1 minute
+				Timeout:      300,   // 5 minutes
+			},
 		},
 	}
 }
@@ -261,6 +275,25 @@ func (impl *Implm) Run(
 			return fmt.Errorf("cannot register connector: %w", err)
 		}
 	}
+	if err := defaultConnectorRegistry.RegisterCollector(
+		coredata.ConnectorProviderGitHub.String(),
+		github.NewCollector(impl.cfg.GetGitHubAPIURL()),
+	); err != nil {
+		return fmt.Errorf("cannot register evidence collector: %w", err)
+	}
+	if err := defaultConnectorRegistry.RegisterCollector(
+		coredata.ConnectorProviderAWS.String(),
+		awscollector.NewCollector(
+			l.Named("aws-collector"),
+			awscollector.CollectorOptions{
+				Endpoint:        impl.cfg.EvidenceCollector.AWSEndpoint,
+				AccessKeyID:     impl.cfg.EvidenceCollector.AWSAccessKeyID,
+				SecretAccessKey: impl.cfg.EvidenceCollector.AWSSecretAccessKey,
+			},
+		),
+	); err != nil {
+		return fmt.Errorf("cannot register evidence collector: %w", err)
+	}
 
 	agentConfig := agents.Config{
 		OpenAIAPIKey: impl.cfg.OpenAI.APIKey,
@@ -375,6 +408,15 @@ func (impl *Implm) Run(
 		l.Named("slack"),
 	)
 
+	webhookSender := webhook.NewSender(pgClient, l.Named("webhook-sender"), webhook.Config{
+		Interval:       time.Duration(impl.cfg.Notifications.Webhook.SenderInterval) * time.Second,
+		CacheTTL:       time.Duration(impl.cfg.Notifications.Webhook.CacheTTL) * time.Second,
+		EncryptionKey:  impl.cfg.EncryptionKey,
+		MaxAttempts:    impl.cfg.Notifications.Webhook.MaxAttempts,
+		RetryBaseDelay: time.Duration(impl.cfg.Notifications.Webhook.RetryBaseDelay) * time.Second,
+		RetryMaxDelay:  time.Duration(impl.cfg.Notifications.Webhook.RetryMaxDelay) * time.Second,
+	})
+
 	proboService, err := probo.NewService(
 		ctx,
 		impl.cfg.EncryptionKey,
@@ -390,6 +432,8 @@ func (impl *Implm) Run(
 		l.Named("probo"),
 		slackService,
 		iamService,
+		webhookSender,
+		defaultConnectorRegistry,
 	)
 	if err != nil {
 		return fmt.Errorf("cannot create probo service: %w", err)
@@ -462,6 +506,10 @@ func (impl *Implm) Run(
 			TLSRequired: impl.cfg.Notifications.Mailer.SMTP.TLSRequired,
 			Timeout:     time.Second * 10,
 			Interval:    time.Duration(impl.cfg.Notifications.Mailer.MailerInterval) * time.Second,
+
+			MaxAttempts:    impl.cfg.Notifications.Mailer.MaxAttempts,
+			RetryBaseDelay: time.Duration(impl.cfg.Notifications.Mailer.RetryBaseDelay) * time.Second,
+			RetryMaxDelay:  time.Duration(impl.cfg.Notifications.Mailer.RetryMaxDelay) * time.Second,
 		},
 	)
 	wg.Go(
@@ -485,11 +533,6 @@ func (impl *Implm) Run(
 	)
 
 	webhookSenderCtx, stopWebhookSender := context.WithCancel(context.Background())
-	webhookSender := webhook.NewSender(pgClient, l.Named("webhook-sender"), webhook.Config{
-		Interval:      time.Duration(impl.cfg.Notifications.Webhook.SenderInterval) * time.Second,
-		CacheTTL:      time.Duration(impl.cfg.Notifications.Webhook.CacheTTL) * time.Second,
-		EncryptionKey: impl.cfg.EncryptionKey,
-	})
 	wg.Go(
 		func() {
 			if err := webhookSender.Run(webhookSenderCtx); err != nil {
@@ -507,6 +550,60 @@ func (impl *Implm) Run(
 		},
 	)
 
+	importJobImporterCtx, stopImportJobImporter := context.WithCancel(context.Background())
+	wg.Go(
+		func() {
+			if err := impl.runImportJob(importJobImporterCtx, proboService, l.Named("import-job-importer")); err != nil {
+				cancel(fmt.Errorf("import job importer crashed: %w", err))
+			}
+		},
+	)
+
+	evidenceCollectorCtx, stopEvidenceCollector := context.WithCancel(context.Background())
+	wg.Go(
+		func() {
+			if err := impl.runEvidenceCollector(evidenceCollectorCtx, proboService, l.Named("evidence-collector")); err != nil {
+				cancel(fmt.Errorf("evidence collector crashed: %w", err))
+			}
+		},
+	)
+
+	snapshotSchedulerCtx, stopSnapshotScheduler := context.WithCancel(context.Background())
+	wg.Go(
+		func() {
+			if err := impl.runSnapshotScheduler(snapshotSchedulerCtx, proboService, l.Named("snapshot-scheduler")); err != nil {
+				cancel(fmt.Errorf("snapshot scheduler crashed: %w", err))
+			}
+		},
+	)
+
+	deadlineReminderCtx, stopDeadlineReminder := context.WithCancel(context.Background())
+	wg.Go(
+		func() {
+			if err := impl.runDeadlineReminder(deadlineReminderCtx, proboService, l.Named("deadline-reminder")); err != nil {
+				cancel(fmt.Errorf("deadline reminder crashed: %w", err))
+			}
+		},
+	)
+
+	weeklyDigestCtx, stopWeeklyDigest := context.WithCancel(context.Background())
+	wg.Go(
+		func() {
+			if err := impl.runWeeklyDigest(weeklyDigestCtx, proboService, l.Named("weekly-digest")); err != nil {
+				cancel(fmt.Errorf("weekly digest crashed: %w", err))
+			}
+		},
+	)
+
+	acknowledgementCampaignCtx, stopAcknowledgementCampaign := context.WithCancel(context.Background())
+	wg.Go(
+		func() {
+			if err := impl.runAcknowledgementCampaign(acknowledgementCampaignCtx, proboService, l.Named("acknowledgement-campaign")); err != nil {
+				cancel(fmt.Errorf("acknowledgement campaign crashed: %w", err))
+			}
+		},
+	)
+
 	iamServiceCtx, stopIAMService := context.WithCancel(context.Background())
 	wg.Go(
 		func() {
@@ -532,6 +629,12 @@ func (impl *Implm) Run(
 	stopSlackSender()
 	stopWebhookSender()
 	stopExportJobExporter()
+	stopImportJobImporter()
+	stopEvidenceCollector()
+	stopSnapshotScheduler()
+	stopDeadlineReminder()
+	stopWeeklyDigest()
+	stopAcknowledgementCampaign()
 	stopIAMService()
 	stopApiServer()
 	stopTrustCenterServer()
@@ -563,6 +666,148 @@ LOOP:
 	}
 }
 
+func (impl *Implm) runImportJob(
+	ctx context.Context,
+	proboService *probo.Service,
+	l *log.Logger,
+) error {
+LOOP:
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-time.After(10 * time.Second):
+		if err := proboService.ImportJob(ctx); err != nil {
+			if !errors.Is(err, coredata.ErrNoImportJobAvailable) {
+				l.ErrorCtx(ctx, "cannot process import job", log.Error(err))
+			}
+		}
+
+		goto LOOP
+	}
+}
+
+func (impl *Implm) runEvidenceCollector(
+	ctx context.Context,
+	proboService *probo.Service,
+	l *log.Logger,
+) error {
+	interval := time.Duration(impl.cfg.EvidenceCollector.Interval) * time.Second
+	pollInterval := time.Duration(impl.cfg.EvidenceCollector.PollInterval) * time.Second
+	timeout := time.Duration(impl.cfg.EvidenceCollector.Timeout) * time.Second
+
+LOOP:
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-time.After(pollInterval):
+		collectCtx, cancel := context.WithTimeout(ctx, timeout)
+		err := proboService.CollectEvidence(collectCtx, interval)
+		cancel()
+		if err != nil {
+			if !errors.Is(err, coredata.ErrNoConnectorAvailable) {
+				l.ErrorCtx(ctx, "cannot collect evidence", log.Error(err))
+			}
+		}
+
+		goto LOOP
+	}
+}
+
+func (impl *Implm) runSnapshotScheduler(
+	ctx context.Context,
+	proboService *probo.Service,
+	l *log.Logger,
+) error {
+LOOP:
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-time.After(60 * time.Second):
+		if err := proboService.TakeScheduledSnapshot(ctx); err != nil {
+			if !errors.Is(err, coredata.ErrNoSnapshotScheduleDue) {
+				l.ErrorCtx(ctx, "cannot take scheduled snapshot", log.Error(err))
+			}
+		}
+
+		goto LOOP
+	}
+}
+
+// runDeadlineReminder sends the deadline reminders of every organization
+// due, one organization at a time, until none is due.
+func (impl *Implm) runDeadlineReminder(
+	ctx context.Context,
+	proboService *probo.Service,
+	l *log.Logger,
+) error {
+LOOP:
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-time.After(60 * time.Second):
+		for {
+			if err := proboService.SendDeadlineReminders(ctx); err != nil {
+				if !errors.Is(err, coredata.ErrNoDeadlineReminderSettingsDue) {
+					l.ErrorCtx(ctx, "cannot send deadline reminders", log.Error(err))
+				}
+				break
+			}
+		}
+
+		goto LOOP
+	}
+}
+
+// runWeeklyDigest queues the weekly digest of every member opted in, one
+// member at a time, until none is due.
+func (impl *Implm) runWeeklyDigest(
+	ctx context.Context,
+	proboService *probo.Service,
+	l *log.Logger,
+) error {
+LOOP:
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-time.After(60 * time.Second):
+		for {
+			if err := proboService.SendWeeklyDigest(ctx); err != nil {
+				if !errors.Is(err, coredata.ErrNoWeeklyDigestDue) {
+					l.ErrorCtx(ctx, "cannot send weekly digest", log.Error(err))
+				}
+				break
+			}
+		}
+
+		goto LOOP
+	}
+}
+
+// runAcknowledgementCampaign runs every acknowledgement campaign due, one
+// campaign at a time, until none is due.
+func (impl *Implm) runAcknowledgementCampaign(
+	ctx context.Context,
+	proboService *probo.Service,
+	l *log.Logger,
+) error {
+LOOP:
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-time.After(60 * time.Second):
+		for {
+			if err := proboService.RunAcknowledgementCampaigns(ctx); err != nil {
+				if !errors.Is(err, coredata.ErrNoAcknowledgementCampaignDue) {
+					l.ErrorCtx(ctx, "cannot run acknowledgement campaign", log.Error(err))
+				}
+				break
+			}
+		}
+
+		goto LOOP
+	}
+}
+
 func (impl *Implm) runApiServer(
 	ctx context.Context,
 	l *log.Logger,
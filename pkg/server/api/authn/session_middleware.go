@@ -23,6 +23,7 @@ import (
 	"github.com/99designs/gqlgen/graphql"
 	"github.com/vektah/gqlparser/v2/gqlerror"
 	"go.gearno.de/kit/httpserver"
+	"go.probo.inc/probo/pkg/auditlog"
 	"go.probo.inc/probo/pkg/gid"
 	"go.probo.inc/probo/pkg/iam"
 	"go.probo.inc/probo/pkg/securecookie"
@@ -101,9 +102,12 @@ func NewSessionMiddleware(svc *iam.Service, cookieConfig securecookie.Config) fu
 				if err != nil {
 					panic(fmt.Errorf("cannot update session info: %w", err))
 				}
+				session.UserAgent = userAgent
+				session.IPAddress = ipAddress
 
 				ctx = ContextWithSession(ctx, session)
 				ctx = ContextWithIdentity(ctx, identity)
+				ctx = auditlog.ContextWithActor(ctx, auditlog.NewUserActor(identity.ID))
 
 				next.ServeHTTP(w, r.WithContext(ctx))
 
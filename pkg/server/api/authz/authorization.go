@@ -56,6 +56,7 @@ func NewAuthorizeFunc(
 	) error {
 		identity := authn.IdentityFromContext(ctx)
 		session := authn.SessionFromContext(ctx)
+		apiKey := authn.APIKeyFromContext(ctx)
 
 		params := iam.AuthorizeParams{
 			Principal:          identity.ID,
@@ -66,6 +67,9 @@ func NewAuthorizeFunc(
 		if session != nil {
 			params.Session = &session.ID
 		}
+		if apiKey != nil {
+			params.APIKey = &apiKey.ID
+		}
 
 		for _, option := range options {
 			option(&params)
@@ -0,0 +1,194 @@
+// Copyright (c) 2025 Probo Inc <hello@getprobo.com>.
+//
+// Permission to use, copy, modify, and/or distribute this software for any
+// purpose with or without fee is hereby granted, provided that the above
+// copyright notice and this permission notice appear in all copies.
+//
+// THE SOFTWARE IS PROVIDED "AS IS" AND THE AUTHOR DISCLAIMS ALL WARRANTIES WITH
+// REGARD TO THIS SOFTWARE INCLUDING ALL IMPLIED WARRANTIES OF MERCHANTABILITY
+// AND FITNESS. IN NO EVENT SHALL THE AUTHOR BE LIABLE FOR ANY SPECIAL, DIRECT,
+// INDIRECT, OR CONSEQUENTIAL DAMAGES OR ANY DAMAGES WHATSOEVER RESULTING FROM
+// LOSS OF USE, DATA OR PROFITS, WHETHER IN AN ACTION OF CONTRACT, NEGLIGENCE OR
+// OTHER TORTIOUS ACTION, ARISING OUT OF OR IN CONNECTION WITH THE USE OR
+// PERFORMANCE OF THIS SOFTWARE.
+
+package connect_v1
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+	"go.gearno.de/kit/httpserver"
+	"go.gearno.de/kit/log"
+	"go.probo.inc/probo/pkg/baseurl"
+	"go.probo.inc/probo/pkg/gid"
+	"go.probo.inc/probo/pkg/iam"
+	"go.probo.inc/probo/pkg/iam/oidc"
+	"go.probo.inc/probo/pkg/saferedirect"
+	"go.probo.inc/probo/pkg/securecookie"
+	"go.probo.inc/probo/pkg/server/api/authn"
+)
+
+type OIDCHandler struct {
+	iam           *iam.Service
+	sessionCookie *authn.Cookie
+	baseURL       *baseurl.BaseURL
+	logger        *log.Logger
+	safeRedirect  *saferedirect.SafeRedirect
+}
+
+func NewOIDCHandler(iam *iam.Service, cookieConfig securecookie.Config, baseURL *baseurl.BaseURL, logger *log.Logger) *OIDCHandler {
+	return &OIDCHandler{
+		iam:           iam,
+		sessionCookie: authn.NewCookie(&cookieConfig),
+		baseURL:       baseURL,
+		logger:        logger,
+		safeRedirect:  &saferedirect.SafeRedirect{AllowedHost: baseURL.Host()},
+	}
+}
+
+func (h *OIDCHandler) renderInternalServerError(w http.ResponseWriter) {
+	httpserver.RenderError(w, http.StatusInternalServerError, errors.New("internal server error"))
+}
+
+func (h *OIDCHandler) LoginHandler(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	oidcConfigIDParam := chi.URLParam(r, "oidcConfigID")
+	if oidcConfigIDParam == "" {
+		httpserver.RenderError(w, http.StatusBadRequest, errors.New("missing OIDC config ID"))
+		return
+	}
+
+	oidcConfigID, err := gid.ParseGID(oidcConfigIDParam)
+	if err != nil {
+		httpserver.RenderError(w, http.StatusBadRequest, errors.New("invalid OIDC config ID"))
+		return
+	}
+
+	continueURLQueryParam := r.URL.Query().Get("continue")
+
+	url, err := h.iam.OIDCService.InitiateLogin(ctx, oidcConfigID, continueURLQueryParam)
+	if err != nil {
+		var (
+			errOIDCConfigurationNotFound *oidc.ErrOIDCConfigurationNotFound
+			errOIDCDisabled              *oidc.ErrOIDCDisabled
+		)
+
+		switch {
+		case errors.As(err, &errOIDCConfigurationNotFound):
+			httpserver.RenderError(w, http.StatusNotFound, err)
+		case errors.As(err, &errOIDCDisabled):
+			httpserver.RenderError(w, http.StatusForbidden, err)
+		default:
+			panic(fmt.Errorf("cannot initiate OIDC login: %w", err))
+		}
+
+		return
+	}
+
+	http.Redirect(w, r, url.String(), http.StatusFound)
+}
+
+func (h *OIDCHandler) CallbackHandler(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	query := r.URL.Query()
+
+	if idpError := query.Get("error"); idpError != "" {
+		h.logger.WarnCtx(
+			ctx,
+			"identity provider returned an error",
+			log.String("error", idpError),
+			log.String("error_description", query.Get("error_description")),
+		)
+		httpserver.RenderError(w, http.StatusUnauthorized, fmt.Errorf("identity provider returned an error: %s", idpError))
+		return
+	}
+
+	state := query.Get("state")
+	code := query.Get("code")
+	if state == "" || code == "" {
+		httpserver.RenderError(w, http.StatusBadRequest, errors.New("missing state or code"))
+		return
+	}
+
+	result, err := h.iam.OIDCService.HandleCallback(ctx, state, code)
+	if err != nil {
+		var (
+			errInvalidAuthorizationRequest *oidc.ErrInvalidAuthorizationRequest
+			errOIDCConfigurationNotFound   *oidc.ErrOIDCConfigurationNotFound
+			errOIDCDisabled                *oidc.ErrOIDCDisabled
+			errInvalidIDToken              *oidc.ErrInvalidIDToken
+			errEmailNotVerified            *oidc.ErrEmailNotVerified
+			errEmailDomainMismatch         *oidc.ErrEmailDomainMismatch
+			errOIDCAutoSignupDisabled      *oidc.ErrOIDCAutoSignupDisabled
+			errMembershipInactive          *oidc.ErrMembershipInactive
+		)
+
+		switch {
+		case errors.As(err, &errInvalidAuthorizationRequest),
+			errors.As(err, &errOIDCConfigurationNotFound),
+			errors.As(err, &errOIDCDisabled),
+			errors.As(err, &errInvalidIDToken),
+			errors.As(err, &errEmailNotVerified),
+			errors.As(err, &errEmailDomainMismatch),
+			errors.As(err, &errOIDCAutoSignupDisabled),
+			errors.As(err, &errMembershipInactive):
+			httpserver.RenderError(w, http.StatusUnauthorized, err)
+		default:
+			h.logger.ErrorCtx(ctx, "cannot handle OIDC callback", log.Error(err))
+			h.renderInternalServerError(w)
+		}
+
+		return
+	}
+
+	var (
+		identity       = result.Identity
+		organizationID = result.Membership.OrganizationID
+		rootSession    = authn.SessionFromContext(ctx)
+	)
+
+	switch {
+	case rootSession == nil:
+		rootSession, err = h.iam.AuthService.OpenSessionWithOIDC(ctx, identity.ID)
+		if err != nil {
+			h.logger.ErrorCtx(ctx, "cannot open root session", log.Error(err))
+			h.renderInternalServerError(w)
+			return
+		}
+	case rootSession.IdentityID != identity.ID:
+		err = h.iam.SessionService.CloseSession(ctx, rootSession.ID)
+		if err != nil {
+			h.logger.ErrorCtx(ctx, "cannot close session", log.Error(err))
+			h.renderInternalServerError(w)
+			return
+		}
+
+		rootSession, err = h.iam.AuthService.OpenSessionWithOIDC(ctx, identity.ID)
+		if err != nil {
+			h.logger.ErrorCtx(ctx, "cannot open root session", log.Error(err))
+			h.renderInternalServerError(w)
+			return
+		}
+	}
+
+	_, _, err = h.iam.SessionService.OpenOIDCChildSessionForOrganization(ctx, rootSession.ID, organizationID)
+	if err != nil {
+		h.logger.ErrorCtx(ctx, "cannot open OIDC child session", log.Error(err))
+		h.renderInternalServerError(w)
+		return
+	}
+
+	h.sessionCookie.Set(w, rootSession)
+
+	defaultURL := "/organizations/" + organizationID.String()
+	continueURL := result.ContinueURL
+	if continueURL == "" {
+		continueURL = defaultURL
+	}
+
+	h.safeRedirect.Redirect(w, r, continueURL, defaultURL, http.StatusFound)
+}
@@ -47,6 +47,7 @@ func NewMux(logger *log.Logger, svc *iam.Service, cookieConfig securecookie.Conf
 	apiKeyMiddleware := authn.NewAPIKeyMiddleware(svc, tokenSecret)
 	graphqlHandler := NewGraphQLHandler(svc, logger, baseURL, cookieConfig)
 	samlHandler := NewSAMLHandler(svc, cookieConfig, baseURL, logger)
+	oidcHandler := NewOIDCHandler(svc, cookieConfig, baseURL, logger.Named("oidc"))
 	scimHandler := NewSCIMHandler(svc, logger.Named("scim"))
 
 	router := r.With(sessionMiddleware, apiKeyMiddleware)
@@ -55,6 +56,8 @@ func NewMux(logger *log.Logger, svc *iam.Service, cookieConfig securecookie.Conf
 	router.Get("/saml/2.0/metadata", samlHandler.MetadataHandler)
 	router.Post("/saml/2.0/consume", samlHandler.ConsumeHandler)
 	router.Get("/saml/2.0/{samlConfigID}", samlHandler.LoginHandler)
+	router.Get("/oidc/callback", oidcHandler.CallbackHandler)
+	router.Get("/oidc/{oidcConfigID}", oidcHandler.LoginHandler)
 
 	// SCIM 2.0 endpoints - these use their own bearer token authentication
 	scimServer := NewSCIMServer(scimHandler)
@@ -0,0 +1,175 @@
+// Copyright (c) 2025 Probo Inc <hello@getprobo.com>.
+//
+// Permission to use, copy, modify, and/or distribute this software for any
+// purpose with or without fee is hereby granted, provided that the above
+// copyright notice and this permission notice appear in all copies.
+//
+// THE SOFTWARE IS PROVIDED "AS IS" AND THE AUTHOR DISCLAIMS ALL WARRANTIES WITH
+// REGARD TO THIS SOFTWARE INCLUDING ALL IMPLIED WARRANTIES OF MERCHANTABILITY
+// AND FITNESS. IN NO EVENT SHALL THE AUTHOR BE LIABLE FOR ANY SPECIAL, DIRECT,
+// INDIRECT, OR CONSEQUENTIAL DAMAGES OR ANY DAMAGES WHATSOEVER RESULTING FROM
+// LOSS OF USE, DATA OR PROFITS, WHETHER IN AN ACTION OF CONTRACT, NEGLIGENCE OR
+// OTHER TORTIOUS ACTION, ARISING OUT OF OR IN CONNECTION WITH THE USE OR
+// PERFORMANCE OF THIS SOFTWARE.
+
+package connect_v1
+
+import (
+	"net/http"
+
+	"github.com/elimity-com/scim"
+	scimerrors "github.com/elimity-com/scim/errors"
+	scimfilter "github.com/scim2/filter-parser/v2"
+	"go.probo.inc/probo/pkg/gid"
+)
+
+func (h *scimGroupResourceHandler) Create(r *http.Request, attributes scim.ResourceAttributes) (scim.Resource, error) {
+	rc := &scimRequestContext{
+		ctx:       r.Context(),
+		config:    scimConfigFromContext(r.Context()),
+		ipAddress: getIPAddress(r),
+		method:    "POST",
+		path:      "/Groups",
+		handler:   &h.scimResourceHandler,
+	}
+
+	resource, err := h.handler.iam.SCIMService.CreateGroup(rc.ctx, rc.config, attributes)
+	if err != nil {
+		return scim.Resource{}, rc.logAndWrapError(err, "cannot create group")
+	}
+
+	rc.logSuccess(201)
+	return resource, nil
+}
+
+func (h *scimGroupResourceHandler) Get(r *http.Request, id string) (scim.Resource, error) {
+	rc := &scimRequestContext{
+		ctx:       r.Context(),
+		config:    scimConfigFromContext(r.Context()),
+		ipAddress: getIPAddress(r),
+		method:    "GET",
+		path:      "/Groups/" + id,
+		handler:   &h.scimResourceHandler,
+	}
+
+	groupID, err := gid.ParseGID(id)
+	if err != nil {
+		return scim.Resource{}, rc.logAndWrapError(scimerrors.ScimErrorResourceNotFound(id), "invalid group ID")
+	}
+
+	resource, err := h.handler.iam.SCIMService.GetGroup(rc.ctx, rc.config, groupID)
+	if err != nil {
+		return scim.Resource{}, rc.logAndWrapError(err, "cannot get group")
+	}
+
+	rc.logSuccess(200)
+	return resource, nil
+}
+
+func (h *scimGroupResourceHandler) GetAll(r *http.Request, params scim.ListRequestParams) (scim.Page, error) {
+	path := "/Groups"
+	if r.URL.RawQuery != "" {
+		path += "?" + r.URL.RawQuery
+	}
+
+	rc := &scimRequestContext{
+		ctx:       r.Context(),
+		config:    scimConfigFromContext(r.Context()),
+		ipAddress: getIPAddress(r),
+		method:    "GET",
+		path:      path,
+		handler:   &h.scimResourceHandler,
+	}
+
+	var filterExpr scimfilter.Expression
+	if params.FilterValidator != nil {
+		if err := params.FilterValidator.Validate(); err != nil {
+			return scim.Page{}, rc.logAndWrapError(scimerrors.ScimErrorBadRequest(err.Error()), "invalid filter")
+		}
+
+		filterExpr = params.FilterValidator.GetFilter()
+	}
+
+	resources, totalCount, err := h.handler.iam.SCIMService.ListGroups(rc.ctx, rc.config, filterExpr, params.StartIndex, params.Count)
+	if err != nil {
+		return scim.Page{}, rc.logAndWrapError(err, "cannot list groups")
+	}
+
+	rc.logSuccess(200)
+	return scim.Page{
+		TotalResults: totalCount,
+		Resources:    resources,
+	}, nil
+}
+
+func (h *scimGroupResourceHandler) Replace(r *http.Request, id string, attributes scim.ResourceAttributes) (scim.Resource, error) {
+	rc := &scimRequestContext{
+		ctx:       r.Context(),
+		config:    scimConfigFromContext(r.Context()),
+		ipAddress: getIPAddress(r),
+		method:    "PUT",
+		path:      "/Groups/" + id,
+		handler:   &h.scimResourceHandler,
+	}
+
+	groupID, err := gid.ParseGID(id)
+	if err != nil {
+		return scim.Resource{}, rc.logAndWrapError(scimerrors.ScimErrorResourceNotFound(id), "invalid group ID")
+	}
+
+	resource, err := h.handler.iam.SCIMService.ReplaceGroup(rc.ctx, rc.config, groupID, attributes)
+	if err != nil {
+		return scim.Resource{}, rc.logAndWrapError(err, "cannot update group")
+	}
+
+	rc.logSuccess(200)
+	return resource, nil
+}
+
+func (h *scimGroupResourceHandler) Patch(r *http.Request, id string, operations []scim.PatchOperation) (scim.Resource, error) {
+	rc := &scimRequestContext{
+		ctx:       r.Context(),
+		config:    scimConfigFromContext(r.Context()),
+		ipAddress: getIPAddress(r),
+		method:    "PATCH",
+		path:      "/Groups/" + id,
+		handler:   &h.scimResourceHandler,
+	}
+
+	groupID, err := gid.ParseGID(id)
+	if err != nil {
+		return scim.Resource{}, rc.logAndWrapError(scimerrors.ScimErrorResourceNotFound(id), "invalid group ID")
+	}
+
+	resource, err := h.handler.iam.SCIMService.PatchGroup(rc.ctx, rc.config, groupID, operations)
+	if err != nil {
+		return scim.Resource{}, rc.logAndWrapError(err, "cannot patch group")
+	}
+
+	rc.logSuccess(200)
+	return resource, nil
+}
+
+func (h *scimGroupResourceHandler) Delete(r *http.Request, id string) error {
+	rc := &scimRequestContext{
+		ctx:       r.Context(),
+		config:    scimConfigFromContext(r.Context()),
+		ipAddress: getIPAddress(r),
+		method:    "DELETE",
+		path:      "/Groups/" + id,
+		handler:   &h.scimResourceHandler,
+	}
+
+	groupID, err := gid.ParseGID(id)
+	if err != nil {
+		return rc.logAndWrapError(scimerrors.ScimErrorResourceNotFound(id), "invalid group ID")
+	}
+
+	err = h.handler.iam.SCIMService.DeleteGroup(rc.ctx, rc.config, groupID)
+	if err != nil {
+		return rc.logAndWrapError(err, "cannot delete group")
+	}
+
+	rc.logSuccess(204)
+	return nil
+}
@@ -45,6 +45,10 @@ type (
 		handler *SCIMHandler
 	}
 
+	scimGroupResourceHandler struct {
+		scimResourceHandler
+	}
+
 	scimRequestContext struct {
 		ctx          context.Context
 		config       *coredata.SCIMConfiguration
@@ -80,6 +84,14 @@ func NewSCIMServer(h *SCIMHandler) http.Handler {
 			Schema:      scimservice.UserSchema(),
 			Handler:     &scimResourceHandler{handler: h},
 		},
+		{
+			ID:          optional.NewString("Group"),
+			Name:        "Group",
+			Endpoint:    "/Groups",
+			Description: optional.NewString("Group"),
+			Schema:      scimservice.GroupSchema(),
+			Handler:     &scimGroupResourceHandler{scimResourceHandler{handler: h}},
+		},
 	}
 
 	serverConfig := scim.ServiceProviderConfig{
@@ -0,0 +1,133 @@
+// Copyright (c) 2025 Probo Inc <hello@getprobo.com>.
+//
+// Permission to use, copy, modify, and/or distribute this software for any
+// purpose with or without fee is hereby granted, provided that the above
+// copyright notice and this permission notice appear in all copies.
+//
+// THE SOFTWARE IS PROVIDED "AS IS" AND THE AUTHOR DISCLAIMS ALL WARRANTIES WITH
+// REGARD TO THIS SOFTWARE INCLUDING ALL IMPLIED WARRANTIES OF MERCHANTABILITY
+// AND FITNESS. IN NO EVENT SHALL THE AUTHOR BE LIABLE FOR ANY SPECIAL, DIRECT,
+// INDIRECT, OR CONSEQUENTIAL DAMAGES OR ANY DAMAGES WHATSOEVER RESULTING FROM
+// LOSS OF USE, DATA OR PROFITS, WHETHER IN AN ACTION OF CONTRACT, NEGLIGENCE OR
+// OTHER TORTIOUS ACTION, ARISING OUT OF OR IN CONNECTION WITH THE USE OR
+// PERFORMANCE OF THIS SOFTWARE.
+
+package types
+
+import (
+	"maps"
+	"slices"
+
+	"go.probo.inc/probo/pkg/iam"
+	"go.probo.inc/probo/pkg/iam/policy"
+)
+
+func NewAuthorizationSimulation(s *iam.AuthorizationSimulation) *AuthorizationSimulation {
+	simulation := &AuthorizationSimulation{
+		Allowed:    s.Allowed,
+		Evaluation: NewPolicyEvaluation(s.Trace),
+	}
+
+	if s.APIKeyTrace != nil {
+		simulation.PersonalAPIKeyEvaluation = NewPolicyEvaluation(*s.APIKeyTrace)
+	}
+
+	return simulation
+}
+
+func NewPolicyEvaluation(t policy.EvaluationTrace) *PolicyEvaluation {
+	evaluation := &PolicyEvaluation{
+		Decision:            NewAuthorizationDecision(t.Result.Decision),
+		PrincipalAttributes: NewAuthorizationAttributes(t.Request.ConditionContext.Principal),
+		ResourceAttributes:  NewAuthorizationAttributes(t.Request.ConditionContext.Resource),
+		Policies:            make([]*PolicyTrace, len(t.Policies)),
+	}
+
+	if t.Result.MatchedPolicy != nil {
+		evaluation.MatchedPolicyID = &t.Result.MatchedPolicy.ID
+	}
+
+	if t.Result.MatchedStatement != nil && t.Result.MatchedStatement.SID != "" {
+		evaluation.MatchedStatementSid = &t.Result.MatchedStatement.SID
+	}
+
+	for i, p := range t.Policies {
+		evaluation.Policies[i] = NewPolicyTrace(p)
+	}
+
+	return evaluation
+}
+
+func NewAuthorizationAttributes(attributes map[string]string) []*AuthorizationAttribute {
+	keys := slices.Sorted(maps.Keys(attributes))
+
+	result := make([]*AuthorizationAttribute, len(keys))
+	for i, key := range keys {
+		result[i] = &AuthorizationAttribute{
+			Key:   key,
+			Value: attributes[key],
+		}
+	}
+
+	return result
+}
+
+func NewPolicyTrace(t policy.PolicyTrace) *PolicyTrace {
+	statements := make([]*StatementTrace, len(t.Statements))
+	for i, s := range t.Statements {
+		statements[i] = NewStatementTrace(s)
+	}
+
+	return &PolicyTrace{
+		ID:         t.Policy.ID,
+		Name:       t.Policy.Name,
+		Statements: statements,
+	}
+}
+
+func NewStatementTrace(t policy.StatementTrace) *StatementTrace {
+	statement := &StatementTrace{
+		Effect:          NewPolicyEffect(t.Statement.Effect),
+		Actions:         t.Statement.Actions,
+		ActionMatched:   t.ActionMatched,
+		ResourceMatched: t.ResourceMatched,
+		Matched:         t.Matched,
+		Conditions:      make([]*ConditionTrace, len(t.Conditions)),
+	}
+
+	if t.Statement.SID != "" {
+		statement.Sid = &t.Statement.SID
+	}
+
+	for i, c := range t.Conditions {
+		statement.Conditions[i] = &ConditionTrace{
+			Operator:       string(c.Condition.Operator),
+			Key:            c.Condition.Key,
+			KeyValue:       c.KeyValue,
+			Values:         c.Condition.Values,
+			ResolvedValues: c.Values,
+			Satisfied:      c.Satisfied,
+		}
+	}
+
+	return statement
+}
+
+func NewAuthorizationDecision(d policy.Decision) AuthorizationDecision {
+	switch d {
+	case policy.DecisionAllow:
+		return AuthorizationDecisionAllow
+	case policy.DecisionDeny:
+		return AuthorizationDecisionDeny
+	default:
+		return AuthorizationDecisionNoMatch
+	}
+}
+
+func NewPolicyEffect(e policy.Effect) PolicyEffect {
+	if e == policy.EffectDeny {
+		return PolicyEffectDeny
+	}
+
+	return PolicyEffectAllow
+}
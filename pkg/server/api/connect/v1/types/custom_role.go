@@ -0,0 +1,74 @@
+// Copyright (c) 2025 Probo Inc <hello@getprobo.com>.
+//
+// Permission to use, copy, modify, and/or distribute this software for any
+// purpose with or without fee is hereby granted, provided that the above
+// copyright notice and this permission notice appear in all copies.
+//
+// THE SOFTWARE IS PROVIDED "AS IS" AND THE AUTHOR DISCLAIMS ALL WARRANTIES WITH
+// REGARD TO THIS SOFTWARE INCLUDING ALL IMPLIED WARRANTIES OF MERCHANTABILITY
+// AND FITNESS. IN NO EVENT SHALL THE AUTHOR BE LIABLE FOR ANY SPECIAL, DIRECT,
+// INDIRECT, OR CONSEQUENTIAL DAMAGES OR ANY DAMAGES WHATSOEVER RESULTING FROM
+// LOSS OF USE, DATA OR PROFITS, WHETHER IN AN ACTION OF CONTRACT, NEGLIGENCE OR
+// OTHER TORTIOUS ACTION, ARISING OUT OF OR IN CONNECTION WITH THE USE OR
+// PERFORMANCE OF THIS SOFTWARE.
+
+package types
+
+import (
+	"go.probo.inc/probo/pkg/coredata"
+	"go.probo.inc/probo/pkg/gid"
+	"go.probo.inc/probo/pkg/page"
+)
+
+type (
+	CustomRoleOrderBy OrderBy[coredata.CustomRoleOrderField]
+
+	CustomRoleConnection struct {
+		TotalCount int
+		Edges      []*CustomRoleEdge
+		PageInfo   PageInfo
+
+		Resolver any
+		ParentID gid.GID
+	}
+)
+
+func NewCustomRoleConnection(
+	p *page.Page[*coredata.CustomRole, coredata.CustomRoleOrderField],
+	resolver any,
+	parentID gid.GID,
+) *CustomRoleConnection {
+	edges := make([]*CustomRoleEdge, len(p.Data))
+	for i, customRole := range p.Data {
+		edges[i] = NewCustomRoleEdge(customRole, p.Cursor.OrderBy.Field)
+	}
+
+	return &CustomRoleConnection{
+		Edges:    edges,
+		PageInfo: *NewPageInfo(p),
+
+		Resolver: resolver,
+		ParentID: parentID,
+	}
+}
+
+func NewCustomRoleEdge(customRole *coredata.CustomRole, orderField coredata.CustomRoleOrderField) *CustomRoleEdge {
+	return &CustomRoleEdge{
+		Node:   NewCustomRole(customRole),
+		Cursor: customRole.CursorKey(orderField),
+	}
+}
+
+func NewCustomRole(customRole *coredata.CustomRole) *CustomRole {
+	return &CustomRole{
+		ID:          customRole.ID,
+		Name:        customRole.Name,
+		Description: customRole.Description,
+		Policy:      string(customRole.Policy),
+		CreatedAt:   customRole.CreatedAt,
+		UpdatedAt:   customRole.UpdatedAt,
+		Organization: &Organization{
+			ID: customRole.OrganizationID,
+		},
+	}
+}
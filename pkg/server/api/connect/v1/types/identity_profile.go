@@ -25,6 +25,7 @@ func NewMembershipProfile(profile *coredata.MembershipProfile) *MembershipProfil
 		Position:                 profile.Position,
 		ContractStartDate:        profile.ContractStartDate,
 		ContractEndDate:          profile.ContractEndDate,
+		WeeklyDigestEnabled:      profile.WeeklyDigestEnabled,
 		CreatedAt:                profile.CreatedAt,
 		UpdatedAt:                profile.UpdatedAt,
 		MembershipID:             profile.MembershipID,
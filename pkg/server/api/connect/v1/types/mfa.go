@@ -0,0 +1,56 @@
+// Copyright (c) 2025 Probo Inc <hello@getprobo.com>.
+//
+// Permission to use, copy, modify, and/or distribute this software for any
+// purpose with or without fee is hereby granted, provided that the above
+// copyright notice and this permission notice appear in all copies.
+//
+// THE SOFTWARE IS PROVIDED "AS IS" AND THE AUTHOR DISCLAIMS ALL WARRANTIES WITH
+// REGARD TO THIS SOFTWARE INCLUDING ALL IMPLIED WARRANTIES OF MERCHANTABILITY
+// AND FITNESS. IN NO EVENT SHALL THE AUTHOR BE LIABLE FOR ANY SPECIAL, DIRECT,
+// INDIRECT, OR CONSEQUENTIAL DAMAGES OR ANY DAMAGES WHATSOEVER RESULTING FROM
+// LOSS OF USE, DATA OR PROFITS, WHETHER IN AN ACTION OF CONTRACT, NEGLIGENCE OR
+// OTHER TORTIOUS ACTION, ARISING OUT OF OR IN CONNECTION WITH THE USE OR
+// PERFORMANCE OF THIS SOFTWARE.
+
+package types
+
+import (
+	"go.probo.inc/probo/pkg/coredata"
+	"go.probo.inc/probo/pkg/iam"
+)
+
+func NewIdentityMfa(status *iam.MFAStatus) *IdentityMfa {
+	credentials := make([]*WebAuthnCredential, len(status.WebAuthnCredentials))
+	for i, credential := range status.WebAuthnCredentials {
+		credentials[i] = NewWebAuthnCredential(credential)
+	}
+
+	return &IdentityMfa{
+		TotpEnabled:            status.TOTPEnabled,
+		RecoveryCodesRemaining: status.RecoveryCodesRemaining,
+		WebAuthnCredentials:    credentials,
+	}
+}
+
+func NewWebAuthnCredential(credential *coredata.WebAuthnCredential) *WebAuthnCredential {
+	return &WebAuthnCredential{
+		ID:         credential.ID,
+		Name:       credential.Name,
+		LastUsedAt: credential.LastUsedAt,
+		CreatedAt:  credential.CreatedAt,
+	}
+}
+
+func NewMFAChallenge(challenge *iam.MFAChallenge) *MFAChallenge {
+	mfaChallenge := &MFAChallenge{
+		Token:   challenge.Token,
+		Methods: challenge.Methods,
+	}
+
+	if challenge.WebAuthnOptions != nil {
+		options := string(challenge.WebAuthnOptions)
+		mfaChallenge.WebAuthnOptions = &options
+	}
+
+	return mfaChallenge
+}
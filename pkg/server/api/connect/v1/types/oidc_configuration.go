@@ -0,0 +1,73 @@
+// Copyright (c) 2025 Probo Inc <hello@getprobo.com>.
+//
+// Permission to use, copy, modify, and/or distribute this software for any
+// purpose with or without fee is hereby granted, provided that the above
+// copyright notice and this permission notice appear in all copies.
+//
+// THE SOFTWARE IS PROVIDED "AS IS" AND THE AUTHOR DISCLAIMS ALL WARRANTIES WITH
+// REGARD TO THIS SOFTWARE INCLUDING ALL IMPLIED WARRANTIES OF MERCHANTABILITY
+// AND FITNESS. IN NO EVENT SHALL THE AUTHOR BE LIABLE FOR ANY SPECIAL, DIRECT,
+// INDIRECT, OR CONSEQUENTIAL DAMAGES OR ANY DAMAGES WHATSOEVER RESULTING FROM
+// LOSS OF USE, DATA OR PROFITS, WHETHER IN AN ACTION OF CONTRACT, NEGLIGENCE OR
+// OTHER TORTIOUS ACTION, ARISING OUT OF OR IN CONNECTION WITH THE USE OR
+// PERFORMANCE OF THIS SOFTWARE.
+
+package types
+
+import (
+	"go.probo.inc/probo/pkg/coredata"
+	"go.probo.inc/probo/pkg/gid"
+	"go.probo.inc/probo/pkg/page"
+)
+
+type (
+	OIDCConfigurationConnection struct {
+		TotalCount int
+		Edges      []*OIDCConfigurationEdge
+		PageInfo   PageInfo
+
+		Resolver any
+		ParentID gid.GID
+	}
+)
+
+func NewOIDCConfigurationConnection(
+	p *page.Page[*coredata.OIDCConfiguration, coredata.OIDCConfigurationOrderField],
+	resolver any,
+	parentID gid.GID,
+) *OIDCConfigurationConnection {
+	edges := make([]*OIDCConfigurationEdge, len(p.Data))
+	for i, oidcConfiguration := range p.Data {
+		edges[i] = NewOIDCConfigurationEdge(oidcConfiguration, p.Cursor.OrderBy.Field)
+	}
+
+	return &OIDCConfigurationConnection{
+		Edges:    edges,
+		PageInfo: *NewPageInfo(p),
+
+		Resolver: resolver,
+		ParentID: parentID,
+	}
+}
+
+func NewOIDCConfigurationEdge(oidcConfiguration *coredata.OIDCConfiguration, orderField coredata.OIDCConfigurationOrderField) *OIDCConfigurationEdge {
+	return &OIDCConfigurationEdge{
+		Node:   NewOIDCConfiguration(oidcConfiguration),
+		Cursor: oidcConfiguration.CursorKey(orderField),
+	}
+}
+
+func NewOIDCConfiguration(oidcConfiguration *coredata.OIDCConfiguration) *OIDCConfiguration {
+	return &OIDCConfiguration{
+		ID:                      oidcConfiguration.ID,
+		EmailDomain:             oidcConfiguration.EmailDomain,
+		EnforcementPolicy:       oidcConfiguration.EnforcementPolicy,
+		DomainVerifiedAt:        oidcConfiguration.DomainVerifiedAt,
+		DomainVerificationToken: oidcConfiguration.DomainVerificationToken,
+		IssuerURL:               oidcConfiguration.IssuerURL,
+		ClientID:                oidcConfiguration.ClientID,
+		AutoSignupEnabled:       oidcConfiguration.AutoSignupEnabled,
+		CreatedAt:               oidcConfiguration.CreatedAt,
+		UpdatedAt:               oidcConfiguration.UpdatedAt,
+	}
+}
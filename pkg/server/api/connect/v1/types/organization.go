@@ -30,6 +30,7 @@ func NewOrganization(organization *coredata.Organization) *Organization {
 		Description:        organization.Description,
 		WebsiteURL:         organization.WebsiteURL,
 		HeadquarterAddress: organization.HeadquarterAddress,
+		MfaRequired:        organization.MFARequired,
 		CreatedAt:          organization.CreatedAt,
 		UpdatedAt:          organization.UpdatedAt,
 	}
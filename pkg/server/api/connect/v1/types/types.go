@@ -12,6 +12,7 @@ import (
 	"github.com/99designs/gqlgen/graphql"
 	"go.probo.inc/probo/pkg/coredata"
 	"go.probo.inc/probo/pkg/gid"
+	"go.probo.inc/probo/pkg/iam"
 	"go.probo.inc/probo/pkg/mail"
 	"go.probo.inc/probo/pkg/page"
 )
@@ -34,6 +35,25 @@ type AcceptInvitationPayload struct {
 	Invitation     *Invitation     `json:"invitation"`
 }
 
+type AssignMembershipCustomRoleInput struct {
+	MembershipID gid.GID  `json:"membershipId"`
+	CustomRoleID *gid.GID `json:"customRoleId,omitempty"`
+}
+
+type AssignMembershipCustomRolePayload struct {
+	Membership *Membership `json:"membership"`
+}
+
+type AssignPersonalAPIKeyCustomRoleInput struct {
+	PersonalAPIKeyID gid.GID  `json:"personalAPIKeyId"`
+	OrganizationID   gid.GID  `json:"organizationId"`
+	CustomRoleID     *gid.GID `json:"customRoleId,omitempty"`
+}
+
+type AssignPersonalAPIKeyCustomRolePayload struct {
+	PersonalAPIKey *PersonalAPIKey `json:"personalAPIKey"`
+}
+
 type AssumeOrganizationSessionInput struct {
 	OrganizationID gid.GID `json:"organizationId"`
 	Continue       string  `json:"continue"`
@@ -43,6 +63,27 @@ type AssumeOrganizationSessionPayload struct {
 	Result AssumeOrganizationSessionResult `json:"result"`
 }
 
+type AuthorizationAttribute struct {
+	Key   string `json:"key"`
+	Value string `json:"value"`
+}
+
+type AuthorizationSimulation struct {
+	Allowed                  bool              `json:"allowed"`
+	Evaluation               *PolicyEvaluation `json:"evaluation"`
+	PersonalAPIKeyEvaluation *PolicyEvaluation `json:"personalAPIKeyEvaluation,omitempty"`
+}
+
+type BeginTOTPEnrollmentPayload struct {
+	Secret          string `json:"secret"`
+	ProvisioningURI string `json:"provisioningUri"`
+}
+
+type BeginWebAuthnRegistrationPayload struct {
+	Token   string `json:"token"`
+	Options string `json:"options"`
+}
+
 type ChangeEmailInput struct {
 	NewEmail mail.Addr `json:"newEmail"`
 	Password string    `json:"password"`
@@ -61,6 +102,23 @@ type ChangePasswordPayload struct {
 	Success bool `json:"success"`
 }
 
+type ConditionTrace struct {
+	Operator       string    `json:"operator"`
+	Key            string    `json:"key"`
+	KeyValue       *string   `json:"keyValue,omitempty"`
+	Values         []string  `json:"values"`
+	ResolvedValues []*string `json:"resolvedValues"`
+	Satisfied      bool      `json:"satisfied"`
+}
+
+type ConfirmTOTPEnrollmentInput struct {
+	Code string `json:"code"`
+}
+
+type ConfirmTOTPEnrollmentPayload struct {
+	RecoveryCodes []string `json:"recoveryCodes"`
+}
+
 type Connector struct {
 	ID         gid.GID                    `json:"id"`
 	Provider   coredata.ConnectorProvider `json:"provider"`
@@ -72,6 +130,30 @@ type Connector struct {
 func (Connector) IsNode()             {}
 func (this Connector) GetID() gid.GID { return this.ID }
 
+type CreateCustomRoleInput struct {
+	OrganizationID gid.GID `json:"organizationId"`
+	Name           string  `json:"name"`
+	Description    *string `json:"description,omitempty"`
+	Policy         string  `json:"policy"`
+}
+
+type CreateCustomRolePayload struct {
+	CustomRoleEdge *CustomRoleEdge `json:"customRoleEdge"`
+}
+
+type CreateOIDCConfigurationInput struct {
+	OrganizationID    gid.GID `json:"organizationId"`
+	EmailDomain       string  `json:"emailDomain"`
+	IssuerURL         string  `json:"issuerUrl"`
+	ClientID          string  `json:"clientId"`
+	ClientSecret      string  `json:"clientSecret"`
+	AutoSignupEnabled bool    `json:"autoSignupEnabled"`
+}
+
+type CreateOIDCConfigurationPayload struct {
+	OidcConfigurationEdge *OIDCConfigurationEdge `json:"oidcConfigurationEdge"`
+}
+
 type CreateOrganizationInput struct {
 	Name               string          `json:"name"`
 	LogoFile           *graphql.Upload `json:"logoFile,omitempty"`
@@ -118,6 +200,43 @@ type CreateSCIMConfigurationPayload struct {
 	Token             string             `json:"token"`
 }
 
+type CreateSCIMGroupRoleMappingInput struct {
+	OrganizationID   gid.GID                 `json:"organizationId"`
+	GroupDisplayName string                  `json:"groupDisplayName"`
+	Role             coredata.MembershipRole `json:"role"`
+}
+
+type CreateSCIMGroupRoleMappingPayload struct {
+	ScimGroupRoleMapping *SCIMGroupRoleMapping `json:"scimGroupRoleMapping"`
+}
+
+type CustomRole struct {
+	ID           gid.GID       `json:"id"`
+	Name         string        `json:"name"`
+	Description  *string       `json:"description,omitempty"`
+	Policy       string        `json:"policy"`
+	CreatedAt    time.Time     `json:"createdAt"`
+	UpdatedAt    time.Time     `json:"updatedAt"`
+	Organization *Organization `json:"organization,omitempty"`
+	Permission   bool          `json:"permission"`
+}
+
+func (CustomRole) IsNode()             {}
+func (this CustomRole) GetID() gid.GID { return this.ID }
+
+type CustomRoleEdge struct {
+	Node   *CustomRole    `json:"node"`
+	Cursor page.CursorKey `json:"cursor"`
+}
+
+type DeleteCustomRoleInput struct {
+	CustomRoleID gid.GID `json:"customRoleId"`
+}
+
+type DeleteCustomRolePayload struct {
+	DeletedCustomRoleID gid.GID `json:"deletedCustomRoleId"`
+}
+
 type DeleteInvitationInput struct {
 	OrganizationID gid.GID `json:"organizationId"`
 	InvitationID   gid.GID `json:"invitationId"`
@@ -127,6 +246,15 @@ type DeleteInvitationPayload struct {
 	DeletedInvitationID gid.GID `json:"deletedInvitationId"`
 }
 
+type DeleteOIDCConfigurationInput struct {
+	OrganizationID      gid.GID `json:"organizationId"`
+	OidcConfigurationID gid.GID `json:"oidcConfigurationId"`
+}
+
+type DeleteOIDCConfigurationPayload struct {
+	DeletedOidcConfigurationID gid.GID `json:"deletedOidcConfigurationId"`
+}
+
 type DeleteOrganizationHorizontalLogoInput struct {
 	OrganizationID gid.GID `json:"organizationId"`
 }
@@ -161,6 +289,38 @@ type DeleteSCIMConfigurationPayload struct {
 	DeletedScimConfigurationID gid.GID `json:"deletedScimConfigurationId"`
 }
 
+type DeleteSCIMGroupRoleMappingInput struct {
+	OrganizationID         gid.GID `json:"organizationId"`
+	ScimGroupRoleMappingID gid.GID `json:"scimGroupRoleMappingId"`
+}
+
+type DeleteSCIMGroupRoleMappingPayload struct {
+	DeletedScimGroupRoleMappingID gid.GID `json:"deletedScimGroupRoleMappingId"`
+}
+
+type DeleteWebAuthnCredentialInput struct {
+	WebAuthnCredentialID gid.GID `json:"webAuthnCredentialId"`
+}
+
+type DeleteWebAuthnCredentialPayload struct {
+	DeletedWebAuthnCredentialID gid.GID `json:"deletedWebAuthnCredentialId"`
+}
+
+type DisableTOTPPayload struct {
+	Success bool `json:"success"`
+}
+
+type FinishWebAuthnRegistrationInput struct {
+	Token    string `json:"token"`
+	Name     string `json:"name"`
+	Response string `json:"response"`
+}
+
+type FinishWebAuthnRegistrationPayload struct {
+	WebAuthnCredential *WebAuthnCredential `json:"webAuthnCredential"`
+	RecoveryCodes      []string            `json:"recoveryCodes"`
+}
+
 type ForgotPasswordInput struct {
 	Email mail.Addr `json:"email"`
 }
@@ -180,12 +340,19 @@ type Identity struct {
 	PendingInvitations *InvitationConnection     `json:"pendingInvitations,omitempty"`
 	Sessions           *SessionConnection        `json:"sessions,omitempty"`
 	PersonalAPIKeys    *PersonalAPIKeyConnection `json:"personalAPIKeys,omitempty"`
+	Mfa                *IdentityMfa              `json:"mfa,omitempty"`
 	Permission         bool                      `json:"permission"`
 }
 
 func (Identity) IsNode()             {}
 func (this Identity) GetID() gid.GID { return this.ID }
 
+type IdentityMfa struct {
+	TotpEnabled            bool                  `json:"totpEnabled"`
+	RecoveryCodesRemaining int                   `json:"recoveryCodesRemaining"`
+	WebAuthnCredentials    []*WebAuthnCredential `json:"webAuthnCredentials"`
+}
+
 type Invitation struct {
 	ID           gid.GID                   `json:"id"`
 	Email        mail.Addr                 `json:"email"`
@@ -218,6 +385,18 @@ type InviteMemberPayload struct {
 	InvitationEdge *InvitationEdge `json:"invitationEdge"`
 }
 
+type MFAChallenge struct {
+	Token           string          `json:"token"`
+	Methods         []iam.MFAMethod `json:"methods"`
+	WebAuthnOptions *string         `json:"webAuthnOptions,omitempty"`
+}
+
+type MFARequired struct {
+	Reason ReauthenticationReason `json:"reason"`
+}
+
+func (MFARequired) IsAssumeOrganizationSessionResult() {}
+
 type Membership struct {
 	ID           gid.GID                   `json:"id"`
 	CreatedAt    time.Time                 `json:"createdAt"`
@@ -227,6 +406,7 @@ type Membership struct {
 	Role         coredata.MembershipRole   `json:"role"`
 	Source       coredata.MembershipSource `json:"source"`
 	State        coredata.MembershipState  `json:"state"`
+	CustomRole   *CustomRole               `json:"customRole,omitempty"`
 	LastSession  *Session                  `json:"lastSession,omitempty"`
 	Permission   bool                      `json:"permission"`
 }
@@ -247,6 +427,7 @@ type MembershipProfile struct {
 	Position                 *string                        `json:"position,omitempty"`
 	ContractStartDate        *time.Time                     `json:"contractStartDate,omitempty"`
 	ContractEndDate          *time.Time                     `json:"contractEndDate,omitempty"`
+	WeeklyDigestEnabled      bool                           `json:"weeklyDigestEnabled"`
 	CreatedAt                time.Time                      `json:"createdAt"`
 	UpdatedAt                time.Time                      `json:"updatedAt"`
 	Identity                 *Identity                      `json:"identity,omitempty"`
@@ -261,23 +442,59 @@ func (this MembershipProfile) GetID() gid.GID { return this.ID }
 type Mutation struct {
 }
 
+type OIDCAuthenticationRequired struct {
+	Reason      ReauthenticationReason `json:"reason"`
+	RedirectURL string                 `json:"redirectUrl"`
+}
+
+func (OIDCAuthenticationRequired) IsAssumeOrganizationSessionResult() {}
+
+type OIDCConfiguration struct {
+	ID                      gid.GID                        `json:"id"`
+	EmailDomain             string                         `json:"emailDomain"`
+	EnforcementPolicy       coredata.SAMLEnforcementPolicy `json:"enforcementPolicy"`
+	DomainVerifiedAt        *time.Time                     `json:"domainVerifiedAt,omitempty"`
+	DomainVerificationToken *string                        `json:"domainVerificationToken,omitempty"`
+	IssuerURL               string                         `json:"issuerUrl"`
+	ClientID                string                         `json:"clientId"`
+	AutoSignupEnabled       bool                           `json:"autoSignupEnabled"`
+	CreatedAt               time.Time                      `json:"createdAt"`
+	UpdatedAt               time.Time                      `json:"updatedAt"`
+	RedirectURI             string                         `json:"redirectUri"`
+	TestLoginURL            string                         `json:"testLoginUrl"`
+	Permission              bool                           `json:"permission"`
+}
+
+func (OIDCConfiguration) IsNode()             {}
+func (this OIDCConfiguration) GetID() gid.GID { return this.ID }
+
+type OIDCConfigurationEdge struct {
+	Node   *OIDCConfiguration `json:"node"`
+	Cursor page.CursorKey     `json:"cursor"`
+}
+
 type Organization struct {
-	ID                 gid.GID                      `json:"id"`
-	Name               string                       `json:"name"`
-	LogoURL            *string                      `json:"logoUrl,omitempty"`
-	HorizontalLogoURL  *string                      `json:"horizontalLogoUrl,omitempty"`
-	Email              *string                      `json:"email,omitempty"`
-	Description        *string                      `json:"description,omitempty"`
-	WebsiteURL         *string                      `json:"websiteUrl,omitempty"`
-	HeadquarterAddress *string                      `json:"headquarterAddress,omitempty"`
-	CreatedAt          time.Time                    `json:"createdAt"`
-	UpdatedAt          time.Time                    `json:"updatedAt"`
-	Members            *MembershipConnection        `json:"members,omitempty"`
-	Invitations        *InvitationConnection        `json:"invitations,omitempty"`
-	SamlConfigurations *SAMLConfigurationConnection `json:"samlConfigurations,omitempty"`
-	ScimConfiguration  *SCIMConfiguration           `json:"scimConfiguration,omitempty"`
-	ViewerMembership   *Membership                  `json:"viewerMembership,omitempty"`
-	Permission         bool                         `json:"permission"`
+	ID                      gid.GID                      `json:"id"`
+	Name                    string                       `json:"name"`
+	LogoURL                 *string                      `json:"logoUrl,omitempty"`
+	HorizontalLogoURL       *string                      `json:"horizontalLogoUrl,omitempty"`
+	Email                   *string                      `json:"email,omitempty"`
+	Description             *string                      `json:"description,omitempty"`
+	WebsiteURL              *string                      `json:"websiteUrl,omitempty"`
+	HeadquarterAddress      *string                      `json:"headquarterAddress,omitempty"`
+	MfaRequired             bool                         `json:"mfaRequired"`
+	CreatedAt               time.Time                    `json:"createdAt"`
+	UpdatedAt               time.Time                    `json:"updatedAt"`
+	Members                 *MembershipConnection        `json:"members,omitempty"`
+	Invitations             *InvitationConnection        `json:"invitations,omitempty"`
+	SamlConfigurations      *SAMLConfigurationConnection `json:"samlConfigurations,omitempty"`
+	OidcConfigurations      *OIDCConfigurationConnection `json:"oidcConfigurations,omitempty"`
+	ScimConfiguration       *SCIMConfiguration           `json:"scimConfiguration,omitempty"`
+	ScimGroupRoleMappings   []*SCIMGroupRoleMapping      `json:"scimGroupRoleMappings"`
+	CustomRoles             *CustomRoleConnection        `json:"customRoles,omitempty"`
+	AuthorizationSimulation *AuthorizationSimulation     `json:"authorizationSimulation,omitempty"`
+	ViewerMembership        *Membership                  `json:"viewerMembership,omitempty"`
+	Permission              bool                         `json:"permission"`
 }
 
 func (Organization) IsNode()             {}
@@ -321,9 +538,28 @@ type PersonalAPIKeyEdge struct {
 	Cursor page.CursorKey  `json:"cursor"`
 }
 
+type PolicyEvaluation struct {
+	Decision            AuthorizationDecision     `json:"decision"`
+	MatchedPolicyID     *string                   `json:"matchedPolicyId,omitempty"`
+	MatchedStatementSid *string                   `json:"matchedStatementSid,omitempty"`
+	PrincipalAttributes []*AuthorizationAttribute `json:"principalAttributes"`
+	ResourceAttributes  []*AuthorizationAttribute `json:"resourceAttributes"`
+	Policies            []*PolicyTrace            `json:"policies"`
+}
+
+type PolicyTrace struct {
+	ID         string            `json:"id"`
+	Name       string            `json:"name"`
+	Statements []*StatementTrace `json:"statements"`
+}
+
 type Query struct {
 }
 
+type RegenerateRecoveryCodesPayload struct {
+	RecoveryCodes []string `json:"recoveryCodes"`
+}
+
 type RegenerateSCIMTokenInput struct {
 	OrganizationID      gid.GID `json:"organizationId"`
 	ScimConfigurationID gid.GID `json:"scimConfigurationId"`
@@ -469,6 +705,18 @@ type SCIMEventEdge struct {
 	Cursor page.CursorKey `json:"cursor"`
 }
 
+type SCIMGroupRoleMapping struct {
+	ID               gid.GID                 `json:"id"`
+	GroupDisplayName string                  `json:"groupDisplayName"`
+	Role             coredata.MembershipRole `json:"role"`
+	CreatedAt        time.Time               `json:"createdAt"`
+	UpdatedAt        time.Time               `json:"updatedAt"`
+	Permission       bool                    `json:"permission"`
+}
+
+func (SCIMGroupRoleMapping) IsNode()             {}
+func (this SCIMGroupRoleMapping) GetID() gid.GID { return this.ID }
+
 type Session struct {
 	ID         gid.GID   `json:"id"`
 	Identity   *Identity `json:"identity,omitempty"`
@@ -500,8 +748,9 @@ type SignInInput struct {
 }
 
 type SignInPayload struct {
-	Identity *Identity `json:"identity,omitempty"`
-	Session  *Session  `json:"session,omitempty"`
+	Identity     *Identity     `json:"identity,omitempty"`
+	Session      *Session      `json:"session,omitempty"`
+	MfaChallenge *MFAChallenge `json:"mfaChallenge,omitempty"`
 }
 
 type SignOutPayload struct {
@@ -528,6 +777,27 @@ type SignUpPayload struct {
 	Identity *Identity `json:"identity,omitempty"`
 }
 
+type StatementTrace struct {
+	Sid             *string           `json:"sid,omitempty"`
+	Effect          PolicyEffect      `json:"effect"`
+	Actions         []string          `json:"actions"`
+	ActionMatched   bool              `json:"actionMatched"`
+	ResourceMatched bool              `json:"resourceMatched"`
+	Matched         bool              `json:"matched"`
+	Conditions      []*ConditionTrace `json:"conditions"`
+}
+
+type UpdateCustomRoleInput struct {
+	CustomRoleID gid.GID                    `json:"customRoleId"`
+	Name         *string                    `json:"name,omitempty"`
+	Description  graphql.Omittable[*string] `json:"description,omitempty"`
+	Policy       *string                    `json:"policy,omitempty"`
+}
+
+type UpdateCustomRolePayload struct {
+	CustomRole *CustomRole `json:"customRole"`
+}
+
 type UpdateMembershipInput struct {
 	OrganizationID gid.GID                 `json:"organizationId"`
 	MembershipID   gid.GID                 `json:"membershipId"`
@@ -538,6 +808,20 @@ type UpdateMembershipPayload struct {
 	Membership *Membership `json:"membership"`
 }
 
+type UpdateOIDCConfigurationInput struct {
+	OrganizationID      gid.GID                         `json:"organizationId"`
+	OidcConfigurationID gid.GID                         `json:"oidcConfigurationId"`
+	IssuerURL           *string                         `json:"issuerUrl,omitempty"`
+	ClientID            *string                         `json:"clientId,omitempty"`
+	ClientSecret        *string                         `json:"clientSecret,omitempty"`
+	AutoSignupEnabled   *bool                           `json:"autoSignupEnabled,omitempty"`
+	EnforcementPolicy   *coredata.SAMLEnforcementPolicy `json:"enforcementPolicy,omitempty"`
+}
+
+type UpdateOIDCConfigurationPayload struct {
+	OidcConfiguration *OIDCConfiguration `json:"oidcConfiguration,omitempty"`
+}
+
 type UpdateOrganizationInput struct {
 	OrganizationID     gid.GID                    `json:"organizationId"`
 	Name               *string                    `json:"name,omitempty"`
@@ -547,6 +831,7 @@ type UpdateOrganizationInput struct {
 	WebsiteURL         graphql.Omittable[*string] `json:"websiteUrl,omitempty"`
 	Email              graphql.Omittable[*string] `json:"email,omitempty"`
 	HeadquarterAddress graphql.Omittable[*string] `json:"headquarterAddress,omitempty"`
+	MfaRequired        *bool                      `json:"mfaRequired,omitempty"`
 }
 
 type UpdateOrganizationPayload struct {
@@ -563,6 +848,15 @@ type UpdateProfileInput struct {
 	ContractEndDate          graphql.Omittable[*time.Time]  `json:"contractEndDate,omitempty"`
 }
 
+type UpdateProfileNotificationPreferencesInput struct {
+	ID                  gid.GID `json:"id"`
+	WeeklyDigestEnabled *bool   `json:"weeklyDigestEnabled,omitempty"`
+}
+
+type UpdateProfileNotificationPreferencesPayload struct {
+	Profile *MembershipProfile `json:"profile"`
+}
+
 type UpdateProfilePayload struct {
 	Profile *MembershipProfile `json:"profile"`
 }
@@ -592,6 +886,16 @@ type UpdateSCIMBridgePayload struct {
 	ScimBridge *SCIMBridge `json:"scimBridge"`
 }
 
+type UpdateSCIMGroupRoleMappingInput struct {
+	OrganizationID         gid.GID                 `json:"organizationId"`
+	ScimGroupRoleMappingID gid.GID                 `json:"scimGroupRoleMappingId"`
+	Role                   coredata.MembershipRole `json:"role"`
+}
+
+type UpdateSCIMGroupRoleMappingPayload struct {
+	ScimGroupRoleMapping *SCIMGroupRoleMapping `json:"scimGroupRoleMapping"`
+}
+
 type VerifyEmailInput struct {
 	Token string `json:"token"`
 }
@@ -600,6 +904,136 @@ type VerifyEmailPayload struct {
 	Success bool `json:"success"`
 }
 
+type VerifyMFAChallengeInput struct {
+	OrganizationID    *gid.GID `json:"organizationId,omitempty"`
+	Token             string   `json:"token"`
+	TotpCode          *string  `json:"totpCode,omitempty"`
+	RecoveryCode      *string  `json:"recoveryCode,omitempty"`
+	WebAuthnAssertion *string  `json:"webAuthnAssertion,omitempty"`
+}
+
+type WebAuthnCredential struct {
+	ID         gid.GID    `json:"id"`
+	Name       string     `json:"name"`
+	LastUsedAt *time.Time `json:"lastUsedAt,omitempty"`
+	CreatedAt  time.Time  `json:"createdAt"`
+}
+
+func (WebAuthnCredential) IsNode()             {}
+func (this WebAuthnCredential) GetID() gid.GID { return this.ID }
+
+type AuthorizationDecision string
+
+const (
+	AuthorizationDecisionAllow   AuthorizationDecision = "ALLOW"
+	AuthorizationDecisionDeny    AuthorizationDecision = "DENY"
+	AuthorizationDecisionNoMatch AuthorizationDecision = "NO_MATCH"
+)
+
+var AllAuthorizationDecision = []AuthorizationDecision{
+	AuthorizationDecisionAllow,
+	AuthorizationDecisionDeny,
+	AuthorizationDecisionNoMatch,
+}
+
+func (e AuthorizationDecision) IsValid() bool {
+	switch e {
+	case AuthorizationDecisionAllow, AuthorizationDecisionDeny, AuthorizationDecisionNoMatch:
+		return true
+	}
+	return false
+}
+
+func (e AuthorizationDecision) String() string {
+	return string(e)
+}
+
+func (e *AuthorizationDecision) UnmarshalGQL(v any) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = AuthorizationDecision(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid AuthorizationDecision", str)
+	}
+	return nil
+}
+
+func (e AuthorizationDecision) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+func (e *AuthorizationDecision) UnmarshalJSON(b []byte) error {
+	s, err := strconv.Unquote(string(b))
+	if err != nil {
+		return err
+	}
+	return e.UnmarshalGQL(s)
+}
+
+func (e AuthorizationDecision) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	e.MarshalGQL(&buf)
+	return buf.Bytes(), nil
+}
+
+type PolicyEffect string
+
+const (
+	PolicyEffectAllow PolicyEffect = "ALLOW"
+	PolicyEffectDeny  PolicyEffect = "DENY"
+)
+
+var AllPolicyEffect = []PolicyEffect{
+	PolicyEffectAllow,
+	PolicyEffectDeny,
+}
+
+func (e PolicyEffect) IsValid() bool {
+	switch e {
+	case PolicyEffectAllow, PolicyEffectDeny:
+		return true
+	}
+	return false
+}
+
+func (e PolicyEffect) String() string {
+	return string(e)
+}
+
+func (e *PolicyEffect) UnmarshalGQL(v any) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = PolicyEffect(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid PolicyEffect", str)
+	}
+	return nil
+}
+
+func (e PolicyEffect) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+func (e *PolicyEffect) UnmarshalJSON(b []byte) error {
+	s, err := strconv.Unquote(string(b))
+	if err != nil {
+		return err
+	}
+	return e.UnmarshalGQL(s)
+}
+
+func (e PolicyEffect) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	e.MarshalGQL(&buf)
+	return buf.Bytes(), nil
+}
+
 type ReauthenticationReason string
 
 const (
@@ -25,6 +25,7 @@ import (
 	"go.probo.inc/probo/pkg/server/api/connect/v1/types"
 	"go.probo.inc/probo/pkg/server/gqlutils"
 	"go.probo.inc/probo/pkg/server/gqlutils/types/cursor"
+	"go.probo.inc/probo/pkg/validator"
 )
 
 // Permission is the resolver for the permission field.
@@ -32,6 +33,53 @@ func (r *connectorResolver) Permission(ctx context.Context, obj *types.Connector
 	return r.Resolver.Permission(ctx, obj, action)
 }
 
+// Organization is the resolver for the organization field.
+func (r *customRoleResolver) Organization(ctx context.Context, obj *types.CustomRole) (*types.Organization, error) {
+	if err := r.authorize(ctx, obj.Organization.ID, iam.ActionOrganizationGet); err != nil {
+		return nil, err
+	}
+
+	if gqlutils.OnlyIDSelected(ctx) {
+		return &types.Organization{
+			ID: obj.Organization.ID,
+		}, nil
+	}
+
+	organization, err := r.iam.OrganizationService.GetOrganization(ctx, obj.Organization.ID)
+	if err != nil {
+		var errOrganizationNotFound *iam.ErrOrganizationNotFound
+		if errors.As(err, &errOrganizationNotFound) {
+			return nil, nil
+		}
+
+		r.logger.ErrorCtx(ctx, "cannot get organization for custom role", log.Error(err))
+		return nil, gqlutils.Internal(ctx)
+	}
+
+	return types.NewOrganization(organization), nil
+}
+
+// Permission is the resolver for the permission field.
+func (r *customRoleResolver) Permission(ctx context.Context, obj *types.CustomRole, action string) (bool, error) {
+	return r.Resolver.Permission(ctx, obj, action)
+}
+
+// TotalCount is the resolver for the totalCount field.
+func (r *customRoleConnectionResolver) TotalCount(ctx context.Context, obj *types.CustomRoleConnection) (*int, error) {
+	switch obj.Resolver.(type) {
+	case *organizationResolver:
+		count, err := r.iam.CustomRoleService.Count(ctx, obj.ParentID)
+		if err != nil {
+			r.logger.ErrorCtx(ctx, "cannot count custom roles", log.Error(err))
+			return nil, gqlutils.Internal(ctx)
+		}
+		return &count, nil
+	}
+
+	r.logger.ErrorCtx(ctx, "unsupported resolver", log.Any("resolver", obj.Resolver))
+	return nil, gqlutils.Internal(ctx)
+}
+
 // Memberships is the resolver for the memberships field.
 func (r *identityResolver) Memberships(ctx context.Context, obj *types.Identity, first *int, after *page.CursorKey, last *int, before *page.CursorKey, orderBy *types.MembershipOrderBy) (*types.MembershipConnection, error) {
 	if err := r.authorize(ctx, obj.ID, iam.ActionMembershipList); err != nil {
@@ -160,6 +208,21 @@ func (r *identityResolver) PersonalAPIKeys(ctx context.Context, obj *types.Ident
 	return types.NewPersonalAPIKeyConnection(page, r, obj.ID), nil
 }
 
+// Mfa is the resolver for the mfa field.
+func (r *identityResolver) Mfa(ctx context.Context, obj *types.Identity) (*types.IdentityMfa, error) {
+	if err := r.authorize(ctx, obj.ID, iam.ActionIdentityMFAGet); err != nil {
+		return nil, err
+	}
+
+	status, err := r.iam.MFAService.GetMFAStatus(ctx, obj.ID)
+	if err != nil {
+		r.logger.ErrorCtx(ctx, "cannot get mfa status", log.Error(err))
+		return nil, gqlutils.Internal(ctx)
+	}
+
+	return types.NewIdentityMfa(status), nil
+}
+
 // Permission is the resolver for the permission field.
 func (r *identityResolver) Permission(ctx context.Context, obj *types.Identity, action string) (bool, error) {
 	return r.Resolver.Permission(ctx, obj, action)
@@ -290,6 +353,25 @@ func (r *membershipResolver) Organization(ctx context.Context, obj *types.Member
 	return types.NewOrganization(organization), nil
 }
 
+// CustomRole is the resolver for the customRole field.
+func (r *membershipResolver) CustomRole(ctx context.Context, obj *types.Membership) (*types.CustomRole, error) {
+	if err := r.authorize(ctx, obj.ID, iam.ActionMembershipGet); err != nil {
+		return nil, err
+	}
+
+	customRole, err := r.iam.CustomRoleService.GetForMembership(ctx, obj.ID)
+	if err != nil {
+		r.logger.ErrorCtx(ctx, "cannot get custom role for membership", log.Error(err))
+		return nil, gqlutils.Internal(ctx)
+	}
+
+	if customRole == nil {
+		return nil, nil
+	}
+
+	return types.NewCustomRole(customRole), nil
+}
+
 // LastSession is the resolver for the lastSession field.
 func (r *membershipResolver) LastSession(ctx context.Context, obj *types.Membership) (*types.Session, error) {
 	if err := r.authorize(ctx, obj.ID, iam.ActionMembershipGet, authz.WithSkipAssumptionCheck()); err != nil {
@@ -426,6 +508,20 @@ func (r *mutationResolver) SignIn(ctx context.Context, input types.SignInInput)
 
 	session := authn.SessionFromContext(ctx)
 
+	if session == nil || session.IdentityID != identity.ID {
+		challenge, err := r.iam.MFAService.BeginChallenge(ctx, identity.ID)
+		if err != nil {
+			r.logger.ErrorCtx(ctx, "cannot begin mfa challenge", log.Error(err))
+			return nil, gqlutils.Internal(ctx)
+		}
+
+		if challenge != nil {
+			return &types.SignInPayload{
+				MfaChallenge: types.NewMFAChallenge(challenge),
+			}, nil
+		}
+	}
+
 	switch {
 	case session == nil:
 		var err error
@@ -463,11 +559,21 @@ func (r *mutationResolver) SignIn(ctx context.Context, input types.SignInInput)
 			// Here session middleware already took care of expired/nil root session so we only handle membership related errors
 			var errMembershipNotFound *iam.ErrMembershipNotFound
 			var errMembershipInactive *iam.ErrMembershipInactive
+			var errMFARequired *iam.ErrMFARequired
 
 			if errors.As(err, &errMembershipNotFound) || errors.As(err, &errMembershipInactive) {
 				return nil, gqlutils.Forbiddenf(ctx, "forbidden")
 			}
 
+			if errors.As(err, &errMFARequired) {
+				return nil, &gqlerror.Error{
+					Message: err.Error(),
+					Extensions: map[string]any{
+						"code": "MFA_REQUIRED",
+					},
+				}
+			}
+
 			r.logger.ErrorCtx(ctx, "cannot assume organization", log.Error(err))
 			return nil, gqlutils.Internal(ctx)
 		}
@@ -479,6 +585,71 @@ func (r *mutationResolver) SignIn(ctx context.Context, input types.SignInInput)
 	}, nil
 }
 
+// VerifyMFAChallenge is the resolver for the verifyMFAChallenge field.
+func (r *mutationResolver) VerifyMFAChallenge(ctx context.Context, input types.VerifyMFAChallengeInput) (*types.SignInPayload, error) {
+	identity, newSession, err := r.iam.MFAService.VerifyChallenge(
+		ctx,
+		&iam.VerifyMFAChallengeRequest{
+			Token:             input.Token,
+			TOTPCode:          input.TotpCode,
+			RecoveryCode:      input.RecoveryCode,
+			WebAuthnAssertion: input.WebAuthnAssertion,
+		},
+	)
+	if err != nil {
+		var (
+			errValidation     validator.ValidationErrors
+			errInvalidToken   *iam.ErrInvalidToken
+			errInvalidMFACode *iam.ErrInvalidMFACode
+		)
+
+		switch {
+		case errors.As(err, &errValidation):
+			return nil, gqlutils.Invalid(ctx, err)
+		case errors.As(err, &errInvalidToken), errors.As(err, &errInvalidMFACode):
+			return nil, &gqlerror.Error{
+				Message: err.Error(),
+				Extensions: map[string]any{
+					"code": "INVALID_MFA_CODE",
+				},
+			}
+		}
+
+		r.logger.ErrorCtx(ctx, "cannot verify mfa challenge", log.Error(err))
+		return nil, gqlutils.Internal(ctx)
+	}
+
+	if session := authn.SessionFromContext(ctx); session != nil {
+		if err := r.iam.SessionService.CloseSession(ctx, session.ID); err != nil {
+			r.logger.ErrorCtx(ctx, "cannot close session", log.Error(err))
+			return nil, gqlutils.Internal(ctx)
+		}
+	}
+
+	w := gqlutils.HTTPResponseWriterFromContext(ctx)
+	r.sessionCookie.Set(w, newSession)
+
+	if input.OrganizationID != nil {
+		_, _, err := r.iam.SessionService.OpenPasswordChildSessionForOrganization(ctx, newSession.ID, *input.OrganizationID)
+		if err != nil {
+			var errMembershipNotFound *iam.ErrMembershipNotFound
+			var errMembershipInactive *iam.ErrMembershipInactive
+
+			if errors.As(err, &errMembershipNotFound) || errors.As(err, &errMembershipInactive) {
+				return nil, gqlutils.Forbiddenf(ctx, "forbidden")
+			}
+
+			r.logger.ErrorCtx(ctx, "cannot assume organization", log.Error(err))
+			return nil, gqlutils.Internal(ctx)
+		}
+	}
+
+	return &types.SignInPayload{
+		Identity: types.NewIdentity(identity),
+		Session:  types.NewSession(newSession),
+	}, nil
+}
+
 // SignUp is the resolver for the signUp field.
 func (r *mutationResolver) SignUp(ctx context.Context, input types.SignUpInput) (*types.SignUpPayload, error) {
 	identity, session, err := r.iam.AuthService.CreateIdentityWithPassword(
@@ -733,6 +904,8 @@ func (r *mutationResolver) AssumeOrganizationSession(ctx context.Context, input
 			errMembershipNotFound         *iam.ErrMembershipNotFound
 			errPasswordRequired           *iam.ErrPasswordRequired
 			errSAMLAuthenticationRequired *iam.ErrSAMLAuthenticationRequired
+			errOIDCAuthenticationRequired *iam.ErrOIDCAuthenticationRequired
+			errMFARequired                *iam.ErrMFARequired
 		)
 
 		switch {
@@ -754,6 +927,21 @@ func (r *mutationResolver) AssumeOrganizationSession(ctx context.Context, input
 				},
 			}, nil
 
+		case errors.As(err, &errOIDCAuthenticationRequired):
+			return &types.AssumeOrganizationSessionPayload{
+				Result: types.OIDCAuthenticationRequired{
+					Reason:      types.ReauthenticationReason(errOIDCAuthenticationRequired.Reason),
+					RedirectURL: errOIDCAuthenticationRequired.RedirectURL,
+				},
+			}, nil
+
+		case errors.As(err, &errMFARequired):
+			return &types.AssumeOrganizationSessionPayload{
+				Result: types.MFARequired{
+					Reason: types.ReauthenticationReasonPolicyRequirement,
+				},
+			}, nil
+
 		default:
 			r.logger.ErrorCtx(ctx, "cannot assume organization session", log.Error(err))
 			return nil, gqlutils.Internal(ctx)
@@ -807,142 +995,450 @@ func (r *mutationResolver) RevokeAllSessions(ctx context.Context) (*types.Revoke
 	return &types.RevokeAllSessionsPayload{RevokedCount: int(revokedCount)}, nil
 }
 
-// CreatePersonalAPIKey is the resolver for the createPersonalAPIKey field.
-func (r *mutationResolver) CreatePersonalAPIKey(ctx context.Context, input types.CreatePersonalAPIKeyInput) (*types.CreatePersonalAPIKeyPayload, error) {
+// BeginTOTPEnrollment is the resolver for the beginTOTPEnrollment field.
+func (r *mutationResolver) BeginTOTPEnrollment(ctx context.Context) (*types.BeginTOTPEnrollmentPayload, error) {
+	// Second factors protect interactive sessions and must not be managed
+	// with a credential that bypasses them.
+	if authn.APIKeyFromContext(ctx) != nil {
+		return nil, gqlutils.Forbiddenf(ctx, "api key authentication cannot be used to manage multi-factor authentication")
+	}
+
 	identity := authn.IdentityFromContext(ctx)
 
-	if err := r.authorize(ctx, identity.ID, iam.ActionPersonalAPIKeyCreate); err != nil {
+	if err := r.authorize(ctx, identity.ID, iam.ActionIdentityMFAUpdate); err != nil {
 		return nil, err
 	}
 
-	userAPIKey, token, err := r.iam.AccountService.CreatePersonalAPIKey(
-		ctx,
-		identity.ID,
-		input.Name,
-		input.ExpiresAt,
-	)
+	enrollment, err := r.iam.MFAService.BeginTOTPEnrollment(ctx, identity.ID)
 	if err != nil {
-		r.logger.ErrorCtx(ctx, "cannot create personal api key", log.Error(err))
+		var errTOTPAlreadyEnrolled *iam.ErrTOTPAlreadyEnrolled
+		if errors.As(err, &errTOTPAlreadyEnrolled) {
+			return nil, gqlutils.Conflict(ctx, err)
+		}
+
+		r.logger.ErrorCtx(ctx, "cannot begin totp enrollment", log.Error(err))
 		return nil, gqlutils.Internal(ctx)
 	}
 
-	return &types.CreatePersonalAPIKeyPayload{
-		PersonalAPIKeyEdge: types.NewPersonalAPIKeyEdge(userAPIKey, coredata.PersonalAPIKeyOrderFieldCreatedAt),
-		Token:              token,
+	return &types.BeginTOTPEnrollmentPayload{
+		Secret:          enrollment.Secret,
+		ProvisioningURI: enrollment.ProvisioningURI,
 	}, nil
 }
 
-// RevokePersonalAPIKey is the resolver for the revokePersonalAPIKey field.
-func (r *mutationResolver) RevokePersonalAPIKey(ctx context.Context, input types.RevokePersonalAPIKeyInput) (*types.RevokePersonalAPIKeyPayload, error) {
-	if err := r.authorize(ctx, input.PersonalAPIKeyID, iam.ActionPersonalAPIKeyDelete); err != nil {
-		return nil, err
+// ConfirmTOTPEnrollment is the resolver for the confirmTOTPEnrollment field.
+func (r *mutationResolver) ConfirmTOTPEnrollment(ctx context.Context, input types.ConfirmTOTPEnrollmentInput) (*types.ConfirmTOTPEnrollmentPayload, error) {
+	if authn.APIKeyFromContext(ctx) != nil {
+		return nil, gqlutils.Forbiddenf(ctx, "api key authentication cannot be used to manage multi-factor authentication")
 	}
 
-	identity := authn.IdentityFromContext(ctx)
-
-	err := r.iam.AccountService.DeletePersonalAPIKey(ctx, identity.ID, input.PersonalAPIKeyID)
-	if err != nil {
-		r.logger.ErrorCtx(ctx, "cannot delete personal api key", log.Error(err))
-		return nil, gqlutils.Internal(ctx)
+	session := authn.SessionFromContext(ctx)
+	if session == nil {
+		return nil, gqlutils.Unauthenticatedf(ctx, "a session is required to manage multi-factor authentication")
 	}
 
-	return &types.RevokePersonalAPIKeyPayload{PersonalAPIKeyID: input.PersonalAPIKeyID}, nil
-}
-
-// CreateOrganization is the resolver for the createOrganization field.
-func (r *mutationResolver) CreateOrganization(ctx context.Context, input types.CreateOrganizationInput) (*types.CreateOrganizationPayload, error) {
 	identity := authn.IdentityFromContext(ctx)
 
-	// FIXME check email domain and related IDP config
-	// if ok := r.authorize(ctx, identity.ID, iam.ActionOrganizationCreate); !ok {
-	// 	return nil, nil
-	// }
-
-	var (
-		logoFile           *iam.UploadedFile
-		horizontalLogoFile *iam.UploadedFile
-	)
-
-	if input.LogoFile != nil {
-		logoFile = &iam.UploadedFile{
-			Content:     input.LogoFile.File,
-			Filename:    input.LogoFile.Filename,
-			ContentType: input.LogoFile.ContentType,
-			Size:        input.LogoFile.Size,
-		}
+	if err := r.authorize(ctx, identity.ID, iam.ActionIdentityMFAUpdate); err != nil {
+		return nil, err
 	}
 
-	if input.HorizontalLogoFile != nil {
-		horizontalLogoFile = &iam.UploadedFile{
-			Content:     input.HorizontalLogoFile.File,
-			Filename:    input.HorizontalLogoFile.Filename,
-			ContentType: input.HorizontalLogoFile.ContentType,
-			Size:        input.HorizontalLogoFile.Size,
-		}
-	}
-	organization, membership, err := r.iam.OrganizationService.CreateOrganization(
+	recoveryCodes, err := r.iam.MFAService.ConfirmTOTPEnrollment(
 		ctx,
+		session.ID,
 		identity.ID,
-		&iam.CreateOrganizationRequest{
-			Name:               input.Name,
-			LogoFile:           logoFile,
-			HorizontalLogoFile: horizontalLogoFile,
-		},
+		input.Code,
 	)
 	if err != nil {
-		r.logger.ErrorCtx(ctx, "cannot create organization", log.Error(err))
+		var (
+			errTOTPAlreadyEnrolled *iam.ErrTOTPAlreadyEnrolled
+			errTOTPNotEnrolled     *iam.ErrTOTPNotEnrolled
+			errInvalidMFACode      *iam.ErrInvalidMFACode
+		)
+
+		switch {
+		case errors.As(err, &errTOTPAlreadyEnrolled):
+			return nil, gqlutils.Conflict(ctx, err)
+		case errors.As(err, &errTOTPNotEnrolled):
+			return nil, gqlutils.NotFound(ctx, err)
+		case errors.As(err, &errInvalidMFACode):
+			return nil, gqlutils.Invalid(ctx, err)
+		}
+
+		r.logger.ErrorCtx(ctx, "cannot confirm totp enrollment", log.Error(err))
 		return nil, gqlutils.Internal(ctx)
 	}
 
-	return &types.CreateOrganizationPayload{
-		Organization:   types.NewOrganization(organization),
-		MembershipEdge: types.NewMembershipEdge(membership, coredata.MembershipOrderFieldCreatedAt),
+	if recoveryCodes == nil {
+		recoveryCodes = []string{}
+	}
+
+	return &types.ConfirmTOTPEnrollmentPayload{
+		RecoveryCodes: recoveryCodes,
 	}, nil
 }
 
-// UpdateOrganization is the resolver for the updateOrganization field.
-func (r *mutationResolver) UpdateOrganization(ctx context.Context, input types.UpdateOrganizationInput) (*types.UpdateOrganizationPayload, error) {
-	if err := r.authorize(ctx, input.OrganizationID, iam.ActionOrganizationUpdate); err != nil {
-		return nil, err
+// DisableTotp is the resolver for the disableTOTP field.
+func (r *mutationResolver) DisableTotp(ctx context.Context) (*types.DisableTOTPPayload, error) {
+	if authn.APIKeyFromContext(ctx) != nil {
+		return nil, gqlutils.Forbiddenf(ctx, "api key authentication cannot be used to manage multi-factor authentication")
 	}
 
-	req := &iam.UpdateOrganizationRequest{
-		Name:               input.Name,
-		Description:        gqlutils.UnwrapOmittable(input.Description),
-		WebsiteURL:         gqlutils.UnwrapOmittable(input.WebsiteURL),
-		Email:              gqlutils.UnwrapOmittable(input.Email),
-		HeadquarterAddress: gqlutils.UnwrapOmittable(input.HeadquarterAddress),
+	session := authn.SessionFromContext(ctx)
+	if session == nil {
+		return nil, gqlutils.Unauthenticatedf(ctx, "a session is required to manage multi-factor authentication")
 	}
 
-	if input.LogoFile != nil {
-		req.LogoFile = &iam.UploadedFile{
-			Filename:    input.LogoFile.Filename,
-			ContentType: input.LogoFile.ContentType,
-			Size:        input.LogoFile.Size,
-			Content:     input.LogoFile.File,
-		}
+	identity := authn.IdentityFromContext(ctx)
+
+	if err := r.authorize(ctx, identity.ID, iam.ActionIdentityMFAUpdate); err != nil {
+		return nil, err
 	}
 
-	if input.HorizontalLogoFile != nil {
-		req.HorizontalLogoFile = &iam.UploadedFile{
-			Filename:    input.HorizontalLogoFile.Filename,
-			ContentType: input.HorizontalLogoFile.ContentType,
-			Size:        input.HorizontalLogoFile.Size,
-			Content:     input.HorizontalLogoFile.File,
+	if err := r.iam.MFAService.DisableTOTP(ctx, session.ID, identity.ID); err != nil {
+		var (
+			errTOTPNotEnrolled *iam.ErrTOTPNotEnrolled
+			errMFARequired     *iam.ErrMFARequired
+		)
+
+		if errors.As(err, &errTOTPNotEnrolled) {
+			return nil, gqlutils.NotFound(ctx, err)
 		}
-	}
 
-	organization, err := r.iam.OrganizationService.UpdateOrganization(
-		ctx,
-		input.OrganizationID,
-		req,
-	)
-	if err != nil {
-		r.logger.ErrorCtx(ctx, "cannot update organization", log.Error(err))
+		if errors.As(err, &errMFARequired) {
+			return nil, &gqlerror.Error{
+				Message: err.Error(),
+				Extensions: map[string]any{
+					"code": "MFA_REQUIRED",
+				},
+			}
+		}
+
+		r.logger.ErrorCtx(ctx, "cannot disable totp", log.Error(err))
 		return nil, gqlutils.Internal(ctx)
 	}
 
-	return &types.UpdateOrganizationPayload{
+	return &types.DisableTOTPPayload{Success: true}, nil
+}
+
+// RegenerateRecoveryCodes is the resolver for the regenerateRecoveryCodes field.
+func (r *mutationResolver) RegenerateRecoveryCodes(ctx context.Context) (*types.RegenerateRecoveryCodesPayload, error) {
+	if authn.APIKeyFromContext(ctx) != nil {
+		return nil, gqlutils.Forbiddenf(ctx, "api key authentication cannot be used to manage multi-factor authentication")
+	}
+
+	session := authn.SessionFromContext(ctx)
+	if session == nil {
+		return nil, gqlutils.Unauthenticatedf(ctx, "a session is required to manage multi-factor authentication")
+	}
+
+	identity := authn.IdentityFromContext(ctx)
+
+	if err := r.authorize(ctx, identity.ID, iam.ActionIdentityMFAUpdate); err != nil {
+		return nil, err
+	}
+
+	recoveryCodes, err := r.iam.MFAService.RegenerateRecoveryCodes(ctx, session.ID, identity.ID)
+	if err != nil {
+		var (
+			errMFANotEnrolled *iam.ErrMFANotEnrolled
+			errMFARequired    *iam.ErrMFARequired
+		)
+
+		if errors.As(err, &errMFANotEnrolled) {
+			return nil, gqlutils.Invalid(ctx, err)
+		}
+
+		if errors.As(err, &errMFARequired) {
+			return nil, &gqlerror.Error{
+				Message: err.Error(),
+				Extensions: map[string]any{
+					"code": "MFA_REQUIRED",
+				},
+			}
+		}
+
+		r.logger.ErrorCtx(ctx, "cannot regenerate recovery codes", log.Error(err))
+		return nil, gqlutils.Internal(ctx)
+	}
+
+	return &types.RegenerateRecoveryCodesPayload{
+		RecoveryCodes: recoveryCodes,
+	}, nil
+}
+
+// BeginWebAuthnRegistration is the resolver for the beginWebAuthnRegistration field.
+func (r *mutationResolver) BeginWebAuthnRegistration(ctx context.Context) (*types.BeginWebAuthnRegistrationPayload, error) {
+	if authn.APIKeyFromContext(ctx) != nil {
+		return nil, gqlutils.Forbiddenf(ctx, "api key authentication cannot be used to manage multi-factor authentication")
+	}
+
+	identity := authn.IdentityFromContext(ctx)
+
+	if err := r.authorize(ctx, identity.ID, iam.ActionIdentityMFAUpdate); err != nil {
+		return nil, err
+	}
+
+	registration, err := r.iam.MFAService.BeginWebAuthnRegistration(ctx, identity.ID)
+	if err != nil {
+		r.logger.ErrorCtx(ctx, "cannot begin webauthn registration", log.Error(err))
+		return nil, gqlutils.Internal(ctx)
+	}
+
+	return &types.BeginWebAuthnRegistrationPayload{
+		Token:   registration.Token,
+		Options: string(registration.Options),
+	}, nil
+}
+
+// FinishWebAuthnRegistration is the resolver for the finishWebAuthnRegistration field.
+func (r *mutationResolver) FinishWebAuthnRegistration(ctx context.Context, input types.FinishWebAuthnRegistrationInput) (*types.FinishWebAuthnRegistrationPayload, error) {
+	if authn.APIKeyFromContext(ctx) != nil {
+		return nil, gqlutils.Forbiddenf(ctx, "api key authentication cannot be used to manage multi-factor authentication")
+	}
+
+	session := authn.SessionFromContext(ctx)
+	if session == nil {
+		return nil, gqlutils.Unauthenticatedf(ctx, "a session is required to manage multi-factor authentication")
+	}
+
+	identity := authn.IdentityFromContext(ctx)
+
+	if err := r.authorize(ctx, identity.ID, iam.ActionIdentityMFAUpdate); err != nil {
+		return nil, err
+	}
+
+	credential, recoveryCodes, err := r.iam.MFAService.FinishWebAuthnRegistration(
+		ctx,
+		session.ID,
+		identity.ID,
+		&iam.FinishWebAuthnRegistrationRequest{
+			Token:    input.Token,
+			Name:     input.Name,
+			Response: input.Response,
+		},
+	)
+	if err != nil {
+		var (
+			errValidation              validator.ValidationErrors
+			errInvalidToken            *iam.ErrInvalidToken
+			errInvalidMFACode          *iam.ErrInvalidMFACode
+			errCredentialAlreadyExists *iam.ErrWebAuthnCredentialAlreadyExists
+		)
+
+		switch {
+		case errors.As(err, &errValidation),
+			errors.As(err, &errInvalidToken),
+			errors.As(err, &errInvalidMFACode):
+			return nil, gqlutils.Invalid(ctx, err)
+		case errors.As(err, &errCredentialAlreadyExists):
+			return nil, gqlutils.Conflict(ctx, err)
+		}
+
+		r.logger.ErrorCtx(ctx, "cannot finish webauthn registration", log.Error(err))
+		return nil, gqlutils.Internal(ctx)
+	}
+
+	if recoveryCodes == nil {
+		recoveryCodes = []string{}
+	}
+
+	return &types.FinishWebAuthnRegistrationPayload{
+		WebAuthnCredential: types.NewWebAuthnCredential(credential),
+		RecoveryCodes:      recoveryCodes,
+	}, nil
+}
+
+// DeleteWebAuthnCredential is the resolver for the deleteWebAuthnCredential field.
+func (r *mutationResolver) DeleteWebAuthnCredential(ctx context.Context, input types.DeleteWebAuthnCredentialInput) (*types.DeleteWebAuthnCredentialPayload, error) {
+	if authn.APIKeyFromContext(ctx) != nil {
+		return nil, gqlutils.Forbiddenf(ctx, "api key authentication cannot be used to manage multi-factor authentication")
+	}
+
+	session := authn.SessionFromContext(ctx)
+	if session == nil {
+		return nil, gqlutils.Unauthenticatedf(ctx, "a session is required to manage multi-factor authentication")
+	}
+
+	if err := r.authorize(ctx, input.WebAuthnCredentialID, iam.ActionWebAuthnCredentialDelete); err != nil {
+		return nil, err
+	}
+
+	if err := r.iam.MFAService.DeleteWebAuthnCredential(ctx, session.ID, input.WebAuthnCredentialID); err != nil {
+		var (
+			errCredentialNotFound *iam.ErrWebAuthnCredentialNotFound
+			errMFARequired        *iam.ErrMFARequired
+		)
+
+		if errors.As(err, &errCredentialNotFound) {
+			return nil, gqlutils.NotFound(ctx, err)
+		}
+
+		if errors.As(err, &errMFARequired) {
+			return nil, &gqlerror.Error{
+				Message: err.Error(),
+				Extensions: map[string]any{
+					"code": "MFA_REQUIRED",
+				},
+			}
+		}
+
+		r.logger.ErrorCtx(ctx, "cannot delete webauthn credential", log.Error(err))
+		return nil, gqlutils.Internal(ctx)
+	}
+
+	return &types.DeleteWebAuthnCredentialPayload{
+		DeletedWebAuthnCredentialID: input.WebAuthnCredentialID,
+	}, nil
+}
+
+// CreatePersonalAPIKey is the resolver for the createPersonalAPIKey field.
+func (r *mutationResolver) CreatePersonalAPIKey(ctx context.Context, input types.CreatePersonalAPIKeyInput) (*types.CreatePersonalAPIKeyPayload, error) {
+	// API key custom roles only restrict organization resources, so a
+	// restricted key must not be able to mint an unrestricted one.
+	if authn.APIKeyFromContext(ctx) != nil {
+		return nil, gqlutils.Forbiddenf(ctx, "api key authentication cannot be used to manage api keys")
+	}
+
+	identity := authn.IdentityFromContext(ctx)
+
+	if err := r.authorize(ctx, identity.ID, iam.ActionPersonalAPIKeyCreate); err != nil {
+		return nil, err
+	}
+
+	userAPIKey, token, err := r.iam.AccountService.CreatePersonalAPIKey(
+		ctx,
+		identity.ID,
+		input.Name,
+		input.ExpiresAt,
+	)
+	if err != nil {
+		r.logger.ErrorCtx(ctx, "cannot create personal api key", log.Error(err))
+		return nil, gqlutils.Internal(ctx)
+	}
+
+	return &types.CreatePersonalAPIKeyPayload{
+		PersonalAPIKeyEdge: types.NewPersonalAPIKeyEdge(userAPIKey, coredata.PersonalAPIKeyOrderFieldCreatedAt),
+		Token:              token,
+	}, nil
+}
+
+// RevokePersonalAPIKey is the resolver for the revokePersonalAPIKey field.
+func (r *mutationResolver) RevokePersonalAPIKey(ctx context.Context, input types.RevokePersonalAPIKeyInput) (*types.RevokePersonalAPIKeyPayload, error) {
+	if authn.APIKeyFromContext(ctx) != nil {
+		return nil, gqlutils.Forbiddenf(ctx, "api key authentication cannot be used to manage api keys")
+	}
+
+	if err := r.authorize(ctx, input.PersonalAPIKeyID, iam.ActionPersonalAPIKeyDelete); err != nil {
+		return nil, err
+	}
+
+	identity := authn.IdentityFromContext(ctx)
+
+	err := r.iam.AccountService.DeletePersonalAPIKey(ctx, identity.ID, input.PersonalAPIKeyID)
+	if err != nil {
+		r.logger.ErrorCtx(ctx, "cannot delete personal api key", log.Error(err))
+		return nil, gqlutils.Internal(ctx)
+	}
+
+	return &types.RevokePersonalAPIKeyPayload{PersonalAPIKeyID: input.PersonalAPIKeyID}, nil
+}
+
+// CreateOrganization is the resolver for the createOrganization field.
+func (r *mutationResolver) CreateOrganization(ctx context.Context, input types.CreateOrganizationInput) (*types.CreateOrganizationPayload, error) {
+	identity := authn.IdentityFromContext(ctx)
+
+	// FIXME check email domain and related IDP config
+	// if ok := r.authorize(ctx, identity.ID, iam.ActionOrganizationCreate); !ok {
+	// 	return nil, nil
+	// }
+
+	var (
+		logoFile           *iam.UploadedFile
+		horizontalLogoFile *iam.UploadedFile
+	)
+
+	if input.LogoFile != nil {
+		logoFile = &iam.UploadedFile{
+			Content:     input.LogoFile.File,
+			Filename:    input.LogoFile.Filename,
+			ContentType: input.LogoFile.ContentType,
+			Size:        input.LogoFile.Size,
+		}
+	}
+
+	if input.HorizontalLogoFile != nil {
+		horizontalLogoFile = &iam.UploadedFile{
+			Content:     input.HorizontalLogoFile.File,
+			Filename:    input.HorizontalLogoFile.Filename,
+			ContentType: input.HorizontalLogoFile.ContentType,
+			Size:        input.HorizontalLogoFile.Size,
+		}
+	}
+	organization, membership, err := r.iam.OrganizationService.CreateOrganization(
+		ctx,
+		identity.ID,
+		&iam.CreateOrganizationRequest{
+			Name:               input.Name,
+			LogoFile:           logoFile,
+			HorizontalLogoFile: horizontalLogoFile,
+		},
+	)
+	if err != nil {
+		r.logger.ErrorCtx(ctx, "cannot create organization", log.Error(err))
+		return nil, gqlutils.Internal(ctx)
+	}
+
+	return &types.CreateOrganizationPayload{
+		Organization:   types.NewOrganization(organization),
+		MembershipEdge: types.NewMembershipEdge(membership, coredata.MembershipOrderFieldCreatedAt),
+	}, nil
+}
+
+// UpdateOrganization is the resolver for the updateOrganization field.
+func (r *mutationResolver) UpdateOrganization(ctx context.Context, input types.UpdateOrganizationInput) (*types.UpdateOrganizationPayload, error) {
+	if err := r.authorize(ctx, input.OrganizationID, iam.ActionOrganizationUpdate); err != nil {
+		return nil, err
+	}
+
+	req := &iam.UpdateOrganizationRequest{
+		Name:               input.Name,
+		Description:        gqlutils.UnwrapOmittable(input.Description),
+		WebsiteURL:         gqlutils.UnwrapOmittable(input.WebsiteURL),
+		Email:              gqlutils.UnwrapOmittable(input.Email),
+		HeadquarterAddress: gqlutils.UnwrapOmittable(input.HeadquarterAddress),
+		MFARequired:        input.MfaRequired,
+	}
+
+	if input.LogoFile != nil {
+		req.LogoFile = &iam.UploadedFile{
+			Filename:    input.LogoFile.Filename,
+			ContentType: input.LogoFile.ContentType,
+			Size:        input.LogoFile.Size,
+			Content:     input.LogoFile.File,
+		}
+	}
+
+	if input.HorizontalLogoFile != nil {
+		req.HorizontalLogoFile = &iam.UploadedFile{
+			Filename:    input.HorizontalLogoFile.Filename,
+			ContentType: input.HorizontalLogoFile.ContentType,
+			Size:        input.HorizontalLogoFile.Size,
+			Content:     input.HorizontalLogoFile.File,
+		}
+	}
+
+	organization, err := r.iam.OrganizationService.UpdateOrganization(
+		ctx,
+		input.OrganizationID,
+		req,
+	)
+	if err != nil {
+		r.logger.ErrorCtx(ctx, "cannot update organization", log.Error(err))
+		return nil, gqlutils.Internal(ctx)
+	}
+
+	return &types.UpdateOrganizationPayload{
 		Organization: &types.Organization{
 			ID:                 organization.ID,
 			Name:               organization.Name,
@@ -950,6 +1446,7 @@ func (r *mutationResolver) UpdateOrganization(ctx context.Context, input types.U
 			WebsiteURL:         organization.WebsiteURL,
 			Email:              organization.Email,
 			HeadquarterAddress: organization.HeadquarterAddress,
+			MfaRequired:        organization.MFARequired,
 			CreatedAt:          organization.CreatedAt,
 			UpdatedAt:          organization.UpdatedAt,
 		},
@@ -1066,6 +1563,29 @@ func (r *mutationResolver) UpdateProfile(ctx context.Context, input types.Update
 	}, nil
 }
 
+// UpdateProfileNotificationPreferences is the resolver for the updateProfileNotificationPreferences field.
+func (r *mutationResolver) UpdateProfileNotificationPreferences(ctx context.Context, input types.UpdateProfileNotificationPreferencesInput) (*types.UpdateProfileNotificationPreferencesPayload, error) {
+	if err := r.authorize(ctx, input.ID, iam.ActionMembershipProfileNotificationPreferencesUpdate); err != nil {
+		return nil, err
+	}
+
+	profile, err := r.iam.OrganizationService.UpdateProfileNotificationPreferences(
+		ctx,
+		&iam.UpdateProfileNotificationPreferencesRequest{
+			ID:                  input.ID,
+			WeeklyDigestEnabled: input.WeeklyDigestEnabled,
+		},
+	)
+	if err != nil {
+		r.logger.ErrorCtx(ctx, "cannot update profile notification preferences", log.Error(err))
+		return nil, gqlutils.Internal(ctx)
+	}
+
+	return &types.UpdateProfileNotificationPreferencesPayload{
+		Profile: types.NewMembershipProfile(profile),
+	}, nil
+}
+
 // UpdateMembership is the resolver for the updateMembership field.
 func (r *mutationResolver) UpdateMembership(ctx context.Context, input types.UpdateMembershipInput) (*types.UpdateMembershipPayload, error) {
 	if err := r.authorize(ctx, input.MembershipID, iam.ActionMembershipUpdate); err != nil {
@@ -1212,108 +1732,519 @@ func (r *mutationResolver) UpdateSAMLConfiguration(ctx context.Context, input ty
 		return nil, gqlutils.Internal(ctx)
 	}
 
-	return &types.UpdateSAMLConfigurationPayload{
-		SamlConfiguration: types.NewSAMLConfiguration(samlConfiguration),
+	return &types.UpdateSAMLConfigurationPayload{
+		SamlConfiguration: types.NewSAMLConfiguration(samlConfiguration),
+	}, nil
+}
+
+// DeleteSAMLConfiguration is the resolver for the deleteSAMLConfiguration field.
+func (r *mutationResolver) DeleteSAMLConfiguration(ctx context.Context, input types.DeleteSAMLConfigurationInput) (*types.DeleteSAMLConfigurationPayload, error) {
+	if err := r.authorize(ctx, input.OrganizationID, iam.ActionSAMLConfigurationDelete); err != nil {
+		return nil, err
+	}
+
+	err := r.iam.OrganizationService.DeleteSAMLConfiguration(ctx, input.OrganizationID, input.SamlConfigurationID)
+	if err != nil {
+		r.logger.ErrorCtx(ctx, "cannot delete saml configuration", log.Error(err))
+		return nil, gqlutils.Internal(ctx)
+	}
+
+	return &types.DeleteSAMLConfigurationPayload{DeletedSamlConfigurationID: input.SamlConfigurationID}, nil
+}
+
+// CreateOIDCConfiguration is the resolver for the createOIDCConfiguration field.
+func (r *mutationResolver) CreateOIDCConfiguration(ctx context.Context, input types.CreateOIDCConfigurationInput) (*types.CreateOIDCConfigurationPayload, error) {
+	if err := r.authorize(ctx, input.OrganizationID, iam.ActionOIDCConfigurationCreate); err != nil {
+		return nil, err
+	}
+
+	oidcConfiguration, err := r.iam.OrganizationService.CreateOIDCConfiguration(
+		ctx,
+		input.OrganizationID,
+		&iam.CreateOIDCConfigurationRequest{
+			EmailDomain:       input.EmailDomain,
+			IssuerURL:         input.IssuerURL,
+			ClientID:          input.ClientID,
+			ClientSecret:      input.ClientSecret,
+			AutoSignupEnabled: input.AutoSignupEnabled,
+		},
+	)
+	if err != nil {
+		var (
+			errValidation                                validator.ValidationErrors
+			errOIDCConfigurationEmailDomainAlreadyExists *iam.ErrOIDCConfigurationEmailDomainAlreadyExists
+		)
+
+		if errors.As(err, &errValidation) {
+			return nil, gqlutils.Invalid(ctx, errValidation)
+		}
+
+		if errors.As(err, &errOIDCConfigurationEmailDomainAlreadyExists) {
+			return nil, gqlutils.Conflict(ctx, err)
+		}
+
+		r.logger.ErrorCtx(ctx, "cannot create oidc configuration", log.Error(err))
+		return nil, gqlutils.Internal(ctx)
+	}
+
+	return &types.CreateOIDCConfigurationPayload{
+		OidcConfigurationEdge: types.NewOIDCConfigurationEdge(
+			oidcConfiguration,
+			coredata.OIDCConfigurationOrderFieldCreatedAt,
+		),
+	}, nil
+}
+
+// UpdateOIDCConfiguration is the resolver for the updateOIDCConfiguration field.
+func (r *mutationResolver) UpdateOIDCConfiguration(ctx context.Context, input types.UpdateOIDCConfigurationInput) (*types.UpdateOIDCConfigurationPayload, error) {
+	if err := r.authorize(ctx, input.OidcConfigurationID, iam.ActionOIDCConfigurationUpdate); err != nil {
+		return nil, err
+	}
+
+	oidcConfiguration, err := r.iam.OrganizationService.UpdateOIDCConfiguration(
+		ctx,
+		input.OrganizationID,
+		input.OidcConfigurationID,
+		&iam.UpdateOIDCConfigurationRequest{
+			EnforcementPolicy: input.EnforcementPolicy,
+			IssuerURL:         input.IssuerURL,
+			ClientID:          input.ClientID,
+			ClientSecret:      input.ClientSecret,
+			AutoSignupEnabled: input.AutoSignupEnabled,
+		},
+	)
+	if err != nil {
+		var (
+			errValidation                         validator.ValidationErrors
+			errOIDCConfigurationNotFound          *iam.ErrOIDCConfigurationNotFound
+			errOIDCConfigurationDomainNotVerified *iam.ErrOIDCConfigurationDomainNotVerified
+		)
+
+		if errors.As(err, &errValidation) {
+			return nil, gqlutils.Invalid(ctx, errValidation)
+		}
+
+		if errors.As(err, &errOIDCConfigurationNotFound) {
+			return nil, gqlutils.NotFound(ctx, err)
+		}
+
+		if errors.As(err, &errOIDCConfigurationDomainNotVerified) {
+			return nil, gqlutils.Invalid(ctx, err)
+		}
+
+		r.logger.ErrorCtx(ctx, "cannot update oidc configuration", log.Error(err))
+		return nil, gqlutils.Internal(ctx)
+	}
+
+	return &types.UpdateOIDCConfigurationPayload{
+		OidcConfiguration: types.NewOIDCConfiguration(oidcConfiguration),
+	}, nil
+}
+
+// DeleteOIDCConfiguration is the resolver for the deleteOIDCConfiguration field.
+func (r *mutationResolver) DeleteOIDCConfiguration(ctx context.Context, input types.DeleteOIDCConfigurationInput) (*types.DeleteOIDCConfigurationPayload, error) {
+	if err := r.authorize(ctx, input.OidcConfigurationID, iam.ActionOIDCConfigurationDelete); err != nil {
+		return nil, err
+	}
+
+	err := r.iam.OrganizationService.DeleteOIDCConfiguration(ctx, input.OrganizationID, input.OidcConfigurationID)
+	if err != nil {
+		var errOIDCConfigurationNotFound *iam.ErrOIDCConfigurationNotFound
+		if errors.As(err, &errOIDCConfigurationNotFound) {
+			return nil, gqlutils.NotFound(ctx, err)
+		}
+
+		r.logger.ErrorCtx(ctx, "cannot delete oidc configuration", log.Error(err))
+		return nil, gqlutils.Internal(ctx)
+	}
+
+	return &types.DeleteOIDCConfigurationPayload{DeletedOidcConfigurationID: input.OidcConfigurationID}, nil
+}
+
+// CreateSCIMConfiguration is the resolver for the createSCIMConfiguration field.
+func (r *mutationResolver) CreateSCIMConfiguration(ctx context.Context, input types.CreateSCIMConfigurationInput) (*types.CreateSCIMConfigurationPayload, error) {
+	if err := r.authorize(ctx, input.OrganizationID, iam.ActionSCIMConfigurationCreate); err != nil {
+		return nil, err
+	}
+
+	config, token, err := r.iam.OrganizationService.CreateSCIMConfiguration(ctx, input.OrganizationID)
+	if err != nil {
+		r.logger.ErrorCtx(ctx, "cannot create scim configuration", log.Error(err))
+		return nil, gqlutils.Internal(ctx)
+	}
+
+	var bridge *types.SCIMBridge
+
+	if input.ConnectorID != nil {
+		scimBridge, err := r.iam.OrganizationService.CreateSCIMBridge(ctx, input.OrganizationID, config.ID, *input.ConnectorID)
+		if err != nil {
+			r.logger.ErrorCtx(ctx, "cannot create scim bridge", log.Error(err))
+			return nil, gqlutils.Internal(ctx)
+		}
+		bridge = types.NewSCIMBridge(scimBridge)
+	}
+
+	payload := &types.CreateSCIMConfigurationPayload{
+		ScimConfiguration: types.NewSCIMConfiguration(config),
+		ScimBridge:        bridge,
+		Token:             token,
+	}
+
+	return payload, nil
+}
+
+// DeleteSCIMConfiguration is the resolver for the deleteSCIMConfiguration field.
+func (r *mutationResolver) DeleteSCIMConfiguration(ctx context.Context, input types.DeleteSCIMConfigurationInput) (*types.DeleteSCIMConfigurationPayload, error) {
+	if err := r.authorize(ctx, input.OrganizationID, iam.ActionSCIMConfigurationDelete); err != nil {
+		return nil, err
+	}
+
+	err := r.iam.OrganizationService.DeleteSCIMConfiguration(ctx, input.OrganizationID, input.ScimConfigurationID)
+	if err != nil {
+		r.logger.ErrorCtx(ctx, "cannot delete scim configuration", log.Error(err))
+		return nil, gqlutils.Internal(ctx)
+	}
+
+	return &types.DeleteSCIMConfigurationPayload{DeletedScimConfigurationID: input.ScimConfigurationID}, nil
+}
+
+// RegenerateSCIMToken is the resolver for the regenerateSCIMToken field.
+func (r *mutationResolver) RegenerateSCIMToken(ctx context.Context, input types.RegenerateSCIMTokenInput) (*types.RegenerateSCIMTokenPayload, error) {
+	if err := r.authorize(ctx, input.ScimConfigurationID, iam.ActionSCIMConfigurationUpdate); err != nil {
+		return nil, err
+	}
+
+	config, token, err := r.iam.OrganizationService.RegenerateSCIMToken(ctx, input.OrganizationID, input.ScimConfigurationID)
+	if err != nil {
+		r.logger.ErrorCtx(ctx, "cannot regenerate scim token", log.Error(err))
+		return nil, gqlutils.Internal(ctx)
+	}
+
+	return &types.RegenerateSCIMTokenPayload{
+		ScimConfiguration: types.NewSCIMConfiguration(config),
+		Token:             token,
+	}, nil
+}
+
+// UpdateSCIMBridge is the resolver for the updateSCIMBridge field.
+func (r *mutationResolver) UpdateSCIMBridge(ctx context.Context, input types.UpdateSCIMBridgeInput) (*types.UpdateSCIMBridgePayload, error) {
+	if err := r.authorize(ctx, input.ScimBridgeID, iam.ActionSCIMBridgeUpdate); err != nil {
+		return nil, err
+	}
+
+	bridge, err := r.iam.OrganizationService.UpdateSCIMBridge(ctx, input.OrganizationID, input.ScimBridgeID, input.ExcludedUserNames)
+	if err != nil {
+		r.logger.ErrorCtx(ctx, "cannot update scim bridge excluded user names", log.Error(err))
+		return nil, gqlutils.Internal(ctx)
+	}
+
+	return &types.UpdateSCIMBridgePayload{
+		ScimBridge: types.NewSCIMBridge(bridge),
+	}, nil
+}
+
+// CreateSCIMGroupRoleMapping is the resolver for the createSCIMGroupRoleMapping field.
+func (r *mutationResolver) CreateSCIMGroupRoleMapping(ctx context.Context, input types.CreateSCIMGroupRoleMappingInput) (*types.CreateSCIMGroupRoleMappingPayload, error) {
+	if err := r.authorize(ctx, input.OrganizationID, iam.ActionSCIMGroupRoleMappingCreate); err != nil {
+		return nil, err
+	}
+
+	mapping, err := r.iam.OrganizationService.CreateSCIMGroupRoleMapping(
+		ctx,
+		input.OrganizationID,
+		&iam.CreateSCIMGroupRoleMappingRequest{
+			GroupDisplayName: input.GroupDisplayName,
+			Role:             input.Role,
+		},
+	)
+	if err != nil {
+		var (
+			errValidation                        validator.ValidationErrors
+			errSCIMGroupRoleMappingAlreadyExists *iam.ErrSCIMGroupRoleMappingAlreadyExists
+		)
+
+		if errors.As(err, &errValidation) {
+			return nil, gqlutils.Invalid(ctx, errValidation)
+		}
+
+		if errors.As(err, &errSCIMGroupRoleMappingAlreadyExists) {
+			return nil, gqlutils.Conflict(ctx, err)
+		}
+
+		r.logger.ErrorCtx(ctx, "cannot create scim group role mapping", log.Error(err))
+		return nil, gqlutils.Internal(ctx)
+	}
+
+	return &types.CreateSCIMGroupRoleMappingPayload{
+		ScimGroupRoleMapping: types.NewSCIMGroupRoleMapping(mapping),
+	}, nil
+}
+
+// UpdateSCIMGroupRoleMapping is the resolver for the updateSCIMGroupRoleMapping field.
+func (r *mutationResolver) UpdateSCIMGroupRoleMapping(ctx context.Context, input types.UpdateSCIMGroupRoleMappingInput) (*types.UpdateSCIMGroupRoleMappingPayload, error) {
+	if err := r.authorize(ctx, input.ScimGroupRoleMappingID, iam.ActionSCIMGroupRoleMappingUpdate); err != nil {
+		return nil, err
+	}
+
+	mapping, err := r.iam.OrganizationService.UpdateSCIMGroupRoleMapping(
+		ctx,
+		input.OrganizationID,
+		input.ScimGroupRoleMappingID,
+		&iam.UpdateSCIMGroupRoleMappingRequest{
+			Role: input.Role,
+		},
+	)
+	if err != nil {
+		var (
+			errValidation                   validator.ValidationErrors
+			errSCIMGroupRoleMappingNotFound *iam.ErrSCIMGroupRoleMappingNotFound
+		)
+
+		if errors.As(err, &errValidation) {
+			return nil, gqlutils.Invalid(ctx, errValidation)
+		}
+
+		if errors.As(err, &errSCIMGroupRoleMappingNotFound) {
+			return nil, gqlutils.NotFound(ctx, err)
+		}
+
+		r.logger.ErrorCtx(ctx, "cannot update scim group role mapping", log.Error(err))
+		return nil, gqlutils.Internal(ctx)
+	}
+
+	return &types.UpdateSCIMGroupRoleMappingPayload{
+		ScimGroupRoleMapping: types.NewSCIMGroupRoleMapping(mapping),
 	}, nil
 }
 
-// DeleteSAMLConfiguration is the resolver for the deleteSAMLConfiguration field.
-func (r *mutationResolver) DeleteSAMLConfiguration(ctx context.Context, input types.DeleteSAMLConfigurationInput) (*types.DeleteSAMLConfigurationPayload, error) {
-	if err := r.authorize(ctx, input.OrganizationID, iam.ActionSAMLConfigurationDelete); err != nil {
+// DeleteSCIMGroupRoleMapping is the resolver for the deleteSCIMGroupRoleMapping field.
+func (r *mutationResolver) DeleteSCIMGroupRoleMapping(ctx context.Context, input types.DeleteSCIMGroupRoleMappingInput) (*types.DeleteSCIMGroupRoleMappingPayload, error) {
+	if err := r.authorize(ctx, input.ScimGroupRoleMappingID, iam.ActionSCIMGroupRoleMappingDelete); err != nil {
 		return nil, err
 	}
 
-	err := r.iam.OrganizationService.DeleteSAMLConfiguration(ctx, input.OrganizationID, input.SamlConfigurationID)
+	err := r.iam.OrganizationService.DeleteSCIMGroupRoleMapping(ctx, input.OrganizationID, input.ScimGroupRoleMappingID)
 	if err != nil {
-		r.logger.ErrorCtx(ctx, "cannot delete saml configuration", log.Error(err))
+		var errSCIMGroupRoleMappingNotFound *iam.ErrSCIMGroupRoleMappingNotFound
+		if errors.As(err, &errSCIMGroupRoleMappingNotFound) {
+			return nil, gqlutils.NotFound(ctx, err)
+		}
+
+		r.logger.ErrorCtx(ctx, "cannot delete scim group role mapping", log.Error(err))
 		return nil, gqlutils.Internal(ctx)
 	}
 
-	return &types.DeleteSAMLConfigurationPayload{DeletedSamlConfigurationID: input.SamlConfigurationID}, nil
+	return &types.DeleteSCIMGroupRoleMappingPayload{DeletedScimGroupRoleMappingID: input.ScimGroupRoleMappingID}, nil
 }
 
-// CreateSCIMConfiguration is the resolver for the createSCIMConfiguration field.
-func (r *mutationResolver) CreateSCIMConfiguration(ctx context.Context, input types.CreateSCIMConfigurationInput) (*types.CreateSCIMConfigurationPayload, error) {
-	if err := r.authorize(ctx, input.OrganizationID, iam.ActionSCIMConfigurationCreate); err != nil {
+// CreateCustomRole is the resolver for the createCustomRole field.
+func (r *mutationResolver) CreateCustomRole(ctx context.Context, input types.CreateCustomRoleInput) (*types.CreateCustomRolePayload, error) {
+	if err := r.authorize(ctx, input.OrganizationID, iam.ActionCustomRoleCreate); err != nil {
 		return nil, err
 	}
 
-	config, token, err := r.iam.OrganizationService.CreateSCIMConfiguration(ctx, input.OrganizationID)
+	customRole, err := r.iam.CustomRoleService.Create(
+		ctx,
+		&iam.CreateCustomRoleRequest{
+			OrganizationID: input.OrganizationID,
+			Name:           input.Name,
+			Description:    input.Description,
+			Policy:         []byte(input.Policy),
+		},
+	)
 	if err != nil {
-		r.logger.ErrorCtx(ctx, "cannot create scim configuration", log.Error(err))
+		var (
+			errValidation    validator.ValidationErrors
+			errInvalidPolicy *iam.ErrInvalidCustomRolePolicy
+			errNameExists    *iam.ErrCustomRoleNameAlreadyExists
+		)
+
+		if errors.As(err, &errValidation) {
+			return nil, gqlutils.Invalid(ctx, errValidation)
+		}
+
+		if errors.As(err, &errInvalidPolicy) {
+			return nil, gqlutils.Invalid(ctx, err)
+		}
+
+		if errors.As(err, &errNameExists) {
+			return nil, gqlutils.Conflict(ctx, err)
+		}
+
+		r.logger.ErrorCtx(ctx, "cannot create custom role", log.Error(err))
 		return nil, gqlutils.Internal(ctx)
 	}
 
-	var bridge *types.SCIMBridge
+	return &types.CreateCustomRolePayload{
+		CustomRoleEdge: types.NewCustomRoleEdge(customRole, coredata.CustomRoleOrderFieldCreatedAt),
+	}, nil
+}
 
-	if input.ConnectorID != nil {
-		scimBridge, err := r.iam.OrganizationService.CreateSCIMBridge(ctx, input.OrganizationID, config.ID, *input.ConnectorID)
-		if err != nil {
-			r.logger.ErrorCtx(ctx, "cannot create scim bridge", log.Error(err))
-			return nil, gqlutils.Internal(ctx)
-		}
-		bridge = types.NewSCIMBridge(scimBridge)
+// UpdateCustomRole is the resolver for the updateCustomRole field.
+func (r *mutationResolver) UpdateCustomRole(ctx context.Context, input types.UpdateCustomRoleInput) (*types.UpdateCustomRolePayload, error) {
+	if err := r.authorize(ctx, input.CustomRoleID, iam.ActionCustomRoleUpdate); err != nil {
+		return nil, err
 	}
 
-	payload := &types.CreateSCIMConfigurationPayload{
-		ScimConfiguration: types.NewSCIMConfiguration(config),
-		ScimBridge:        bridge,
-		Token:             token,
+	req := &iam.UpdateCustomRoleRequest{
+		ID:          input.CustomRoleID,
+		Name:        input.Name,
+		Description: gqlutils.UnwrapOmittable(input.Description),
+	}
+	if input.Policy != nil {
+		req.Policy = []byte(*input.Policy)
 	}
 
-	return payload, nil
+	customRole, err := r.iam.CustomRoleService.Update(ctx, req)
+	if err != nil {
+		var (
+			errValidation    validator.ValidationErrors
+			errInvalidPolicy *iam.ErrInvalidCustomRolePolicy
+			errNameExists    *iam.ErrCustomRoleNameAlreadyExists
+			errNotFound      *iam.ErrCustomRoleNotFound
+		)
+
+		if errors.As(err, &errValidation) {
+			return nil, gqlutils.Invalid(ctx, errValidation)
+		}
+
+		if errors.As(err, &errInvalidPolicy) {
+			return nil, gqlutils.Invalid(ctx, err)
+		}
+
+		if errors.As(err, &errNameExists) {
+			return nil, gqlutils.Conflict(ctx, err)
+		}
+
+		if errors.As(err, &errNotFound) {
+			return nil, gqlutils.NotFound(ctx, err)
+		}
+
+		r.logger.ErrorCtx(ctx, "cannot update custom role", log.Error(err))
+		return nil, gqlutils.Internal(ctx)
+	}
+
+	return &types.UpdateCustomRolePayload{
+		CustomRole: types.NewCustomRole(customRole),
+	}, nil
 }
 
-// DeleteSCIMConfiguration is the resolver for the deleteSCIMConfiguration field.
-func (r *mutationResolver) DeleteSCIMConfiguration(ctx context.Context, input types.DeleteSCIMConfigurationInput) (*types.DeleteSCIMConfigurationPayload, error) {
-	if err := r.authorize(ctx, input.OrganizationID, iam.ActionSCIMConfigurationDelete); err != nil {
+// DeleteCustomRole is the resolver for the deleteCustomRole field.
+func (r *mutationResolver) DeleteCustomRole(ctx context.Context, input types.DeleteCustomRoleInput) (*types.DeleteCustomRolePayload, error) {
+	if err := r.authorize(ctx, input.CustomRoleID, iam.ActionCustomRoleDelete); err != nil {
 		return nil, err
 	}
 
-	err := r.iam.OrganizationService.DeleteSCIMConfiguration(ctx, input.OrganizationID, input.ScimConfigurationID)
-	if err != nil {
-		r.logger.ErrorCtx(ctx, "cannot delete scim configuration", log.Error(err))
+	if err := r.iam.CustomRoleService.Delete(ctx, input.CustomRoleID); err != nil {
+		var errNotFound *iam.ErrCustomRoleNotFound
+		if errors.As(err, &errNotFound) {
+			return nil, gqlutils.NotFound(ctx, err)
+		}
+
+		r.logger.ErrorCtx(ctx, "cannot delete custom role", log.Error(err))
 		return nil, gqlutils.Internal(ctx)
 	}
 
-	return &types.DeleteSCIMConfigurationPayload{DeletedScimConfigurationID: input.ScimConfigurationID}, nil
+	return &types.DeleteCustomRolePayload{DeletedCustomRoleID: input.CustomRoleID}, nil
 }
 
-// RegenerateSCIMToken is the resolver for the regenerateSCIMToken field.
-func (r *mutationResolver) RegenerateSCIMToken(ctx context.Context, input types.RegenerateSCIMTokenInput) (*types.RegenerateSCIMTokenPayload, error) {
-	if err := r.authorize(ctx, input.ScimConfigurationID, iam.ActionSCIMConfigurationUpdate); err != nil {
+// AssignMembershipCustomRole is the resolver for the assignMembershipCustomRole field.
+func (r *mutationResolver) AssignMembershipCustomRole(ctx context.Context, input types.AssignMembershipCustomRoleInput) (*types.AssignMembershipCustomRolePayload, error) {
+	if err := r.authorize(ctx, input.MembershipID, iam.ActionCustomRoleAssign); err != nil {
 		return nil, err
 	}
 
-	config, token, err := r.iam.OrganizationService.RegenerateSCIMToken(ctx, input.OrganizationID, input.ScimConfigurationID)
+	membership, err := r.iam.CustomRoleService.AssignToMembership(ctx, input.MembershipID, input.CustomRoleID)
 	if err != nil {
-		r.logger.ErrorCtx(ctx, "cannot regenerate scim token", log.Error(err))
+		var (
+			errMembershipNotFound *iam.ErrMembershipNotFound
+			errCustomRoleNotFound *iam.ErrCustomRoleNotFound
+		)
+
+		if errors.As(err, &errMembershipNotFound) || errors.As(err, &errCustomRoleNotFound) {
+			return nil, gqlutils.NotFound(ctx, err)
+		}
+
+		r.logger.ErrorCtx(ctx, "cannot assign custom role to membership", log.Error(err))
 		return nil, gqlutils.Internal(ctx)
 	}
 
-	return &types.RegenerateSCIMTokenPayload{
-		ScimConfiguration: types.NewSCIMConfiguration(config),
-		Token:             token,
+	return &types.AssignMembershipCustomRolePayload{
+		Membership: types.NewMembership(membership),
 	}, nil
 }
 
-// UpdateSCIMBridge is the resolver for the updateSCIMBridge field.
-func (r *mutationResolver) UpdateSCIMBridge(ctx context.Context, input types.UpdateSCIMBridgeInput) (*types.UpdateSCIMBridgePayload, error) {
-	if err := r.authorize(ctx, input.ScimBridgeID, iam.ActionSCIMBridgeUpdate); err != nil {
+// AssignPersonalAPIKeyCustomRole is the resolver for the assignPersonalAPIKeyCustomRole field.
+func (r *mutationResolver) AssignPersonalAPIKeyCustomRole(ctx context.Context, input types.AssignPersonalAPIKeyCustomRoleInput) (*types.AssignPersonalAPIKeyCustomRolePayload, error) {
+	// A restricted API key must not be able to lift its own restriction.
+	if authn.APIKeyFromContext(ctx) != nil {
+		return nil, gqlutils.Forbiddenf(ctx, "api key authentication cannot be used to restrict api keys")
+	}
+
+	if err := r.authorize(ctx, input.PersonalAPIKeyID, iam.ActionPersonalAPIKeyUpdate); err != nil {
 		return nil, err
 	}
 
-	bridge, err := r.iam.OrganizationService.UpdateSCIMBridge(ctx, input.OrganizationID, input.ScimBridgeID, input.ExcludedUserNames)
+	if input.CustomRoleID != nil {
+		if err := r.authorize(ctx, *input.CustomRoleID, iam.ActionCustomRoleGet); err != nil {
+			return nil, err
+		}
+	}
+
+	apiKey, err := r.iam.CustomRoleService.AssignToPersonalAPIKey(ctx, input.PersonalAPIKeyID, input.OrganizationID, input.CustomRoleID)
 	if err != nil {
-		r.logger.ErrorCtx(ctx, "cannot update scim bridge excluded user names", log.Error(err))
+		var (
+			errPersonalAPIKeyNotFound *iam.ErrPersonalAPIKeyNotFound
+			errMembershipNotFound     *iam.ErrMembershipNotFound
+			errCustomRoleNotFound     *iam.ErrCustomRoleNotFound
+		)
+
+		if errors.As(err, &errPersonalAPIKeyNotFound) ||
+			errors.As(err, &errMembershipNotFound) ||
+			errors.As(err, &errCustomRoleNotFound) {
+			return nil, gqlutils.NotFound(ctx, err)
+		}
+
+		r.logger.ErrorCtx(ctx, "cannot assign custom role to personal api key", log.Error(err))
 		return nil, gqlutils.Internal(ctx)
 	}
 
-	return &types.UpdateSCIMBridgePayload{
-		ScimBridge: types.NewSCIMBridge(bridge),
+	return &types.AssignPersonalAPIKeyCustomRolePayload{
+		PersonalAPIKey: types.NewPersonalAPIKey(apiKey),
 	}, nil
 }
 
+// RedirectURI is the resolver for the redirectUri field.
+func (r *oIDCConfigurationResolver) RedirectURI(ctx context.Context, obj *types.OIDCConfiguration) (string, error) {
+	return r.iam.OIDCService.RedirectURL(), nil
+}
+
+// TestLoginURL is the resolver for the testLoginUrl field.
+func (r *oIDCConfigurationResolver) TestLoginURL(ctx context.Context, obj *types.OIDCConfiguration) (string, error) {
+	return r.baseURL.WithPath("/api/connect/v1/oidc/" + obj.ID.String()).MustString(), nil
+}
+
+// Permission is the resolver for the permission field.
+func (r *oIDCConfigurationResolver) Permission(ctx context.Context, obj *types.OIDCConfiguration, action string) (bool, error) {
+	return r.Resolver.Permission(ctx, obj, action)
+}
+
+// TotalCount is the resolver for the totalCount field.
+func (r *oIDCConfigurationConnectionResolver) TotalCount(ctx context.Context, obj *types.OIDCConfigurationConnection) (*int, error) {
+	switch obj.Resolver.(type) {
+	case *organizationResolver:
+		count, err := r.iam.OrganizationService.CountOIDCConfigurations(ctx, obj.ParentID)
+		if err != nil {
+			r.logger.ErrorCtx(ctx, "cannot count oidc configurations", log.Error(err))
+			return nil, gqlutils.Internal(ctx)
+		}
+		return &count, nil
+	}
+
+	r.logger.ErrorCtx(ctx, "unsupported resolver", log.Any("resolver", obj.Resolver))
+	return nil, gqlutils.Internal(ctx)
+}
+
 // LogoURL is the resolver for the logoUrl field.
 func (r *organizationResolver) LogoURL(ctx context.Context, obj *types.Organization) (*string, error) {
 	if err := r.authorize(ctx, obj.ID, iam.ActionOrganizationGet, authz.WithSkipAssumptionCheck()); err != nil {
@@ -1447,6 +2378,35 @@ func (r *organizationResolver) SamlConfigurations(ctx context.Context, obj *type
 	return types.NewSAMLConfigurationConnection(page, r, obj.ID), nil
 }
 
+// OidcConfigurations is the resolver for the oidcConfigurations field.
+func (r *organizationResolver) OidcConfigurations(ctx context.Context, obj *types.Organization, first *int, after *page.CursorKey, last *int, before *page.CursorKey) (*types.OIDCConfigurationConnection, error) {
+	if err := r.authorize(ctx, obj.ID, iam.ActionOIDCConfigurationList); err != nil {
+		return nil, err
+	}
+
+	if gqlutils.OnlyTotalCountSelected(ctx) {
+		return &types.OIDCConfigurationConnection{
+			Resolver: r,
+			ParentID: obj.ID,
+		}, nil
+	}
+
+	pageOrderBy := page.OrderBy[coredata.OIDCConfigurationOrderField]{
+		Field:     coredata.OIDCConfigurationOrderFieldCreatedAt,
+		Direction: page.OrderDirectionDesc,
+	}
+
+	cursor := cursor.NewCursor(first, after, last, before, pageOrderBy)
+
+	page, err := r.iam.OrganizationService.ListOIDCConfigurations(ctx, obj.ID, cursor)
+	if err != nil {
+		r.logger.ErrorCtx(ctx, "cannot list oidc configurations", log.Error(err))
+		return nil, gqlutils.Internal(ctx)
+	}
+
+	return types.NewOIDCConfigurationConnection(page, r, obj.ID), nil
+}
+
 // ScimConfiguration is the resolver for the scimConfiguration field.
 func (r *organizationResolver) ScimConfiguration(ctx context.Context, obj *types.Organization) (*types.SCIMConfiguration, error) {
 	if err := r.authorize(ctx, obj.ID, iam.ActionSCIMConfigurationGet); err != nil {
@@ -1467,6 +2427,96 @@ func (r *organizationResolver) ScimConfiguration(ctx context.Context, obj *types
 	return types.NewSCIMConfiguration(config), nil
 }
 
+// ScimGroupRoleMappings is the resolver for the scimGroupRoleMappings field.
+func (r *organizationResolver) ScimGroupRoleMappings(ctx context.Context, obj *types.Organization) ([]*types.SCIMGroupRoleMapping, error) {
+	if err := r.authorize(ctx, obj.ID, iam.ActionSCIMGroupRoleMappingList); err != nil {
+		return nil, err
+	}
+
+	mappings, err := r.iam.OrganizationService.ListSCIMGroupRoleMappings(ctx, obj.ID)
+	if err != nil {
+		r.logger.ErrorCtx(ctx, "cannot list scim group role mappings", log.Error(err))
+		return nil, gqlutils.Internal(ctx)
+	}
+
+	return types.NewSCIMGroupRoleMappings(mappings), nil
+}
+
+// CustomRoles is the resolver for the customRoles field.
+func (r *organizationResolver) CustomRoles(ctx context.Context, obj *types.Organization, first *int, after *page.CursorKey, last *int, before *page.CursorKey, orderBy *types.CustomRoleOrderBy) (*types.CustomRoleConnection, error) {
+	if err := r.authorize(ctx, obj.ID, iam.ActionCustomRoleList); err != nil {
+		return nil, err
+	}
+
+	if gqlutils.OnlyTotalCountSelected(ctx) {
+		return &types.CustomRoleConnection{
+			Resolver: r,
+			ParentID: obj.ID,
+		}, nil
+	}
+
+	pageOrderBy := page.OrderBy[coredata.CustomRoleOrderField]{
+		Field:     coredata.CustomRoleOrderFieldCreatedAt,
+		Direction: page.OrderDirectionDesc,
+	}
+	if orderBy != nil {
+		pageOrderBy.Field = coredata.CustomRoleOrderField(orderBy.Field)
+		pageOrderBy.Direction = page.OrderDirection(orderBy.Direction)
+	}
+
+	cursor := cursor.NewCursor(first, after, last, before, pageOrderBy)
+
+	page, err := r.iam.CustomRoleService.List(ctx, obj.ID, cursor)
+	if err != nil {
+		r.logger.ErrorCtx(ctx, "cannot list custom roles", log.Error(err))
+		return nil, gqlutils.Internal(ctx)
+	}
+
+	return types.NewCustomRoleConnection(page, r, obj.ID), nil
+}
+
+// AuthorizationSimulation is the resolver for the authorizationSimulation field.
+func (r *organizationResolver) AuthorizationSimulation(ctx context.Context, obj *types.Organization, principalID gid.GID, action string, resourceID gid.GID, personalAPIKeyID *gid.GID) (*types.AuthorizationSimulation, error) {
+	if err := r.authorize(ctx, obj.ID, iam.ActionAuthorizationSimulate); err != nil {
+		return nil, err
+	}
+
+	simulation, err := r.iam.OrganizationService.SimulateAuthorization(
+		ctx,
+		obj.ID,
+		iam.AuthorizeParams{
+			Principal: principalID,
+			Resource:  resourceID,
+			Action:    action,
+			APIKey:    personalAPIKeyID,
+		},
+	)
+	if err != nil {
+		var (
+			errUnknownAction             *iam.ErrUnknownAction
+			errUnsupportedPrincipalType  *iam.ErrUnsupportedPrincipalType
+			errMembershipNotFound        *iam.ErrMembershipNotFound
+			errPersonalAPIKeyNotFound    *iam.ErrPersonalAPIKeyNotFound
+			errResourceNotInOrganization *iam.ErrResourceNotInOrganization
+		)
+
+		if errors.As(err, &errUnknownAction) || errors.As(err, &errUnsupportedPrincipalType) {
+			return nil, gqlutils.Invalid(ctx, err)
+		}
+
+		if errors.As(err, &errMembershipNotFound) ||
+			errors.As(err, &errPersonalAPIKeyNotFound) ||
+			errors.As(err, &errResourceNotInOrganization) {
+			return nil, gqlutils.NotFound(ctx, err)
+		}
+
+		r.logger.ErrorCtx(ctx, "cannot simulate authorization", log.Error(err))
+		return nil, gqlutils.Internal(ctx)
+	}
+
+	return types.NewAuthorizationSimulation(simulation), nil
+}
+
 // ViewerMembership is the resolver for the viewerMembership field.
 func (r *organizationResolver) ViewerMembership(ctx context.Context, obj *types.Organization) (*types.Membership, error) {
 	if err := r.authorize(ctx, obj.ID, iam.ActionMembershipGet, authz.WithSkipAssumptionCheck()); err != nil {
@@ -1491,6 +2541,10 @@ func (r *organizationResolver) Permission(ctx context.Context, obj *types.Organi
 
 // Token is the resolver for the token field.
 func (r *personalAPIKeyResolver) Token(ctx context.Context, obj *types.PersonalAPIKey) (*string, error) {
+	if authn.APIKeyFromContext(ctx) != nil {
+		return nil, gqlutils.Forbiddenf(ctx, "api key authentication cannot be used to manage api keys")
+	}
+
 	if err := r.authorize(ctx, obj.ID, iam.ActionPersonalAPIKeyGet); err != nil {
 		return nil, err
 	}
@@ -1609,6 +2663,26 @@ func (r *queryResolver) Node(ctx context.Context, id gid.GID) (types.Node, error
 
 			return types.NewSAMLConfiguration(samlConfiguration), nil
 		}
+	case coredata.OIDCConfigurationEntityType:
+		action = iam.ActionOIDCConfigurationGet
+		loadNode = func(ctx context.Context, id gid.GID) (types.Node, error) {
+			oidcConfiguration, err := r.iam.GetOIDCConfiguration(ctx, id)
+			if err != nil {
+				return nil, err
+			}
+
+			return types.NewOIDCConfiguration(oidcConfiguration), nil
+		}
+	case coredata.CustomRoleEntityType:
+		action = iam.ActionCustomRoleGet
+		loadNode = func(ctx context.Context, id gid.GID) (types.Node, error) {
+			customRole, err := r.iam.CustomRoleService.Get(ctx, id)
+			if err != nil {
+				return nil, err
+			}
+
+			return types.NewCustomRole(customRole), nil
+		}
 	case coredata.PersonalAPIKeyEntityType:
 		action = iam.ActionPersonalAPIKeyGet
 		loadNode = func(ctx context.Context, id gid.GID) (types.Node, error) {
@@ -1636,6 +2710,24 @@ func (r *queryResolver) Node(ctx context.Context, id gid.GID) (types.Node, error
 			}
 			return types.NewSCIMEvent(scimEvent), nil
 		}
+	case coredata.SCIMGroupRoleMappingEntityType:
+		action = iam.ActionSCIMGroupRoleMappingGet
+		loadNode = func(ctx context.Context, id gid.GID) (types.Node, error) {
+			mapping, err := r.iam.GetSCIMGroupRoleMapping(ctx, id)
+			if err != nil {
+				return nil, err
+			}
+			return types.NewSCIMGroupRoleMapping(mapping), nil
+		}
+	case coredata.WebAuthnCredentialEntityType:
+		action = iam.ActionIdentityMFAGet
+		loadNode = func(ctx context.Context, id gid.GID) (types.Node, error) {
+			credential, err := r.iam.MFAService.GetWebAuthnCredential(ctx, id)
+			if err != nil {
+				return nil, err
+			}
+			return types.NewWebAuthnCredential(credential), nil
+		}
 	default:
 		return nil, fmt.Errorf("unsupported entity type: %d", id.EntityType())
 	}
@@ -1647,17 +2739,25 @@ func (r *queryResolver) Node(ctx context.Context, id gid.GID) (types.Node, error
 	node, err := loadNode(ctx, id)
 	if err != nil {
 		var (
-			errOrganizationNotFound *iam.ErrOrganizationNotFound
-			errIdentityNotFound     *iam.ErrIdentityNotFound
-			errSessionNotFound      *iam.ErrSessionNotFound
-			errMembershipNotFound   *iam.ErrMembershipNotFound
-			errInvitationNotFound   *iam.ErrInvitationNotFound
+			errOrganizationNotFound      *iam.ErrOrganizationNotFound
+			errIdentityNotFound          *iam.ErrIdentityNotFound
+			errSessionNotFound           *iam.ErrSessionNotFound
+			errMembershipNotFound        *iam.ErrMembershipNotFound
+			errInvitationNotFound        *iam.ErrInvitationNotFound
+			errCustomRoleNotFound        *iam.ErrCustomRoleNotFound
+			errCredentialNotFound        *iam.ErrWebAuthnCredentialNotFound
+			errOIDCConfigurationNotFound *iam.ErrOIDCConfigurationNotFound
+			errMappingNotFound           *iam.ErrSCIMGroupRoleMappingNotFound
 
 			isNotFoundErr = errors.As(err, &errOrganizationNotFound) ||
 				errors.As(err, &errIdentityNotFound) ||
 				errors.As(err, &errSessionNotFound) ||
 				errors.As(err, &errMembershipNotFound) ||
-				errors.As(err, &errInvitationNotFound)
+				errors.As(err, &errInvitationNotFound) ||
+				errors.As(err, &errCustomRoleNotFound) ||
+				errors.As(err, &errCredentialNotFound) ||
+				errors.As(err, &errOIDCConfigurationNotFound) ||
+				errors.As(err, &errMappingNotFound)
 		)
 
 		if isNotFoundErr {
@@ -1687,17 +2787,23 @@ func (r *queryResolver) Viewer(ctx context.Context) (*types.Identity, error) {
 
 // SsoLoginURL is the resolver for the ssoLoginURL field.
 func (r *queryResolver) SsoLoginURL(ctx context.Context, email mail.Addr) (*string, error) {
-	count, err := r.iam.AccountService.CountSAMLConfigurationsForEmail(ctx, email)
+	samlCount, err := r.iam.AccountService.CountSAMLConfigurationsForEmail(ctx, email)
 	if err != nil {
 		r.logger.ErrorCtx(ctx, "cannot count SAML configurations for email", log.Error(err))
 		return nil, gqlutils.Internal(ctx)
 	}
 
-	if count != 1 {
-		if count == 0 {
+	oidcCount, err := r.iam.AccountService.CountOIDCConfigurationsForEmail(ctx, email)
+	if err != nil {
+		r.logger.ErrorCtx(ctx, "cannot count OIDC configurations for email", log.Error(err))
+		return nil, gqlutils.Internal(ctx)
+	}
+
+	if samlCount+oidcCount != 1 {
+		if samlCount+oidcCount == 0 {
 			return nil, graphql.ErrorOnPath(
 				ctx,
-				fmt.Errorf("no SAML configuration for email"),
+				fmt.Errorf("no SSO configuration for email"),
 			)
 		}
 
@@ -1707,6 +2813,17 @@ func (r *queryResolver) SsoLoginURL(ctx context.Context, email mail.Addr) (*stri
 		)
 	}
 
+	if oidcCount == 1 {
+		oidcConfigs, err := r.iam.AccountService.ListOIDCConfigurationsForEmail(ctx, email)
+		if err != nil {
+			r.logger.ErrorCtx(ctx, "cannot list OIDC configurations for email", log.Error(err))
+			return nil, gqlutils.Internal(ctx)
+		}
+
+		loginURL := r.baseURL.WithPath("/api/connect/v1/oidc/" + oidcConfigs[0].ID.String()).MustString()
+		return &loginURL, nil
+	}
+
 	samlConfigs, err := r.iam.AccountService.ListSAMLConfigurationsForEmail(ctx, email)
 	if err != nil {
 		r.logger.ErrorCtx(ctx, "cannot list SAML configurations for email", log.Error(err))
@@ -1942,6 +3059,11 @@ func (r *sCIMEventConnectionResolver) TotalCount(ctx context.Context, obj *types
 	return nil, gqlutils.Internal(ctx)
 }
 
+// Permission is the resolver for the permission field.
+func (r *sCIMGroupRoleMappingResolver) Permission(ctx context.Context, obj *types.SCIMGroupRoleMapping, action string) (bool, error) {
+	return r.Resolver.Permission(ctx, obj, action)
+}
+
 // Identity is the resolver for the identity field.
 func (r *sessionResolver) Identity(ctx context.Context, obj *types.Session) (*types.Identity, error) {
 	if gqlutils.OnlyIDSelected(ctx) {
@@ -1984,6 +3106,14 @@ func (r *sessionConnectionResolver) TotalCount(ctx context.Context, obj *types.S
 // Connector returns schema.ConnectorResolver implementation.
 func (r *Resolver) Connector() schema.ConnectorResolver { return &connectorResolver{r} }
 
+// CustomRole returns schema.CustomRoleResolver implementation.
+func (r *Resolver) CustomRole() schema.CustomRoleResolver { return &customRoleResolver{r} }
+
+// CustomRoleConnection returns schema.CustomRoleConnectionResolver implementation.
+func (r *Resolver) CustomRoleConnection() schema.CustomRoleConnectionResolver {
+	return &customRoleConnectionResolver{r}
+}
+
 // Identity returns schema.IdentityResolver implementation.
 func (r *Resolver) Identity() schema.IdentityResolver { return &identityResolver{r} }
 
@@ -2011,6 +3141,16 @@ func (r *Resolver) MembershipProfile() schema.MembershipProfileResolver {
 // Mutation returns schema.MutationResolver implementation.
 func (r *Resolver) Mutation() schema.MutationResolver { return &mutationResolver{r} }
 
+// OIDCConfiguration returns schema.OIDCConfigurationResolver implementation.
+func (r *Resolver) OIDCConfiguration() schema.OIDCConfigurationResolver {
+	return &oIDCConfigurationResolver{r}
+}
+
+// OIDCConfigurationConnection returns schema.OIDCConfigurationConnectionResolver implementation.
+func (r *Resolver) OIDCConfigurationConnection() schema.OIDCConfigurationConnectionResolver {
+	return &oIDCConfigurationConnectionResolver{r}
+}
+
 // Organization returns schema.OrganizationResolver implementation.
 func (r *Resolver) Organization() schema.OrganizationResolver { return &organizationResolver{r} }
 
@@ -2051,6 +3191,11 @@ func (r *Resolver) SCIMEventConnection() schema.SCIMEventConnectionResolver {
 	return &sCIMEventConnectionResolver{r}
 }
 
+// SCIMGroupRoleMapping returns schema.SCIMGroupRoleMappingResolver implementation.
+func (r *Resolver) SCIMGroupRoleMapping() schema.SCIMGroupRoleMappingResolver {
+	return &sCIMGroupRoleMappingResolver{r}
+}
+
 // Session returns schema.SessionResolver implementation.
 func (r *Resolver) Session() schema.SessionResolver { return &sessionResolver{r} }
 
@@ -2060,6 +3205,8 @@ func (r *Resolver) SessionConnection() schema.SessionConnectionResolver {
 }
 
 type connectorResolver struct{ *Resolver }
+type customRoleResolver struct{ *Resolver }
+type customRoleConnectionResolver struct{ *Resolver }
 type identityResolver struct{ *Resolver }
 type invitationResolver struct{ *Resolver }
 type invitationConnectionResolver struct{ *Resolver }
@@ -2067,6 +3214,8 @@ type membershipResolver struct{ *Resolver }
 type membershipConnectionResolver struct{ *Resolver }
 type membershipProfileResolver struct{ *Resolver }
 type mutationResolver struct{ *Resolver }
+type oIDCConfigurationResolver struct{ *Resolver }
+type oIDCConfigurationConnectionResolver struct{ *Resolver }
 type organizationResolver struct{ *Resolver }
 type personalAPIKeyResolver struct{ *Resolver }
 type personalAPIKeyConnectionResolver struct{ *Resolver }
@@ -2077,5 +3226,6 @@ type sCIMBridgeResolver struct{ *Resolver }
 type sCIMConfigurationResolver struct{ *Resolver }
 type sCIMEventResolver struct{ *Resolver }
 type sCIMEventConnectionResolver struct{ *Resolver }
+type sCIMGroupRoleMappingResolver struct{ *Resolver }
 type sessionResolver struct{ *Resolver }
 type sessionConnectionResolver struct{ *Resolver }
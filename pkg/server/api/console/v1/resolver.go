@@ -20,6 +20,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"net"
 	"net/http"
 	"net/url"
 	"strings"
@@ -77,7 +78,7 @@ func NewMux(
 
 		r.Get("/connectors/initiate", func(w http.ResponseWriter, r *http.Request) {
 			provider := r.URL.Query().Get("provider")
-			if provider != "SLACK" && provider != "GOOGLE_WORKSPACE" {
+			if provider != "SLACK" && provider != "GOOGLE_WORKSPACE" && provider != "GITHUB" && provider != "MICROSOFT_ENTRA_ID" {
 				httpserver.RenderError(w, http.StatusBadRequest, fmt.Errorf("unsupported provider"))
 				return
 			}
@@ -126,6 +127,10 @@ func NewMux(
 				oauthSafeRedirect = &saferedirect.SafeRedirect{AllowedHost: "slack.com"}
 			case "GOOGLE_WORKSPACE":
 				oauthSafeRedirect = &saferedirect.SafeRedirect{AllowedHost: "accounts.google.com"}
+			case "GITHUB":
+				oauthSafeRedirect = &saferedirect.SafeRedirect{AllowedHost: "github.com"}
+			case "MICROSOFT_ENTRA_ID":
+				oauthSafeRedirect = &saferedirect.SafeRedirect{AllowedHost: "login.microsoftonline.com"}
 			}
 			oauthSafeRedirect.Redirect(w, r, redirectURL, "/", http.StatusSeeOther)
 		})
@@ -143,6 +148,10 @@ func NewMux(
 				connectorProvider = coredata.ConnectorProviderSlack
 			case "GOOGLE_WORKSPACE":
 				connectorProvider = coredata.ConnectorProviderGoogleWorkspace
+			case "GITHUB":
+				connectorProvider = coredata.ConnectorProviderGitHub
+			case "MICROSOFT_ENTRA_ID":
+				connectorProvider = coredata.ConnectorProviderMicrosoftEntraID
 			default:
 				httpserver.RenderError(w, http.StatusBadRequest, fmt.Errorf("unsupported provider"))
 				return
@@ -303,7 +312,13 @@ func NewMux(
 
 			svc := proboSvc.WithTenant(data.Data.OrganizationID.TenantID())
 
-			if err := svc.Documents.SignDocumentVersion(r.Context(), documentVersionID, data.Data.PeopleID); err != nil {
+			metadata := probo.SignatureMetadata{
+				IPAddress:  remoteIPAddress(r),
+				UserAgent:  r.UserAgent(),
+				AuthMethod: probo.SignatureAuthMethodSigningRequestToken,
+			}
+
+			if err := svc.Documents.SignDocumentVersion(r.Context(), documentVersionID, data.Data.PeopleID, metadata); err != nil {
 				http.Error(w, err.Error(), http.StatusInternalServerError)
 				return
 			}
@@ -315,6 +330,15 @@ func NewMux(
 	return r
 }
 
+// remoteIPAddress returns the address of the client that sent the request.
+func remoteIPAddress(r *http.Request) net.IP {
+	if host, _, err := net.SplitHostPort(r.RemoteAddr); err == nil {
+		return net.ParseIP(host)
+	}
+
+	return net.ParseIP(r.RemoteAddr)
+}
+
 func (r *Resolver) ProboService(ctx context.Context, tenantID gid.TenantID) *probo.TenantService {
 	return r.probo.WithTenant(tenantID)
 }
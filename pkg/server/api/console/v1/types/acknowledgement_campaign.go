@@ -0,0 +1,61 @@
+// Copyright (c) 2025 Probo Inc <hello@getprobo.com>.
+//
+// Permission to use, copy, modify, and/or distribute this software for any
+// purpose with or without fee is hereby granted, provided that the above
+// copyright notice and this permission notice appear in all copies.
+//
+// THE SOFTWARE IS PROVIDED "AS IS" AND THE AUTHOR DISCLAIMS ALL WARRANTIES WITH
+// REGARD TO THIS SOFTWARE INCLUDING ALL IMPLIED WARRANTIES OF MERCHANTABILITY
+// AND FITNESS. IN NO EVENT SHALL THE AUTHOR BE LIABLE FOR ANY SPECIAL, DIRECT,
+// INDIRECT, OR CONSEQUENTIAL DAMAGES OR ANY DAMAGES WHATSOEVER RESULTING FROM
+// LOSS OF USE, DATA OR PROFITS, WHETHER IN AN ACTION OF CONTRACT, NEGLIGENCE OR
+// OTHER TORTIOUS ACTION, ARISING OUT OF OR IN CONNECTION WITH THE USE OR
+// PERFORMANCE OF THIS SOFTWARE.
+
+package types
+
+import (
+	"go.probo.inc/probo/pkg/coredata"
+)
+
+func NewAcknowledgementCampaigns(campaigns coredata.AcknowledgementCampaigns) []*AcknowledgementCampaign {
+	result := make([]*AcknowledgementCampaign, len(campaigns))
+	for i, campaign := range campaigns {
+		result[i] = NewAcknowledgementCampaign(campaign)
+	}
+
+	return result
+}
+
+func NewAcknowledgementCampaign(c *coredata.AcknowledgementCampaign) *AcknowledgementCampaign {
+	return &AcknowledgementCampaign{
+		ID: c.ID,
+		Document: &Document{
+			ID: c.DocumentID,
+		},
+		RecipientProfileIds: c.RecipientProfileIDs,
+		IntervalDays:        c.IntervalDays,
+		Enabled:             c.Enabled,
+		NextRunAt:           c.NextRunAt,
+		LastRunAt:           c.LastRunAt,
+		LastError:           c.LastError,
+		CreatedAt:           c.CreatedAt,
+		UpdatedAt:           c.UpdatedAt,
+	}
+}
+
+func NewAcknowledgementCampaignRun(
+	run *coredata.AcknowledgementCampaignRun,
+	completion *coredata.AcknowledgementCampaignRunCompletion,
+) *AcknowledgementCampaignRun {
+	return &AcknowledgementCampaignRun{
+		ID: run.ID,
+		DocumentVersion: &DocumentVersion{
+			ID: run.DocumentVersionID,
+		},
+		StartedAt:            run.StartedAt,
+		TotalCount:           completion.TotalCount,
+		SignedCount:          completion.SignedCount,
+		CompletionPercentage: completion.Percentage(),
+	}
+}
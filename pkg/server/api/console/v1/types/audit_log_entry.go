@@ -0,0 +1,89 @@
+// Copyright (c) 2025 Probo Inc <hello@getprobo.com>.
+//
+// Permission to use, copy, modify, and/or distribute this software for any
+// purpose with or without fee is hereby granted, provided that the above
+// copyright notice and this permission notice appear in all copies.
+//
+// THE SOFTWARE IS PROVIDED "AS IS" AND THE AUTHOR DISCLAIMS ALL WARRANTIES WITH
+// REGARD TO THIS SOFTWARE INCLUDING ALL IMPLIED WARRANTIES OF MERCHANTABILITY
+// AND FITNESS. IN NO EVENT SHALL THE AUTHOR BE LIABLE FOR ANY SPECIAL, DIRECT,
+// INDIRECT, OR CONSEQUENTIAL DAMAGES OR ANY DAMAGES WHATSOEVER RESULTING FROM
+// LOSS OF USE, DATA OR PROFITS, WHETHER IN AN ACTION OF CONTRACT, NEGLIGENCE OR
+// OTHER TORTIOUS ACTION, ARISING OUT OF OR IN CONNECTION WITH THE USE OR
+// PERFORMANCE OF THIS SOFTWARE.
+
+package types
+
+import (
+	"go.probo.inc/probo/pkg/coredata"
+	"go.probo.inc/probo/pkg/gid"
+	"go.probo.inc/probo/pkg/page"
+)
+
+type (
+	AuditLogEntryOrderBy OrderBy[coredata.AuditLogEntryOrderField]
+
+	AuditLogEntryConnection struct {
+		TotalCount int
+		Edges      []*AuditLogEntryEdge
+		PageInfo   PageInfo
+
+		Resolver any
+		ParentID gid.GID
+		Filter   *AuditLogEntryFilter
+	}
+)
+
+func NewAuditLogEntryConnection(
+	p *page.Page[*coredata.AuditLogEntry, coredata.AuditLogEntryOrderField],
+	parentType any,
+	parentID gid.GID,
+	filter *AuditLogEntryFilter,
+) *AuditLogEntryConnection {
+	edges := make([]*AuditLogEntryEdge, len(p.Data))
+	for i, entry := range p.Data {
+		edges[i] = NewAuditLogEntryEdge(entry, p.Cursor.OrderBy.Field)
+	}
+
+	return &AuditLogEntryConnection{
+		Edges:    edges,
+		PageInfo: *NewPageInfo(p),
+
+		Resolver: parentType,
+		ParentID: parentID,
+		Filter:   filter,
+	}
+}
+
+func NewAuditLogEntryEdge(e *coredata.AuditLogEntry, orderBy coredata.AuditLogEntryOrderField) *AuditLogEntryEdge {
+	return &AuditLogEntryEdge{
+		Cursor: e.CursorKey(orderBy),
+		Node:   NewAuditLogEntry(e),
+	}
+}
+
+func NewAuditLogEntry(e *coredata.AuditLogEntry) *AuditLogEntry {
+	return &AuditLogEntry{
+		ID:         e.ID,
+		ActorType:  e.ActorType,
+		ActorID:    e.ActorID,
+		APIKeyID:   e.APIKeyID,
+		Action:     e.Action,
+		ResourceID: e.ResourceID,
+		Changes:    string(e.Changes),
+		CreatedAt:  e.CreatedAt,
+	}
+}
+
+func NewAuditLogEntryFilter(filter *AuditLogEntryFilter) *coredata.AuditLogEntryFilter {
+	f := coredata.NewAuditLogEntryFilter()
+	if filter == nil {
+		return f
+	}
+
+	return f.
+		WithAction(filter.Action).
+		WithActorID(filter.ActorID).
+		WithResourceID(filter.ResourceID).
+		WithCreatedBetween(filter.From, filter.To)
+}
@@ -0,0 +1,78 @@
+// Copyright (c) 2025 Probo Inc <hello@getprobo.com>.
+//
+// Permission to use, copy, modify, and/or distribute this software for any
+// purpose with or without fee is hereby granted, provided that the above
+// copyright notice and this permission notice appear in all copies.
+//
+// THE SOFTWARE IS PROVIDED "AS IS" AND THE AUTHOR DISCLAIMS ALL WARRANTIES WITH
+// REGARD TO THIS SOFTWARE INCLUDING ALL IMPLIED WARRANTIES OF MERCHANTABILITY
+// AND FITNESS. IN NO EVENT SHALL THE AUTHOR BE LIABLE FOR ANY SPECIAL, DIRECT,
+// INDIRECT, OR CONSEQUENTIAL DAMAGES OR ANY DAMAGES WHATSOEVER RESULTING FROM
+// LOSS OF USE, DATA OR PROFITS, WHETHER IN AN ACTION OF CONTRACT, NEGLIGENCE OR
+// OTHER TORTIOUS ACTION, ARISING OUT OF OR IN CONNECTION WITH THE USE OR
+// PERFORMANCE OF THIS SOFTWARE.
+
+package types
+
+import (
+	"go.probo.inc/probo/pkg/coredata"
+	"go.probo.inc/probo/pkg/gid"
+	"go.probo.inc/probo/pkg/page"
+)
+
+type (
+	ConnectorEvidenceMappingOrderBy OrderBy[coredata.ConnectorEvidenceMappingOrderField]
+
+	ConnectorEvidenceMappingConnection struct {
+		TotalCount int
+		Edges      []*ConnectorEvidenceMappingEdge
+		PageInfo   PageInfo
+
+		Resolver any
+		ParentID gid.GID
+	}
+)
+
+func NewConnectorEvidenceMappingConnection(
+	p *page.Page[*coredata.ConnectorEvidenceMapping, coredata.ConnectorEvidenceMappingOrderField],
+	parentType any,
+	parentID gid.GID,
+) *ConnectorEvidenceMappingConnection {
+	edges := make([]*ConnectorEvidenceMappingEdge, len(p.Data))
+	for i, mapping := range p.Data {
+		edges[i] = NewConnectorEvidenceMappingEdge(mapping, p.Cursor.OrderBy.Field)
+	}
+
+	return &ConnectorEvidenceMappingConnection{
+		Edges:    edges,
+		PageInfo: *NewPageInfo(p),
+
+		Resolver: parentType,
+		ParentID: parentID,
+	}
+}
+
+func NewConnectorEvidenceMappingEdge(m *coredata.ConnectorEvidenceMapping, orderBy coredata.ConnectorEvidenceMappingOrderField) *ConnectorEvidenceMappingEdge {
+	return &ConnectorEvidenceMappingEdge{
+		Cursor: m.CursorKey(orderBy),
+		Node:   NewConnectorEvidenceMapping(m),
+	}
+}
+
+func NewConnectorEvidenceMapping(m *coredata.ConnectorEvidenceMapping) *ConnectorEvidenceMapping {
+	return &ConnectorEvidenceMapping{
+		ID:             m.ID,
+		ConnectorID:    m.ConnectorID,
+		EvidenceKey:    m.EvidenceKey,
+		FindingAction:  m.FindingAction,
+		FindingOwnerID: m.FindingOwnerID,
+		Measure: &Measure{
+			ID: m.MeasureID,
+		},
+		LastCollectedAt:  m.LastCollectedAt,
+		NextCollectionAt: m.NextCollectionAt,
+		CollectionError:  m.CollectionError,
+		CreatedAt:        m.CreatedAt,
+		UpdatedAt:        m.UpdatedAt,
+	}
+}
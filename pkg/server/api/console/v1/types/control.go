@@ -65,3 +65,12 @@ func NewControl(control *coredata.Control) *Control {
 		UpdatedAt:    control.UpdatedAt,
 	}
 }
+
+func NewControls(controls coredata.Controls) []*Control {
+	result := make([]*Control, len(controls))
+	for i, control := range controls {
+		result[i] = NewControl(control)
+	}
+
+	return result
+}
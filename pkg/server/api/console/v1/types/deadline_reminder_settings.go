@@ -0,0 +1,36 @@
+// Copyright (c) 2025 Probo Inc <hello@getprobo.com>.
+//
+// Permission to use, copy, modify, and/or distribute this software for any
+// purpose with or without fee is hereby granted, provided that the above
+// copyright notice and this permission notice appear in all copies.
+//
+// THE SOFTWARE IS PROVIDED "AS IS" AND THE AUTHOR DISCLAIMS ALL WARRANTIES WITH
+// REGARD TO THIS SOFTWARE INCLUDING ALL IMPLIED WARRANTIES OF MERCHANTABILITY
+// AND FITNESS. IN NO EVENT SHALL THE AUTHOR BE LIABLE FOR ANY SPECIAL, DIRECT,
+// INDIRECT, OR CONSEQUENTIAL DAMAGES OR ANY DAMAGES WHATSOEVER RESULTING FROM
+// LOSS OF USE, DATA OR PROFITS, WHETHER IN AN ACTION OF CONTRACT, NEGLIGENCE OR
+// OTHER TORTIOUS ACTION, ARISING OUT OF OR IN CONNECTION WITH THE USE OR
+// PERFORMANCE OF THIS SOFTWARE.
+
+package types
+
+import (
+	"go.probo.inc/probo/pkg/coredata"
+)
+
+func NewDeadlineReminderSettings(s *coredata.DeadlineReminderSettings) *DeadlineReminderSettings {
+	return &DeadlineReminderSettings{
+		ID:                  s.ID,
+		Enabled:             s.Enabled,
+		DaysBefore:          s.DaysBefore,
+		RemindOnDueDate:     s.RemindOnDueDate,
+		OverdueIntervalDays: s.OverdueIntervalDays,
+		EscalationGraceDays: s.EscalationGraceDays,
+		SlackEnabled:        s.SlackEnabled,
+		NextRunAt:           s.NextRunAt,
+		LastRunAt:           s.LastRunAt,
+		LastError:           s.LastError,
+		CreatedAt:           s.CreatedAt,
+		UpdatedAt:           s.UpdatedAt,
+	}
+}
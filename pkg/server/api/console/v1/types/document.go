@@ -74,7 +74,7 @@ func NewDocumentEdge(document *coredata.Document, orderBy coredata.DocumentOrder
 }
 
 func NewDocument(document *coredata.Document) *Document {
-	return &Document{
+	d := &Document{
 		ID:    document.ID,
 		Title: document.Title,
 		Organization: &Organization{
@@ -84,7 +84,29 @@ func NewDocument(document *coredata.Document) *Document {
 		Classification:          document.Classification,
 		CurrentPublishedVersion: document.CurrentPublishedVersion,
 		TrustCenterVisibility:   document.TrustCenterVisibility,
+		ReviewIntervalDays:      document.ReviewIntervalDays,
+		NextReviewDate:          document.NextReviewDate,
+		LastReviewedAt:          document.LastReviewedAt,
 		CreatedAt:               document.CreatedAt,
 		UpdatedAt:               document.UpdatedAt,
 	}
+
+	if document.OwnerProfileID != nil {
+		d.Owner = &Profile{ID: *document.OwnerProfileID}
+	}
+
+	return d
+}
+
+func NewApprovalStages(stages []*DocumentApprovalStageInput) [][]gid.GID {
+	if stages == nil {
+		return nil
+	}
+
+	approvalStages := make([][]gid.GID, len(stages))
+	for i, stage := range stages {
+		approvalStages[i] = stage.ApproverIds
+	}
+
+	return approvalStages
 }
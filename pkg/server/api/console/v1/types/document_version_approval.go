@@ -0,0 +1,59 @@
+// Copyright (c) 2025 Probo Inc <hello@getprobo.com>.
+//
+// Permission to use, copy, modify, and/or distribute this software for any
+// purpose with or without fee is hereby granted, provided that the above
+// copyright notice and this permission notice appear in all copies.
+//
+// THE SOFTWARE IS PROVIDED "AS IS" AND THE AUTHOR DISCLAIMS ALL WARRANTIES WITH
+// REGARD TO THIS SOFTWARE INCLUDING ALL IMPLIED WARRANTIES OF MERCHANTABILITY
+// AND FITNESS. IN NO EVENT SHALL THE AUTHOR BE LIABLE FOR ANY SPECIAL, DIRECT,
+// INDIRECT, OR CONSEQUENTIAL DAMAGES OR ANY DAMAGES WHATSOEVER RESULTING FROM
+// LOSS OF USE, DATA OR PROFITS, WHETHER IN AN ACTION OF CONTRACT, NEGLIGENCE OR
+// OTHER TORTIOUS ACTION, ARISING OUT OF OR IN CONNECTION WITH THE USE OR
+// PERFORMANCE OF THIS SOFTWARE.
+
+package types
+
+import (
+	"time"
+
+	"go.probo.inc/probo/pkg/coredata"
+	"go.probo.inc/probo/pkg/gid"
+)
+
+type (
+	DocumentVersionApproval struct {
+		DocumentVersionID gid.GID
+		Approver          *Profile
+		Stage             int
+		State             coredata.DocumentVersionApprovalState
+		Comment           *string
+		DecidedAt         *time.Time
+		CreatedAt         time.Time
+		UpdatedAt         time.Time
+	}
+)
+
+func NewDocumentVersionApprovals(approvers coredata.DocumentVersionApprovers) []*DocumentVersionApproval {
+	approvals := make([]*DocumentVersionApproval, len(approvers))
+	for i, approver := range approvers {
+		approvals[i] = NewDocumentVersionApproval(approver)
+	}
+
+	return approvals
+}
+
+func NewDocumentVersionApproval(approver *coredata.DocumentVersionApprover) *DocumentVersionApproval {
+	return &DocumentVersionApproval{
+		DocumentVersionID: approver.DocumentVersionID,
+		Approver: &Profile{
+			ID: approver.ApproverProfileID,
+		},
+		Stage:     approver.Stage,
+		State:     approver.State,
+		Comment:   approver.Comment,
+		DecidedAt: approver.DecidedAt,
+		CreatedAt: approver.CreatedAt,
+		UpdatedAt: approver.UpdatedAt,
+	}
+}
@@ -0,0 +1,50 @@
+// Copyright (c) 2025 Probo Inc <hello@getprobo.com>.
+//
+// Permission to use, copy, modify, and/or distribute this software for any
+// purpose with or without fee is hereby granted, provided that the above
+// copyright notice and this permission notice appear in all copies.
+//
+// THE SOFTWARE IS PROVIDED "AS IS" AND THE AUTHOR DISCLAIMS ALL WARRANTIES WITH
+// REGARD TO THIS SOFTWARE INCLUDING ALL IMPLIED WARRANTIES OF MERCHANTABILITY
+// AND FITNESS. IN NO EVENT SHALL THE AUTHOR BE LIABLE FOR ANY SPECIAL, DIRECT,
+// INDIRECT, OR CONSEQUENTIAL DAMAGES OR ANY DAMAGES WHATSOEVER RESULTING FROM
+// LOSS OF USE, DATA OR PROFITS, WHETHER IN AN ACTION OF CONTRACT, NEGLIGENCE OR
+// OTHER TORTIOUS ACTION, ARISING OUT OF OR IN CONNECTION WITH THE USE OR
+// PERFORMANCE OF THIS SOFTWARE.
+
+package types
+
+import (
+	"go.probo.inc/probo/pkg/docdiff"
+	"go.probo.inc/probo/pkg/probo"
+)
+
+func NewDocumentVersionDiff(diff *probo.DocumentVersionDiff) *DocumentVersionDiff {
+	blocks := make([]*DocumentDiffBlock, len(diff.Blocks))
+	for i, block := range diff.Blocks {
+		blocks[i] = NewDocumentDiffBlock(block)
+	}
+
+	return &DocumentVersionDiff{
+		FromVersion: NewDocumentVersion(diff.From),
+		ToVersion:   NewDocumentVersion(diff.To),
+		Blocks:      blocks,
+	}
+}
+
+func NewDocumentDiffBlock(block docdiff.Block) *DocumentDiffBlock {
+	words := make([]*DocumentDiffWord, len(block.Words))
+	for i, word := range block.Words {
+		words[i] = &DocumentDiffWord{
+			Operation: word.Operation,
+			Text:      word.Text,
+		}
+	}
+
+	return &DocumentDiffBlock{
+		Operation: block.Operation,
+		Before:    block.Before,
+		After:     block.After,
+		Words:     words,
+	}
+}
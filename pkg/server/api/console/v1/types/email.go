@@ -0,0 +1,89 @@
+// Copyright (c) 2025 Probo Inc <hello@getprobo.com>.
+//
+// Permission to use, copy, modify, and/or distribute this software for any
+// purpose with or without fee is hereby granted, provided that the above
+// copyright notice and this permission notice appear in all copies.
+//
+// THE SOFTWARE IS PROVIDED "AS IS" AND THE AUTHOR DISCLAIMS ALL WARRANTIES WITH
+// REGARD TO THIS SOFTWARE INCLUDING ALL IMPLIED WARRANTIES OF MERCHANTABILITY
+// AND FITNESS. IN NO EVENT SHALL THE AUTHOR BE LIABLE FOR ANY SPECIAL, DIRECT,
+// INDIRECT, OR CONSEQUENTIAL DAMAGES OR ANY DAMAGES WHATSOEVER RESULTING FROM
+// LOSS OF USE, DATA OR PROFITS, WHETHER IN AN ACTION OF CONTRACT, NEGLIGENCE OR
+// OTHER TORTIOUS ACTION, ARISING OUT OF OR IN CONNECTION WITH THE USE OR
+// PERFORMANCE OF THIS SOFTWARE.
+
+package types
+
+import (
+	"go.probo.inc/probo/pkg/coredata"
+	"go.probo.inc/probo/pkg/gid"
+	"go.probo.inc/probo/pkg/page"
+)
+
+type (
+	EmailOrderBy OrderBy[coredata.EmailOrderField]
+
+	EmailConnection struct {
+		TotalCount int
+		Edges      []*EmailEdge
+		PageInfo   PageInfo
+
+		Resolver any
+		ParentID gid.GID
+		Filter   *EmailFilter
+	}
+)
+
+func NewEmailConnection(
+	p *page.Page[*coredata.Email, coredata.EmailOrderField],
+	parentType any,
+	parentID gid.GID,
+	filter *EmailFilter,
+) *EmailConnection {
+	edges := make([]*EmailEdge, len(p.Data))
+	for i, email := range p.Data {
+		edges[i] = NewEmailEdge(email, p.Cursor.OrderBy.Field)
+	}
+
+	return &EmailConnection{
+		Edges:    edges,
+		PageInfo: *NewPageInfo(p),
+
+		Resolver: parentType,
+		ParentID: parentID,
+		Filter:   filter,
+	}
+}
+
+func NewEmailEdge(e *coredata.Email, orderBy coredata.EmailOrderField) *EmailEdge {
+	return &EmailEdge{
+		Cursor: e.CursorKey(orderBy),
+		Node:   NewEmail(e),
+	}
+}
+
+func NewEmail(e *coredata.Email) *Email {
+	return &Email{
+		ID:             e.ID,
+		RecipientEmail: e.RecipientEmail,
+		RecipientName:  e.RecipientName,
+		Subject:        e.Subject,
+		Status:         e.Status,
+		AttemptCount:   e.AttemptCount,
+		LastError:      e.LastError,
+		NextAttemptAt:  e.NextAttemptAt,
+		SentAt:         e.SentAt,
+		FailedAt:       e.FailedAt,
+		CreatedAt:      e.CreatedAt,
+		UpdatedAt:      e.UpdatedAt,
+	}
+}
+
+func NewEmailFilter(filter *EmailFilter) *coredata.EmailFilter {
+	f := coredata.NewEmailFilter()
+	if filter == nil {
+		return f
+	}
+
+	return f.WithStatus(filter.Status)
+}
@@ -0,0 +1,119 @@
+// Copyright (c) 2025 Probo Inc <hello@getprobo.com>.
+//
+// Permission to use, copy, modify, and/or distribute this software for any
+// purpose with or without fee is hereby granted, provided that the above
+// copyright notice and this permission notice appear in all copies.
+//
+// THE SOFTWARE IS PROVIDED "AS IS" AND THE AUTHOR DISCLAIMS ALL WARRANTIES WITH
+// REGARD TO THIS SOFTWARE INCLUDING ALL IMPLIED WARRANTIES OF MERCHANTABILITY
+// AND FITNESS. IN NO EVENT SHALL THE AUTHOR BE LIABLE FOR ANY SPECIAL, DIRECT,
+// INDIRECT, OR CONSEQUENTIAL DAMAGES OR ANY DAMAGES WHATSOEVER RESULTING FROM
+// LOSS OF USE, DATA OR PROFITS, WHETHER IN AN ACTION OF CONTRACT, NEGLIGENCE OR
+// OTHER TORTIOUS ACTION, ARISING OUT OF OR IN CONNECTION WITH THE USE OR
+// PERFORMANCE OF THIS SOFTWARE.
+
+package types
+
+import (
+	"go.probo.inc/probo/pkg/coredata"
+	"go.probo.inc/probo/pkg/gid"
+	"go.probo.inc/probo/pkg/page"
+	"go.probo.inc/probo/pkg/probo"
+)
+
+type (
+	SnapshotScheduleOrderBy OrderBy[coredata.SnapshotScheduleOrderField]
+
+	SnapshotScheduleConnection struct {
+		TotalCount int
+		Edges      []*SnapshotScheduleEdge
+		PageInfo   PageInfo
+
+		Resolver any
+		ParentID gid.GID
+	}
+)
+
+func NewSnapshotScheduleConnection(
+	p *page.Page[*coredata.SnapshotSchedule, coredata.SnapshotScheduleOrderField],
+	parentType any,
+	parentID gid.GID,
+) *SnapshotScheduleConnection {
+	edges := make([]*SnapshotScheduleEdge, len(p.Data))
+	for i, schedule := range p.Data {
+		edges[i] = NewSnapshotScheduleEdge(schedule, p.Cursor.OrderBy.Field)
+	}
+
+	return &SnapshotScheduleConnection{
+		Edges:    edges,
+		PageInfo: *NewPageInfo(p),
+
+		Resolver: parentType,
+		ParentID: parentID,
+	}
+}
+
+func NewSnapshotScheduleEdge(s *coredata.SnapshotSchedule, orderField coredata.SnapshotScheduleOrderField) *SnapshotScheduleEdge {
+	return &SnapshotScheduleEdge{
+		Node:   NewSnapshotSchedule(s),
+		Cursor: s.CursorKey(orderField),
+	}
+}
+
+func NewSnapshotSchedule(s *coredata.SnapshotSchedule) *SnapshotSchedule {
+	schedule := &SnapshotSchedule{
+		ID: s.ID,
+		Organization: &Organization{
+			ID: s.OrganizationID,
+		},
+		Name:           s.Name,
+		Description:    s.Description,
+		Type:           s.Type,
+		CronExpression: s.CronExpression,
+		NextRunAt:      s.NextRunAt,
+		LastRunAt:      s.LastRunAt,
+		LastError:      s.LastError,
+		CreatedAt:      s.CreatedAt,
+		UpdatedAt:      s.UpdatedAt,
+	}
+
+	if s.LastSnapshotID != nil {
+		schedule.LastSnapshot = &Snapshot{ID: *s.LastSnapshotID}
+	}
+
+	return schedule
+}
+
+func NewSnapshotDiff(d *probo.SnapshotDiff) *SnapshotDiff {
+	return &SnapshotDiff{
+		From:    NewSnapshot(d.From),
+		To:      NewSnapshot(d.To),
+		Added:   NewSnapshotRowDiffs(d.Added),
+		Removed: NewSnapshotRowDiffs(d.Removed),
+		Changed: NewSnapshotRowDiffs(d.Changed),
+	}
+}
+
+func NewSnapshotRowDiffs(rows []*probo.SnapshotRowDiff) []*SnapshotRowDiff {
+	result := make([]*SnapshotRowDiff, len(rows))
+	for i, row := range rows {
+		changes := make([]*SnapshotFieldChange, len(row.Changes))
+		for j, change := range row.Changes {
+			changes[j] = &SnapshotFieldChange{
+				Field:  change.Field,
+				Before: string(change.Before),
+				After:  string(change.After),
+			}
+		}
+
+		result[i] = &SnapshotRowDiff{
+			SourceID:  row.SourceID,
+			FromRowID: row.FromRowID,
+			ToRowID:   row.ToRowID,
+			Label:     row.Label,
+			Changes:   changes,
+		}
+	}
+
+	return result
+}
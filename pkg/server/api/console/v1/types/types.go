@@ -7,6 +7,7 @@ import (
 
 	"github.com/99designs/gqlgen/graphql"
 	"go.probo.inc/probo/pkg/coredata"
+	"go.probo.inc/probo/pkg/docdiff"
 	"go.probo.inc/probo/pkg/gid"
 	"go.probo.inc/probo/pkg/mail"
 	"go.probo.inc/probo/pkg/page"
@@ -17,6 +18,33 @@ type Node interface {
 	GetID() gid.GID
 }
 
+type AcknowledgementCampaign struct {
+	ID                  gid.GID                     `json:"id"`
+	Document            *Document                   `json:"document"`
+	RecipientProfileIds []gid.GID                   `json:"recipientProfileIds"`
+	IntervalDays        int                         `json:"intervalDays"`
+	Enabled             bool                        `json:"enabled"`
+	NextRunAt           time.Time                   `json:"nextRunAt"`
+	LastRunAt           *time.Time                  `json:"lastRunAt,omitempty"`
+	LastError           *string                     `json:"lastError,omitempty"`
+	LatestRun           *AcknowledgementCampaignRun `json:"latestRun,omitempty"`
+	CreatedAt           time.Time                   `json:"createdAt"`
+	UpdatedAt           time.Time                   `json:"updatedAt"`
+	Permission          bool                        `json:"permission"`
+}
+
+func (AcknowledgementCampaign) IsNode()             {}
+func (this AcknowledgementCampaign) GetID() gid.GID { return this.ID }
+
+type AcknowledgementCampaignRun struct {
+	ID                   gid.GID          `json:"id"`
+	DocumentVersion      *DocumentVersion `json:"documentVersion"`
+	StartedAt            time.Time        `json:"startedAt"`
+	TotalCount           int              `json:"totalCount"`
+	SignedCount          int              `json:"signedCount"`
+	CompletionPercentage float64          `json:"completionPercentage"`
+}
+
 type ApplicabilityStatement struct {
 	ID                   gid.GID               `json:"id"`
 	StateOfApplicability *StateOfApplicability `json:"stateOfApplicability"`
@@ -42,6 +70,15 @@ type ApplicabilityStatementInput struct {
 	Justification *string `json:"justification,omitempty"`
 }
 
+type ApproveDocumentVersionInput struct {
+	DocumentVersionID gid.GID `json:"documentVersionId"`
+	Comment           *string `json:"comment,omitempty"`
+}
+
+type ApproveDocumentVersionPayload struct {
+	DocumentVersionApproval *DocumentVersionApproval `json:"documentVersionApproval"`
+}
+
 type AssessVendorInput struct {
 	ID         gid.GID `json:"id"`
 	WebsiteURL string  `json:"websiteUrl"`
@@ -103,6 +140,33 @@ type AuditEdge struct {
 	Node   *Audit         `json:"node"`
 }
 
+type AuditLogEntry struct {
+	ID         gid.GID                    `json:"id"`
+	ActorType  coredata.AuditLogActorType `json:"actorType"`
+	ActorID    *gid.GID                   `json:"actorId,omitempty"`
+	APIKeyID   *gid.GID                   `json:"apiKeyId,omitempty"`
+	Action     string                     `json:"action"`
+	ResourceID gid.GID                    `json:"resourceId"`
+	Changes    string                     `json:"changes"`
+	CreatedAt  time.Time                  `json:"createdAt"`
+}
+
+func (AuditLogEntry) IsNode()             {}
+func (this AuditLogEntry) GetID() gid.GID { return this.ID }
+
+type AuditLogEntryEdge struct {
+	Cursor page.CursorKey `json:"cursor"`
+	Node   *AuditLogEntry `json:"node"`
+}
+
+type AuditLogEntryFilter struct {
+	Action     *string    `json:"action,omitempty"`
+	ActorID    *gid.GID   `json:"actorId,omitempty"`
+	ResourceID *gid.GID   `json:"resourceId,omitempty"`
+	From       *time.Time `json:"from,omitempty"`
+	To         *time.Time `json:"to,omitempty"`
+}
+
 type BulkDeleteDocumentsInput struct {
 	DocumentIds []gid.GID `json:"documentIds"`
 }
@@ -149,6 +213,29 @@ type CancelSignatureRequestPayload struct {
 	DeletedDocumentVersionSignatureID gid.GID `json:"deletedDocumentVersionSignatureId"`
 }
 
+type ConnectorEvidenceMapping struct {
+	ID               gid.GID                                 `json:"id"`
+	ConnectorID      gid.GID                                 `json:"connectorId"`
+	EvidenceKey      string                                  `json:"evidenceKey"`
+	FindingAction    coredata.ConnectorEvidenceFindingAction `json:"findingAction"`
+	FindingOwnerID   *gid.GID                                `json:"findingOwnerId,omitempty"`
+	Measure          *Measure                                `json:"measure"`
+	LastCollectedAt  *time.Time                              `json:"lastCollectedAt,omitempty"`
+	NextCollectionAt *time.Time                              `json:"nextCollectionAt,omitempty"`
+	CollectionError  *string                                 `json:"collectionError,omitempty"`
+	CreatedAt        time.Time                               `json:"createdAt"`
+	UpdatedAt        time.Time                               `json:"updatedAt"`
+	Permission       bool                                    `json:"permission"`
+}
+
+func (ConnectorEvidenceMapping) IsNode()             {}
+func (this ConnectorEvidenceMapping) GetID() gid.GID { return this.ID }
+
+type ConnectorEvidenceMappingEdge struct {
+	Cursor page.CursorKey            `json:"cursor"`
+	Node   *ConnectorEvidenceMapping `json:"node"`
+}
+
 type ContinualImprovement struct {
 	ID           gid.GID                               `json:"id"`
 	SnapshotID   *gid.GID                              `json:"snapshotId,omitempty"`
@@ -179,24 +266,25 @@ type ContinualImprovementFilter struct {
 }
 
 type Control struct {
-	ID             gid.GID               `json:"id"`
-	Organization   *Organization         `json:"organization,omitempty"`
-	SectionTitle   string                `json:"sectionTitle"`
-	Name           string                `json:"name"`
-	Description    *string               `json:"description,omitempty"`
-	BestPractice   bool                  `json:"bestPractice"`
-	Regulatory     bool                  `json:"regulatory"`
-	Contractual    bool                  `json:"contractual"`
-	RiskAssessment bool                  `json:"riskAssessment"`
-	Framework      *Framework            `json:"framework"`
-	Measures       *MeasureConnection    `json:"measures"`
-	Documents      *DocumentConnection   `json:"documents"`
-	Audits         *AuditConnection      `json:"audits"`
-	Obligations    *ObligationConnection `json:"obligations"`
-	Snapshots      *SnapshotConnection   `json:"snapshots"`
-	CreatedAt      time.Time             `json:"createdAt"`
-	UpdatedAt      time.Time             `json:"updatedAt"`
-	Permission     bool                  `json:"permission"`
+	ID                 gid.GID               `json:"id"`
+	Organization       *Organization         `json:"organization,omitempty"`
+	SectionTitle       string                `json:"sectionTitle"`
+	Name               string                `json:"name"`
+	Description        *string               `json:"description,omitempty"`
+	BestPractice       bool                  `json:"bestPractice"`
+	Regulatory         bool                  `json:"regulatory"`
+	Contractual        bool                  `json:"contractual"`
+	RiskAssessment     bool                  `json:"riskAssessment"`
+	Framework          *Framework            `json:"framework"`
+	Measures           *MeasureConnection    `json:"measures"`
+	Documents          *DocumentConnection   `json:"documents"`
+	Audits             *AuditConnection      `json:"audits"`
+	Obligations        *ObligationConnection `json:"obligations"`
+	Snapshots          *SnapshotConnection   `json:"snapshots"`
+	EquivalentControls []*Control            `json:"equivalentControls"`
+	CreatedAt          time.Time             `json:"createdAt"`
+	UpdatedAt          time.Time             `json:"updatedAt"`
+	Permission         bool                  `json:"permission"`
 }
 
 func (Control) IsNode()             {}
@@ -211,6 +299,30 @@ type ControlFilter struct {
 	Query *string `json:"query,omitempty"`
 }
 
+type CreateAWSConnectorInput struct {
+	OrganizationID  gid.GID `json:"organizationId"`
+	Region          string  `json:"region"`
+	AccessKeyID     *string `json:"accessKeyId,omitempty"`
+	SecretAccessKey *string `json:"secretAccessKey,omitempty"`
+	RoleArn         *string `json:"roleArn,omitempty"`
+}
+
+type CreateAWSConnectorPayload struct {
+	ConnectorID gid.GID `json:"connectorId"`
+	ExternalID  *string `json:"externalId,omitempty"`
+}
+
+type CreateAcknowledgementCampaignInput struct {
+	DocumentID          gid.GID    `json:"documentId"`
+	RecipientProfileIds []gid.GID  `json:"recipientProfileIds"`
+	IntervalDays        int        `json:"intervalDays"`
+	StartAt             *time.Time `json:"startAt,omitempty"`
+}
+
+type CreateAcknowledgementCampaignPayload struct {
+	AcknowledgementCampaign *AcknowledgementCampaign `json:"acknowledgementCampaign"`
+}
+
 type CreateApplicabilityStatementInput struct {
 	StateOfApplicabilityID gid.GID `json:"stateOfApplicabilityId"`
 	ControlID              gid.GID `json:"controlId"`
@@ -250,6 +362,18 @@ type CreateAuditPayload struct {
 	AuditEdge *AuditEdge `json:"auditEdge"`
 }
 
+type CreateConnectorEvidenceMappingInput struct {
+	ConnectorID    gid.GID                                  `json:"connectorId"`
+	MeasureID      gid.GID                                  `json:"measureId"`
+	EvidenceKey    string                                   `json:"evidenceKey"`
+	FindingAction  *coredata.ConnectorEvidenceFindingAction `json:"findingAction,omitempty"`
+	FindingOwnerID *gid.GID                                 `json:"findingOwnerId,omitempty"`
+}
+
+type CreateConnectorEvidenceMappingPayload struct {
+	ConnectorEvidenceMappingEdge *ConnectorEvidenceMappingEdge `json:"connectorEvidenceMappingEdge"`
+}
+
 type CreateContinualImprovementInput struct {
 	OrganizationID gid.GID                               `json:"organizationId"`
 	ReferenceID    string                                `json:"referenceId"`
@@ -285,6 +409,16 @@ type CreateControlDocumentMappingPayload struct {
 	DocumentEdge *DocumentEdge `json:"documentEdge"`
 }
 
+type CreateControlEquivalenceMappingInput struct {
+	ControlID           gid.GID `json:"controlId"`
+	EquivalentControlID gid.GID `json:"equivalentControlId"`
+}
+
+type CreateControlEquivalenceMappingPayload struct {
+	ControlEdge           *ControlEdge `json:"controlEdge"`
+	EquivalentControlEdge *ControlEdge `json:"equivalentControlEdge"`
+}
+
 type CreateControlInput struct {
 	FrameworkID  gid.GID `json:"frameworkId"`
 	SectionTitle string  `json:"sectionTitle"`
@@ -366,6 +500,7 @@ type CreateDocumentInput struct {
 	Title                 string                          `json:"title"`
 	Content               string                          `json:"content"`
 	ApproverIds           []gid.GID                       `json:"approverIds"`
+	ApprovalStages        []*DocumentApprovalStageInput   `json:"approvalStages,omitempty"`
 	DocumentType          coredata.DocumentType           `json:"documentType"`
 	Classification        coredata.DocumentClassification `json:"classification"`
 	TrustCenterVisibility *coredata.TrustCenterVisibility `json:"trustCenterVisibility,omitempty"`
@@ -394,6 +529,16 @@ type CreateFrameworkPayload struct {
 	FrameworkEdge *FrameworkEdge `json:"frameworkEdge"`
 }
 
+type CreateImportJobInput struct {
+	OrganizationID gid.GID                `json:"organizationId"`
+	Type           coredata.ImportJobType `json:"type"`
+	File           graphql.Upload         `json:"file"`
+}
+
+type CreateImportJobPayload struct {
+	ImportJob *ImportJob `json:"importJob"`
+}
+
 type CreateMeasureInput struct {
 	OrganizationID gid.GID `json:"organizationId"`
 	Name           string  `json:"name"`
@@ -453,6 +598,16 @@ type CreateObligationPayload struct {
 	ObligationEdge *ObligationEdge `json:"obligationEdge"`
 }
 
+type CreateOktaConnectorInput struct {
+	OrganizationID gid.GID `json:"organizationId"`
+	Domain         string  `json:"domain"`
+	APIToken       string  `json:"apiToken"`
+}
+
+type CreateOktaConnectorPayload struct {
+	ConnectorID gid.GID `json:"connectorId"`
+}
+
 type CreateProcessingActivityInput struct {
 	OrganizationID                       gid.GID                                                   `json:"organizationId"`
 	Name                                 string                                                    `json:"name"`
@@ -555,6 +710,18 @@ type CreateSnapshotPayload struct {
 	SnapshotEdge *SnapshotEdge `json:"snapshotEdge"`
 }
 
+type CreateSnapshotScheduleInput struct {
+	OrganizationID gid.GID                `json:"organizationId"`
+	Name           string                 `json:"name"`
+	Description    *string                `json:"description,omitempty"`
+	Type           coredata.SnapshotsType `json:"type"`
+	CronExpression string                 `json:"cronExpression"`
+}
+
+type CreateSnapshotSchedulePayload struct {
+	SnapshotScheduleEdge *SnapshotScheduleEdge `json:"snapshotScheduleEdge"`
+}
+
 type CreateStateOfApplicabilityInput struct {
 	OrganizationID gid.GID `json:"organizationId"`
 	Name           string  `json:"name"`
@@ -699,6 +866,18 @@ type CreateWebhookSubscriptionPayload struct {
 	WebhookSubscriptionEdge *WebhookSubscriptionEdge `json:"webhookSubscriptionEdge"`
 }
 
+type CrosswalkControlCoverage struct {
+	Control            *Control   `json:"control"`
+	EquivalentControls []*Control `json:"equivalentControls"`
+	Measures           []*Measure `json:"measures"`
+}
+
+type CrosswalkCoverage struct {
+	TotalControlCount   int                         `json:"totalControlCount"`
+	CoveredControlCount int                         `json:"coveredControlCount"`
+	Controls            []*CrosswalkControlCoverage `json:"controls"`
+}
+
 type CustomDomain struct {
 	ID           gid.GID                        `json:"id"`
 	Organization *Organization                  `json:"organization"`
@@ -757,6 +936,29 @@ type DatumFilter struct {
 	SnapshotID *gid.GID `json:"snapshotId,omitempty"`
 }
 
+type DeadlineReminderSettings struct {
+	ID                  gid.GID    `json:"id"`
+	Enabled             bool       `json:"enabled"`
+	DaysBefore          []int      `json:"daysBefore"`
+	RemindOnDueDate     bool       `json:"remindOnDueDate"`
+	OverdueIntervalDays int        `json:"overdueIntervalDays"`
+	EscalationGraceDays *int       `json:"escalationGraceDays,omitempty"`
+	SlackEnabled        bool       `json:"slackEnabled"`
+	NextRunAt           time.Time  `json:"nextRunAt"`
+	LastRunAt           *time.Time `json:"lastRunAt,omitempty"`
+	LastError           *string    `json:"lastError,omitempty"`
+	CreatedAt           time.Time  `json:"createdAt"`
+	UpdatedAt           time.Time  `json:"updatedAt"`
+}
+
+type DeleteAcknowledgementCampaignInput struct {
+	AcknowledgementCampaignID gid.GID `json:"acknowledgementCampaignId"`
+}
+
+type DeleteAcknowledgementCampaignPayload struct {
+	DeletedAcknowledgementCampaignID gid.GID `json:"deletedAcknowledgementCampaignId"`
+}
+
 type DeleteApplicabilityStatementInput struct {
 	ApplicabilityStatementID gid.GID `json:"applicabilityStatementId"`
 }
@@ -789,6 +991,14 @@ type DeleteAuditReportPayload struct {
 	Audit *Audit `json:"audit"`
 }
 
+type DeleteConnectorEvidenceMappingInput struct {
+	ConnectorEvidenceMappingID gid.GID `json:"connectorEvidenceMappingId"`
+}
+
+type DeleteConnectorEvidenceMappingPayload struct {
+	DeletedConnectorEvidenceMappingID gid.GID `json:"deletedConnectorEvidenceMappingId"`
+}
+
 type DeleteContinualImprovementInput struct {
 	ContinualImprovementID gid.GID `json:"continualImprovementId"`
 }
@@ -817,6 +1027,16 @@ type DeleteControlDocumentMappingPayload struct {
 	DeletedDocumentID gid.GID `json:"deletedDocumentId"`
 }
 
+type DeleteControlEquivalenceMappingInput struct {
+	ControlID           gid.GID `json:"controlId"`
+	EquivalentControlID gid.GID `json:"equivalentControlId"`
+}
+
+type DeleteControlEquivalenceMappingPayload struct {
+	DeletedControlID           gid.GID `json:"deletedControlId"`
+	DeletedEquivalentControlID gid.GID `json:"deletedEquivalentControlId"`
+}
+
 type DeleteControlInput struct {
 	ControlID gid.GID `json:"controlId"`
 }
@@ -1005,6 +1225,14 @@ type DeleteSnapshotPayload struct {
 	DeletedSnapshotID gid.GID `json:"deletedSnapshotId"`
 }
 
+type DeleteSnapshotScheduleInput struct {
+	SnapshotScheduleID gid.GID `json:"snapshotScheduleId"`
+}
+
+type DeleteSnapshotSchedulePayload struct {
+	DeletedSnapshotScheduleID gid.GID `json:"deletedSnapshotScheduleId"`
+}
+
 type DeleteStateOfApplicabilityInput struct {
 	StateOfApplicabilityID gid.GID `json:"stateOfApplicabilityId"`
 }
@@ -1118,32 +1346,55 @@ type DeleteWebhookSubscriptionPayload struct {
 }
 
 type Document struct {
-	ID                      gid.GID                         `json:"id"`
-	Title                   string                          `json:"title"`
-	Description             *string                         `json:"description,omitempty"`
-	DocumentType            coredata.DocumentType           `json:"documentType"`
-	Classification          coredata.DocumentClassification `json:"classification"`
-	CurrentPublishedVersion *int                            `json:"currentPublishedVersion,omitempty"`
-	TrustCenterVisibility   coredata.TrustCenterVisibility  `json:"trustCenterVisibility"`
-	Approvers               *ProfileConnection              `json:"approvers"`
-	Organization            *Organization                   `json:"organization"`
-	Versions                *DocumentVersionConnection      `json:"versions"`
-	Controls                *ControlConnection              `json:"controls"`
-	CreatedAt               time.Time                       `json:"createdAt"`
-	UpdatedAt               time.Time                       `json:"updatedAt"`
-	Permission              bool                            `json:"permission"`
+	ID                       gid.GID                         `json:"id"`
+	Title                    string                          `json:"title"`
+	Description              *string                         `json:"description,omitempty"`
+	DocumentType             coredata.DocumentType           `json:"documentType"`
+	Classification           coredata.DocumentClassification `json:"classification"`
+	CurrentPublishedVersion  *int                            `json:"currentPublishedVersion,omitempty"`
+	TrustCenterVisibility    coredata.TrustCenterVisibility  `json:"trustCenterVisibility"`
+	Owner                    *Profile                        `json:"owner,omitempty"`
+	ReviewIntervalDays       *int                            `json:"reviewIntervalDays,omitempty"`
+	NextReviewDate           *time.Time                      `json:"nextReviewDate,omitempty"`
+	LastReviewedAt           *time.Time                      `json:"lastReviewedAt,omitempty"`
+	Approvers                *ProfileConnection              `json:"approvers"`
+	Organization             *Organization                   `json:"organization"`
+	Versions                 *DocumentVersionConnection      `json:"versions"`
+	Controls                 *ControlConnection              `json:"controls"`
+	AcknowledgementCampaigns []*AcknowledgementCampaign      `json:"acknowledgementCampaigns"`
+	VersionDiff              *DocumentVersionDiff            `json:"versionDiff"`
+	CreatedAt                time.Time                       `json:"createdAt"`
+	UpdatedAt                time.Time                       `json:"updatedAt"`
+	Permission               bool                            `json:"permission"`
 }
 
 func (Document) IsNode()             {}
 func (this Document) GetID() gid.GID { return this.ID }
 
+type DocumentApprovalStageInput struct {
+	ApproverIds []gid.GID `json:"approverIds"`
+}
+
+type DocumentDiffBlock struct {
+	Operation docdiff.Operation   `json:"operation"`
+	Before    string              `json:"before"`
+	After     string              `json:"after"`
+	Words     []*DocumentDiffWord `json:"words"`
+}
+
+type DocumentDiffWord struct {
+	Operation docdiff.Operation `json:"operation"`
+	Text      string            `json:"text"`
+}
+
 type DocumentEdge struct {
 	Cursor page.CursorKey `json:"cursor"`
 	Node   *Document      `json:"node"`
 }
 
 type DocumentFilter struct {
-	Query *string `json:"query,omitempty"`
+	Query         *string `json:"query,omitempty"`
+	ReviewOverdue *bool   `json:"reviewOverdue,omitempty"`
 }
 
 type DocumentVersion struct {
@@ -1158,6 +1409,7 @@ type DocumentVersion struct {
 	Approvers      *ProfileConnection                  `json:"approvers"`
 	Signatures     *DocumentVersionSignatureConnection `json:"signatures"`
 	Signed         bool                                `json:"signed"`
+	Approvals      []*DocumentVersionApproval          `json:"approvals"`
 	PublishedAt    *time.Time                          `json:"publishedAt,omitempty"`
 	CreatedAt      time.Time                           `json:"createdAt"`
 	UpdatedAt      time.Time                           `json:"updatedAt"`
@@ -1167,6 +1419,12 @@ type DocumentVersion struct {
 func (DocumentVersion) IsNode()             {}
 func (this DocumentVersion) GetID() gid.GID { return this.ID }
 
+type DocumentVersionDiff struct {
+	FromVersion *DocumentVersion     `json:"fromVersion"`
+	ToVersion   *DocumentVersion     `json:"toVersion"`
+	Blocks      []*DocumentDiffBlock `json:"blocks"`
+}
+
 type DocumentVersionEdge struct {
 	Cursor page.CursorKey   `json:"cursor"`
 	Node   *DocumentVersion `json:"node"`
@@ -1206,6 +1464,30 @@ type DocumentVersionSignatureOrder struct {
 	Direction page.OrderDirection                         `json:"direction"`
 }
 
+type Email struct {
+	ID             gid.GID              `json:"id"`
+	RecipientEmail string               `json:"recipientEmail"`
+	RecipientName  string               `json:"recipientName"`
+	Subject        string               `json:"subject"`
+	Status         coredata.EmailStatus `json:"status"`
+	AttemptCount   int                  `json:"attemptCount"`
+	LastError      *string              `json:"lastError,omitempty"`
+	NextAttemptAt  *time.Time           `json:"nextAttemptAt,omitempty"`
+	SentAt         *time.Time           `json:"sentAt,omitempty"`
+	FailedAt       *time.Time           `json:"failedAt,omitempty"`
+	CreatedAt      time.Time            `json:"createdAt"`
+	UpdatedAt      time.Time            `json:"updatedAt"`
+}
+
+type EmailEdge struct {
+	Cursor page.CursorKey `json:"cursor"`
+	Node   *Email         `json:"node"`
+}
+
+type EmailFilter struct {
+	Status *coredata.EmailStatus `json:"status,omitempty"`
+}
+
 type Evidence struct {
 	ID          gid.GID                `json:"id"`
 	Size        int                    `json:"size"`
@@ -1229,6 +1511,15 @@ type EvidenceEdge struct {
 	Node   *Evidence      `json:"node"`
 }
 
+type ExportAuditLogInput struct {
+	OrganizationID gid.GID              `json:"organizationId"`
+	Filter         *AuditLogEntryFilter `json:"filter,omitempty"`
+}
+
+type ExportAuditLogPayload struct {
+	ExportJobID gid.GID `json:"exportJobId"`
+}
+
 type ExportDataProtectionImpactAssessmentsPDFInput struct {
 	OrganizationID gid.GID                               `json:"organizationId"`
 	Filter         *DataProtectionImpactAssessmentFilter `json:"filter,omitempty"`
@@ -1238,6 +1529,15 @@ type ExportDataProtectionImpactAssessmentsPDFPayload struct {
 	Data string `json:"data"`
 }
 
+type ExportDocumentVersionDiffPDFInput struct {
+	FromDocumentVersionID gid.GID `json:"fromDocumentVersionId"`
+	ToDocumentVersionID   gid.GID `json:"toDocumentVersionId"`
+}
+
+type ExportDocumentVersionDiffPDFPayload struct {
+	Data string `json:"data"`
+}
+
 type ExportDocumentVersionPDFInput struct {
 	DocumentVersionID gid.GID    `json:"documentVersionId"`
 	WithWatermark     bool       `json:"withWatermark"`
@@ -1249,8 +1549,17 @@ type ExportDocumentVersionPDFPayload struct {
 	Data string `json:"data"`
 }
 
+type ExportDocumentVersionSignatureCertificatePDFInput struct {
+	DocumentVersionID gid.GID `json:"documentVersionId"`
+}
+
+type ExportDocumentVersionSignatureCertificatePDFPayload struct {
+	Data string `json:"data"`
+}
+
 type ExportFrameworkInput struct {
-	FrameworkID gid.GID `json:"frameworkId"`
+	FrameworkID gid.GID                        `json:"frameworkId"`
+	Format      coredata.FrameworkExportFormat `json:"format"`
 }
 
 type ExportFrameworkPayload struct {
@@ -1302,16 +1611,17 @@ type File struct {
 }
 
 type Framework struct {
-	ID           gid.GID            `json:"id"`
-	Name         string             `json:"name"`
-	Description  *string            `json:"description,omitempty"`
-	Organization *Organization      `json:"organization"`
-	Controls     *ControlConnection `json:"controls"`
-	LightLogoURL *string            `json:"lightLogoURL,omitempty"`
-	DarkLogoURL  *string            `json:"darkLogoURL,omitempty"`
-	CreatedAt    time.Time          `json:"createdAt"`
-	UpdatedAt    time.Time          `json:"updatedAt"`
-	Permission   bool               `json:"permission"`
+	ID                gid.GID            `json:"id"`
+	Name              string             `json:"name"`
+	Description       *string            `json:"description,omitempty"`
+	Organization      *Organization      `json:"organization"`
+	Controls          *ControlConnection `json:"controls"`
+	LightLogoURL      *string            `json:"lightLogoURL,omitempty"`
+	DarkLogoURL       *string            `json:"darkLogoURL,omitempty"`
+	CrosswalkCoverage *CrosswalkCoverage `json:"crosswalkCoverage"`
+	CreatedAt         time.Time          `json:"createdAt"`
+	UpdatedAt         time.Time          `json:"updatedAt"`
+	Permission        bool               `json:"permission"`
 }
 
 func (Framework) IsNode()             {}
@@ -1338,6 +1648,18 @@ type GetTrustCenterFilePayload struct {
 	TrustCenterFile *TrustCenterFile `json:"trustCenterFile"`
 }
 
+type ImportCrosswalkInput struct {
+	OrganizationID gid.GID        `json:"organizationId"`
+	File           graphql.Upload `json:"file"`
+}
+
+type ImportCrosswalkPayload struct {
+	SourceFramework *Framework `json:"sourceFramework"`
+	TargetFramework *Framework `json:"targetFramework"`
+	ImportedCount   int        `json:"importedCount"`
+	SkippedCount    int        `json:"skippedCount"`
+}
+
 type ImportFrameworkInput struct {
 	OrganizationID gid.GID        `json:"organizationId"`
 	File           graphql.Upload `json:"file"`
@@ -1347,6 +1669,21 @@ type ImportFrameworkPayload struct {
 	FrameworkEdge *FrameworkEdge `json:"frameworkEdge"`
 }
 
+type ImportJob struct {
+	ID            gid.GID                  `json:"id"`
+	Type          coredata.ImportJobType   `json:"type"`
+	Status        coredata.ImportJobStatus `json:"status"`
+	Error         *string                  `json:"error,omitempty"`
+	RowErrors     []*ImportRowError        `json:"rowErrors"`
+	ImportedCount int                      `json:"importedCount"`
+	CreatedAt     time.Time                `json:"createdAt"`
+	StartedAt     *time.Time               `json:"startedAt,omitempty"`
+	CompletedAt   *time.Time               `json:"completedAt,omitempty"`
+}
+
+func (ImportJob) IsNode()             {}
+func (this ImportJob) GetID() gid.GID { return this.ID }
+
 type ImportMeasureInput struct {
 	OrganizationID gid.GID        `json:"organizationId"`
 	File           graphql.Upload `json:"file"`
@@ -1356,19 +1693,44 @@ type ImportMeasurePayload struct {
 	MeasureEdges []*MeasureEdge `json:"measureEdges"`
 }
 
+type ImportOSCALFrameworkInput struct {
+	OrganizationID gid.GID         `json:"organizationId"`
+	File           graphql.Upload  `json:"file"`
+	CatalogFile    *graphql.Upload `json:"catalogFile,omitempty"`
+}
+
+type ImportOSCALFrameworkPayload struct {
+	FrameworkEdge *FrameworkEdge `json:"frameworkEdge"`
+}
+
+type ImportRowError struct {
+	Row     int     `json:"row"`
+	Column  *string `json:"column,omitempty"`
+	Message string  `json:"message"`
+}
+
+type MarkDocumentReviewedInput struct {
+	DocumentID gid.GID `json:"documentId"`
+}
+
+type MarkDocumentReviewedPayload struct {
+	Document *Document `json:"document"`
+}
+
 type Measure struct {
-	ID          gid.GID               `json:"id"`
-	Category    string                `json:"category"`
-	Name        string                `json:"name"`
-	Description *string               `json:"description,omitempty"`
-	State       coredata.MeasureState `json:"state"`
-	Evidences   *EvidenceConnection   `json:"evidences"`
-	Tasks       *TaskConnection       `json:"tasks"`
-	Risks       *RiskConnection       `json:"risks"`
-	Controls    *ControlConnection    `json:"controls"`
-	CreatedAt   time.Time             `json:"createdAt"`
-	UpdatedAt   time.Time             `json:"updatedAt"`
-	Permission  bool                  `json:"permission"`
+	ID                        gid.GID                             `json:"id"`
+	Category                  string                              `json:"category"`
+	Name                      string                              `json:"name"`
+	Description               *string                             `json:"description,omitempty"`
+	State                     coredata.MeasureState               `json:"state"`
+	Evidences                 *EvidenceConnection                 `json:"evidences"`
+	ConnectorEvidenceMappings *ConnectorEvidenceMappingConnection `json:"connectorEvidenceMappings"`
+	Tasks                     *TaskConnection                     `json:"tasks"`
+	Risks                     *RiskConnection                     `json:"risks"`
+	Controls                  *ControlConnection                  `json:"controls"`
+	CreatedAt                 time.Time                           `json:"createdAt"`
+	UpdatedAt                 time.Time                           `json:"updatedAt"`
+	Permission                bool                                `json:"permission"`
 }
 
 func (Measure) IsNode()             {}
@@ -1502,10 +1864,15 @@ type Organization struct {
 	DataProtectionImpactAssessments *DataProtectionImpactAssessmentConnection `json:"dataProtectionImpactAssessments"`
 	TransferImpactAssessments       *TransferImpactAssessmentConnection       `json:"transferImpactAssessments"`
 	Snapshots                       *SnapshotConnection                       `json:"snapshots"`
+	SnapshotSchedules               *SnapshotScheduleConnection               `json:"snapshotSchedules"`
+	SnapshotDiff                    *SnapshotDiff                             `json:"snapshotDiff"`
 	TrustCenterFiles                *TrustCenterFileConnection                `json:"trustCenterFiles"`
 	TrustCenter                     *TrustCenter                              `json:"trustCenter,omitempty"`
 	CustomDomain                    *CustomDomain                             `json:"customDomain,omitempty"`
 	WebhookSubscriptions            *WebhookSubscriptionConnection            `json:"webhookSubscriptions"`
+	AuditLogEntries                 *AuditLogEntryConnection                  `json:"auditLogEntries"`
+	Emails                          *EmailConnection                          `json:"emails"`
+	DeadlineReminderSettings        *DeadlineReminderSettings                 `json:"deadlineReminderSettings"`
 	CreatedAt                       time.Time                                 `json:"createdAt"`
 	UpdatedAt                       time.Time                                 `json:"updatedAt"`
 	Permission                      bool                                      `json:"permission"`
@@ -1526,6 +1893,17 @@ type PageInfo struct {
 	EndCursor       *page.CursorKey `json:"endCursor,omitempty"`
 }
 
+type PreviewImportInput struct {
+	OrganizationID gid.GID                `json:"organizationId"`
+	Type           coredata.ImportJobType `json:"type"`
+	File           graphql.Upload         `json:"file"`
+}
+
+type PreviewImportPayload struct {
+	RowCount  int               `json:"rowCount"`
+	RowErrors []*ImportRowError `json:"rowErrors"`
+}
+
 type ProcessingActivity struct {
 	ID                                   gid.GID                                                   `json:"id"`
 	SnapshotID                           *gid.GID                                                  `json:"snapshotId,omitempty"`
@@ -1609,6 +1987,41 @@ type PublishDocumentVersionPayload struct {
 type Query struct {
 }
 
+type RedriveWebhookEventInput struct {
+	WebhookEventID gid.GID `json:"webhookEventId"`
+}
+
+type RedriveWebhookEventPayload struct {
+	WebhookEvent *WebhookEvent `json:"webhookEvent"`
+}
+
+type RejectDocumentVersionInput struct {
+	DocumentVersionID gid.GID `json:"documentVersionId"`
+	Comment           string  `json:"comment"`
+}
+
+type RejectDocumentVersionPayload struct {
+	DocumentVersionApproval *DocumentVersionApproval `json:"documentVersionApproval"`
+}
+
+type ReplayWebhookEventInput struct {
+	WebhookEventID gid.GID `json:"webhookEventId"`
+}
+
+type ReplayWebhookEventPayload struct {
+	WebhookEventEdge *WebhookEventEdge `json:"webhookEventEdge"`
+}
+
+type ReplayWebhookEventsInput struct {
+	WebhookSubscriptionID gid.GID   `json:"webhookSubscriptionId"`
+	From                  time.Time `json:"from"`
+	To                    time.Time `json:"to"`
+}
+
+type ReplayWebhookEventsPayload struct {
+	WebhookEventEdges []*WebhookEventEdge `json:"webhookEventEdges"`
+}
+
 type Report struct {
 	ID          gid.GID   `json:"id"`
 	ObjectKey   string    `json:"objectKey"`
@@ -1625,6 +2038,14 @@ type Report struct {
 func (Report) IsNode()             {}
 func (this Report) GetID() gid.GID { return this.ID }
 
+type RequestDocumentVersionApprovalInput struct {
+	DocumentVersionID gid.GID `json:"documentVersionId"`
+}
+
+type RequestDocumentVersionApprovalPayload struct {
+	DocumentVersion *DocumentVersion `json:"documentVersion"`
+}
+
 type RequestSignatureInput struct {
 	DocumentVersionID gid.GID `json:"documentVersionId"`
 	SignatoryID       gid.GID `json:"signatoryId"`
@@ -1703,6 +2124,16 @@ type SendSigningNotificationsPayload struct {
 	Success bool `json:"success"`
 }
 
+type SendWebhookTestEventInput struct {
+	WebhookSubscriptionID gid.GID `json:"webhookSubscriptionId"`
+}
+
+type SendWebhookTestEventPayload struct {
+	Succeeded bool    `json:"succeeded"`
+	Response  *string `json:"response,omitempty"`
+	Error     *string `json:"error,omitempty"`
+}
+
 type SignDocumentInput struct {
 	DocumentVersionID gid.GID `json:"documentVersionId"`
 }
@@ -1743,11 +2174,57 @@ type Snapshot struct {
 func (Snapshot) IsNode()             {}
 func (this Snapshot) GetID() gid.GID { return this.ID }
 
+type SnapshotDiff struct {
+	From    *Snapshot          `json:"from"`
+	To      *Snapshot          `json:"to"`
+	Added   []*SnapshotRowDiff `json:"added"`
+	Removed []*SnapshotRowDiff `json:"removed"`
+	Changed []*SnapshotRowDiff `json:"changed"`
+}
+
 type SnapshotEdge struct {
 	Cursor page.CursorKey `json:"cursor"`
 	Node   *Snapshot      `json:"node"`
 }
 
+type SnapshotFieldChange struct {
+	Field  string `json:"field"`
+	Before string `json:"before"`
+	After  string `json:"after"`
+}
+
+type SnapshotRowDiff struct {
+	SourceID  *gid.GID               `json:"sourceId,omitempty"`
+	FromRowID *gid.GID               `json:"fromRowId,omitempty"`
+	ToRowID   *gid.GID               `json:"toRowId,omitempty"`
+	Label     string                 `json:"label"`
+	Changes   []*SnapshotFieldChange `json:"changes"`
+}
+
+type SnapshotSchedule struct {
+	ID             gid.GID                `json:"id"`
+	Organization   *Organization          `json:"organization"`
+	Name           string                 `json:"name"`
+	Description    *string                `json:"description,omitempty"`
+	Type           coredata.SnapshotsType `json:"type"`
+	CronExpression string                 `json:"cronExpression"`
+	NextRunAt      time.Time              `json:"nextRunAt"`
+	LastRunAt      *time.Time             `json:"lastRunAt,omitempty"`
+	LastSnapshot   *Snapshot              `json:"lastSnapshot,omitempty"`
+	LastError      *string                `json:"lastError,omitempty"`
+	CreatedAt      time.Time              `json:"createdAt"`
+	UpdatedAt      time.Time              `json:"updatedAt"`
+	Permission     bool                   `json:"permission"`
+}
+
+func (SnapshotSchedule) IsNode()             {}
+func (this SnapshotSchedule) GetID() gid.GID { return this.ID }
+
+type SnapshotScheduleEdge struct {
+	Cursor page.CursorKey    `json:"cursor"`
+	Node   *SnapshotSchedule `json:"node"`
+}
+
 type StateOfApplicability struct {
 	ID                      gid.GID                           `json:"id"`
 	Name                    string                            `json:"name"`
@@ -1929,6 +2406,18 @@ type TrustCenterReferenceEdge struct {
 	Node   *TrustCenterReference `json:"node"`
 }
 
+type UpdateAcknowledgementCampaignInput struct {
+	ID                  gid.GID    `json:"id"`
+	RecipientProfileIds []gid.GID  `json:"recipientProfileIds,omitempty"`
+	IntervalDays        *int       `json:"intervalDays,omitempty"`
+	Enabled             *bool      `json:"enabled,omitempty"`
+	NextRunAt           *time.Time `json:"nextRunAt,omitempty"`
+}
+
+type UpdateAcknowledgementCampaignPayload struct {
+	AcknowledgementCampaign *AcknowledgementCampaign `json:"acknowledgementCampaign"`
+}
+
 type UpdateApplicabilityStatementInput struct {
 	ApplicabilityStatementID gid.GID `json:"applicabilityStatementId"`
 	Applicability            bool    `json:"applicability"`
@@ -2018,14 +2507,31 @@ type UpdateDatumPayload struct {
 	Datum *Datum `json:"datum"`
 }
 
+type UpdateDeadlineReminderSettingsInput struct {
+	OrganizationID      gid.GID                 `json:"organizationId"`
+	Enabled             *bool                   `json:"enabled,omitempty"`
+	DaysBefore          []int                   `json:"daysBefore,omitempty"`
+	RemindOnDueDate     *bool                   `json:"remindOnDueDate,omitempty"`
+	OverdueIntervalDays *int                    `json:"overdueIntervalDays,omitempty"`
+	EscalationGraceDays graphql.Omittable[*int] `json:"escalationGraceDays,omitempty"`
+	SlackEnabled        *bool                   `json:"slackEnabled,omitempty"`
+}
+
+type UpdateDeadlineReminderSettingsPayload struct {
+	DeadlineReminderSettings *DeadlineReminderSettings `json:"deadlineReminderSettings"`
+}
+
 type UpdateDocumentInput struct {
 	ID                    gid.GID                          `json:"id"`
 	Title                 *string                          `json:"title,omitempty"`
 	Content               *string                          `json:"content,omitempty"`
 	ApproverIds           []gid.GID                        `json:"approverIds,omitempty"`
+	ApprovalStages        []*DocumentApprovalStageInput    `json:"approvalStages,omitempty"`
 	DocumentType          *coredata.DocumentType           `json:"documentType,omitempty"`
 	Classification        *coredata.DocumentClassification `json:"classification,omitempty"`
 	TrustCenterVisibility *coredata.TrustCenterVisibility  `json:"trustCenterVisibility,omitempty"`
+	OwnerID               graphql.Omittable[*gid.GID]      `json:"ownerId,omitempty"`
+	ReviewIntervalDays    graphql.Omittable[*int]          `json:"reviewIntervalDays,omitempty"`
 }
 
 type UpdateDocumentPayload struct {
@@ -2181,6 +2687,18 @@ type UpdateRiskPayload struct {
 	Risk *Risk `json:"risk"`
 }
 
+type UpdateSnapshotScheduleInput struct {
+	ID             gid.GID                    `json:"id"`
+	Name           *string                    `json:"name,omitempty"`
+	Description    graphql.Omittable[*string] `json:"description,omitempty"`
+	Type           *coredata.SnapshotsType    `json:"type,omitempty"`
+	CronExpression *string                    `json:"cronExpression,omitempty"`
+}
+
+type UpdateSnapshotSchedulePayload struct {
+	SnapshotSchedule *SnapshotSchedule `json:"snapshotSchedule"`
+}
+
 type UpdateStateOfApplicabilityInput struct {
 	ID      gid.GID  `json:"id"`
 	Name    *string  `json:"name,omitempty"`
@@ -2357,6 +2875,20 @@ type UpdateWebhookSubscriptionPayload struct {
 	WebhookSubscription *WebhookSubscription `json:"webhookSubscription"`
 }
 
+type UpgradeFrameworkInput struct {
+	FrameworkID gid.GID        `json:"frameworkId"`
+	File        graphql.Upload `json:"file"`
+	MappingFile graphql.Upload `json:"mappingFile"`
+}
+
+type UpgradeFrameworkPayload struct {
+	FrameworkEdge        *FrameworkEdge `json:"frameworkEdge"`
+	MigratedControlCount int            `json:"migratedControlCount"`
+	UnmappedControls     []*Control     `json:"unmappedControls"`
+	NewControls          []*Control     `json:"newControls"`
+	TaskEdges            []*TaskEdge    `json:"taskEdges"`
+}
+
 type UploadAuditReportInput struct {
 	AuditID gid.GID        `json:"auditId"`
 	File    graphql.Upload `json:"file"`
@@ -2614,6 +3146,9 @@ type WebhookEvent struct {
 	WebhookSubscriptionID gid.GID                     `json:"webhookSubscriptionId"`
 	Status                coredata.WebhookEventStatus `json:"status"`
 	Response              *string                     `json:"response,omitempty"`
+	Attempts              int                         `json:"attempts"`
+	NextAttemptAt         *time.Time                  `json:"nextAttemptAt,omitempty"`
+	LastAttemptedAt       *time.Time                  `json:"lastAttemptedAt,omitempty"`
 	CreatedAt             time.Time                   `json:"createdAt"`
 }
 
@@ -68,10 +68,13 @@ func NewWebhookEvent(we *coredata.WebhookEvent) *WebhookEvent {
 	}
 
 	return &WebhookEvent{
-		ID:                     we.ID,
+		ID:                    we.ID,
 		WebhookSubscriptionID: we.WebhookSubscriptionID,
-		Status:                 we.Status,
-		Response:               response,
-		CreatedAt:              we.CreatedAt,
+		Status:                we.Status,
+		Response:              response,
+		Attempts:              we.Attempts,
+		NextAttemptAt:         we.NextAttemptAt,
+		LastAttemptedAt:       we.LastAttemptedAt,
+		CreatedAt:             we.CreatedAt,
 	}
 }
@@ -15,9 +15,11 @@ import (
 
 	pgx "github.com/jackc/pgx/v5"
 	"go.gearno.de/kit/log"
+	"go.probo.inc/probo/pkg/connector"
 	"go.probo.inc/probo/pkg/coredata"
 	"go.probo.inc/probo/pkg/gid"
 	"go.probo.inc/probo/pkg/iam"
+	"go.probo.inc/probo/pkg/oscal"
 	"go.probo.inc/probo/pkg/page"
 	"go.probo.inc/probo/pkg/probo"
 	"go.probo.inc/probo/pkg/server/api/authn"
@@ -25,8 +27,78 @@ import (
 	"go.probo.inc/probo/pkg/server/api/console/v1/types"
 	"go.probo.inc/probo/pkg/server/gqlutils"
 	"go.probo.inc/probo/pkg/server/gqlutils/types/cursor"
+	"go.probo.inc/probo/pkg/spreadsheet"
+	"go.probo.inc/probo/pkg/validator"
 )
 
+// Document is the resolver for the document field.
+func (r *acknowledgementCampaignResolver) Document(ctx context.Context, obj *types.AcknowledgementCampaign) (*types.Document, error) {
+	if err := r.authorize(ctx, obj.ID, probo.ActionDocumentGet); err != nil {
+		return nil, err
+	}
+
+	prb := r.ProboService(ctx, obj.ID.TenantID())
+
+	document, err := prb.Documents.Get(ctx, obj.Document.ID)
+	if err != nil {
+		if errors.Is(err, coredata.ErrResourceNotFound) {
+			return nil, gqlutils.NotFound(ctx, err)
+		}
+
+		// TODO no panic use gqlutils.InternalError
+		panic(fmt.Errorf("cannot get document: %w", err))
+	}
+
+	return types.NewDocument(document), nil
+}
+
+// LatestRun is the resolver for the latestRun field.
+func (r *acknowledgementCampaignResolver) LatestRun(ctx context.Context, obj *types.AcknowledgementCampaign) (*types.AcknowledgementCampaignRun, error) {
+	if err := r.authorize(ctx, obj.ID, probo.ActionAcknowledgementCampaignGet); err != nil {
+		return nil, err
+	}
+
+	prb := r.ProboService(ctx, obj.ID.TenantID())
+
+	run, completion, err := prb.AcknowledgementCampaigns.GetLatestRun(ctx, obj.ID)
+	if err != nil {
+		if errors.Is(err, coredata.ErrResourceNotFound) {
+			return nil, nil
+		}
+
+		// TODO no panic use gqlutils.InternalError
+		panic(fmt.Errorf("cannot get latest acknowledgement campaign run: %w", err))
+	}
+
+	return types.NewAcknowledgementCampaignRun(run, completion), nil
+}
+
+// Permission is the resolver for the permission field.
+func (r *acknowledgementCampaignResolver) Permission(ctx context.Context, obj *types.AcknowledgementCampaign, action string) (bool, error) {
+	return r.Resolver.Permission(ctx, obj, action)
+}
+
+// DocumentVersion is the resolver for the documentVersion field.
+func (r *acknowledgementCampaignRunResolver) DocumentVersion(ctx context.Context, obj *types.AcknowledgementCampaignRun) (*types.DocumentVersion, error) {
+	if err := r.authorize(ctx, obj.ID, probo.ActionDocumentVersionGet); err != nil {
+		return nil, err
+	}
+
+	prb := r.ProboService(ctx, obj.ID.TenantID())
+
+	documentVersion, err := prb.Documents.GetVersion(ctx, obj.DocumentVersion.ID)
+	if err != nil {
+		if errors.Is(err, coredata.ErrResourceNotFound) {
+			return nil, gqlutils.NotFound(ctx, err)
+		}
+
+		// TODO no panic use gqlutils.InternalError
+		panic(fmt.Errorf("cannot get document version: %w", err))
+	}
+
+	return types.NewDocumentVersion(documentVersion), nil
+}
+
 // StateOfApplicability is the resolver for the stateOfApplicability field.
 func (r *applicabilityStatementResolver) StateOfApplicability(ctx context.Context, obj *types.ApplicabilityStatement) (*types.StateOfApplicability, error) {
 	if err := r.authorize(ctx, obj.StateOfApplicability.ID, probo.ActionStateOfApplicabilityGet); err != nil {
@@ -324,6 +396,71 @@ func (r *auditConnectionResolver) TotalCount(ctx context.Context, obj *types.Aud
 	return count, nil
 }
 
+// TotalCount is the resolver for the totalCount field.
+func (r *auditLogEntryConnectionResolver) TotalCount(ctx context.Context, obj *types.AuditLogEntryConnection) (int, error) {
+	if err := r.authorize(ctx, obj.ParentID, probo.ActionAuditLogEntryList); err != nil {
+		return 0, err
+	}
+
+	prb := r.ProboService(ctx, obj.ParentID.TenantID())
+
+	count, err := prb.AuditLogEntries.CountForOrganizationID(ctx, obj.ParentID, types.NewAuditLogEntryFilter(obj.Filter))
+	if err != nil {
+		r.logger.ErrorCtx(ctx, "cannot count audit log entries", log.Error(err))
+		return 0, gqlutils.Internal(ctx)
+	}
+
+	return count, nil
+}
+
+// Measure is the resolver for the measure field.
+func (r *connectorEvidenceMappingResolver) Measure(ctx context.Context, obj *types.ConnectorEvidenceMapping) (*types.Measure, error) {
+	if err := r.authorize(ctx, obj.ID, probo.ActionMeasureGet); err != nil {
+		return nil, err
+	}
+
+	prb := r.ProboService(ctx, obj.ID.TenantID())
+
+	measure, err := prb.Measures.Get(ctx, obj.Measure.ID)
+	if err != nil {
+		if errors.Is(err, coredata.ErrResourceNotFound) {
+			return nil, gqlutils.NotFound(ctx, err)
+		}
+
+		r.logger.ErrorCtx(ctx, "cannot load measure", log.Error(err))
+		return nil, gqlutils.Internal(ctx)
+	}
+
+	return types.NewMeasure(measure), nil
+}
+
+// Permission is the resolver for the permission field.
+func (r *connectorEvidenceMappingResolver) Permission(ctx context.Context, obj *types.ConnectorEvidenceMapping, action string) (bool, error) {
+	return r.Resolver.Permission(ctx, obj, action)
+}
+
+// TotalCount is the resolver for the totalCount field.
+func (r *connectorEvidenceMappingConnectionResolver) TotalCount(ctx context.Context, obj *types.ConnectorEvidenceMappingConnection) (int, error) {
+	if err := r.authorize(ctx, obj.ParentID, probo.ActionConnectorEvidenceMappingList); err != nil {
+		return 0, err
+	}
+
+	prb := r.ProboService(ctx, obj.ParentID.TenantID())
+
+	switch obj.Resolver.(type) {
+	case *measureResolver:
+		count, err := prb.ConnectorEvidenceMappings.CountForMeasureID(ctx, obj.ParentID)
+		if err != nil {
+			r.logger.ErrorCtx(ctx, "cannot count measure connector evidence mappings", log.Error(err))
+			return 0, gqlutils.Internal(ctx)
+		}
+		return count, nil
+	}
+
+	r.logger.ErrorCtx(ctx, "unsupported resolver", log.Any("resolver", obj.Resolver))
+	return 0, gqlutils.Internal(ctx)
+}
+
 // Organization is the resolver for the organization field.
 func (r *continualImprovementResolver) Organization(ctx context.Context, obj *types.ContinualImprovement) (*types.Organization, error) {
 	if err := r.authorize(ctx, obj.ID, probo.ActionOrganizationGet); err != nil {
@@ -528,7 +665,7 @@ func (r *controlResolver) Documents(ctx context.Context, obj *types.Control, fir
 
 	var documentFilter = coredata.NewDocumentFilter(nil)
 	if filter != nil {
-		documentFilter = coredata.NewDocumentFilter(filter.Query)
+		documentFilter = coredata.NewDocumentFilter(filter.Query).WithReviewOverdue(filter.ReviewOverdue)
 	}
 
 	page, err := prb.Documents.ListForControlID(ctx, obj.ID, cursor, documentFilter)
@@ -632,6 +769,23 @@ func (r *controlResolver) Snapshots(ctx context.Context, obj *types.Control, fir
 	return types.NewSnapshotConnection(page, r, obj.ID), nil
 }
 
+// EquivalentControls is the resolver for the equivalentControls field.
+func (r *controlResolver) EquivalentControls(ctx context.Context, obj *types.Control) ([]*types.Control, error) {
+	if err := r.authorize(ctx, obj.ID, probo.ActionControlList); err != nil {
+		return nil, err
+	}
+
+	prb := r.ProboService(ctx, obj.ID.TenantID())
+
+	controls, err := prb.Controls.ListEquivalents(ctx, obj.ID)
+	if err != nil {
+		// TODO no panic use gqlutils.InternalError
+		panic(fmt.Errorf("cannot list equivalent controls: %w", err))
+	}
+
+	return types.NewControls(controls), nil
+}
+
 // Permission is the resolver for the permission field.
 func (r *controlResolver) Permission(ctx context.Context, obj *types.Control, action string) (bool, error) {
 	return r.Resolver.Permission(ctx, obj, action)
@@ -862,6 +1016,29 @@ func (r *datumConnectionResolver) TotalCount(ctx context.Context, obj *types.Dat
 	panic(fmt.Errorf("unsupported resolver: %T", obj.Resolver))
 }
 
+// Owner is the resolver for the owner field.
+func (r *documentResolver) Owner(ctx context.Context, obj *types.Document) (*types.Profile, error) {
+	if err := r.authorize(ctx, obj.ID, iam.ActionMembershipProfileGet); err != nil {
+		return nil, err
+	}
+
+	if obj.Owner == nil {
+		return nil, nil
+	}
+
+	owner, err := r.iam.OrganizationService.GetProfile(ctx, obj.Owner.ID)
+	if err != nil {
+		if errors.Is(err, coredata.ErrResourceNotFound) {
+			return nil, gqlutils.NotFound(ctx, err)
+		}
+
+		// TODO no panic use gqlutils.InternalError
+		panic(fmt.Errorf("cannot get owner: %w", err))
+	}
+
+	return types.NewProfile(owner), nil
+}
+
 // Approvers is the resolver for the approvers field.
 func (r *documentResolver) Approvers(ctx context.Context, obj *types.Document, first *int, after *page.CursorKey, last *int, before *page.CursorKey, orderBy *types.ProfileOrderBy) (*types.ProfileConnection, error) {
 	if err := r.authorize(ctx, obj.ID, iam.ActionMembershipProfileList); err != nil {
@@ -985,6 +1162,49 @@ func (r *documentResolver) Controls(ctx context.Context, obj *types.Document, fi
 	return types.NewControlConnection(page, r, obj.ID, controlFilter), nil
 }
 
+// AcknowledgementCampaigns is the resolver for the acknowledgementCampaigns field.
+func (r *documentResolver) AcknowledgementCampaigns(ctx context.Context, obj *types.Document) ([]*types.AcknowledgementCampaign, error) {
+	if err := r.authorize(ctx, obj.ID, probo.ActionAcknowledgementCampaignList); err != nil {
+		return nil, err
+	}
+
+	prb := r.ProboService(ctx, obj.ID.TenantID())
+
+	campaigns, err := prb.AcknowledgementCampaigns.ListForDocumentID(ctx, obj.ID)
+	if err != nil {
+		// TODO no panic use gqlutils.InternalError
+		panic(fmt.Errorf("cannot list acknowledgement campaigns: %w", err))
+	}
+
+	return types.NewAcknowledgementCampaigns(campaigns), nil
+}
+
+// VersionDiff is the resolver for the versionDiff field.
+func (r *documentResolver) VersionDiff(ctx context.Context, obj *types.Document, fromVersionID gid.GID, toVersionID gid.GID) (*types.DocumentVersionDiff, error) {
+	if err := r.authorize(ctx, obj.ID, probo.ActionDocumentVersionDiff); err != nil {
+		return nil, err
+	}
+
+	prb := r.ProboService(ctx, obj.ID.TenantID())
+
+	diff, err := prb.Documents.DiffVersions(ctx, obj.ID, fromVersionID, toVersionID)
+	if err != nil {
+		if errors.Is(err, coredata.ErrResourceNotFound) {
+			return nil, gqlutils.NotFound(ctx, err)
+		}
+
+		var errMismatch *probo.ErrDocumentVersionsMismatch
+		if errors.As(err, &errMismatch) {
+			return nil, gqlutils.Invalid(ctx, err)
+		}
+
+		// TODO no panic use gqlutils.InternalError
+		panic(fmt.Errorf("cannot diff document versions: %w", err))
+	}
+
+	return types.NewDocumentVersionDiff(diff), nil
+}
+
 // Permission is the resolver for the permission field.
 func (r *documentResolver) Permission(ctx context.Context, obj *types.Document, action string) (bool, error) {
 	return r.Resolver.Permission(ctx, obj, action)
@@ -1143,11 +1363,47 @@ func (r *documentVersionResolver) Signed(ctx context.Context, obj *types.Documen
 	return signed, nil
 }
 
+// Approvals is the resolver for the approvals field.
+func (r *documentVersionResolver) Approvals(ctx context.Context, obj *types.DocumentVersion) ([]*types.DocumentVersionApproval, error) {
+	if err := r.authorize(ctx, obj.ID, probo.ActionDocumentVersionGet); err != nil {
+		return nil, err
+	}
+
+	prb := r.ProboService(ctx, obj.ID.TenantID())
+
+	approvers, err := prb.Documents.ListVersionApprovals(ctx, obj.ID)
+	if err != nil {
+		// TODO no panic use gqlutils.InternalError
+		panic(fmt.Errorf("cannot list document version approvals: %w", err))
+	}
+
+	return types.NewDocumentVersionApprovals(approvers), nil
+}
+
 // Permission is the resolver for the permission field.
 func (r *documentVersionResolver) Permission(ctx context.Context, obj *types.DocumentVersion, action string) (bool, error) {
 	return r.Resolver.Permission(ctx, obj, action)
 }
 
+// Approver is the resolver for the approver field.
+func (r *documentVersionApprovalResolver) Approver(ctx context.Context, obj *types.DocumentVersionApproval) (*types.Profile, error) {
+	if err := r.authorize(ctx, obj.DocumentVersionID, iam.ActionMembershipProfileGet); err != nil {
+		return nil, err
+	}
+
+	approver, err := r.iam.OrganizationService.GetProfile(ctx, obj.Approver.ID)
+	if err != nil {
+		if errors.Is(err, coredata.ErrResourceNotFound) {
+			return nil, gqlutils.NotFound(ctx, err)
+		}
+
+		// TODO no panic use gqlutils.InternalError
+		panic(fmt.Errorf("cannot get approver: %w", err))
+	}
+
+	return types.NewProfile(approver), nil
+}
+
 // TotalCount is the resolver for the totalCount field.
 func (r *documentVersionConnectionResolver) TotalCount(ctx context.Context, obj *types.DocumentVersionConnection) (int, error) {
 	if err := r.authorize(ctx, obj.ParentID, probo.ActionDocumentVersionList); err != nil {
@@ -1241,6 +1497,23 @@ func (r *documentVersionSignatureConnectionResolver) TotalCount(ctx context.Cont
 	panic(fmt.Errorf("unsupported resolver: %T", obj.Resolver))
 }
 
+// TotalCount is the resolver for the totalCount field.
+func (r *emailConnectionResolver) TotalCount(ctx context.Context, obj *types.EmailConnection) (int, error) {
+	if err := r.authorize(ctx, obj.ParentID, probo.ActionEmailList); err != nil {
+		return 0, err
+	}
+
+	prb := r.ProboService(ctx, obj.ParentID.TenantID())
+
+	count, err := prb.Emails.CountForOrganizationID(ctx, obj.ParentID, types.NewEmailFilter(obj.Filter))
+	if err != nil {
+		r.logger.ErrorCtx(ctx, "cannot count emails", log.Error(err))
+		return 0, gqlutils.Internal(ctx)
+	}
+
+	return count, nil
+}
+
 // File is the resolver for the file field.
 func (r *evidenceResolver) File(ctx context.Context, obj *types.Evidence) (*types.File, error) {
 	if err := r.authorize(ctx, obj.ID, probo.ActionFileGet); err != nil {
@@ -1441,6 +1714,27 @@ func (r *frameworkResolver) DarkLogoURL(ctx context.Context, obj *types.Framewor
 	return prb.Frameworks.GenerateDarkLogoURL(ctx, obj.ID, 1*time.Hour)
 }
 
+// CrosswalkCoverage is the resolver for the crosswalkCoverage field.
+func (r *frameworkResolver) CrosswalkCoverage(ctx context.Context, obj *types.Framework, sourceFrameworkID gid.GID) (*types.CrosswalkCoverage, error) {
+	if err := r.authorize(ctx, obj.ID, probo.ActionFrameworkGetCrosswalkCoverage); err != nil {
+		return nil, err
+	}
+
+	prb := r.ProboService(ctx, obj.ID.TenantID())
+
+	coverage, err := prb.Frameworks.GetCrosswalkCoverage(ctx, obj.ID, sourceFrameworkID)
+	if err != nil {
+		if errors.Is(err, coredata.ErrResourceNotFound) {
+			return nil, gqlutils.NotFound(ctx, err)
+		}
+
+		// TODO no panic use gqlutils.InternalError
+		panic(fmt.Errorf("cannot get crosswalk coverage: %w", err))
+	}
+
+	return types.NewCrosswalkCoverage(coverage), nil
+}
+
 // Permission is the resolver for the permission field.
 func (r *frameworkResolver) Permission(ctx context.Context, obj *types.Framework, action string) (bool, error) {
 	return r.Resolver.Permission(ctx, obj, action)
@@ -1498,6 +1792,36 @@ func (r *measureResolver) Evidences(ctx context.Context, obj *types.Measure, fir
 	return types.NewEvidenceConnection(page, r, obj.ID), nil
 }
 
+// ConnectorEvidenceMappings is the resolver for the connectorEvidenceMappings field.
+func (r *measureResolver) ConnectorEvidenceMappings(ctx context.Context, obj *types.Measure, first *int, after *page.CursorKey, last *int, before *page.CursorKey, orderBy *types.ConnectorEvidenceMappingOrderBy) (*types.ConnectorEvidenceMappingConnection, error) {
+	if err := r.authorize(ctx, obj.ID, probo.ActionConnectorEvidenceMappingList); err != nil {
+		return nil, err
+	}
+
+	prb := r.ProboService(ctx, obj.ID.TenantID())
+
+	pageOrderBy := page.OrderBy[coredata.ConnectorEvidenceMappingOrderField]{
+		Field:     coredata.ConnectorEvidenceMappingOrderFieldCreatedAt,
+		Direction: page.OrderDirectionDesc,
+	}
+	if orderBy != nil {
+		pageOrderBy = page.OrderBy[coredata.ConnectorEvidenceMappingOrderField]{
+			Field:     orderBy.Field,
+			Direction: orderBy.Direction,
+		}
+	}
+
+	cursor := types.NewCursor(first, after, last, before, pageOrderBy)
+
+	page, err := prb.ConnectorEvidenceMappings.ListForMeasureID(ctx, obj.ID, cursor)
+	if err != nil {
+		r.logger.ErrorCtx(ctx, "cannot list measure connector evidence mappings", log.Error(err))
+		return nil, gqlutils.Internal(ctx)
+	}
+
+	return types.NewConnectorEvidenceMappingConnection(page, r, obj.ID), nil
+}
+
 // Tasks is the resolver for the tasks field.
 func (r *measureResolver) Tasks(ctx context.Context, obj *types.Measure, first *int, after *page.CursorKey, last *int, before *page.CursorKey, orderBy *types.TaskOrderBy) (*types.TaskConnection, error) {
 	if err := r.authorize(ctx, obj.ID, probo.ActionTaskList); err != nil {
@@ -2413,17 +2737,98 @@ func (r *mutationResolver) DeleteVendorService(ctx context.Context, input types.
 	}, nil
 }
 
-// CreateFramework is the resolver for the createFramework field.
-func (r *mutationResolver) CreateFramework(ctx context.Context, input types.CreateFrameworkInput) (*types.CreateFrameworkPayload, error) {
-	if err := r.authorize(ctx, input.OrganizationID, probo.ActionFrameworkCreate); err != nil {
+// PreviewImport is the resolver for the previewImport field.
+func (r *mutationResolver) PreviewImport(ctx context.Context, input types.PreviewImportInput) (*types.PreviewImportPayload, error) {
+	if err := r.authorize(ctx, input.OrganizationID, probo.ActionImportJobCreate); err != nil {
 		return nil, err
 	}
 
 	prb := r.ProboService(ctx, input.OrganizationID.TenantID())
 
-	framework, err := prb.Frameworks.Create(
+	rows, err := spreadsheet.Read(input.File.File, probo.ImportMaxRows+1)
+	if err != nil {
+		return nil, gqlutils.Invalid(ctx, err)
+	}
+
+	preview, err := prb.Imports.Preview(
 		ctx,
-		probo.CreateFrameworkRequest{
+		probo.ImportRequest{
+			OrganizationID: input.OrganizationID,
+			Type:           input.Type,
+			Rows:           rows,
+		},
+	)
+	if err != nil {
+		if errors.Is(err, coredata.ErrResourceNotFound) {
+			return nil, gqlutils.NotFound(ctx, err)
+		}
+
+		var validationErrors validator.ValidationErrors
+		if errors.As(err, &validationErrors) {
+			return nil, gqlutils.Invalid(ctx, err)
+		}
+
+		r.logger.ErrorCtx(ctx, "cannot preview import", log.Error(err))
+		return nil, gqlutils.Internal(ctx)
+	}
+
+	return &types.PreviewImportPayload{
+		RowCount:  preview.RowCount,
+		RowErrors: types.NewImportRowErrors(preview.RowErrors),
+	}, nil
+}
+
+// CreateImportJob is the resolver for the createImportJob field.
+func (r *mutationResolver) CreateImportJob(ctx context.Context, input types.CreateImportJobInput) (*types.CreateImportJobPayload, error) {
+	if err := r.authorize(ctx, input.OrganizationID, probo.ActionImportJobCreate); err != nil {
+		return nil, err
+	}
+
+	prb := r.ProboService(ctx, input.OrganizationID.TenantID())
+
+	rows, err := spreadsheet.Read(input.File.File, probo.ImportMaxRows+1)
+	if err != nil {
+		return nil, gqlutils.Invalid(ctx, err)
+	}
+
+	importJob, err := prb.Imports.CreateJob(
+		ctx,
+		probo.ImportRequest{
+			OrganizationID: input.OrganizationID,
+			Type:           input.Type,
+			Rows:           rows,
+		},
+	)
+	if err != nil {
+		if errors.Is(err, coredata.ErrResourceNotFound) {
+			return nil, gqlutils.NotFound(ctx, err)
+		}
+
+		var validationErrors validator.ValidationErrors
+		if errors.As(err, &validationErrors) {
+			return nil, gqlutils.Invalid(ctx, err)
+		}
+
+		r.logger.ErrorCtx(ctx, "cannot create import job", log.Error(err))
+		return nil, gqlutils.Internal(ctx)
+	}
+
+	return &types.CreateImportJobPayload{
+		ImportJob: types.NewImportJob(importJob),
+	}, nil
+}
+
+// CreateFramework is the resolver for the createFramework field.
+func (r *mutationResolver) CreateFramework(ctx context.Context, input types.CreateFrameworkInput) (*types.CreateFrameworkPayload, error) {
+	if err := r.authorize(ctx, input.OrganizationID, probo.ActionFrameworkCreate); err != nil {
+		return nil, err
+	}
+
+	prb := r.ProboService(ctx, input.OrganizationID.TenantID())
+
+	framework, err := prb.Frameworks.Create(
+		ctx,
+		probo.CreateFrameworkRequest{
 			OrganizationID: input.OrganizationID,
 			Name:           input.Name,
 		},
@@ -2492,6 +2897,131 @@ func (r *mutationResolver) ImportFramework(ctx context.Context, input types.Impo
 	}, nil
 }
 
+// ImportOSCALFramework is the resolver for the importOSCALFramework field.
+func (r *mutationResolver) ImportOSCALFramework(ctx context.Context, input types.ImportOSCALFrameworkInput) (*types.ImportOSCALFrameworkPayload, error) {
+	if err := r.authorize(ctx, input.OrganizationID, probo.ActionFrameworkImport); err != nil {
+		return nil, err
+	}
+
+	prb := r.ProboService(ctx, input.OrganizationID.TenantID())
+
+	document, err := oscal.Decode(input.File.File)
+	if err != nil {
+		return nil, gqlutils.Invalid(ctx, err)
+	}
+
+	req := probo.ImportOSCALFrameworkRequest{Document: document}
+	if input.CatalogFile != nil {
+		req.Catalog, err = oscal.Decode(input.CatalogFile.File)
+		if err != nil {
+			return nil, gqlutils.Invalid(ctx, err)
+		}
+	}
+
+	framework, err := prb.Frameworks.ImportOSCAL(ctx, input.OrganizationID, req)
+	if err != nil {
+		if errors.Is(err, oscal.ErrUnsupportedDocument) {
+			return nil, gqlutils.Invalid(ctx, err)
+		}
+
+		if errors.Is(err, coredata.ErrResourceAlreadyExists) {
+			return nil, gqlutils.Conflict(ctx, err)
+		}
+
+		// TODO no panic use gqlutils.InternalError
+		panic(fmt.Errorf("cannot import oscal framework: %w", err))
+	}
+
+	return &types.ImportOSCALFrameworkPayload{
+		FrameworkEdge: types.NewFrameworkEdge(framework, coredata.FrameworkOrderFieldCreatedAt),
+	}, nil
+}
+
+// ImportCrosswalk is the resolver for the importCrosswalk field.
+func (r *mutationResolver) ImportCrosswalk(ctx context.Context, input types.ImportCrosswalkInput) (*types.ImportCrosswalkPayload, error) {
+	if err := r.authorize(ctx, input.OrganizationID, probo.ActionFrameworkImportCrosswalk); err != nil {
+		return nil, err
+	}
+
+	prb := r.ProboService(ctx, input.OrganizationID.TenantID())
+
+	req := probo.ImportCrosswalkRequest{}
+	if err := json.NewDecoder(input.File.File).Decode(&req.Crosswalk); err != nil {
+		return nil, gqlutils.Invalid(ctx, fmt.Errorf("cannot decode crosswalk: %w", err))
+	}
+
+	result, err := prb.Frameworks.ImportCrosswalk(ctx, input.OrganizationID, req)
+	if err != nil {
+		if errors.Is(err, coredata.ErrResourceNotFound) {
+			return nil, gqlutils.NotFound(ctx, err)
+		}
+
+		var (
+			errFrameworkNotFound *probo.ErrCrosswalkFrameworkNotFound
+			errSameFramework     *probo.ErrCrosswalkSameFramework
+		)
+
+		if errors.As(err, &errFrameworkNotFound) || errors.As(err, &errSameFramework) {
+			return nil, gqlutils.Invalid(ctx, err)
+		}
+
+		// TODO no panic use gqlutils.InternalError
+		panic(fmt.Errorf("cannot import crosswalk: %w", err))
+	}
+
+	return &types.ImportCrosswalkPayload{
+		SourceFramework: types.NewFramework(result.SourceFramework),
+		TargetFramework: types.NewFramework(result.TargetFramework),
+		ImportedCount:   result.ImportedCount,
+		SkippedCount:    result.SkippedCount,
+	}, nil
+}
+
+// UpgradeFramework is the resolver for the upgradeFramework field.
+func (r *mutationResolver) UpgradeFramework(ctx context.Context, input types.UpgradeFrameworkInput) (*types.UpgradeFrameworkPayload, error) {
+	if err := r.authorize(ctx, input.FrameworkID, probo.ActionFrameworkUpgrade); err != nil {
+		return nil, err
+	}
+
+	prb := r.ProboService(ctx, input.FrameworkID.TenantID())
+
+	req := probo.UpgradeFrameworkRequest{}
+	if err := json.NewDecoder(input.File.File).Decode(&req.Framework); err != nil {
+		return nil, gqlutils.Invalid(ctx, fmt.Errorf("cannot decode framework: %w", err))
+	}
+
+	if err := json.NewDecoder(input.MappingFile.File).Decode(&req.Mapping); err != nil {
+		return nil, gqlutils.Invalid(ctx, fmt.Errorf("cannot decode framework mapping: %w", err))
+	}
+
+	result, err := prb.Frameworks.Upgrade(ctx, input.FrameworkID, req)
+	if err != nil {
+		if errors.Is(err, coredata.ErrResourceNotFound) {
+			return nil, gqlutils.NotFound(ctx, err)
+		}
+
+		if errors.Is(err, coredata.ErrResourceAlreadyExists) {
+			return nil, gqlutils.Conflict(ctx, err)
+		}
+
+		r.logger.ErrorCtx(ctx, "cannot upgrade framework", log.Error(err))
+		return nil, gqlutils.Internal(ctx)
+	}
+
+	taskEdges := make([]*types.TaskEdge, len(result.Tasks))
+	for i, task := range result.Tasks {
+		taskEdges[i] = types.NewTaskEdge(task, coredata.TaskOrderFieldCreatedAt)
+	}
+
+	return &types.UpgradeFrameworkPayload{
+		FrameworkEdge:        types.NewFrameworkEdge(result.Framework, coredata.FrameworkOrderFieldCreatedAt),
+		MigratedControlCount: result.MigratedControlCount,
+		UnmappedControls:     types.NewControls(result.UnmappedControls),
+		NewControls:          types.NewControls(result.NewControls),
+		TaskEdges:            taskEdges,
+	}, nil
+}
+
 // DeleteFramework is the resolver for the deleteFramework field.
 func (r *mutationResolver) DeleteFramework(ctx context.Context, input types.DeleteFrameworkInput) (*types.DeleteFrameworkPayload, error) {
 	if err := r.authorize(ctx, input.FrameworkID, probo.ActionFrameworkDelete); err != nil {
@@ -2523,6 +3053,7 @@ func (r *mutationResolver) ExportFramework(ctx context.Context, input types.Expo
 	exportJob, exportErr := prb.Frameworks.RequestExport(
 		ctx,
 		input.FrameworkID,
+		input.Format,
 		identity.EmailAddress,
 		identity.FullName,
 	)
@@ -2983,6 +3514,59 @@ func (r *mutationResolver) DeleteControlSnapshotMapping(ctx context.Context, inp
 	}, nil
 }
 
+// CreateControlEquivalenceMapping is the resolver for the createControlEquivalenceMapping field.
+func (r *mutationResolver) CreateControlEquivalenceMapping(ctx context.Context, input types.CreateControlEquivalenceMappingInput) (*types.CreateControlEquivalenceMappingPayload, error) {
+	if err := r.authorize(ctx, input.ControlID, probo.ActionControlEquivalenceMappingCreate); err != nil {
+		return nil, err
+	}
+
+	prb := r.ProboService(ctx, input.ControlID.TenantID())
+
+	control, equivalentControl, err := prb.Controls.CreateEquivalenceMapping(ctx, input.ControlID, input.EquivalentControlID)
+	if err != nil {
+		var errValidation validator.ValidationErrors
+		if errors.As(err, &errValidation) {
+			return nil, gqlutils.Invalid(ctx, errValidation)
+		}
+
+		if errors.Is(err, coredata.ErrResourceNotFound) {
+			return nil, gqlutils.NotFound(ctx, err)
+		}
+
+		// TODO no panic use gqlutils.InternalError
+		panic(fmt.Errorf("cannot create control equivalence mapping: %w", err))
+	}
+
+	return &types.CreateControlEquivalenceMappingPayload{
+		ControlEdge:           types.NewControlEdge(control, coredata.ControlOrderFieldCreatedAt),
+		EquivalentControlEdge: types.NewControlEdge(equivalentControl, coredata.ControlOrderFieldCreatedAt),
+	}, nil
+}
+
+// DeleteControlEquivalenceMapping is the resolver for the deleteControlEquivalenceMapping field.
+func (r *mutationResolver) DeleteControlEquivalenceMapping(ctx context.Context, input types.DeleteControlEquivalenceMappingInput) (*types.DeleteControlEquivalenceMappingPayload, error) {
+	if err := r.authorize(ctx, input.ControlID, probo.ActionControlEquivalenceMappingDelete); err != nil {
+		return nil, err
+	}
+
+	prb := r.ProboService(ctx, input.ControlID.TenantID())
+
+	control, equivalentControl, err := prb.Controls.DeleteEquivalenceMapping(ctx, input.ControlID, input.EquivalentControlID)
+	if err != nil {
+		if errors.Is(err, coredata.ErrResourceNotFound) {
+			return nil, gqlutils.NotFound(ctx, err)
+		}
+
+		// TODO no panic use gqlutils.InternalError
+		panic(fmt.Errorf("cannot delete control equivalence mapping: %w", err))
+	}
+
+	return &types.DeleteControlEquivalenceMappingPayload{
+		DeletedControlID:           control.ID,
+		DeletedEquivalentControlID: equivalentControl.ID,
+	}, nil
+}
+
 // CreateTask is the resolver for the createTask field.
 func (r *mutationResolver) CreateTask(ctx context.Context, input types.CreateTaskInput) (*types.CreateTaskPayload, error) {
 	if err := r.authorize(ctx, input.OrganizationID, probo.ActionTaskCreate); err != nil {
@@ -3327,6 +3911,143 @@ func (r *mutationResolver) UploadMeasureEvidence(ctx context.Context, input type
 	}, nil
 }
 
+// CreateAWSConnector is the resolver for the createAWSConnector field.
+func (r *mutationResolver) CreateAWSConnector(ctx context.Context, input types.CreateAWSConnectorInput) (*types.CreateAWSConnectorPayload, error) {
+	if err := r.authorize(ctx, input.OrganizationID, probo.ActionConnectorCreate); err != nil {
+		return nil, err
+	}
+
+	prb := r.ProboService(ctx, input.OrganizationID.TenantID())
+
+	awsConnector, err := prb.Connectors.CreateAWS(
+		ctx,
+		probo.CreateAWSConnectorRequest{
+			OrganizationID:  input.OrganizationID,
+			Region:          input.Region,
+			AccessKeyID:     input.AccessKeyID,
+			SecretAccessKey: input.SecretAccessKey,
+			RoleARN:         input.RoleArn,
+		},
+	)
+	if err != nil {
+		var errValidation validator.ValidationErrors
+		if errors.As(err, &errValidation) {
+			return nil, gqlutils.Invalid(ctx, errValidation)
+		}
+
+		r.logger.ErrorCtx(ctx, "cannot create aws connector", log.Error(err))
+		return nil, gqlutils.Internal(ctx)
+	}
+
+	payload := &types.CreateAWSConnectorPayload{
+		ConnectorID: awsConnector.ID,
+	}
+	if awsConn, ok := awsConnector.Connection.(*connector.AWSConnection); ok && awsConn.ExternalID != "" {
+		payload.ExternalID = &awsConn.ExternalID
+	}
+
+	return payload, nil
+}
+
+// CreateOktaConnector is the resolver for the createOktaConnector field.
+func (r *mutationResolver) CreateOktaConnector(ctx context.Context, input types.CreateOktaConnectorInput) (*types.CreateOktaConnectorPayload, error) {
+	if err := r.authorize(ctx, input.OrganizationID, probo.ActionConnectorCreate); err != nil {
+		return nil, err
+	}
+
+	prb := r.ProboService(ctx, input.OrganizationID.TenantID())
+
+	connector, err := prb.Connectors.CreateOkta(
+		ctx,
+		probo.CreateOktaConnectorRequest{
+			OrganizationID: input.OrganizationID,
+			Domain:         input.Domain,
+			APIToken:       input.APIToken,
+		},
+	)
+	if err != nil {
+		var errValidation validator.ValidationErrors
+		if errors.As(err, &errValidation) {
+			return nil, gqlutils.Invalid(ctx, errValidation)
+		}
+
+		r.logger.ErrorCtx(ctx, "cannot create okta connector", log.Error(err))
+		return nil, gqlutils.Internal(ctx)
+	}
+
+	return &types.CreateOktaConnectorPayload{
+		ConnectorID: connector.ID,
+	}, nil
+}
+
+// CreateConnectorEvidenceMapping is the resolver for the createConnectorEvidenceMapping field.
+func (r *mutationResolver) CreateConnectorEvidenceMapping(ctx context.Context, input types.CreateConnectorEvidenceMappingInput) (*types.CreateConnectorEvidenceMappingPayload, error) {
+	if err := r.authorize(ctx, input.MeasureID, probo.ActionConnectorEvidenceMappingCreate); err != nil {
+		return nil, err
+	}
+
+	prb := r.ProboService(ctx, input.MeasureID.TenantID())
+
+	mapping, err := prb.ConnectorEvidenceMappings.Create(
+		ctx,
+		probo.CreateConnectorEvidenceMappingRequest{
+			ConnectorID:    input.ConnectorID,
+			MeasureID:      input.MeasureID,
+			EvidenceKey:    input.EvidenceKey,
+			FindingAction:  input.FindingAction,
+			FindingOwnerID: input.FindingOwnerID,
+		},
+	)
+	if err != nil {
+		if errors.Is(err, coredata.ErrResourceNotFound) {
+			return nil, gqlutils.NotFound(ctx, err)
+		}
+
+		if errors.Is(err, coredata.ErrResourceAlreadyExists) {
+			return nil, gqlutils.Conflict(ctx, err)
+		}
+
+		var errUnsupported *probo.ErrConnectorEvidenceCollectionUnsupported
+		if errors.As(err, &errUnsupported) {
+			return nil, gqlutils.Invalid(ctx, errUnsupported)
+		}
+
+		var errValidation validator.ValidationErrors
+		if errors.As(err, &errValidation) {
+			return nil, gqlutils.Invalid(ctx, errValidation)
+		}
+
+		r.logger.ErrorCtx(ctx, "cannot create connector evidence mapping", log.Error(err))
+		return nil, gqlutils.Internal(ctx)
+	}
+
+	return &types.CreateConnectorEvidenceMappingPayload{
+		ConnectorEvidenceMappingEdge: types.NewConnectorEvidenceMappingEdge(mapping, coredata.ConnectorEvidenceMappingOrderFieldCreatedAt),
+	}, nil
+}
+
+// DeleteConnectorEvidenceMapping is the resolver for the deleteConnectorEvidenceMapping field.
+func (r *mutationResolver) DeleteConnectorEvidenceMapping(ctx context.Context, input types.DeleteConnectorEvidenceMappingInput) (*types.DeleteConnectorEvidenceMappingPayload, error) {
+	if err := r.authorize(ctx, input.ConnectorEvidenceMappingID, probo.ActionConnectorEvidenceMappingDelete); err != nil {
+		return nil, err
+	}
+
+	prb := r.ProboService(ctx, input.ConnectorEvidenceMappingID.TenantID())
+
+	if err := prb.ConnectorEvidenceMappings.Delete(ctx, input.ConnectorEvidenceMappingID); err != nil {
+		if errors.Is(err, coredata.ErrResourceNotFound) {
+			return nil, gqlutils.NotFound(ctx, err)
+		}
+
+		r.logger.ErrorCtx(ctx, "cannot delete connector evidence mapping", log.Error(err))
+		return nil, gqlutils.Internal(ctx)
+	}
+
+	return &types.DeleteConnectorEvidenceMappingPayload{
+		DeletedConnectorEvidenceMappingID: input.ConnectorEvidenceMappingID,
+	}, nil
+}
+
 // UploadVendorComplianceReport is the resolver for the uploadVendorComplianceReport field.
 func (r *mutationResolver) UploadVendorComplianceReport(ctx context.Context, input types.UploadVendorComplianceReportInput) (*types.UploadVendorComplianceReportPayload, error) {
 	if err := r.authorize(ctx, input.VendorID, probo.ActionVendorComplianceReportUpload); err != nil {
@@ -3535,6 +4256,7 @@ func (r *mutationResolver) CreateDocument(ctx context.Context, input types.Creat
 			DocumentType:          input.DocumentType,
 			Title:                 input.Title,
 			ApproverIDs:           input.ApproverIds,
+			ApprovalStages:        types.NewApprovalStages(input.ApprovalStages),
 			Content:               input.Content,
 			Classification:        input.Classification,
 			TrustCenterVisibility: input.TrustCenterVisibility,
@@ -3569,13 +4291,25 @@ func (r *mutationResolver) UpdateDocument(ctx context.Context, input types.Updat
 			DocumentID:            input.ID,
 			Title:                 input.Title,
 			ApproverIDs:           input.ApproverIds,
+			ApprovalStages:        types.NewApprovalStages(input.ApprovalStages),
 			Classification:        input.Classification,
 			DocumentType:          input.DocumentType,
 			TrustCenterVisibility: input.TrustCenterVisibility,
+			OwnerID:               gqlutils.UnwrapOmittable(input.OwnerID),
+			ReviewIntervalDays:    gqlutils.UnwrapOmittable(input.ReviewIntervalDays),
 		},
 	)
 
 	if err != nil {
+		if errors.Is(err, coredata.ErrResourceNotFound) {
+			return nil, gqlutils.NotFound(ctx, err)
+		}
+
+		var errValidation validator.ValidationErrors
+		if errors.As(err, &errValidation) {
+			return nil, gqlutils.Invalid(ctx, errValidation)
+		}
+
 		// TODO no panic use gqlutils.InternalError
 		panic(fmt.Errorf("cannot update document: %w", err))
 	}
@@ -3604,6 +4338,125 @@ func (r *mutationResolver) DeleteDocument(ctx context.Context, input types.Delet
 	}, nil
 }
 
+// MarkDocumentReviewed is the resolver for the markDocumentReviewed field.
+func (r *mutationResolver) MarkDocumentReviewed(ctx context.Context, input types.MarkDocumentReviewedInput) (*types.MarkDocumentReviewedPayload, error) {
+	if err := r.authorize(ctx, input.DocumentID, probo.ActionDocumentMarkReviewed); err != nil {
+		return nil, err
+	}
+
+	prb := r.ProboService(ctx, input.DocumentID.TenantID())
+
+	document, err := prb.Documents.MarkReviewed(ctx, input.DocumentID)
+	if err != nil {
+		if errors.Is(err, coredata.ErrResourceNotFound) {
+			return nil, gqlutils.NotFound(ctx, err)
+		}
+
+		r.logger.ErrorCtx(ctx, "cannot mark document as reviewed", log.Error(err))
+		return nil, gqlutils.Internal(ctx)
+	}
+
+	return &types.MarkDocumentReviewedPayload{
+		Document: types.NewDocument(document),
+	}, nil
+}
+
+// CreateAcknowledgementCampaign is the resolver for the createAcknowledgementCampaign field.
+func (r *mutationResolver) CreateAcknowledgementCampaign(ctx context.Context, input types.CreateAcknowledgementCampaignInput) (*types.CreateAcknowledgementCampaignPayload, error) {
+	if err := r.authorize(ctx, input.DocumentID, probo.ActionAcknowledgementCampaignCreate); err != nil {
+		return nil, err
+	}
+
+	prb := r.ProboService(ctx, input.DocumentID.TenantID())
+
+	campaign, err := prb.AcknowledgementCampaigns.Create(
+		ctx,
+		probo.CreateAcknowledgementCampaignRequest{
+			DocumentID:          input.DocumentID,
+			RecipientProfileIDs: input.RecipientProfileIds,
+			IntervalDays:        input.IntervalDays,
+			StartAt:             input.StartAt,
+		},
+	)
+	if err != nil {
+		if errors.Is(err, coredata.ErrResourceNotFound) {
+			return nil, gqlutils.NotFound(ctx, err)
+		}
+
+		var errValidation validator.ValidationErrors
+		if errors.As(err, &errValidation) {
+			return nil, gqlutils.Invalid(ctx, errValidation)
+		}
+
+		r.logger.ErrorCtx(ctx, "cannot create acknowledgement campaign", log.Error(err))
+		return nil, gqlutils.Internal(ctx)
+	}
+
+	return &types.CreateAcknowledgementCampaignPayload{
+		AcknowledgementCampaign: types.NewAcknowledgementCampaign(campaign),
+	}, nil
+}
+
+// UpdateAcknowledgementCampaign is the resolver for the updateAcknowledgementCampaign field.
+func (r *mutationResolver) UpdateAcknowledgementCampaign(ctx context.Context, input types.UpdateAcknowledgementCampaignInput) (*types.UpdateAcknowledgementCampaignPayload, error) {
+	if err := r.authorize(ctx, input.ID, probo.ActionAcknowledgementCampaignUpdate); err != nil {
+		return nil, err
+	}
+
+	prb := r.ProboService(ctx, input.ID.TenantID())
+
+	req := probo.UpdateAcknowledgementCampaignRequest{
+		ID:           input.ID,
+		IntervalDays: input.IntervalDays,
+		Enabled:      input.Enabled,
+		NextRunAt:    input.NextRunAt,
+	}
+	if input.RecipientProfileIds != nil {
+		req.RecipientProfileIDs = &input.RecipientProfileIds
+	}
+
+	campaign, err := prb.AcknowledgementCampaigns.Update(ctx, req)
+	if err != nil {
+		if errors.Is(err, coredata.ErrResourceNotFound) {
+			return nil, gqlutils.NotFound(ctx, err)
+		}
+
+		var errValidation validator.ValidationErrors
+		if errors.As(err, &errValidation) {
+			return nil, gqlutils.Invalid(ctx, errValidation)
+		}
+
+		r.logger.ErrorCtx(ctx, "cannot update acknowledgement campaign", log.Error(err))
+		return nil, gqlutils.Internal(ctx)
+	}
+
+	return &types.UpdateAcknowledgementCampaignPayload{
+		AcknowledgementCampaign: types.NewAcknowledgementCampaign(campaign),
+	}, nil
+}
+
+// DeleteAcknowledgementCampaign is the resolver for the deleteAcknowledgementCampaign field.
+func (r *mutationResolver) DeleteAcknowledgementCampaign(ctx context.Context, input types.DeleteAcknowledgementCampaignInput) (*types.DeleteAcknowledgementCampaignPayload, error) {
+	if err := r.authorize(ctx, input.AcknowledgementCampaignID, probo.ActionAcknowledgementCampaignDelete); err != nil {
+		return nil, err
+	}
+
+	prb := r.ProboService(ctx, input.AcknowledgementCampaignID.TenantID())
+
+	if err := prb.AcknowledgementCampaigns.Delete(ctx, input.AcknowledgementCampaignID); err != nil {
+		if errors.Is(err, coredata.ErrResourceNotFound) {
+			return nil, gqlutils.NotFound(ctx, err)
+		}
+
+		r.logger.ErrorCtx(ctx, "cannot delete acknowledgement campaign", log.Error(err))
+		return nil, gqlutils.Internal(ctx)
+	}
+
+	return &types.DeleteAcknowledgementCampaignPayload{
+		DeletedAcknowledgementCampaignID: input.AcknowledgementCampaignID,
+	}, nil
+}
+
 // CreateMeeting is the resolver for the createMeeting field.
 func (r *mutationResolver) CreateMeeting(ctx context.Context, input types.CreateMeetingInput) (*types.CreateMeetingPayload, error) {
 	if err := r.authorize(ctx, input.OrganizationID, probo.ActionMeetingCreate); err != nil {
@@ -3679,79 +4532,244 @@ func (r *mutationResolver) DeleteMeeting(ctx context.Context, input types.Delete
 		panic(fmt.Errorf("cannot delete meeting: %w", err))
 	}
 
-	return &types.DeleteMeetingPayload{
-		DeletedMeetingID: input.MeetingID,
+	return &types.DeleteMeetingPayload{
+		DeletedMeetingID: input.MeetingID,
+	}, nil
+}
+
+// CreateWebhookSubscription is the resolver for the createWebhookSubscription field.
+func (r *mutationResolver) CreateWebhookSubscription(ctx context.Context, input types.CreateWebhookSubscriptionInput) (*types.CreateWebhookSubscriptionPayload, error) {
+	if err := r.authorize(ctx, input.OrganizationID, probo.ActionWebhookSubscriptionCreate); err != nil {
+		return nil, err
+	}
+
+	prb := r.ProboService(ctx, input.OrganizationID.TenantID())
+
+	wc, err := prb.WebhookSubscriptions.Create(
+		ctx,
+		probo.CreateWebhookSubscriptionRequest{
+			OrganizationID: input.OrganizationID,
+			EndpointURL:    input.EndpointURL,
+			SelectedEvents: input.SelectedEvents,
+		},
+	)
+	if err != nil {
+		r.logger.ErrorCtx(ctx, "cannot create webhook subscription", log.Error(err))
+		return nil, gqlutils.Internal(ctx)
+	}
+
+	return &types.CreateWebhookSubscriptionPayload{
+		WebhookSubscriptionEdge: types.NewWebhookSubscriptionEdge(wc, coredata.WebhookSubscriptionOrderFieldCreatedAt),
+	}, nil
+}
+
+// UpdateWebhookSubscription is the resolver for the updateWebhookSubscription field.
+func (r *mutationResolver) UpdateWebhookSubscription(ctx context.Context, input types.UpdateWebhookSubscriptionInput) (*types.UpdateWebhookSubscriptionPayload, error) {
+	if err := r.authorize(ctx, input.ID, probo.ActionWebhookSubscriptionUpdate); err != nil {
+		return nil, err
+	}
+
+	prb := r.ProboService(ctx, input.ID.TenantID())
+
+	wc, err := prb.WebhookSubscriptions.Update(
+		ctx,
+		probo.UpdateWebhookSubscriptionRequest{
+			WebhookSubscriptionID: input.ID,
+			EndpointURL:           input.EndpointURL,
+			SelectedEvents:        input.SelectedEvents,
+		},
+	)
+	if err != nil {
+		r.logger.ErrorCtx(ctx, "cannot update webhook subscription", log.Error(err))
+		return nil, gqlutils.Internal(ctx)
+	}
+
+	return &types.UpdateWebhookSubscriptionPayload{
+		WebhookSubscription: types.NewWebhookSubscription(wc),
+	}, nil
+}
+
+// DeleteWebhookSubscription is the resolver for the deleteWebhookSubscription field.
+func (r *mutationResolver) DeleteWebhookSubscription(ctx context.Context, input types.DeleteWebhookSubscriptionInput) (*types.DeleteWebhookSubscriptionPayload, error) {
+	if err := r.authorize(ctx, input.WebhookSubscriptionID, probo.ActionWebhookSubscriptionDelete); err != nil {
+		return nil, err
+	}
+
+	prb := r.ProboService(ctx, input.WebhookSubscriptionID.TenantID())
+
+	err := prb.WebhookSubscriptions.Delete(ctx, input.WebhookSubscriptionID)
+	if err != nil {
+		r.logger.ErrorCtx(ctx, "cannot delete webhook subscription", log.Error(err))
+		return nil, gqlutils.Internal(ctx)
+	}
+
+	return &types.DeleteWebhookSubscriptionPayload{
+		DeletedWebhookSubscriptionID: input.WebhookSubscriptionID,
+	}, nil
+}
+
+// RedriveWebhookEvent is the resolver for the redriveWebhookEvent field.
+func (r *mutationResolver) RedriveWebhookEvent(ctx context.Context, input types.RedriveWebhookEventInput) (*types.RedriveWebhookEventPayload, error) {
+	if err := r.authorize(ctx, input.WebhookEventID, probo.ActionWebhookEventRedrive); err != nil {
+		return nil, err
+	}
+
+	prb := r.ProboService(ctx, input.WebhookEventID.TenantID())
+
+	event, err := prb.WebhookSubscriptions.RedriveEvent(ctx, input.WebhookEventID)
+	if err != nil {
+		if errors.Is(err, coredata.ErrResourceNotFound) {
+			return nil, gqlutils.NotFound(ctx, err)
+		}
+
+		var errNotDeadLettered *probo.ErrWebhookEventNotDeadLettered
+		if errors.As(err, &errNotDeadLettered) {
+			return nil, gqlutils.Invalid(ctx, errNotDeadLettered)
+		}
+
+		r.logger.ErrorCtx(ctx, "cannot redrive webhook event", log.Error(err))
+		return nil, gqlutils.Internal(ctx)
+	}
+
+	return &types.RedriveWebhookEventPayload{
+		WebhookEvent: types.NewWebhookEvent(event),
+	}, nil
+}
+
+// ReplayWebhookEvent is the resolver for the replayWebhookEvent field.
+func (r *mutationResolver) ReplayWebhookEvent(ctx context.Context, input types.ReplayWebhookEventInput) (*types.ReplayWebhookEventPayload, error) {
+	if err := r.authorize(ctx, input.WebhookEventID, probo.ActionWebhookEventReplay); err != nil {
+		return nil, err
+	}
+
+	prb := r.ProboService(ctx, input.WebhookEventID.TenantID())
+
+	event, err := prb.WebhookSubscriptions.ReplayEvent(ctx, input.WebhookEventID)
+	if err != nil {
+		if errors.Is(err, coredata.ErrResourceNotFound) {
+			return nil, gqlutils.NotFound(ctx, err)
+		}
+
+		var errStillPending *probo.ErrWebhookEventStillPending
+		if errors.As(err, &errStillPending) {
+			return nil, gqlutils.Invalid(ctx, errStillPending)
+		}
+
+		r.logger.ErrorCtx(ctx, "cannot replay webhook event", log.Error(err))
+		return nil, gqlutils.Internal(ctx)
+	}
+
+	return &types.ReplayWebhookEventPayload{
+		WebhookEventEdge: types.NewWebhookEventEdge(event, coredata.WebhookEventOrderFieldCreatedAt),
 	}, nil
 }
 
-// CreateWebhookSubscription is the resolver for the createWebhookSubscription field.
-func (r *mutationResolver) CreateWebhookSubscription(ctx context.Context, input types.CreateWebhookSubscriptionInput) (*types.CreateWebhookSubscriptionPayload, error) {
-	if err := r.authorize(ctx, input.OrganizationID, probo.ActionWebhookSubscriptionCreate); err != nil {
+// ReplayWebhookEvents is the resolver for the replayWebhookEvents field.
+func (r *mutationResolver) ReplayWebhookEvents(ctx context.Context, input types.ReplayWebhookEventsInput) (*types.ReplayWebhookEventsPayload, error) {
+	if err := r.authorize(ctx, input.WebhookSubscriptionID, probo.ActionWebhookSubscriptionReplay); err != nil {
 		return nil, err
 	}
 
-	prb := r.ProboService(ctx, input.OrganizationID.TenantID())
+	prb := r.ProboService(ctx, input.WebhookSubscriptionID.TenantID())
 
-	wc, err := prb.WebhookSubscriptions.Create(
+	events, err := prb.WebhookSubscriptions.ReplayEvents(
 		ctx,
-		probo.CreateWebhookSubscriptionRequest{
-			OrganizationID: input.OrganizationID,
-			EndpointURL:    input.EndpointURL,
-			SelectedEvents: input.SelectedEvents,
+		probo.ReplayWebhookEventsRequest{
+			WebhookSubscriptionID: input.WebhookSubscriptionID,
+			From:                  input.From,
+			To:                    input.To,
 		},
 	)
 	if err != nil {
-		r.logger.ErrorCtx(ctx, "cannot create webhook subscription", log.Error(err))
+		if errors.Is(err, coredata.ErrResourceNotFound) {
+			return nil, gqlutils.NotFound(ctx, err)
+		}
+
+		var errValidation validator.ValidationErrors
+		if errors.As(err, &errValidation) {
+			return nil, gqlutils.Invalid(ctx, err)
+		}
+
+		r.logger.ErrorCtx(ctx, "cannot replay webhook events", log.Error(err))
 		return nil, gqlutils.Internal(ctx)
 	}
 
-	return &types.CreateWebhookSubscriptionPayload{
-		WebhookSubscriptionEdge: types.NewWebhookSubscriptionEdge(wc, coredata.WebhookSubscriptionOrderFieldCreatedAt),
+	edges := make([]*types.WebhookEventEdge, len(events))
+	for i, event := range events {
+		edges[i] = types.NewWebhookEventEdge(event, coredata.WebhookEventOrderFieldCreatedAt)
+	}
+
+	return &types.ReplayWebhookEventsPayload{
+		WebhookEventEdges: edges,
 	}, nil
 }
 
-// UpdateWebhookSubscription is the resolver for the updateWebhookSubscription field.
-func (r *mutationResolver) UpdateWebhookSubscription(ctx context.Context, input types.UpdateWebhookSubscriptionInput) (*types.UpdateWebhookSubscriptionPayload, error) {
-	if err := r.authorize(ctx, input.ID, probo.ActionWebhookSubscriptionUpdate); err != nil {
+// SendWebhookTestEvent is the resolver for the sendWebhookTestEvent field.
+func (r *mutationResolver) SendWebhookTestEvent(ctx context.Context, input types.SendWebhookTestEventInput) (*types.SendWebhookTestEventPayload, error) {
+	if err := r.authorize(ctx, input.WebhookSubscriptionID, probo.ActionWebhookSubscriptionTest); err != nil {
 		return nil, err
 	}
 
-	prb := r.ProboService(ctx, input.ID.TenantID())
+	prb := r.ProboService(ctx, input.WebhookSubscriptionID.TenantID())
 
-	wc, err := prb.WebhookSubscriptions.Update(
-		ctx,
-		probo.UpdateWebhookSubscriptionRequest{
-			WebhookSubscriptionID: input.ID,
-			EndpointURL:           input.EndpointURL,
-			SelectedEvents:        input.SelectedEvents,
-		},
-	)
+	delivery, err := prb.WebhookSubscriptions.SendTestEvent(ctx, input.WebhookSubscriptionID)
 	if err != nil {
-		r.logger.ErrorCtx(ctx, "cannot update webhook subscription", log.Error(err))
+		if errors.Is(err, coredata.ErrResourceNotFound) {
+			return nil, gqlutils.NotFound(ctx, err)
+		}
+
+		r.logger.ErrorCtx(ctx, "cannot send webhook test event", log.Error(err))
 		return nil, gqlutils.Internal(ctx)
 	}
 
-	return &types.UpdateWebhookSubscriptionPayload{
-		WebhookSubscription: types.NewWebhookSubscription(wc),
-	}, nil
+	payload := &types.SendWebhookTestEventPayload{
+		Succeeded: delivery.Succeeded,
+	}
+	if len(delivery.Response) > 0 {
+		response := string(delivery.Response)
+		payload.Response = &response
+	}
+	if delivery.Error != "" {
+		payload.Error = &delivery.Error
+	}
+
+	return payload, nil
 }
 
-// DeleteWebhookSubscription is the resolver for the deleteWebhookSubscription field.
-func (r *mutationResolver) DeleteWebhookSubscription(ctx context.Context, input types.DeleteWebhookSubscriptionInput) (*types.DeleteWebhookSubscriptionPayload, error) {
-	if err := r.authorize(ctx, input.WebhookSubscriptionID, probo.ActionWebhookSubscriptionDelete); err != nil {
+// ExportAuditLog is the resolver for the exportAuditLog field.
+func (r *mutationResolver) ExportAuditLog(ctx context.Context, input types.ExportAuditLogInput) (*types.ExportAuditLogPayload, error) {
+	if err := r.authorize(ctx, input.OrganizationID, probo.ActionAuditLogEntryExport); err != nil {
 		return nil, err
 	}
 
-	prb := r.ProboService(ctx, input.WebhookSubscriptionID.TenantID())
+	prb := r.ProboService(ctx, input.OrganizationID.TenantID())
+	identity := authn.IdentityFromContext(ctx)
 
-	err := prb.WebhookSubscriptions.Delete(ctx, input.WebhookSubscriptionID)
+	var args coredata.AuditLogExportArguments
+	if input.Filter != nil {
+		args = coredata.AuditLogExportArguments{
+			Action:     input.Filter.Action,
+			ActorID:    input.Filter.ActorID,
+			ResourceID: input.Filter.ResourceID,
+			From:       input.Filter.From,
+			To:         input.Filter.To,
+		}
+	}
+
+	exportJob, err := prb.AuditLogEntries.RequestExport(
+		ctx,
+		input.OrganizationID,
+		args,
+		identity.EmailAddress,
+		identity.FullName,
+	)
 	if err != nil {
-		r.logger.ErrorCtx(ctx, "cannot delete webhook subscription", log.Error(err))
+		r.logger.ErrorCtx(ctx, "cannot export audit log", log.Error(err))
 		return nil, gqlutils.Internal(ctx)
 	}
 
-	return &types.DeleteWebhookSubscriptionPayload{
-		DeletedWebhookSubscriptionID: input.WebhookSubscriptionID,
+	return &types.ExportAuditLogPayload{
+		ExportJobID: exportJob.ID,
 	}, nil
 }
 
@@ -3872,6 +4890,11 @@ func (r *mutationResolver) PublishDocumentVersion(ctx context.Context, input typ
 			return nil, gqlutils.Invalid(ctx, errNoChanges)
 		}
 
+		var errNotApproved *probo.ErrDocumentVersionNotApproved
+		if errors.As(err, &errNotApproved) {
+			return nil, gqlutils.Invalid(ctx, errNotApproved)
+		}
+
 		// TODO no panic use gqlutils.InternalError
 		panic(fmt.Errorf("cannot publish document version: %w", err))
 	}
@@ -3915,6 +4938,11 @@ func (r *mutationResolver) BulkPublishDocumentVersions(ctx context.Context, inpu
 			return nil, gqlutils.Invalid(ctx, errNoChanges)
 		}
 
+		var errNotApproved *probo.ErrDocumentVersionNotApproved
+		if errors.As(err, &errNotApproved) {
+			return nil, gqlutils.Invalid(ctx, errNotApproved)
+		}
+
 		// TODO no panic use gqlutils.InternalError
 		panic(fmt.Errorf("cannot bulk publish document versions: %w", err))
 	}
@@ -3925,6 +4953,113 @@ func (r *mutationResolver) BulkPublishDocumentVersions(ctx context.Context, inpu
 	}, nil
 }
 
+// RequestDocumentVersionApproval is the resolver for the requestDocumentVersionApproval field.
+func (r *mutationResolver) RequestDocumentVersionApproval(ctx context.Context, input types.RequestDocumentVersionApprovalInput) (*types.RequestDocumentVersionApprovalPayload, error) {
+	if err := r.authorize(ctx, input.DocumentVersionID, probo.ActionDocumentVersionRequestApproval); err != nil {
+		return nil, err
+	}
+
+	prb := r.ProboService(ctx, input.DocumentVersionID.TenantID())
+
+	documentVersion, err := prb.Documents.RequestVersionApproval(ctx, input.DocumentVersionID)
+	if err != nil {
+		if errors.Is(err, coredata.ErrResourceNotFound) {
+			return nil, gqlutils.NotFound(ctx, err)
+		}
+
+		r.logger.ErrorCtx(ctx, "cannot request document version approval", log.Error(err))
+		return nil, gqlutils.Internal(ctx)
+	}
+
+	return &types.RequestDocumentVersionApprovalPayload{
+		DocumentVersion: types.NewDocumentVersion(documentVersion),
+	}, nil
+}
+
+// ApproveDocumentVersion is the resolver for the approveDocumentVersion field.
+func (r *mutationResolver) ApproveDocumentVersion(ctx context.Context, input types.ApproveDocumentVersionInput) (*types.ApproveDocumentVersionPayload, error) {
+	if err := r.authorize(ctx, input.DocumentVersionID, probo.ActionDocumentVersionApprove); err != nil {
+		return nil, err
+	}
+
+	prb := r.ProboService(ctx, input.DocumentVersionID.TenantID())
+
+	identity := authn.IdentityFromContext(ctx)
+
+	approver, err := prb.Documents.ApproveVersion(
+		ctx,
+		probo.DecideDocumentVersionApprovalRequest{
+			DocumentVersionID: input.DocumentVersionID,
+			IdentityID:        identity.ID,
+			Comment:           input.Comment,
+		},
+	)
+	if err != nil {
+		if errors.Is(err, coredata.ErrResourceNotFound) {
+			return nil, gqlutils.NotFound(ctx, err)
+		}
+
+		var errValidation validator.ValidationErrors
+		if errors.As(err, &errValidation) {
+			return nil, gqlutils.Invalid(ctx, errValidation)
+		}
+
+		var errNotPending *probo.ErrDocumentVersionApprovalNotPending
+		if errors.As(err, &errNotPending) {
+			return nil, gqlutils.Conflict(ctx, errNotPending)
+		}
+
+		r.logger.ErrorCtx(ctx, "cannot approve document version", log.Error(err))
+		return nil, gqlutils.Internal(ctx)
+	}
+
+	return &types.ApproveDocumentVersionPayload{
+		DocumentVersionApproval: types.NewDocumentVersionApproval(approver),
+	}, nil
+}
+
+// RejectDocumentVersion is the resolver for the rejectDocumentVersion field.
+func (r *mutationResolver) RejectDocumentVersion(ctx context.Context, input types.RejectDocumentVersionInput) (*types.RejectDocumentVersionPayload, error) {
+	if err := r.authorize(ctx, input.DocumentVersionID, probo.ActionDocumentVersionReject); err != nil {
+		return nil, err
+	}
+
+	prb := r.ProboService(ctx, input.DocumentVersionID.TenantID())
+
+	identity := authn.IdentityFromContext(ctx)
+
+	approver, err := prb.Documents.RejectVersion(
+		ctx,
+		probo.DecideDocumentVersionApprovalRequest{
+			DocumentVersionID: input.DocumentVersionID,
+			IdentityID:        identity.ID,
+			Comment:           &input.Comment,
+		},
+	)
+	if err != nil {
+		if errors.Is(err, coredata.ErrResourceNotFound) {
+			return nil, gqlutils.NotFound(ctx, err)
+		}
+
+		var errValidation validator.ValidationErrors
+		if errors.As(err, &errValidation) {
+			return nil, gqlutils.Invalid(ctx, errValidation)
+		}
+
+		var errNotPending *probo.ErrDocumentVersionApprovalNotPending
+		if errors.As(err, &errNotPending) {
+			return nil, gqlutils.Conflict(ctx, errNotPending)
+		}
+
+		r.logger.ErrorCtx(ctx, "cannot reject document version", log.Error(err))
+		return nil, gqlutils.Internal(ctx)
+	}
+
+	return &types.RejectDocumentVersionPayload{
+		DocumentVersionApproval: types.NewDocumentVersionApproval(approver),
+	}, nil
+}
+
 // BulkDeleteDocuments is the resolver for the bulkDeleteDocuments field.
 func (r *mutationResolver) BulkDeleteDocuments(ctx context.Context, input types.BulkDeleteDocumentsInput) (*types.BulkDeleteDocumentsPayload, error) {
 	if len(input.DocumentIds) == 0 {
@@ -4171,7 +5306,18 @@ func (r *mutationResolver) SignDocument(ctx context.Context, input types.SignDoc
 	identity := authn.IdentityFromContext(ctx)
 	prb := r.ProboService(ctx, input.DocumentVersionID.TenantID())
 
-	documentVersionSignature, err := prb.Documents.SignDocumentVersionByIdentity(ctx, input.DocumentVersionID, identity.ID)
+	metadata := probo.SignatureMetadata{AuthMethod: probo.SignatureAuthMethodAPIKey}
+	if session := authn.SessionFromContext(ctx); session != nil {
+		metadata.IPAddress = session.IPAddress
+		metadata.UserAgent = session.UserAgent
+		metadata.AuthMethod = string(session.AuthMethod)
+	} else {
+		httpReq := gqlutils.HTTPRequestFromContext(ctx)
+		metadata.IPAddress = remoteIPAddress(httpReq)
+		metadata.UserAgent = httpReq.UserAgent()
+	}
+
+	documentVersionSignature, err := prb.Documents.SignDocumentVersionByIdentity(ctx, input.DocumentVersionID, identity.ID, metadata)
 	if err != nil {
 		if errors.Is(err, coredata.ErrResourceAlreadyExists) {
 			return nil, gqlutils.Conflict(ctx, err)
@@ -4255,6 +5401,57 @@ func (r *mutationResolver) ExportSignableVersionDocumentPDF(ctx context.Context,
 	}, nil
 }
 
+// ExportDocumentVersionSignatureCertificatePDF is the resolver for the exportDocumentVersionSignatureCertificatePDF field.
+func (r *mutationResolver) ExportDocumentVersionSignatureCertificatePDF(ctx context.Context, input types.ExportDocumentVersionSignatureCertificatePDFInput) (*types.ExportDocumentVersionSignatureCertificatePDFPayload, error) {
+	if err := r.authorize(ctx, input.DocumentVersionID, probo.ActionDocumentVersionExportSignatureCertificate); err != nil {
+		return nil, err
+	}
+
+	prb := r.ProboService(ctx, input.DocumentVersionID.TenantID())
+
+	pdf, err := prb.Documents.ExportSignatureCertificatePDF(ctx, input.DocumentVersionID)
+	if err != nil {
+		if errors.Is(err, coredata.ErrResourceNotFound) {
+			return nil, gqlutils.NotFound(ctx, err)
+		}
+
+		// TODO no panic use gqlutils.InternalError
+		panic(fmt.Errorf("cannot export signature certificate PDF: %w", err))
+	}
+
+	return &types.ExportDocumentVersionSignatureCertificatePDFPayload{
+		Data: fmt.Sprintf("data:application/pdf;base64,%s", base64.StdEncoding.EncodeToString(pdf)),
+	}, nil
+}
+
+// ExportDocumentVersionDiffPDF is the resolver for the exportDocumentVersionDiffPDF field.
+func (r *mutationResolver) ExportDocumentVersionDiffPDF(ctx context.Context, input types.ExportDocumentVersionDiffPDFInput) (*types.ExportDocumentVersionDiffPDFPayload, error) {
+	if err := r.authorize(ctx, input.ToDocumentVersionID, probo.ActionDocumentVersionExportDiff); err != nil {
+		return nil, err
+	}
+
+	prb := r.ProboService(ctx, input.ToDocumentVersionID.TenantID())
+
+	pdf, err := prb.Documents.ExportVersionDiffPDF(ctx, input.FromDocumentVersionID, input.ToDocumentVersionID)
+	if err != nil {
+		if errors.Is(err, coredata.ErrResourceNotFound) {
+			return nil, gqlutils.NotFound(ctx, err)
+		}
+
+		var errMismatch *probo.ErrDocumentVersionsMismatch
+		if errors.As(err, &errMismatch) {
+			return nil, gqlutils.Invalid(ctx, err)
+		}
+
+		// TODO no panic use gqlutils.InternalError
+		panic(fmt.Errorf("cannot export document version diff PDF: %w", err))
+	}
+
+	return &types.ExportDocumentVersionDiffPDFPayload{
+		Data: fmt.Sprintf("data:application/pdf;base64,%s", base64.StdEncoding.EncodeToString(pdf)),
+	}, nil
+}
+
 // ExportProcessingActivitiesPDF is the resolver for the exportProcessingActivitiesPDF field.
 func (r *mutationResolver) ExportProcessingActivitiesPDF(ctx context.Context, input types.ExportProcessingActivitiesPDFInput) (*types.ExportProcessingActivitiesPDFPayload, error) {
 	if err := r.authorize(ctx, input.OrganizationID, probo.ActionProcessingActivityExport); err != nil {
@@ -5241,59 +6438,195 @@ func (r *mutationResolver) DeleteTransferImpactAssessment(ctx context.Context, i
 
 	prb := r.ProboService(ctx, input.TransferImpactAssessmentID.TenantID())
 
-	err := prb.TransferImpactAssessments.Delete(ctx, input.TransferImpactAssessmentID)
-	if err != nil {
-		panic(fmt.Errorf("cannot delete transfer impact assessment: %w", err))
+	err := prb.TransferImpactAssessments.Delete(ctx, input.TransferImpactAssessmentID)
+	if err != nil {
+		panic(fmt.Errorf("cannot delete transfer impact assessment: %w", err))
+	}
+
+	return &types.DeleteTransferImpactAssessmentPayload{
+		DeletedTransferImpactAssessmentID: input.TransferImpactAssessmentID,
+	}, nil
+}
+
+// CreateSnapshot is the resolver for the createSnapshot field.
+func (r *mutationResolver) CreateSnapshot(ctx context.Context, input types.CreateSnapshotInput) (*types.CreateSnapshotPayload, error) {
+	if err := r.authorize(ctx, input.OrganizationID, probo.ActionSnapshotCreate); err != nil {
+		return nil, err
+	}
+
+	prb := r.ProboService(ctx, input.OrganizationID.TenantID())
+
+	snapshot, err := prb.Snapshots.Create(
+		ctx,
+		&probo.CreateSnapshotRequest{
+			OrganizationID: input.OrganizationID,
+			Name:           input.Name,
+			Description:    input.Description,
+			Type:           input.Type,
+		},
+	)
+	if err != nil {
+		// TODO no panic use gqlutils.InternalError
+		panic(fmt.Errorf("cannot create snapshot: %w", err))
+	}
+
+	return &types.CreateSnapshotPayload{
+		SnapshotEdge: types.NewSnapshotEdge(snapshot, coredata.SnapshotOrderFieldCreatedAt),
+	}, nil
+}
+
+// DeleteSnapshot is the resolver for the deleteSnapshot field.
+func (r *mutationResolver) DeleteSnapshot(ctx context.Context, input types.DeleteSnapshotInput) (*types.DeleteSnapshotPayload, error) {
+	if err := r.authorize(ctx, input.SnapshotID, probo.ActionSnapshotDelete); err != nil {
+		return nil, err
+	}
+
+	prb := r.ProboService(ctx, input.SnapshotID.TenantID())
+
+	err := prb.Snapshots.Delete(ctx, input.SnapshotID)
+	if err != nil {
+		// TODO no panic use gqlutils.InternalError
+		panic(fmt.Errorf("cannot delete snapshot: %w", err))
+	}
+
+	return &types.DeleteSnapshotPayload{
+		DeletedSnapshotID: input.SnapshotID,
+	}, nil
+}
+
+// CreateSnapshotSchedule is the resolver for the createSnapshotSchedule field.
+func (r *mutationResolver) CreateSnapshotSchedule(ctx context.Context, input types.CreateSnapshotScheduleInput) (*types.CreateSnapshotSchedulePayload, error) {
+	if err := r.authorize(ctx, input.OrganizationID, probo.ActionSnapshotScheduleCreate); err != nil {
+		return nil, err
+	}
+
+	prb := r.ProboService(ctx, input.OrganizationID.TenantID())
+
+	schedule, err := prb.SnapshotSchedules.Create(
+		ctx,
+		probo.CreateSnapshotScheduleRequest{
+			OrganizationID: input.OrganizationID,
+			Name:           input.Name,
+			Description:    input.Description,
+			Type:           input.Type,
+			CronExpression: input.CronExpression,
+		},
+	)
+	if err != nil {
+		if errors.Is(err, coredata.ErrResourceNotFound) {
+			return nil, gqlutils.NotFound(ctx, err)
+		}
+
+		var errValidation validator.ValidationErrors
+		if errors.As(err, &errValidation) {
+			return nil, gqlutils.Invalid(ctx, errValidation)
+		}
+
+		r.logger.ErrorCtx(ctx, "cannot create snapshot schedule", log.Error(err))
+		return nil, gqlutils.Internal(ctx)
 	}
 
-	return &types.DeleteTransferImpactAssessmentPayload{
-		DeletedTransferImpactAssessmentID: input.TransferImpactAssessmentID,
+	return &types.CreateSnapshotSchedulePayload{
+		SnapshotScheduleEdge: types.NewSnapshotScheduleEdge(schedule, coredata.SnapshotScheduleOrderFieldCreatedAt),
 	}, nil
 }
 
-// CreateSnapshot is the resolver for the createSnapshot field.
-func (r *mutationResolver) CreateSnapshot(ctx context.Context, input types.CreateSnapshotInput) (*types.CreateSnapshotPayload, error) {
-	if err := r.authorize(ctx, input.OrganizationID, probo.ActionSnapshotCreate); err != nil {
+// UpdateSnapshotSchedule is the resolver for the updateSnapshotSchedule field.
+func (r *mutationResolver) UpdateSnapshotSchedule(ctx context.Context, input types.UpdateSnapshotScheduleInput) (*types.UpdateSnapshotSchedulePayload, error) {
+	if err := r.authorize(ctx, input.ID, probo.ActionSnapshotScheduleUpdate); err != nil {
 		return nil, err
 	}
 
-	prb := r.ProboService(ctx, input.OrganizationID.TenantID())
+	prb := r.ProboService(ctx, input.ID.TenantID())
 
-	snapshot, err := prb.Snapshots.Create(
+	schedule, err := prb.SnapshotSchedules.Update(
 		ctx,
-		&probo.CreateSnapshotRequest{
-			OrganizationID: input.OrganizationID,
+		probo.UpdateSnapshotScheduleRequest{
+			ID:             input.ID,
 			Name:           input.Name,
-			Description:    input.Description,
+			Description:    gqlutils.UnwrapOmittable(input.Description),
 			Type:           input.Type,
+			CronExpression: input.CronExpression,
 		},
 	)
 	if err != nil {
-		// TODO no panic use gqlutils.InternalError
-		panic(fmt.Errorf("cannot create snapshot: %w", err))
+		if errors.Is(err, coredata.ErrResourceNotFound) {
+			return nil, gqlutils.NotFound(ctx, err)
+		}
+
+		var errValidation validator.ValidationErrors
+		if errors.As(err, &errValidation) {
+			return nil, gqlutils.Invalid(ctx, errValidation)
+		}
+
+		r.logger.ErrorCtx(ctx, "cannot update snapshot schedule", log.Error(err))
+		return nil, gqlutils.Internal(ctx)
 	}
 
-	return &types.CreateSnapshotPayload{
-		SnapshotEdge: types.NewSnapshotEdge(snapshot, coredata.SnapshotOrderFieldCreatedAt),
+	return &types.UpdateSnapshotSchedulePayload{
+		SnapshotSchedule: types.NewSnapshotSchedule(schedule),
 	}, nil
 }
 
-// DeleteSnapshot is the resolver for the deleteSnapshot field.
-func (r *mutationResolver) DeleteSnapshot(ctx context.Context, input types.DeleteSnapshotInput) (*types.DeleteSnapshotPayload, error) {
-	if err := r.authorize(ctx, input.SnapshotID, probo.ActionSnapshotDelete); err != nil {
+// DeleteSnapshotSchedule is the resolver for the deleteSnapshotSchedule field.
+func (r *mutationResolver) DeleteSnapshotSchedule(ctx context.Context, input types.DeleteSnapshotScheduleInput) (*types.DeleteSnapshotSchedulePayload, error) {
+	if err := r.authorize(ctx, input.SnapshotScheduleID, probo.ActionSnapshotScheduleDelete); err != nil {
 		return nil, err
 	}
 
-	prb := r.ProboService(ctx, input.SnapshotID.TenantID())
+	prb := r.ProboService(ctx, input.SnapshotScheduleID.TenantID())
 
-	err := prb.Snapshots.Delete(ctx, input.SnapshotID)
+	if err := prb.SnapshotSchedules.Delete(ctx, input.SnapshotScheduleID); err != nil {
+		if errors.Is(err, coredata.ErrResourceNotFound) {
+			return nil, gqlutils.NotFound(ctx, err)
+		}
+
+		r.logger.ErrorCtx(ctx, "cannot delete snapshot schedule", log.Error(err))
+		return nil, gqlutils.Internal(ctx)
+	}
+
+	return &types.DeleteSnapshotSchedulePayload{
+		DeletedSnapshotScheduleID: input.SnapshotScheduleID,
+	}, nil
+}
+
+// UpdateDeadlineReminderSettings is the resolver for the updateDeadlineReminderSettings field.
+func (r *mutationResolver) UpdateDeadlineReminderSettings(ctx context.Context, input types.UpdateDeadlineReminderSettingsInput) (*types.UpdateDeadlineReminderSettingsPayload, error) {
+	if err := r.authorize(ctx, input.OrganizationID, probo.ActionDeadlineReminderSettingsUpdate); err != nil {
+		return nil, err
+	}
+
+	prb := r.ProboService(ctx, input.OrganizationID.TenantID())
+
+	req := probo.UpdateDeadlineReminderSettingsRequest{
+		OrganizationID:      input.OrganizationID,
+		Enabled:             input.Enabled,
+		RemindOnDueDate:     input.RemindOnDueDate,
+		OverdueIntervalDays: input.OverdueIntervalDays,
+		EscalationGraceDays: gqlutils.UnwrapOmittable(input.EscalationGraceDays),
+		SlackEnabled:        input.SlackEnabled,
+	}
+	if input.DaysBefore != nil {
+		req.DaysBefore = &input.DaysBefore
+	}
+
+	settings, err := prb.DeadlineReminderSettings.Update(ctx, req)
 	if err != nil {
-		// TODO no panic use gqlutils.InternalError
-		panic(fmt.Errorf("cannot delete snapshot: %w", err))
+		if errors.Is(err, coredata.ErrResourceNotFound) {
+			return nil, gqlutils.NotFound(ctx, err)
+		}
+
+		var errValidation validator.ValidationErrors
+		if errors.As(err, &errValidation) {
+			return nil, gqlutils.Invalid(ctx, errValidation)
+		}
+
+		r.logger.ErrorCtx(ctx, "cannot update deadline reminder settings", log.Error(err))
+		return nil, gqlutils.Internal(ctx)
 	}
 
-	return &types.DeleteSnapshotPayload{
-		DeletedSnapshotID: input.SnapshotID,
+	return &types.UpdateDeadlineReminderSettingsPayload{
+		DeadlineReminderSettings: types.NewDeadlineReminderSettings(settings),
 	}, nil
 }
 
@@ -5772,7 +7105,7 @@ func (r *organizationResolver) Documents(ctx context.Context, obj *types.Organiz
 
 	var documentFilter = coredata.NewDocumentFilter(nil)
 	if filter != nil {
-		documentFilter = coredata.NewDocumentFilter(filter.Query)
+		documentFilter = coredata.NewDocumentFilter(filter.Query).WithReviewOverdue(filter.ReviewOverdue)
 	}
 
 	page, err := prb.Documents.ListByOrganizationID(ctx, obj.ID, cursor, documentFilter)
@@ -6322,6 +7655,74 @@ func (r *organizationResolver) Snapshots(ctx context.Context, obj *types.Organiz
 	return types.NewSnapshotConnection(page, r, obj.ID), nil
 }
 
+// SnapshotSchedules is the resolver for the snapshotSchedules field.
+func (r *organizationResolver) SnapshotSchedules(ctx context.Context, obj *types.Organization, first *int, after *page.CursorKey, last *int, before *page.CursorKey, orderBy *types.SnapshotScheduleOrderBy) (*types.SnapshotScheduleConnection, error) {
+	if err := r.authorize(ctx, obj.ID, probo.ActionSnapshotScheduleList); err != nil {
+		return nil, err
+	}
+
+	prb := r.ProboService(ctx, obj.ID.TenantID())
+
+	pageOrderBy := page.OrderBy[coredata.SnapshotScheduleOrderField]{
+		Field:     coredata.SnapshotScheduleOrderFieldCreatedAt,
+		Direction: page.OrderDirectionDesc,
+	}
+	if orderBy != nil {
+		pageOrderBy = page.OrderBy[coredata.SnapshotScheduleOrderField]{
+			Field:     orderBy.Field,
+			Direction: orderBy.Direction,
+		}
+	}
+
+	cursor := types.NewCursor(first, after, last, before, pageOrderBy)
+
+	page, err := prb.SnapshotSchedules.ListForOrganizationID(ctx, obj.ID, cursor)
+	if err != nil {
+		r.logger.ErrorCtx(ctx, "cannot list organization snapshot schedules", log.Error(err))
+		return nil, gqlutils.Internal(ctx)
+	}
+
+	return types.NewSnapshotScheduleConnection(page, r, obj.ID), nil
+}
+
+// SnapshotDiff is the resolver for the snapshotDiff field.
+func (r *organizationResolver) SnapshotDiff(ctx context.Context, obj *types.Organization, fromSnapshotID gid.GID, toSnapshotID gid.GID) (*types.SnapshotDiff, error) {
+	if err := r.authorize(ctx, obj.ID, probo.ActionSnapshotDiff); err != nil {
+		return nil, err
+	}
+
+	if err := r.authorize(ctx, fromSnapshotID, probo.ActionSnapshotGet); err != nil {
+		return nil, err
+	}
+
+	if err := r.authorize(ctx, toSnapshotID, probo.ActionSnapshotGet); err != nil {
+		return nil, err
+	}
+
+	prb := r.ProboService(ctx, obj.ID.TenantID())
+
+	diff, err := prb.Snapshots.Diff(ctx, obj.ID, fromSnapshotID, toSnapshotID)
+	if err != nil {
+		if errors.Is(err, coredata.ErrResourceNotFound) {
+			return nil, gqlutils.NotFound(ctx, err)
+		}
+
+		var (
+			errTypeMismatch *probo.ErrSnapshotTypeMismatch
+			errUnsupported  *probo.ErrSnapshotDiffUnsupported
+		)
+
+		if errors.As(err, &errTypeMismatch) || errors.As(err, &errUnsupported) {
+			return nil, gqlutils.Invalid(ctx, err)
+		}
+
+		r.logger.ErrorCtx(ctx, "cannot diff snapshots", log.Error(err))
+		return nil, gqlutils.Internal(ctx)
+	}
+
+	return types.NewSnapshotDiff(diff), nil
+}
+
 // TrustCenterFiles is the resolver for the trustCenterFiles field.
 func (r *organizationResolver) TrustCenterFiles(ctx context.Context, obj *types.Organization, first *int, after *page.CursorKey, last *int, before *page.CursorKey, orderBy *types.OrderBy[coredata.TrustCenterFileOrderField]) (*types.TrustCenterFileConnection, error) {
 	if err := r.authorize(ctx, obj.ID, probo.ActionTrustCenterFileList); err != nil {
@@ -6420,6 +7821,83 @@ func (r *organizationResolver) WebhookSubscriptions(ctx context.Context, obj *ty
 	return types.NewWebhookSubscriptionConnection(page, r, obj.ID), nil
 }
 
+// AuditLogEntries is the resolver for the auditLogEntries field.
+func (r *organizationResolver) AuditLogEntries(ctx context.Context, obj *types.Organization, first *int, after *page.CursorKey, last *int, before *page.CursorKey, orderBy *types.AuditLogEntryOrderBy, filter *types.AuditLogEntryFilter) (*types.AuditLogEntryConnection, error) {
+	if err := r.authorize(ctx, obj.ID, probo.ActionAuditLogEntryList); err != nil {
+		return nil, err
+	}
+
+	prb := r.ProboService(ctx, obj.ID.TenantID())
+
+	pageOrderBy := page.OrderBy[coredata.AuditLogEntryOrderField]{
+		Field:     coredata.AuditLogEntryOrderFieldCreatedAt,
+		Direction: page.OrderDirectionDesc,
+	}
+	if orderBy != nil {
+		pageOrderBy = page.OrderBy[coredata.AuditLogEntryOrderField]{
+			Field:     orderBy.Field,
+			Direction: orderBy.Direction,
+		}
+	}
+
+	cursor := types.NewCursor(first, after, last, before, pageOrderBy)
+
+	page, err := prb.AuditLogEntries.ListForOrganizationID(ctx, obj.ID, cursor, types.NewAuditLogEntryFilter(filter))
+	if err != nil {
+		r.logger.ErrorCtx(ctx, "cannot list organization audit log entries", log.Error(err))
+		return nil, gqlutils.Internal(ctx)
+	}
+
+	return types.NewAuditLogEntryConnection(page, r, obj.ID, filter), nil
+}
+
+// Emails is the resolver for the emails field.
+func (r *organizationResolver) Emails(ctx context.Context, obj *types.Organization, first *int, after *page.CursorKey, last *int, before *page.CursorKey, orderBy *types.EmailOrderBy, filter *types.EmailFilter) (*types.EmailConnection, error) {
+	if err := r.authorize(ctx, obj.ID, probo.ActionEmailList); err != nil {
+		return nil, err
+	}
+
+	prb := r.ProboService(ctx, obj.ID.TenantID())
+
+	pageOrderBy := page.OrderBy[coredata.EmailOrderField]{
+		Field:     coredata.EmailOrderFieldCreatedAt,
+		Direction: page.OrderDirectionDesc,
+	}
+	if orderBy != nil {
+		pageOrderBy = page.OrderBy[coredata.EmailOrderField]{
+			Field:     orderBy.Field,
+			Direction: orderBy.Direction,
+		}
+	}
+
+	cursor := types.NewCursor(first, after, last, before, pageOrderBy)
+
+	page, err := prb.Emails.ListForOrganizationID(ctx, obj.ID, cursor, types.NewEmailFilter(filter))
+	if err != nil {
+		r.logger.ErrorCtx(ctx, "cannot list organization emails", log.Error(err))
+		return nil, gqlutils.Internal(ctx)
+	}
+
+	return types.NewEmailConnection(page, r, obj.ID, filter), nil
+}
+
+// DeadlineReminderSettings is the resolver for the deadlineReminderSettings field.
+func (r *organizationResolver) DeadlineReminderSettings(ctx context.Context, obj *types.Organization) (*types.DeadlineReminderSettings, error) {
+	if err := r.authorize(ctx, obj.ID, probo.ActionDeadlineReminderSettingsGet); err != nil {
+		return nil, err
+	}
+
+	prb := r.ProboService(ctx, obj.ID.TenantID())
+
+	settings, err := prb.DeadlineReminderSettings.GetByOrganizationID(ctx, obj.ID)
+	if err != nil {
+		r.logger.ErrorCtx(ctx, "cannot load deadline reminder settings", log.Error(err))
+		return nil, gqlutils.Internal(ctx)
+	}
+
+	return types.NewDeadlineReminderSettings(settings), nil
+}
+
 // Permission is the resolver for the permission field.
 func (r *organizationResolver) Permission(ctx context.Context, obj *types.Organization, action string) (bool, error) {
 	return r.Resolver.Permission(ctx, obj, action)
@@ -6772,6 +8250,51 @@ func (r *queryResolver) Node(ctx context.Context, id gid.GID) (types.Node, error
 			}
 			return types.NewNonconformity(nonconformity), nil
 		}
+	case coredata.AcknowledgementCampaignEntityType:
+		action = probo.ActionAcknowledgementCampaignGet
+		loadNode = func(ctx context.Context, id gid.GID) (types.Node, error) {
+			campaign, err := prb.AcknowledgementCampaigns.Get(ctx, id)
+			if err != nil {
+				return nil, err
+			}
+			return types.NewAcknowledgementCampaign(campaign), nil
+		}
+	case coredata.ImportJobEntityType:
+		action = probo.ActionImportJobGet
+		loadNode = func(ctx context.Context, id gid.GID) (types.Node, error) {
+			importJob, err := prb.Imports.Get(ctx, id)
+			if err != nil {
+				return nil, err
+			}
+			return types.NewImportJob(importJob), nil
+		}
+	case coredata.SnapshotScheduleEntityType:
+		action = probo.ActionSnapshotScheduleGet
+		loadNode = func(ctx context.Context, id gid.GID) (types.Node, error) {
+			schedule, err := prb.SnapshotSchedules.Get(ctx, id)
+			if err != nil {
+				return nil, err
+			}
+			return types.NewSnapshotSchedule(schedule), nil
+		}
+	case coredata.ConnectorEvidenceMappingEntityType:
+		action = probo.ActionConnectorEvidenceMappingGet
+		loadNode = func(ctx context.Context, id gid.GID) (types.Node, error) {
+			mapping, err := prb.ConnectorEvidenceMappings.Get(ctx, id)
+			if err != nil {
+				return nil, err
+			}
+			return types.NewConnectorEvidenceMapping(mapping), nil
+		}
+	case coredata.AuditLogEntryEntityType:
+		action = probo.ActionAuditLogEntryGet
+		loadNode = func(ctx context.Context, id gid.GID) (types.Node, error) {
+			entry, err := prb.AuditLogEntries.Get(ctx, id)
+			if err != nil {
+				return nil, err
+			}
+			return types.NewAuditLogEntry(entry), nil
+		}
 	case coredata.ObligationEntityType:
 		action = probo.ActionObligationList
 		loadNode = func(ctx context.Context, id gid.GID) (types.Node, error) {
@@ -7120,7 +8643,7 @@ func (r *riskResolver) Documents(ctx context.Context, obj *types.Risk, first *in
 
 	var documentFilter = coredata.NewDocumentFilter(nil)
 	if filter != nil {
-		documentFilter = coredata.NewDocumentFilter(filter.Query)
+		documentFilter = coredata.NewDocumentFilter(filter.Query).WithReviewOverdue(filter.ReviewOverdue)
 	}
 
 	page, err := prb.Documents.ListForRiskID(ctx, obj.ID, cursor, documentFilter)
@@ -7370,6 +8893,75 @@ func (r *snapshotConnectionResolver) TotalCount(ctx context.Context, obj *types.
 	panic(fmt.Errorf("unsupported resolver: %T", obj.Resolver))
 }
 
+// Organization is the resolver for the organization field.
+func (r *snapshotScheduleResolver) Organization(ctx context.Context, obj *types.SnapshotSchedule) (*types.Organization, error) {
+	if err := r.authorize(ctx, obj.ID, probo.ActionOrganizationGet); err != nil {
+		return nil, err
+	}
+
+	prb := r.ProboService(ctx, obj.ID.TenantID())
+
+	organization, err := prb.Organizations.Get(ctx, obj.Organization.ID)
+	if err != nil {
+		if errors.Is(err, coredata.ErrResourceNotFound) {
+			return nil, gqlutils.NotFound(ctx, err)
+		}
+
+		r.logger.ErrorCtx(ctx, "cannot get organization", log.Error(err))
+		return nil, gqlutils.Internal(ctx)
+	}
+
+	return types.NewOrganization(organization), nil
+}
+
+// LastSnapshot is the resolver for the lastSnapshot field.
+func (r *snapshotScheduleResolver) LastSnapshot(ctx context.Context, obj *types.SnapshotSchedule) (*types.Snapshot, error) {
+	if obj.LastSnapshot == nil {
+		return nil, nil
+	}
+
+	if err := r.authorize(ctx, obj.LastSnapshot.ID, probo.ActionSnapshotGet); err != nil {
+		return nil, err
+	}
+
+	prb := r.ProboService(ctx, obj.ID.TenantID())
+
+	snapshot, err := prb.Snapshots.Get(ctx, obj.LastSnapshot.ID)
+	if err != nil {
+		r.logger.ErrorCtx(ctx, "cannot get snapshot", log.Error(err))
+		return nil, gqlutils.Internal(ctx)
+	}
+
+	return types.NewSnapshot(snapshot), nil
+}
+
+// Permission is the resolver for the permission field.
+func (r *snapshotScheduleResolver) Permission(ctx context.Context, obj *types.SnapshotSchedule, action string) (bool, error) {
+	return r.Resolver.Permission(ctx, obj, action)
+}
+
+// TotalCount is the resolver for the totalCount field.
+func (r *snapshotScheduleConnectionResolver) TotalCount(ctx context.Context, obj *types.SnapshotScheduleConnection) (int, error) {
+	if err := r.authorize(ctx, obj.ParentID, probo.ActionSnapshotScheduleList); err != nil {
+		return 0, err
+	}
+
+	prb := r.ProboService(ctx, obj.ParentID.TenantID())
+
+	switch obj.Resolver.(type) {
+	case *organizationResolver:
+		count, err := prb.SnapshotSchedules.CountForOrganizationID(ctx, obj.ParentID)
+		if err != nil {
+			r.logger.ErrorCtx(ctx, "cannot count snapshot schedules", log.Error(err))
+			return 0, gqlutils.Internal(ctx)
+		}
+		return count, nil
+	}
+
+	r.logger.ErrorCtx(ctx, "unsupported resolver", log.Any("resolver", obj.Resolver))
+	return 0, gqlutils.Internal(ctx)
+}
+
 // Organization is the resolver for the organization field.
 func (r *stateOfApplicabilityResolver) Organization(ctx context.Context, obj *types.StateOfApplicability) (*types.Organization, error) {
 	if err := r.authorize(ctx, obj.ID, probo.ActionOrganizationGet); err != nil {
@@ -8691,6 +10283,16 @@ func (r *webhookSubscriptionConnectionResolver) TotalCount(ctx context.Context,
 	return 0, gqlutils.Internal(ctx)
 }
 
+// AcknowledgementCampaign returns schema.AcknowledgementCampaignResolver implementation.
+func (r *Resolver) AcknowledgementCampaign() schema.AcknowledgementCampaignResolver {
+	return &acknowledgementCampaignResolver{r}
+}
+
+// AcknowledgementCampaignRun returns schema.AcknowledgementCampaignRunResolver implementation.
+func (r *Resolver) AcknowledgementCampaignRun() schema.AcknowledgementCampaignRunResolver {
+	return &acknowledgementCampaignRunResolver{r}
+}
+
 // ApplicabilityStatement returns schema.ApplicabilityStatementResolver implementation.
 func (r *Resolver) ApplicabilityStatement() schema.ApplicabilityStatementResolver {
 	return &applicabilityStatementResolver{r}
@@ -8717,6 +10319,21 @@ func (r *Resolver) AuditConnection() schema.AuditConnectionResolver {
 	return &auditConnectionResolver{r}
 }
 
+// AuditLogEntryConnection returns schema.AuditLogEntryConnectionResolver implementation.
+func (r *Resolver) AuditLogEntryConnection() schema.AuditLogEntryConnectionResolver {
+	return &auditLogEntryConnectionResolver{r}
+}
+
+// ConnectorEvidenceMapping returns schema.ConnectorEvidenceMappingResolver implementation.
+func (r *Resolver) ConnectorEvidenceMapping() schema.ConnectorEvidenceMappingResolver {
+	return &connectorEvidenceMappingResolver{r}
+}
+
+// ConnectorEvidenceMappingConnection returns schema.ConnectorEvidenceMappingConnectionResolver implementation.
+func (r *Resolver) ConnectorEvidenceMappingConnection() schema.ConnectorEvidenceMappingConnectionResolver {
+	return &connectorEvidenceMappingConnectionResolver{r}
+}
+
 // ContinualImprovement returns schema.ContinualImprovementResolver implementation.
 func (r *Resolver) ContinualImprovement() schema.ContinualImprovementResolver {
 	return &continualImprovementResolver{r}
@@ -8769,6 +10386,11 @@ func (r *Resolver) DocumentVersion() schema.DocumentVersionResolver {
 	return &documentVersionResolver{r}
 }
 
+// DocumentVersionApproval returns schema.DocumentVersionApprovalResolver implementation.
+func (r *Resolver) DocumentVersionApproval() schema.DocumentVersionApprovalResolver {
+	return &documentVersionApprovalResolver{r}
+}
+
 // DocumentVersionConnection returns schema.DocumentVersionConnectionResolver implementation.
 func (r *Resolver) DocumentVersionConnection() schema.DocumentVersionConnectionResolver {
 	return &documentVersionConnectionResolver{r}
@@ -8784,6 +10406,11 @@ func (r *Resolver) DocumentVersionSignatureConnection() schema.DocumentVersionSi
 	return &documentVersionSignatureConnectionResolver{r}
 }
 
+// EmailConnection returns schema.EmailConnectionResolver implementation.
+func (r *Resolver) EmailConnection() schema.EmailConnectionResolver {
+	return &emailConnectionResolver{r}
+}
+
 // Evidence returns schema.EvidenceResolver implementation.
 func (r *Resolver) Evidence() schema.EvidenceResolver { return &evidenceResolver{r} }
 
@@ -8892,6 +10519,16 @@ func (r *Resolver) SnapshotConnection() schema.SnapshotConnectionResolver {
 	return &snapshotConnectionResolver{r}
 }
 
+// SnapshotSchedule returns schema.SnapshotScheduleResolver implementation.
+func (r *Resolver) SnapshotSchedule() schema.SnapshotScheduleResolver {
+	return &snapshotScheduleResolver{r}
+}
+
+// SnapshotScheduleConnection returns schema.SnapshotScheduleConnectionResolver implementation.
+func (r *Resolver) SnapshotScheduleConnection() schema.SnapshotScheduleConnectionResolver {
+	return &snapshotScheduleConnectionResolver{r}
+}
+
 // StateOfApplicability returns schema.StateOfApplicabilityResolver implementation.
 func (r *Resolver) StateOfApplicability() schema.StateOfApplicabilityResolver {
 	return &stateOfApplicabilityResolver{r}
@@ -9008,12 +10645,17 @@ func (r *Resolver) WebhookSubscriptionConnection() schema.WebhookSubscriptionCon
 	return &webhookSubscriptionConnectionResolver{r}
 }
 
+type acknowledgementCampaignResolver struct{ *Resolver }
+type acknowledgementCampaignRunResolver struct{ *Resolver }
 type applicabilityStatementResolver struct{ *Resolver }
 type applicabilityStatementConnectionResolver struct{ *Resolver }
 type assetResolver struct{ *Resolver }
 type assetConnectionResolver struct{ *Resolver }
 type auditResolver struct{ *Resolver }
 type auditConnectionResolver struct{ *Resolver }
+type auditLogEntryConnectionResolver struct{ *Resolver }
+type connectorEvidenceMappingResolver struct{ *Resolver }
+type connectorEvidenceMappingConnectionResolver struct{ *Resolver }
 type continualImprovementResolver struct{ *Resolver }
 type continualImprovementConnectionResolver struct{ *Resolver }
 type controlResolver struct{ *Resolver }
@@ -9026,9 +10668,11 @@ type datumConnectionResolver struct{ *Resolver }
 type documentResolver struct{ *Resolver }
 type documentConnectionResolver struct{ *Resolver }
 type documentVersionResolver struct{ *Resolver }
+type documentVersionApprovalResolver struct{ *Resolver }
 type documentVersionConnectionResolver struct{ *Resolver }
 type documentVersionSignatureResolver struct{ *Resolver }
 type documentVersionSignatureConnectionResolver struct{ *Resolver }
+type emailConnectionResolver struct{ *Resolver }
 type evidenceResolver struct{ *Resolver }
 type evidenceConnectionResolver struct{ *Resolver }
 type fileResolver struct{ *Resolver }
@@ -9057,6 +10701,8 @@ type riskConnectionResolver struct{ *Resolver }
 type signableDocumentResolver struct{ *Resolver }
 type snapshotResolver struct{ *Resolver }
 type snapshotConnectionResolver struct{ *Resolver }
+type snapshotScheduleResolver struct{ *Resolver }
+type snapshotScheduleConnectionResolver struct{ *Resolver }
 type stateOfApplicabilityResolver struct{ *Resolver }
 type stateOfApplicabilityConnectionResolver struct{ *Resolver }
 type taskResolver struct{ *Resolver }
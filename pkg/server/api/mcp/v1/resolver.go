@@ -21,14 +21,16 @@ type Resolver struct {
 func (r *Resolver) MustAuthorize(ctx context.Context, entityID gid.GID, action iam.Action) {
 	identity := authn.IdentityFromContext(ctx)
 
-	err := r.iamSvc.Authorizer.Authorize(
-		ctx,
-		iam.AuthorizeParams{
-			Principal: identity.ID,
-			Resource:  entityID,
-			Action:    action,
-		},
-	)
+	params := iam.AuthorizeParams{
+		Principal: identity.ID,
+		Resource:  entityID,
+		Action:    action,
+	}
+	if apiKey := authn.APIKeyFromContext(ctx); apiKey != nil {
+		params.APIKey = &apiKey.ID
+	}
+
+	err := r.iamSvc.Authorizer.Authorize(ctx, params)
 	if err != nil {
 		panic(err)
 	}
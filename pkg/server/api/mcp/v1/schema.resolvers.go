@@ -6,6 +6,7 @@ package mcp_v1
 
 import (
 	"context"
+	"errors"
 	"fmt"
 
 	"github.com/modelcontextprotocol/go-sdk/mcp"
@@ -1754,6 +1755,11 @@ func (r *Resolver) PublishDocumentVersionTool(ctx context.Context, req *mcp.Call
 
 	document, documentVersion, err := svc.Documents.PublishVersion(ctx, input.DocumentID, user.ID, input.Changelog)
 	if err != nil {
+		var errNotApproved *probo.ErrDocumentVersionNotApproved
+		if errors.As(err, &errNotApproved) {
+			return nil, types.PublishDocumentVersionOutput{}, fmt.Errorf("cannot publish document version: %w", err)
+		}
+
 		panic(fmt.Errorf("cannot publish document version: %w", err))
 	}
 
@@ -0,0 +1,144 @@
+// Copyright (c) 2025 Probo Inc <hello@getprobo.com>.
+//
+// Permission to use, copy, modify, and/or distribute this software for any
+// purpose with or without fee is hereby granted, provided that the above
+// copyright notice and this permission notice appear in all copies.
+//
+// THE SOFTWARE IS PROVIDED "AS IS" AND THE AUTHOR DISCLAIMS ALL WARRANTIES WITH
+// REGARD TO THIS SOFTWARE INCLUDING ALL IMPLIED WARRANTIES OF MERCHANTABILITY
+// AND FITNESS. IN NO EVENT SHALL THE AUTHOR BE LIABLE FOR ANY SPECIAL, DIRECT,
+// INDIRECT, OR CONSEQUENTIAL DAMAGES OR ANY DAMAGES WHATSOEVER RESULTING FROM
+// LOSS OF USE, DATA OR PROFITS, WHETHER IN AN ACTION OF CONTRACT, NEGLIGENCE OR
+// OTHER TORTIOUS ACTION, ARISING OUT OF OR IN CONNECTION WITH THE USE OR
+// PERFORMANCE OF THIS SOFTWARE.
+
+// Package signaturechain links the signatures of a document version into
+// a hash chain so that altering, removing or reordering any of them can be
+// detected offline, from the entries alone.
+//
+// Each entry hash is the hexadecimal SHA-256 of the previous entry hash
+// followed by the JSON encoding of the entry. The first entry of a chain
+// uses GenesisHash as its previous hash.
+package signaturechain
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"time"
+)
+
+// GenesisHash is the previous hash of the first entry of a chain.
+var GenesisHash = hex.EncodeToString(make([]byte, sha256.Size))
+
+type (
+	Entry struct {
+		Sequence           int       `json:"sequence"`
+		SignatureID        string    `json:"signature_id"`
+		DocumentVersionID  string    `json:"document_version_id"`
+		ContentHash        string    `json:"content_hash"`
+		SignerFullName     string    `json:"signer_full_name"`
+		SignerEmailAddress string    `json:"signer_email_address"`
+		SignerIPAddress    string    `json:"signer_ip_address"`
+		SignerUserAgent    string    `json:"signer_user_agent"`
+		SignerAuthMethod   string    `json:"signer_auth_method"`
+		SignedAt           time.Time `json:"signed_at"`
+	}
+
+	// Link is an entry along with its position in the chain.
+	Link struct {
+		Entry        Entry
+		PreviousHash string
+		Hash         string
+	}
+
+	ErrBrokenChain struct {
+		Sequence int
+		Reason   string
+	}
+)
+
+func (e ErrBrokenChain) Error() string {
+	return fmt.Sprintf("signature chain broken at entry %d: %s", e.Sequence, e.Reason)
+}
+
+// ContentHash returns the hexadecimal SHA-256 of the rendered content a
+// signer was shown.
+func ContentHash(rendered []byte) string {
+	sum := sha256.Sum256(rendered)
+	return hex.EncodeToString(sum[:])
+}
+
+// Canonical returns the bytes hashed for the entry.
+func (e Entry) Canonical() ([]byte, error) {
+	e.SignedAt = e.SignedAt.UTC()
+
+	data, err := json.Marshal(e)
+	if err != nil {
+		return nil, fmt.Errorf("cannot marshal signature chain entry: %w", err)
+	}
+
+	return data, nil
+}
+
+// Hash returns the hash of the entry when appended after previousHash.
+func Hash(previousHash string, entry Entry) (string, error) {
+	data, err := entry.Canonical()
+	if err != nil {
+		return "", err
+	}
+
+	h := sha256.New()
+	_, _ = h.Write([]byte(previousHash))
+	_, _ = h.Write(data)
+
+	return hex.EncodeToString(h.Sum(nil)), nil
+}
+
+// Append returns the link of the entry appended after previous, which is
+// nil for the first entry of a chain.
+func Append(previous *Link, entry Entry) (*Link, error) {
+	previousHash := GenesisHash
+	entry.Sequence = 1
+	if previous != nil {
+		previousHash = previous.Hash
+		entry.Sequence = previous.Entry.Sequence + 1
+	}
+
+	hash, err := Hash(previousHash, entry)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Link{Entry: entry, PreviousHash: previousHash, Hash: hash}, nil
+}
+
+// Verify checks that the links, ordered by sequence, form an unbroken
+// chain starting from GenesisHash.
+func Verify(links []*Link) error {
+	previousHash := GenesisHash
+
+	for i, link := range links {
+		if link.Entry.Sequence != i+1 {
+			return ErrBrokenChain{Sequence: link.Entry.Sequence, Reason: fmt.Sprintf("expected sequence %d", i+1)}
+		}
+
+		if link.PreviousHash != previousHash {
+			return ErrBrokenChain{Sequence: link.Entry.Sequence, Reason: "previous hash mismatch"}
+		}
+
+		hash, err := Hash(previousHash, link.Entry)
+		if err != nil {
+			return err
+		}
+
+		if link.Hash != hash {
+			return ErrBrokenChain{Sequence: link.Entry.Sequence, Reason: "hash mismatch"}
+		}
+
+		previousHash = link.Hash
+	}
+
+	return nil
+}
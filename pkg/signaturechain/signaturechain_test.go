@@ -0,0 +1,125 @@
+// Copyright (c) 2025 Probo Inc <hello@getprobo.com>.
+//
+// Permission to use, copy, modify, and/or distribute this software for any
+// purpose with or without fee is hereby granted, provided that the above
+// copyright notice and this permission notice appear in all copies.
+//
+// THE SOFTWARE IS PROVIDED "AS IS" AND THE AUTHOR DISCLAIMS ALL WARRANTIES WITH
+// REGARD TO THIS SOFTWARE INCLUDING ALL IMPLIED WARRANTIES OF MERCHANTABILITY
+// AND FITNESS. IN NO EVENT SHALL THE AUTHOR BE LIABLE FOR ANY SPECIAL, DIRECT,
+// INDIRECT, OR CONSEQUENTIAL DAMAGES OR ANY DAMAGES WHATSOEVER RESULTING FROM
+// LOSS OF USE, DATA OR PROFITS, WHETHER IN AN ACTION OF CONTRACT, NEGLIGENCE OR
+// OTHER TORTIOUS ACTION, ARISING OUT OF OR IN CONNECTION WITH THE USE OR
+// PERFORMANCE OF THIS SOFTWARE.
+
+package signaturechain
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func testChain(t *testing.T) []*Link {
+	t.Helper()
+
+	signedAt := time.Date(2026, 2, 21, 10, 0, 0, 0, time.UTC)
+	contentHash := ContentHash([]byte("<h1>Security Policy</h1>"))
+
+	var links []*Link
+	var previous *Link
+	for _, name := range []string{"Alice", "Bob", "Carol"} {
+		link, err := Append(
+			previous,
+			Entry{
+				SignatureID:        "signature-" + name,
+				DocumentVersionID:  "version",
+				ContentHash:        contentHash,
+				SignerFullName:     name,
+				SignerEmailAddress: name + "@example.com",
+				SignerIPAddress:    "192.0.2.1",
+				SignerUserAgent:    "Mozilla/5.0",
+				SignerAuthMethod:   "PASSWORD",
+				SignedAt:           signedAt,
+			},
+		)
+		require.NoError(t, err)
+
+		links = append(links, link)
+		previous = link
+	}
+
+	return links
+}
+
+func TestContentHash(t *testing.T) {
+	assert.Equal(
+		t,
+		"e3b0c44298fc1c149afbf4c8996fb92427ae41e4649b934ca495991b7852b855",
+		ContentHash(nil),
+	)
+}
+
+func TestAppend(t *testing.T) {
+	links := testChain(t)
+
+	assert.Equal(t, GenesisHash, links[0].PreviousHash)
+	assert.Equal(t, 1, links[0].Entry.Sequence)
+	assert.Equal(t, links[0].Hash, links[1].PreviousHash)
+	assert.Equal(t, 3, links[2].Entry.Sequence)
+	assert.Len(t, links[2].Hash, 64)
+}
+
+func TestHashIgnoresTimezone(t *testing.T) {
+	entry := Entry{SignedAt: time.Date(2026, 2, 21, 10, 0, 0, 0, time.UTC)}
+
+	paris, err := time.LoadLocation("Europe/Paris")
+	require.NoError(t, err)
+
+	local := entry
+	local.SignedAt = entry.SignedAt.In(paris)
+
+	utcHash, err := Hash(GenesisHash, entry)
+	require.NoError(t, err)
+	localHash, err := Hash(GenesisHash, local)
+	require.NoError(t, err)
+
+	assert.Equal(t, utcHash, localHash)
+}
+
+func TestVerify(t *testing.T) {
+	t.Run("valid chain", func(t *testing.T) {
+		assert.NoError(t, Verify(testChain(t)))
+	})
+
+	t.Run("empty chain", func(t *testing.T) {
+		assert.NoError(t, Verify(nil))
+	})
+
+	t.Run("altered entry", func(t *testing.T) {
+		links := testChain(t)
+		links[1].Entry.ContentHash = ContentHash([]byte("<h1>Other Policy</h1>"))
+
+		var errBroken ErrBrokenChain
+		require.ErrorAs(t, Verify(links), &errBroken)
+		assert.Equal(t, 2, errBroken.Sequence)
+	})
+
+	t.Run("removed entry", func(t *testing.T) {
+		links := testChain(t)
+		links = append(links[:1], links[2:]...)
+
+		var errBroken ErrBrokenChain
+		require.ErrorAs(t, Verify(links), &errBroken)
+		assert.Equal(t, 3, errBroken.Sequence)
+	})
+
+	t.Run("reordered entries", func(t *testing.T) {
+		links := testChain(t)
+		links[0], links[1] = links[1], links[0]
+
+		assert.Error(t, Verify(links))
+	})
+}
@@ -0,0 +1,366 @@
+// Copyright (c) 2025 Probo Inc <hello@getprobo.com>.
+//
+// Permission to use, copy, modify, and/or distribute this software for any
+// purpose with or without fee is hereby granted, provided that the above
+// copyright notice and this permission notice appear in all copies.
+//
+// THE SOFTWARE IS PROVIDED "AS IS" AND THE AUTHOR DISCLAIMS ALL WARRANTIES WITH
+// REGARD TO THIS SOFTWARE INCLUDING ALL IMPLIED WARRANTIES OF MERCHANTABILITY
+// AND FITNESS. IN NO EVENT SHALL THE AUTHOR BE LIABLE FOR ANY SPECIAL, DIRECT,
+// INDIRECT, OR CONSEQUENTIAL DAMAGES OR ANY DAMAGES WHATSOEVER RESULTING FROM
+// LOSS OF USE, DATA OR PROFITS, WHETHER IN AN ACTION OF CONTRACT, NEGLIGENCE OR
+// OTHER TORTIOUS ACTION, ARISING OUT OF OR IN CONNECTION WITH THE USE OR
+// PERFORMANCE OF THIS SOFTWARE.
+
+// Package spreadsheet reads the rows of CSV files and of the first
+// worksheet of XLSX workbooks as text.
+package spreadsheet
+
+import (
+	"archive/zip"
+	"bytes"
+	"encoding/csv"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"path"
+	"strconv"
+	"strings"
+)
+
+type (
+	// Row is a non empty row of a spreadsheet. Number is the one based
+	// position of the row in the file, so that it can be reported back
+	// to the people editing it.
+	Row struct {
+		Number int
+		Cells  []string
+	}
+
+	xlsxWorkbook struct {
+		Sheets []struct {
+			RelationshipID string `xml:"http://schemas.openxmlformats.org/officeDocument/2006/relationships id,attr"`
+		} `xml:"sheets>sheet"`
+	}
+
+	xlsxRelationships struct {
+		Relationships []struct {
+			ID     string `xml:"Id,attr"`
+			Target string `xml:"Target,attr"`
+		} `xml:"Relationship"`
+	}
+
+	xlsxSharedStrings struct {
+		Items []xlsxText `xml:"si"`
+	}
+
+	xlsxText struct {
+		Text string `xml:"t"`
+		Runs []struct {
+			Text string `xml:"t"`
+		} `xml:"r"`
+	}
+
+	xlsxRow struct {
+		Number int `xml:"r,attr"`
+		Cells  []struct {
+			Reference string   `xml:"r,attr"`
+			Type      string   `xml:"t,attr"`
+			Value     string   `xml:"v"`
+			Inline    xlsxText `xml:"is"`
+		} `xml:"c"`
+	}
+)
+
+const (
+	// MaxColumns is the number of columns of an XLSX worksheet, the last
+	// one being XFD. Wider rows are rejected instead of being allocated.
+	MaxColumns = 16384
+
+	// maxXLSXPartSize caps the uncompressed size of every XLSX part read,
+	// so a small zip bomb cannot exhaust memory.
+	maxXLSXPartSize = 64 << 20
+)
+
+var (
+	ErrUnsupportedFormat = errors.New("unsupported spreadsheet format")
+	ErrTooManyRows       = errors.New("too many spreadsheet rows")
+
+	zipSignature = []byte("PK\x03\x04")
+	utf8BOM      = []byte("\xef\xbb\xbf")
+)
+
+// Read returns the non empty rows of a CSV file or of the first worksheet
+// of an XLSX workbook, detected from the content. Reading stops with
+// ErrTooManyRows as soon as more than maxRows non empty rows are found.
+func Read(r io.Reader, maxRows int) ([]Row, error) {
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return nil, fmt.Errorf("cannot read spreadsheet: %w", err)
+	}
+
+	if bytes.HasPrefix(data, zipSignature) {
+		return ReadXLSX(data, maxRows)
+	}
+
+	return ReadCSV(data, maxRows)
+}
+
+// ReadCSV returns the non empty rows of a CSV file. Fields are separated
+// by commas, or by semicolons when the first line has semicolons but no
+// commas as exported by some spreadsheet locales.
+func ReadCSV(data []byte, maxRows int) ([]Row, error) {
+	data = bytes.TrimPrefix(data, utf8BOM)
+
+	reader := csv.NewReader(bytes.NewReader(data))
+	reader.FieldsPerRecord = -1
+	reader.LazyQuotes = true
+
+	firstLine, _, _ := bytes.Cut(data, []byte("\n"))
+	if bytes.Contains(firstLine, []byte(";")) && !bytes.Contains(firstLine, []byte(",")) {
+		reader.Comma = ';'
+	}
+
+	var rows []Row
+	for {
+		record, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("cannot parse csv: %w", err)
+		}
+
+		line, _ := reader.FieldPos(0)
+		if len(record) > MaxColumns {
+			return nil, fmt.Errorf("%w: line %d has more than %d columns", ErrUnsupportedFormat, line, MaxColumns)
+		}
+
+		rows = appendRow(rows, line, record)
+		if len(rows) > maxRows {
+			return nil, fmt.Errorf("%w: more than %d rows", ErrTooManyRows, maxRows)
+		}
+	}
+
+	return rows, nil
+}
+
+// ReadXLSX returns the non empty rows of the first worksheet of an XLSX
+// workbook. Cells are returned as stored: numbers and dates are not
+// formatted.
+func ReadXLSX(data []byte, maxRows int) ([]Row, error) {
+	archive, err := zip.NewReader(bytes.NewReader(data), int64(len(data)))
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrUnsupportedFormat, err)
+	}
+
+	files := make(map[string]*zip.File, len(archive.File))
+	for _, f := range archive.File {
+		files[f.Name] = f
+	}
+
+	var workbook xlsxWorkbook
+	if err := decodeXLSXPart(files, "xl/workbook.xml", &workbook); err != nil {
+		return nil, err
+	}
+	if len(workbook.Sheets) == 0 {
+		return nil, fmt.Errorf("%w: workbook has no worksheet", ErrUnsupportedFormat)
+	}
+
+	var relationships xlsxRelationships
+	if err := decodeXLSXPart(files, "xl/_rels/workbook.xml.rels", &relationships); err != nil {
+		return nil, err
+	}
+
+	sheetPath := ""
+	for _, rel := range relationships.Relationships {
+		if rel.ID == workbook.Sheets[0].RelationshipID {
+			if strings.HasPrefix(rel.Target, "/") {
+				sheetPath = strings.TrimPrefix(rel.Target, "/")
+			} else {
+				sheetPath = path.Join("xl", rel.Target)
+			}
+		}
+	}
+	if sheetPath == "" {
+		return nil, fmt.Errorf("%w: cannot find first worksheet", ErrUnsupportedFormat)
+	}
+
+	var sharedStrings xlsxSharedStrings
+	if _, ok := files["xl/sharedStrings.xml"]; ok {
+		if err := decodeXLSXPart(files, "xl/sharedStrings.xml", &sharedStrings); err != nil {
+			return nil, err
+		}
+	}
+
+	sheet, err := openXLSXPart(files, sheetPath)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = sheet.Close() }()
+
+	var (
+		rows    []Row
+		decoder = xml.NewDecoder(sheet)
+	)
+	for i := 0; ; {
+		token, err := decoder.Token()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("%w: cannot decode %s: %w", ErrUnsupportedFormat, sheetPath, err)
+		}
+
+		start, ok := token.(xml.StartElement)
+		if !ok || start.Name.Local != "row" {
+			continue
+		}
+
+		var row xlsxRow
+		if err := decoder.DecodeElement(&row, &start); err != nil {
+			return nil, fmt.Errorf("%w: cannot decode %s: %w", ErrUnsupportedFormat, sheetPath, err)
+		}
+
+		i++
+		number := row.Number
+		if number == 0 {
+			number = i
+		}
+
+		if len(row.Cells) > MaxColumns {
+			return nil, fmt.Errorf("%w: row %d has more than %d columns", ErrUnsupportedFormat, number, MaxColumns)
+		}
+
+		var cells []string
+		for j, cell := range row.Cells {
+			column := j
+			if cell.Reference != "" {
+				column, err = columnIndex(cell.Reference)
+				if err != nil {
+					return nil, err
+				}
+			}
+
+			var value string
+			switch cell.Type {
+			case "s":
+				index, err := strconv.Atoi(cell.Value)
+				if err != nil || index < 0 || index >= len(sharedStrings.Items) {
+					return nil, fmt.Errorf("%w: invalid shared string %q in cell %s", ErrUnsupportedFormat, cell.Value, cell.Reference)
+				}
+				value = sharedStrings.Items[index].String()
+			case "inlineStr":
+				value = cell.Inline.String()
+			case "b":
+				value = "FALSE"
+				if cell.Value == "1" {
+					value = "TRUE"
+				}
+			default:
+				value = cell.Value
+			}
+
+			for len(cells) <= column {
+				cells = append(cells, "")
+			}
+			cells[column] = value
+		}
+
+		rows = appendRow(rows, number, cells)
+		if len(rows) > maxRows {
+			return nil, fmt.Errorf("%w: more than %d rows", ErrTooManyRows, maxRows)
+		}
+	}
+
+	return rows, nil
+}
+
+func (t xlsxText) String() string {
+	if len(t.Runs) == 0 {
+		return t.Text
+	}
+
+	var b strings.Builder
+	for _, run := range t.Runs {
+		b.WriteString(run.Text)
+	}
+
+	return b.String()
+}
+
+// openXLSXPart opens a part of the archive for reading at most
+// maxXLSXPartSize uncompressed bytes, whatever its header claims.
+func openXLSXPart(files map[string]*zip.File, name string) (io.ReadCloser, error) {
+	f, ok := files[name]
+	if !ok {
+		return nil, fmt.Errorf("%w: missing %s", ErrUnsupportedFormat, name)
+	}
+
+	if f.UncompressedSize64 > maxXLSXPartSize {
+		return nil, fmt.Errorf("%w: %s is larger than %d bytes", ErrUnsupportedFormat, name, maxXLSXPartSize)
+	}
+
+	rc, err := f.Open()
+	if err != nil {
+		return nil, fmt.Errorf("cannot open %s: %w", name, err)
+	}
+
+	return struct {
+		io.Reader
+		io.Closer
+	}{io.LimitReader(rc, maxXLSXPartSize), rc}, nil
+}
+
+func decodeXLSXPart(files map[string]*zip.File, name string, v any) error {
+	rc, err := openXLSXPart(files, name)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = rc.Close() }()
+
+	if err := xml.NewDecoder(rc).Decode(v); err != nil {
+		return fmt.Errorf("%w: cannot decode %s: %w", ErrUnsupportedFormat, name, err)
+	}
+
+	return nil
+}
+
+// columnIndex returns the zero based column of a cell reference such as
+// "AB12". References past the last worksheet column XFD are rejected.
+func columnIndex(reference string) (int, error) {
+	column := 0
+	for i, r := range reference {
+		if r >= 'A' && r <= 'Z' {
+			column = column*26 + int(r-'A') + 1
+			if column > MaxColumns {
+				break
+			}
+
+			continue
+		}
+
+		if i == 0 {
+			break
+		}
+
+		return column - 1, nil
+	}
+
+	return 0, fmt.Errorf("%w: invalid cell reference %q", ErrUnsupportedFormat, reference)
+}
+
+func appendRow(rows []Row, number int, cells []string) []Row {
+	for i := range cells {
+		cells[i] = strings.TrimSpace(cells[i])
+	}
+
+	for _, cell := range cells {
+		if cell != "" {
+			return append(rows, Row{Number: number, Cells: cells})
+		}
+	}
+
+	return rows
+}
@@ -0,0 +1,165 @@
+// Copyright (c) 2025 Probo Inc <hello@getprobo.com>.
+//
+// Permission to use, copy, modify, and/or distribute this software for any
+// purpose with or without fee is hereby granted, provided that the above
+// copyright notice and this permission notice appear in all copies.
+//
+// THE SOFTWARE IS PROVIDED "AS IS" AND THE AUTHOR DISCLAIMS ALL WARRANTIES WITH
+// REGARD TO THIS SOFTWARE INCLUDING ALL IMPLIED WARRANTIES OF MERCHANTABILITY
+// AND FITNESS. IN NO EVENT SHALL THE AUTHOR BE LIABLE FOR ANY SPECIAL, DIRECT,
+// INDIRECT, OR CONSEQUENTIAL DAMAGES OR ANY DAMAGES WHATSOEVER RESULTING FROM
+// LOSS OF USE, DATA OR PROFITS, WHETHER IN AN ACTION OF CONTRACT, NEGLIGENCE OR
+// OTHER TORTIOUS ACTION, ARISING OUT OF OR IN CONNECTION WITH THE USE OR
+// PERFORMANCE OF THIS SOFTWARE.
+
+package spreadsheet
+
+import (
+	"archive/zip"
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func testXLSX(t *testing.T, parts map[string]string) []byte {
+	t.Helper()
+
+	var buf bytes.Buffer
+	w := zip.NewWriter(&buf)
+	for name, content := range parts {
+		f, err := w.Create(name)
+		require.NoError(t, err)
+		_, err = f.Write([]byte(content))
+		require.NoError(t, err)
+	}
+	require.NoError(t, w.Close())
+
+	return buf.Bytes()
+}
+
+func TestReadCSV(t *testing.T) {
+	t.Run("comma separated", func(t *testing.T) {
+		rows, err := Read(strings.NewReader("\xef\xbb\xbfName,Owner\n\"Data leak, customer\", alice@example.com \n,\n\nPhishing,bob@example.com\n"), 100)
+		require.NoError(t, err)
+
+		assert.Equal(
+			t,
+			[]Row{
+				{Number: 1, Cells: []string{"Name", "Owner"}},
+				{Number: 2, Cells: []string{"Data leak, customer", "alice@example.com"}},
+				{Number: 5, Cells: []string{"Phishing", "bob@example.com"}},
+			},
+			rows,
+		)
+	})
+
+	t.Run("semicolon separated", func(t *testing.T) {
+		rows, err := Read(strings.NewReader("Name;Amount\nLaptop;3\n"), 100)
+		require.NoError(t, err)
+
+		assert.Equal(
+			t,
+			[]Row{
+				{Number: 1, Cells: []string{"Name", "Amount"}},
+				{Number: 2, Cells: []string{"Laptop", "3"}},
+			},
+			rows,
+		)
+	})
+}
+
+func TestReadXLSX(t *testing.T) {
+	data := testXLSX(
+		t,
+		map[string]string{
+			"xl/workbook.xml": `<?xml version="1.0" encoding="UTF-8"?>
+<workbook xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main" xmlns:r="http://schemas.openxmlformats.org/officeDocument/2006/relationships">
+  <sheets>
+    <sheet name="Risks" sheetId="1" r:id="rId2"/>
+    <sheet name="Other" sheetId="2" r:id="rId1"/>
+  </sheets>
+</workbook>`,
+			"xl/_rels/workbook.xml.rels": `<?xml version="1.0" encoding="UTF-8"?>
+<Relationships xmlns="http://schemas.openxmlformats.org/package/2006/relationships">
+  <Relationship Id="rId1" Target="worksheets/sheet2.xml"/>
+  <Relationship Id="rId2" Target="worksheets/sheet1.xml"/>
+</Relationships>`,
+			"xl/sharedStrings.xml": `<?xml version="1.0" encoding="UTF-8"?>
+<sst xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main">
+  <si><t>Name</t></si>
+  <si><t>Likelihood</t></si>
+  <si><r><t>Data </t></r><r><t>leak</t></r></si>
+</sst>`,
+			"xl/worksheets/sheet1.xml": `<?xml version="1.0" encoding="UTF-8"?>
+<worksheet xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main">
+  <sheetData>
+    <row r="1"><c r="A1" t="s"><v>0</v></c><c r="B1" t="s"><v>1</v></c><c r="C1" t="inlineStr"><is><t>Accepted</t></is></c></row>
+    <row r="3"><c r="A3" t="s"><v>2</v></c><c r="C3" t="b"><v>1</v></c></row>
+    <row r="4"><c r="B4"><v>4</v></c></row>
+  </sheetData>
+</worksheet>`,
+			"xl/worksheets/sheet2.xml": `<?xml version="1.0" encoding="UTF-8"?>
+<worksheet xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main"><sheetData/></worksheet>`,
+		},
+	)
+
+	rows, err := Read(bytes.NewReader(data), 100)
+	require.NoError(t, err)
+
+	assert.Equal(
+		t,
+		[]Row{
+			{Number: 1, Cells: []string{"Name", "Likelihood", "Accepted"}},
+			{Number: 3, Cells: []string{"Data leak", "", "TRUE"}},
+			{Number: 4, Cells: []string{"", "4"}},
+		},
+		rows,
+	)
+}
+
+func TestReadXLSXInvalid(t *testing.T) {
+	_, err := Read(bytes.NewReader(testXLSX(t, map[string]string{"foo.txt": "bar"})), 100)
+	assert.ErrorIs(t, err, ErrUnsupportedFormat)
+}
+
+func TestColumnIndex(t *testing.T) {
+	for reference, expected := range map[string]int{"A1": 0, "Z9": 25, "AA10": 26, "AB3": 27, "XFD1": 16383} {
+		column, err := columnIndex(reference)
+		require.NoError(t, err)
+		assert.Equal(t, expected, column, reference)
+	}
+
+	for _, reference := range []string{"12", "XFE1", "ZZZZZZ1", strings.Repeat("Z", 20) + "1"} {
+		_, err := columnIndex(reference)
+		assert.ErrorIs(t, err, ErrUnsupportedFormat, reference)
+	}
+}
+
+func TestReadTooManyRows(t *testing.T) {
+	_, err := Read(strings.NewReader("Name\nfoo\nbar\n"), 2)
+	assert.ErrorIs(t, err, ErrTooManyRows)
+
+	rows, err := Read(strings.NewReader("Name\nfoo\n\n"), 2)
+	require.NoError(t, err)
+	assert.Len(t, rows, 2)
+}
+
+func TestReadXLSXPartTooLarge(t *testing.T) {
+	data := testXLSX(
+		t,
+		map[string]string{
+			"xl/workbook.xml": "<workbook>" + strings.Repeat(" ", maxXLSXPartSize) + "</workbook>",
+		},
+	)
+
+	_, err := Read(bytes.NewReader(data), 100)
+	assert.ErrorIs(t, err, ErrUnsupportedFormat)
+}
+
+func TestReadTooManyColumns(t *testing.T) {
+	_, err := Read(strings.NewReader(strings.Repeat(",", MaxColumns)+"\n"), 100)
+	assert.ErrorIs(t, err, ErrUnsupportedFormat)
+}
@@ -29,6 +29,8 @@ import (
 	"go.probo.inc/probo/pkg/iam"
 	"go.probo.inc/probo/pkg/mail"
 	"go.probo.inc/probo/pkg/validator"
+	"go.probo.inc/probo/pkg/webhook"
+	webhooktypes "go.probo.inc/probo/pkg/webhook/types"
 )
 
 type (
@@ -210,6 +212,10 @@ func (s TrustCenterAccessService) Request(
 				return fmt.Errorf("cannot bulk insert trust center file accesses: %w", err)
 			}
 
+			if err := webhook.InsertData(ctx, tx, s.svc.scope, access.OrganizationID, coredata.WebhookEventTypeTrustCenterAccessRequested, webhooktypes.NewTrustCenterAccess(access)); err != nil {
+				return fmt.Errorf("cannot insert webhook event: %w", err)
+			}
+
 			return nil
 		},
 	)
@@ -497,6 +503,7 @@ func (s *TrustCenterAccessService) sendAccessEmail(ctx context.Context, tx pg.Co
 		textBody,
 		htmlBody,
 	)
+	accessEmail.OrganizationID = &organization.ID
 
 	if err := accessEmail.Insert(ctx, tx); err != nil {
 		return fmt.Errorf("cannot insert access email: %w", err)
@@ -624,6 +631,7 @@ func (s *TrustCenterAccessService) sendDocumentAccessRejectedEmail(
 		textBody,
 		htmlBody,
 	)
+	accessEmail.OrganizationID = &organization.ID
 
 	if err := accessEmail.Insert(ctx, tx); err != nil {
 		return fmt.Errorf("cannot insert access email: %w", err)
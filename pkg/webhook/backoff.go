@@ -0,0 +1,62 @@
+// Copyright (c) 2025 Probo Inc <hello@getprobo.com>.
+//
+// Permission to use, copy, modify, and/or distribute this software for any
+// purpose with or without fee is hereby granted, provided that the above
+// copyright notice and this permission notice appear in all copies.
+//
+// THE SOFTWARE IS PROVIDED "AS IS" AND THE AUTHOR DISCLAIMS ALL WARRANTIES WITH
+// REGARD TO THIS SOFTWARE INCLUDING ALL IMPLIED WARRANTIES OF MERCHANTABILITY
+// AND FITNESS. IN NO EVENT SHALL THE AUTHOR BE LIABLE FOR ANY SPECIAL, DIRECT,
+// INDIRECT, OR CONSEQUENTIAL DAMAGES OR ANY DAMAGES WHATSOEVER RESULTING FROM
+// LOSS OF USE, DATA OR PROFITS, WHETHER IN AN ACTION OF CONTRACT, NEGLIGENCE OR
+// OTHER TORTIOUS ACTION, ARISING OUT OF OR IN CONNECTION WITH THE USE OR
+// PERFORMANCE OF THIS SOFTWARE.
+
+package webhook
+
+import (
+	"math/rand/v2"
+	"time"
+)
+
+const (
+	// DefaultMaxAttempts is the number of delivery attempts made for an
+	// event before it is moved to the dead-letter state.
+	DefaultMaxAttempts = 8
+
+	// DefaultRetryBaseDelay is the delay before the first retry. Each
+	// following retry doubles it.
+	DefaultRetryBaseDelay = 30 * time.Second
+
+	// DefaultRetryMaxDelay caps the delay between two attempts.
+	DefaultRetryMaxDelay = 6 * time.Hour
+)
+
+// retryDelay returns the delay to wait before the next attempt once
+// attempts deliveries have failed. The delay grows exponentially from
+// baseDelay and is capped at maxDelay; the upper half of the window is jittered so
+// that events failing together do not retry in lockstep.
+func retryDelay(attempts int, baseDelay, maxDelay time.Duration) time.Duration {
+	if attempts <= 0 {
+		return 0
+	}
+
+	// Cap the shift exponent to prevent integer overflow from the shift itself.
+	const maxShift = 62
+	shiftAmount := min(attempts-1, maxShift)
+
+	delay := baseDelay * time.Duration(1<<shiftAmount)
+
+	// Detect multiplication overflow: if result is non-positive or less than
+	// the base delay, overflow occurred.
+	if delay <= 0 || delay < baseDelay || delay > maxDelay {
+		delay = maxDelay
+	}
+
+	half := delay / 2
+	if half <= 0 {
+		return delay
+	}
+
+	return half + rand.N(half)
+}
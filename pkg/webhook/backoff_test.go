@@ -0,0 +1,55 @@
+// Copyright (c) 2025 Probo Inc <hello@getprobo.com>.
+//
+// Permission to use, copy, modify, and/or distribute this software for any
+// purpose with or without fee is hereby granted, provided that the above
+// copyright notice and this permission notice appear in all copies.
+//
+// THE SOFTWARE IS PROVIDED "AS IS" AND THE AUTHOR DISCLAIMS ALL WARRANTIES WITH
+// REGARD TO THIS SOFTWARE INCLUDING ALL IMPLIED WARRANTIES OF MERCHANTABILITY
+// AND FITNESS. IN NO EVENT SHALL THE AUTHOR BE LIABLE FOR ANY SPECIAL, DIRECT,
+// INDIRECT, OR CONSEQUENTIAL DAMAGES OR ANY DAMAGES WHATSOEVER RESULTING FROM
+// LOSS OF USE, DATA OR PROFITS, WHETHER IN AN ACTION OF CONTRACT, NEGLIGENCE OR
+// OTHER TORTIOUS ACTION, ARISING OUT OF OR IN CONNECTION WITH THE USE OR
+// PERFORMANCE OF THIS SOFTWARE.
+
+package webhook
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRetryDelay(t *testing.T) {
+	t.Parallel()
+
+	base := 30 * time.Second
+	maxDelay := 6 * time.Hour
+
+	tests := []struct {
+		name     string
+		attempts int
+		lower    time.Duration
+		upper    time.Duration
+	}{
+		{name: "no attempt", attempts: 0, lower: 0, upper: 0},
+		{name: "first retry", attempts: 1, lower: 15 * time.Second, upper: 30 * time.Second},
+		{name: "second retry", attempts: 2, lower: 30 * time.Second, upper: time.Minute},
+		{name: "fifth retry", attempts: 5, lower: 4 * time.Minute, upper: 8 * time.Minute},
+		{name: "capped", attempts: 20, lower: 3 * time.Hour, upper: 6 * time.Hour},
+		{name: "overflow", attempts: 200, lower: 3 * time.Hour, upper: 6 * time.Hour},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			for range 100 {
+				delay := retryDelay(tt.attempts, base, maxDelay)
+				assert.GreaterOrEqual(t, delay, tt.lower)
+				assert.LessOrEqual(t, delay, tt.upper)
+			}
+		})
+	}
+}
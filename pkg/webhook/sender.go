@@ -48,6 +48,9 @@ type (
 		cacheTTL       time.Duration
 		interval       time.Duration
 		timeout        time.Duration
+		maxAttempts    int
+		retryBaseDelay time.Duration
+		retryMaxDelay  time.Duration
 	}
 
 	cachedSecret struct {
@@ -55,20 +58,32 @@ type (
 		plaintext       string
 	}
 
-	Config struct {
-		Interval      time.Duration
-		Timeout       time.Duration
-		CacheTTL      time.Duration
-		EncryptionKey cipher.EncryptionKey
+	// TestDelivery is the outcome of a test event sent to a subscription
+	// endpoint.
+	TestDelivery struct {
+		Succeeded bool
+		Response  json.RawMessage
+		Error     string
 	}
 
-	pendingDelivery struct {
-		Event  *coredata.WebhookEvent
-		Config *coredata.WebhookSubscription
+	Config struct {
+		Interval       time.Duration
+		Timeout        time.Duration
+		CacheTTL       time.Duration
+		EncryptionKey  cipher.EncryptionKey
+		MaxAttempts    int
+		RetryBaseDelay time.Duration
+		RetryMaxDelay  time.Duration
 	}
 )
 
-const maxResponseBodySize = 64 * 1024 // 64KB
+const (
+	maxResponseBodySize = 64 * 1024 // 64KB
+
+	// TestEventType is only ever sent by SendTestEvent. Test events are
+	// not persisted, so it is not part of the subscribable catalog.
+	TestEventType coredata.WebhookEventType = "webhook:test"
+)
 
 func NewSender(pg *pg.Client, logger *log.Logger, cfg Config) *Sender {
 	if cfg.Interval <= 0 {
@@ -83,6 +98,18 @@ func NewSender(pg *pg.Client, logger *log.Logger, cfg Config) *Sender {
 		cfg.CacheTTL = 24 * time.Hour
 	}
 
+	if cfg.MaxAttempts <= 0 {
+		cfg.MaxAttempts = DefaultMaxAttempts
+	}
+
+	if cfg.RetryBaseDelay <= 0 {
+		cfg.RetryBaseDelay = DefaultRetryBaseDelay
+	}
+
+	if cfg.RetryMaxDelay <= 0 {
+		cfg.RetryMaxDelay = DefaultRetryMaxDelay
+	}
+
 	return &Sender{
 		pg:             pg,
 		logger:         logger,
@@ -92,6 +119,9 @@ func NewSender(pg *pg.Client, logger *log.Logger, cfg Config) *Sender {
 		cacheTTL:       cfg.CacheTTL,
 		interval:       cfg.Interval,
 		timeout:        cfg.Timeout,
+		maxAttempts:    cfg.MaxAttempts,
+		retryBaseDelay: cfg.RetryBaseDelay,
+		retryMaxDelay:  cfg.RetryMaxDelay,
 	}
 }
 
@@ -115,23 +145,33 @@ func (s *Sender) processEvents(ctx context.Context) error {
 	}
 
 	for {
-		webhookData, deliveries, err := s.claimNextWebhookData(ctx)
+		if err := s.fanOutNextWebhookData(ctx); err != nil {
+			if errors.Is(err, coredata.ErrResourceNotFound) {
+				break
+			}
+			return fmt.Errorf("cannot fan out next webhook data: %w", err)
+		}
+	}
+
+	for {
+		event, subscription, webhookData, err := s.claimNextDueEvent(ctx)
 		if err != nil {
 			if errors.Is(err, coredata.ErrResourceNotFound) {
 				return nil
 			}
-			return fmt.Errorf("cannot claim next webhook data: %w", err)
+			return fmt.Errorf("cannot claim next due webhook event: %w", err)
 		}
 
-		s.processDeliveries(ctx, webhookData, deliveries)
+		s.deliver(ctx, event, subscription, webhookData)
 	}
 }
 
-func (s *Sender) claimNextWebhookData(ctx context.Context) (*coredata.WebhookData, []pendingDelivery, error) {
-	var webhookData coredata.WebhookData
-	var deliveries []pendingDelivery
-
-	err := s.pg.WithTx(ctx, func(tx pg.Conn) error {
+// fanOutNextWebhookData creates one pending event per subscription matching
+// the next unprocessed webhook data. Events are delivered later on by
+// claimNextDueEvent so first deliveries and retries share the same path.
+func (s *Sender) fanOutNextWebhookData(ctx context.Context) error {
+	return s.pg.WithTx(ctx, func(tx pg.Conn) error {
+		var webhookData coredata.WebhookData
 		if err := webhookData.LoadNextUnprocessedForUpdate(ctx, tx); err != nil {
 			return fmt.Errorf("cannot load next unprocessed webhook data: %w", err)
 		}
@@ -157,17 +197,14 @@ func (s *Sender) claimNextWebhookData(ctx context.Context) (*coredata.WebhookDat
 				WebhookDataID:         webhookData.ID,
 				WebhookSubscriptionID: config.ID,
 				Status:                coredata.WebhookEventStatusPending,
+				Attempts:              0,
+				NextAttemptAt:         &now,
 				CreatedAt:             now,
 			}
 
 			if err := event.Insert(ctx, tx, scope); err != nil {
 				return fmt.Errorf("cannot insert webhook event: %w", err)
 			}
-
-			deliveries = append(deliveries, pendingDelivery{
-				Event:  event,
-				Config: config,
-			})
 		}
 
 		webhookData.ProcessedAt = &now
@@ -177,77 +214,191 @@ func (s *Sender) claimNextWebhookData(ctx context.Context) (*coredata.WebhookDat
 
 		return nil
 	})
+}
 
-	if err != nil {
-		return nil, nil, err
-	}
+// claimNextDueEvent locks the next due event and leases it to this sender
+// by pushing its next attempt past the HTTP timeout, so that a concurrent
+// sender does not deliver it twice while the request is in flight.
+func (s *Sender) claimNextDueEvent(ctx context.Context) (
+	*coredata.WebhookEvent,
+	*coredata.WebhookSubscription,
+	*coredata.WebhookData,
+	error,
+) {
+	var (
+		event        coredata.WebhookEvent
+		subscription coredata.WebhookSubscription
+		webhookData  coredata.WebhookData
+	)
 
-	return &webhookData, deliveries, nil
-}
+	err := s.pg.WithTx(ctx, func(tx pg.Conn) error {
+		now := time.Now()
+
+		if err := event.LoadNextDueForUpdate(ctx, tx, now); err != nil {
+			return fmt.Errorf("cannot load next due webhook event: %w", err)
+		}
+
+		scope := coredata.NewScopeFromObjectID(event.ID)
+
+		if err := subscription.LoadByID(ctx, tx, scope, event.WebhookSubscriptionID); err != nil {
+			return fmt.Errorf("cannot load webhook subscription: %w", err)
+		}
 
-func (s *Sender) processDeliveries(ctx context.Context, webhookData *coredata.WebhookData, deliveries []pendingDelivery) {
-	for _, d := range deliveries {
-		s.deliver(ctx, webhookData, d)
+		if err := webhookData.LoadByID(ctx, tx, scope, event.WebhookDataID); err != nil {
+			return fmt.Errorf("cannot load webhook data: %w", err)
+		}
+
+		leaseUntil := now.Add(2 * s.timeout)
+		event.Attempts++
+		event.LastAttemptedAt = &now
+		event.NextAttemptAt = &leaseUntil
+
+		if err := event.Update(ctx, tx, scope); err != nil {
+			return fmt.Errorf("cannot lease webhook event: %w", err)
+		}
+
+		return nil
+	})
+
+	if err != nil {
+		return nil, nil, nil, err
 	}
-}
 
-func (s *Sender) deliver(ctx context.Context, webhookData *coredata.WebhookData, d pendingDelivery) {
-	scope := coredata.NewScopeFromObjectID(d.Event.ID)
+	return &event, &subscription, &webhookData, nil
+}
 
-	signingSecret, err := s.getSigningSecret(d.Config.ID.String(), d.Config.EncryptedSigningSecret)
+func (s *Sender) deliver(
+	ctx context.Context,
+	event *coredata.WebhookEvent,
+	subscription *coredata.WebhookSubscription,
+	webhookData *coredata.WebhookData,
+) {
+	signingSecret, err := s.getSigningSecret(subscription.ID.String(), subscription.EncryptedSigningSecret)
 	if err != nil {
 		s.logger.ErrorCtx(
 			ctx,
 			"cannot get signing secret",
 			log.Error(err),
 			log.String("webhook_data_id", webhookData.ID.String()),
-			log.String("subscription_id", d.Config.ID.String()),
+			log.String("subscription_id", subscription.ID.String()),
 		)
-		s.updateEventStatus(ctx, d.Event, scope, coredata.WebhookEventStatusFailed, nil)
+		s.recordFailure(ctx, event, nil)
 		return
 	}
 
-	response, sendErr := s.doHTTPCall(ctx, d.Event.ID, d.Config.EndpointURL, webhookData, d.Config.ID, signingSecret)
-
-	eventStatus := coredata.WebhookEventStatusSucceeded
+	response, sendErr := s.doHTTPCall(ctx, event.ID, subscription.EndpointURL, webhookData, subscription.ID, signingSecret)
 	if sendErr != nil {
-		eventStatus = coredata.WebhookEventStatusFailed
 		s.logger.ErrorCtx(
 			ctx,
 			"error delivering webhook",
 			log.Error(sendErr),
 			log.String("webhook_data_id", webhookData.ID.String()),
-			log.String("event_id", d.Event.ID.String()),
+			log.String("event_id", event.ID.String()),
+			log.Int("attempts", event.Attempts),
 		)
+		s.recordFailure(ctx, event, response)
+		return
 	}
 
-	s.updateEventStatus(ctx, d.Event, scope, eventStatus, response)
+	event.Status = coredata.WebhookEventStatusSucceeded
+	event.Response = response
+	event.NextAttemptAt = nil
+
+	s.updateEvent(ctx, event)
 }
 
-func (s *Sender) updateEventStatus(
-	ctx context.Context,
-	event *coredata.WebhookEvent,
-	scope coredata.Scoper,
-	status coredata.WebhookEventStatus,
-	response json.RawMessage,
-) {
-	event.Status = status
+// recordFailure schedules the next attempt of a failed event, or moves it
+// to the dead-letter state once all attempts have been used.
+func (s *Sender) recordFailure(ctx context.Context, event *coredata.WebhookEvent, response json.RawMessage) {
 	event.Response = response
 
+	if event.Attempts >= s.maxAttempts {
+		event.Status = coredata.WebhookEventStatusDeadLetter
+		event.NextAttemptAt = nil
+
+		s.logger.WarnCtx(
+			ctx,
+			"webhook event moved to dead letter",
+			log.String("event_id", event.ID.String()),
+			log.Int("attempts", event.Attempts),
+		)
+	} else {
+		nextAttemptAt := time.Now().Add(retryDelay(event.Attempts, s.retryBaseDelay, s.retryMaxDelay))
+		event.Status = coredata.WebhookEventStatusFailed
+		event.NextAttemptAt = &nextAttemptAt
+	}
+
+	s.updateEvent(ctx, event)
+}
+
+func (s *Sender) updateEvent(ctx context.Context, event *coredata.WebhookEvent) {
+	scope := coredata.NewScopeFromObjectID(event.ID)
+
 	err := s.pg.WithConn(ctx, func(conn pg.Conn) error {
-		return event.UpdateStatus(ctx, conn, scope)
+		return event.Update(ctx, conn, scope)
 	})
 	if err != nil {
 		s.logger.ErrorCtx(
 			ctx,
-			"cannot update webhook event status",
+			"cannot update webhook event",
 			log.Error(err),
 			log.String("event_id", event.ID.String()),
-			log.String("target_status", status.String()),
+			log.String("target_status", event.Status.String()),
 		)
 	}
 }
 
+// SendTestEvent signs and posts a synthetic event to the subscription
+// endpoint through the regular delivery path and returns the captured
+// response. Nothing is persisted and a failed delivery is not retried.
+func (s *Sender) SendTestEvent(
+	ctx context.Context,
+	subscription *coredata.WebhookSubscription,
+) (*TestDelivery, error) {
+	// The secret cache is owned by the Run loop, decrypt directly.
+	signingSecret, err := cipher.Decrypt(subscription.EncryptedSigningSecret, s.encryptionKey)
+	if err != nil {
+		return nil, fmt.Errorf("cannot decrypt signing secret: %w", err)
+	}
+
+	data, err := json.Marshal(
+		map[string]string{
+			"message": "This is a test event sent from Probo.",
+		},
+	)
+	if err != nil {
+		return nil, fmt.Errorf("cannot marshal test event data: %w", err)
+	}
+
+	tenantID := subscription.ID.TenantID()
+	webhookData := &coredata.WebhookData{
+		ID:             gid.New(tenantID, coredata.WebhookDataEntityType),
+		OrganizationID: subscription.OrganizationID,
+		EventType:      TestEventType,
+		Data:           data,
+		CreatedAt:      time.Now(),
+	}
+
+	response, err := s.doHTTPCall(
+		ctx,
+		gid.New(tenantID, coredata.WebhookEventEntityType),
+		subscription.EndpointURL,
+		webhookData,
+		subscription.ID,
+		string(signingSecret),
+	)
+
+	delivery := &TestDelivery{
+		Succeeded: err == nil,
+		Response:  response,
+	}
+	if err != nil {
+		delivery.Error = err.Error()
+	}
+
+	return delivery, nil
+}
+
 func (s *Sender) getSigningSecret(webhookSubscriptionID string, encryptedSigningSecret []byte) (string, error) {
 	if cached, ok := s.cache.Load(webhookSubscriptionID); ok {
 		entry := cached.(*cachedSecret)
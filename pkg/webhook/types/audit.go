@@ -0,0 +1,50 @@
+// Copyright (c) 2025 Probo Inc <hello@getprobo.com>.
+//
+// Permission to use, copy, modify, and/or distribute this software for any
+// purpose with or without fee is hereby granted, provided that the above
+// copyright notice and this permission notice appear in all copies.
+//
+// THE SOFTWARE IS PROVIDED "AS IS" AND THE AUTHOR DISCLAIMS ALL WARRANTIES WITH
+// REGARD TO THIS SOFTWARE INCLUDING ALL IMPLIED WARRANTIES OF MERCHANTABILITY
+// AND FITNESS. IN NO EVENT SHALL THE AUTHOR BE LIABLE FOR ANY SPECIAL, DIRECT,
+// INDIRECT, OR CONSEQUENTIAL DAMAGES OR ANY DAMAGES WHATSOEVER RESULTING FROM
+// LOSS OF USE, DATA OR PROFITS, WHETHER IN AN ACTION OF CONTRACT, NEGLIGENCE OR
+// OTHER TORTIOUS ACTION, ARISING OUT OF OR IN CONNECTION WITH THE USE OR
+// PERFORMANCE OF THIS SOFTWARE.
+
+package types
+
+import (
+	"time"
+
+	"go.probo.inc/probo/pkg/coredata"
+	"go.probo.inc/probo/pkg/gid"
+)
+
+type Audit struct {
+	ID                    gid.GID                        `json:"id"`
+	Name                  *string                        `json:"name"`
+	FrameworkID           gid.GID                        `json:"frameworkId"`
+	ReportID              *gid.GID                       `json:"reportId"`
+	ValidFrom             *time.Time                     `json:"validFrom"`
+	ValidUntil            *time.Time                     `json:"validUntil"`
+	State                 coredata.AuditState            `json:"state"`
+	TrustCenterVisibility coredata.TrustCenterVisibility `json:"trustCenterVisibility"`
+	CreatedAt             time.Time                      `json:"createdAt"`
+	UpdatedAt             time.Time                      `json:"updatedAt"`
+}
+
+func NewAudit(a *coredata.Audit) *Audit {
+	return &Audit{
+		ID:                    a.ID,
+		Name:                  a.Name,
+		FrameworkID:           a.FrameworkID,
+		ReportID:              a.ReportID,
+		ValidFrom:             a.ValidFrom,
+		ValidUntil:            a.ValidUntil,
+		State:                 a.State,
+		TrustCenterVisibility: a.TrustCenterVisibility,
+		CreatedAt:             a.CreatedAt,
+		UpdatedAt:             a.UpdatedAt,
+	}
+}
@@ -0,0 +1,76 @@
+// Copyright (c) 2025 Probo Inc <hello@getprobo.com>.
+//
+// Permission to use, copy, modify, and/or distribute this software for any
+// purpose with or without fee is hereby granted, provided that the above
+// copyright notice and this permission notice appear in all copies.
+//
+// THE SOFTWARE IS PROVIDED "AS IS" AND THE AUTHOR DISCLAIMS ALL WARRANTIES WITH
+// REGARD TO THIS SOFTWARE INCLUDING ALL IMPLIED WARRANTIES OF MERCHANTABILITY
+// AND FITNESS. IN NO EVENT SHALL THE AUTHOR BE LIABLE FOR ANY SPECIAL, DIRECT,
+// INDIRECT, OR CONSEQUENTIAL DAMAGES OR ANY DAMAGES WHATSOEVER RESULTING FROM
+// LOSS OF USE, DATA OR PROFITS, WHETHER IN AN ACTION OF CONTRACT, NEGLIGENCE OR
+// OTHER TORTIOUS ACTION, ARISING OUT OF OR IN CONNECTION WITH THE USE OR
+// PERFORMANCE OF THIS SOFTWARE.
+
+package types
+
+import (
+	"time"
+
+	"go.probo.inc/probo/pkg/coredata"
+	"go.probo.inc/probo/pkg/gid"
+)
+
+type (
+	DocumentVersion struct {
+		ID             gid.GID                         `json:"id"`
+		DocumentID     gid.GID                         `json:"documentId"`
+		Title          string                          `json:"title"`
+		VersionNumber  int                             `json:"versionNumber"`
+		Classification coredata.DocumentClassification `json:"classification"`
+		Changelog      string                          `json:"changelog"`
+		Status         coredata.DocumentStatus         `json:"status"`
+		PublishedAt    *time.Time                      `json:"publishedAt"`
+		CreatedAt      time.Time                       `json:"createdAt"`
+		UpdatedAt      time.Time                       `json:"updatedAt"`
+	}
+
+	DocumentVersionSignature struct {
+		ID                gid.GID                                `json:"id"`
+		DocumentVersionID gid.GID                                `json:"documentVersionId"`
+		State             coredata.DocumentVersionSignatureState `json:"state"`
+		SignedByID        gid.GID                                `json:"signedById"`
+		SignedAt          *time.Time                             `json:"signedAt"`
+		RequestedAt       time.Time                              `json:"requestedAt"`
+		CreatedAt         time.Time                              `json:"createdAt"`
+		UpdatedAt         time.Time                              `json:"updatedAt"`
+	}
+)
+
+func NewDocumentVersion(v *coredata.DocumentVersion) *DocumentVersion {
+	return &DocumentVersion{
+		ID:             v.ID,
+		DocumentID:     v.DocumentID,
+		Title:          v.Title,
+		VersionNumber:  v.VersionNumber,
+		Classification: v.Classification,
+		Changelog:      v.Changelog,
+		Status:         v.Status,
+		PublishedAt:    v.PublishedAt,
+		CreatedAt:      v.CreatedAt,
+		UpdatedAt:      v.UpdatedAt,
+	}
+}
+
+func NewDocumentVersionSignature(s *coredata.DocumentVersionSignature) *DocumentVersionSignature {
+	return &DocumentVersionSignature{
+		ID:                s.ID,
+		DocumentVersionID: s.DocumentVersionID,
+		State:             s.State,
+		SignedByID:        s.SignedBy,
+		SignedAt:          s.SignedAt,
+		RequestedAt:       s.RequestedAt,
+		CreatedAt:         s.CreatedAt,
+		UpdatedAt:         s.UpdatedAt,
+	}
+}
@@ -0,0 +1,50 @@
+// Copyright (c) 2025 Probo Inc <hello@getprobo.com>.
+//
+// Permission to use, copy, modify, and/or distribute this software for any
+// purpose with or without fee is hereby granted, provided that the above
+// copyright notice and this permission notice appear in all copies.
+//
+// THE SOFTWARE IS PROVIDED "AS IS" AND THE AUTHOR DISCLAIMS ALL WARRANTIES WITH
+// REGARD TO THIS SOFTWARE INCLUDING ALL IMPLIED WARRANTIES OF MERCHANTABILITY
+// AND FITNESS. IN NO EVENT SHALL THE AUTHOR BE LIABLE FOR ANY SPECIAL, DIRECT,
+// INDIRECT, OR CONSEQUENTIAL DAMAGES OR ANY DAMAGES WHATSOEVER RESULTING FROM
+// LOSS OF USE, DATA OR PROFITS, WHETHER IN AN ACTION OF CONTRACT, NEGLIGENCE OR
+// OTHER TORTIOUS ACTION, ARISING OUT OF OR IN CONNECTION WITH THE USE OR
+// PERFORMANCE OF THIS SOFTWARE.
+
+package types
+
+import (
+	"time"
+
+	"go.probo.inc/probo/pkg/coredata"
+	"go.probo.inc/probo/pkg/gid"
+)
+
+type Evidence struct {
+	ID          gid.GID                `json:"id"`
+	MeasureID   gid.GID                `json:"measureId"`
+	TaskID      *gid.GID               `json:"taskId"`
+	State       coredata.EvidenceState `json:"state"`
+	ReferenceID string                 `json:"referenceId"`
+	Type        coredata.EvidenceType  `json:"type"`
+	URL         string                 `json:"url"`
+	Description *string                `json:"description"`
+	CreatedAt   time.Time              `json:"createdAt"`
+	UpdatedAt   time.Time              `json:"updatedAt"`
+}
+
+func NewEvidence(e *coredata.Evidence) *Evidence {
+	return &Evidence{
+		ID:          e.ID,
+		MeasureID:   e.MeasureID,
+		TaskID:      e.TaskID,
+		State:       e.State,
+		ReferenceID: e.ReferenceID,
+		Type:        e.Type,
+		URL:         e.URL,
+		Description: e.Description,
+		CreatedAt:   e.CreatedAt,
+		UpdatedAt:   e.UpdatedAt,
+	}
+}
@@ -0,0 +1,56 @@
+// Copyright (c) 2025 Probo Inc <hello@getprobo.com>.
+//
+// Permission to use, copy, modify, and/or distribute this software for any
+// purpose with or without fee is hereby granted, provided that the above
+// copyright notice and this permission notice appear in all copies.
+//
+// THE SOFTWARE IS PROVIDED "AS IS" AND THE AUTHOR DISCLAIMS ALL WARRANTIES WITH
+// REGARD TO THIS SOFTWARE INCLUDING ALL IMPLIED WARRANTIES OF MERCHANTABILITY
+// AND FITNESS. IN NO EVENT SHALL THE AUTHOR BE LIABLE FOR ANY SPECIAL, DIRECT,
+// INDIRECT, OR CONSEQUENTIAL DAMAGES OR ANY DAMAGES WHATSOEVER RESULTING FROM
+// LOSS OF USE, DATA OR PROFITS, WHETHER IN AN ACTION OF CONTRACT, NEGLIGENCE OR
+// OTHER TORTIOUS ACTION, ARISING OUT OF OR IN CONNECTION WITH THE USE OR
+// PERFORMANCE OF THIS SOFTWARE.
+
+package types
+
+import (
+	"time"
+
+	"go.probo.inc/probo/pkg/coredata"
+	"go.probo.inc/probo/pkg/gid"
+)
+
+type Nonconformity struct {
+	ID                 gid.GID                      `json:"id"`
+	ReferenceID        string                       `json:"referenceId"`
+	Description        *string                      `json:"description"`
+	AuditID            *gid.GID                     `json:"auditId"`
+	DateIdentified     *time.Time                   `json:"dateIdentified"`
+	RootCause          string                       `json:"rootCause"`
+	CorrectiveAction   *string                      `json:"correctiveAction"`
+	OwnerID            gid.GID                      `json:"ownerId"`
+	DueDate            *time.Time                   `json:"dueDate"`
+	Status             coredata.NonconformityStatus `json:"status"`
+	EffectivenessCheck *string                      `json:"effectivenessCheck"`
+	CreatedAt          time.Time                    `json:"createdAt"`
+	UpdatedAt          time.Time                    `json:"updatedAt"`
+}
+
+func NewNonconformity(n *coredata.Nonconformity) *Nonconformity {
+	return &Nonconformity{
+		ID:                 n.ID,
+		ReferenceID:        n.ReferenceID,
+		Description:        n.Description,
+		AuditID:            n.AuditID,
+		DateIdentified:     n.DateIdentified,
+		RootCause:          n.RootCause,
+		CorrectiveAction:   n.CorrectiveAction,
+		OwnerID:            n.OwnerID,
+		DueDate:            n.DueDate,
+		Status:             n.Status,
+		EffectivenessCheck: n.EffectivenessCheck,
+		CreatedAt:          n.CreatedAt,
+		UpdatedAt:          n.UpdatedAt,
+	}
+}
@@ -0,0 +1,62 @@
+// Copyright (c) 2025 Probo Inc <hello@getprobo.com>.
+//
+// Permission to use, copy, modify, and/or distribute this software for any
+// purpose with or without fee is hereby granted, provided that the above
+// copyright notice and this permission notice appear in all copies.
+//
+// THE SOFTWARE IS PROVIDED "AS IS" AND THE AUTHOR DISCLAIMS ALL WARRANTIES WITH
+// REGARD TO THIS SOFTWARE INCLUDING ALL IMPLIED WARRANTIES OF MERCHANTABILITY
+// AND FITNESS. IN NO EVENT SHALL THE AUTHOR BE LIABLE FOR ANY SPECIAL, DIRECT,
+// INDIRECT, OR CONSEQUENTIAL DAMAGES OR ANY DAMAGES WHATSOEVER RESULTING FROM
+// LOSS OF USE, DATA OR PROFITS, WHETHER IN AN ACTION OF CONTRACT, NEGLIGENCE OR
+// OTHER TORTIOUS ACTION, ARISING OUT OF OR IN CONNECTION WITH THE USE OR
+// PERFORMANCE OF THIS SOFTWARE.
+
+package types
+
+import (
+	"time"
+
+	"go.probo.inc/probo/pkg/coredata"
+	"go.probo.inc/probo/pkg/gid"
+)
+
+type Risk struct {
+	ID                 gid.GID                `json:"id"`
+	Name               string                 `json:"name"`
+	Description        *string                `json:"description"`
+	Category           string                 `json:"category"`
+	Treatment          coredata.RiskTreatment `json:"treatment"`
+	Note               string                 `json:"note"`
+	OwnerID            *gid.GID               `json:"ownerId"`
+	InherentLikelihood int                    `json:"inherentLikelihood"`
+	InherentImpact     int                    `json:"inherentImpact"`
+	InherentRiskScore  int                    `json:"inherentRiskScore"`
+	ResidualLikelihood int                    `json:"residualLikelihood"`
+	ResidualImpact     int                    `json:"residualImpact"`
+	ResidualRiskScore  int                    `json:"residualRiskScore"`
+	CreatedAt          time.Time              `json:"createdAt"`
+	UpdatedAt          time.Time              `json:"updatedAt"`
+}
+
+func NewRisk(r *coredata.Risk) *Risk {
+	return &Risk{
+		ID:                 r.ID,
+		Name:               r.Name,
+		Description:        r.Description,
+		Category:           r.Category,
+		Treatment:          r.Treatment,
+		Note:               r.Note,
+		OwnerID:            r.OwnerID,
+		InherentLikelihood: r.InherentLikelihood,
+		InherentImpact:     r.InherentImpact,
+		// Scores are generated columns and are not refreshed on the
+		// struct after a write, so derive them the same way here.
+		InherentRiskScore:  r.InherentLikelihood * r.InherentImpact,
+		ResidualLikelihood: r.ResidualLikelihood,
+		ResidualImpact:     r.ResidualImpact,
+		ResidualRiskScore:  r.ResidualLikelihood * r.ResidualImpact,
+		CreatedAt:          r.CreatedAt,
+		UpdatedAt:          r.UpdatedAt,
+	}
+}
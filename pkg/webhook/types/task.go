@@ -0,0 +1,58 @@
+// Copyright (c) 2025 Probo Inc <hello@getprobo.com>.
+//
+// Permission to use, copy, modify, and/or distribute this software for any
+// purpose with or without fee is hereby granted, provided that the above
+// copyright notice and this permission notice appear in all copies.
+//
+// THE SOFTWARE IS PROVIDED "AS IS" AND THE AUTHOR DISCLAIMS ALL WARRANTIES WITH
+// REGARD TO THIS SOFTWARE INCLUDING ALL IMPLIED WARRANTIES OF MERCHANTABILITY
+// AND FITNESS. IN NO EVENT SHALL THE AUTHOR BE LIABLE FOR ANY SPECIAL, DIRECT,
+// INDIRECT, OR CONSEQUENTIAL DAMAGES OR ANY DAMAGES WHATSOEVER RESULTING FROM
+// LOSS OF USE, DATA OR PROFITS, WHETHER IN AN ACTION OF CONTRACT, NEGLIGENCE OR
+// OTHER TORTIOUS ACTION, ARISING OUT OF OR IN CONNECTION WITH THE USE OR
+// PERFORMANCE OF THIS SOFTWARE.
+
+package types
+
+import (
+	"time"
+
+	"go.probo.inc/probo/pkg/coredata"
+	"go.probo.inc/probo/pkg/gid"
+)
+
+type Task struct {
+	ID           gid.GID            `json:"id"`
+	MeasureID    *gid.GID           `json:"measureId"`
+	Name         string             `json:"name"`
+	Description  *string            `json:"description"`
+	State        coredata.TaskState `json:"state"`
+	ReferenceID  string             `json:"referenceId"`
+	TimeEstimate *int64             `json:"timeEstimate"`
+	AssignedToID *gid.GID           `json:"assignedToId"`
+	Deadline     *time.Time         `json:"deadline"`
+	CreatedAt    time.Time          `json:"createdAt"`
+	UpdatedAt    time.Time          `json:"updatedAt"`
+}
+
+func NewTask(t *coredata.Task) *Task {
+	task := &Task{
+		ID:           t.ID,
+		MeasureID:    t.MeasureID,
+		Name:         t.Name,
+		Description:  t.Description,
+		State:        t.State,
+		ReferenceID:  t.ReferenceID,
+		AssignedToID: t.AssignedToID,
+		Deadline:     t.Deadline,
+		CreatedAt:    t.CreatedAt,
+		UpdatedAt:    t.UpdatedAt,
+	}
+
+	if t.TimeEstimate != nil {
+		seconds := int64(t.TimeEstimate.Seconds())
+		task.TimeEstimate = &seconds
+	}
+
+	return task
+}
@@ -0,0 +1,47 @@
+// Copyright (c) 2025 Probo Inc <hello@getprobo.com>.
+//
+// Permission to use, copy, modify, and/or distribute this software for any
+// purpose with or without fee is hereby granted, provided that the above
+// copyright notice and this permission notice appear in all copies.
+//
+// THE SOFTWARE IS PROVIDED "AS IS" AND THE AUTHOR DISCLAIMS ALL WARRANTIES WITH
+// REGARD TO THIS SOFTWARE INCLUDING ALL IMPLIED WARRANTIES OF MERCHANTABILITY
+// AND FITNESS. IN NO EVENT SHALL THE AUTHOR BE LIABLE FOR ANY SPECIAL, DIRECT,
+// INDIRECT, OR CONSEQUENTIAL DAMAGES OR ANY DAMAGES WHATSOEVER RESULTING FROM
+// LOSS OF USE, DATA OR PROFITS, WHETHER IN AN ACTION OF CONTRACT, NEGLIGENCE OR
+// OTHER TORTIOUS ACTION, ARISING OUT OF OR IN CONNECTION WITH THE USE OR
+// PERFORMANCE OF THIS SOFTWARE.
+
+package types
+
+import (
+	"time"
+
+	"go.probo.inc/probo/pkg/coredata"
+	"go.probo.inc/probo/pkg/gid"
+	"go.probo.inc/probo/pkg/mail"
+)
+
+type TrustCenterAccess struct {
+	ID                                gid.GID                         `json:"id"`
+	TrustCenterID                     gid.GID                         `json:"trustCenterId"`
+	Email                             mail.Addr                       `json:"email"`
+	Name                              string                          `json:"name"`
+	State                             coredata.TrustCenterAccessState `json:"state"`
+	HasAcceptedNonDisclosureAgreement bool                            `json:"hasAcceptedNonDisclosureAgreement"`
+	CreatedAt                         time.Time                       `json:"createdAt"`
+	UpdatedAt                         time.Time                       `json:"updatedAt"`
+}
+
+func NewTrustCenterAccess(a *coredata.TrustCenterAccess) *TrustCenterAccess {
+	return &TrustCenterAccess{
+		ID:                                a.ID,
+		TrustCenterID:                     a.TrustCenterID,
+		Email:                             a.Email,
+		Name:                              a.Name,
+		State:                             a.State,
+		HasAcceptedNonDisclosureAgreement: a.HasAcceptedNonDisclosureAgreement,
+		CreatedAt:                         a.CreatedAt,
+		UpdatedAt:                         a.UpdatedAt,
+	}
+}